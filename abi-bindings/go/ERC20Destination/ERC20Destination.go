@@ -64,7 +64,7 @@ type TeleporterFeeInfo struct {
 
 // ERC20DestinationMetaData contains all meta data concerning the ERC20Destination contract.
 var ERC20DestinationMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterRegistryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"teleporterManager\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID_\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"tokenSourceAddress_\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"tokenName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"tokenSymbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"tokenDecimals\",\"type\":\"uint8\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MULTI_HOP_CALL_GAS_PER_WORD\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MULTI_HOP_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REGISTER_DESTINATION_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"payloadSize\",\"type\":\"uint256\"}],\"name\":\"calculateNumWords\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialReserveImbalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isCollateralized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isRegistered\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"multiplyOnDestination\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"feeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structTeleporterFeeInfo\",\"name\":\"feeInfo\",\"type\":\"tuple\"}],\"name\":\"registerWithSource\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"sendAndCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sourceBlockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenMultiplier\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenSourceAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterRegistryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"teleporterManager\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID_\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"tokenSourceAddress_\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"tokenName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"tokenSymbol\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"tokenDecimals\",\"type\":\"uint8\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"EscrowClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"RecipientBlocked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"RecipientUnblocked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensEscrowed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MULTI_HOP_CALL_GAS_PER_WORD\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MULTI_HOP_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REGISTER_DESTINATION_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"}],\"name\":\"allowance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"blockRecipient\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"blockedRecipients\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"blocked\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"payloadSize\",\"type\":\"uint256\"}],\"name\":\"calculateNumWords\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"claimEscrowedTokens\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"decimals\",\"outputs\":[{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"subtractedValue\",\"type\":\"uint256\"}],\"name\":\"decreaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"escrowedBalances\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"spender\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"addedValue\",\"type\":\"uint256\"}],\"name\":\"increaseAllowance\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialReserveImbalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isCollateralized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isRegistered\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"multiplyOnDestination\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"feeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structTeleporterFeeInfo\",\"name\":\"feeInfo\",\"type\":\"tuple\"}],\"name\":\"registerWithSource\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"sendAndCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sendsPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sourceBlockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenMultiplier\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenSourceAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transfer\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"unblockRecipient\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 	Bin: "0x6101806040523480156200001257600080fd5b50604051620049533803806200495383398101604081905262000035916200063f565b8282888888886000806000868681600160008190555060006001600160a01b0316816001600160a01b031603620000d95760405162461bcd60e51b815260206004820152603760248201527f54656c65706f727465725570677261646561626c653a207a65726f2074656c6560448201527f706f72746572207265676973747279206164647265737300000000000000000060648201526084015b60405180910390fd5b6001600160a01b03811660808190526040805163301fd1f560e21b8152905163c07f47d4916004808201926020929091908290030181865afa15801562000124573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906200014a919062000707565b6002555062000159336200042e565b620001648162000480565b505060016004819055507302000000000000000000000000000000000000056001600160a01b0316634213cf786040518163ffffffff1660e01b8152600401602060405180830381865afa158015620001c1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620001e7919062000707565b60a052846200024e5760405162461bcd60e51b815260206004820152603560248201526000805160206200493383398151915260448201527f20736f7572636520626c6f636b636861696e20494400000000000000000000006064820152608401620000d0565b60a0518503620002d65760405162461bcd60e51b815260206004820152604660248201527f54656c65706f72746572546f6b656e44657374696e6174696f6e3a2063616e6e60448201527f6f74206465706c6f7920746f2073616d6520626c6f636b636861696e20617320606482015265736f7572636560d01b608482015260a401620000d0565b6001600160a01b038416620003435760405162461bcd60e51b815260206004820152603560248201526000805160206200493383398151915260448201527f20746f6b656e20736f75726365206164647265737300000000000000000000006064820152608401620000d0565b60128260ff161115620003b35760405162461bcd60e51b815260206004820152603160248201527f54656c65706f72746572546f6b656e44657374696e6174696f6e3a20696e76616044820152701b1a5908191958da5b585b1cd4da1a599d607a1b6064820152608401620000d0565b60c08590526001600160a01b03841660e0526101408390526005805460ff19168415179055620003e582600a62000836565b610100521515610120525060099450620004089350869250849150620008dd9050565b50600a620004178282620008dd565b50505060ff166101605250620009a9945050505050565b600380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b6200048a620004ff565b6001600160a01b038116620004f15760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401620000d0565b620004fc816200042e565b50565b6003546001600160a01b031633146200055b5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401620000d0565b565b80516001600160a01b03811681146200057557600080fd5b919050565b634e487b7160e01b600052604160045260246000fd5b600082601f830112620005a257600080fd5b81516001600160401b0380821115620005bf57620005bf6200057a565b604051601f8301601f19908116603f01168101908282118183101715620005ea57620005ea6200057a565b816040528381526020925086838588010111156200060757600080fd5b600091505b838210156200062b57858201830151818301840152908201906200060c565b600093810190920192909252949350505050565b600080600080600080600060e0888a0312156200065b57600080fd5b62000666886200055d565b965062000676602089016200055d565b9550604088015194506200068d606089016200055d565b60808901519094506001600160401b0380821115620006ab57600080fd5b620006b98b838c0162000590565b945060a08a0151915080821115620006d057600080fd5b50620006df8a828b0162000590565b92505060c088015160ff81168114620006f757600080fd5b8091505092959891949750929550565b6000602082840312156200071a57600080fd5b5051919050565b634e487b7160e01b600052601160045260246000fd5b600181815b80851115620007785781600019048211156200075c576200075c62000721565b808516156200076a57918102915b93841c93908002906200073c565b509250929050565b600082620007915750600162000830565b81620007a05750600062000830565b8160018114620007b95760028114620007c457620007e4565b600191505062000830565b60ff841115620007d857620007d862000721565b50506001821b62000830565b5060208310610133831016604e8410600b841016171562000809575081810a62000830565b62000815838362000737565b80600019048211156200082c576200082c62000721565b0290505b92915050565b60006200084760ff84168362000780565b9392505050565b600181811c908216806200086357607f821691505b6020821081036200088457634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620008d857600081815260208120601f850160051c81016020861015620008b35750805b601f850160051c820191505b81811015620008d457828155600101620008bf565b5050505b505050565b81516001600160401b03811115620008f957620008f96200057a565b62000911816200090a84546200084e565b846200088a565b602080601f831160018114620009495760008415620009305750858301515b600019600386901b1c1916600185901b178555620008d4565b600085815260208120601f198616915b828110156200097a5788860151825594840194600190910190840162000959565b5085821015620009995787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60805160a05160c05160e05161010051610120516101405161016051613e7562000abe600039600061036201526000818161044201526106c50152600081816102da01528181610711015281816126ba01526127050152600081816104d4015281816106e80152818161269901526126e4015260008181610576015281816107a60152818161139a015281816116a301528181611bbb01528181611fbf01526121c60152600081816103260152818161077b0152818161136a0152818161167d01528181611b8b01528181611f99015261215001526000818161050e015281816114f101528181611c8b0152611db601526000818161029b01528181610b7e0152818161178501526123870152613e756000f3fe608060405234801561001057600080fd5b506004361061021c5760003560e01c80635eb9951411610125578063a9059cbb116100ad578063d2cc7a701161007c578063d2cc7a7014610530578063dd62ed3e14610538578063f2fde38b1461054b578063f3f981d81461055e578063f5ea06031461057157600080fd5b8063a9059cbb146104bc578063ba3f5a12146104cf578063c868efaa146104f6578063d127dc9b1461050957600080fd5b80638ac7dd20116100f45780638ac7dd201461043d5780638da5cb5b1461046457806395d89b4114610475578063973142971461047d578063a457c2d7146104a957600080fd5b80635eb99514146103e657806365690038146103f957806370a082311461040c578063715018a61461043557600080fd5b806329b7b3fd116101a85780634511243e116101775780634511243e1461039f57806349e3284e146103b2578063525975e6146103bf57806355c26725146103c95780635d16225d146103d357600080fd5b806329b7b3fd146103215780632b0d8f1814610348578063313ce5671461035b578063395093511461038c57600080fd5b806318160ddd116101ef57806318160ddd1461028e5780631a7f5bec146102965780632001eff6146102d557806322366844146102fc57806323b872dd1461030e57600080fd5b806306fdde0314610221578063095ea7b31461023f578063151637f61461026257806315beb59f14610277575b600080fd5b610229610598565b60405161023691906130de565b60405180910390f35b61025261024d366004613111565b61062a565b6040519015158152602001610236565b61027561027036600461313d565b610644565b005b61028061213481565b604051908152602001610236565b600854610280565b6102bd7f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b039091168152602001610236565b6102527f000000000000000000000000000000000000000000000000000000000000000081565b60055461025290610100900460ff1681565b61025261031c366004613155565b610852565b6102807f000000000000000000000000000000000000000000000000000000000000000081565b610275610356366004613196565b610876565b60405160ff7f0000000000000000000000000000000000000000000000000000000000000000168152602001610236565b61025261039a366004613111565b610972565b6102756103ad366004613196565b610994565b6005546102529060ff1681565b6102806203a98081565b610280620249f081565b6102756103e13660046131b3565b610a91565b6102756103f43660046131e5565b610a9f565b6102756104073660046131fe565b610ab3565b61028061041a366004613196565b6001600160a01b031660009081526006602052604090205490565b610275610abd565b6102807f000000000000000000000000000000000000000000000000000000000000000081565b6003546001600160a01b03166102bd565b610229610ad1565b61025261048b366004613196565b6001600160a01b031660009081526001602052604090205460ff1690565b6102526104b7366004613111565b610ae0565b6102526104ca366004613111565b610b5b565b6102807f000000000000000000000000000000000000000000000000000000000000000081565b61027561050436600461323b565b610b69565b6102807f000000000000000000000000000000000000000000000000000000000000000081565b600254610280565b6102806105463660046132c4565b610d2d565b610275610559366004613196565b610d58565b61028061056c3660046131e5565b610dce565b6102bd7f000000000000000000000000000000000000000000000000000000000000000081565b6060600980546105a7906132fd565b80601f01602080910402602001604051908101604052809291908181526020018280546105d3906132fd565b80156106205780601f106105f557610100808354040283529160200191610620565b820191906000526020600020905b81548152906001019060200180831161060357829003601f168201915b5050505050905090565b600033610638818585610de5565b60019150505b92915050565b600554610100900460ff16156106b85760405162461bcd60e51b815260206004820152602e60248201527f54656c65706f72746572546f6b656e44657374696e6174696f6e3a20616c726560448201526d18591e481c9959da5cdd195c995960921b60648201526084015b60405180910390fd5b60408051606080820183527f000000000000000000000000000000000000000000000000000000000000000082527f000000000000000000000000000000000000000000000000000000000000000060208084019182527f00000000000000000000000000000000000000000000000000000000000000001515848601908152855180870187526000815286518651818501529351848801529051151583850152855180840390940184526080909201855281810192909252835160c0810185527f000000000000000000000000000000000000000000000000000000000000000081526001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001692810192909252919261084c919081016107e5368790038701876133a9565b8152620249f06020820152604001600060405190808252806020026020018201604052801561081e578160200160208202803683370190505b5081526020018360405160200161083591906133e3565b604051602081830303815290604052815250610f09565b50505050565b60003361086085828561102f565b61086b8585856110a3565b506001949350505050565b61087e61124e565b6001600160a01b0381166108a45760405162461bcd60e51b81526004016106af90613428565b6001600160a01b03811660009081526001602052604090205460ff16156109235760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f727465725570677261646561626c653a2061646472657373206160448201526c1b1c9958591e481c185d5cd959609a1b60648201526084016106af565b6001600160a01b0381166000818152600160208190526040808320805460ff1916909217909155517f933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c9190a250565b6000336106388185856109858383610d2d565b61098f919061348c565b610de5565b61099c61124e565b6001600160a01b0381166109c25760405162461bcd60e51b81526004016106af90613428565b6001600160a01b03811660009081526001602052604090205460ff16610a3c5760405162461bcd60e51b815260206004820152602960248201527f54656c65706f727465725570677261646561626c653a2061646472657373206e6044820152681bdd081c185d5cd95960ba1b60648201526084016106af565b6040516001600160a01b038216907f844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c390600090a26001600160a01b03166000908152600160205260409020805460ff19169055565b610a9b8282611256565b5050565b610aa761124e565b610ab081611781565b50565b610a9b8282611921565b610ac5612049565b610acf60006120a3565b565b6060600a80546105a7906132fd565b60003381610aee8286610d2d565b905083811015610b4e5760405162461bcd60e51b815260206004820152602560248201527f45524332303a2064656372656173656420616c6c6f77616e63652062656c6f77604482015264207a65726f60d81b60648201526084016106af565b61086b8286868403610de5565b6000336106388185856110a3565b610b716120f5565b6002546001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016634c1f08ce336040516001600160e01b031960e084901b1681526001600160a01b039091166004820152602401602060405180830381865afa158015610be8573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610c0c919061349f565b1015610c735760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a20696e76616c6964205460448201526f32b632b837b93a32b91039b2b73232b960811b60648201526084016106af565b610c7c3361048b565b15610ce25760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a2054656c65706f72746560448201526f1c881859191c995cdcc81c185d5cd95960821b60648201526084016106af565b610d23848484848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061214e92505050565b61084c6001600055565b6001600160a01b03918216600090815260076020908152604080832093909416825291909152205490565b610d60612049565b6001600160a01b038116610dc55760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016106af565b610ab0816120a3565b60006005610ddd83601f61348c565b901c92915050565b6001600160a01b038316610e475760405162461bcd60e51b8152602060048201526024808201527f45524332303a20617070726f76652066726f6d20746865207a65726f206164646044820152637265737360e01b60648201526084016106af565b6001600160a01b038216610ea85760405162461bcd60e51b815260206004820152602260248201527f45524332303a20617070726f766520746f20746865207a65726f206164647265604482015261737360f01b60648201526084016106af565b6001600160a01b0383811660008181526007602090815260408083209487168084529482529182902085905590518481527f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925910160405180910390a3505050565b600080610f14612382565b60408401516020015190915015610fb9576040830151516001600160a01b0316610f965760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f727465725570677261646561626c653a207a65726f206665652060448201526c746f6b656e206164647265737360981b60648201526084016106af565b604083015160208101519051610fb9916001600160a01b03909116908390612496565b604051630624488560e41b81526001600160a01b03821690636244885090610fe59086906004016134b8565b6020604051808303816000875af1158015611004573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611028919061349f565b9392505050565b600061103b8484610d2d565b9050600019811461084c57818110156110965760405162461bcd60e51b815260206004820152601d60248201527f45524332303a20696e73756666696369656e7420616c6c6f77616e636500000060448201526064016106af565b61084c8484848403610de5565b6001600160a01b0383166111075760405162461bcd60e51b815260206004820152602560248201527f45524332303a207472616e736665722066726f6d20746865207a65726f206164604482015264647265737360d81b60648201526084016106af565b6001600160a01b0382166111695760405162461bcd60e51b815260206004820152602360248201527f45524332303a207472616e7366657220746f20746865207a65726f206164647260448201526265737360e81b60648201526084016106af565b6001600160a01b038316600090815260066020526040902054818110156111e15760405162461bcd60e51b815260206004820152602660248201527f45524332303a207472616e7366657220616d6f756e7420657863656564732062604482015265616c616e636560d01b60648201526084016106af565b6001600160a01b0380851660008181526006602052604080822086860390559286168082529083902080548601905591517fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef906112419086815260200190565b60405180910390a361084c565b610acf612049565b6001600454146112785760405162461bcd60e51b81526004016106af90613571565b6002600455600061128f6060840160408501613196565b6001600160a01b0316036112ee5760405162461bcd60e51b81526020600482015260326024820152600080516020613e0083398151915260448201527120726563697069656e74206164647265737360701b60648201526084016106af565b60008260c00135116113125760405162461bcd60e51b81526004016106af906135b5565b600061134983356113296040860160208701613196565b8461133a6080880160608901613196565b87608001358860a00135612580565b604080518082019091526000815260606020820152919350915060c08401357f00000000000000000000000000000000000000000000000000000000000000008535036114b6576001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000166113ca6040870160208801613196565b6001600160a01b0316146113f05760405162461bcd60e51b81526004016106af906135f6565b60a0850135156114125760405162461bcd60e51b81526004016106af90613641565b6000611425610100870160e08801613196565b6001600160a01b03161461144b5760405162461bcd60e51b81526004016106af90613693565b604080518082019091528060018152602001604051806040016040528088604001602081019061147b9190613196565b6001600160a01b031681526020018781525060405160200161149d91906136f0565b604051602081830303815290604052815250915061166b565b60006114c9610100870160e08801613196565b6001600160a01b0316036114ef5760405162461bcd60e51b81526004016106af90613710565b7f000000000000000000000000000000000000000000000000000000000000000085350361154e57306115286040870160208801613196565b6001600160a01b03160361154e5760405162461bcd60e51b81526004016106af906135f6565b6040805180820190915280600381526020016040518060e00160405280886000013581526020018860200160208101906115889190613196565b6001600160a01b031681526020016115a660608a0160408b01613196565b6001600160a01b031681526020810188905260a0890135604082015260c089013560608201526080016115e06101008a0160e08b01613196565b6001600160a01b031690526040516116509190602001815181526020808301516001600160a01b0390811691830191909152604080840151821690830152606080840151908301526080808401519083015260a0808401519083015260c092830151169181019190915260e00190565b60405160208183030381529060405281525091506203a98090505b600061172e6040518060c001604052807f000000000000000000000000000000000000000000000000000000000000000081526020017f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316815260200160405180604001604052808a60600160208101906116ee9190613196565b6001600160a01b031681526020908101899052908252818101869052604080516000815280830182528184015251606090920191610835918891016133e3565b9050336001600160a01b0316817f93f19bf1ec58a15dc643b37e7e18a1c13e85e06cd11929e283154691ace9fb52888860405161176c929190613751565b60405180910390a35050600160045550505050565b60007f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031663c07f47d46040518163ffffffff1660e01b8152600401602060405180830381865afa1580156117e1573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611805919061349f565b600254909150818311156118755760405162461bcd60e51b815260206004820152603160248201527f54656c65706f727465725570677261646561626c653a20696e76616c6964205460448201527032b632b837b93a32b9103b32b939b4b7b760791b60648201526084016106af565b8083116118ea5760405162461bcd60e51b815260206004820152603f60248201527f54656c65706f727465725570677261646561626c653a206e6f7420677265617460448201527f6572207468616e2063757272656e74206d696e696d756d2076657273696f6e0060648201526084016106af565b6002839055604051839082907fa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d90600090a3505050565b6001600454146119435760405162461bcd60e51b81526004016106af90613571565b6002600455600061195a6060840160408501613196565b6001600160a01b0316036119c45760405162461bcd60e51b815260206004820152603b6024820152600080516020613e0083398151915260448201527f20726563697069656e7420636f6e74726163742061646472657373000000000060648201526084016106af565b60008260800135116119e85760405162461bcd60e51b81526004016106af906135b5565b60008260a0013511611a475760405162461bcd60e51b81526020600482015260346024820152600080516020613e00833981519152604482015273081c9958da5c1a595b9d0819d85cc81b1a5b5a5d60621b60648201526084016106af565b81608001358260a0013510611ab25760405162461bcd60e51b81526020600482015260376024820152600080516020613e2083398151915260448201527f6c696420726563697069656e7420676173206c696d697400000000000000000060648201526084016106af565b6000611ac5610100840160e08501613196565b6001600160a01b031603611b2f5760405162461bcd60e51b815260206004820152603b6024820152600080516020613e0083398151915260448201527f2066616c6c6261636b20726563697069656e742061646472657373000000000060648201526084016106af565b6000611b6a8335611b466040860160208701613196565b84611b5961012088016101008901613196565b876101200135886101400135612580565b604080518082019091526000815260606020820152919350915060808401357f0000000000000000000000000000000000000000000000000000000000000000853503611d7c576001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016611beb6040870160208801613196565b6001600160a01b031614611c115760405162461bcd60e51b81526004016106af906135f6565b61014085013515611c345760405162461bcd60e51b81526004016106af90613641565b6000611c4660e0870160c08801613196565b6001600160a01b031614611c6c5760405162461bcd60e51b81526004016106af90613693565b6040805180820190915280600281526020016040518060e001604052807f00000000000000000000000000000000000000000000000000000000000000008152602001336001600160a01b03168152602001886040016020810190611cd19190613196565b6001600160a01b0316815260208101889052604001611cf360608a018a6137f0565b8080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050509082525060a08901356020820152604001611d486101008a0160e08b01613196565b6001600160a01b03169052604051611d63919060200161383e565b6040516020818303038152906040528152509150611f87565b6000611d8e60e0870160c08801613196565b6001600160a01b031603611db45760405162461bcd60e51b81526004016106af90613710565b7f0000000000000000000000000000000000000000000000000000000000000000853503611e135730611ded6040870160208801613196565b6001600160a01b031603611e135760405162461bcd60e51b81526004016106af906135f6565b604080518082019091528060048152602001604051806101600160405280336001600160a01b0316815260200188600001358152602001886020016020810190611e5d9190613196565b6001600160a01b03168152602001611e7b60608a0160408b01613196565b6001600160a01b0316815260208101889052604001611e9d60608a018a6137f0565b8080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050509082525060a08901356020820152604001611ef26101008a0160e08b01613196565b6001600160a01b0316815260808901356020820152604001611f1a60e08a0160c08b01613196565b6001600160a01b03168152610140890135602091820152604051611f3f9291016138b4565b60408051601f1981840301815291905290529150612134611f6d611f6660608801886137f0565b9050610dce565b611f779190613992565b611f84906203a98061348c565b90505b600061200b6040518060c001604052807f000000000000000000000000000000000000000000000000000000000000000081526020017f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316815260200160405180604001604052808a6101000160208101906116ee9190613196565b9050336001600160a01b0316817f5d76dff81bf773b908b050fa113d39f7d8135bb4175398f313ea19cd3a1a0b16888860405161176c929190613a18565b6003546001600160a01b03163314610acf5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016106af565b600380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b6002600054036121475760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016106af565b6002600055565b7f000000000000000000000000000000000000000000000000000000000000000083146121c45760405162461bcd60e51b81526020600482015260306024820152600080516020613e2083398151915260448201526f3634b21039b7bab931b29031b430b4b760811b60648201526084016106af565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316826001600160a01b0316146122595760405162461bcd60e51b81526020600482015260386024820152600080516020613e2083398151915260448201527f6c696420746f6b656e20736f757263652061646472657373000000000000000060648201526084016106af565b60008180602001905181019061226f9190613baf565b600554909150610100900460ff16158061228c575060055460ff16155b156122a1576005805461ffff19166101011790555b6001815160048111156122b6576122b6613331565b036122ef57600081602001518060200190518101906122d59190613c3e565b90506122e9816000015182602001516127ab565b5061084c565b60028151600481111561230457612304613331565b0361233357600081602001518060200190518101906123239190613c78565b90506122e98182606001516127f8565b60405162461bcd60e51b81526020600482015260306024820152600080516020613e2083398151915260448201526f6c6964206d657373616765207479706560801b60648201526084016106af565b6000807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031663d820e64f6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156123e3573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906124079190613d36565b905061242b816001600160a01b031660009081526001602052604090205460ff1690565b156124915760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a2054656c65706f72746560448201526f1c881cd95b991a5b99c81c185d5cd95960821b60648201526084016106af565b919050565b604051636eb1769f60e11b81523060048201526001600160a01b038381166024830152600091839186169063dd62ed3e90604401602060405180830381865afa1580156124e7573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061250b919061349f565b612515919061348c565b6040516001600160a01b03851660248201526044810182905290915061084c90859063095ea7b360e01b906064015b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b031990931692909217909152612956565b505050565b600080876125e45760405162461bcd60e51b815260206004820152603a6024820152600080516020613e0083398151915260448201527f2064657374696e6174696f6e20626c6f636b636861696e20494400000000000060648201526084016106af565b6001600160a01b03871661264e5760405162461bcd60e51b815260206004820152603b6024820152600080516020613e0083398151915260448201527f2064657374696e6174696f6e206272696467652061646472657373000000000060648201526084016106af565b61265786612a28565b9550831561268b57306001600160a01b0386160361267e5761267884612a28565b5061268b565b6126888585612a44565b93505b61269486612bac565b6126df7f00000000000000000000000000000000000000000000000000000000000000007f000000000000000000000000000000000000000000000000000000000000000085612bb6565b61272a7f00000000000000000000000000000000000000000000000000000000000000007f000000000000000000000000000000000000000000000000000000000000000089612bb6565b1161279d5760405162461bcd60e51b815260206004820152603b60248201527f54656c65706f72746572546f6b656e44657374696e6174696f6e3a20696e737560448201527f6666696369656e7420746f6b656e7320746f207472616e73666572000000000060648201526084016106af565b509396919550909350505050565b816001600160a01b03167f6352c5382c4a4578e712449ca65e83cdb392d045dfcf1cad9615189db2da244b826040516127e691815260200190565b60405180910390a2610a9b8282612bcd565b6128023082612bcd565b61281130836040015183610de5565b60008260000151836020015130848660800151604051602401612838959493929190613d53565b60408051601f198184030181529181526020820180516001600160e01b031663329d940d60e01b17905260a08501519085015191925060009161287c919084612c8e565b9050600061288e308660400151610d2d565b90506128a03086604001516000610de5565b81156128f25784604001516001600160a01b03167f104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff4856040516128e591815260200190565b60405180910390a261293a565b84604001516001600160a01b03167fb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb08560405161293191815260200190565b60405180910390a25b801561294f5761294f308660c00151836110a3565b5050505050565b60006129ab826040518060400160405280602081526020017f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c6564815250856001600160a01b0316612c9d9092919063ffffffff16565b80519091501561257b57808060200190518101906129c99190613d8c565b61257b5760405162461bcd60e51b815260206004820152602a60248201527f5361666545524332303a204552433230206f7065726174696f6e20646964206e6044820152691bdd081cdd58d8d9595960b21b60648201526084016106af565b6000612a3533308461102f565b612a403330846110a3565b5090565b6040516370a0823160e01b815230600482015260009081906001600160a01b038516906370a0823190602401602060405180830381865afa158015612a8d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612ab1919061349f565b9050612ac86001600160a01b038516333086612cac565b6040516370a0823160e01b81523060048201526000906001600160a01b038616906370a0823190602401602060405180830381865afa158015612b0f573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612b33919061349f565b9050818111612b995760405162461bcd60e51b815260206004820152602c60248201527f5361666545524332305472616e7366657246726f6d3a2062616c616e6365206e60448201526b1bdd081a5b98dc99585cd95960a21b60648201526084016106af565b612ba38282613dae565b95945050505050565b610ab03082612ce4565b6000612bc58484846000612e18565b949350505050565b6001600160a01b038216612c235760405162461bcd60e51b815260206004820152601f60248201527f45524332303a206d696e7420746f20746865207a65726f20616464726573730060448201526064016106af565b8060086000828254612c35919061348c565b90915550506001600160a01b0382166000818152600660209081526040808320805486019055518481527fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef910160405180910390a35050565b6000612bc58460008585612e40565b6060612bc58484600085612f15565b6040516001600160a01b038085166024830152831660448201526064810182905261084c9085906323b872dd60e01b90608401612544565b6001600160a01b038216612d445760405162461bcd60e51b815260206004820152602160248201527f45524332303a206275726e2066726f6d20746865207a65726f206164647265736044820152607360f81b60648201526084016106af565b6001600160a01b03821660009081526006602052604090205481811015612db85760405162461bcd60e51b815260206004820152602260248201527f45524332303a206275726e20616d6f756e7420657863656564732062616c616e604482015261636560f01b60648201526084016106af565b6001600160a01b03831660008181526006602090815260408083208686039055600880548790039055518581529192917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef910160405180910390a3505050565b600081151584151503612e3657612e2f8584613992565b9050612bc5565b612ba38584613dc1565b6000845a1015612e925760405162461bcd60e51b815260206004820152601b60248201527f43616c6c5574696c733a20696e73756666696369656e7420676173000000000060448201526064016106af565b83471015612ee25760405162461bcd60e51b815260206004820152601d60248201527f43616c6c5574696c733a20696e73756666696369656e742076616c756500000060448201526064016106af565b826001600160a01b03163b600003612efc57506000612bc5565b600080600084516020860188888bf19695505050505050565b606082471015612f765760405162461bcd60e51b815260206004820152602660248201527f416464726573733a20696e73756666696369656e742062616c616e636520666f6044820152651c8818d85b1b60d21b60648201526084016106af565b600080866001600160a01b03168587604051612f929190613de3565b60006040518083038185875af1925050503d8060008114612fcf576040519150601f19603f3d011682016040523d82523d6000602084013e612fd4565b606091505b5091509150612fe587838387612ff0565b979650505050505050565b6060831561305f578251600003613058576001600160a01b0385163b6130585760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e747261637400000060448201526064016106af565b5081612bc5565b612bc583838151156130745781518083602001fd5b8060405162461bcd60e51b81526004016106af91906130de565b60005b838110156130a9578181015183820152602001613091565b50506000910152565b600081518084526130ca81602086016020860161308e565b601f01601f19169290920160200192915050565b60208152600061102860208301846130b2565b6001600160a01b0381168114610ab057600080fd5b8035612491816130f1565b6000806040838503121561312457600080fd5b823561312f816130f1565b946020939093013593505050565b60006040828403121561314f57600080fd5b50919050565b60008060006060848603121561316a57600080fd5b8335613175816130f1565b92506020840135613185816130f1565b929592945050506040919091013590565b6000602082840312156131a857600080fd5b8135611028816130f1565b6000808284036101208112156131c857600080fd5b610100808212156131d857600080fd5b9395938601359450505050565b6000602082840312156131f757600080fd5b5035919050565b6000806040838503121561321157600080fd5b823567ffffffffffffffff81111561322857600080fd5b8301610160818603121561312f57600080fd5b6000806000806060858703121561325157600080fd5b843593506020850135613263816130f1565b9250604085013567ffffffffffffffff8082111561328057600080fd5b818701915087601f83011261329457600080fd5b8135818111156132a357600080fd5b8860208285010111156132b557600080fd5b95989497505060200194505050565b600080604083850312156132d757600080fd5b82356132e2816130f1565b915060208301356132f2816130f1565b809150509250929050565b600181811c9082168061331157607f821691505b60208210810361314f57634e487b7160e01b600052602260045260246000fd5b634e487b7160e01b600052602160045260246000fd5b634e487b7160e01b600052604160045260246000fd5b6040805190810167ffffffffffffffff8111828210171561338057613380613347565b60405290565b60405160e0810167ffffffffffffffff8111828210171561338057613380613347565b6000604082840312156133bb57600080fd5b6133c361335d565b82356133ce816130f1565b81526020928301359281019290925250919050565b60208152600082516005811061340957634e487b7160e01b600052602160045260246000fd5b806020840152506020830151604080840152612bc560608401826130b2565b6020808252602e908201527f54656c65706f727465725570677261646561626c653a207a65726f2054656c6560408201526d706f72746572206164647265737360901b606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8082018082111561063e5761063e613476565b6000602082840312156134b157600080fd5b5051919050565b6020808252825182820152828101516001600160a01b039081166040808501919091528401518051821660608501528083015160808501526000929161010085019190606087015160a0870152608087015160e060c0880152805193849052840192600092506101208701905b8084101561354757845183168252938501936001939093019290850190613525565b5060a0880151878203601f190160e0890152945061356581866130b2565b98975050505050505050565b60208082526024908201527f53656e645265656e7472616e637947756172643a2073656e64207265656e7472604082015263616e637960e01b606082015260800190565b6020808252603390820152600080516020613e00833981519152604082015272081c995c5d5a5c99590819d85cc81b1a5b5a5d606a1b606082015260800190565b6020808252603e90820152600080516020613e2083398151915260408201527f6c69642064657374696e6174696f6e2062726964676520616464726573730000606082015260800190565b60208082526032908201527f54656c65706f72746572546f6b656e44657374696e6174696f6e3a206e6f6e2d6040820152717a65726f207365636f6e646172792066656560701b606082015260800190565b60208082526037908201527f54656c65706f72746572546f6b656e44657374696e6174696f6e3a206e6f6e2d60408201527f7a65726f206d756c74692d686f702066616c6c6261636b000000000000000000606082015260800190565b81516001600160a01b03168152602080830151908201526040810161063e565b6020808252603390820152600080516020613e00833981519152604082015272206d756c74692d686f702066616c6c6261636b60681b606082015260800190565b8235815261012081016020840135613768816130f1565b6001600160a01b039081166020840152604085013590613787826130f1565b16604083015261379960608501613106565b6001600160a01b0381166060840152506080840135608083015260a084013560a083015260c084013560c08301526137d360e08501613106565b6001600160a01b031660e083015261010090910191909152919050565b6000808335601e1984360301811261380757600080fd5b83018035915067ffffffffffffffff82111561382257600080fd5b60200191503681900382131561383757600080fd5b9250929050565b60208152815160208201526000602083015160018060a01b038082166040850152806040860151166060850152606085015160808501526080850151915060e060a08501526138916101008501836130b2565b915060a085015160c08501528060c08601511660e0850152508091505092915050565b602081526138ce6020820183516001600160a01b03169052565b60208201516040820152600060408301516138f460608401826001600160a01b03169052565b5060608301516001600160a01b038116608084015250608083015160a083015260a08301516101608060c08501526139306101808501836130b2565b915060c085015160e085015260e0850151610100613958818701836001600160a01b03169052565b860151610120868101919091528601519050610140613981818701836001600160a01b03169052565b959095015193019290925250919050565b808202811582820484141761063e5761063e613476565b6000808335601e198436030181126139c057600080fd5b830160208101925035905067ffffffffffffffff8111156139e057600080fd5b80360382131561383757600080fd5b81835281816020850137506000828201602090810191909152601f909101601f19169091010190565b60408152823560408201526000613a3160208501613106565b6001600160a01b03166060830152613a4b60408501613106565b6001600160a01b03166080830152613a6660608501856139a9565b6101608060a0860152613a7e6101a0860183856139ef565b9250608087013560c086015260a087013560e0860152613aa060c08801613106565b9150610100613ab9818701846001600160a01b03169052565b613ac560e08901613106565b9250610120613ade818801856001600160a01b03169052565b613ae9828a01613106565b93506101409150613b04828801856001600160a01b03169052565b880135918601919091529095013561018084015260209092019290925292915050565b600082601f830112613b3857600080fd5b815167ffffffffffffffff80821115613b5357613b53613347565b604051601f8301601f19908116603f01168101908282118183101715613b7b57613b7b613347565b81604052838152866020858801011115613b9457600080fd5b613ba584602083016020890161308e565b9695505050505050565b600060208284031215613bc157600080fd5b815167ffffffffffffffff80821115613bd957600080fd5b9083019060408286031215613bed57600080fd5b613bf561335d565b825160058110613c0457600080fd5b8152602083015182811115613c1857600080fd5b613c2487828601613b27565b60208301525095945050505050565b8051612491816130f1565b600060408284031215613c5057600080fd5b613c5861335d565b8251613c63816130f1565b81526020928301519281019290925250919050565b600060208284031215613c8a57600080fd5b815167ffffffffffffffff80821115613ca257600080fd5b9083019060e08286031215613cb657600080fd5b613cbe613386565b82518152613cce60208401613c33565b6020820152613cdf60408401613c33565b604082015260608301516060820152608083015182811115613d0057600080fd5b613d0c87828601613b27565b60808301525060a083015160a0820152613d2860c08401613c33565b60c082015295945050505050565b600060208284031215613d4857600080fd5b8151611028816130f1565b8581526001600160a01b038581166020830152841660408201526060810183905260a060808201819052600090612fe5908301846130b2565b600060208284031215613d9e57600080fd5b8151801515811461102857600080fd5b8181038181111561063e5761063e613476565b600082613dde57634e487b7160e01b600052601260045260246000fd5b500490565b60008251613df581846020870161308e565b919091019291505056fe54656c65706f72746572546f6b656e44657374696e6174696f6e3a207a65726f54656c65706f72746572546f6b656e44657374696e6174696f6e3a20696e7661a2646970667358221220181c2626530607371736c1b6a095795fba98895a72bf88c0bbf5d2c4202f371b64736f6c6343000812003354656c65706f72746572546f6b656e44657374696e6174696f6e3a207a65726f",
 }
 
@@ -421,6 +421,37 @@ func (_ERC20Destination *ERC20DestinationCallerSession) BlockchainID() ([32]byte
 	return _ERC20Destination.Contract.BlockchainID(&_ERC20Destination.CallOpts)
 }
 
+// BlockedRecipients is a free data retrieval call binding the contract method 0x205b10a0.
+//
+// Solidity: function blockedRecipients(address recipient) view returns(bool blocked)
+func (_ERC20Destination *ERC20DestinationCaller) BlockedRecipients(opts *bind.CallOpts, recipient common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC20Destination.contract.Call(opts, &out, "blockedRecipients", recipient)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// BlockedRecipients is a free data retrieval call binding the contract method 0x205b10a0.
+//
+// Solidity: function blockedRecipients(address recipient) view returns(bool blocked)
+func (_ERC20Destination *ERC20DestinationSession) BlockedRecipients(recipient common.Address) (bool, error) {
+	return _ERC20Destination.Contract.BlockedRecipients(&_ERC20Destination.CallOpts, recipient)
+}
+
+// BlockedRecipients is a free data retrieval call binding the contract method 0x205b10a0.
+//
+// Solidity: function blockedRecipients(address recipient) view returns(bool blocked)
+func (_ERC20Destination *ERC20DestinationCallerSession) BlockedRecipients(recipient common.Address) (bool, error) {
+	return _ERC20Destination.Contract.BlockedRecipients(&_ERC20Destination.CallOpts, recipient)
+}
+
 // CalculateNumWords is a free data retrieval call binding the contract method 0xf3f981d8.
 //
 // Solidity: function calculateNumWords(uint256 payloadSize) pure returns(uint256)
@@ -483,6 +514,37 @@ func (_ERC20Destination *ERC20DestinationCallerSession) Decimals() (uint8, error
 	return _ERC20Destination.Contract.Decimals(&_ERC20Destination.CallOpts)
 }
 
+// EscrowedBalances is a free data retrieval call binding the contract method 0xd6074aba.
+//
+// Solidity: function escrowedBalances(address recipient) view returns(uint256 amount)
+func (_ERC20Destination *ERC20DestinationCaller) EscrowedBalances(opts *bind.CallOpts, recipient common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Destination.contract.Call(opts, &out, "escrowedBalances", recipient)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EscrowedBalances is a free data retrieval call binding the contract method 0xd6074aba.
+//
+// Solidity: function escrowedBalances(address recipient) view returns(uint256 amount)
+func (_ERC20Destination *ERC20DestinationSession) EscrowedBalances(recipient common.Address) (*big.Int, error) {
+	return _ERC20Destination.Contract.EscrowedBalances(&_ERC20Destination.CallOpts, recipient)
+}
+
+// EscrowedBalances is a free data retrieval call binding the contract method 0xd6074aba.
+//
+// Solidity: function escrowedBalances(address recipient) view returns(uint256 amount)
+func (_ERC20Destination *ERC20DestinationCallerSession) EscrowedBalances(recipient common.Address) (*big.Int, error) {
+	return _ERC20Destination.Contract.EscrowedBalances(&_ERC20Destination.CallOpts, recipient)
+}
+
 // GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
 //
 // Solidity: function getMinTeleporterVersion() view returns(uint256)
@@ -731,6 +793,37 @@ func (_ERC20Destination *ERC20DestinationCallerSession) Owner() (common.Address,
 	return _ERC20Destination.Contract.Owner(&_ERC20Destination.CallOpts)
 }
 
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC20Destination *ERC20DestinationCaller) SendsPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ERC20Destination.contract.Call(opts, &out, "sendsPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC20Destination *ERC20DestinationSession) SendsPaused() (bool, error) {
+	return _ERC20Destination.Contract.SendsPaused(&_ERC20Destination.CallOpts)
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC20Destination *ERC20DestinationCallerSession) SendsPaused() (bool, error) {
+	return _ERC20Destination.Contract.SendsPaused(&_ERC20Destination.CallOpts)
+}
+
 // SourceBlockchainID is a free data retrieval call binding the contract method 0x29b7b3fd.
 //
 // Solidity: function sourceBlockchainID() view returns(bytes32)
@@ -938,6 +1031,48 @@ func (_ERC20Destination *ERC20DestinationTransactorSession) Approve(spender comm
 	return _ERC20Destination.Contract.Approve(&_ERC20Destination.TransactOpts, spender, amount)
 }
 
+// BlockRecipient is a paid mutator transaction binding the contract method 0x0f2f5e51.
+//
+// Solidity: function blockRecipient(address recipient) returns()
+func (_ERC20Destination *ERC20DestinationTransactor) BlockRecipient(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _ERC20Destination.contract.Transact(opts, "blockRecipient", recipient)
+}
+
+// BlockRecipient is a paid mutator transaction binding the contract method 0x0f2f5e51.
+//
+// Solidity: function blockRecipient(address recipient) returns()
+func (_ERC20Destination *ERC20DestinationSession) BlockRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _ERC20Destination.Contract.BlockRecipient(&_ERC20Destination.TransactOpts, recipient)
+}
+
+// BlockRecipient is a paid mutator transaction binding the contract method 0x0f2f5e51.
+//
+// Solidity: function blockRecipient(address recipient) returns()
+func (_ERC20Destination *ERC20DestinationTransactorSession) BlockRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _ERC20Destination.Contract.BlockRecipient(&_ERC20Destination.TransactOpts, recipient)
+}
+
+// ClaimEscrowedTokens is a paid mutator transaction binding the contract method 0x03018cc6.
+//
+// Solidity: function claimEscrowedTokens() returns()
+func (_ERC20Destination *ERC20DestinationTransactor) ClaimEscrowedTokens(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Destination.contract.Transact(opts, "claimEscrowedTokens")
+}
+
+// ClaimEscrowedTokens is a paid mutator transaction binding the contract method 0x03018cc6.
+//
+// Solidity: function claimEscrowedTokens() returns()
+func (_ERC20Destination *ERC20DestinationSession) ClaimEscrowedTokens() (*types.Transaction, error) {
+	return _ERC20Destination.Contract.ClaimEscrowedTokens(&_ERC20Destination.TransactOpts)
+}
+
+// ClaimEscrowedTokens is a paid mutator transaction binding the contract method 0x03018cc6.
+//
+// Solidity: function claimEscrowedTokens() returns()
+func (_ERC20Destination *ERC20DestinationTransactorSession) ClaimEscrowedTokens() (*types.Transaction, error) {
+	return _ERC20Destination.Contract.ClaimEscrowedTokens(&_ERC20Destination.TransactOpts)
+}
+
 // DecreaseAllowance is a paid mutator transaction binding the contract method 0xa457c2d7.
 //
 // Solidity: function decreaseAllowance(address spender, uint256 subtractedValue) returns(bool)
@@ -980,6 +1115,27 @@ func (_ERC20Destination *ERC20DestinationTransactorSession) IncreaseAllowance(sp
 	return _ERC20Destination.Contract.IncreaseAllowance(&_ERC20Destination.TransactOpts, spender, addedValue)
 }
 
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC20Destination *ERC20DestinationTransactor) PauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Destination.contract.Transact(opts, "pauseSends")
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC20Destination *ERC20DestinationSession) PauseSends() (*types.Transaction, error) {
+	return _ERC20Destination.Contract.PauseSends(&_ERC20Destination.TransactOpts)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC20Destination *ERC20DestinationTransactorSession) PauseSends() (*types.Transaction, error) {
+	return _ERC20Destination.Contract.PauseSends(&_ERC20Destination.TransactOpts)
+}
+
 // PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
 //
 // Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
@@ -1169,6 +1325,48 @@ func (_ERC20Destination *ERC20DestinationTransactorSession) TransferOwnership(ne
 	return _ERC20Destination.Contract.TransferOwnership(&_ERC20Destination.TransactOpts, newOwner)
 }
 
+// UnblockRecipient is a paid mutator transaction binding the contract method 0x3c242106.
+//
+// Solidity: function unblockRecipient(address recipient) returns()
+func (_ERC20Destination *ERC20DestinationTransactor) UnblockRecipient(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _ERC20Destination.contract.Transact(opts, "unblockRecipient", recipient)
+}
+
+// UnblockRecipient is a paid mutator transaction binding the contract method 0x3c242106.
+//
+// Solidity: function unblockRecipient(address recipient) returns()
+func (_ERC20Destination *ERC20DestinationSession) UnblockRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _ERC20Destination.Contract.UnblockRecipient(&_ERC20Destination.TransactOpts, recipient)
+}
+
+// UnblockRecipient is a paid mutator transaction binding the contract method 0x3c242106.
+//
+// Solidity: function unblockRecipient(address recipient) returns()
+func (_ERC20Destination *ERC20DestinationTransactorSession) UnblockRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _ERC20Destination.Contract.UnblockRecipient(&_ERC20Destination.TransactOpts, recipient)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC20Destination *ERC20DestinationTransactor) UnpauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Destination.contract.Transact(opts, "unpauseSends")
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC20Destination *ERC20DestinationSession) UnpauseSends() (*types.Transaction, error) {
+	return _ERC20Destination.Contract.UnpauseSends(&_ERC20Destination.TransactOpts)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC20Destination *ERC20DestinationTransactorSession) UnpauseSends() (*types.Transaction, error) {
+	return _ERC20Destination.Contract.UnpauseSends(&_ERC20Destination.TransactOpts)
+}
+
 // UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
 //
 // Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
@@ -1655,6 +1853,151 @@ func (_ERC20Destination *ERC20DestinationFilterer) ParseCallSucceeded(log types.
 	return event, nil
 }
 
+// ERC20DestinationEscrowClaimedIterator is returned from FilterEscrowClaimed and is used to iterate over the raw logs and unpacked data for EscrowClaimed events raised by the ERC20Destination contract.
+type ERC20DestinationEscrowClaimedIterator struct {
+	Event *ERC20DestinationEscrowClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20DestinationEscrowClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20DestinationEscrowClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20DestinationEscrowClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20DestinationEscrowClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20DestinationEscrowClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20DestinationEscrowClaimed represents a EscrowClaimed event raised by the ERC20Destination contract.
+type ERC20DestinationEscrowClaimed struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterEscrowClaimed is a free log retrieval operation binding the contract event 0xcde81b0bf99f48ced5f97323017cb811ae69eb0acc58d1c9ae771f6768bee8c1.
+//
+// Solidity: event EscrowClaimed(address indexed recipient, uint256 amount)
+func (_ERC20Destination *ERC20DestinationFilterer) FilterEscrowClaimed(opts *bind.FilterOpts, recipient []common.Address) (*ERC20DestinationEscrowClaimedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.FilterLogs(opts, "EscrowClaimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20DestinationEscrowClaimedIterator{contract: _ERC20Destination.contract, event: "EscrowClaimed", logs: logs, sub: sub}, nil
+}
+
+// WatchEscrowClaimed is a free log subscription operation binding the contract event 0xcde81b0bf99f48ced5f97323017cb811ae69eb0acc58d1c9ae771f6768bee8c1.
+//
+// Solidity: event EscrowClaimed(address indexed recipient, uint256 amount)
+func (_ERC20Destination *ERC20DestinationFilterer) WatchEscrowClaimed(opts *bind.WatchOpts, sink chan<- *ERC20DestinationEscrowClaimed, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.WatchLogs(opts, "EscrowClaimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20DestinationEscrowClaimed)
+				if err := _ERC20Destination.contract.UnpackLog(event, "EscrowClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEscrowClaimed is a log parse operation binding the contract event 0xcde81b0bf99f48ced5f97323017cb811ae69eb0acc58d1c9ae771f6768bee8c1.
+//
+// Solidity: event EscrowClaimed(address indexed recipient, uint256 amount)
+func (_ERC20Destination *ERC20DestinationFilterer) ParseEscrowClaimed(log types.Log) (*ERC20DestinationEscrowClaimed, error) {
+	event := new(ERC20DestinationEscrowClaimed)
+	if err := _ERC20Destination.contract.UnpackLog(event, "EscrowClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ERC20DestinationMinTeleporterVersionUpdatedIterator is returned from FilterMinTeleporterVersionUpdated and is used to iterate over the raw logs and unpacked data for MinTeleporterVersionUpdated events raised by the ERC20Destination contract.
 type ERC20DestinationMinTeleporterVersionUpdatedIterator struct {
 	Event *ERC20DestinationMinTeleporterVersionUpdated // Event containing the contract specifics and raw log
@@ -1961,9 +2304,9 @@ func (_ERC20Destination *ERC20DestinationFilterer) ParseOwnershipTransferred(log
 	return event, nil
 }
 
-// ERC20DestinationTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the ERC20Destination contract.
-type ERC20DestinationTeleporterAddressPausedIterator struct {
-	Event *ERC20DestinationTeleporterAddressPaused // Event containing the contract specifics and raw log
+// ERC20DestinationRecipientBlockedIterator is returned from FilterRecipientBlocked and is used to iterate over the raw logs and unpacked data for RecipientBlocked events raised by the ERC20Destination contract.
+type ERC20DestinationRecipientBlockedIterator struct {
+	Event *ERC20DestinationRecipientBlocked // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1977,7 +2320,7 @@ type ERC20DestinationTeleporterAddressPausedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *ERC20DestinationTeleporterAddressPausedIterator) Next() bool {
+func (it *ERC20DestinationRecipientBlockedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1986,7 +2329,7 @@ func (it *ERC20DestinationTeleporterAddressPausedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(ERC20DestinationTeleporterAddressPaused)
+			it.Event = new(ERC20DestinationRecipientBlocked)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -2001,7 +2344,7 @@ func (it *ERC20DestinationTeleporterAddressPausedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(ERC20DestinationTeleporterAddressPaused)
+		it.Event = new(ERC20DestinationRecipientBlocked)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -2017,48 +2360,642 @@ func (it *ERC20DestinationTeleporterAddressPausedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *ERC20DestinationTeleporterAddressPausedIterator) Error() error {
+func (it *ERC20DestinationRecipientBlockedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *ERC20DestinationTeleporterAddressPausedIterator) Close() error {
+func (it *ERC20DestinationRecipientBlockedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// ERC20DestinationTeleporterAddressPaused represents a TeleporterAddressPaused event raised by the ERC20Destination contract.
-type ERC20DestinationTeleporterAddressPaused struct {
-	TeleporterAddress common.Address
-	Raw               types.Log // Blockchain specific contextual infos
+// ERC20DestinationRecipientBlocked represents a RecipientBlocked event raised by the ERC20Destination contract.
+type ERC20DestinationRecipientBlocked struct {
+	Recipient common.Address
+	Account   common.Address
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterTeleporterAddressPaused is a free log retrieval operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+// FilterRecipientBlocked is a free log retrieval operation binding the contract event 0xdeb8edc6672dcdbb8c0fd33ce2205670d309907a2d4939e64370e6ccc1403892.
 //
-// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
-func (_ERC20Destination *ERC20DestinationFilterer) FilterTeleporterAddressPaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*ERC20DestinationTeleporterAddressPausedIterator, error) {
+// Solidity: event RecipientBlocked(address indexed recipient, address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) FilterRecipientBlocked(opts *bind.FilterOpts, recipient []common.Address, account []common.Address) (*ERC20DestinationRecipientBlockedIterator, error) {
 
-	var teleporterAddressRule []interface{}
-	for _, teleporterAddressItem := range teleporterAddress {
-		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
 	}
 
-	logs, sub, err := _ERC20Destination.contract.FilterLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	logs, sub, err := _ERC20Destination.contract.FilterLogs(opts, "RecipientBlocked", recipientRule, accountRule)
 	if err != nil {
 		return nil, err
 	}
-	return &ERC20DestinationTeleporterAddressPausedIterator{contract: _ERC20Destination.contract, event: "TeleporterAddressPaused", logs: logs, sub: sub}, nil
+	return &ERC20DestinationRecipientBlockedIterator{contract: _ERC20Destination.contract, event: "RecipientBlocked", logs: logs, sub: sub}, nil
 }
 
-// WatchTeleporterAddressPaused is a free log subscription operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+// WatchRecipientBlocked is a free log subscription operation binding the contract event 0xdeb8edc6672dcdbb8c0fd33ce2205670d309907a2d4939e64370e6ccc1403892.
 //
-// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
-func (_ERC20Destination *ERC20DestinationFilterer) WatchTeleporterAddressPaused(opts *bind.WatchOpts, sink chan<- *ERC20DestinationTeleporterAddressPaused, teleporterAddress []common.Address) (event.Subscription, error) {
+// Solidity: event RecipientBlocked(address indexed recipient, address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) WatchRecipientBlocked(opts *bind.WatchOpts, sink chan<- *ERC20DestinationRecipientBlocked, recipient []common.Address, account []common.Address) (event.Subscription, error) {
 
-	var teleporterAddressRule []interface{}
-	for _, teleporterAddressItem := range teleporterAddress {
-		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.WatchLogs(opts, "RecipientBlocked", recipientRule, accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20DestinationRecipientBlocked)
+				if err := _ERC20Destination.contract.UnpackLog(event, "RecipientBlocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRecipientBlocked is a log parse operation binding the contract event 0xdeb8edc6672dcdbb8c0fd33ce2205670d309907a2d4939e64370e6ccc1403892.
+//
+// Solidity: event RecipientBlocked(address indexed recipient, address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) ParseRecipientBlocked(log types.Log) (*ERC20DestinationRecipientBlocked, error) {
+	event := new(ERC20DestinationRecipientBlocked)
+	if err := _ERC20Destination.contract.UnpackLog(event, "RecipientBlocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20DestinationRecipientUnblockedIterator is returned from FilterRecipientUnblocked and is used to iterate over the raw logs and unpacked data for RecipientUnblocked events raised by the ERC20Destination contract.
+type ERC20DestinationRecipientUnblockedIterator struct {
+	Event *ERC20DestinationRecipientUnblocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20DestinationRecipientUnblockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20DestinationRecipientUnblocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20DestinationRecipientUnblocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20DestinationRecipientUnblockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20DestinationRecipientUnblockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20DestinationRecipientUnblocked represents a RecipientUnblocked event raised by the ERC20Destination contract.
+type ERC20DestinationRecipientUnblocked struct {
+	Recipient common.Address
+	Account   common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRecipientUnblocked is a free log retrieval operation binding the contract event 0x5ba1fef13af3a10d449b576e50b96e823f45b1b3ed112c7ba14dd2f4faee8266.
+//
+// Solidity: event RecipientUnblocked(address indexed recipient, address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) FilterRecipientUnblocked(opts *bind.FilterOpts, recipient []common.Address, account []common.Address) (*ERC20DestinationRecipientUnblockedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.FilterLogs(opts, "RecipientUnblocked", recipientRule, accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20DestinationRecipientUnblockedIterator{contract: _ERC20Destination.contract, event: "RecipientUnblocked", logs: logs, sub: sub}, nil
+}
+
+// WatchRecipientUnblocked is a free log subscription operation binding the contract event 0x5ba1fef13af3a10d449b576e50b96e823f45b1b3ed112c7ba14dd2f4faee8266.
+//
+// Solidity: event RecipientUnblocked(address indexed recipient, address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) WatchRecipientUnblocked(opts *bind.WatchOpts, sink chan<- *ERC20DestinationRecipientUnblocked, recipient []common.Address, account []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.WatchLogs(opts, "RecipientUnblocked", recipientRule, accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20DestinationRecipientUnblocked)
+				if err := _ERC20Destination.contract.UnpackLog(event, "RecipientUnblocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRecipientUnblocked is a log parse operation binding the contract event 0x5ba1fef13af3a10d449b576e50b96e823f45b1b3ed112c7ba14dd2f4faee8266.
+//
+// Solidity: event RecipientUnblocked(address indexed recipient, address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) ParseRecipientUnblocked(log types.Log) (*ERC20DestinationRecipientUnblocked, error) {
+	event := new(ERC20DestinationRecipientUnblocked)
+	if err := _ERC20Destination.contract.UnpackLog(event, "RecipientUnblocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20DestinationSendsPausedIterator is returned from FilterSendsPaused and is used to iterate over the raw logs and unpacked data for SendsPaused events raised by the ERC20Destination contract.
+type ERC20DestinationSendsPausedIterator struct {
+	Event *ERC20DestinationSendsPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20DestinationSendsPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20DestinationSendsPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20DestinationSendsPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20DestinationSendsPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20DestinationSendsPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20DestinationSendsPaused represents a SendsPaused event raised by the ERC20Destination contract.
+type ERC20DestinationSendsPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsPaused is a free log retrieval operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) FilterSendsPaused(opts *bind.FilterOpts, account []common.Address) (*ERC20DestinationSendsPausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.FilterLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20DestinationSendsPausedIterator{contract: _ERC20Destination.contract, event: "SendsPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsPaused is a free log subscription operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) WatchSendsPaused(opts *bind.WatchOpts, sink chan<- *ERC20DestinationSendsPaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.WatchLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20DestinationSendsPaused)
+				if err := _ERC20Destination.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsPaused is a log parse operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) ParseSendsPaused(log types.Log) (*ERC20DestinationSendsPaused, error) {
+	event := new(ERC20DestinationSendsPaused)
+	if err := _ERC20Destination.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20DestinationSendsUnpausedIterator is returned from FilterSendsUnpaused and is used to iterate over the raw logs and unpacked data for SendsUnpaused events raised by the ERC20Destination contract.
+type ERC20DestinationSendsUnpausedIterator struct {
+	Event *ERC20DestinationSendsUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20DestinationSendsUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20DestinationSendsUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20DestinationSendsUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20DestinationSendsUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20DestinationSendsUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20DestinationSendsUnpaused represents a SendsUnpaused event raised by the ERC20Destination contract.
+type ERC20DestinationSendsUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsUnpaused is a free log retrieval operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) FilterSendsUnpaused(opts *bind.FilterOpts, account []common.Address) (*ERC20DestinationSendsUnpausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.FilterLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20DestinationSendsUnpausedIterator{contract: _ERC20Destination.contract, event: "SendsUnpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsUnpaused is a free log subscription operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) WatchSendsUnpaused(opts *bind.WatchOpts, sink chan<- *ERC20DestinationSendsUnpaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.WatchLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20DestinationSendsUnpaused)
+				if err := _ERC20Destination.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsUnpaused is a log parse operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC20Destination *ERC20DestinationFilterer) ParseSendsUnpaused(log types.Log) (*ERC20DestinationSendsUnpaused, error) {
+	event := new(ERC20DestinationSendsUnpaused)
+	if err := _ERC20Destination.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20DestinationTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the ERC20Destination contract.
+type ERC20DestinationTeleporterAddressPausedIterator struct {
+	Event *ERC20DestinationTeleporterAddressPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20DestinationTeleporterAddressPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20DestinationTeleporterAddressPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20DestinationTeleporterAddressPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20DestinationTeleporterAddressPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20DestinationTeleporterAddressPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20DestinationTeleporterAddressPaused represents a TeleporterAddressPaused event raised by the ERC20Destination contract.
+type ERC20DestinationTeleporterAddressPaused struct {
+	TeleporterAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterTeleporterAddressPaused is a free log retrieval operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_ERC20Destination *ERC20DestinationFilterer) FilterTeleporterAddressPaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*ERC20DestinationTeleporterAddressPausedIterator, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.FilterLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20DestinationTeleporterAddressPausedIterator{contract: _ERC20Destination.contract, event: "TeleporterAddressPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchTeleporterAddressPaused is a free log subscription operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_ERC20Destination *ERC20DestinationFilterer) WatchTeleporterAddressPaused(opts *bind.WatchOpts, sink chan<- *ERC20DestinationTeleporterAddressPaused, teleporterAddress []common.Address) (event.Subscription, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
 	}
 
 	logs, sub, err := _ERC20Destination.contract.WatchLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
@@ -2404,6 +3341,151 @@ func (_ERC20Destination *ERC20DestinationFilterer) ParseTokensAndCallSent(log ty
 	return event, nil
 }
 
+// ERC20DestinationTokensEscrowedIterator is returned from FilterTokensEscrowed and is used to iterate over the raw logs and unpacked data for TokensEscrowed events raised by the ERC20Destination contract.
+type ERC20DestinationTokensEscrowedIterator struct {
+	Event *ERC20DestinationTokensEscrowed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20DestinationTokensEscrowedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20DestinationTokensEscrowed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20DestinationTokensEscrowed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20DestinationTokensEscrowedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20DestinationTokensEscrowedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20DestinationTokensEscrowed represents a TokensEscrowed event raised by the ERC20Destination contract.
+type ERC20DestinationTokensEscrowed struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensEscrowed is a free log retrieval operation binding the contract event 0xd59334be1cb8a5ea0e1e4479039a4b41e2e6d0d42c71c924087f13c67fa1a339.
+//
+// Solidity: event TokensEscrowed(address indexed recipient, uint256 amount)
+func (_ERC20Destination *ERC20DestinationFilterer) FilterTokensEscrowed(opts *bind.FilterOpts, recipient []common.Address) (*ERC20DestinationTokensEscrowedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.FilterLogs(opts, "TokensEscrowed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20DestinationTokensEscrowedIterator{contract: _ERC20Destination.contract, event: "TokensEscrowed", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensEscrowed is a free log subscription operation binding the contract event 0xd59334be1cb8a5ea0e1e4479039a4b41e2e6d0d42c71c924087f13c67fa1a339.
+//
+// Solidity: event TokensEscrowed(address indexed recipient, uint256 amount)
+func (_ERC20Destination *ERC20DestinationFilterer) WatchTokensEscrowed(opts *bind.WatchOpts, sink chan<- *ERC20DestinationTokensEscrowed, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ERC20Destination.contract.WatchLogs(opts, "TokensEscrowed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20DestinationTokensEscrowed)
+				if err := _ERC20Destination.contract.UnpackLog(event, "TokensEscrowed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensEscrowed is a log parse operation binding the contract event 0xd59334be1cb8a5ea0e1e4479039a4b41e2e6d0d42c71c924087f13c67fa1a339.
+//
+// Solidity: event TokensEscrowed(address indexed recipient, uint256 amount)
+func (_ERC20Destination *ERC20DestinationFilterer) ParseTokensEscrowed(log types.Log) (*ERC20DestinationTokensEscrowed, error) {
+	event := new(ERC20DestinationTokensEscrowed)
+	if err := _ERC20Destination.contract.UnpackLog(event, "TokensEscrowed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ERC20DestinationTokensSentIterator is returned from FilterTokensSent and is used to iterate over the raw logs and unpacked data for TokensSent events raised by the ERC20Destination contract.
 type ERC20DestinationTokensSentIterator struct {
 	Event *ERC20DestinationTokensSent // Event containing the contract specifics and raw log
@@ -58,7 +58,7 @@ type SendTokensInput struct {
 
 // ERC20SourceMetaData contains all meta data concerning the ERC20Source contract.
 var ERC20SourceMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterRegistryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"teleporterManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenAddress\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"remaining\",\"type\":\"uint256\"}],\"name\":\"CollateralAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"initialCollateralNeeded\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenMultiplier\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"multiplyOnDestination\",\"type\":\"bool\"}],\"name\":\"DestinationRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MAX_TOKEN_MULTIPLIER\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"addCollateral\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"bridgedBalances\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"registeredDestinations\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"registered\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"collateralNeeded\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"tokenMultiplier\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"multiplyOnDestination\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"sendAndCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"token\",\"outputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterRegistryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"teleporterManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenAddress\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"remaining\",\"type\":\"uint256\"}],\"name\":\"CollateralAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"initialCollateralNeeded\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenMultiplier\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"multiplyOnDestination\",\"type\":\"bool\"}],\"name\":\"DestinationRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"window\",\"type\":\"uint256\"}],\"name\":\"RateLimitUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MAX_TOKEN_MULTIPLIER\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"addCollateral\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"bridgedBalances\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"rateLimitRemaining\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"rateLimits\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"window\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"windowStart\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amountSent\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"registeredDestinations\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"registered\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"collateralNeeded\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"tokenMultiplier\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"multiplyOnDestination\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"sendAndCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sendsPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"window\",\"type\":\"uint256\"}],\"name\":\"setRateLimit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"token\",\"outputs\":[{\"internalType\":\"contractIERC20\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 	Bin: "0x6101006040523480156200001257600080fd5b50604051620041b5380380620041b58339810160408190526200003591620003a9565b60016000558282828282816001600160a01b038116620000c25760405162461bcd60e51b815260206004820152603760248201527f54656c65706f727465725570677261646561626c653a207a65726f2074656c6560448201527f706f72746572207265676973747279206164647265737300000000000000000060648201526084015b60405180910390fd5b6001600160a01b03811660808190526040805163301fd1f560e21b8152905163c07f47d4916004808201926020929091908290030181865afa1580156200010d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620001339190620003f3565b6002555062000142336200025d565b6200014d81620002af565b505060016004819055507302000000000000000000000000000000000000056001600160a01b0316634213cf786040518163ffffffff1660e01b8152600401602060405180830381865afa158015620001aa573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620001d09190620003f3565b60a0526001600160a01b0381166200023d5760405162461bcd60e51b815260206004820152602960248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20746f6b656044820152686e206164647265737360b81b6064820152608401620000b9565b6001600160a01b0390811660c0529290921660e052506200040d92505050565b600380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b620002b96200032e565b6001600160a01b038116620003205760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401620000b9565b6200032b816200025d565b50565b6003546001600160a01b031633146200038a5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401620000b9565b565b80516001600160a01b0381168114620003a457600080fd5b919050565b600080600060608486031215620003bf57600080fd5b620003ca846200038c565b9250620003da602085016200038c565b9150620003ea604085016200038c565b90509250925092565b6000602082840312156200040657600080fd5b5051919050565b60805160a05160c05160e051613d0c620004a9600039600081816102e8015281816119fc01528181611dc701528181611dff01528181611eac01528181611f2001528181611ffe015261243501526000818161026c0152818161148c01526115d10152600081816102a6015281816105bf01526121230152600081816101770152818161061901528181610b9901526126360152613d0c6000f3fe608060405234801561001057600080fd5b50600436106101205760003560e01c80638da5cb5b116100ad578063d2cc7a7011610071578063d2cc7a70146102c8578063f2fde38b146102d0578063fc0c546a146102e3578063fcfb5ff91461030a578063fd6582681461037357600080fd5b80638da5cb5b1461021a578063973142971461022b5780639d76ea5814610267578063c868efaa1461028e578063d127dc9b146102a157600080fd5b80634511243e116100f45780634511243e146101c65780635d16225d146101d95780635eb99514146101ec57806365690038146101ff578063715018a61461021257600080fd5b80628ad7771461012557806302ee3e9c146101475780631a7f5bec146101725780632b0d8f18146101b1575b600080fd5b610134670de0b6b3a764000081565b6040519081526020015b60405180910390f35b610134610155366004612e3f565b600660209081526000928352604080842090915290825290205481565b6101997f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b03909116815260200161013e565b6101c46101bf366004612e6f565b610386565b005b6101c46101d4366004612e6f565b61048b565b6101c46101e7366004612e8c565b610588565b6101c46101fa366004612ebe565b6105a6565b6101c461020d366004612ed7565b6105ba565b6101c46105f0565b6003546001600160a01b0316610199565b610257610239366004612e6f565b6001600160a01b031660009081526001602052604090205460ff1690565b604051901515815260200161013e565b6101997f000000000000000000000000000000000000000000000000000000000000000081565b6101c461029c366004612f21565b610604565b6101347f000000000000000000000000000000000000000000000000000000000000000081565b600254610134565b6101c46102de366004612e6f565b6107ce565b6101997f000000000000000000000000000000000000000000000000000000000000000081565b61034f610318366004612e3f565b6005602090815260009283526040808420909152908252902080546001820154600283015460039093015460ff9283169391921684565b6040805194151585526020850193909352918301521515606082015260800161013e565b6101c4610381366004612fa9565b610844565b61038e610854565b6001600160a01b0381166103bd5760405162461bcd60e51b81526004016103b490612fe1565b60405180910390fd5b6001600160a01b03811660009081526001602052604090205460ff161561043c5760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f727465725570677261646561626c653a2061646472657373206160448201526c1b1c9958591e481c185d5cd959609a1b60648201526084016103b4565b6001600160a01b0381166000818152600160208190526040808320805460ff1916909217909155517f933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c9190a250565b610493610854565b6001600160a01b0381166104b95760405162461bcd60e51b81526004016103b490612fe1565b6001600160a01b03811660009081526001602052604090205460ff166105335760405162461bcd60e51b815260206004820152602960248201527f54656c65706f727465725570677261646561626c653a2061646472657373206e6044820152681bdd081c185d5cd95960ba1b60648201526084016103b4565b6040516001600160a01b038216907f844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c390600090a26001600160a01b03166000908152600160205260409020805460ff19169055565b6105a261059a368490038401846130e2565b82600061085c565b5050565b6105ae610854565b6105b781610b95565b50565b6105a27f0000000000000000000000000000000000000000000000000000000000000000336105e88561320c565b846000610d35565b6105f86111bb565b6106026000611215565b565b61060c611267565b6002546001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016634c1f08ce336040516001600160e01b031960e084901b1681526001600160a01b039091166004820152602401602060405180830381865afa158015610683573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906106a791906132dd565b101561070e5760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a20696e76616c6964205460448201526f32b632b837b93a32b91039b2b73232b960811b60648201526084016103b4565b61071733610239565b1561077d5760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a2054656c65706f72746560448201526f1c881859191c995cdcc81c185d5cd95960821b60648201526084016103b4565b6107be848484848080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506112c092505050565b6107c86001600055565b50505050565b6107d66111bb565b6001600160a01b03811661083b5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016103b4565b6105b781611215565b61084f838383611667565b505050565b6106026111bb565b60016004541461087e5760405162461bcd60e51b81526004016103b4906132f6565b600260045560408301516001600160a01b03166108f35760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f207265636960448201526c7069656e74206164647265737360981b60648201526084016103b4565b60008360c00151116109175760405162461bcd60e51b81526004016103b49061333a565b60a0830151156109395760405162461bcd60e51b81526004016103b490613388565b608083015160009082156109835761095f85600001518660200151868860800151611840565b91508160000361097e576109778560e00151856119ac565b5050610b8b565b6109d1565b60e08501516001600160a01b0316156109ae5760405162461bcd60e51b81526004016103b4906133d5565b6109cb856000015186602001518688606001518960800151611a23565b90925090505b604080518082019091526000908060018152602001604051806040016040528089604001516001600160a01b0316815260200186815250604051602001610a18919061343d565b60405160208183030381529060405281525090506000610afa6040518060c001604052808960000151815260200189602001516001600160a01b0316815260200160405180604001604052808b606001516001600160a01b031681526020018781525081526020018960c00151815260200160006001600160401b03811115610aa357610aa361302f565b604051908082528060200260200182016040528015610acc578160200160208202803683370190505b50815260200184604051602001610ae391906134ad565b604051602081830303815290604052815250611c2c565b90508415610b4157807f825080857c76cef4a1629c0705a7f8b4ef0282ddcafde0b6715c4fb34b68aaf08886604051610b349291906134f2565b60405180910390a2610b86565b336001600160a01b0316817f93f19bf1ec58a15dc643b37e7e18a1c13e85e06cd11929e283154691ace9fb528987604051610b7d9291906134f2565b60405180910390a35b505050505b5050600160045550565b60007f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031663c07f47d46040518163ffffffff1660e01b8152600401602060405180830381865afa158015610bf5573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610c1991906132dd565b60025490915081831115610c895760405162461bcd60e51b815260206004820152603160248201527f54656c65706f727465725570677261646561626c653a20696e76616c6964205460448201527032b632b837b93a32b9103b32b939b4b7b760791b60648201526084016103b4565b808311610cfe5760405162461bcd60e51b815260206004820152603f60248201527f54656c65706f727465725570677261646561626c653a206e6f7420677265617460448201527f6572207468616e2063757272656e74206d696e696d756d2076657273696f6e0060648201526084016103b4565b6002839055604051839082907fa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d90600090a3505050565b600160045414610d575760405162461bcd60e51b81526004016103b4906132f6565b600260045560408301516001600160a01b0316610dd55760405162461bcd60e51b815260206004820152603660248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20726563696044820152757069656e7420636f6e7472616374206164647265737360501b60648201526084016103b4565b6000836080015111610df95760405162461bcd60e51b81526004016103b49061333a565b60008360a0015111610e655760405162461bcd60e51b815260206004820152602f60248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f207265636960448201526e1c1a595b9d0819d85cc81b1a5b5a5d608a1b60648201526084016103b4565b82608001518360a0015110610ed75760405162461bcd60e51b815260206004820152603260248201527f54656c65706f72746572546f6b656e536f757263653a20696e76616c696420726044820152711958da5c1a595b9d0819d85cc81b1a5b5a5d60721b60648201526084016103b4565b60e08301516001600160a01b0316610f505760405162461bcd60e51b815260206004820152603660248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f2066616c6c6044820152756261636b20726563697069656e74206164647265737360501b60648201526084016103b4565b61014083015115610f735760405162461bcd60e51b81526004016103b490613388565b6101208301516000908215610fbf57610f9b8560000151866020015186886101200151611840565b915081600003610fba57610fb38560c00151856119ac565b50506111af565b61100f565b60c08501516001600160a01b031615610fea5760405162461bcd60e51b81526004016103b4906133d5565b6110098560000151866020015186886101000151896101200151611a23565b90925090505b6040805180820190915260009080600281526020016040518060e001604052808b81526020018a6001600160a01b0316815260200189604001516001600160a01b03168152602001868152602001896060015181526020018960a0015181526020018960e001516001600160a01b03168152506040516020016110929190613574565b6040516020818303038152906040528152509050600061111e6040518060c001604052808960000151815260200189602001516001600160a01b0316815260200160405180604001604052808b61010001516001600160a01b031681526020018781525081526020018960800151815260200160006001600160401b03811115610aa357610aa361302f565b9050841561116557807f42eff9005856e3c586b096d67211a566dc926052119fd7cc08023c70937ecb3088866040516111589291906135ea565b60405180910390a26111aa565b876001600160a01b0316817f5d76dff81bf773b908b050fa113d39f7d8135bb4175398f313ea19cd3a1a0b1689876040516111a19291906135ea565b60405180910390a35b505050505b50506001600455505050565b6003546001600160a01b031633146106025760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016103b4565b600380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b6002600054036112b95760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016103b4565b6002600055565b6000818060200190518101906112d69190613712565b90506001815160048111156112ed576112ed613427565b03611337576000816020015180602001905181019061130c91906137a0565b9050600061131f86868460200151611d52565b905061132f8260000151826119ac565b505050505050565b60028151600481111561134c5761134c613427565b036113fa576000816020015180602001905181019061136b91906137da565b9050600061137e86868460600151611d52565b825190915086146113f05760405162461bcd60e51b815260206004820152603660248201527f54656c65706f72746572546f6b656e536f757263653a206d69736d617463686560448201527519081cdbdd5c98d948189b1bd8dad8da185a5b88125160521b60648201526084016103b4565b61132f8282611dc2565b60038151600481111561140f5761140f613427565b036114f2576000816020015180602001905181019061142e9190613897565b9050600080611447878785606001518660800151612025565b915091506114e96040518061010001604052808560000151815260200185602001516001600160a01b0316815260200185604001516001600160a01b031681526020017f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602001838152602001600081526020018560a0015181526020018560c001516001600160a01b031681525083600161085c565b50505050505050565b60048151600481111561150757611507613427565b0361161157600081602001518060200190518101906115269190613915565b905060008061154087878560800151866101400151612025565b915091506114e98784600001516040518061016001604052808760200151815260200187604001516001600160a01b0316815260200187606001516001600160a01b031681526020018760a00151815260200187610100015181526020018760c0015181526020018761012001516001600160a01b031681526020018760e001516001600160a01b031681526020017f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020018581526020016000815250856001610d35565b60008151600481111561162657611626613427565b036107c857600081602001518060200190518101906116459190613a22565b905061166085858360000151846020015185604001516120b6565b5050505050565b6001600454146116895760405162461bcd60e51b81526004016103b4906132f6565b6002600481905560008481526005602090815260408083206001600160a01b03871684528252918290208251608081018452815460ff908116151580835260018401549483019490945294820154938101939093526003015490921615156060820152906117095760405162461bcd60e51b81526004016103b490613a81565b60008160200151116117735760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20636f6c6c60448201526c185d195c985b081b9959591959609a1b60648201526084016103b4565b61177c8261242e565b9150600080826020015184106117ad5760208301516000925061179f9085613ae2565b9050826020015193506117c0565b8383602001516117bd9190613ae2565b91505b60008681526005602090815260408083206001600160a01b03891680855290835292819020600101859055805187815291820185905288917f6769a5f9bfc8b6e0db839ab981cbf9239274ae72d2d035081a9157d43bd33cb6910160405180910390a380156118335761183333826119ac565b5050600160045550505050565b60008481526005602090815260408083206001600160a01b038716845282528083208151608081018352815460ff90811615801583526001840154958301959095526002830154938201939093526003909101549091161515606082015290806118ae575060008160200151115b156118bd5760009150506119a4565b8284116119325760405162461bcd60e51b815260206004820152603860248201527f54656c65706f72746572546f6b656e536f757263653a20696e7375666669636960448201527f656e7420616d6f756e7420746f20636f7665722066656573000000000000000060648201526084016103b4565b61193c8385613ae2565b935060006119538260400151836060015187612460565b905080600003611968576000925050506119a4565b60008781526006602090815260408083206001600160a01b038a1684529091528120805483929061199a908490613af5565b9091555090925050505b949350505050565b816001600160a01b03167f6352c5382c4a4578e712449ca65e83cdb392d045dfcf1cad9615189db2da244b826040516119e791815260200190565b60405180910390a26105a26001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016838361246f565b60008581526005602090815260408083206001600160a01b038816845282528083208151608081018352815460ff9081161515808352600184015495830195909552600283015493820193909352600390910154909116151560608201528291611ad75760405162461bcd60e51b81526020600482015260316024820152600080516020613cb78339815191526044820152701bdb881b9bdd081c9959da5cdd195c9959607a1b60648201526084016103b4565b602081015115611b4f5760405162461bcd60e51b815260206004820152603860248201527f54656c65706f72746572546f6b656e536f757263653a20636f6c6c617465726160448201527f6c206e656564656420666f722064657374696e6174696f6e000000000000000060648201526084016103b4565b611b588661242e565b95508315611b6d57611b6a85856124d2565b93505b6000611b828260400151836060015189612460565b905060008111611be65760405162461bcd60e51b815260206004820152602960248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f207363616c604482015268195908185b5bdd5b9d60ba1b60648201526084016103b4565b60008981526006602090815260408083206001600160a01b038c16845290915281208054839290611c18908490613af5565b909155509099949850939650505050505050565b600080611c37612631565b60408401516020015190915015611cdc576040830151516001600160a01b0316611cb95760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f727465725570677261646561626c653a207a65726f206665652060448201526c746f6b656e206164647265737360981b60648201526084016103b4565b604083015160208101519051611cdc916001600160a01b03909116908390612745565b604051630624488560e41b81526001600160a01b03821690636244885090611d08908690600401613b08565b6020604051808303816000875af1158015611d27573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611d4b91906132dd565b9392505050565b60008381526005602090815260408083206001600160a01b038616845282528083208151608081018352815460ff908116151582526001830154948201949094526002820154928101929092526003015490911615156060820152611db9818686866127f7565b95945050505050565b611df17f0000000000000000000000000000000000000000000000000000000000000000836040015183612745565b6000826000015183602001517f0000000000000000000000000000000000000000000000000000000000000000848660800151604051602401611e38959493929190613bc1565b60408051601f198184030181529181526020820180516001600160e01b031663329d940d60e01b17905260a085015190850151919250600091611e7c919084612901565b6040858101519051636eb1769f60e11b81523060048201526001600160a01b0391821660248201529192506000917f00000000000000000000000000000000000000000000000000000000000000009091169063dd62ed3e90604401602060405180830381865afa158015611ef5573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611f1991906132dd565b9050611f4b7f000000000000000000000000000000000000000000000000000000000000000086604001516000612910565b8115611f9d5784604001516001600160a01b03167f104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff485604051611f9091815260200190565b60405180910390a2611fe5565b84604001516001600160a01b03167fb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb085604051611fdc91815260200190565b60405180910390a25b80156116605760c0850151611660906001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016908361246f565b60008481526005602090815260408083206001600160a01b038716845282528083208151608081018352815460ff90811615158252600183015494820194909452600282015492810192909252600301549091161515606082015281908161208f828989896127f7565b905060006120a68360400151846060015188612a25565b9199919850909650505050505050565b846121215760405162461bcd60e51b815260206004820152603560248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20646573746044820152741a5b985d1a5bdb88189b1bd8dad8da185a5b881251605a1b60648201526084016103b4565b7f000000000000000000000000000000000000000000000000000000000000000085036121b65760405162461bcd60e51b815260206004820152603b60248201527f54656c65706f72746572546f6b656e536f757263653a2063616e6e6f7420726560448201527f67697374657220627269646765206f6e2073616d6520636861696e000000000060648201526084016103b4565b6001600160a01b03841661222b5760405162461bcd60e51b815260206004820152603660248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f2064657374604482015275696e6174696f6e20627269646765206164647265737360501b60648201526084016103b4565b6000821180156122425750670de0b6b3a764000082105b6122a65760405162461bcd60e51b815260206004820152602f60248201527f54656c65706f72746572546f6b656e536f757263653a20696e76616c6964207460448201526e37b5b2b71036bab63a34b83634b2b960891b60648201526084016103b4565b60008581526005602090815260408083206001600160a01b038816845290915290205460ff16156123255760405162461bcd60e51b81526020600482015260356024820152600080516020613cb78339815191526044820152741bdb88185b1c9958591e481c9959da5cdd195c9959605a1b60648201526084016103b4565b6000612332838386612a25565b905081801561234957506123468385613c10565b15155b1561235c57612359600182613af5565b90505b60408051608081018252600180825260208083018581528385018881528715156060860190815260008d8152600585528781206001600160a01b038e1680835295528790209551865490151560ff1991821617875592519486019490945551600285015591516003909301805493151593909216929092179055905187907f34e0f289c6952b50ea58fa31d8c3732fb85091cfc3d5ded7767d7562ce0bfb229061241e9085908890889092835260208301919091521515604082015260600190565b60405180910390a3505050505050565b600061245a7f0000000000000000000000000000000000000000000000000000000000000000836124d2565b92915050565b60006119a48484846001612a30565b6040516001600160a01b03831660248201526044810182905261084f90849063a9059cbb60e01b906064015b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b031990931692909217909152612a58565b6040516370a0823160e01b815230600482015260009081906001600160a01b038516906370a0823190602401602060405180830381865afa15801561251b573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061253f91906132dd565b90506125566001600160a01b038516333086612b2a565b6040516370a0823160e01b81523060048201526000906001600160a01b038616906370a0823190602401602060405180830381865afa15801561259d573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906125c191906132dd565b90508181116126275760405162461bcd60e51b815260206004820152602c60248201527f5361666545524332305472616e7366657246726f6d3a2062616c616e6365206e60448201526b1bdd081a5b98dc99585cd95960a21b60648201526084016103b4565b611db98282613ae2565b6000807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031663d820e64f6040518163ffffffff1660e01b8152600401602060405180830381865afa158015612692573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906126b69190613c24565b90506126da816001600160a01b031660009081526001602052604090205460ff1690565b156127405760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a2054656c65706f72746560448201526f1c881cd95b991a5b99c81c185d5cd95960821b60648201526084016103b4565b919050565b604051636eb1769f60e11b81523060048201526001600160a01b038381166024830152600091839186169063dd62ed3e90604401602060405180830381865afa158015612796573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906127ba91906132dd565b6127c49190613af5565b6040516001600160a01b0385166024820152604481018290529091506107c890859063095ea7b360e01b9060640161249b565b83516000906128185760405162461bcd60e51b81526004016103b490613a81565b60208501511561287e5760405162461bcd60e51b815260206004820152603c6024820152600080516020613cb783398151915260448201527f6f6e20627269646765206e6f7420636f6c6c61746572616c697a65640000000060648201526084016103b4565b612889848484612b62565b600061289e8660400151876060015185612a25565b905060008111611db95760405162461bcd60e51b815260206004820152602860248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20746f6b656044820152671b88185b5bdd5b9d60c21b60648201526084016103b4565b60006119a48460008585612c27565b80158061298a5750604051636eb1769f60e11b81523060048201526001600160a01b03838116602483015284169063dd62ed3e90604401602060405180830381865afa158015612964573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061298891906132dd565b155b6129f55760405162461bcd60e51b815260206004820152603660248201527f5361666545524332303a20617070726f76652066726f6d206e6f6e2d7a65726f60448201527520746f206e6f6e2d7a65726f20616c6c6f77616e636560501b60648201526084016103b4565b6040516001600160a01b03831660248201526044810182905261084f90849063095ea7b360e01b9060640161249b565b60006119a484848460005b600081151584151503612a4e57612a478584613c41565b90506119a4565b611db98584613c58565b6000612aad826040518060400160405280602081526020017f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c6564815250856001600160a01b0316612cfc9092919063ffffffff16565b80519091501561084f5780806020019051810190612acb9190613c6c565b61084f5760405162461bcd60e51b815260206004820152602a60248201527f5361666545524332303a204552433230206f7065726174696f6e20646964206e6044820152691bdd081cdd58d8d9595960b21b60648201526084016103b4565b6040516001600160a01b03808516602483015283166044820152606481018290526107c89085906323b872dd60e01b9060840161249b565b60008381526006602090815260408083206001600160a01b038616845290915290205481811015612bf05760405162461bcd60e51b815260206004820152603260248201527f54656c65706f72746572546f6b656e536f757263653a20696e73756666696369604482015271656e74206272696467652062616c616e636560701b60648201526084016103b4565b612bfa8282613ae2565b60009485526006602090815260408087206001600160a01b03909616875294905292909320919091555050565b6000845a1015612c795760405162461bcd60e51b815260206004820152601b60248201527f43616c6c5574696c733a20696e73756666696369656e7420676173000000000060448201526064016103b4565b83471015612cc95760405162461bcd60e51b815260206004820152601d60248201527f43616c6c5574696c733a20696e73756666696369656e742076616c756500000060448201526064016103b4565b826001600160a01b03163b600003612ce3575060006119a4565b600080600084516020860188888bf19695505050505050565b60606119a4848460008585600080866001600160a01b03168587604051612d239190613c87565b60006040518083038185875af1925050503d8060008114612d60576040519150601f19603f3d011682016040523d82523d6000602084013e612d65565b606091505b5091509150612d7687838387612d81565b979650505050505050565b60608315612df0578251600003612de9576001600160a01b0385163b612de95760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e747261637400000060448201526064016103b4565b50816119a4565b6119a48383815115612e055781518083602001fd5b8060405162461bcd60e51b81526004016103b49190613ca3565b6001600160a01b03811681146105b757600080fd5b803561274081612e1f565b60008060408385031215612e5257600080fd5b823591506020830135612e6481612e1f565b809150509250929050565b600060208284031215612e8157600080fd5b8135611d4b81612e1f565b600080828403610120811215612ea157600080fd5b61010080821215612eb157600080fd5b9395938601359450505050565b600060208284031215612ed057600080fd5b5035919050565b60008060408385031215612eea57600080fd5b82356001600160401b03811115612f0057600080fd5b83016101608186031215612f1357600080fd5b946020939093013593505050565b60008060008060608587031215612f3757600080fd5b843593506020850135612f4981612e1f565b925060408501356001600160401b0380821115612f6557600080fd5b818701915087601f830112612f7957600080fd5b813581811115612f8857600080fd5b886020828501011115612f9a57600080fd5b95989497505060200194505050565b600080600060608486031215612fbe57600080fd5b833592506020840135612fd081612e1f565b929592945050506040919091013590565b6020808252602e908201527f54656c65706f727465725570677261646561626c653a207a65726f2054656c6560408201526d706f72746572206164647265737360901b606082015260800190565b634e487b7160e01b600052604160045260246000fd5b60405161016081016001600160401b03811182821017156130685761306861302f565b60405290565b604080519081016001600160401b03811182821017156130685761306861302f565b60405160e081016001600160401b03811182821017156130685761306861302f565b604051601f8201601f191681016001600160401b03811182821017156130da576130da61302f565b604052919050565b60006101008083850312156130f657600080fd5b604051908101906001600160401b03821181831017156131185761311861302f565b81604052833581526020840135915061313082612e1f565b81602082015261314260408501612e34565b604082015261315360608501612e34565b60608201526080840135608082015260a084013560a082015260c084013560c082015261318260e08501612e34565b60e0820152949350505050565b60006001600160401b038211156131a8576131a861302f565b50601f01601f191660200190565b600082601f8301126131c757600080fd5b81356131da6131d58261318f565b6130b2565b8181528460208386010111156131ef57600080fd5b816020850160208301376000918101602001919091529392505050565b6000610160823603121561321f57600080fd5b613227613045565b8235815261323760208401612e34565b602082015261324860408401612e34565b604082015260608301356001600160401b0381111561326657600080fd5b613272368286016131b6565b6060830152506080830135608082015260a083013560a082015261329860c08401612e34565b60c08201526132a960e08401612e34565b60e08201526101006132bc818501612e34565b90820152610120838101359082015261014092830135928101929092525090565b6000602082840312156132ef57600080fd5b5051919050565b60208082526024908201527f53656e645265656e7472616e637947756172643a2073656e64207265656e7472604082015263616e637960e01b606082015260800190565b6020808252602e908201527f54656c65706f72746572546f6b656e536f757263653a207a65726f207265717560408201526d1a5c99590819d85cc81b1a5b5a5d60921b606082015260800190565b6020808252602d908201527f54656c65706f72746572546f6b656e536f757263653a206e6f6e2d7a65726f2060408201526c7365636f6e646172792066656560981b606082015260800190565b60208082526032908201527f54656c65706f72746572546f6b656e536f757263653a206e6f6e2d7a65726f206040820152716d756c74692d686f702066616c6c6261636b60701b606082015260800190565b634e487b7160e01b600052602160045260246000fd5b81516001600160a01b03168152602080830151908201526040810161245a565b60005b83811015613478578181015183820152602001613460565b50506000910152565b6000815180845261349981602086016020860161345d565b601f01601f19169290920160200192915050565b6020815260008251600581106134d357634e487b7160e01b600052602160045260246000fd5b8060208401525060208301516040808401526119a46060840182613481565b60006101208201905083518252602084015160018060a01b03808216602085015280604087015116604085015280606087015116606085015250506080840151608083015260a084015160a083015260c084015160c083015260e084015161356560e08401826001600160a01b03169052565b50826101008301529392505050565b60208152815160208201526000602083015160018060a01b038082166040850152806040860151166060850152606085015160808501526080850151915060e060a08501526135c7610100850183613481565b915060a085015160c08501528060c08601511660e0850152508091505092915050565b60408152825160408201526000602084015161361160608401826001600160a01b03169052565b5060408401516001600160a01b03166080830152606084015161016060a084018190526136426101a0850183613481565b9150608086015160c085015260a086015160e085015260c0860151610100613674818701836001600160a01b03169052565b60e08801519150610120613692818801846001600160a01b03169052565b908801519150610140906136b0878301846001600160a01b03169052565b880151928601929092525090940151610180830152506020015290565b600082601f8301126136de57600080fd5b81516136ec6131d58261318f565b81815284602083860101111561370157600080fd5b6119a482602083016020870161345d565b60006020828403121561372457600080fd5b81516001600160401b038082111561373b57600080fd5b908301906040828603121561374f57600080fd5b61375761306e565b82516005811061376657600080fd5b815260208301518281111561377a57600080fd5b613786878286016136cd565b60208301525095945050505050565b805161274081612e1f565b6000604082840312156137b257600080fd5b6137ba61306e565b82516137c581612e1f565b81526020928301519281019290925250919050565b6000602082840312156137ec57600080fd5b81516001600160401b038082111561380357600080fd5b9083019060e0828603121561381757600080fd5b61381f613090565b8251815261382f60208401613795565b602082015261384060408401613795565b60408201526060830151606082015260808301518281111561386157600080fd5b61386d878286016136cd565b60808301525060a083015160a082015261388960c08401613795565b60c082015295945050505050565b600060e082840312156138a957600080fd5b6138b1613090565b8251815260208301516138c381612e1f565b602082015260408301516138d681612e1f565b80604083015250606083015160608201526080830151608082015260a083015160a082015260c083015161390981612e1f565b60c08201529392505050565b60006020828403121561392757600080fd5b81516001600160401b038082111561393e57600080fd5b90830190610160828603121561395357600080fd5b61395b613045565b61396483613795565b81526020830151602082015261397c60408401613795565b604082015261398d60608401613795565b60608201526080830151608082015260a0830151828111156139ae57600080fd5b6139ba878286016136cd565b60a08301525060c083015160c08201526139d660e08401613795565b60e0820152610100838101519082015261012091506139f6828401613795565b9181019190915261014091820151918101919091529392505050565b8051801515811461274057600080fd5b600060608284031215613a3457600080fd5b604051606081018181106001600160401b0382111715613a5657613a5661302f565b80604052508251815260208301516020820152613a7560408401613a12565b60408201529392505050565b6020808252603890820152600080516020613cb783398151915260408201527f6f6e20627269646765206e6f7420726567697374657265640000000000000000606082015260800190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561245a5761245a613acc565b8082018082111561245a5761245a613acc565b6020808252825182820152828101516001600160a01b039081166040808501919091528401518051821660608501528083015160808501526000929161010085019190606087015160a0870152608087015160e060c0880152805193849052840192600092506101208701905b80841015613b9757845183168252938501936001939093019290850190613b75565b5060a0880151878203601f190160e08901529450613bb58186613481565b98975050505050505050565b8581526001600160a01b038581166020830152841660408201526060810183905260a060808201819052600090612d7690830184613481565b634e487b7160e01b600052601260045260246000fd5b600082613c1f57613c1f613bfa565b500690565b600060208284031215613c3657600080fd5b8151611d4b81612e1f565b808202811582820484141761245a5761245a613acc565b600082613c6757613c67613bfa565b500490565b600060208284031215613c7e57600080fd5b611d4b82613a12565b60008251613c9981846020870161345d565b9190910192915050565b602081526000611d4b602083018461348156fe54656c65706f72746572546f6b656e536f757263653a2064657374696e617469a2646970667358221220271283dc9e3f12b1f90829c05677c290e78431d3e8b8e5c8362a38acacb7ebc864736f6c63430008120033",
 }
 
@@ -415,6 +415,92 @@ func (_ERC20Source *ERC20SourceCallerSession) Owner() (common.Address, error) {
 	return _ERC20Source.Contract.Owner(&_ERC20Source.CallOpts)
 }
 
+// RateLimitRemaining is a free data retrieval call binding the contract method 0xe8a4726b.
+//
+// Solidity: function rateLimitRemaining(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256)
+func (_ERC20Source *ERC20SourceCaller) RateLimitRemaining(opts *bind.CallOpts, destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC20Source.contract.Call(opts, &out, "rateLimitRemaining", destinationBlockchainID, destinationBridgeAddress)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RateLimitRemaining is a free data retrieval call binding the contract method 0xe8a4726b.
+//
+// Solidity: function rateLimitRemaining(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256)
+func (_ERC20Source *ERC20SourceSession) RateLimitRemaining(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (*big.Int, error) {
+	return _ERC20Source.Contract.RateLimitRemaining(&_ERC20Source.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// RateLimitRemaining is a free data retrieval call binding the contract method 0xe8a4726b.
+//
+// Solidity: function rateLimitRemaining(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256)
+func (_ERC20Source *ERC20SourceCallerSession) RateLimitRemaining(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (*big.Int, error) {
+	return _ERC20Source.Contract.RateLimitRemaining(&_ERC20Source.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// RateLimits is a free data retrieval call binding the contract method 0x9a1ae5cd.
+//
+// Solidity: function rateLimits(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256 limit, uint256 window, uint256 windowStart, uint256 amountSent)
+func (_ERC20Source *ERC20SourceCaller) RateLimits(opts *bind.CallOpts, destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (struct {
+	Limit       *big.Int
+	Window      *big.Int
+	WindowStart *big.Int
+	AmountSent  *big.Int
+}, error) {
+	var out []interface{}
+	err := _ERC20Source.contract.Call(opts, &out, "rateLimits", destinationBlockchainID, destinationBridgeAddress)
+
+	outstruct := new(struct {
+		Limit       *big.Int
+		Window      *big.Int
+		WindowStart *big.Int
+		AmountSent  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Limit = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Window = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.WindowStart = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.AmountSent = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// RateLimits is a free data retrieval call binding the contract method 0x9a1ae5cd.
+//
+// Solidity: function rateLimits(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256 limit, uint256 window, uint256 windowStart, uint256 amountSent)
+func (_ERC20Source *ERC20SourceSession) RateLimits(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (struct {
+	Limit       *big.Int
+	Window      *big.Int
+	WindowStart *big.Int
+	AmountSent  *big.Int
+}, error) {
+	return _ERC20Source.Contract.RateLimits(&_ERC20Source.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// RateLimits is a free data retrieval call binding the contract method 0x9a1ae5cd.
+//
+// Solidity: function rateLimits(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256 limit, uint256 window, uint256 windowStart, uint256 amountSent)
+func (_ERC20Source *ERC20SourceCallerSession) RateLimits(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (struct {
+	Limit       *big.Int
+	Window      *big.Int
+	WindowStart *big.Int
+	AmountSent  *big.Int
+}, error) {
+	return _ERC20Source.Contract.RateLimits(&_ERC20Source.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
 // RegisteredDestinations is a free data retrieval call binding the contract method 0xfcfb5ff9.
 //
 // Solidity: function registeredDestinations(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(bool registered, uint256 collateralNeeded, uint256 tokenMultiplier, bool multiplyOnDestination)
@@ -470,6 +556,37 @@ func (_ERC20Source *ERC20SourceCallerSession) RegisteredDestinations(destination
 	return _ERC20Source.Contract.RegisteredDestinations(&_ERC20Source.CallOpts, destinationBlockchainID, destinationBridgeAddress)
 }
 
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC20Source *ERC20SourceCaller) SendsPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ERC20Source.contract.Call(opts, &out, "sendsPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC20Source *ERC20SourceSession) SendsPaused() (bool, error) {
+	return _ERC20Source.Contract.SendsPaused(&_ERC20Source.CallOpts)
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC20Source *ERC20SourceCallerSession) SendsPaused() (bool, error) {
+	return _ERC20Source.Contract.SendsPaused(&_ERC20Source.CallOpts)
+}
+
 // TeleporterRegistry is a free data retrieval call binding the contract method 0x1a7f5bec.
 //
 // Solidity: function teleporterRegistry() view returns(address)
@@ -584,6 +701,27 @@ func (_ERC20Source *ERC20SourceTransactorSession) AddCollateral(destinationBlock
 	return _ERC20Source.Contract.AddCollateral(&_ERC20Source.TransactOpts, destinationBlockchainID, destinationBridgeAddress, amount)
 }
 
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC20Source *ERC20SourceTransactor) PauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Source.contract.Transact(opts, "pauseSends")
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC20Source *ERC20SourceSession) PauseSends() (*types.Transaction, error) {
+	return _ERC20Source.Contract.PauseSends(&_ERC20Source.TransactOpts)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC20Source *ERC20SourceTransactorSession) PauseSends() (*types.Transaction, error) {
+	return _ERC20Source.Contract.PauseSends(&_ERC20Source.TransactOpts)
+}
+
 // PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
 //
 // Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
@@ -689,6 +827,27 @@ func (_ERC20Source *ERC20SourceTransactorSession) SendAndCall(input SendAndCallI
 	return _ERC20Source.Contract.SendAndCall(&_ERC20Source.TransactOpts, input, amount)
 }
 
+// SetRateLimit is a paid mutator transaction binding the contract method 0xa86aba85.
+//
+// Solidity: function setRateLimit(bytes32 destinationBlockchainID, address destinationBridgeAddress, uint256 limit, uint256 window) returns()
+func (_ERC20Source *ERC20SourceTransactor) SetRateLimit(opts *bind.TransactOpts, destinationBlockchainID [32]byte, destinationBridgeAddress common.Address, limit *big.Int, window *big.Int) (*types.Transaction, error) {
+	return _ERC20Source.contract.Transact(opts, "setRateLimit", destinationBlockchainID, destinationBridgeAddress, limit, window)
+}
+
+// SetRateLimit is a paid mutator transaction binding the contract method 0xa86aba85.
+//
+// Solidity: function setRateLimit(bytes32 destinationBlockchainID, address destinationBridgeAddress, uint256 limit, uint256 window) returns()
+func (_ERC20Source *ERC20SourceSession) SetRateLimit(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address, limit *big.Int, window *big.Int) (*types.Transaction, error) {
+	return _ERC20Source.Contract.SetRateLimit(&_ERC20Source.TransactOpts, destinationBlockchainID, destinationBridgeAddress, limit, window)
+}
+
+// SetRateLimit is a paid mutator transaction binding the contract method 0xa86aba85.
+//
+// Solidity: function setRateLimit(bytes32 destinationBlockchainID, address destinationBridgeAddress, uint256 limit, uint256 window) returns()
+func (_ERC20Source *ERC20SourceTransactorSession) SetRateLimit(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address, limit *big.Int, window *big.Int) (*types.Transaction, error) {
+	return _ERC20Source.Contract.SetRateLimit(&_ERC20Source.TransactOpts, destinationBlockchainID, destinationBridgeAddress, limit, window)
+}
+
 // TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
 //
 // Solidity: function transferOwnership(address newOwner) returns()
@@ -710,6 +869,27 @@ func (_ERC20Source *ERC20SourceTransactorSession) TransferOwnership(newOwner com
 	return _ERC20Source.Contract.TransferOwnership(&_ERC20Source.TransactOpts, newOwner)
 }
 
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC20Source *ERC20SourceTransactor) UnpauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC20Source.contract.Transact(opts, "unpauseSends")
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC20Source *ERC20SourceSession) UnpauseSends() (*types.Transaction, error) {
+	return _ERC20Source.Contract.UnpauseSends(&_ERC20Source.TransactOpts)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC20Source *ERC20SourceTransactorSession) UnpauseSends() (*types.Transaction, error) {
+	return _ERC20Source.Contract.UnpauseSends(&_ERC20Source.TransactOpts)
+}
+
 // UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
 //
 // Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
@@ -1659,6 +1839,449 @@ func (_ERC20Source *ERC20SourceFilterer) ParseOwnershipTransferred(log types.Log
 	return event, nil
 }
 
+// ERC20SourceRateLimitUpdatedIterator is returned from FilterRateLimitUpdated and is used to iterate over the raw logs and unpacked data for RateLimitUpdated events raised by the ERC20Source contract.
+type ERC20SourceRateLimitUpdatedIterator struct {
+	Event *ERC20SourceRateLimitUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20SourceRateLimitUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20SourceRateLimitUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20SourceRateLimitUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20SourceRateLimitUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20SourceRateLimitUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20SourceRateLimitUpdated represents a RateLimitUpdated event raised by the ERC20Source contract.
+type ERC20SourceRateLimitUpdated struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+	Limit                    *big.Int
+	Window                   *big.Int
+	Raw                      types.Log // Blockchain specific contextual infos
+}
+
+// FilterRateLimitUpdated is a free log retrieval operation binding the contract event 0x10cd5d93bb79292b62622d5cd2f12124aae6e20fc3929e6fee2a466c0e8ef3a3.
+//
+// Solidity: event RateLimitUpdated(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress, uint256 limit, uint256 window)
+func (_ERC20Source *ERC20SourceFilterer) FilterRateLimitUpdated(opts *bind.FilterOpts, destinationBlockchainID [][32]byte, destinationBridgeAddress []common.Address) (*ERC20SourceRateLimitUpdatedIterator, error) {
+
+	var destinationBlockchainIDRule []interface{}
+	for _, destinationBlockchainIDItem := range destinationBlockchainID {
+		destinationBlockchainIDRule = append(destinationBlockchainIDRule, destinationBlockchainIDItem)
+	}
+	var destinationBridgeAddressRule []interface{}
+	for _, destinationBridgeAddressItem := range destinationBridgeAddress {
+		destinationBridgeAddressRule = append(destinationBridgeAddressRule, destinationBridgeAddressItem)
+	}
+
+	logs, sub, err := _ERC20Source.contract.FilterLogs(opts, "RateLimitUpdated", destinationBlockchainIDRule, destinationBridgeAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20SourceRateLimitUpdatedIterator{contract: _ERC20Source.contract, event: "RateLimitUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchRateLimitUpdated is a free log subscription operation binding the contract event 0x10cd5d93bb79292b62622d5cd2f12124aae6e20fc3929e6fee2a466c0e8ef3a3.
+//
+// Solidity: event RateLimitUpdated(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress, uint256 limit, uint256 window)
+func (_ERC20Source *ERC20SourceFilterer) WatchRateLimitUpdated(opts *bind.WatchOpts, sink chan<- *ERC20SourceRateLimitUpdated, destinationBlockchainID [][32]byte, destinationBridgeAddress []common.Address) (event.Subscription, error) {
+
+	var destinationBlockchainIDRule []interface{}
+	for _, destinationBlockchainIDItem := range destinationBlockchainID {
+		destinationBlockchainIDRule = append(destinationBlockchainIDRule, destinationBlockchainIDItem)
+	}
+	var destinationBridgeAddressRule []interface{}
+	for _, destinationBridgeAddressItem := range destinationBridgeAddress {
+		destinationBridgeAddressRule = append(destinationBridgeAddressRule, destinationBridgeAddressItem)
+	}
+
+	logs, sub, err := _ERC20Source.contract.WatchLogs(opts, "RateLimitUpdated", destinationBlockchainIDRule, destinationBridgeAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20SourceRateLimitUpdated)
+				if err := _ERC20Source.contract.UnpackLog(event, "RateLimitUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRateLimitUpdated is a log parse operation binding the contract event 0x10cd5d93bb79292b62622d5cd2f12124aae6e20fc3929e6fee2a466c0e8ef3a3.
+//
+// Solidity: event RateLimitUpdated(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress, uint256 limit, uint256 window)
+func (_ERC20Source *ERC20SourceFilterer) ParseRateLimitUpdated(log types.Log) (*ERC20SourceRateLimitUpdated, error) {
+	event := new(ERC20SourceRateLimitUpdated)
+	if err := _ERC20Source.contract.UnpackLog(event, "RateLimitUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20SourceSendsPausedIterator is returned from FilterSendsPaused and is used to iterate over the raw logs and unpacked data for SendsPaused events raised by the ERC20Source contract.
+type ERC20SourceSendsPausedIterator struct {
+	Event *ERC20SourceSendsPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20SourceSendsPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20SourceSendsPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20SourceSendsPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20SourceSendsPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20SourceSendsPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20SourceSendsPaused represents a SendsPaused event raised by the ERC20Source contract.
+type ERC20SourceSendsPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsPaused is a free log retrieval operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC20Source *ERC20SourceFilterer) FilterSendsPaused(opts *bind.FilterOpts, account []common.Address) (*ERC20SourceSendsPausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Source.contract.FilterLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20SourceSendsPausedIterator{contract: _ERC20Source.contract, event: "SendsPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsPaused is a free log subscription operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC20Source *ERC20SourceFilterer) WatchSendsPaused(opts *bind.WatchOpts, sink chan<- *ERC20SourceSendsPaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Source.contract.WatchLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20SourceSendsPaused)
+				if err := _ERC20Source.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsPaused is a log parse operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC20Source *ERC20SourceFilterer) ParseSendsPaused(log types.Log) (*ERC20SourceSendsPaused, error) {
+	event := new(ERC20SourceSendsPaused)
+	if err := _ERC20Source.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC20SourceSendsUnpausedIterator is returned from FilterSendsUnpaused and is used to iterate over the raw logs and unpacked data for SendsUnpaused events raised by the ERC20Source contract.
+type ERC20SourceSendsUnpausedIterator struct {
+	Event *ERC20SourceSendsUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC20SourceSendsUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC20SourceSendsUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC20SourceSendsUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC20SourceSendsUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC20SourceSendsUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC20SourceSendsUnpaused represents a SendsUnpaused event raised by the ERC20Source contract.
+type ERC20SourceSendsUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsUnpaused is a free log retrieval operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC20Source *ERC20SourceFilterer) FilterSendsUnpaused(opts *bind.FilterOpts, account []common.Address) (*ERC20SourceSendsUnpausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Source.contract.FilterLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC20SourceSendsUnpausedIterator{contract: _ERC20Source.contract, event: "SendsUnpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsUnpaused is a free log subscription operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC20Source *ERC20SourceFilterer) WatchSendsUnpaused(opts *bind.WatchOpts, sink chan<- *ERC20SourceSendsUnpaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC20Source.contract.WatchLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC20SourceSendsUnpaused)
+				if err := _ERC20Source.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsUnpaused is a log parse operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC20Source *ERC20SourceFilterer) ParseSendsUnpaused(log types.Log) (*ERC20SourceSendsUnpaused, error) {
+	event := new(ERC20SourceSendsUnpaused)
+	if err := _ERC20Source.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // ERC20SourceTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the ERC20Source contract.
 type ERC20SourceTeleporterAddressPausedIterator struct {
 	Event *ERC20SourceTeleporterAddressPaused // Event containing the contract specifics and raw log
@@ -0,0 +1,3306 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package erc721destination
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = interfaces.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SendAndCallInput is an auto generated low-level Go binding around an user-defined struct.
+type SendAndCallInput struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+	RecipientContract        common.Address
+	RecipientPayload         []byte
+	RequiredGasLimit         *big.Int
+	RecipientGasLimit        *big.Int
+	MultiHopFallback         common.Address
+	FallbackRecipient        common.Address
+	PrimaryFeeTokenAddress   common.Address
+	PrimaryFee               *big.Int
+	SecondaryFee             *big.Int
+}
+
+// SendTokensInput is an auto generated low-level Go binding around an user-defined struct.
+type SendTokensInput struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+	Recipient                common.Address
+	PrimaryFeeTokenAddress   common.Address
+	PrimaryFee               *big.Int
+	SecondaryFee             *big.Int
+	RequiredGasLimit         *big.Int
+	MultiHopFallback         common.Address
+}
+
+// TeleporterFeeInfo is an auto generated low-level Go binding around an user-defined struct.
+type TeleporterFeeInfo struct {
+	FeeTokenAddress common.Address
+	Amount          *big.Int
+}
+
+// ERC721DestinationMetaData contains all meta data concerning the ERC721Destination contract.
+var ERC721DestinationMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterRegistryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"teleporterManager\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID_\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"tokenSourceAddress_\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"tokenName\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"tokenSymbol\",\"type\":\"string\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"TokenAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"TokenSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"TokenWithdrawn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MULTI_HOP_CALL_GAS_PER_WORD\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MULTI_HOP_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REGISTER_DESTINATION_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"payloadSize\",\"type\":\"uint256\"}],\"name\":\"calculateNumWords\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isRegistered\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"feeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structTeleporterFeeInfo\",\"name\":\"feeInfo\",\"type\":\"tuple\"}],\"name\":\"registerWithSource\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"sendAndCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sendsPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sourceBlockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenSourceAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// ERC721DestinationABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC721DestinationMetaData.ABI instead.
+var ERC721DestinationABI = ERC721DestinationMetaData.ABI
+
+// ERC721Destination is an auto generated Go binding around an Ethereum contract.
+type ERC721Destination struct {
+	ERC721DestinationCaller     // Read-only binding to the contract
+	ERC721DestinationTransactor // Write-only binding to the contract
+	ERC721DestinationFilterer   // Log filterer for contract events
+}
+
+// ERC721DestinationCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC721DestinationCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721DestinationTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC721DestinationTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721DestinationFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC721DestinationFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721DestinationSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC721DestinationSession struct {
+	Contract     *ERC721Destination // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// ERC721DestinationCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC721DestinationCallerSession struct {
+	Contract *ERC721DestinationCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// ERC721DestinationTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC721DestinationTransactorSession struct {
+	Contract     *ERC721DestinationTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// ERC721DestinationRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC721DestinationRaw struct {
+	Contract *ERC721Destination // Generic contract binding to access the raw methods on
+}
+
+// ERC721DestinationCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC721DestinationCallerRaw struct {
+	Contract *ERC721DestinationCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC721DestinationTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC721DestinationTransactorRaw struct {
+	Contract *ERC721DestinationTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC721Destination creates a new instance of ERC721Destination, bound to a specific deployed contract.
+func NewERC721Destination(address common.Address, backend bind.ContractBackend) (*ERC721Destination, error) {
+	contract, err := bindERC721Destination(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721Destination{ERC721DestinationCaller: ERC721DestinationCaller{contract: contract}, ERC721DestinationTransactor: ERC721DestinationTransactor{contract: contract}, ERC721DestinationFilterer: ERC721DestinationFilterer{contract: contract}}, nil
+}
+
+// NewERC721DestinationCaller creates a new read-only instance of ERC721Destination, bound to a specific deployed contract.
+func NewERC721DestinationCaller(address common.Address, caller bind.ContractCaller) (*ERC721DestinationCaller, error) {
+	contract, err := bindERC721Destination(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationCaller{contract: contract}, nil
+}
+
+// NewERC721DestinationTransactor creates a new write-only instance of ERC721Destination, bound to a specific deployed contract.
+func NewERC721DestinationTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC721DestinationTransactor, error) {
+	contract, err := bindERC721Destination(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationTransactor{contract: contract}, nil
+}
+
+// NewERC721DestinationFilterer creates a new log filterer instance of ERC721Destination, bound to a specific deployed contract.
+func NewERC721DestinationFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC721DestinationFilterer, error) {
+	contract, err := bindERC721Destination(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationFilterer{contract: contract}, nil
+}
+
+// bindERC721Destination binds a generic wrapper to an already deployed contract.
+func bindERC721Destination(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC721DestinationMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC721Destination *ERC721DestinationRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721Destination.Contract.ERC721DestinationCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC721Destination *ERC721DestinationRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.ERC721DestinationTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC721Destination *ERC721DestinationRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.ERC721DestinationTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC721Destination *ERC721DestinationCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721Destination.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC721Destination *ERC721DestinationTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC721Destination *ERC721DestinationTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.contract.Transact(opts, method, params...)
+}
+
+// MULTIHOPCALLGASPERWORD is a free data retrieval call binding the contract method 0x15beb59f.
+//
+// Solidity: function MULTI_HOP_CALL_GAS_PER_WORD() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCaller) MULTIHOPCALLGASPERWORD(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "MULTI_HOP_CALL_GAS_PER_WORD")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MULTIHOPCALLGASPERWORD is a free data retrieval call binding the contract method 0x15beb59f.
+//
+// Solidity: function MULTI_HOP_CALL_GAS_PER_WORD() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationSession) MULTIHOPCALLGASPERWORD() (*big.Int, error) {
+	return _ERC721Destination.Contract.MULTIHOPCALLGASPERWORD(&_ERC721Destination.CallOpts)
+}
+
+// MULTIHOPCALLGASPERWORD is a free data retrieval call binding the contract method 0x15beb59f.
+//
+// Solidity: function MULTI_HOP_CALL_GAS_PER_WORD() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCallerSession) MULTIHOPCALLGASPERWORD() (*big.Int, error) {
+	return _ERC721Destination.Contract.MULTIHOPCALLGASPERWORD(&_ERC721Destination.CallOpts)
+}
+
+// MULTIHOPREQUIREDGAS is a free data retrieval call binding the contract method 0x525975e6.
+//
+// Solidity: function MULTI_HOP_REQUIRED_GAS() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCaller) MULTIHOPREQUIREDGAS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "MULTI_HOP_REQUIRED_GAS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MULTIHOPREQUIREDGAS is a free data retrieval call binding the contract method 0x525975e6.
+//
+// Solidity: function MULTI_HOP_REQUIRED_GAS() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationSession) MULTIHOPREQUIREDGAS() (*big.Int, error) {
+	return _ERC721Destination.Contract.MULTIHOPREQUIREDGAS(&_ERC721Destination.CallOpts)
+}
+
+// MULTIHOPREQUIREDGAS is a free data retrieval call binding the contract method 0x525975e6.
+//
+// Solidity: function MULTI_HOP_REQUIRED_GAS() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCallerSession) MULTIHOPREQUIREDGAS() (*big.Int, error) {
+	return _ERC721Destination.Contract.MULTIHOPREQUIREDGAS(&_ERC721Destination.CallOpts)
+}
+
+// REGISTERDESTINATIONREQUIREDGAS is a free data retrieval call binding the contract method 0x55c26725.
+//
+// Solidity: function REGISTER_DESTINATION_REQUIRED_GAS() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCaller) REGISTERDESTINATIONREQUIREDGAS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "REGISTER_DESTINATION_REQUIRED_GAS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// REGISTERDESTINATIONREQUIREDGAS is a free data retrieval call binding the contract method 0x55c26725.
+//
+// Solidity: function REGISTER_DESTINATION_REQUIRED_GAS() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationSession) REGISTERDESTINATIONREQUIREDGAS() (*big.Int, error) {
+	return _ERC721Destination.Contract.REGISTERDESTINATIONREQUIREDGAS(&_ERC721Destination.CallOpts)
+}
+
+// REGISTERDESTINATIONREQUIREDGAS is a free data retrieval call binding the contract method 0x55c26725.
+//
+// Solidity: function REGISTER_DESTINATION_REQUIRED_GAS() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCallerSession) REGISTERDESTINATIONREQUIREDGAS() (*big.Int, error) {
+	return _ERC721Destination.Contract.REGISTERDESTINATIONREQUIREDGAS(&_ERC721Destination.CallOpts)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721Destination *ERC721DestinationSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721Destination.Contract.BalanceOf(&_ERC721Destination.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _ERC721Destination.Contract.BalanceOf(&_ERC721Destination.CallOpts, owner)
+}
+
+// BlockchainID is a free data retrieval call binding the contract method 0xd127dc9b.
+//
+// Solidity: function blockchainID() view returns(bytes32)
+func (_ERC721Destination *ERC721DestinationCaller) BlockchainID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "blockchainID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BlockchainID is a free data retrieval call binding the contract method 0xd127dc9b.
+//
+// Solidity: function blockchainID() view returns(bytes32)
+func (_ERC721Destination *ERC721DestinationSession) BlockchainID() ([32]byte, error) {
+	return _ERC721Destination.Contract.BlockchainID(&_ERC721Destination.CallOpts)
+}
+
+// BlockchainID is a free data retrieval call binding the contract method 0xd127dc9b.
+//
+// Solidity: function blockchainID() view returns(bytes32)
+func (_ERC721Destination *ERC721DestinationCallerSession) BlockchainID() ([32]byte, error) {
+	return _ERC721Destination.Contract.BlockchainID(&_ERC721Destination.CallOpts)
+}
+
+// CalculateNumWords is a free data retrieval call binding the contract method 0xf3f981d8.
+//
+// Solidity: function calculateNumWords(uint256 payloadSize) pure returns(uint256)
+func (_ERC721Destination *ERC721DestinationCaller) CalculateNumWords(opts *bind.CallOpts, payloadSize *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "calculateNumWords", payloadSize)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CalculateNumWords is a free data retrieval call binding the contract method 0xf3f981d8.
+//
+// Solidity: function calculateNumWords(uint256 payloadSize) pure returns(uint256)
+func (_ERC721Destination *ERC721DestinationSession) CalculateNumWords(payloadSize *big.Int) (*big.Int, error) {
+	return _ERC721Destination.Contract.CalculateNumWords(&_ERC721Destination.CallOpts, payloadSize)
+}
+
+// CalculateNumWords is a free data retrieval call binding the contract method 0xf3f981d8.
+//
+// Solidity: function calculateNumWords(uint256 payloadSize) pure returns(uint256)
+func (_ERC721Destination *ERC721DestinationCallerSession) CalculateNumWords(payloadSize *big.Int) (*big.Int, error) {
+	return _ERC721Destination.Contract.CalculateNumWords(&_ERC721Destination.CallOpts, payloadSize)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721Destination *ERC721DestinationCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721Destination *ERC721DestinationSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721Destination.Contract.GetApproved(&_ERC721Destination.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_ERC721Destination *ERC721DestinationCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _ERC721Destination.Contract.GetApproved(&_ERC721Destination.CallOpts, tokenId)
+}
+
+// GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
+//
+// Solidity: function getMinTeleporterVersion() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCaller) GetMinTeleporterVersion(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "getMinTeleporterVersion")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
+//
+// Solidity: function getMinTeleporterVersion() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationSession) GetMinTeleporterVersion() (*big.Int, error) {
+	return _ERC721Destination.Contract.GetMinTeleporterVersion(&_ERC721Destination.CallOpts)
+}
+
+// GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
+//
+// Solidity: function getMinTeleporterVersion() view returns(uint256)
+func (_ERC721Destination *ERC721DestinationCallerSession) GetMinTeleporterVersion() (*big.Int, error) {
+	return _ERC721Destination.Contract.GetMinTeleporterVersion(&_ERC721Destination.CallOpts)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721Destination *ERC721DestinationCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721Destination *ERC721DestinationSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721Destination.Contract.IsApprovedForAll(&_ERC721Destination.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_ERC721Destination *ERC721DestinationCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _ERC721Destination.Contract.IsApprovedForAll(&_ERC721Destination.CallOpts, owner, operator)
+}
+
+// IsRegistered is a free data retrieval call binding the contract method 0x22366844.
+//
+// Solidity: function isRegistered() view returns(bool)
+func (_ERC721Destination *ERC721DestinationCaller) IsRegistered(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "isRegistered")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsRegistered is a free data retrieval call binding the contract method 0x22366844.
+//
+// Solidity: function isRegistered() view returns(bool)
+func (_ERC721Destination *ERC721DestinationSession) IsRegistered() (bool, error) {
+	return _ERC721Destination.Contract.IsRegistered(&_ERC721Destination.CallOpts)
+}
+
+// IsRegistered is a free data retrieval call binding the contract method 0x22366844.
+//
+// Solidity: function isRegistered() view returns(bool)
+func (_ERC721Destination *ERC721DestinationCallerSession) IsRegistered() (bool, error) {
+	return _ERC721Destination.Contract.IsRegistered(&_ERC721Destination.CallOpts)
+}
+
+// IsTeleporterAddressPaused is a free data retrieval call binding the contract method 0x97314297.
+//
+// Solidity: function isTeleporterAddressPaused(address teleporterAddress) view returns(bool)
+func (_ERC721Destination *ERC721DestinationCaller) IsTeleporterAddressPaused(opts *bind.CallOpts, teleporterAddress common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "isTeleporterAddressPaused", teleporterAddress)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsTeleporterAddressPaused is a free data retrieval call binding the contract method 0x97314297.
+//
+// Solidity: function isTeleporterAddressPaused(address teleporterAddress) view returns(bool)
+func (_ERC721Destination *ERC721DestinationSession) IsTeleporterAddressPaused(teleporterAddress common.Address) (bool, error) {
+	return _ERC721Destination.Contract.IsTeleporterAddressPaused(&_ERC721Destination.CallOpts, teleporterAddress)
+}
+
+// IsTeleporterAddressPaused is a free data retrieval call binding the contract method 0x97314297.
+//
+// Solidity: function isTeleporterAddressPaused(address teleporterAddress) view returns(bool)
+func (_ERC721Destination *ERC721DestinationCallerSession) IsTeleporterAddressPaused(teleporterAddress common.Address) (bool, error) {
+	return _ERC721Destination.Contract.IsTeleporterAddressPaused(&_ERC721Destination.CallOpts, teleporterAddress)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721Destination *ERC721DestinationCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721Destination *ERC721DestinationSession) Name() (string, error) {
+	return _ERC721Destination.Contract.Name(&_ERC721Destination.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_ERC721Destination *ERC721DestinationCallerSession) Name() (string, error) {
+	return _ERC721Destination.Contract.Name(&_ERC721Destination.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ERC721Destination *ERC721DestinationCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ERC721Destination *ERC721DestinationSession) Owner() (common.Address, error) {
+	return _ERC721Destination.Contract.Owner(&_ERC721Destination.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ERC721Destination *ERC721DestinationCallerSession) Owner() (common.Address, error) {
+	return _ERC721Destination.Contract.Owner(&_ERC721Destination.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721Destination *ERC721DestinationCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721Destination *ERC721DestinationSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721Destination.Contract.OwnerOf(&_ERC721Destination.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_ERC721Destination *ERC721DestinationCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _ERC721Destination.Contract.OwnerOf(&_ERC721Destination.CallOpts, tokenId)
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC721Destination *ERC721DestinationCaller) SendsPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "sendsPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC721Destination *ERC721DestinationSession) SendsPaused() (bool, error) {
+	return _ERC721Destination.Contract.SendsPaused(&_ERC721Destination.CallOpts)
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC721Destination *ERC721DestinationCallerSession) SendsPaused() (bool, error) {
+	return _ERC721Destination.Contract.SendsPaused(&_ERC721Destination.CallOpts)
+}
+
+// SourceBlockchainID is a free data retrieval call binding the contract method 0x29b7b3fd.
+//
+// Solidity: function sourceBlockchainID() view returns(bytes32)
+func (_ERC721Destination *ERC721DestinationCaller) SourceBlockchainID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "sourceBlockchainID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SourceBlockchainID is a free data retrieval call binding the contract method 0x29b7b3fd.
+//
+// Solidity: function sourceBlockchainID() view returns(bytes32)
+func (_ERC721Destination *ERC721DestinationSession) SourceBlockchainID() ([32]byte, error) {
+	return _ERC721Destination.Contract.SourceBlockchainID(&_ERC721Destination.CallOpts)
+}
+
+// SourceBlockchainID is a free data retrieval call binding the contract method 0x29b7b3fd.
+//
+// Solidity: function sourceBlockchainID() view returns(bytes32)
+func (_ERC721Destination *ERC721DestinationCallerSession) SourceBlockchainID() ([32]byte, error) {
+	return _ERC721Destination.Contract.SourceBlockchainID(&_ERC721Destination.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721Destination *ERC721DestinationCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721Destination *ERC721DestinationSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721Destination.Contract.SupportsInterface(&_ERC721Destination.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_ERC721Destination *ERC721DestinationCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _ERC721Destination.Contract.SupportsInterface(&_ERC721Destination.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721Destination *ERC721DestinationCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721Destination *ERC721DestinationSession) Symbol() (string, error) {
+	return _ERC721Destination.Contract.Symbol(&_ERC721Destination.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_ERC721Destination *ERC721DestinationCallerSession) Symbol() (string, error) {
+	return _ERC721Destination.Contract.Symbol(&_ERC721Destination.CallOpts)
+}
+
+// TeleporterRegistry is a free data retrieval call binding the contract method 0x1a7f5bec.
+//
+// Solidity: function teleporterRegistry() view returns(address)
+func (_ERC721Destination *ERC721DestinationCaller) TeleporterRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "teleporterRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TeleporterRegistry is a free data retrieval call binding the contract method 0x1a7f5bec.
+//
+// Solidity: function teleporterRegistry() view returns(address)
+func (_ERC721Destination *ERC721DestinationSession) TeleporterRegistry() (common.Address, error) {
+	return _ERC721Destination.Contract.TeleporterRegistry(&_ERC721Destination.CallOpts)
+}
+
+// TeleporterRegistry is a free data retrieval call binding the contract method 0x1a7f5bec.
+//
+// Solidity: function teleporterRegistry() view returns(address)
+func (_ERC721Destination *ERC721DestinationCallerSession) TeleporterRegistry() (common.Address, error) {
+	return _ERC721Destination.Contract.TeleporterRegistry(&_ERC721Destination.CallOpts)
+}
+
+// TokenSourceAddress is a free data retrieval call binding the contract method 0xf5ea0603.
+//
+// Solidity: function tokenSourceAddress() view returns(address)
+func (_ERC721Destination *ERC721DestinationCaller) TokenSourceAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "tokenSourceAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenSourceAddress is a free data retrieval call binding the contract method 0xf5ea0603.
+//
+// Solidity: function tokenSourceAddress() view returns(address)
+func (_ERC721Destination *ERC721DestinationSession) TokenSourceAddress() (common.Address, error) {
+	return _ERC721Destination.Contract.TokenSourceAddress(&_ERC721Destination.CallOpts)
+}
+
+// TokenSourceAddress is a free data retrieval call binding the contract method 0xf5ea0603.
+//
+// Solidity: function tokenSourceAddress() view returns(address)
+func (_ERC721Destination *ERC721DestinationCallerSession) TokenSourceAddress() (common.Address, error) {
+	return _ERC721Destination.Contract.TokenSourceAddress(&_ERC721Destination.CallOpts)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721Destination *ERC721DestinationCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _ERC721Destination.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721Destination *ERC721DestinationSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721Destination.Contract.TokenURI(&_ERC721Destination.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_ERC721Destination *ERC721DestinationCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _ERC721Destination.Contract.TokenURI(&_ERC721Destination.CallOpts, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.Approve(&_ERC721Destination.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.Approve(&_ERC721Destination.TransactOpts, to, tokenId)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC721Destination *ERC721DestinationTransactor) PauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "pauseSends")
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC721Destination *ERC721DestinationSession) PauseSends() (*types.Transaction, error) {
+	return _ERC721Destination.Contract.PauseSends(&_ERC721Destination.TransactOpts)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) PauseSends() (*types.Transaction, error) {
+	return _ERC721Destination.Contract.PauseSends(&_ERC721Destination.TransactOpts)
+}
+
+// PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
+//
+// Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) PauseTeleporterAddress(opts *bind.TransactOpts, teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "pauseTeleporterAddress", teleporterAddress)
+}
+
+// PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
+//
+// Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Destination *ERC721DestinationSession) PauseTeleporterAddress(teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.PauseTeleporterAddress(&_ERC721Destination.TransactOpts, teleporterAddress)
+}
+
+// PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
+//
+// Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) PauseTeleporterAddress(teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.PauseTeleporterAddress(&_ERC721Destination.TransactOpts, teleporterAddress)
+}
+
+// ReceiveTeleporterMessage is a paid mutator transaction binding the contract method 0xc868efaa.
+//
+// Solidity: function receiveTeleporterMessage(bytes32 sourceBlockchainID, address originSenderAddress, bytes message) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) ReceiveTeleporterMessage(opts *bind.TransactOpts, sourceBlockchainID [32]byte, originSenderAddress common.Address, message []byte) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "receiveTeleporterMessage", sourceBlockchainID, originSenderAddress, message)
+}
+
+// ReceiveTeleporterMessage is a paid mutator transaction binding the contract method 0xc868efaa.
+//
+// Solidity: function receiveTeleporterMessage(bytes32 sourceBlockchainID, address originSenderAddress, bytes message) returns()
+func (_ERC721Destination *ERC721DestinationSession) ReceiveTeleporterMessage(sourceBlockchainID [32]byte, originSenderAddress common.Address, message []byte) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.ReceiveTeleporterMessage(&_ERC721Destination.TransactOpts, sourceBlockchainID, originSenderAddress, message)
+}
+
+// ReceiveTeleporterMessage is a paid mutator transaction binding the contract method 0xc868efaa.
+//
+// Solidity: function receiveTeleporterMessage(bytes32 sourceBlockchainID, address originSenderAddress, bytes message) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) ReceiveTeleporterMessage(sourceBlockchainID [32]byte, originSenderAddress common.Address, message []byte) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.ReceiveTeleporterMessage(&_ERC721Destination.TransactOpts, sourceBlockchainID, originSenderAddress, message)
+}
+
+// RegisterWithSource is a paid mutator transaction binding the contract method 0x151637f6.
+//
+// Solidity: function registerWithSource((address,uint256) feeInfo) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) RegisterWithSource(opts *bind.TransactOpts, feeInfo TeleporterFeeInfo) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "registerWithSource", feeInfo)
+}
+
+// RegisterWithSource is a paid mutator transaction binding the contract method 0x151637f6.
+//
+// Solidity: function registerWithSource((address,uint256) feeInfo) returns()
+func (_ERC721Destination *ERC721DestinationSession) RegisterWithSource(feeInfo TeleporterFeeInfo) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.RegisterWithSource(&_ERC721Destination.TransactOpts, feeInfo)
+}
+
+// RegisterWithSource is a paid mutator transaction binding the contract method 0x151637f6.
+//
+// Solidity: function registerWithSource((address,uint256) feeInfo) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) RegisterWithSource(feeInfo TeleporterFeeInfo) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.RegisterWithSource(&_ERC721Destination.TransactOpts, feeInfo)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ERC721Destination *ERC721DestinationTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ERC721Destination *ERC721DestinationSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ERC721Destination.Contract.RenounceOwnership(&_ERC721Destination.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ERC721Destination.Contract.RenounceOwnership(&_ERC721Destination.TransactOpts)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.SafeTransferFrom(&_ERC721Destination.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.SafeTransferFrom(&_ERC721Destination.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC721Destination *ERC721DestinationSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.SafeTransferFrom0(&_ERC721Destination.TransactOpts, from, to, tokenId, data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes data) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, data []byte) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.SafeTransferFrom0(&_ERC721Destination.TransactOpts, from, to, tokenId, data)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x5d16225d.
+//
+// Solidity: function send((bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) Send(opts *bind.TransactOpts, input SendTokensInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "send", input, tokenId)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x5d16225d.
+//
+// Solidity: function send((bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationSession) Send(input SendTokensInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.Send(&_ERC721Destination.TransactOpts, input, tokenId)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x5d16225d.
+//
+// Solidity: function send((bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) Send(input SendTokensInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.Send(&_ERC721Destination.TransactOpts, input, tokenId)
+}
+
+// SendAndCall is a paid mutator transaction binding the contract method 0x65690038.
+//
+// Solidity: function sendAndCall((bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) SendAndCall(opts *bind.TransactOpts, input SendAndCallInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "sendAndCall", input, tokenId)
+}
+
+// SendAndCall is a paid mutator transaction binding the contract method 0x65690038.
+//
+// Solidity: function sendAndCall((bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationSession) SendAndCall(input SendAndCallInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.SendAndCall(&_ERC721Destination.TransactOpts, input, tokenId)
+}
+
+// SendAndCall is a paid mutator transaction binding the contract method 0x65690038.
+//
+// Solidity: function sendAndCall((bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) SendAndCall(input SendAndCallInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.SendAndCall(&_ERC721Destination.TransactOpts, input, tokenId)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721Destination *ERC721DestinationSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.SetApprovalForAll(&_ERC721Destination.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.SetApprovalForAll(&_ERC721Destination.TransactOpts, operator, approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.TransferFrom(&_ERC721Destination.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.TransferFrom(&_ERC721Destination.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ERC721Destination *ERC721DestinationSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.TransferOwnership(&_ERC721Destination.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.TransferOwnership(&_ERC721Destination.TransactOpts, newOwner)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC721Destination *ERC721DestinationTransactor) UnpauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "unpauseSends")
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC721Destination *ERC721DestinationSession) UnpauseSends() (*types.Transaction, error) {
+	return _ERC721Destination.Contract.UnpauseSends(&_ERC721Destination.TransactOpts)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) UnpauseSends() (*types.Transaction, error) {
+	return _ERC721Destination.Contract.UnpauseSends(&_ERC721Destination.TransactOpts)
+}
+
+// UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
+//
+// Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) UnpauseTeleporterAddress(opts *bind.TransactOpts, teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "unpauseTeleporterAddress", teleporterAddress)
+}
+
+// UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
+//
+// Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Destination *ERC721DestinationSession) UnpauseTeleporterAddress(teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.UnpauseTeleporterAddress(&_ERC721Destination.TransactOpts, teleporterAddress)
+}
+
+// UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
+//
+// Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) UnpauseTeleporterAddress(teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.UnpauseTeleporterAddress(&_ERC721Destination.TransactOpts, teleporterAddress)
+}
+
+// UpdateMinTeleporterVersion is a paid mutator transaction binding the contract method 0x5eb99514.
+//
+// Solidity: function updateMinTeleporterVersion(uint256 version) returns()
+func (_ERC721Destination *ERC721DestinationTransactor) UpdateMinTeleporterVersion(opts *bind.TransactOpts, version *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.contract.Transact(opts, "updateMinTeleporterVersion", version)
+}
+
+// UpdateMinTeleporterVersion is a paid mutator transaction binding the contract method 0x5eb99514.
+//
+// Solidity: function updateMinTeleporterVersion(uint256 version) returns()
+func (_ERC721Destination *ERC721DestinationSession) UpdateMinTeleporterVersion(version *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.UpdateMinTeleporterVersion(&_ERC721Destination.TransactOpts, version)
+}
+
+// UpdateMinTeleporterVersion is a paid mutator transaction binding the contract method 0x5eb99514.
+//
+// Solidity: function updateMinTeleporterVersion(uint256 version) returns()
+func (_ERC721Destination *ERC721DestinationTransactorSession) UpdateMinTeleporterVersion(version *big.Int) (*types.Transaction, error) {
+	return _ERC721Destination.Contract.UpdateMinTeleporterVersion(&_ERC721Destination.TransactOpts, version)
+}
+
+// ERC721DestinationApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the ERC721Destination contract.
+type ERC721DestinationApprovalIterator struct {
+	Event *ERC721DestinationApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationApproval represents a Approval event raised by the ERC721Destination contract.
+type ERC721DestinationApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*ERC721DestinationApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationApprovalIterator{contract: _ERC721Destination.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *ERC721DestinationApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationApproval)
+				if err := _ERC721Destination.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseApproval(log types.Log) (*ERC721DestinationApproval, error) {
+	event := new(ERC721DestinationApproval)
+	if err := _ERC721Destination.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the ERC721Destination contract.
+type ERC721DestinationApprovalForAllIterator struct {
+	Event *ERC721DestinationApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationApprovalForAll represents a ApprovalForAll event raised by the ERC721Destination contract.
+type ERC721DestinationApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*ERC721DestinationApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationApprovalForAllIterator{contract: _ERC721Destination.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *ERC721DestinationApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationApprovalForAll)
+				if err := _ERC721Destination.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseApprovalForAll(log types.Log) (*ERC721DestinationApprovalForAll, error) {
+	event := new(ERC721DestinationApprovalForAll)
+	if err := _ERC721Destination.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationCallFailedIterator is returned from FilterCallFailed and is used to iterate over the raw logs and unpacked data for CallFailed events raised by the ERC721Destination contract.
+type ERC721DestinationCallFailedIterator struct {
+	Event *ERC721DestinationCallFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationCallFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationCallFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationCallFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationCallFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationCallFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationCallFailed represents a CallFailed event raised by the ERC721Destination contract.
+type ERC721DestinationCallFailed struct {
+	RecipientContract common.Address
+	TokenId           *big.Int
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterCallFailed is a free log retrieval operation binding the contract event 0xb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb0.
+//
+// Solidity: event CallFailed(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterCallFailed(opts *bind.FilterOpts, recipientContract []common.Address) (*ERC721DestinationCallFailedIterator, error) {
+
+	var recipientContractRule []interface{}
+	for _, recipientContractItem := range recipientContract {
+		recipientContractRule = append(recipientContractRule, recipientContractItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "CallFailed", recipientContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationCallFailedIterator{contract: _ERC721Destination.contract, event: "CallFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchCallFailed is a free log subscription operation binding the contract event 0xb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb0.
+//
+// Solidity: event CallFailed(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchCallFailed(opts *bind.WatchOpts, sink chan<- *ERC721DestinationCallFailed, recipientContract []common.Address) (event.Subscription, error) {
+
+	var recipientContractRule []interface{}
+	for _, recipientContractItem := range recipientContract {
+		recipientContractRule = append(recipientContractRule, recipientContractItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "CallFailed", recipientContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationCallFailed)
+				if err := _ERC721Destination.contract.UnpackLog(event, "CallFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCallFailed is a log parse operation binding the contract event 0xb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb0.
+//
+// Solidity: event CallFailed(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseCallFailed(log types.Log) (*ERC721DestinationCallFailed, error) {
+	event := new(ERC721DestinationCallFailed)
+	if err := _ERC721Destination.contract.UnpackLog(event, "CallFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationCallSucceededIterator is returned from FilterCallSucceeded and is used to iterate over the raw logs and unpacked data for CallSucceeded events raised by the ERC721Destination contract.
+type ERC721DestinationCallSucceededIterator struct {
+	Event *ERC721DestinationCallSucceeded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationCallSucceededIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationCallSucceeded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationCallSucceeded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationCallSucceededIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationCallSucceededIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationCallSucceeded represents a CallSucceeded event raised by the ERC721Destination contract.
+type ERC721DestinationCallSucceeded struct {
+	RecipientContract common.Address
+	TokenId           *big.Int
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterCallSucceeded is a free log retrieval operation binding the contract event 0x104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff4.
+//
+// Solidity: event CallSucceeded(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterCallSucceeded(opts *bind.FilterOpts, recipientContract []common.Address) (*ERC721DestinationCallSucceededIterator, error) {
+
+	var recipientContractRule []interface{}
+	for _, recipientContractItem := range recipientContract {
+		recipientContractRule = append(recipientContractRule, recipientContractItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "CallSucceeded", recipientContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationCallSucceededIterator{contract: _ERC721Destination.contract, event: "CallSucceeded", logs: logs, sub: sub}, nil
+}
+
+// WatchCallSucceeded is a free log subscription operation binding the contract event 0x104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff4.
+//
+// Solidity: event CallSucceeded(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchCallSucceeded(opts *bind.WatchOpts, sink chan<- *ERC721DestinationCallSucceeded, recipientContract []common.Address) (event.Subscription, error) {
+
+	var recipientContractRule []interface{}
+	for _, recipientContractItem := range recipientContract {
+		recipientContractRule = append(recipientContractRule, recipientContractItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "CallSucceeded", recipientContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationCallSucceeded)
+				if err := _ERC721Destination.contract.UnpackLog(event, "CallSucceeded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCallSucceeded is a log parse operation binding the contract event 0x104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff4.
+//
+// Solidity: event CallSucceeded(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseCallSucceeded(log types.Log) (*ERC721DestinationCallSucceeded, error) {
+	event := new(ERC721DestinationCallSucceeded)
+	if err := _ERC721Destination.contract.UnpackLog(event, "CallSucceeded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationMinTeleporterVersionUpdatedIterator is returned from FilterMinTeleporterVersionUpdated and is used to iterate over the raw logs and unpacked data for MinTeleporterVersionUpdated events raised by the ERC721Destination contract.
+type ERC721DestinationMinTeleporterVersionUpdatedIterator struct {
+	Event *ERC721DestinationMinTeleporterVersionUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationMinTeleporterVersionUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationMinTeleporterVersionUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationMinTeleporterVersionUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationMinTeleporterVersionUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationMinTeleporterVersionUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationMinTeleporterVersionUpdated represents a MinTeleporterVersionUpdated event raised by the ERC721Destination contract.
+type ERC721DestinationMinTeleporterVersionUpdated struct {
+	OldMinTeleporterVersion *big.Int
+	NewMinTeleporterVersion *big.Int
+	Raw                     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMinTeleporterVersionUpdated is a free log retrieval operation binding the contract event 0xa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d.
+//
+// Solidity: event MinTeleporterVersionUpdated(uint256 indexed oldMinTeleporterVersion, uint256 indexed newMinTeleporterVersion)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterMinTeleporterVersionUpdated(opts *bind.FilterOpts, oldMinTeleporterVersion []*big.Int, newMinTeleporterVersion []*big.Int) (*ERC721DestinationMinTeleporterVersionUpdatedIterator, error) {
+
+	var oldMinTeleporterVersionRule []interface{}
+	for _, oldMinTeleporterVersionItem := range oldMinTeleporterVersion {
+		oldMinTeleporterVersionRule = append(oldMinTeleporterVersionRule, oldMinTeleporterVersionItem)
+	}
+	var newMinTeleporterVersionRule []interface{}
+	for _, newMinTeleporterVersionItem := range newMinTeleporterVersion {
+		newMinTeleporterVersionRule = append(newMinTeleporterVersionRule, newMinTeleporterVersionItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "MinTeleporterVersionUpdated", oldMinTeleporterVersionRule, newMinTeleporterVersionRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationMinTeleporterVersionUpdatedIterator{contract: _ERC721Destination.contract, event: "MinTeleporterVersionUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchMinTeleporterVersionUpdated is a free log subscription operation binding the contract event 0xa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d.
+//
+// Solidity: event MinTeleporterVersionUpdated(uint256 indexed oldMinTeleporterVersion, uint256 indexed newMinTeleporterVersion)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchMinTeleporterVersionUpdated(opts *bind.WatchOpts, sink chan<- *ERC721DestinationMinTeleporterVersionUpdated, oldMinTeleporterVersion []*big.Int, newMinTeleporterVersion []*big.Int) (event.Subscription, error) {
+
+	var oldMinTeleporterVersionRule []interface{}
+	for _, oldMinTeleporterVersionItem := range oldMinTeleporterVersion {
+		oldMinTeleporterVersionRule = append(oldMinTeleporterVersionRule, oldMinTeleporterVersionItem)
+	}
+	var newMinTeleporterVersionRule []interface{}
+	for _, newMinTeleporterVersionItem := range newMinTeleporterVersion {
+		newMinTeleporterVersionRule = append(newMinTeleporterVersionRule, newMinTeleporterVersionItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "MinTeleporterVersionUpdated", oldMinTeleporterVersionRule, newMinTeleporterVersionRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationMinTeleporterVersionUpdated)
+				if err := _ERC721Destination.contract.UnpackLog(event, "MinTeleporterVersionUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMinTeleporterVersionUpdated is a log parse operation binding the contract event 0xa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d.
+//
+// Solidity: event MinTeleporterVersionUpdated(uint256 indexed oldMinTeleporterVersion, uint256 indexed newMinTeleporterVersion)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseMinTeleporterVersionUpdated(log types.Log) (*ERC721DestinationMinTeleporterVersionUpdated, error) {
+	event := new(ERC721DestinationMinTeleporterVersionUpdated)
+	if err := _ERC721Destination.contract.UnpackLog(event, "MinTeleporterVersionUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ERC721Destination contract.
+type ERC721DestinationOwnershipTransferredIterator struct {
+	Event *ERC721DestinationOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationOwnershipTransferred represents a OwnershipTransferred event raised by the ERC721Destination contract.
+type ERC721DestinationOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ERC721DestinationOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationOwnershipTransferredIterator{contract: _ERC721Destination.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ERC721DestinationOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationOwnershipTransferred)
+				if err := _ERC721Destination.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseOwnershipTransferred(log types.Log) (*ERC721DestinationOwnershipTransferred, error) {
+	event := new(ERC721DestinationOwnershipTransferred)
+	if err := _ERC721Destination.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationSendsPausedIterator is returned from FilterSendsPaused and is used to iterate over the raw logs and unpacked data for SendsPaused events raised by the ERC721Destination contract.
+type ERC721DestinationSendsPausedIterator struct {
+	Event *ERC721DestinationSendsPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationSendsPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationSendsPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationSendsPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationSendsPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationSendsPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationSendsPaused represents a SendsPaused event raised by the ERC721Destination contract.
+type ERC721DestinationSendsPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsPaused is a free log retrieval operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterSendsPaused(opts *bind.FilterOpts, account []common.Address) (*ERC721DestinationSendsPausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationSendsPausedIterator{contract: _ERC721Destination.contract, event: "SendsPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsPaused is a free log subscription operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchSendsPaused(opts *bind.WatchOpts, sink chan<- *ERC721DestinationSendsPaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationSendsPaused)
+				if err := _ERC721Destination.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsPaused is a log parse operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseSendsPaused(log types.Log) (*ERC721DestinationSendsPaused, error) {
+	event := new(ERC721DestinationSendsPaused)
+	if err := _ERC721Destination.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationSendsUnpausedIterator is returned from FilterSendsUnpaused and is used to iterate over the raw logs and unpacked data for SendsUnpaused events raised by the ERC721Destination contract.
+type ERC721DestinationSendsUnpausedIterator struct {
+	Event *ERC721DestinationSendsUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationSendsUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationSendsUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationSendsUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationSendsUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationSendsUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationSendsUnpaused represents a SendsUnpaused event raised by the ERC721Destination contract.
+type ERC721DestinationSendsUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsUnpaused is a free log retrieval operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterSendsUnpaused(opts *bind.FilterOpts, account []common.Address) (*ERC721DestinationSendsUnpausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationSendsUnpausedIterator{contract: _ERC721Destination.contract, event: "SendsUnpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsUnpaused is a free log subscription operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchSendsUnpaused(opts *bind.WatchOpts, sink chan<- *ERC721DestinationSendsUnpaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationSendsUnpaused)
+				if err := _ERC721Destination.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsUnpaused is a log parse operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseSendsUnpaused(log types.Log) (*ERC721DestinationSendsUnpaused, error) {
+	event := new(ERC721DestinationSendsUnpaused)
+	if err := _ERC721Destination.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the ERC721Destination contract.
+type ERC721DestinationTeleporterAddressPausedIterator struct {
+	Event *ERC721DestinationTeleporterAddressPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationTeleporterAddressPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationTeleporterAddressPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationTeleporterAddressPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationTeleporterAddressPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationTeleporterAddressPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationTeleporterAddressPaused represents a TeleporterAddressPaused event raised by the ERC721Destination contract.
+type ERC721DestinationTeleporterAddressPaused struct {
+	TeleporterAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterTeleporterAddressPaused is a free log retrieval operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterTeleporterAddressPaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*ERC721DestinationTeleporterAddressPausedIterator, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationTeleporterAddressPausedIterator{contract: _ERC721Destination.contract, event: "TeleporterAddressPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchTeleporterAddressPaused is a free log subscription operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchTeleporterAddressPaused(opts *bind.WatchOpts, sink chan<- *ERC721DestinationTeleporterAddressPaused, teleporterAddress []common.Address) (event.Subscription, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationTeleporterAddressPaused)
+				if err := _ERC721Destination.contract.UnpackLog(event, "TeleporterAddressPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTeleporterAddressPaused is a log parse operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseTeleporterAddressPaused(log types.Log) (*ERC721DestinationTeleporterAddressPaused, error) {
+	event := new(ERC721DestinationTeleporterAddressPaused)
+	if err := _ERC721Destination.contract.UnpackLog(event, "TeleporterAddressPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationTeleporterAddressUnpausedIterator is returned from FilterTeleporterAddressUnpaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressUnpaused events raised by the ERC721Destination contract.
+type ERC721DestinationTeleporterAddressUnpausedIterator struct {
+	Event *ERC721DestinationTeleporterAddressUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationTeleporterAddressUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationTeleporterAddressUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationTeleporterAddressUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationTeleporterAddressUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationTeleporterAddressUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationTeleporterAddressUnpaused represents a TeleporterAddressUnpaused event raised by the ERC721Destination contract.
+type ERC721DestinationTeleporterAddressUnpaused struct {
+	TeleporterAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterTeleporterAddressUnpaused is a free log retrieval operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterTeleporterAddressUnpaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*ERC721DestinationTeleporterAddressUnpausedIterator, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "TeleporterAddressUnpaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationTeleporterAddressUnpausedIterator{contract: _ERC721Destination.contract, event: "TeleporterAddressUnpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchTeleporterAddressUnpaused is a free log subscription operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchTeleporterAddressUnpaused(opts *bind.WatchOpts, sink chan<- *ERC721DestinationTeleporterAddressUnpaused, teleporterAddress []common.Address) (event.Subscription, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "TeleporterAddressUnpaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationTeleporterAddressUnpaused)
+				if err := _ERC721Destination.contract.UnpackLog(event, "TeleporterAddressUnpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTeleporterAddressUnpaused is a log parse operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseTeleporterAddressUnpaused(log types.Log) (*ERC721DestinationTeleporterAddressUnpaused, error) {
+	event := new(ERC721DestinationTeleporterAddressUnpaused)
+	if err := _ERC721Destination.contract.UnpackLog(event, "TeleporterAddressUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationTokenAndCallSentIterator is returned from FilterTokenAndCallSent and is used to iterate over the raw logs and unpacked data for TokenAndCallSent events raised by the ERC721Destination contract.
+type ERC721DestinationTokenAndCallSentIterator struct {
+	Event *ERC721DestinationTokenAndCallSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationTokenAndCallSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationTokenAndCallSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationTokenAndCallSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationTokenAndCallSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationTokenAndCallSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationTokenAndCallSent represents a TokenAndCallSent event raised by the ERC721Destination contract.
+type ERC721DestinationTokenAndCallSent struct {
+	TeleporterMessageID [32]byte
+	Sender              common.Address
+	Input               SendAndCallInput
+	TokenId             *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenAndCallSent is a free log retrieval operation binding the contract event 0x33312f1dee191e43139267f3f784f9f00f8cb3a19683961a6b0c277a1bd16165.
+//
+// Solidity: event TokenAndCallSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterTokenAndCallSent(opts *bind.FilterOpts, teleporterMessageID [][32]byte, sender []common.Address) (*ERC721DestinationTokenAndCallSentIterator, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "TokenAndCallSent", teleporterMessageIDRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationTokenAndCallSentIterator{contract: _ERC721Destination.contract, event: "TokenAndCallSent", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenAndCallSent is a free log subscription operation binding the contract event 0x33312f1dee191e43139267f3f784f9f00f8cb3a19683961a6b0c277a1bd16165.
+//
+// Solidity: event TokenAndCallSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchTokenAndCallSent(opts *bind.WatchOpts, sink chan<- *ERC721DestinationTokenAndCallSent, teleporterMessageID [][32]byte, sender []common.Address) (event.Subscription, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "TokenAndCallSent", teleporterMessageIDRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationTokenAndCallSent)
+				if err := _ERC721Destination.contract.UnpackLog(event, "TokenAndCallSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenAndCallSent is a log parse operation binding the contract event 0x33312f1dee191e43139267f3f784f9f00f8cb3a19683961a6b0c277a1bd16165.
+//
+// Solidity: event TokenAndCallSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseTokenAndCallSent(log types.Log) (*ERC721DestinationTokenAndCallSent, error) {
+	event := new(ERC721DestinationTokenAndCallSent)
+	if err := _ERC721Destination.contract.UnpackLog(event, "TokenAndCallSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationTokenSentIterator is returned from FilterTokenSent and is used to iterate over the raw logs and unpacked data for TokenSent events raised by the ERC721Destination contract.
+type ERC721DestinationTokenSentIterator struct {
+	Event *ERC721DestinationTokenSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationTokenSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationTokenSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationTokenSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationTokenSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationTokenSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationTokenSent represents a TokenSent event raised by the ERC721Destination contract.
+type ERC721DestinationTokenSent struct {
+	TeleporterMessageID [32]byte
+	Sender              common.Address
+	Input               SendTokensInput
+	TokenId             *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenSent is a free log retrieval operation binding the contract event 0x55b835c46a86c474ba8722033b77f5286254a69ebeab26f240ffc80656dcaaab.
+//
+// Solidity: event TokenSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterTokenSent(opts *bind.FilterOpts, teleporterMessageID [][32]byte, sender []common.Address) (*ERC721DestinationTokenSentIterator, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "TokenSent", teleporterMessageIDRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationTokenSentIterator{contract: _ERC721Destination.contract, event: "TokenSent", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenSent is a free log subscription operation binding the contract event 0x55b835c46a86c474ba8722033b77f5286254a69ebeab26f240ffc80656dcaaab.
+//
+// Solidity: event TokenSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchTokenSent(opts *bind.WatchOpts, sink chan<- *ERC721DestinationTokenSent, teleporterMessageID [][32]byte, sender []common.Address) (event.Subscription, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "TokenSent", teleporterMessageIDRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationTokenSent)
+				if err := _ERC721Destination.contract.UnpackLog(event, "TokenSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenSent is a log parse operation binding the contract event 0x55b835c46a86c474ba8722033b77f5286254a69ebeab26f240ffc80656dcaaab.
+//
+// Solidity: event TokenSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseTokenSent(log types.Log) (*ERC721DestinationTokenSent, error) {
+	event := new(ERC721DestinationTokenSent)
+	if err := _ERC721Destination.contract.UnpackLog(event, "TokenSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationTokenWithdrawnIterator is returned from FilterTokenWithdrawn and is used to iterate over the raw logs and unpacked data for TokenWithdrawn events raised by the ERC721Destination contract.
+type ERC721DestinationTokenWithdrawnIterator struct {
+	Event *ERC721DestinationTokenWithdrawn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationTokenWithdrawnIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationTokenWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationTokenWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationTokenWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationTokenWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationTokenWithdrawn represents a TokenWithdrawn event raised by the ERC721Destination contract.
+type ERC721DestinationTokenWithdrawn struct {
+	Recipient common.Address
+	TokenId   *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenWithdrawn is a free log retrieval operation binding the contract event 0xa2bd9fcfcdba69f52bcd9a520846ad4bd685b187483f53efc42d035b2ddebff0.
+//
+// Solidity: event TokenWithdrawn(address indexed recipient, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterTokenWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*ERC721DestinationTokenWithdrawnIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "TokenWithdrawn", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationTokenWithdrawnIterator{contract: _ERC721Destination.contract, event: "TokenWithdrawn", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenWithdrawn is a free log subscription operation binding the contract event 0xa2bd9fcfcdba69f52bcd9a520846ad4bd685b187483f53efc42d035b2ddebff0.
+//
+// Solidity: event TokenWithdrawn(address indexed recipient, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchTokenWithdrawn(opts *bind.WatchOpts, sink chan<- *ERC721DestinationTokenWithdrawn, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "TokenWithdrawn", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationTokenWithdrawn)
+				if err := _ERC721Destination.contract.UnpackLog(event, "TokenWithdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenWithdrawn is a log parse operation binding the contract event 0xa2bd9fcfcdba69f52bcd9a520846ad4bd685b187483f53efc42d035b2ddebff0.
+//
+// Solidity: event TokenWithdrawn(address indexed recipient, uint256 tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseTokenWithdrawn(log types.Log) (*ERC721DestinationTokenWithdrawn, error) {
+	event := new(ERC721DestinationTokenWithdrawn)
+	if err := _ERC721Destination.contract.UnpackLog(event, "TokenWithdrawn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721DestinationTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the ERC721Destination contract.
+type ERC721DestinationTransferIterator struct {
+	Event *ERC721DestinationTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721DestinationTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721DestinationTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721DestinationTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721DestinationTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721DestinationTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721DestinationTransfer represents a Transfer event raised by the ERC721Destination contract.
+type ERC721DestinationTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*ERC721DestinationTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721DestinationTransferIterator{contract: _ERC721Destination.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *ERC721DestinationTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _ERC721Destination.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721DestinationTransfer)
+				if err := _ERC721Destination.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_ERC721Destination *ERC721DestinationFilterer) ParseTransfer(log types.Log) (*ERC721DestinationTransfer, error) {
+	event := new(ERC721DestinationTransfer)
+	if err := _ERC721Destination.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
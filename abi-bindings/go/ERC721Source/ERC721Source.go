@@ -0,0 +1,2783 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package erc721source
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = interfaces.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SendAndCallInput is an auto generated low-level Go binding around an user-defined struct.
+type SendAndCallInput struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+	RecipientContract        common.Address
+	RecipientPayload         []byte
+	RequiredGasLimit         *big.Int
+	RecipientGasLimit        *big.Int
+	MultiHopFallback         common.Address
+	FallbackRecipient        common.Address
+	PrimaryFeeTokenAddress   common.Address
+	PrimaryFee               *big.Int
+	SecondaryFee             *big.Int
+}
+
+// SendTokensInput is an auto generated low-level Go binding around an user-defined struct.
+type SendTokensInput struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+	Recipient                common.Address
+	PrimaryFeeTokenAddress   common.Address
+	PrimaryFee               *big.Int
+	SecondaryFee             *big.Int
+	RequiredGasLimit         *big.Int
+	MultiHopFallback         common.Address
+}
+
+// ERC721SourceMetaData contains all meta data concerning the ERC721Source contract.
+var ERC721SourceMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterRegistryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"teleporterManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"tokenAddress_\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"DestinationRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"TokenAndCallRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"TokenAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"TokenRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"TokenSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"TokenWithdrawn\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"bridgedTokens\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"registeredDestinations\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"registered\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"sendAndCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sendsPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// ERC721SourceABI is the input ABI used to generate the binding from.
+// Deprecated: Use ERC721SourceMetaData.ABI instead.
+var ERC721SourceABI = ERC721SourceMetaData.ABI
+
+// ERC721Source is an auto generated Go binding around an Ethereum contract.
+type ERC721Source struct {
+	ERC721SourceCaller     // Read-only binding to the contract
+	ERC721SourceTransactor // Write-only binding to the contract
+	ERC721SourceFilterer   // Log filterer for contract events
+}
+
+// ERC721SourceCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ERC721SourceCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721SourceTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ERC721SourceTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721SourceFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ERC721SourceFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ERC721SourceSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ERC721SourceSession struct {
+	Contract     *ERC721Source     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ERC721SourceCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ERC721SourceCallerSession struct {
+	Contract *ERC721SourceCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// ERC721SourceTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ERC721SourceTransactorSession struct {
+	Contract     *ERC721SourceTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// ERC721SourceRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ERC721SourceRaw struct {
+	Contract *ERC721Source // Generic contract binding to access the raw methods on
+}
+
+// ERC721SourceCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ERC721SourceCallerRaw struct {
+	Contract *ERC721SourceCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ERC721SourceTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ERC721SourceTransactorRaw struct {
+	Contract *ERC721SourceTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewERC721Source creates a new instance of ERC721Source, bound to a specific deployed contract.
+func NewERC721Source(address common.Address, backend bind.ContractBackend) (*ERC721Source, error) {
+	contract, err := bindERC721Source(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721Source{ERC721SourceCaller: ERC721SourceCaller{contract: contract}, ERC721SourceTransactor: ERC721SourceTransactor{contract: contract}, ERC721SourceFilterer: ERC721SourceFilterer{contract: contract}}, nil
+}
+
+// NewERC721SourceCaller creates a new read-only instance of ERC721Source, bound to a specific deployed contract.
+func NewERC721SourceCaller(address common.Address, caller bind.ContractCaller) (*ERC721SourceCaller, error) {
+	contract, err := bindERC721Source(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceCaller{contract: contract}, nil
+}
+
+// NewERC721SourceTransactor creates a new write-only instance of ERC721Source, bound to a specific deployed contract.
+func NewERC721SourceTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC721SourceTransactor, error) {
+	contract, err := bindERC721Source(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceTransactor{contract: contract}, nil
+}
+
+// NewERC721SourceFilterer creates a new log filterer instance of ERC721Source, bound to a specific deployed contract.
+func NewERC721SourceFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC721SourceFilterer, error) {
+	contract, err := bindERC721Source(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceFilterer{contract: contract}, nil
+}
+
+// bindERC721Source binds a generic wrapper to an already deployed contract.
+func bindERC721Source(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ERC721SourceMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC721Source *ERC721SourceRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721Source.Contract.ERC721SourceCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC721Source *ERC721SourceRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Source.Contract.ERC721SourceTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC721Source *ERC721SourceRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721Source.Contract.ERC721SourceTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ERC721Source *ERC721SourceCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ERC721Source.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ERC721Source *ERC721SourceTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Source.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ERC721Source *ERC721SourceTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ERC721Source.Contract.contract.Transact(opts, method, params...)
+}
+
+// BlockchainID is a free data retrieval call binding the contract method 0xd127dc9b.
+//
+// Solidity: function blockchainID() view returns(bytes32)
+func (_ERC721Source *ERC721SourceCaller) BlockchainID(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "blockchainID")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BlockchainID is a free data retrieval call binding the contract method 0xd127dc9b.
+//
+// Solidity: function blockchainID() view returns(bytes32)
+func (_ERC721Source *ERC721SourceSession) BlockchainID() ([32]byte, error) {
+	return _ERC721Source.Contract.BlockchainID(&_ERC721Source.CallOpts)
+}
+
+// BlockchainID is a free data retrieval call binding the contract method 0xd127dc9b.
+//
+// Solidity: function blockchainID() view returns(bytes32)
+func (_ERC721Source *ERC721SourceCallerSession) BlockchainID() ([32]byte, error) {
+	return _ERC721Source.Contract.BlockchainID(&_ERC721Source.CallOpts)
+}
+
+// BridgedTokens is a free data retrieval call binding the contract method 0xc409b8f9.
+//
+// Solidity: function bridgedTokens(uint256 tokenId) view returns(bytes32 destinationBlockchainID, address destinationBridgeAddress)
+func (_ERC721Source *ERC721SourceCaller) BridgedTokens(opts *bind.CallOpts, tokenId *big.Int) (struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+}, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "bridgedTokens", tokenId)
+
+	outstruct := new(struct {
+		DestinationBlockchainID  [32]byte
+		DestinationBridgeAddress common.Address
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.DestinationBlockchainID = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.DestinationBridgeAddress = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+
+	return *outstruct, err
+
+}
+
+// BridgedTokens is a free data retrieval call binding the contract method 0xc409b8f9.
+//
+// Solidity: function bridgedTokens(uint256 tokenId) view returns(bytes32 destinationBlockchainID, address destinationBridgeAddress)
+func (_ERC721Source *ERC721SourceSession) BridgedTokens(tokenId *big.Int) (struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+}, error) {
+	return _ERC721Source.Contract.BridgedTokens(&_ERC721Source.CallOpts, tokenId)
+}
+
+// BridgedTokens is a free data retrieval call binding the contract method 0xc409b8f9.
+//
+// Solidity: function bridgedTokens(uint256 tokenId) view returns(bytes32 destinationBlockchainID, address destinationBridgeAddress)
+func (_ERC721Source *ERC721SourceCallerSession) BridgedTokens(tokenId *big.Int) (struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+}, error) {
+	return _ERC721Source.Contract.BridgedTokens(&_ERC721Source.CallOpts, tokenId)
+}
+
+// GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
+//
+// Solidity: function getMinTeleporterVersion() view returns(uint256)
+func (_ERC721Source *ERC721SourceCaller) GetMinTeleporterVersion(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "getMinTeleporterVersion")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
+//
+// Solidity: function getMinTeleporterVersion() view returns(uint256)
+func (_ERC721Source *ERC721SourceSession) GetMinTeleporterVersion() (*big.Int, error) {
+	return _ERC721Source.Contract.GetMinTeleporterVersion(&_ERC721Source.CallOpts)
+}
+
+// GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
+//
+// Solidity: function getMinTeleporterVersion() view returns(uint256)
+func (_ERC721Source *ERC721SourceCallerSession) GetMinTeleporterVersion() (*big.Int, error) {
+	return _ERC721Source.Contract.GetMinTeleporterVersion(&_ERC721Source.CallOpts)
+}
+
+// IsTeleporterAddressPaused is a free data retrieval call binding the contract method 0x97314297.
+//
+// Solidity: function isTeleporterAddressPaused(address teleporterAddress) view returns(bool)
+func (_ERC721Source *ERC721SourceCaller) IsTeleporterAddressPaused(opts *bind.CallOpts, teleporterAddress common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "isTeleporterAddressPaused", teleporterAddress)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsTeleporterAddressPaused is a free data retrieval call binding the contract method 0x97314297.
+//
+// Solidity: function isTeleporterAddressPaused(address teleporterAddress) view returns(bool)
+func (_ERC721Source *ERC721SourceSession) IsTeleporterAddressPaused(teleporterAddress common.Address) (bool, error) {
+	return _ERC721Source.Contract.IsTeleporterAddressPaused(&_ERC721Source.CallOpts, teleporterAddress)
+}
+
+// IsTeleporterAddressPaused is a free data retrieval call binding the contract method 0x97314297.
+//
+// Solidity: function isTeleporterAddressPaused(address teleporterAddress) view returns(bool)
+func (_ERC721Source *ERC721SourceCallerSession) IsTeleporterAddressPaused(teleporterAddress common.Address) (bool, error) {
+	return _ERC721Source.Contract.IsTeleporterAddressPaused(&_ERC721Source.CallOpts, teleporterAddress)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ERC721Source *ERC721SourceCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ERC721Source *ERC721SourceSession) Owner() (common.Address, error) {
+	return _ERC721Source.Contract.Owner(&_ERC721Source.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_ERC721Source *ERC721SourceCallerSession) Owner() (common.Address, error) {
+	return _ERC721Source.Contract.Owner(&_ERC721Source.CallOpts)
+}
+
+// RegisteredDestinations is a free data retrieval call binding the contract method 0xfcfb5ff9.
+//
+// Solidity: function registeredDestinations(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(bool registered)
+func (_ERC721Source *ERC721SourceCaller) RegisteredDestinations(opts *bind.CallOpts, destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (bool, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "registeredDestinations", destinationBlockchainID, destinationBridgeAddress)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// RegisteredDestinations is a free data retrieval call binding the contract method 0xfcfb5ff9.
+//
+// Solidity: function registeredDestinations(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(bool registered)
+func (_ERC721Source *ERC721SourceSession) RegisteredDestinations(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (bool, error) {
+	return _ERC721Source.Contract.RegisteredDestinations(&_ERC721Source.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// RegisteredDestinations is a free data retrieval call binding the contract method 0xfcfb5ff9.
+//
+// Solidity: function registeredDestinations(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(bool registered)
+func (_ERC721Source *ERC721SourceCallerSession) RegisteredDestinations(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (bool, error) {
+	return _ERC721Source.Contract.RegisteredDestinations(&_ERC721Source.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC721Source *ERC721SourceCaller) SendsPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "sendsPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC721Source *ERC721SourceSession) SendsPaused() (bool, error) {
+	return _ERC721Source.Contract.SendsPaused(&_ERC721Source.CallOpts)
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_ERC721Source *ERC721SourceCallerSession) SendsPaused() (bool, error) {
+	return _ERC721Source.Contract.SendsPaused(&_ERC721Source.CallOpts)
+}
+
+// TeleporterRegistry is a free data retrieval call binding the contract method 0x1a7f5bec.
+//
+// Solidity: function teleporterRegistry() view returns(address)
+func (_ERC721Source *ERC721SourceCaller) TeleporterRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "teleporterRegistry")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TeleporterRegistry is a free data retrieval call binding the contract method 0x1a7f5bec.
+//
+// Solidity: function teleporterRegistry() view returns(address)
+func (_ERC721Source *ERC721SourceSession) TeleporterRegistry() (common.Address, error) {
+	return _ERC721Source.Contract.TeleporterRegistry(&_ERC721Source.CallOpts)
+}
+
+// TeleporterRegistry is a free data retrieval call binding the contract method 0x1a7f5bec.
+//
+// Solidity: function teleporterRegistry() view returns(address)
+func (_ERC721Source *ERC721SourceCallerSession) TeleporterRegistry() (common.Address, error) {
+	return _ERC721Source.Contract.TeleporterRegistry(&_ERC721Source.CallOpts)
+}
+
+// TokenAddress is a free data retrieval call binding the contract method 0x9d76ea58.
+//
+// Solidity: function tokenAddress() view returns(address)
+func (_ERC721Source *ERC721SourceCaller) TokenAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ERC721Source.contract.Call(opts, &out, "tokenAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenAddress is a free data retrieval call binding the contract method 0x9d76ea58.
+//
+// Solidity: function tokenAddress() view returns(address)
+func (_ERC721Source *ERC721SourceSession) TokenAddress() (common.Address, error) {
+	return _ERC721Source.Contract.TokenAddress(&_ERC721Source.CallOpts)
+}
+
+// TokenAddress is a free data retrieval call binding the contract method 0x9d76ea58.
+//
+// Solidity: function tokenAddress() view returns(address)
+func (_ERC721Source *ERC721SourceCallerSession) TokenAddress() (common.Address, error) {
+	return _ERC721Source.Contract.TokenAddress(&_ERC721Source.CallOpts)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC721Source *ERC721SourceTransactor) PauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "pauseSends")
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC721Source *ERC721SourceSession) PauseSends() (*types.Transaction, error) {
+	return _ERC721Source.Contract.PauseSends(&_ERC721Source.TransactOpts)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_ERC721Source *ERC721SourceTransactorSession) PauseSends() (*types.Transaction, error) {
+	return _ERC721Source.Contract.PauseSends(&_ERC721Source.TransactOpts)
+}
+
+// PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
+//
+// Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Source *ERC721SourceTransactor) PauseTeleporterAddress(opts *bind.TransactOpts, teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "pauseTeleporterAddress", teleporterAddress)
+}
+
+// PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
+//
+// Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Source *ERC721SourceSession) PauseTeleporterAddress(teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Source.Contract.PauseTeleporterAddress(&_ERC721Source.TransactOpts, teleporterAddress)
+}
+
+// PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
+//
+// Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Source *ERC721SourceTransactorSession) PauseTeleporterAddress(teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Source.Contract.PauseTeleporterAddress(&_ERC721Source.TransactOpts, teleporterAddress)
+}
+
+// ReceiveTeleporterMessage is a paid mutator transaction binding the contract method 0xc868efaa.
+//
+// Solidity: function receiveTeleporterMessage(bytes32 sourceBlockchainID, address originSenderAddress, bytes message) returns()
+func (_ERC721Source *ERC721SourceTransactor) ReceiveTeleporterMessage(opts *bind.TransactOpts, sourceBlockchainID [32]byte, originSenderAddress common.Address, message []byte) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "receiveTeleporterMessage", sourceBlockchainID, originSenderAddress, message)
+}
+
+// ReceiveTeleporterMessage is a paid mutator transaction binding the contract method 0xc868efaa.
+//
+// Solidity: function receiveTeleporterMessage(bytes32 sourceBlockchainID, address originSenderAddress, bytes message) returns()
+func (_ERC721Source *ERC721SourceSession) ReceiveTeleporterMessage(sourceBlockchainID [32]byte, originSenderAddress common.Address, message []byte) (*types.Transaction, error) {
+	return _ERC721Source.Contract.ReceiveTeleporterMessage(&_ERC721Source.TransactOpts, sourceBlockchainID, originSenderAddress, message)
+}
+
+// ReceiveTeleporterMessage is a paid mutator transaction binding the contract method 0xc868efaa.
+//
+// Solidity: function receiveTeleporterMessage(bytes32 sourceBlockchainID, address originSenderAddress, bytes message) returns()
+func (_ERC721Source *ERC721SourceTransactorSession) ReceiveTeleporterMessage(sourceBlockchainID [32]byte, originSenderAddress common.Address, message []byte) (*types.Transaction, error) {
+	return _ERC721Source.Contract.ReceiveTeleporterMessage(&_ERC721Source.TransactOpts, sourceBlockchainID, originSenderAddress, message)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ERC721Source *ERC721SourceTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ERC721Source *ERC721SourceSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ERC721Source.Contract.RenounceOwnership(&_ERC721Source.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_ERC721Source *ERC721SourceTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _ERC721Source.Contract.RenounceOwnership(&_ERC721Source.TransactOpts)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x5d16225d.
+//
+// Solidity: function send((bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId) returns()
+func (_ERC721Source *ERC721SourceTransactor) Send(opts *bind.TransactOpts, input SendTokensInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "send", input, tokenId)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x5d16225d.
+//
+// Solidity: function send((bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId) returns()
+func (_ERC721Source *ERC721SourceSession) Send(input SendTokensInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.Contract.Send(&_ERC721Source.TransactOpts, input, tokenId)
+}
+
+// Send is a paid mutator transaction binding the contract method 0x5d16225d.
+//
+// Solidity: function send((bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId) returns()
+func (_ERC721Source *ERC721SourceTransactorSession) Send(input SendTokensInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.Contract.Send(&_ERC721Source.TransactOpts, input, tokenId)
+}
+
+// SendAndCall is a paid mutator transaction binding the contract method 0x65690038.
+//
+// Solidity: function sendAndCall((bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId) returns()
+func (_ERC721Source *ERC721SourceTransactor) SendAndCall(opts *bind.TransactOpts, input SendAndCallInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "sendAndCall", input, tokenId)
+}
+
+// SendAndCall is a paid mutator transaction binding the contract method 0x65690038.
+//
+// Solidity: function sendAndCall((bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId) returns()
+func (_ERC721Source *ERC721SourceSession) SendAndCall(input SendAndCallInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.Contract.SendAndCall(&_ERC721Source.TransactOpts, input, tokenId)
+}
+
+// SendAndCall is a paid mutator transaction binding the contract method 0x65690038.
+//
+// Solidity: function sendAndCall((bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId) returns()
+func (_ERC721Source *ERC721SourceTransactorSession) SendAndCall(input SendAndCallInput, tokenId *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.Contract.SendAndCall(&_ERC721Source.TransactOpts, input, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ERC721Source *ERC721SourceTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ERC721Source *ERC721SourceSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721Source.Contract.TransferOwnership(&_ERC721Source.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_ERC721Source *ERC721SourceTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _ERC721Source.Contract.TransferOwnership(&_ERC721Source.TransactOpts, newOwner)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC721Source *ERC721SourceTransactor) UnpauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "unpauseSends")
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC721Source *ERC721SourceSession) UnpauseSends() (*types.Transaction, error) {
+	return _ERC721Source.Contract.UnpauseSends(&_ERC721Source.TransactOpts)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_ERC721Source *ERC721SourceTransactorSession) UnpauseSends() (*types.Transaction, error) {
+	return _ERC721Source.Contract.UnpauseSends(&_ERC721Source.TransactOpts)
+}
+
+// UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
+//
+// Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Source *ERC721SourceTransactor) UnpauseTeleporterAddress(opts *bind.TransactOpts, teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "unpauseTeleporterAddress", teleporterAddress)
+}
+
+// UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
+//
+// Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Source *ERC721SourceSession) UnpauseTeleporterAddress(teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Source.Contract.UnpauseTeleporterAddress(&_ERC721Source.TransactOpts, teleporterAddress)
+}
+
+// UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
+//
+// Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
+func (_ERC721Source *ERC721SourceTransactorSession) UnpauseTeleporterAddress(teleporterAddress common.Address) (*types.Transaction, error) {
+	return _ERC721Source.Contract.UnpauseTeleporterAddress(&_ERC721Source.TransactOpts, teleporterAddress)
+}
+
+// UpdateMinTeleporterVersion is a paid mutator transaction binding the contract method 0x5eb99514.
+//
+// Solidity: function updateMinTeleporterVersion(uint256 version) returns()
+func (_ERC721Source *ERC721SourceTransactor) UpdateMinTeleporterVersion(opts *bind.TransactOpts, version *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.contract.Transact(opts, "updateMinTeleporterVersion", version)
+}
+
+// UpdateMinTeleporterVersion is a paid mutator transaction binding the contract method 0x5eb99514.
+//
+// Solidity: function updateMinTeleporterVersion(uint256 version) returns()
+func (_ERC721Source *ERC721SourceSession) UpdateMinTeleporterVersion(version *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.Contract.UpdateMinTeleporterVersion(&_ERC721Source.TransactOpts, version)
+}
+
+// UpdateMinTeleporterVersion is a paid mutator transaction binding the contract method 0x5eb99514.
+//
+// Solidity: function updateMinTeleporterVersion(uint256 version) returns()
+func (_ERC721Source *ERC721SourceTransactorSession) UpdateMinTeleporterVersion(version *big.Int) (*types.Transaction, error) {
+	return _ERC721Source.Contract.UpdateMinTeleporterVersion(&_ERC721Source.TransactOpts, version)
+}
+
+// ERC721SourceCallFailedIterator is returned from FilterCallFailed and is used to iterate over the raw logs and unpacked data for CallFailed events raised by the ERC721Source contract.
+type ERC721SourceCallFailedIterator struct {
+	Event *ERC721SourceCallFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceCallFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceCallFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceCallFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceCallFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceCallFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceCallFailed represents a CallFailed event raised by the ERC721Source contract.
+type ERC721SourceCallFailed struct {
+	RecipientContract common.Address
+	TokenId           *big.Int
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterCallFailed is a free log retrieval operation binding the contract event 0xb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb0.
+//
+// Solidity: event CallFailed(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) FilterCallFailed(opts *bind.FilterOpts, recipientContract []common.Address) (*ERC721SourceCallFailedIterator, error) {
+
+	var recipientContractRule []interface{}
+	for _, recipientContractItem := range recipientContract {
+		recipientContractRule = append(recipientContractRule, recipientContractItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "CallFailed", recipientContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceCallFailedIterator{contract: _ERC721Source.contract, event: "CallFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchCallFailed is a free log subscription operation binding the contract event 0xb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb0.
+//
+// Solidity: event CallFailed(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) WatchCallFailed(opts *bind.WatchOpts, sink chan<- *ERC721SourceCallFailed, recipientContract []common.Address) (event.Subscription, error) {
+
+	var recipientContractRule []interface{}
+	for _, recipientContractItem := range recipientContract {
+		recipientContractRule = append(recipientContractRule, recipientContractItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "CallFailed", recipientContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceCallFailed)
+				if err := _ERC721Source.contract.UnpackLog(event, "CallFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCallFailed is a log parse operation binding the contract event 0xb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb0.
+//
+// Solidity: event CallFailed(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) ParseCallFailed(log types.Log) (*ERC721SourceCallFailed, error) {
+	event := new(ERC721SourceCallFailed)
+	if err := _ERC721Source.contract.UnpackLog(event, "CallFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceCallSucceededIterator is returned from FilterCallSucceeded and is used to iterate over the raw logs and unpacked data for CallSucceeded events raised by the ERC721Source contract.
+type ERC721SourceCallSucceededIterator struct {
+	Event *ERC721SourceCallSucceeded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceCallSucceededIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceCallSucceeded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceCallSucceeded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceCallSucceededIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceCallSucceededIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceCallSucceeded represents a CallSucceeded event raised by the ERC721Source contract.
+type ERC721SourceCallSucceeded struct {
+	RecipientContract common.Address
+	TokenId           *big.Int
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterCallSucceeded is a free log retrieval operation binding the contract event 0x104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff4.
+//
+// Solidity: event CallSucceeded(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) FilterCallSucceeded(opts *bind.FilterOpts, recipientContract []common.Address) (*ERC721SourceCallSucceededIterator, error) {
+
+	var recipientContractRule []interface{}
+	for _, recipientContractItem := range recipientContract {
+		recipientContractRule = append(recipientContractRule, recipientContractItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "CallSucceeded", recipientContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceCallSucceededIterator{contract: _ERC721Source.contract, event: "CallSucceeded", logs: logs, sub: sub}, nil
+}
+
+// WatchCallSucceeded is a free log subscription operation binding the contract event 0x104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff4.
+//
+// Solidity: event CallSucceeded(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) WatchCallSucceeded(opts *bind.WatchOpts, sink chan<- *ERC721SourceCallSucceeded, recipientContract []common.Address) (event.Subscription, error) {
+
+	var recipientContractRule []interface{}
+	for _, recipientContractItem := range recipientContract {
+		recipientContractRule = append(recipientContractRule, recipientContractItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "CallSucceeded", recipientContractRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceCallSucceeded)
+				if err := _ERC721Source.contract.UnpackLog(event, "CallSucceeded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCallSucceeded is a log parse operation binding the contract event 0x104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff4.
+//
+// Solidity: event CallSucceeded(address indexed recipientContract, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) ParseCallSucceeded(log types.Log) (*ERC721SourceCallSucceeded, error) {
+	event := new(ERC721SourceCallSucceeded)
+	if err := _ERC721Source.contract.UnpackLog(event, "CallSucceeded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceDestinationRegisteredIterator is returned from FilterDestinationRegistered and is used to iterate over the raw logs and unpacked data for DestinationRegistered events raised by the ERC721Source contract.
+type ERC721SourceDestinationRegisteredIterator struct {
+	Event *ERC721SourceDestinationRegistered // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceDestinationRegisteredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceDestinationRegistered)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceDestinationRegistered)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceDestinationRegisteredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceDestinationRegisteredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceDestinationRegistered represents a DestinationRegistered event raised by the ERC721Source contract.
+type ERC721SourceDestinationRegistered struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+	Raw                      types.Log // Blockchain specific contextual infos
+}
+
+// FilterDestinationRegistered is a free log retrieval operation binding the contract event 0xb6c7638e853b52ac025190855b2fbd377259d60c0cb3bd3ed14694e76c98e2b8.
+//
+// Solidity: event DestinationRegistered(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress)
+func (_ERC721Source *ERC721SourceFilterer) FilterDestinationRegistered(opts *bind.FilterOpts, destinationBlockchainID [][32]byte, destinationBridgeAddress []common.Address) (*ERC721SourceDestinationRegisteredIterator, error) {
+
+	var destinationBlockchainIDRule []interface{}
+	for _, destinationBlockchainIDItem := range destinationBlockchainID {
+		destinationBlockchainIDRule = append(destinationBlockchainIDRule, destinationBlockchainIDItem)
+	}
+	var destinationBridgeAddressRule []interface{}
+	for _, destinationBridgeAddressItem := range destinationBridgeAddress {
+		destinationBridgeAddressRule = append(destinationBridgeAddressRule, destinationBridgeAddressItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "DestinationRegistered", destinationBlockchainIDRule, destinationBridgeAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceDestinationRegisteredIterator{contract: _ERC721Source.contract, event: "DestinationRegistered", logs: logs, sub: sub}, nil
+}
+
+// WatchDestinationRegistered is a free log subscription operation binding the contract event 0xb6c7638e853b52ac025190855b2fbd377259d60c0cb3bd3ed14694e76c98e2b8.
+//
+// Solidity: event DestinationRegistered(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress)
+func (_ERC721Source *ERC721SourceFilterer) WatchDestinationRegistered(opts *bind.WatchOpts, sink chan<- *ERC721SourceDestinationRegistered, destinationBlockchainID [][32]byte, destinationBridgeAddress []common.Address) (event.Subscription, error) {
+
+	var destinationBlockchainIDRule []interface{}
+	for _, destinationBlockchainIDItem := range destinationBlockchainID {
+		destinationBlockchainIDRule = append(destinationBlockchainIDRule, destinationBlockchainIDItem)
+	}
+	var destinationBridgeAddressRule []interface{}
+	for _, destinationBridgeAddressItem := range destinationBridgeAddress {
+		destinationBridgeAddressRule = append(destinationBridgeAddressRule, destinationBridgeAddressItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "DestinationRegistered", destinationBlockchainIDRule, destinationBridgeAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceDestinationRegistered)
+				if err := _ERC721Source.contract.UnpackLog(event, "DestinationRegistered", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDestinationRegistered is a log parse operation binding the contract event 0xb6c7638e853b52ac025190855b2fbd377259d60c0cb3bd3ed14694e76c98e2b8.
+//
+// Solidity: event DestinationRegistered(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress)
+func (_ERC721Source *ERC721SourceFilterer) ParseDestinationRegistered(log types.Log) (*ERC721SourceDestinationRegistered, error) {
+	event := new(ERC721SourceDestinationRegistered)
+	if err := _ERC721Source.contract.UnpackLog(event, "DestinationRegistered", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceMinTeleporterVersionUpdatedIterator is returned from FilterMinTeleporterVersionUpdated and is used to iterate over the raw logs and unpacked data for MinTeleporterVersionUpdated events raised by the ERC721Source contract.
+type ERC721SourceMinTeleporterVersionUpdatedIterator struct {
+	Event *ERC721SourceMinTeleporterVersionUpdated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceMinTeleporterVersionUpdatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceMinTeleporterVersionUpdated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceMinTeleporterVersionUpdated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceMinTeleporterVersionUpdatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceMinTeleporterVersionUpdatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceMinTeleporterVersionUpdated represents a MinTeleporterVersionUpdated event raised by the ERC721Source contract.
+type ERC721SourceMinTeleporterVersionUpdated struct {
+	OldMinTeleporterVersion *big.Int
+	NewMinTeleporterVersion *big.Int
+	Raw                     types.Log // Blockchain specific contextual infos
+}
+
+// FilterMinTeleporterVersionUpdated is a free log retrieval operation binding the contract event 0xa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d.
+//
+// Solidity: event MinTeleporterVersionUpdated(uint256 indexed oldMinTeleporterVersion, uint256 indexed newMinTeleporterVersion)
+func (_ERC721Source *ERC721SourceFilterer) FilterMinTeleporterVersionUpdated(opts *bind.FilterOpts, oldMinTeleporterVersion []*big.Int, newMinTeleporterVersion []*big.Int) (*ERC721SourceMinTeleporterVersionUpdatedIterator, error) {
+
+	var oldMinTeleporterVersionRule []interface{}
+	for _, oldMinTeleporterVersionItem := range oldMinTeleporterVersion {
+		oldMinTeleporterVersionRule = append(oldMinTeleporterVersionRule, oldMinTeleporterVersionItem)
+	}
+	var newMinTeleporterVersionRule []interface{}
+	for _, newMinTeleporterVersionItem := range newMinTeleporterVersion {
+		newMinTeleporterVersionRule = append(newMinTeleporterVersionRule, newMinTeleporterVersionItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "MinTeleporterVersionUpdated", oldMinTeleporterVersionRule, newMinTeleporterVersionRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceMinTeleporterVersionUpdatedIterator{contract: _ERC721Source.contract, event: "MinTeleporterVersionUpdated", logs: logs, sub: sub}, nil
+}
+
+// WatchMinTeleporterVersionUpdated is a free log subscription operation binding the contract event 0xa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d.
+//
+// Solidity: event MinTeleporterVersionUpdated(uint256 indexed oldMinTeleporterVersion, uint256 indexed newMinTeleporterVersion)
+func (_ERC721Source *ERC721SourceFilterer) WatchMinTeleporterVersionUpdated(opts *bind.WatchOpts, sink chan<- *ERC721SourceMinTeleporterVersionUpdated, oldMinTeleporterVersion []*big.Int, newMinTeleporterVersion []*big.Int) (event.Subscription, error) {
+
+	var oldMinTeleporterVersionRule []interface{}
+	for _, oldMinTeleporterVersionItem := range oldMinTeleporterVersion {
+		oldMinTeleporterVersionRule = append(oldMinTeleporterVersionRule, oldMinTeleporterVersionItem)
+	}
+	var newMinTeleporterVersionRule []interface{}
+	for _, newMinTeleporterVersionItem := range newMinTeleporterVersion {
+		newMinTeleporterVersionRule = append(newMinTeleporterVersionRule, newMinTeleporterVersionItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "MinTeleporterVersionUpdated", oldMinTeleporterVersionRule, newMinTeleporterVersionRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceMinTeleporterVersionUpdated)
+				if err := _ERC721Source.contract.UnpackLog(event, "MinTeleporterVersionUpdated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMinTeleporterVersionUpdated is a log parse operation binding the contract event 0xa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d.
+//
+// Solidity: event MinTeleporterVersionUpdated(uint256 indexed oldMinTeleporterVersion, uint256 indexed newMinTeleporterVersion)
+func (_ERC721Source *ERC721SourceFilterer) ParseMinTeleporterVersionUpdated(log types.Log) (*ERC721SourceMinTeleporterVersionUpdated, error) {
+	event := new(ERC721SourceMinTeleporterVersionUpdated)
+	if err := _ERC721Source.contract.UnpackLog(event, "MinTeleporterVersionUpdated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the ERC721Source contract.
+type ERC721SourceOwnershipTransferredIterator struct {
+	Event *ERC721SourceOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceOwnershipTransferred represents a OwnershipTransferred event raised by the ERC721Source contract.
+type ERC721SourceOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721Source *ERC721SourceFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*ERC721SourceOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceOwnershipTransferredIterator{contract: _ERC721Source.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721Source *ERC721SourceFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *ERC721SourceOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceOwnershipTransferred)
+				if err := _ERC721Source.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_ERC721Source *ERC721SourceFilterer) ParseOwnershipTransferred(log types.Log) (*ERC721SourceOwnershipTransferred, error) {
+	event := new(ERC721SourceOwnershipTransferred)
+	if err := _ERC721Source.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceSendsPausedIterator is returned from FilterSendsPaused and is used to iterate over the raw logs and unpacked data for SendsPaused events raised by the ERC721Source contract.
+type ERC721SourceSendsPausedIterator struct {
+	Event *ERC721SourceSendsPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceSendsPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceSendsPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceSendsPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceSendsPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceSendsPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceSendsPaused represents a SendsPaused event raised by the ERC721Source contract.
+type ERC721SourceSendsPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsPaused is a free log retrieval operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC721Source *ERC721SourceFilterer) FilterSendsPaused(opts *bind.FilterOpts, account []common.Address) (*ERC721SourceSendsPausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceSendsPausedIterator{contract: _ERC721Source.contract, event: "SendsPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsPaused is a free log subscription operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC721Source *ERC721SourceFilterer) WatchSendsPaused(opts *bind.WatchOpts, sink chan<- *ERC721SourceSendsPaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceSendsPaused)
+				if err := _ERC721Source.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsPaused is a log parse operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_ERC721Source *ERC721SourceFilterer) ParseSendsPaused(log types.Log) (*ERC721SourceSendsPaused, error) {
+	event := new(ERC721SourceSendsPaused)
+	if err := _ERC721Source.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceSendsUnpausedIterator is returned from FilterSendsUnpaused and is used to iterate over the raw logs and unpacked data for SendsUnpaused events raised by the ERC721Source contract.
+type ERC721SourceSendsUnpausedIterator struct {
+	Event *ERC721SourceSendsUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceSendsUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceSendsUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceSendsUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceSendsUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceSendsUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceSendsUnpaused represents a SendsUnpaused event raised by the ERC721Source contract.
+type ERC721SourceSendsUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsUnpaused is a free log retrieval operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC721Source *ERC721SourceFilterer) FilterSendsUnpaused(opts *bind.FilterOpts, account []common.Address) (*ERC721SourceSendsUnpausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceSendsUnpausedIterator{contract: _ERC721Source.contract, event: "SendsUnpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsUnpaused is a free log subscription operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC721Source *ERC721SourceFilterer) WatchSendsUnpaused(opts *bind.WatchOpts, sink chan<- *ERC721SourceSendsUnpaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceSendsUnpaused)
+				if err := _ERC721Source.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsUnpaused is a log parse operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_ERC721Source *ERC721SourceFilterer) ParseSendsUnpaused(log types.Log) (*ERC721SourceSendsUnpaused, error) {
+	event := new(ERC721SourceSendsUnpaused)
+	if err := _ERC721Source.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the ERC721Source contract.
+type ERC721SourceTeleporterAddressPausedIterator struct {
+	Event *ERC721SourceTeleporterAddressPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceTeleporterAddressPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceTeleporterAddressPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceTeleporterAddressPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceTeleporterAddressPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceTeleporterAddressPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceTeleporterAddressPaused represents a TeleporterAddressPaused event raised by the ERC721Source contract.
+type ERC721SourceTeleporterAddressPaused struct {
+	TeleporterAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterTeleporterAddressPaused is a free log retrieval operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_ERC721Source *ERC721SourceFilterer) FilterTeleporterAddressPaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*ERC721SourceTeleporterAddressPausedIterator, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceTeleporterAddressPausedIterator{contract: _ERC721Source.contract, event: "TeleporterAddressPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchTeleporterAddressPaused is a free log subscription operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_ERC721Source *ERC721SourceFilterer) WatchTeleporterAddressPaused(opts *bind.WatchOpts, sink chan<- *ERC721SourceTeleporterAddressPaused, teleporterAddress []common.Address) (event.Subscription, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceTeleporterAddressPaused)
+				if err := _ERC721Source.contract.UnpackLog(event, "TeleporterAddressPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTeleporterAddressPaused is a log parse operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_ERC721Source *ERC721SourceFilterer) ParseTeleporterAddressPaused(log types.Log) (*ERC721SourceTeleporterAddressPaused, error) {
+	event := new(ERC721SourceTeleporterAddressPaused)
+	if err := _ERC721Source.contract.UnpackLog(event, "TeleporterAddressPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceTeleporterAddressUnpausedIterator is returned from FilterTeleporterAddressUnpaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressUnpaused events raised by the ERC721Source contract.
+type ERC721SourceTeleporterAddressUnpausedIterator struct {
+	Event *ERC721SourceTeleporterAddressUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceTeleporterAddressUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceTeleporterAddressUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceTeleporterAddressUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceTeleporterAddressUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceTeleporterAddressUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceTeleporterAddressUnpaused represents a TeleporterAddressUnpaused event raised by the ERC721Source contract.
+type ERC721SourceTeleporterAddressUnpaused struct {
+	TeleporterAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterTeleporterAddressUnpaused is a free log retrieval operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_ERC721Source *ERC721SourceFilterer) FilterTeleporterAddressUnpaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*ERC721SourceTeleporterAddressUnpausedIterator, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "TeleporterAddressUnpaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceTeleporterAddressUnpausedIterator{contract: _ERC721Source.contract, event: "TeleporterAddressUnpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchTeleporterAddressUnpaused is a free log subscription operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_ERC721Source *ERC721SourceFilterer) WatchTeleporterAddressUnpaused(opts *bind.WatchOpts, sink chan<- *ERC721SourceTeleporterAddressUnpaused, teleporterAddress []common.Address) (event.Subscription, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "TeleporterAddressUnpaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceTeleporterAddressUnpaused)
+				if err := _ERC721Source.contract.UnpackLog(event, "TeleporterAddressUnpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTeleporterAddressUnpaused is a log parse operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_ERC721Source *ERC721SourceFilterer) ParseTeleporterAddressUnpaused(log types.Log) (*ERC721SourceTeleporterAddressUnpaused, error) {
+	event := new(ERC721SourceTeleporterAddressUnpaused)
+	if err := _ERC721Source.contract.UnpackLog(event, "TeleporterAddressUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceTokenAndCallRoutedIterator is returned from FilterTokenAndCallRouted and is used to iterate over the raw logs and unpacked data for TokenAndCallRouted events raised by the ERC721Source contract.
+type ERC721SourceTokenAndCallRoutedIterator struct {
+	Event *ERC721SourceTokenAndCallRouted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceTokenAndCallRoutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceTokenAndCallRouted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceTokenAndCallRouted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceTokenAndCallRoutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceTokenAndCallRoutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceTokenAndCallRouted represents a TokenAndCallRouted event raised by the ERC721Source contract.
+type ERC721SourceTokenAndCallRouted struct {
+	TeleporterMessageID [32]byte
+	Input               SendAndCallInput
+	TokenId             *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenAndCallRouted is a free log retrieval operation binding the contract event 0x6773e92d5085a01d74d3be93de096102bfa0d493b1dfaad3dca6510aa4280faa.
+//
+// Solidity: event TokenAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) FilterTokenAndCallRouted(opts *bind.FilterOpts, teleporterMessageID [][32]byte) (*ERC721SourceTokenAndCallRoutedIterator, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "TokenAndCallRouted", teleporterMessageIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceTokenAndCallRoutedIterator{contract: _ERC721Source.contract, event: "TokenAndCallRouted", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenAndCallRouted is a free log subscription operation binding the contract event 0x6773e92d5085a01d74d3be93de096102bfa0d493b1dfaad3dca6510aa4280faa.
+//
+// Solidity: event TokenAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) WatchTokenAndCallRouted(opts *bind.WatchOpts, sink chan<- *ERC721SourceTokenAndCallRouted, teleporterMessageID [][32]byte) (event.Subscription, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "TokenAndCallRouted", teleporterMessageIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceTokenAndCallRouted)
+				if err := _ERC721Source.contract.UnpackLog(event, "TokenAndCallRouted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenAndCallRouted is a log parse operation binding the contract event 0x6773e92d5085a01d74d3be93de096102bfa0d493b1dfaad3dca6510aa4280faa.
+//
+// Solidity: event TokenAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) ParseTokenAndCallRouted(log types.Log) (*ERC721SourceTokenAndCallRouted, error) {
+	event := new(ERC721SourceTokenAndCallRouted)
+	if err := _ERC721Source.contract.UnpackLog(event, "TokenAndCallRouted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceTokenAndCallSentIterator is returned from FilterTokenAndCallSent and is used to iterate over the raw logs and unpacked data for TokenAndCallSent events raised by the ERC721Source contract.
+type ERC721SourceTokenAndCallSentIterator struct {
+	Event *ERC721SourceTokenAndCallSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceTokenAndCallSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceTokenAndCallSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceTokenAndCallSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceTokenAndCallSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceTokenAndCallSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceTokenAndCallSent represents a TokenAndCallSent event raised by the ERC721Source contract.
+type ERC721SourceTokenAndCallSent struct {
+	TeleporterMessageID [32]byte
+	Sender              common.Address
+	Input               SendAndCallInput
+	TokenId             *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenAndCallSent is a free log retrieval operation binding the contract event 0x33312f1dee191e43139267f3f784f9f00f8cb3a19683961a6b0c277a1bd16165.
+//
+// Solidity: event TokenAndCallSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) FilterTokenAndCallSent(opts *bind.FilterOpts, teleporterMessageID [][32]byte, sender []common.Address) (*ERC721SourceTokenAndCallSentIterator, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "TokenAndCallSent", teleporterMessageIDRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceTokenAndCallSentIterator{contract: _ERC721Source.contract, event: "TokenAndCallSent", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenAndCallSent is a free log subscription operation binding the contract event 0x33312f1dee191e43139267f3f784f9f00f8cb3a19683961a6b0c277a1bd16165.
+//
+// Solidity: event TokenAndCallSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) WatchTokenAndCallSent(opts *bind.WatchOpts, sink chan<- *ERC721SourceTokenAndCallSent, teleporterMessageID [][32]byte, sender []common.Address) (event.Subscription, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "TokenAndCallSent", teleporterMessageIDRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceTokenAndCallSent)
+				if err := _ERC721Source.contract.UnpackLog(event, "TokenAndCallSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenAndCallSent is a log parse operation binding the contract event 0x33312f1dee191e43139267f3f784f9f00f8cb3a19683961a6b0c277a1bd16165.
+//
+// Solidity: event TokenAndCallSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) ParseTokenAndCallSent(log types.Log) (*ERC721SourceTokenAndCallSent, error) {
+	event := new(ERC721SourceTokenAndCallSent)
+	if err := _ERC721Source.contract.UnpackLog(event, "TokenAndCallSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceTokenRoutedIterator is returned from FilterTokenRouted and is used to iterate over the raw logs and unpacked data for TokenRouted events raised by the ERC721Source contract.
+type ERC721SourceTokenRoutedIterator struct {
+	Event *ERC721SourceTokenRouted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceTokenRoutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceTokenRouted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceTokenRouted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceTokenRoutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceTokenRoutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceTokenRouted represents a TokenRouted event raised by the ERC721Source contract.
+type ERC721SourceTokenRouted struct {
+	TeleporterMessageID [32]byte
+	Input               SendTokensInput
+	TokenId             *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenRouted is a free log retrieval operation binding the contract event 0x1744cd3f4c2233ed75cd3d42ff54baae6893578d39792edac83addce2aed7967.
+//
+// Solidity: event TokenRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) FilterTokenRouted(opts *bind.FilterOpts, teleporterMessageID [][32]byte) (*ERC721SourceTokenRoutedIterator, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "TokenRouted", teleporterMessageIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceTokenRoutedIterator{contract: _ERC721Source.contract, event: "TokenRouted", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenRouted is a free log subscription operation binding the contract event 0x1744cd3f4c2233ed75cd3d42ff54baae6893578d39792edac83addce2aed7967.
+//
+// Solidity: event TokenRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) WatchTokenRouted(opts *bind.WatchOpts, sink chan<- *ERC721SourceTokenRouted, teleporterMessageID [][32]byte) (event.Subscription, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "TokenRouted", teleporterMessageIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceTokenRouted)
+				if err := _ERC721Source.contract.UnpackLog(event, "TokenRouted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenRouted is a log parse operation binding the contract event 0x1744cd3f4c2233ed75cd3d42ff54baae6893578d39792edac83addce2aed7967.
+//
+// Solidity: event TokenRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) ParseTokenRouted(log types.Log) (*ERC721SourceTokenRouted, error) {
+	event := new(ERC721SourceTokenRouted)
+	if err := _ERC721Source.contract.UnpackLog(event, "TokenRouted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceTokenSentIterator is returned from FilterTokenSent and is used to iterate over the raw logs and unpacked data for TokenSent events raised by the ERC721Source contract.
+type ERC721SourceTokenSentIterator struct {
+	Event *ERC721SourceTokenSent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceTokenSentIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceTokenSent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceTokenSent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceTokenSentIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceTokenSentIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceTokenSent represents a TokenSent event raised by the ERC721Source contract.
+type ERC721SourceTokenSent struct {
+	TeleporterMessageID [32]byte
+	Sender              common.Address
+	Input               SendTokensInput
+	TokenId             *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenSent is a free log retrieval operation binding the contract event 0x55b835c46a86c474ba8722033b77f5286254a69ebeab26f240ffc80656dcaaab.
+//
+// Solidity: event TokenSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) FilterTokenSent(opts *bind.FilterOpts, teleporterMessageID [][32]byte, sender []common.Address) (*ERC721SourceTokenSentIterator, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "TokenSent", teleporterMessageIDRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceTokenSentIterator{contract: _ERC721Source.contract, event: "TokenSent", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenSent is a free log subscription operation binding the contract event 0x55b835c46a86c474ba8722033b77f5286254a69ebeab26f240ffc80656dcaaab.
+//
+// Solidity: event TokenSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) WatchTokenSent(opts *bind.WatchOpts, sink chan<- *ERC721SourceTokenSent, teleporterMessageID [][32]byte, sender []common.Address) (event.Subscription, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "TokenSent", teleporterMessageIDRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceTokenSent)
+				if err := _ERC721Source.contract.UnpackLog(event, "TokenSent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenSent is a log parse operation binding the contract event 0x55b835c46a86c474ba8722033b77f5286254a69ebeab26f240ffc80656dcaaab.
+//
+// Solidity: event TokenSent(bytes32 indexed teleporterMessageID, address indexed sender, (bytes32,address,address,address,uint256,uint256,uint256,address) input, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) ParseTokenSent(log types.Log) (*ERC721SourceTokenSent, error) {
+	event := new(ERC721SourceTokenSent)
+	if err := _ERC721Source.contract.UnpackLog(event, "TokenSent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// ERC721SourceTokenWithdrawnIterator is returned from FilterTokenWithdrawn and is used to iterate over the raw logs and unpacked data for TokenWithdrawn events raised by the ERC721Source contract.
+type ERC721SourceTokenWithdrawnIterator struct {
+	Event *ERC721SourceTokenWithdrawn // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ERC721SourceTokenWithdrawnIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ERC721SourceTokenWithdrawn)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ERC721SourceTokenWithdrawn)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ERC721SourceTokenWithdrawnIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ERC721SourceTokenWithdrawnIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ERC721SourceTokenWithdrawn represents a TokenWithdrawn event raised by the ERC721Source contract.
+type ERC721SourceTokenWithdrawn struct {
+	Recipient common.Address
+	TokenId   *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenWithdrawn is a free log retrieval operation binding the contract event 0xa2bd9fcfcdba69f52bcd9a520846ad4bd685b187483f53efc42d035b2ddebff0.
+//
+// Solidity: event TokenWithdrawn(address indexed recipient, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) FilterTokenWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*ERC721SourceTokenWithdrawnIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.FilterLogs(opts, "TokenWithdrawn", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ERC721SourceTokenWithdrawnIterator{contract: _ERC721Source.contract, event: "TokenWithdrawn", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenWithdrawn is a free log subscription operation binding the contract event 0xa2bd9fcfcdba69f52bcd9a520846ad4bd685b187483f53efc42d035b2ddebff0.
+//
+// Solidity: event TokenWithdrawn(address indexed recipient, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) WatchTokenWithdrawn(opts *bind.WatchOpts, sink chan<- *ERC721SourceTokenWithdrawn, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _ERC721Source.contract.WatchLogs(opts, "TokenWithdrawn", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ERC721SourceTokenWithdrawn)
+				if err := _ERC721Source.contract.UnpackLog(event, "TokenWithdrawn", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenWithdrawn is a log parse operation binding the contract event 0xa2bd9fcfcdba69f52bcd9a520846ad4bd685b187483f53efc42d035b2ddebff0.
+//
+// Solidity: event TokenWithdrawn(address indexed recipient, uint256 tokenId)
+func (_ERC721Source *ERC721SourceFilterer) ParseTokenWithdrawn(log types.Log) (*ERC721SourceTokenWithdrawn, error) {
+	event := new(ERC721SourceTokenWithdrawn)
+	if err := _ERC721Source.contract.UnpackLog(event, "TokenWithdrawn", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -58,7 +58,7 @@ type SendTokensInput struct {
 
 // NativeTokenSourceMetaData contains all meta data concerning the NativeTokenSource contract.
 var NativeTokenSourceMetaData = &bind.MetaData{
-	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterRegistryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"teleporterManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"wrappedTokenAddress\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"remaining\",\"type\":\"uint256\"}],\"name\":\"CollateralAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"initialCollateralNeeded\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenMultiplier\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"multiplyOnDestination\",\"type\":\"bool\"}],\"name\":\"DestinationRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MAX_TOKEN_MULTIPLIER\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"addCollateral\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"bridgedBalances\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"registeredDestinations\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"registered\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"collateralNeeded\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"tokenMultiplier\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"multiplyOnDestination\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"}],\"name\":\"sendAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"wrappedToken\",\"outputs\":[{\"internalType\":\"contractIWrappedNativeToken\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
+	ABI: "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterRegistryAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"teleporterManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"wrappedTokenAddress\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"remaining\",\"type\":\"uint256\"}],\"name\":\"CollateralAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"initialCollateralNeeded\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenMultiplier\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"multiplyOnDestination\",\"type\":\"bool\"}],\"name\":\"DestinationRegistered\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"window\",\"type\":\"uint256\"}],\"name\":\"RateLimitUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensRouted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"WithdrawalClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"WithdrawalFailed\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MAX_TOKEN_MULTIPLIER\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"addCollateral\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"bridgedBalances\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"balance\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"claimWithdrawal\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"claimableWithdrawals\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"rateLimitRemaining\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"rateLimits\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"window\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"windowStart\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amountSent\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"}],\"name\":\"registeredDestinations\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"registered\",\"type\":\"bool\"},{\"internalType\":\"uint256\",\"name\":\"collateralNeeded\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"tokenMultiplier\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"multiplyOnDestination\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"}],\"name\":\"send\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"}],\"name\":\"sendAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sendsPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"limit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"window\",\"type\":\"uint256\"}],\"name\":\"setRateLimit\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalClaimableWithdrawals\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"wrappedToken\",\"outputs\":[{\"internalType\":\"contractIWrappedNativeToken\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"stateMutability\":\"payable\",\"type\":\"receive\"}]",
 	Bin: "0x6101006040523480156200001257600080fd5b5060405162004374380380620043748339810160408190526200003591620003a9565b60016000558282828282816001600160a01b038116620000c25760405162461bcd60e51b815260206004820152603760248201527f54656c65706f727465725570677261646561626c653a207a65726f2074656c6560448201527f706f72746572207265676973747279206164647265737300000000000000000060648201526084015b60405180910390fd5b6001600160a01b03811660808190526040805163301fd1f560e21b8152905163c07f47d4916004808201926020929091908290030181865afa1580156200010d573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620001339190620003f3565b6002555062000142336200025d565b6200014d81620002af565b505060016004819055507302000000000000000000000000000000000000056001600160a01b0316634213cf786040518163ffffffff1660e01b8152600401602060405180830381865afa158015620001aa573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190620001d09190620003f3565b60a0526001600160a01b0381166200023d5760405162461bcd60e51b815260206004820152602960248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20746f6b656044820152686e206164647265737360b81b6064820152608401620000b9565b6001600160a01b0390811660c0529290921660e052506200040d92505050565b600380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b620002b96200032e565b6001600160a01b038116620003205760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401620000b9565b6200032b816200025d565b50565b6003546001600160a01b031633146200038a5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401620000b9565b565b80516001600160a01b0381168114620003a457600080fd5b919050565b600080600060608486031215620003bf57600080fd5b620003ca846200038c565b9250620003da602085016200038c565b9150620003ea604085016200038c565b90509250925092565b6000602082840312156200040657600080fd5b5051919050565b60805160a05160c05160e051613ee0620004946000396000818161038101528181611b5a01528181611f080152611fb9015260008181610127015281816103b5015281816117ba01526118ff01526000818161041c015281816106fb01526122320152600081816102330152818161077e015281816109b501526127ce0152613ee06000f3fe6080604052600436106101175760003560e01c80638da5cb5b116100a0578063c868efaa11610064578063c868efaa146103ea578063d127dc9b1461040a578063d2cc7a701461043e578063f2fde38b14610453578063fcfb5ff91461047357600080fd5b80638da5cb5b146103085780639731429714610326578063996c6cc31461036f5780639d76ea58146103a3578063b0b78b26146103d757600080fd5b80634511243e116100e75780634511243e1461028d5780635eb99514146102ad5780636e6eef8d146102cd578063715018a6146102e05780638bf2fa94146102f557600080fd5b80628ad777146101ba57806302ee3e9c146101e95780631a7f5bec146102215780632b0d8f181461026d57600080fd5b366101b557336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146101b35760405162461bcd60e51b815260206004820152603160248201527f4e6174697665546f6b656e536f757263653a20696e76616c69642072656365696044820152703b32903830bcb0b136329039b2b73232b960791b60648201526084015b60405180910390fd5b005b600080fd5b3480156101c657600080fd5b506101d6670de0b6b3a764000081565b6040519081526020015b60405180910390f35b3480156101f557600080fd5b506101d6610204366004613082565b600660209081526000928352604080842090915290825290205481565b34801561022d57600080fd5b506102557f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016101e0565b34801561027957600080fd5b506101b36102883660046130b2565b6104e9565b34801561029957600080fd5b506101b36102a83660046130b2565b6105e5565b3480156102b957600080fd5b506101b36102c83660046130cf565b6106e2565b6101b36102db3660046130e8565b6106f6565b3480156102ec57600080fd5b506101b361072c565b6101b3610303366004613123565b610740565b34801561031457600080fd5b506003546001600160a01b0316610255565b34801561033257600080fd5b5061035f6103413660046130b2565b6001600160a01b031660009081526001602052604090205460ff1690565b60405190151581526020016101e0565b34801561037b57600080fd5b506102557f000000000000000000000000000000000000000000000000000000000000000081565b3480156103af57600080fd5b506102557f000000000000000000000000000000000000000000000000000000000000000081565b6101b36103e5366004613082565b61075a565b3480156103f657600080fd5b506101b361040536600461313c565b610769565b34801561041657600080fd5b506101d67f000000000000000000000000000000000000000000000000000000000000000081565b34801561044a57600080fd5b506002546101d6565b34801561045f57600080fd5b506101b361046e3660046130b2565b610933565b34801561047f57600080fd5b506104c561048e366004613082565b6005602090815260009283526040808420909152908252902080546001820154600283015460039093015460ff9283169391921684565b604080519415158552602085019390935291830152151560608201526080016101e0565b6104f16109a9565b6001600160a01b0381166105175760405162461bcd60e51b81526004016101aa906131c4565b6001600160a01b03811660009081526001602052604090205460ff16156105965760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f727465725570677261646561626c653a2061646472657373206160448201526c1b1c9958591e481c185d5cd959609a1b60648201526084016101aa565b6001600160a01b0381166000818152600160208190526040808320805460ff1916909217909155517f933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c9190a250565b6105ed6109a9565b6001600160a01b0381166106135760405162461bcd60e51b81526004016101aa906131c4565b6001600160a01b03811660009081526001602052604090205460ff1661068d5760405162461bcd60e51b815260206004820152602960248201527f54656c65706f727465725570677261646561626c653a2061646472657373206e6044820152681bdd081c185d5cd95960ba1b60648201526084016101aa565b6040516001600160a01b038216907f844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c390600090a26001600160a01b03166000908152600160205260409020805460ff19169055565b6106ea6109a9565b6106f3816109b1565b50565b6106f37f00000000000000000000000000000000000000000000000000000000000000003361072484613342565b346000610b51565b61073461102e565b61073e6000611088565b565b6106f361075236839003830183613413565b3460006110da565b6107658282346113bc565b5050565b610771611595565b6002546001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016634c1f08ce336040516001600160e01b031960e084901b1681526001600160a01b039091166004820152602401602060405180830381865afa1580156107e8573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061080c91906134c0565b10156108735760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a20696e76616c6964205460448201526f32b632b837b93a32b91039b2b73232b960811b60648201526084016101aa565b61087c33610341565b156108e25760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a2054656c65706f72746560448201526f1c881859191c995cdcc81c185d5cd95960821b60648201526084016101aa565b610923848484848080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506115ee92505050565b61092d6001600055565b50505050565b61093b61102e565b6001600160a01b0381166109a05760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016101aa565b6106f381611088565b61073e61102e565b60007f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031663c07f47d46040518163ffffffff1660e01b8152600401602060405180830381865afa158015610a11573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190610a3591906134c0565b60025490915081831115610aa55760405162461bcd60e51b815260206004820152603160248201527f54656c65706f727465725570677261646561626c653a20696e76616c6964205460448201527032b632b837b93a32b9103b32b939b4b7b760791b60648201526084016101aa565b808311610b1a5760405162461bcd60e51b815260206004820152603f60248201527f54656c65706f727465725570677261646561626c653a206e6f7420677265617460448201527f6572207468616e2063757272656e74206d696e696d756d2076657273696f6e0060648201526084016101aa565b6002839055604051839082907fa9a7ef57e41f05b4c15480842f5f0c27edfcbb553fed281f7c4068452cc1c02d90600090a3505050565b600160045414610b735760405162461bcd60e51b81526004016101aa906134d9565b600260045560408301516001600160a01b0316610bf15760405162461bcd60e51b815260206004820152603660248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20726563696044820152757069656e7420636f6e7472616374206164647265737360501b60648201526084016101aa565b6000836080015111610c155760405162461bcd60e51b81526004016101aa9061351d565b60008360a0015111610c815760405162461bcd60e51b815260206004820152602f60248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f207265636960448201526e1c1a595b9d0819d85cc81b1a5b5a5d608a1b60648201526084016101aa565b82608001518360a0015110610cf35760405162461bcd60e51b815260206004820152603260248201527f54656c65706f72746572546f6b656e536f757263653a20696e76616c696420726044820152711958da5c1a595b9d0819d85cc81b1a5b5a5d60721b60648201526084016101aa565b60e08301516001600160a01b0316610d6c5760405162461bcd60e51b815260206004820152603660248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f2066616c6c6044820152756261636b20726563697069656e74206164647265737360501b60648201526084016101aa565b61014083015115610d8f5760405162461bcd60e51b81526004016101aa9061356b565b6101208301516000908215610ddb57610db78560000151866020015186886101200151611995565b915081600003610dd657610dcf8560c0015185611b01565b5050611022565b610e2b565b60c08501516001600160a01b031615610e065760405162461bcd60e51b81526004016101aa906135b8565b610e258560000151866020015186886101000151896101200151611bd2565b90925090505b6040805180820190915260009080600281526020016040518060e001604052808b81526020018a6001600160a01b0316815260200189604001516001600160a01b03168152602001868152602001896060015181526020018960a0015181526020018960e001516001600160a01b0316815250604051602001610eae9190613670565b60405160208183030381529060405281525090506000610f916040518060c001604052808960000151815260200189602001516001600160a01b0316815260200160405180604001604052808b61010001516001600160a01b031681526020018781525081526020018960800151815260200160006001600160401b03811115610f3a57610f3a613212565b604051908082528060200260200182016040528015610f63578160200160208202803683370190505b50815260200184604051602001610f7a91906136e6565b604051602081830303815290604052815250611ddb565b90508415610fd857807f42eff9005856e3c586b096d67211a566dc926052119fd7cc08023c70937ecb308886604051610fcb92919061372b565b60405180910390a261101d565b876001600160a01b0316817f5d76dff81bf773b908b050fa113d39f7d8135bb4175398f313ea19cd3a1a0b16898760405161101492919061372b565b60405180910390a35b505050505b50506001600455505050565b6003546001600160a01b0316331461073e5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016101aa565b600380546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b6001600454146110fc5760405162461bcd60e51b81526004016101aa906134d9565b600260045560408301516001600160a01b03166111715760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f207265636960448201526c7069656e74206164647265737360981b60648201526084016101aa565b60008360c00151116111955760405162461bcd60e51b81526004016101aa9061351d565b60a0830151156111b75760405162461bcd60e51b81526004016101aa9061356b565b60808301516000908215611201576111dd85600001518660200151868860800151611995565b9150816000036111fc576111f58560e0015185611b01565b50506113b2565b61124f565b60e08501516001600160a01b03161561122c5760405162461bcd60e51b81526004016101aa906135b8565b611249856000015186602001518688606001518960800151611bd2565b90925090505b604080518082019091526000908060018152602001604051806040016040528089604001516001600160a01b0316815260200186815250604051602001611296919061380e565b604051602081830303815290604052815250905060006113216040518060c001604052808960000151815260200189602001516001600160a01b0316815260200160405180604001604052808b606001516001600160a01b031681526020018781525081526020018960c00151815260200160006001600160401b03811115610f3a57610f3a613212565b9050841561136857807f825080857c76cef4a1629c0705a7f8b4ef0282ddcafde0b6715c4fb34b68aaf0888660405161135b92919061382e565b60405180910390a26113ad565b336001600160a01b0316817f93f19bf1ec58a15dc643b37e7e18a1c13e85e06cd11929e283154691ace9fb5289876040516113a492919061382e565b60405180910390a35b505050505b5050600160045550565b6001600454146113de5760405162461bcd60e51b81526004016101aa906134d9565b6002600481905560008481526005602090815260408083206001600160a01b03871684528252918290208251608081018452815460ff9081161515808352600184015494830194909452948201549381019390935260030154909216151560608201529061145e5760405162461bcd60e51b81526004016101aa906138b0565b60008160200151116114c85760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20636f6c6c60448201526c185d195c985b081b9959591959609a1b60648201526084016101aa565b6114d182611f01565b915060008082602001518410611502576020830151600092506114f49085613911565b905082602001519350611515565b8383602001516115129190613911565b91505b60008681526005602090815260408083206001600160a01b03891680855290835292819020600101859055805187815291820185905288917f6769a5f9bfc8b6e0db839ab981cbf9239274ae72d2d035081a9157d43bd33cb6910160405180910390a38015611588576115883382611b01565b5050600160045550505050565b6002600054036115e75760405162461bcd60e51b815260206004820152601f60248201527f5265656e7472616e637947756172643a207265656e7472616e742063616c6c0060448201526064016101aa565b6002600055565b6000818060200190518101906116049190613969565b905060018151600481111561161b5761161b61360a565b03611665576000816020015180602001905181019061163a91906139f7565b9050600061164d86868460200151611f33565b905061165d826000015182611b01565b505050505050565b60028151600481111561167a5761167a61360a565b0361172857600081602001518060200190518101906116999190613a31565b905060006116ac86868460600151611f33565b8251909150861461171e5760405162461bcd60e51b815260206004820152603660248201527f54656c65706f72746572546f6b656e536f757263653a206d69736d617463686560448201527519081cdbdd5c98d948189b1bd8dad8da185a5b88125160521b60648201526084016101aa565b61165d8282611fa3565b60038151600481111561173d5761173d61360a565b03611820576000816020015180602001905181019061175c9190613aee565b9050600080611775878785606001518660800151612134565b915091506118176040518061010001604052808560000151815260200185602001516001600160a01b0316815260200185604001516001600160a01b031681526020017f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602001838152602001600081526020018560a0015181526020018560c001516001600160a01b03168152508360016110da565b50505050505050565b6004815160048111156118355761183561360a565b0361193f57600081602001518060200190518101906118549190613b6c565b905060008061186e87878560800151866101400151612134565b915091506118178784600001516040518061016001604052808760200151815260200187604001516001600160a01b0316815260200187606001516001600160a01b031681526020018760a00151815260200187610100015181526020018760c0015181526020018761012001516001600160a01b031681526020018760e001516001600160a01b031681526020017f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020018581526020016000815250856001610b51565b6000815160048111156119545761195461360a565b0361092d57600081602001518060200190518101906119739190613c79565b905061198e85858360000151846020015185604001516121c5565b5050505050565b60008481526005602090815260408083206001600160a01b038716845282528083208151608081018352815460ff9081161580158352600184015495830195909552600283015493820193909352600390910154909116151560608201529080611a03575060008160200151115b15611a12576000915050611af9565b828411611a875760405162461bcd60e51b815260206004820152603860248201527f54656c65706f72746572546f6b656e536f757263653a20696e7375666669636960448201527f656e7420616d6f756e7420746f20636f7665722066656573000000000000000060648201526084016101aa565b611a918385613911565b93506000611aa8826040015183606001518761253d565b905080600003611abd57600092505050611af9565b60008781526006602090815260408083206001600160a01b038a16845290915281208054839290611aef908490613cd8565b9091555090925050505b949350505050565b816001600160a01b03167f6352c5382c4a4578e712449ca65e83cdb392d045dfcf1cad9615189db2da244b82604051611b3c91815260200190565b60405180910390a2604051632e1a7d4d60e01b8152600481018290527f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031690632e1a7d4d90602401600060405180830381600087803b158015611ba657600080fd5b505af1158015611bba573d6000803e3d6000fd5b50610765925050506001600160a01b0383168261254c565b60008581526005602090815260408083206001600160a01b038816845282528083208151608081018352815460ff9081161515808352600184015495830195909552600283015493820193909352600390910154909116151560608201528291611c865760405162461bcd60e51b81526020600482015260316024820152600080516020613e8b8339815191526044820152701bdb881b9bdd081c9959da5cdd195c9959607a1b60648201526084016101aa565b602081015115611cfe5760405162461bcd60e51b815260206004820152603860248201527f54656c65706f72746572546f6b656e536f757263653a20636f6c6c617465726160448201527f6c206e656564656420666f722064657374696e6174696f6e000000000000000060648201526084016101aa565b611d0786611f01565b95508315611d1c57611d19858561266a565b93505b6000611d31826040015183606001518961253d565b905060008111611d955760405162461bcd60e51b815260206004820152602960248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f207363616c604482015268195908185b5bdd5b9d60ba1b60648201526084016101aa565b60008981526006602090815260408083206001600160a01b038c16845290915281208054839290611dc7908490613cd8565b909155509099949850939650505050505050565b600080611de66127c9565b60408401516020015190915015611e8b576040830151516001600160a01b0316611e685760405162461bcd60e51b815260206004820152602d60248201527f54656c65706f727465725570677261646561626c653a207a65726f206665652060448201526c746f6b656e206164647265737360981b60648201526084016101aa565b604083015160208101519051611e8b916001600160a01b039091169083906128dd565b604051630624488560e41b81526001600160a01b03821690636244885090611eb7908690600401613ceb565b6020604051808303816000875af1158015611ed6573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190611efa91906134c0565b9392505050565b6000611f2d7f0000000000000000000000000000000000000000000000000000000000000000836129c2565b92915050565b60008381526005602090815260408083206001600160a01b038616845282528083208151608081018352815460ff908116151582526001830154948201949094526002820154928101929092526003015490911615156060820152611f9a81868686612b5e565b95945050505050565b604051632e1a7d4d60e01b8152600481018290527f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031690632e1a7d4d90602401600060405180830381600087803b15801561200557600080fd5b505af1158015612019573d6000803e3d6000fd5b50508351602085015160808601516040516000955061203b9450602401613da4565b60408051601f198184030181529181526020820180516001600160e01b0316630d57eddf60e21b17905260a0850151908501519192506000916120819190859085612c68565b905080156120d55783604001516001600160a01b03167f104deb555f67e63782bb817bc26c39050894645f9b9f29c4be8ae68d0e8b7ff4846040516120c891815260200190565b60405180910390a261092d565b83604001516001600160a01b03167fb9eaeae386d339f8115782f297a9e5f0e13fb587cd6b0d502f113cb8dd4d6cb08460405161211491815260200190565b60405180910390a260c084015161092d906001600160a01b03168461254c565b60008481526005602090815260408083206001600160a01b038716845282528083208151608081018352815460ff90811615158252600183015494820194909452600282015492810192909252600301549091161515606082015281908161219e82898989612b5e565b905060006121b58360400151846060015188612d3d565b9199919850909650505050505050565b846122305760405162461bcd60e51b815260206004820152603560248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20646573746044820152741a5b985d1a5bdb88189b1bd8dad8da185a5b881251605a1b60648201526084016101aa565b7f000000000000000000000000000000000000000000000000000000000000000085036122c55760405162461bcd60e51b815260206004820152603b60248201527f54656c65706f72746572546f6b656e536f757263653a2063616e6e6f7420726560448201527f67697374657220627269646765206f6e2073616d6520636861696e000000000060648201526084016101aa565b6001600160a01b03841661233a5760405162461bcd60e51b815260206004820152603660248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f2064657374604482015275696e6174696f6e20627269646765206164647265737360501b60648201526084016101aa565b6000821180156123515750670de0b6b3a764000082105b6123b55760405162461bcd60e51b815260206004820152602f60248201527f54656c65706f72746572546f6b656e536f757263653a20696e76616c6964207460448201526e37b5b2b71036bab63a34b83634b2b960891b60648201526084016101aa565b60008581526005602090815260408083206001600160a01b038816845290915290205460ff16156124345760405162461bcd60e51b81526020600482015260356024820152600080516020613e8b8339815191526044820152741bdb88185b1c9958591e481c9959da5cdd195c9959605a1b60648201526084016101aa565b6000612441838386612d3d565b905081801561245857506124558385613de4565b15155b1561246b57612468600182613cd8565b90505b60408051608081018252600180825260208083018581528385018881528715156060860190815260008d8152600585528781206001600160a01b038e1680835295528790209551865490151560ff1991821617875592519486019490945551600285015591516003909301805493151593909216929092179055905187907f34e0f289c6952b50ea58fa31d8c3732fb85091cfc3d5ded7767d7562ce0bfb229061252d9085908890889092835260208301919091521515604082015260600190565b60405180910390a3505050505050565b6000611af98484846001612d48565b8047101561259c5760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a20696e73756666696369656e742062616c616e636500000060448201526064016101aa565b6000826001600160a01b03168260405160006040518083038185875af1925050503d80600081146125e9576040519150601f19603f3d011682016040523d82523d6000602084013e6125ee565b606091505b50509050806126655760405162461bcd60e51b815260206004820152603a60248201527f416464726573733a20756e61626c6520746f2073656e642076616c75652c207260448201527f6563697069656e74206d6179206861766520726576657274656400000000000060648201526084016101aa565b505050565b6040516370a0823160e01b815230600482015260009081906001600160a01b038516906370a0823190602401602060405180830381865afa1580156126b3573d6000803e3d6000fd5b505050506040513d601f19601f820116820180604052508101906126d791906134c0565b90506126ee6001600160a01b038516333086612d70565b6040516370a0823160e01b81523060048201526000906001600160a01b038616906370a0823190602401602060405180830381865afa158015612735573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061275991906134c0565b90508181116127bf5760405162461bcd60e51b815260206004820152602c60248201527f5361666545524332305472616e7366657246726f6d3a2062616c616e6365206e60448201526b1bdd081a5b98dc99585cd95960a21b60648201526084016101aa565b611f9a8282613911565b6000807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031663d820e64f6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561282a573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061284e9190613df8565b9050612872816001600160a01b031660009081526001602052604090205460ff1690565b156128d85760405162461bcd60e51b815260206004820152603060248201527f54656c65706f727465725570677261646561626c653a2054656c65706f72746560448201526f1c881cd95b991a5b99c81c185d5cd95960821b60648201526084016101aa565b919050565b604051636eb1769f60e11b81523060048201526001600160a01b038381166024830152600091839186169063dd62ed3e90604401602060405180830381865afa15801561292e573d6000803e3d6000fd5b505050506040513d601f19601f8201168201806040525081019061295291906134c0565b61295c9190613cd8565b6040516001600160a01b03851660248201526044810182905290915061092d90859063095ea7b360e01b906064015b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b031990931692909217909152612da8565b6040516370a0823160e01b815230600482015260009081906001600160a01b038516906370a0823190602401602060405180830381865afa158015612a0b573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612a2f91906134c0565b9050836001600160a01b031663d0e30db0846040518263ffffffff1660e01b81526004016000604051808303818588803b158015612a6c57600080fd5b505af1158015612a80573d6000803e3d6000fd5b50506040516370a0823160e01b8152306004820152600093506001600160a01b03881692506370a082319150602401602060405180830381865afa158015612acc573d6000803e3d6000fd5b505050506040513d601f19601f82011682018060405250810190612af091906134c0565b90508181116127bf5760405162461bcd60e51b815260206004820152603460248201527f53616665577261707065644e6174697665546f6b656e4465706f7369743a2062604482015273185b185b98d9481b9bdd081a5b98dc99585cd95960621b60648201526084016101aa565b8351600090612b7f5760405162461bcd60e51b81526004016101aa906138b0565b602085015115612be55760405162461bcd60e51b815260206004820152603c6024820152600080516020613e8b83398151915260448201527f6f6e20627269646765206e6f7420636f6c6c61746572616c697a65640000000060648201526084016101aa565b612bf0848484612e7a565b6000612c058660400151876060015185612d3d565b905060008111611f9a5760405162461bcd60e51b815260206004820152602860248201527f54656c65706f72746572546f6b656e536f757263653a207a65726f20746f6b656044820152671b88185b5bdd5b9d60c21b60648201526084016101aa565b6000845a1015612cba5760405162461bcd60e51b815260206004820152601b60248201527f43616c6c5574696c733a20696e73756666696369656e7420676173000000000060448201526064016101aa565b83471015612d0a5760405162461bcd60e51b815260206004820152601d60248201527f43616c6c5574696c733a20696e73756666696369656e742076616c756500000060448201526064016101aa565b826001600160a01b03163b600003612d2457506000611af9565b600080600084516020860188888bf19695505050505050565b6000611af984848460005b600081151584151503612d6657612d5f8584613e15565b9050611af9565b611f9a8584613e2c565b6040516001600160a01b038085166024830152831660448201526064810182905261092d9085906323b872dd60e01b9060840161298b565b6000612dfd826040518060400160405280602081526020017f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c6564815250856001600160a01b0316612f3f9092919063ffffffff16565b8051909150156126655780806020019051810190612e1b9190613e40565b6126655760405162461bcd60e51b815260206004820152602a60248201527f5361666545524332303a204552433230206f7065726174696f6e20646964206e6044820152691bdd081cdd58d8d9595960b21b60648201526084016101aa565b60008381526006602090815260408083206001600160a01b038616845290915290205481811015612f085760405162461bcd60e51b815260206004820152603260248201527f54656c65706f72746572546f6b656e536f757263653a20696e73756666696369604482015271656e74206272696467652062616c616e636560701b60648201526084016101aa565b612f128282613911565b60009485526006602090815260408087206001600160a01b03909616875294905292909320919091555050565b6060611af9848460008585600080866001600160a01b03168587604051612f669190613e5b565b60006040518083038185875af1925050503d8060008114612fa3576040519150601f19603f3d011682016040523d82523d6000602084013e612fa8565b606091505b5091509150612fb987838387612fc4565b979650505050505050565b6060831561303357825160000361302c576001600160a01b0385163b61302c5760405162461bcd60e51b815260206004820152601d60248201527f416464726573733a2063616c6c20746f206e6f6e2d636f6e747261637400000060448201526064016101aa565b5081611af9565b611af983838151156130485781518083602001fd5b8060405162461bcd60e51b81526004016101aa9190613e77565b6001600160a01b03811681146106f357600080fd5b80356128d881613062565b6000806040838503121561309557600080fd5b8235915060208301356130a781613062565b809150509250929050565b6000602082840312156130c457600080fd5b8135611efa81613062565b6000602082840312156130e157600080fd5b5035919050565b6000602082840312156130fa57600080fd5b81356001600160401b0381111561311057600080fd5b82016101608185031215611efa57600080fd5b6000610100828403121561313657600080fd5b50919050565b6000806000806060858703121561315257600080fd5b84359350602085013561316481613062565b925060408501356001600160401b038082111561318057600080fd5b818701915087601f83011261319457600080fd5b8135818111156131a357600080fd5b8860208285010111156131b557600080fd5b95989497505060200194505050565b6020808252602e908201527f54656c65706f727465725570677261646561626c653a207a65726f2054656c6560408201526d706f72746572206164647265737360901b606082015260800190565b634e487b7160e01b600052604160045260246000fd5b60405161016081016001600160401b038111828210171561324b5761324b613212565b60405290565b604080519081016001600160401b038111828210171561324b5761324b613212565b60405160e081016001600160401b038111828210171561324b5761324b613212565b604051601f8201601f191681016001600160401b03811182821017156132bd576132bd613212565b604052919050565b60006001600160401b038211156132de576132de613212565b50601f01601f191660200190565b600082601f8301126132fd57600080fd5b813561331061330b826132c5565b613295565b81815284602083860101111561332557600080fd5b816020850160208301376000918101602001919091529392505050565b6000610160823603121561335557600080fd5b61335d613228565b8235815261336d60208401613077565b602082015261337e60408401613077565b604082015260608301356001600160401b0381111561339c57600080fd5b6133a8368286016132ec565b6060830152506080830135608082015260a083013560a08201526133ce60c08401613077565b60c08201526133df60e08401613077565b60e08201526101006133f2818501613077565b90820152610120838101359082015261014092830135928101929092525090565b600061010080838503121561342757600080fd5b604051908101906001600160401b038211818310171561344957613449613212565b81604052833581526020840135915061346182613062565b81602082015261347360408501613077565b604082015261348460608501613077565b60608201526080840135608082015260a084013560a082015260c084013560c08201526134b360e08501613077565b60e0820152949350505050565b6000602082840312156134d257600080fd5b5051919050565b60208082526024908201527f53656e645265656e7472616e637947756172643a2073656e64207265656e7472604082015263616e637960e01b606082015260800190565b6020808252602e908201527f54656c65706f72746572546f6b656e536f757263653a207a65726f207265717560408201526d1a5c99590819d85cc81b1a5b5a5d60921b606082015260800190565b6020808252602d908201527f54656c65706f72746572546f6b656e536f757263653a206e6f6e2d7a65726f2060408201526c7365636f6e646172792066656560981b606082015260800190565b60208082526032908201527f54656c65706f72746572546f6b656e536f757263653a206e6f6e2d7a65726f206040820152716d756c74692d686f702066616c6c6261636b60701b606082015260800190565b634e487b7160e01b600052602160045260246000fd5b60005b8381101561363b578181015183820152602001613623565b50506000910152565b6000815180845261365c816020860160208601613620565b601f01601f19169290920160200192915050565b60208152815160208201526000602083015160018060a01b038082166040850152806040860151166060850152606085015160808501526080850151915060e060a08501526136c3610100850183613644565b915060a085015160c08501528060c08601511660e0850152508091505092915050565b60208152600082516005811061370c57634e487b7160e01b600052602160045260246000fd5b806020840152506020830151604080840152611af96060840182613644565b60408152825160408201526000602084015161375260608401826001600160a01b03169052565b5060408401516001600160a01b03166080830152606084015161016060a084018190526137836101a0850183613644565b9150608086015160c085015260a086015160e085015260c08601516101006137b5818701836001600160a01b03169052565b60e088015191506101206137d3818801846001600160a01b03169052565b908801519150610140906137f1878301846001600160a01b03169052565b880151928601929092525090940151610180830152506020015290565b81516001600160a01b031681526020808301519082015260408101611f2d565b60006101208201905083518252602084015160018060a01b03808216602085015280604087015116604085015280606087015116606085015250506080840151608083015260a084015160a083015260c084015160c083015260e08401516138a160e08401826001600160a01b03169052565b50826101008301529392505050565b6020808252603890820152600080516020613e8b83398151915260408201527f6f6e20627269646765206e6f7420726567697374657265640000000000000000606082015260800190565b634e487b7160e01b600052601160045260246000fd5b81810381811115611f2d57611f2d6138fb565b600082601f83011261393557600080fd5b815161394361330b826132c5565b81815284602083860101111561395857600080fd5b611af9826020830160208701613620565b60006020828403121561397b57600080fd5b81516001600160401b038082111561399257600080fd5b90830190604082860312156139a657600080fd5b6139ae613251565b8251600581106139bd57600080fd5b81526020830151828111156139d157600080fd5b6139dd87828601613924565b60208301525095945050505050565b80516128d881613062565b600060408284031215613a0957600080fd5b613a11613251565b8251613a1c81613062565b81526020928301519281019290925250919050565b600060208284031215613a4357600080fd5b81516001600160401b0380821115613a5a57600080fd5b9083019060e08286031215613a6e57600080fd5b613a76613273565b82518152613a86602084016139ec565b6020820152613a97604084016139ec565b604082015260608301516060820152608083015182811115613ab857600080fd5b613ac487828601613924565b60808301525060a083015160a0820152613ae060c084016139ec565b60c082015295945050505050565b600060e08284031215613b0057600080fd5b613b08613273565b825181526020830151613b1a81613062565b60208201526040830151613b2d81613062565b80604083015250606083015160608201526080830151608082015260a083015160a082015260c0830151613b6081613062565b60c08201529392505050565b600060208284031215613b7e57600080fd5b81516001600160401b0380821115613b9557600080fd5b908301906101608286031215613baa57600080fd5b613bb2613228565b613bbb836139ec565b815260208301516020820152613bd3604084016139ec565b6040820152613be4606084016139ec565b60608201526080830151608082015260a083015182811115613c0557600080fd5b613c1187828601613924565b60a08301525060c083015160c0820152613c2d60e084016139ec565b60e082015261010083810151908201526101209150613c4d8284016139ec565b9181019190915261014091820151918101919091529392505050565b805180151581146128d857600080fd5b600060608284031215613c8b57600080fd5b604051606081018181106001600160401b0382111715613cad57613cad613212565b80604052508251815260208301516020820152613ccc60408401613c69565b60408201529392505050565b80820180821115611f2d57611f2d6138fb565b6020808252825182820152828101516001600160a01b039081166040808501919091528401518051821660608501528083015160808501526000929161010085019190606087015160a0870152608087015160e060c0880152805193849052840192600092506101208701905b80841015613d7a57845183168252938501936001939093019290850190613d58565b5060a0880151878203601f190160e08901529450613d988186613644565b98975050505050505050565b8381526001600160a01b0383166020820152606060408201819052600090611f9a90830184613644565b634e487b7160e01b600052601260045260246000fd5b600082613df357613df3613dce565b500690565b600060208284031215613e0a57600080fd5b8151611efa81613062565b8082028115828204841417611f2d57611f2d6138fb565b600082613e3b57613e3b613dce565b500490565b600060208284031215613e5257600080fd5b611efa82613c69565b60008251613e6d818460208701613620565b9190910192915050565b602081526000611efa602083018461364456fe54656c65706f72746572546f6b656e536f757263653a2064657374696e617469a264697066735822122082748f3221087b41c56f5c4526bb4a7f7595ccd93ef1735e127bbe66d7769d6564736f6c63430008120033",
 }
 
@@ -322,6 +322,37 @@ func (_NativeTokenSource *NativeTokenSourceCallerSession) BridgedBalances(destin
 	return _NativeTokenSource.Contract.BridgedBalances(&_NativeTokenSource.CallOpts, destinationBlockchainID, destinationBridgeAddress)
 }
 
+// ClaimableWithdrawals is a free data retrieval call binding the contract method 0x7bf3e0fb.
+//
+// Solidity: function claimableWithdrawals(address recipient) view returns(uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceCaller) ClaimableWithdrawals(opts *bind.CallOpts, recipient common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _NativeTokenSource.contract.Call(opts, &out, "claimableWithdrawals", recipient)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ClaimableWithdrawals is a free data retrieval call binding the contract method 0x7bf3e0fb.
+//
+// Solidity: function claimableWithdrawals(address recipient) view returns(uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceSession) ClaimableWithdrawals(recipient common.Address) (*big.Int, error) {
+	return _NativeTokenSource.Contract.ClaimableWithdrawals(&_NativeTokenSource.CallOpts, recipient)
+}
+
+// ClaimableWithdrawals is a free data retrieval call binding the contract method 0x7bf3e0fb.
+//
+// Solidity: function claimableWithdrawals(address recipient) view returns(uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceCallerSession) ClaimableWithdrawals(recipient common.Address) (*big.Int, error) {
+	return _NativeTokenSource.Contract.ClaimableWithdrawals(&_NativeTokenSource.CallOpts, recipient)
+}
+
 // GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
 //
 // Solidity: function getMinTeleporterVersion() view returns(uint256)
@@ -415,6 +446,92 @@ func (_NativeTokenSource *NativeTokenSourceCallerSession) Owner() (common.Addres
 	return _NativeTokenSource.Contract.Owner(&_NativeTokenSource.CallOpts)
 }
 
+// RateLimitRemaining is a free data retrieval call binding the contract method 0xe8a4726b.
+//
+// Solidity: function rateLimitRemaining(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256)
+func (_NativeTokenSource *NativeTokenSourceCaller) RateLimitRemaining(opts *bind.CallOpts, destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _NativeTokenSource.contract.Call(opts, &out, "rateLimitRemaining", destinationBlockchainID, destinationBridgeAddress)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RateLimitRemaining is a free data retrieval call binding the contract method 0xe8a4726b.
+//
+// Solidity: function rateLimitRemaining(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256)
+func (_NativeTokenSource *NativeTokenSourceSession) RateLimitRemaining(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (*big.Int, error) {
+	return _NativeTokenSource.Contract.RateLimitRemaining(&_NativeTokenSource.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// RateLimitRemaining is a free data retrieval call binding the contract method 0xe8a4726b.
+//
+// Solidity: function rateLimitRemaining(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256)
+func (_NativeTokenSource *NativeTokenSourceCallerSession) RateLimitRemaining(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (*big.Int, error) {
+	return _NativeTokenSource.Contract.RateLimitRemaining(&_NativeTokenSource.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// RateLimits is a free data retrieval call binding the contract method 0x9a1ae5cd.
+//
+// Solidity: function rateLimits(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256 limit, uint256 window, uint256 windowStart, uint256 amountSent)
+func (_NativeTokenSource *NativeTokenSourceCaller) RateLimits(opts *bind.CallOpts, destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (struct {
+	Limit       *big.Int
+	Window      *big.Int
+	WindowStart *big.Int
+	AmountSent  *big.Int
+}, error) {
+	var out []interface{}
+	err := _NativeTokenSource.contract.Call(opts, &out, "rateLimits", destinationBlockchainID, destinationBridgeAddress)
+
+	outstruct := new(struct {
+		Limit       *big.Int
+		Window      *big.Int
+		WindowStart *big.Int
+		AmountSent  *big.Int
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Limit = *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+	outstruct.Window = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.WindowStart = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.AmountSent = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+
+	return *outstruct, err
+
+}
+
+// RateLimits is a free data retrieval call binding the contract method 0x9a1ae5cd.
+//
+// Solidity: function rateLimits(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256 limit, uint256 window, uint256 windowStart, uint256 amountSent)
+func (_NativeTokenSource *NativeTokenSourceSession) RateLimits(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (struct {
+	Limit       *big.Int
+	Window      *big.Int
+	WindowStart *big.Int
+	AmountSent  *big.Int
+}, error) {
+	return _NativeTokenSource.Contract.RateLimits(&_NativeTokenSource.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// RateLimits is a free data retrieval call binding the contract method 0x9a1ae5cd.
+//
+// Solidity: function rateLimits(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(uint256 limit, uint256 window, uint256 windowStart, uint256 amountSent)
+func (_NativeTokenSource *NativeTokenSourceCallerSession) RateLimits(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address) (struct {
+	Limit       *big.Int
+	Window      *big.Int
+	WindowStart *big.Int
+	AmountSent  *big.Int
+}, error) {
+	return _NativeTokenSource.Contract.RateLimits(&_NativeTokenSource.CallOpts, destinationBlockchainID, destinationBridgeAddress)
+}
+
 // RegisteredDestinations is a free data retrieval call binding the contract method 0xfcfb5ff9.
 //
 // Solidity: function registeredDestinations(bytes32 destinationBlockchainID, address destinationBridgeAddress) view returns(bool registered, uint256 collateralNeeded, uint256 tokenMultiplier, bool multiplyOnDestination)
@@ -470,6 +587,37 @@ func (_NativeTokenSource *NativeTokenSourceCallerSession) RegisteredDestinations
 	return _NativeTokenSource.Contract.RegisteredDestinations(&_NativeTokenSource.CallOpts, destinationBlockchainID, destinationBridgeAddress)
 }
 
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_NativeTokenSource *NativeTokenSourceCaller) SendsPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _NativeTokenSource.contract.Call(opts, &out, "sendsPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_NativeTokenSource *NativeTokenSourceSession) SendsPaused() (bool, error) {
+	return _NativeTokenSource.Contract.SendsPaused(&_NativeTokenSource.CallOpts)
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_NativeTokenSource *NativeTokenSourceCallerSession) SendsPaused() (bool, error) {
+	return _NativeTokenSource.Contract.SendsPaused(&_NativeTokenSource.CallOpts)
+}
+
 // TeleporterRegistry is a free data retrieval call binding the contract method 0x1a7f5bec.
 //
 // Solidity: function teleporterRegistry() view returns(address)
@@ -532,6 +680,37 @@ func (_NativeTokenSource *NativeTokenSourceCallerSession) TokenAddress() (common
 	return _NativeTokenSource.Contract.TokenAddress(&_NativeTokenSource.CallOpts)
 }
 
+// TotalClaimableWithdrawals is a free data retrieval call binding the contract method 0xd7fa49e7.
+//
+// Solidity: function totalClaimableWithdrawals() view returns(uint256)
+func (_NativeTokenSource *NativeTokenSourceCaller) TotalClaimableWithdrawals(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NativeTokenSource.contract.Call(opts, &out, "totalClaimableWithdrawals")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalClaimableWithdrawals is a free data retrieval call binding the contract method 0xd7fa49e7.
+//
+// Solidity: function totalClaimableWithdrawals() view returns(uint256)
+func (_NativeTokenSource *NativeTokenSourceSession) TotalClaimableWithdrawals() (*big.Int, error) {
+	return _NativeTokenSource.Contract.TotalClaimableWithdrawals(&_NativeTokenSource.CallOpts)
+}
+
+// TotalClaimableWithdrawals is a free data retrieval call binding the contract method 0xd7fa49e7.
+//
+// Solidity: function totalClaimableWithdrawals() view returns(uint256)
+func (_NativeTokenSource *NativeTokenSourceCallerSession) TotalClaimableWithdrawals() (*big.Int, error) {
+	return _NativeTokenSource.Contract.TotalClaimableWithdrawals(&_NativeTokenSource.CallOpts)
+}
+
 // WrappedToken is a free data retrieval call binding the contract method 0x996c6cc3.
 //
 // Solidity: function wrappedToken() view returns(address)
@@ -584,6 +763,48 @@ func (_NativeTokenSource *NativeTokenSourceTransactorSession) AddCollateral(dest
 	return _NativeTokenSource.Contract.AddCollateral(&_NativeTokenSource.TransactOpts, destinationBlockchainID, destinationBridgeAddress)
 }
 
+// ClaimWithdrawal is a paid mutator transaction binding the contract method 0xa3066aab.
+//
+// Solidity: function claimWithdrawal(address recipient) returns()
+func (_NativeTokenSource *NativeTokenSourceTransactor) ClaimWithdrawal(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _NativeTokenSource.contract.Transact(opts, "claimWithdrawal", recipient)
+}
+
+// ClaimWithdrawal is a paid mutator transaction binding the contract method 0xa3066aab.
+//
+// Solidity: function claimWithdrawal(address recipient) returns()
+func (_NativeTokenSource *NativeTokenSourceSession) ClaimWithdrawal(recipient common.Address) (*types.Transaction, error) {
+	return _NativeTokenSource.Contract.ClaimWithdrawal(&_NativeTokenSource.TransactOpts, recipient)
+}
+
+// ClaimWithdrawal is a paid mutator transaction binding the contract method 0xa3066aab.
+//
+// Solidity: function claimWithdrawal(address recipient) returns()
+func (_NativeTokenSource *NativeTokenSourceTransactorSession) ClaimWithdrawal(recipient common.Address) (*types.Transaction, error) {
+	return _NativeTokenSource.Contract.ClaimWithdrawal(&_NativeTokenSource.TransactOpts, recipient)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_NativeTokenSource *NativeTokenSourceTransactor) PauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeTokenSource.contract.Transact(opts, "pauseSends")
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_NativeTokenSource *NativeTokenSourceSession) PauseSends() (*types.Transaction, error) {
+	return _NativeTokenSource.Contract.PauseSends(&_NativeTokenSource.TransactOpts)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_NativeTokenSource *NativeTokenSourceTransactorSession) PauseSends() (*types.Transaction, error) {
+	return _NativeTokenSource.Contract.PauseSends(&_NativeTokenSource.TransactOpts)
+}
+
 // PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
 //
 // Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
@@ -689,6 +910,27 @@ func (_NativeTokenSource *NativeTokenSourceTransactorSession) SendAndCall(input
 	return _NativeTokenSource.Contract.SendAndCall(&_NativeTokenSource.TransactOpts, input)
 }
 
+// SetRateLimit is a paid mutator transaction binding the contract method 0xa86aba85.
+//
+// Solidity: function setRateLimit(bytes32 destinationBlockchainID, address destinationBridgeAddress, uint256 limit, uint256 window) returns()
+func (_NativeTokenSource *NativeTokenSourceTransactor) SetRateLimit(opts *bind.TransactOpts, destinationBlockchainID [32]byte, destinationBridgeAddress common.Address, limit *big.Int, window *big.Int) (*types.Transaction, error) {
+	return _NativeTokenSource.contract.Transact(opts, "setRateLimit", destinationBlockchainID, destinationBridgeAddress, limit, window)
+}
+
+// SetRateLimit is a paid mutator transaction binding the contract method 0xa86aba85.
+//
+// Solidity: function setRateLimit(bytes32 destinationBlockchainID, address destinationBridgeAddress, uint256 limit, uint256 window) returns()
+func (_NativeTokenSource *NativeTokenSourceSession) SetRateLimit(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address, limit *big.Int, window *big.Int) (*types.Transaction, error) {
+	return _NativeTokenSource.Contract.SetRateLimit(&_NativeTokenSource.TransactOpts, destinationBlockchainID, destinationBridgeAddress, limit, window)
+}
+
+// SetRateLimit is a paid mutator transaction binding the contract method 0xa86aba85.
+//
+// Solidity: function setRateLimit(bytes32 destinationBlockchainID, address destinationBridgeAddress, uint256 limit, uint256 window) returns()
+func (_NativeTokenSource *NativeTokenSourceTransactorSession) SetRateLimit(destinationBlockchainID [32]byte, destinationBridgeAddress common.Address, limit *big.Int, window *big.Int) (*types.Transaction, error) {
+	return _NativeTokenSource.Contract.SetRateLimit(&_NativeTokenSource.TransactOpts, destinationBlockchainID, destinationBridgeAddress, limit, window)
+}
+
 // TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
 //
 // Solidity: function transferOwnership(address newOwner) returns()
@@ -710,6 +952,27 @@ func (_NativeTokenSource *NativeTokenSourceTransactorSession) TransferOwnership(
 	return _NativeTokenSource.Contract.TransferOwnership(&_NativeTokenSource.TransactOpts, newOwner)
 }
 
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_NativeTokenSource *NativeTokenSourceTransactor) UnpauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeTokenSource.contract.Transact(opts, "unpauseSends")
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_NativeTokenSource *NativeTokenSourceSession) UnpauseSends() (*types.Transaction, error) {
+	return _NativeTokenSource.Contract.UnpauseSends(&_NativeTokenSource.TransactOpts)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_NativeTokenSource *NativeTokenSourceTransactorSession) UnpauseSends() (*types.Transaction, error) {
+	return _NativeTokenSource.Contract.UnpauseSends(&_NativeTokenSource.TransactOpts)
+}
+
 // UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
 //
 // Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
@@ -1680,9 +1943,9 @@ func (_NativeTokenSource *NativeTokenSourceFilterer) ParseOwnershipTransferred(l
 	return event, nil
 }
 
-// NativeTokenSourceTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the NativeTokenSource contract.
-type NativeTokenSourceTeleporterAddressPausedIterator struct {
-	Event *NativeTokenSourceTeleporterAddressPaused // Event containing the contract specifics and raw log
+// NativeTokenSourceRateLimitUpdatedIterator is returned from FilterRateLimitUpdated and is used to iterate over the raw logs and unpacked data for RateLimitUpdated events raised by the NativeTokenSource contract.
+type NativeTokenSourceRateLimitUpdatedIterator struct {
+	Event *NativeTokenSourceRateLimitUpdated // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1696,7 +1959,7 @@ type NativeTokenSourceTeleporterAddressPausedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NativeTokenSourceTeleporterAddressPausedIterator) Next() bool {
+func (it *NativeTokenSourceRateLimitUpdatedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1705,7 +1968,7 @@ func (it *NativeTokenSourceTeleporterAddressPausedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NativeTokenSourceTeleporterAddressPaused)
+			it.Event = new(NativeTokenSourceRateLimitUpdated)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1720,7 +1983,7 @@ func (it *NativeTokenSourceTeleporterAddressPausedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NativeTokenSourceTeleporterAddressPaused)
+		it.Event = new(NativeTokenSourceRateLimitUpdated)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1736,51 +1999,62 @@ func (it *NativeTokenSourceTeleporterAddressPausedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NativeTokenSourceTeleporterAddressPausedIterator) Error() error {
+func (it *NativeTokenSourceRateLimitUpdatedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NativeTokenSourceTeleporterAddressPausedIterator) Close() error {
+func (it *NativeTokenSourceRateLimitUpdatedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NativeTokenSourceTeleporterAddressPaused represents a TeleporterAddressPaused event raised by the NativeTokenSource contract.
-type NativeTokenSourceTeleporterAddressPaused struct {
-	TeleporterAddress common.Address
-	Raw               types.Log // Blockchain specific contextual infos
+// NativeTokenSourceRateLimitUpdated represents a RateLimitUpdated event raised by the NativeTokenSource contract.
+type NativeTokenSourceRateLimitUpdated struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+	Limit                    *big.Int
+	Window                   *big.Int
+	Raw                      types.Log // Blockchain specific contextual infos
 }
 
-// FilterTeleporterAddressPaused is a free log retrieval operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+// FilterRateLimitUpdated is a free log retrieval operation binding the contract event 0x10cd5d93bb79292b62622d5cd2f12124aae6e20fc3929e6fee2a466c0e8ef3a3.
 //
-// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
-func (_NativeTokenSource *NativeTokenSourceFilterer) FilterTeleporterAddressPaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*NativeTokenSourceTeleporterAddressPausedIterator, error) {
+// Solidity: event RateLimitUpdated(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress, uint256 limit, uint256 window)
+func (_NativeTokenSource *NativeTokenSourceFilterer) FilterRateLimitUpdated(opts *bind.FilterOpts, destinationBlockchainID [][32]byte, destinationBridgeAddress []common.Address) (*NativeTokenSourceRateLimitUpdatedIterator, error) {
 
-	var teleporterAddressRule []interface{}
-	for _, teleporterAddressItem := range teleporterAddress {
-		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	var destinationBlockchainIDRule []interface{}
+	for _, destinationBlockchainIDItem := range destinationBlockchainID {
+		destinationBlockchainIDRule = append(destinationBlockchainIDRule, destinationBlockchainIDItem)
+	}
+	var destinationBridgeAddressRule []interface{}
+	for _, destinationBridgeAddressItem := range destinationBridgeAddress {
+		destinationBridgeAddressRule = append(destinationBridgeAddressRule, destinationBridgeAddressItem)
 	}
 
-	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "RateLimitUpdated", destinationBlockchainIDRule, destinationBridgeAddressRule)
 	if err != nil {
 		return nil, err
 	}
-	return &NativeTokenSourceTeleporterAddressPausedIterator{contract: _NativeTokenSource.contract, event: "TeleporterAddressPaused", logs: logs, sub: sub}, nil
+	return &NativeTokenSourceRateLimitUpdatedIterator{contract: _NativeTokenSource.contract, event: "RateLimitUpdated", logs: logs, sub: sub}, nil
 }
 
-// WatchTeleporterAddressPaused is a free log subscription operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+// WatchRateLimitUpdated is a free log subscription operation binding the contract event 0x10cd5d93bb79292b62622d5cd2f12124aae6e20fc3929e6fee2a466c0e8ef3a3.
 //
-// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
-func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTeleporterAddressPaused(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceTeleporterAddressPaused, teleporterAddress []common.Address) (event.Subscription, error) {
+// Solidity: event RateLimitUpdated(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress, uint256 limit, uint256 window)
+func (_NativeTokenSource *NativeTokenSourceFilterer) WatchRateLimitUpdated(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceRateLimitUpdated, destinationBlockchainID [][32]byte, destinationBridgeAddress []common.Address) (event.Subscription, error) {
 
-	var teleporterAddressRule []interface{}
-	for _, teleporterAddressItem := range teleporterAddress {
-		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	var destinationBlockchainIDRule []interface{}
+	for _, destinationBlockchainIDItem := range destinationBlockchainID {
+		destinationBlockchainIDRule = append(destinationBlockchainIDRule, destinationBlockchainIDItem)
+	}
+	var destinationBridgeAddressRule []interface{}
+	for _, destinationBridgeAddressItem := range destinationBridgeAddress {
+		destinationBridgeAddressRule = append(destinationBridgeAddressRule, destinationBridgeAddressItem)
 	}
 
-	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "RateLimitUpdated", destinationBlockchainIDRule, destinationBridgeAddressRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1790,8 +2064,8 @@ func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTeleporterAddressPause
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NativeTokenSourceTeleporterAddressPaused)
-				if err := _NativeTokenSource.contract.UnpackLog(event, "TeleporterAddressPaused", log); err != nil {
+				event := new(NativeTokenSourceRateLimitUpdated)
+				if err := _NativeTokenSource.contract.UnpackLog(event, "RateLimitUpdated", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1812,21 +2086,21 @@ func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTeleporterAddressPause
 	}), nil
 }
 
-// ParseTeleporterAddressPaused is a log parse operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+// ParseRateLimitUpdated is a log parse operation binding the contract event 0x10cd5d93bb79292b62622d5cd2f12124aae6e20fc3929e6fee2a466c0e8ef3a3.
 //
-// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
-func (_NativeTokenSource *NativeTokenSourceFilterer) ParseTeleporterAddressPaused(log types.Log) (*NativeTokenSourceTeleporterAddressPaused, error) {
-	event := new(NativeTokenSourceTeleporterAddressPaused)
-	if err := _NativeTokenSource.contract.UnpackLog(event, "TeleporterAddressPaused", log); err != nil {
+// Solidity: event RateLimitUpdated(bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress, uint256 limit, uint256 window)
+func (_NativeTokenSource *NativeTokenSourceFilterer) ParseRateLimitUpdated(log types.Log) (*NativeTokenSourceRateLimitUpdated, error) {
+	event := new(NativeTokenSourceRateLimitUpdated)
+	if err := _NativeTokenSource.contract.UnpackLog(event, "RateLimitUpdated", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NativeTokenSourceTeleporterAddressUnpausedIterator is returned from FilterTeleporterAddressUnpaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressUnpaused events raised by the NativeTokenSource contract.
-type NativeTokenSourceTeleporterAddressUnpausedIterator struct {
-	Event *NativeTokenSourceTeleporterAddressUnpaused // Event containing the contract specifics and raw log
+// NativeTokenSourceSendsPausedIterator is returned from FilterSendsPaused and is used to iterate over the raw logs and unpacked data for SendsPaused events raised by the NativeTokenSource contract.
+type NativeTokenSourceSendsPausedIterator struct {
+	Event *NativeTokenSourceSendsPaused // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1840,7 +2114,7 @@ type NativeTokenSourceTeleporterAddressUnpausedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Next() bool {
+func (it *NativeTokenSourceSendsPausedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1849,7 +2123,7 @@ func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NativeTokenSourceTeleporterAddressUnpaused)
+			it.Event = new(NativeTokenSourceSendsPaused)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1864,7 +2138,7 @@ func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NativeTokenSourceTeleporterAddressUnpaused)
+		it.Event = new(NativeTokenSourceSendsPaused)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1880,51 +2154,51 @@ func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Error() error {
+func (it *NativeTokenSourceSendsPausedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Close() error {
+func (it *NativeTokenSourceSendsPausedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NativeTokenSourceTeleporterAddressUnpaused represents a TeleporterAddressUnpaused event raised by the NativeTokenSource contract.
-type NativeTokenSourceTeleporterAddressUnpaused struct {
-	TeleporterAddress common.Address
-	Raw               types.Log // Blockchain specific contextual infos
+// NativeTokenSourceSendsPaused represents a SendsPaused event raised by the NativeTokenSource contract.
+type NativeTokenSourceSendsPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterTeleporterAddressUnpaused is a free log retrieval operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+// FilterSendsPaused is a free log retrieval operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
 //
-// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
-func (_NativeTokenSource *NativeTokenSourceFilterer) FilterTeleporterAddressUnpaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*NativeTokenSourceTeleporterAddressUnpausedIterator, error) {
+// Solidity: event SendsPaused(address indexed account)
+func (_NativeTokenSource *NativeTokenSourceFilterer) FilterSendsPaused(opts *bind.FilterOpts, account []common.Address) (*NativeTokenSourceSendsPausedIterator, error) {
 
-	var teleporterAddressRule []interface{}
-	for _, teleporterAddressItem := range teleporterAddress {
-		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
 	}
 
-	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "TeleporterAddressUnpaused", teleporterAddressRule)
+	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "SendsPaused", accountRule)
 	if err != nil {
 		return nil, err
 	}
-	return &NativeTokenSourceTeleporterAddressUnpausedIterator{contract: _NativeTokenSource.contract, event: "TeleporterAddressUnpaused", logs: logs, sub: sub}, nil
+	return &NativeTokenSourceSendsPausedIterator{contract: _NativeTokenSource.contract, event: "SendsPaused", logs: logs, sub: sub}, nil
 }
 
-// WatchTeleporterAddressUnpaused is a free log subscription operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+// WatchSendsPaused is a free log subscription operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
 //
-// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
-func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTeleporterAddressUnpaused(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceTeleporterAddressUnpaused, teleporterAddress []common.Address) (event.Subscription, error) {
+// Solidity: event SendsPaused(address indexed account)
+func (_NativeTokenSource *NativeTokenSourceFilterer) WatchSendsPaused(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceSendsPaused, account []common.Address) (event.Subscription, error) {
 
-	var teleporterAddressRule []interface{}
-	for _, teleporterAddressItem := range teleporterAddress {
-		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
 	}
 
-	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "TeleporterAddressUnpaused", teleporterAddressRule)
+	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "SendsPaused", accountRule)
 	if err != nil {
 		return nil, err
 	}
@@ -1934,8 +2208,8 @@ func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTeleporterAddressUnpau
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NativeTokenSourceTeleporterAddressUnpaused)
-				if err := _NativeTokenSource.contract.UnpackLog(event, "TeleporterAddressUnpaused", log); err != nil {
+				event := new(NativeTokenSourceSendsPaused)
+				if err := _NativeTokenSource.contract.UnpackLog(event, "SendsPaused", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -1956,21 +2230,21 @@ func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTeleporterAddressUnpau
 	}), nil
 }
 
-// ParseTeleporterAddressUnpaused is a log parse operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+// ParseSendsPaused is a log parse operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
 //
-// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
-func (_NativeTokenSource *NativeTokenSourceFilterer) ParseTeleporterAddressUnpaused(log types.Log) (*NativeTokenSourceTeleporterAddressUnpaused, error) {
-	event := new(NativeTokenSourceTeleporterAddressUnpaused)
-	if err := _NativeTokenSource.contract.UnpackLog(event, "TeleporterAddressUnpaused", log); err != nil {
+// Solidity: event SendsPaused(address indexed account)
+func (_NativeTokenSource *NativeTokenSourceFilterer) ParseSendsPaused(log types.Log) (*NativeTokenSourceSendsPaused, error) {
+	event := new(NativeTokenSourceSendsPaused)
+	if err := _NativeTokenSource.contract.UnpackLog(event, "SendsPaused", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
 	return event, nil
 }
 
-// NativeTokenSourceTokensAndCallRoutedIterator is returned from FilterTokensAndCallRouted and is used to iterate over the raw logs and unpacked data for TokensAndCallRouted events raised by the NativeTokenSource contract.
-type NativeTokenSourceTokensAndCallRoutedIterator struct {
-	Event *NativeTokenSourceTokensAndCallRouted // Event containing the contract specifics and raw log
+// NativeTokenSourceSendsUnpausedIterator is returned from FilterSendsUnpaused and is used to iterate over the raw logs and unpacked data for SendsUnpaused events raised by the NativeTokenSource contract.
+type NativeTokenSourceSendsUnpausedIterator struct {
+	Event *NativeTokenSourceSendsUnpaused // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1984,7 +2258,7 @@ type NativeTokenSourceTokensAndCallRoutedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *NativeTokenSourceTokensAndCallRoutedIterator) Next() bool {
+func (it *NativeTokenSourceSendsUnpausedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1993,7 +2267,7 @@ func (it *NativeTokenSourceTokensAndCallRoutedIterator) Next() bool {
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(NativeTokenSourceTokensAndCallRouted)
+			it.Event = new(NativeTokenSourceSendsUnpaused)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -2008,7 +2282,7 @@ func (it *NativeTokenSourceTokensAndCallRoutedIterator) Next() bool {
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(NativeTokenSourceTokensAndCallRouted)
+		it.Event = new(NativeTokenSourceSendsUnpaused)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -2024,53 +2298,51 @@ func (it *NativeTokenSourceTokensAndCallRoutedIterator) Next() bool {
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *NativeTokenSourceTokensAndCallRoutedIterator) Error() error {
+func (it *NativeTokenSourceSendsUnpausedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *NativeTokenSourceTokensAndCallRoutedIterator) Close() error {
+func (it *NativeTokenSourceSendsUnpausedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// NativeTokenSourceTokensAndCallRouted represents a TokensAndCallRouted event raised by the NativeTokenSource contract.
-type NativeTokenSourceTokensAndCallRouted struct {
-	TeleporterMessageID [32]byte
-	Input               SendAndCallInput
-	Amount              *big.Int
-	Raw                 types.Log // Blockchain specific contextual infos
+// NativeTokenSourceSendsUnpaused represents a SendsUnpaused event raised by the NativeTokenSource contract.
+type NativeTokenSourceSendsUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
 }
 
-// FilterTokensAndCallRouted is a free log retrieval operation binding the contract event 0x42eff9005856e3c586b096d67211a566dc926052119fd7cc08023c70937ecb30.
+// FilterSendsUnpaused is a free log retrieval operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
 //
-// Solidity: event TokensAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 amount)
-func (_NativeTokenSource *NativeTokenSourceFilterer) FilterTokensAndCallRouted(opts *bind.FilterOpts, teleporterMessageID [][32]byte) (*NativeTokenSourceTokensAndCallRoutedIterator, error) {
+// Solidity: event SendsUnpaused(address indexed account)
+func (_NativeTokenSource *NativeTokenSourceFilterer) FilterSendsUnpaused(opts *bind.FilterOpts, account []common.Address) (*NativeTokenSourceSendsUnpausedIterator, error) {
 
-	var teleporterMessageIDRule []interface{}
-	for _, teleporterMessageIDItem := range teleporterMessageID {
-		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
 	}
 
-	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "TokensAndCallRouted", teleporterMessageIDRule)
+	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "SendsUnpaused", accountRule)
 	if err != nil {
 		return nil, err
 	}
-	return &NativeTokenSourceTokensAndCallRoutedIterator{contract: _NativeTokenSource.contract, event: "TokensAndCallRouted", logs: logs, sub: sub}, nil
+	return &NativeTokenSourceSendsUnpausedIterator{contract: _NativeTokenSource.contract, event: "SendsUnpaused", logs: logs, sub: sub}, nil
 }
 
-// WatchTokensAndCallRouted is a free log subscription operation binding the contract event 0x42eff9005856e3c586b096d67211a566dc926052119fd7cc08023c70937ecb30.
+// WatchSendsUnpaused is a free log subscription operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
 //
-// Solidity: event TokensAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 amount)
-func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTokensAndCallRouted(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceTokensAndCallRouted, teleporterMessageID [][32]byte) (event.Subscription, error) {
+// Solidity: event SendsUnpaused(address indexed account)
+func (_NativeTokenSource *NativeTokenSourceFilterer) WatchSendsUnpaused(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceSendsUnpaused, account []common.Address) (event.Subscription, error) {
 
-	var teleporterMessageIDRule []interface{}
-	for _, teleporterMessageIDItem := range teleporterMessageID {
-		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
 	}
 
-	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "TokensAndCallRouted", teleporterMessageIDRule)
+	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "SendsUnpaused", accountRule)
 	if err != nil {
 		return nil, err
 	}
@@ -2080,8 +2352,8 @@ func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTokensAndCallRouted(op
 			select {
 			case log := <-logs:
 				// New log arrived, parse the event and forward to the user
-				event := new(NativeTokenSourceTokensAndCallRouted)
-				if err := _NativeTokenSource.contract.UnpackLog(event, "TokensAndCallRouted", log); err != nil {
+				event := new(NativeTokenSourceSendsUnpaused)
+				if err := _NativeTokenSource.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
 					return err
 				}
 				event.Raw = log
@@ -2102,12 +2374,446 @@ func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTokensAndCallRouted(op
 	}), nil
 }
 
-// ParseTokensAndCallRouted is a log parse operation binding the contract event 0x42eff9005856e3c586b096d67211a566dc926052119fd7cc08023c70937ecb30.
+// ParseSendsUnpaused is a log parse operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
 //
-// Solidity: event TokensAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 amount)
-func (_NativeTokenSource *NativeTokenSourceFilterer) ParseTokensAndCallRouted(log types.Log) (*NativeTokenSourceTokensAndCallRouted, error) {
-	event := new(NativeTokenSourceTokensAndCallRouted)
-	if err := _NativeTokenSource.contract.UnpackLog(event, "TokensAndCallRouted", log); err != nil {
+// Solidity: event SendsUnpaused(address indexed account)
+func (_NativeTokenSource *NativeTokenSourceFilterer) ParseSendsUnpaused(log types.Log) (*NativeTokenSourceSendsUnpaused, error) {
+	event := new(NativeTokenSourceSendsUnpaused)
+	if err := _NativeTokenSource.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NativeTokenSourceTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the NativeTokenSource contract.
+type NativeTokenSourceTeleporterAddressPausedIterator struct {
+	Event *NativeTokenSourceTeleporterAddressPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NativeTokenSourceTeleporterAddressPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NativeTokenSourceTeleporterAddressPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NativeTokenSourceTeleporterAddressPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NativeTokenSourceTeleporterAddressPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NativeTokenSourceTeleporterAddressPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NativeTokenSourceTeleporterAddressPaused represents a TeleporterAddressPaused event raised by the NativeTokenSource contract.
+type NativeTokenSourceTeleporterAddressPaused struct {
+	TeleporterAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterTeleporterAddressPaused is a free log retrieval operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_NativeTokenSource *NativeTokenSourceFilterer) FilterTeleporterAddressPaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*NativeTokenSourceTeleporterAddressPausedIterator, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTokenSourceTeleporterAddressPausedIterator{contract: _NativeTokenSource.contract, event: "TeleporterAddressPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchTeleporterAddressPaused is a free log subscription operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTeleporterAddressPaused(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceTeleporterAddressPaused, teleporterAddress []common.Address) (event.Subscription, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NativeTokenSourceTeleporterAddressPaused)
+				if err := _NativeTokenSource.contract.UnpackLog(event, "TeleporterAddressPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTeleporterAddressPaused is a log parse operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_NativeTokenSource *NativeTokenSourceFilterer) ParseTeleporterAddressPaused(log types.Log) (*NativeTokenSourceTeleporterAddressPaused, error) {
+	event := new(NativeTokenSourceTeleporterAddressPaused)
+	if err := _NativeTokenSource.contract.UnpackLog(event, "TeleporterAddressPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NativeTokenSourceTeleporterAddressUnpausedIterator is returned from FilterTeleporterAddressUnpaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressUnpaused events raised by the NativeTokenSource contract.
+type NativeTokenSourceTeleporterAddressUnpausedIterator struct {
+	Event *NativeTokenSourceTeleporterAddressUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NativeTokenSourceTeleporterAddressUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NativeTokenSourceTeleporterAddressUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NativeTokenSourceTeleporterAddressUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NativeTokenSourceTeleporterAddressUnpaused represents a TeleporterAddressUnpaused event raised by the NativeTokenSource contract.
+type NativeTokenSourceTeleporterAddressUnpaused struct {
+	TeleporterAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterTeleporterAddressUnpaused is a free log retrieval operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_NativeTokenSource *NativeTokenSourceFilterer) FilterTeleporterAddressUnpaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*NativeTokenSourceTeleporterAddressUnpausedIterator, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "TeleporterAddressUnpaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTokenSourceTeleporterAddressUnpausedIterator{contract: _NativeTokenSource.contract, event: "TeleporterAddressUnpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchTeleporterAddressUnpaused is a free log subscription operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTeleporterAddressUnpaused(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceTeleporterAddressUnpaused, teleporterAddress []common.Address) (event.Subscription, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "TeleporterAddressUnpaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NativeTokenSourceTeleporterAddressUnpaused)
+				if err := _NativeTokenSource.contract.UnpackLog(event, "TeleporterAddressUnpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTeleporterAddressUnpaused is a log parse operation binding the contract event 0x844e2f3154214672229235858fd029d1dfd543901c6d05931f0bc2480a2d72c3.
+//
+// Solidity: event TeleporterAddressUnpaused(address indexed teleporterAddress)
+func (_NativeTokenSource *NativeTokenSourceFilterer) ParseTeleporterAddressUnpaused(log types.Log) (*NativeTokenSourceTeleporterAddressUnpaused, error) {
+	event := new(NativeTokenSourceTeleporterAddressUnpaused)
+	if err := _NativeTokenSource.contract.UnpackLog(event, "TeleporterAddressUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NativeTokenSourceTokensAndCallRoutedIterator is returned from FilterTokensAndCallRouted and is used to iterate over the raw logs and unpacked data for TokensAndCallRouted events raised by the NativeTokenSource contract.
+type NativeTokenSourceTokensAndCallRoutedIterator struct {
+	Event *NativeTokenSourceTokensAndCallRouted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NativeTokenSourceTokensAndCallRoutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NativeTokenSourceTokensAndCallRouted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NativeTokenSourceTokensAndCallRouted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NativeTokenSourceTokensAndCallRoutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NativeTokenSourceTokensAndCallRoutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NativeTokenSourceTokensAndCallRouted represents a TokensAndCallRouted event raised by the NativeTokenSource contract.
+type NativeTokenSourceTokensAndCallRouted struct {
+	TeleporterMessageID [32]byte
+	Input               SendAndCallInput
+	Amount              *big.Int
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensAndCallRouted is a free log retrieval operation binding the contract event 0x42eff9005856e3c586b096d67211a566dc926052119fd7cc08023c70937ecb30.
+//
+// Solidity: event TokensAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) FilterTokensAndCallRouted(opts *bind.FilterOpts, teleporterMessageID [][32]byte) (*NativeTokenSourceTokensAndCallRoutedIterator, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "TokensAndCallRouted", teleporterMessageIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTokenSourceTokensAndCallRoutedIterator{contract: _NativeTokenSource.contract, event: "TokensAndCallRouted", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensAndCallRouted is a free log subscription operation binding the contract event 0x42eff9005856e3c586b096d67211a566dc926052119fd7cc08023c70937ecb30.
+//
+// Solidity: event TokensAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) WatchTokensAndCallRouted(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceTokensAndCallRouted, teleporterMessageID [][32]byte) (event.Subscription, error) {
+
+	var teleporterMessageIDRule []interface{}
+	for _, teleporterMessageIDItem := range teleporterMessageID {
+		teleporterMessageIDRule = append(teleporterMessageIDRule, teleporterMessageIDItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "TokensAndCallRouted", teleporterMessageIDRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NativeTokenSourceTokensAndCallRouted)
+				if err := _NativeTokenSource.contract.UnpackLog(event, "TokensAndCallRouted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensAndCallRouted is a log parse operation binding the contract event 0x42eff9005856e3c586b096d67211a566dc926052119fd7cc08023c70937ecb30.
+//
+// Solidity: event TokensAndCallRouted(bytes32 indexed teleporterMessageID, (bytes32,address,address,bytes,uint256,uint256,address,address,address,uint256,uint256) input, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) ParseTokensAndCallRouted(log types.Log) (*NativeTokenSourceTokensAndCallRouted, error) {
+	event := new(NativeTokenSourceTokensAndCallRouted)
+	if err := _NativeTokenSource.contract.UnpackLog(event, "TokensAndCallRouted", log); err != nil {
 		return nil, err
 	}
 	event.Raw = log
@@ -2714,3 +3420,293 @@ func (_NativeTokenSource *NativeTokenSourceFilterer) ParseTokensWithdrawn(log ty
 	event.Raw = log
 	return event, nil
 }
+
+// NativeTokenSourceWithdrawalClaimedIterator is returned from FilterWithdrawalClaimed and is used to iterate over the raw logs and unpacked data for WithdrawalClaimed events raised by the NativeTokenSource contract.
+type NativeTokenSourceWithdrawalClaimedIterator struct {
+	Event *NativeTokenSourceWithdrawalClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NativeTokenSourceWithdrawalClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NativeTokenSourceWithdrawalClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NativeTokenSourceWithdrawalClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NativeTokenSourceWithdrawalClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NativeTokenSourceWithdrawalClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NativeTokenSourceWithdrawalClaimed represents a WithdrawalClaimed event raised by the NativeTokenSource contract.
+type NativeTokenSourceWithdrawalClaimed struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalClaimed is a free log retrieval operation binding the contract event 0xd3f990ddd0f1a820ca21d6f29136b25c504220122117b21de9ede5602b99c586.
+//
+// Solidity: event WithdrawalClaimed(address indexed recipient, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) FilterWithdrawalClaimed(opts *bind.FilterOpts, recipient []common.Address) (*NativeTokenSourceWithdrawalClaimedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "WithdrawalClaimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTokenSourceWithdrawalClaimedIterator{contract: _NativeTokenSource.contract, event: "WithdrawalClaimed", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalClaimed is a free log subscription operation binding the contract event 0xd3f990ddd0f1a820ca21d6f29136b25c504220122117b21de9ede5602b99c586.
+//
+// Solidity: event WithdrawalClaimed(address indexed recipient, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) WatchWithdrawalClaimed(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceWithdrawalClaimed, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "WithdrawalClaimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NativeTokenSourceWithdrawalClaimed)
+				if err := _NativeTokenSource.contract.UnpackLog(event, "WithdrawalClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalClaimed is a log parse operation binding the contract event 0xd3f990ddd0f1a820ca21d6f29136b25c504220122117b21de9ede5602b99c586.
+//
+// Solidity: event WithdrawalClaimed(address indexed recipient, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) ParseWithdrawalClaimed(log types.Log) (*NativeTokenSourceWithdrawalClaimed, error) {
+	event := new(NativeTokenSourceWithdrawalClaimed)
+	if err := _NativeTokenSource.contract.UnpackLog(event, "WithdrawalClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// NativeTokenSourceWithdrawalFailedIterator is returned from FilterWithdrawalFailed and is used to iterate over the raw logs and unpacked data for WithdrawalFailed events raised by the NativeTokenSource contract.
+type NativeTokenSourceWithdrawalFailedIterator struct {
+	Event *NativeTokenSourceWithdrawalFailed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NativeTokenSourceWithdrawalFailedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NativeTokenSourceWithdrawalFailed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NativeTokenSourceWithdrawalFailed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NativeTokenSourceWithdrawalFailedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NativeTokenSourceWithdrawalFailedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NativeTokenSourceWithdrawalFailed represents a WithdrawalFailed event raised by the NativeTokenSource contract.
+type NativeTokenSourceWithdrawalFailed struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdrawalFailed is a free log retrieval operation binding the contract event 0x92873d130824b495f22ad10f7f14028200557770e5986714318e78c54f3aa83c.
+//
+// Solidity: event WithdrawalFailed(address indexed recipient, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) FilterWithdrawalFailed(opts *bind.FilterOpts, recipient []common.Address) (*NativeTokenSourceWithdrawalFailedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.FilterLogs(opts, "WithdrawalFailed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTokenSourceWithdrawalFailedIterator{contract: _NativeTokenSource.contract, event: "WithdrawalFailed", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdrawalFailed is a free log subscription operation binding the contract event 0x92873d130824b495f22ad10f7f14028200557770e5986714318e78c54f3aa83c.
+//
+// Solidity: event WithdrawalFailed(address indexed recipient, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) WatchWithdrawalFailed(opts *bind.WatchOpts, sink chan<- *NativeTokenSourceWithdrawalFailed, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _NativeTokenSource.contract.WatchLogs(opts, "WithdrawalFailed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NativeTokenSourceWithdrawalFailed)
+				if err := _NativeTokenSource.contract.UnpackLog(event, "WithdrawalFailed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdrawalFailed is a log parse operation binding the contract event 0x92873d130824b495f22ad10f7f14028200557770e5986714318e78c54f3aa83c.
+//
+// Solidity: event WithdrawalFailed(address indexed recipient, uint256 amount)
+func (_NativeTokenSource *NativeTokenSourceFilterer) ParseWithdrawalFailed(log types.Log) (*NativeTokenSourceWithdrawalFailed, error) {
+	event := new(NativeTokenSourceWithdrawalFailed)
+	if err := _NativeTokenSource.contract.UnpackLog(event, "WithdrawalFailed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -64,7 +64,7 @@ type TeleporterFeeInfo struct {
 
 // TeleporterTokenDestinationMetaData contains all meta data concerning the TeleporterTokenDestination contract.
 var TeleporterTokenDestinationMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MULTI_HOP_CALL_GAS_PER_WORD\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MULTI_HOP_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REGISTER_DESTINATION_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"payloadSize\",\"type\":\"uint256\"}],\"name\":\"calculateNumWords\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialReserveImbalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isCollateralized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isRegistered\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"multiplyOnDestination\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"feeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structTeleporterFeeInfo\",\"name\":\"feeInfo\",\"type\":\"tuple\"}],\"name\":\"registerWithSource\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sourceBlockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenMultiplier\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenSourceAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallFailed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"CallSucceeded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"EscrowClaimed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"oldMinTeleporterVersion\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"newMinTeleporterVersion\",\"type\":\"uint256\"}],\"name\":\"MinTeleporterVersionUpdated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"RecipientBlocked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"RecipientUnblocked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"SendsUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"TeleporterAddressUnpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipientContract\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"recipientPayload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"recipientGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"fallbackRecipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"}],\"indexed\":false,\"internalType\":\"structSendAndCallInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensAndCallSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensEscrowed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"teleporterMessageID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"components\":[{\"internalType\":\"bytes32\",\"name\":\"destinationBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"destinationBridgeAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"primaryFeeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"primaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"secondaryFee\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"requiredGasLimit\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"multiHopFallback\",\"type\":\"address\"}],\"indexed\":false,\"internalType\":\"structSendTokensInput\",\"name\":\"input\",\"type\":\"tuple\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensSent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"TokensWithdrawn\",\"type\":\"event\"},{\"inputs\":[],\"name\":\"MULTI_HOP_CALL_GAS_PER_WORD\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"MULTI_HOP_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"REGISTER_DESTINATION_REQUIRED_GAS\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"blockRecipient\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"blockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"blockedRecipients\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"blocked\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"payloadSize\",\"type\":\"uint256\"}],\"name\":\"calculateNumWords\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"pure\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"claimEscrowedTokens\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"escrowedBalances\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getMinTeleporterVersion\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"initialReserveImbalance\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isCollateralized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isRegistered\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"isTeleporterAddressPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"multiplyOnDestination\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"pauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"pauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"message\",\"type\":\"bytes\"}],\"name\":\"receiveTeleporterMessage\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address\",\"name\":\"feeTokenAddress\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"internalType\":\"structTeleporterFeeInfo\",\"name\":\"feeInfo\",\"type\":\"tuple\"}],\"name\":\"registerWithSource\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sendsPaused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"sourceBlockchainID\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"teleporterRegistry\",\"outputs\":[{\"internalType\":\"contractTeleporterRegistry\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenMultiplier\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenSourceAddress\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"recipient\",\"type\":\"address\"}],\"name\":\"unblockRecipient\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"unpauseSends\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"teleporterAddress\",\"type\":\"address\"}],\"name\":\"unpauseTeleporterAddress\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"version\",\"type\":\"uint256\"}],\"name\":\"updateMinTeleporterVersion\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
 }
 
 // TeleporterTokenDestinationABI is the input ABI used to generate the binding from.
@@ -337,6 +337,37 @@ func (_TeleporterTokenDestination *TeleporterTokenDestinationCallerSession) Bloc
 	return _TeleporterTokenDestination.Contract.BlockchainID(&_TeleporterTokenDestination.CallOpts)
 }
 
+// BlockedRecipients is a free data retrieval call binding the contract method 0x205b10a0.
+//
+// Solidity: function blockedRecipients(address recipient) view returns(bool blocked)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationCaller) BlockedRecipients(opts *bind.CallOpts, recipient common.Address) (bool, error) {
+	var out []interface{}
+	err := _TeleporterTokenDestination.contract.Call(opts, &out, "blockedRecipients", recipient)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// BlockedRecipients is a free data retrieval call binding the contract method 0x205b10a0.
+//
+// Solidity: function blockedRecipients(address recipient) view returns(bool blocked)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationSession) BlockedRecipients(recipient common.Address) (bool, error) {
+	return _TeleporterTokenDestination.Contract.BlockedRecipients(&_TeleporterTokenDestination.CallOpts, recipient)
+}
+
+// BlockedRecipients is a free data retrieval call binding the contract method 0x205b10a0.
+//
+// Solidity: function blockedRecipients(address recipient) view returns(bool blocked)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationCallerSession) BlockedRecipients(recipient common.Address) (bool, error) {
+	return _TeleporterTokenDestination.Contract.BlockedRecipients(&_TeleporterTokenDestination.CallOpts, recipient)
+}
+
 // CalculateNumWords is a free data retrieval call binding the contract method 0xf3f981d8.
 //
 // Solidity: function calculateNumWords(uint256 payloadSize) pure returns(uint256)
@@ -368,6 +399,37 @@ func (_TeleporterTokenDestination *TeleporterTokenDestinationCallerSession) Calc
 	return _TeleporterTokenDestination.Contract.CalculateNumWords(&_TeleporterTokenDestination.CallOpts, payloadSize)
 }
 
+// EscrowedBalances is a free data retrieval call binding the contract method 0xd6074aba.
+//
+// Solidity: function escrowedBalances(address recipient) view returns(uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationCaller) EscrowedBalances(opts *bind.CallOpts, recipient common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _TeleporterTokenDestination.contract.Call(opts, &out, "escrowedBalances", recipient)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EscrowedBalances is a free data retrieval call binding the contract method 0xd6074aba.
+//
+// Solidity: function escrowedBalances(address recipient) view returns(uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationSession) EscrowedBalances(recipient common.Address) (*big.Int, error) {
+	return _TeleporterTokenDestination.Contract.EscrowedBalances(&_TeleporterTokenDestination.CallOpts, recipient)
+}
+
+// EscrowedBalances is a free data retrieval call binding the contract method 0xd6074aba.
+//
+// Solidity: function escrowedBalances(address recipient) view returns(uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationCallerSession) EscrowedBalances(recipient common.Address) (*big.Int, error) {
+	return _TeleporterTokenDestination.Contract.EscrowedBalances(&_TeleporterTokenDestination.CallOpts, recipient)
+}
+
 // GetMinTeleporterVersion is a free data retrieval call binding the contract method 0xd2cc7a70.
 //
 // Solidity: function getMinTeleporterVersion() view returns(uint256)
@@ -585,6 +647,37 @@ func (_TeleporterTokenDestination *TeleporterTokenDestinationCallerSession) Owne
 	return _TeleporterTokenDestination.Contract.Owner(&_TeleporterTokenDestination.CallOpts)
 }
 
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationCaller) SendsPaused(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _TeleporterTokenDestination.contract.Call(opts, &out, "sendsPaused")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationSession) SendsPaused() (bool, error) {
+	return _TeleporterTokenDestination.Contract.SendsPaused(&_TeleporterTokenDestination.CallOpts)
+}
+
+// SendsPaused is a free data retrieval call binding the contract method 0x4bcd2f78.
+//
+// Solidity: function sendsPaused() view returns(bool)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationCallerSession) SendsPaused() (bool, error) {
+	return _TeleporterTokenDestination.Contract.SendsPaused(&_TeleporterTokenDestination.CallOpts)
+}
+
 // SourceBlockchainID is a free data retrieval call binding the contract method 0x29b7b3fd.
 //
 // Solidity: function sourceBlockchainID() view returns(bytes32)
@@ -709,6 +802,69 @@ func (_TeleporterTokenDestination *TeleporterTokenDestinationCallerSession) Toke
 	return _TeleporterTokenDestination.Contract.TokenSourceAddress(&_TeleporterTokenDestination.CallOpts)
 }
 
+// BlockRecipient is a paid mutator transaction binding the contract method 0x0f2f5e51.
+//
+// Solidity: function blockRecipient(address recipient) returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactor) BlockRecipient(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.contract.Transact(opts, "blockRecipient", recipient)
+}
+
+// BlockRecipient is a paid mutator transaction binding the contract method 0x0f2f5e51.
+//
+// Solidity: function blockRecipient(address recipient) returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationSession) BlockRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.BlockRecipient(&_TeleporterTokenDestination.TransactOpts, recipient)
+}
+
+// BlockRecipient is a paid mutator transaction binding the contract method 0x0f2f5e51.
+//
+// Solidity: function blockRecipient(address recipient) returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactorSession) BlockRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.BlockRecipient(&_TeleporterTokenDestination.TransactOpts, recipient)
+}
+
+// ClaimEscrowedTokens is a paid mutator transaction binding the contract method 0x03018cc6.
+//
+// Solidity: function claimEscrowedTokens() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactor) ClaimEscrowedTokens(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.contract.Transact(opts, "claimEscrowedTokens")
+}
+
+// ClaimEscrowedTokens is a paid mutator transaction binding the contract method 0x03018cc6.
+//
+// Solidity: function claimEscrowedTokens() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationSession) ClaimEscrowedTokens() (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.ClaimEscrowedTokens(&_TeleporterTokenDestination.TransactOpts)
+}
+
+// ClaimEscrowedTokens is a paid mutator transaction binding the contract method 0x03018cc6.
+//
+// Solidity: function claimEscrowedTokens() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactorSession) ClaimEscrowedTokens() (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.ClaimEscrowedTokens(&_TeleporterTokenDestination.TransactOpts)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactor) PauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.contract.Transact(opts, "pauseSends")
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationSession) PauseSends() (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.PauseSends(&_TeleporterTokenDestination.TransactOpts)
+}
+
+// PauseSends is a paid mutator transaction binding the contract method 0xa96d8ef2.
+//
+// Solidity: function pauseSends() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactorSession) PauseSends() (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.PauseSends(&_TeleporterTokenDestination.TransactOpts)
+}
+
 // PauseTeleporterAddress is a paid mutator transaction binding the contract method 0x2b0d8f18.
 //
 // Solidity: function pauseTeleporterAddress(address teleporterAddress) returns()
@@ -814,6 +970,48 @@ func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactorSession)
 	return _TeleporterTokenDestination.Contract.TransferOwnership(&_TeleporterTokenDestination.TransactOpts, newOwner)
 }
 
+// UnblockRecipient is a paid mutator transaction binding the contract method 0x3c242106.
+//
+// Solidity: function unblockRecipient(address recipient) returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactor) UnblockRecipient(opts *bind.TransactOpts, recipient common.Address) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.contract.Transact(opts, "unblockRecipient", recipient)
+}
+
+// UnblockRecipient is a paid mutator transaction binding the contract method 0x3c242106.
+//
+// Solidity: function unblockRecipient(address recipient) returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationSession) UnblockRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.UnblockRecipient(&_TeleporterTokenDestination.TransactOpts, recipient)
+}
+
+// UnblockRecipient is a paid mutator transaction binding the contract method 0x3c242106.
+//
+// Solidity: function unblockRecipient(address recipient) returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactorSession) UnblockRecipient(recipient common.Address) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.UnblockRecipient(&_TeleporterTokenDestination.TransactOpts, recipient)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactor) UnpauseSends(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TeleporterTokenDestination.contract.Transact(opts, "unpauseSends")
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationSession) UnpauseSends() (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.UnpauseSends(&_TeleporterTokenDestination.TransactOpts)
+}
+
+// UnpauseSends is a paid mutator transaction binding the contract method 0xae1102ca.
+//
+// Solidity: function unpauseSends() returns()
+func (_TeleporterTokenDestination *TeleporterTokenDestinationTransactorSession) UnpauseSends() (*types.Transaction, error) {
+	return _TeleporterTokenDestination.Contract.UnpauseSends(&_TeleporterTokenDestination.TransactOpts)
+}
+
 // UnpauseTeleporterAddress is a paid mutator transaction binding the contract method 0x4511243e.
 //
 // Solidity: function unpauseTeleporterAddress(address teleporterAddress) returns()
@@ -1146,6 +1344,151 @@ func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseCall
 	return event, nil
 }
 
+// TeleporterTokenDestinationEscrowClaimedIterator is returned from FilterEscrowClaimed and is used to iterate over the raw logs and unpacked data for EscrowClaimed events raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationEscrowClaimedIterator struct {
+	Event *TeleporterTokenDestinationEscrowClaimed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TeleporterTokenDestinationEscrowClaimedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TeleporterTokenDestinationEscrowClaimed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TeleporterTokenDestinationEscrowClaimed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TeleporterTokenDestinationEscrowClaimedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TeleporterTokenDestinationEscrowClaimedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TeleporterTokenDestinationEscrowClaimed represents a EscrowClaimed event raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationEscrowClaimed struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterEscrowClaimed is a free log retrieval operation binding the contract event 0xcde81b0bf99f48ced5f97323017cb811ae69eb0acc58d1c9ae771f6768bee8c1.
+//
+// Solidity: event EscrowClaimed(address indexed recipient, uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) FilterEscrowClaimed(opts *bind.FilterOpts, recipient []common.Address) (*TeleporterTokenDestinationEscrowClaimedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.FilterLogs(opts, "EscrowClaimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TeleporterTokenDestinationEscrowClaimedIterator{contract: _TeleporterTokenDestination.contract, event: "EscrowClaimed", logs: logs, sub: sub}, nil
+}
+
+// WatchEscrowClaimed is a free log subscription operation binding the contract event 0xcde81b0bf99f48ced5f97323017cb811ae69eb0acc58d1c9ae771f6768bee8c1.
+//
+// Solidity: event EscrowClaimed(address indexed recipient, uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) WatchEscrowClaimed(opts *bind.WatchOpts, sink chan<- *TeleporterTokenDestinationEscrowClaimed, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.WatchLogs(opts, "EscrowClaimed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TeleporterTokenDestinationEscrowClaimed)
+				if err := _TeleporterTokenDestination.contract.UnpackLog(event, "EscrowClaimed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseEscrowClaimed is a log parse operation binding the contract event 0xcde81b0bf99f48ced5f97323017cb811ae69eb0acc58d1c9ae771f6768bee8c1.
+//
+// Solidity: event EscrowClaimed(address indexed recipient, uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseEscrowClaimed(log types.Log) (*TeleporterTokenDestinationEscrowClaimed, error) {
+	event := new(TeleporterTokenDestinationEscrowClaimed)
+	if err := _TeleporterTokenDestination.contract.UnpackLog(event, "EscrowClaimed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // TeleporterTokenDestinationMinTeleporterVersionUpdatedIterator is returned from FilterMinTeleporterVersionUpdated and is used to iterate over the raw logs and unpacked data for MinTeleporterVersionUpdated events raised by the TeleporterTokenDestination contract.
 type TeleporterTokenDestinationMinTeleporterVersionUpdatedIterator struct {
 	Event *TeleporterTokenDestinationMinTeleporterVersionUpdated // Event containing the contract specifics and raw log
@@ -1452,9 +1795,9 @@ func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseOwne
 	return event, nil
 }
 
-// TeleporterTokenDestinationTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the TeleporterTokenDestination contract.
-type TeleporterTokenDestinationTeleporterAddressPausedIterator struct {
-	Event *TeleporterTokenDestinationTeleporterAddressPaused // Event containing the contract specifics and raw log
+// TeleporterTokenDestinationRecipientBlockedIterator is returned from FilterRecipientBlocked and is used to iterate over the raw logs and unpacked data for RecipientBlocked events raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationRecipientBlockedIterator struct {
+	Event *TeleporterTokenDestinationRecipientBlocked // Event containing the contract specifics and raw log
 
 	contract *bind.BoundContract // Generic contract to use for unpacking event data
 	event    string              // Event name to use for unpacking event data
@@ -1468,7 +1811,7 @@ type TeleporterTokenDestinationTeleporterAddressPausedIterator struct {
 // Next advances the iterator to the subsequent event, returning whether there
 // are any more events found. In case of a retrieval or parsing error, false is
 // returned and Error() can be queried for the exact failure.
-func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Next() bool {
+func (it *TeleporterTokenDestinationRecipientBlockedIterator) Next() bool {
 	// If the iterator failed, stop iterating
 	if it.fail != nil {
 		return false
@@ -1477,7 +1820,7 @@ func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Next() bool
 	if it.done {
 		select {
 		case log := <-it.logs:
-			it.Event = new(TeleporterTokenDestinationTeleporterAddressPaused)
+			it.Event = new(TeleporterTokenDestinationRecipientBlocked)
 			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 				it.fail = err
 				return false
@@ -1492,7 +1835,7 @@ func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Next() bool
 	// Iterator still in progress, wait for either a data or an error event
 	select {
 	case log := <-it.logs:
-		it.Event = new(TeleporterTokenDestinationTeleporterAddressPaused)
+		it.Event = new(TeleporterTokenDestinationRecipientBlocked)
 		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
 			it.fail = err
 			return false
@@ -1508,48 +1851,642 @@ func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Next() bool
 }
 
 // Error returns any retrieval or parsing error occurred during filtering.
-func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Error() error {
+func (it *TeleporterTokenDestinationRecipientBlockedIterator) Error() error {
 	return it.fail
 }
 
 // Close terminates the iteration process, releasing any pending underlying
 // resources.
-func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Close() error {
+func (it *TeleporterTokenDestinationRecipientBlockedIterator) Close() error {
 	it.sub.Unsubscribe()
 	return nil
 }
 
-// TeleporterTokenDestinationTeleporterAddressPaused represents a TeleporterAddressPaused event raised by the TeleporterTokenDestination contract.
-type TeleporterTokenDestinationTeleporterAddressPaused struct {
-	TeleporterAddress common.Address
-	Raw               types.Log // Blockchain specific contextual infos
+// TeleporterTokenDestinationRecipientBlocked represents a RecipientBlocked event raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationRecipientBlocked struct {
+	Recipient common.Address
+	Account   common.Address
+	Raw       types.Log // Blockchain specific contextual infos
 }
 
-// FilterTeleporterAddressPaused is a free log retrieval operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+// FilterRecipientBlocked is a free log retrieval operation binding the contract event 0xdeb8edc6672dcdbb8c0fd33ce2205670d309907a2d4939e64370e6ccc1403892.
 //
-// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
-func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) FilterTeleporterAddressPaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*TeleporterTokenDestinationTeleporterAddressPausedIterator, error) {
+// Solidity: event RecipientBlocked(address indexed recipient, address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) FilterRecipientBlocked(opts *bind.FilterOpts, recipient []common.Address, account []common.Address) (*TeleporterTokenDestinationRecipientBlockedIterator, error) {
 
-	var teleporterAddressRule []interface{}
-	for _, teleporterAddressItem := range teleporterAddress {
-		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
 	}
 
-	logs, sub, err := _TeleporterTokenDestination.contract.FilterLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	logs, sub, err := _TeleporterTokenDestination.contract.FilterLogs(opts, "RecipientBlocked", recipientRule, accountRule)
 	if err != nil {
 		return nil, err
 	}
-	return &TeleporterTokenDestinationTeleporterAddressPausedIterator{contract: _TeleporterTokenDestination.contract, event: "TeleporterAddressPaused", logs: logs, sub: sub}, nil
+	return &TeleporterTokenDestinationRecipientBlockedIterator{contract: _TeleporterTokenDestination.contract, event: "RecipientBlocked", logs: logs, sub: sub}, nil
 }
 
-// WatchTeleporterAddressPaused is a free log subscription operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+// WatchRecipientBlocked is a free log subscription operation binding the contract event 0xdeb8edc6672dcdbb8c0fd33ce2205670d309907a2d4939e64370e6ccc1403892.
 //
-// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
-func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) WatchTeleporterAddressPaused(opts *bind.WatchOpts, sink chan<- *TeleporterTokenDestinationTeleporterAddressPaused, teleporterAddress []common.Address) (event.Subscription, error) {
+// Solidity: event RecipientBlocked(address indexed recipient, address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) WatchRecipientBlocked(opts *bind.WatchOpts, sink chan<- *TeleporterTokenDestinationRecipientBlocked, recipient []common.Address, account []common.Address) (event.Subscription, error) {
 
-	var teleporterAddressRule []interface{}
-	for _, teleporterAddressItem := range teleporterAddress {
-		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.WatchLogs(opts, "RecipientBlocked", recipientRule, accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TeleporterTokenDestinationRecipientBlocked)
+				if err := _TeleporterTokenDestination.contract.UnpackLog(event, "RecipientBlocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRecipientBlocked is a log parse operation binding the contract event 0xdeb8edc6672dcdbb8c0fd33ce2205670d309907a2d4939e64370e6ccc1403892.
+//
+// Solidity: event RecipientBlocked(address indexed recipient, address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseRecipientBlocked(log types.Log) (*TeleporterTokenDestinationRecipientBlocked, error) {
+	event := new(TeleporterTokenDestinationRecipientBlocked)
+	if err := _TeleporterTokenDestination.contract.UnpackLog(event, "RecipientBlocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TeleporterTokenDestinationRecipientUnblockedIterator is returned from FilterRecipientUnblocked and is used to iterate over the raw logs and unpacked data for RecipientUnblocked events raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationRecipientUnblockedIterator struct {
+	Event *TeleporterTokenDestinationRecipientUnblocked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TeleporterTokenDestinationRecipientUnblockedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TeleporterTokenDestinationRecipientUnblocked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TeleporterTokenDestinationRecipientUnblocked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TeleporterTokenDestinationRecipientUnblockedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TeleporterTokenDestinationRecipientUnblockedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TeleporterTokenDestinationRecipientUnblocked represents a RecipientUnblocked event raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationRecipientUnblocked struct {
+	Recipient common.Address
+	Account   common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterRecipientUnblocked is a free log retrieval operation binding the contract event 0x5ba1fef13af3a10d449b576e50b96e823f45b1b3ed112c7ba14dd2f4faee8266.
+//
+// Solidity: event RecipientUnblocked(address indexed recipient, address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) FilterRecipientUnblocked(opts *bind.FilterOpts, recipient []common.Address, account []common.Address) (*TeleporterTokenDestinationRecipientUnblockedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.FilterLogs(opts, "RecipientUnblocked", recipientRule, accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TeleporterTokenDestinationRecipientUnblockedIterator{contract: _TeleporterTokenDestination.contract, event: "RecipientUnblocked", logs: logs, sub: sub}, nil
+}
+
+// WatchRecipientUnblocked is a free log subscription operation binding the contract event 0x5ba1fef13af3a10d449b576e50b96e823f45b1b3ed112c7ba14dd2f4faee8266.
+//
+// Solidity: event RecipientUnblocked(address indexed recipient, address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) WatchRecipientUnblocked(opts *bind.WatchOpts, sink chan<- *TeleporterTokenDestinationRecipientUnblocked, recipient []common.Address, account []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.WatchLogs(opts, "RecipientUnblocked", recipientRule, accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TeleporterTokenDestinationRecipientUnblocked)
+				if err := _TeleporterTokenDestination.contract.UnpackLog(event, "RecipientUnblocked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRecipientUnblocked is a log parse operation binding the contract event 0x5ba1fef13af3a10d449b576e50b96e823f45b1b3ed112c7ba14dd2f4faee8266.
+//
+// Solidity: event RecipientUnblocked(address indexed recipient, address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseRecipientUnblocked(log types.Log) (*TeleporterTokenDestinationRecipientUnblocked, error) {
+	event := new(TeleporterTokenDestinationRecipientUnblocked)
+	if err := _TeleporterTokenDestination.contract.UnpackLog(event, "RecipientUnblocked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TeleporterTokenDestinationSendsPausedIterator is returned from FilterSendsPaused and is used to iterate over the raw logs and unpacked data for SendsPaused events raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationSendsPausedIterator struct {
+	Event *TeleporterTokenDestinationSendsPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TeleporterTokenDestinationSendsPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TeleporterTokenDestinationSendsPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TeleporterTokenDestinationSendsPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TeleporterTokenDestinationSendsPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TeleporterTokenDestinationSendsPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TeleporterTokenDestinationSendsPaused represents a SendsPaused event raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationSendsPaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsPaused is a free log retrieval operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) FilterSendsPaused(opts *bind.FilterOpts, account []common.Address) (*TeleporterTokenDestinationSendsPausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.FilterLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TeleporterTokenDestinationSendsPausedIterator{contract: _TeleporterTokenDestination.contract, event: "SendsPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsPaused is a free log subscription operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) WatchSendsPaused(opts *bind.WatchOpts, sink chan<- *TeleporterTokenDestinationSendsPaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.WatchLogs(opts, "SendsPaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TeleporterTokenDestinationSendsPaused)
+				if err := _TeleporterTokenDestination.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsPaused is a log parse operation binding the contract event 0x6df0736fc7d95a19ea9ce888db26b5abfe99066582f4e3debd8f34ddc22746fa.
+//
+// Solidity: event SendsPaused(address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseSendsPaused(log types.Log) (*TeleporterTokenDestinationSendsPaused, error) {
+	event := new(TeleporterTokenDestinationSendsPaused)
+	if err := _TeleporterTokenDestination.contract.UnpackLog(event, "SendsPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TeleporterTokenDestinationSendsUnpausedIterator is returned from FilterSendsUnpaused and is used to iterate over the raw logs and unpacked data for SendsUnpaused events raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationSendsUnpausedIterator struct {
+	Event *TeleporterTokenDestinationSendsUnpaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TeleporterTokenDestinationSendsUnpausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TeleporterTokenDestinationSendsUnpaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TeleporterTokenDestinationSendsUnpaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TeleporterTokenDestinationSendsUnpausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TeleporterTokenDestinationSendsUnpausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TeleporterTokenDestinationSendsUnpaused represents a SendsUnpaused event raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationSendsUnpaused struct {
+	Account common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSendsUnpaused is a free log retrieval operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) FilterSendsUnpaused(opts *bind.FilterOpts, account []common.Address) (*TeleporterTokenDestinationSendsUnpausedIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.FilterLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TeleporterTokenDestinationSendsUnpausedIterator{contract: _TeleporterTokenDestination.contract, event: "SendsUnpaused", logs: logs, sub: sub}, nil
+}
+
+// WatchSendsUnpaused is a free log subscription operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) WatchSendsUnpaused(opts *bind.WatchOpts, sink chan<- *TeleporterTokenDestinationSendsUnpaused, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.WatchLogs(opts, "SendsUnpaused", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TeleporterTokenDestinationSendsUnpaused)
+				if err := _TeleporterTokenDestination.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSendsUnpaused is a log parse operation binding the contract event 0xe3d186a13c1b1d71830f1b656784a05b333d9a2680e14a3f1899ec8c97ee3773.
+//
+// Solidity: event SendsUnpaused(address indexed account)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseSendsUnpaused(log types.Log) (*TeleporterTokenDestinationSendsUnpaused, error) {
+	event := new(TeleporterTokenDestinationSendsUnpaused)
+	if err := _TeleporterTokenDestination.contract.UnpackLog(event, "SendsUnpaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TeleporterTokenDestinationTeleporterAddressPausedIterator is returned from FilterTeleporterAddressPaused and is used to iterate over the raw logs and unpacked data for TeleporterAddressPaused events raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationTeleporterAddressPausedIterator struct {
+	Event *TeleporterTokenDestinationTeleporterAddressPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TeleporterTokenDestinationTeleporterAddressPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TeleporterTokenDestinationTeleporterAddressPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TeleporterTokenDestinationTeleporterAddressPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TeleporterTokenDestinationTeleporterAddressPaused represents a TeleporterAddressPaused event raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationTeleporterAddressPaused struct {
+	TeleporterAddress common.Address
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterTeleporterAddressPaused is a free log retrieval operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) FilterTeleporterAddressPaused(opts *bind.FilterOpts, teleporterAddress []common.Address) (*TeleporterTokenDestinationTeleporterAddressPausedIterator, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.FilterLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TeleporterTokenDestinationTeleporterAddressPausedIterator{contract: _TeleporterTokenDestination.contract, event: "TeleporterAddressPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchTeleporterAddressPaused is a free log subscription operation binding the contract event 0x933f93e57a222e6330362af8b376d0a8725b6901e9a2fb86d00f169702b28a4c.
+//
+// Solidity: event TeleporterAddressPaused(address indexed teleporterAddress)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) WatchTeleporterAddressPaused(opts *bind.WatchOpts, sink chan<- *TeleporterTokenDestinationTeleporterAddressPaused, teleporterAddress []common.Address) (event.Subscription, error) {
+
+	var teleporterAddressRule []interface{}
+	for _, teleporterAddressItem := range teleporterAddress {
+		teleporterAddressRule = append(teleporterAddressRule, teleporterAddressItem)
 	}
 
 	logs, sub, err := _TeleporterTokenDestination.contract.WatchLogs(opts, "TeleporterAddressPaused", teleporterAddressRule)
@@ -1895,6 +2832,151 @@ func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseToke
 	return event, nil
 }
 
+// TeleporterTokenDestinationTokensEscrowedIterator is returned from FilterTokensEscrowed and is used to iterate over the raw logs and unpacked data for TokensEscrowed events raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationTokensEscrowedIterator struct {
+	Event *TeleporterTokenDestinationTokensEscrowed // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TeleporterTokenDestinationTokensEscrowedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TeleporterTokenDestinationTokensEscrowed)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TeleporterTokenDestinationTokensEscrowed)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TeleporterTokenDestinationTokensEscrowedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TeleporterTokenDestinationTokensEscrowedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TeleporterTokenDestinationTokensEscrowed represents a TokensEscrowed event raised by the TeleporterTokenDestination contract.
+type TeleporterTokenDestinationTokensEscrowed struct {
+	Recipient common.Address
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensEscrowed is a free log retrieval operation binding the contract event 0xd59334be1cb8a5ea0e1e4479039a4b41e2e6d0d42c71c924087f13c67fa1a339.
+//
+// Solidity: event TokensEscrowed(address indexed recipient, uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) FilterTokensEscrowed(opts *bind.FilterOpts, recipient []common.Address) (*TeleporterTokenDestinationTokensEscrowedIterator, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.FilterLogs(opts, "TokensEscrowed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TeleporterTokenDestinationTokensEscrowedIterator{contract: _TeleporterTokenDestination.contract, event: "TokensEscrowed", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensEscrowed is a free log subscription operation binding the contract event 0xd59334be1cb8a5ea0e1e4479039a4b41e2e6d0d42c71c924087f13c67fa1a339.
+//
+// Solidity: event TokensEscrowed(address indexed recipient, uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) WatchTokensEscrowed(opts *bind.WatchOpts, sink chan<- *TeleporterTokenDestinationTokensEscrowed, recipient []common.Address) (event.Subscription, error) {
+
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _TeleporterTokenDestination.contract.WatchLogs(opts, "TokensEscrowed", recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TeleporterTokenDestinationTokensEscrowed)
+				if err := _TeleporterTokenDestination.contract.UnpackLog(event, "TokensEscrowed", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensEscrowed is a log parse operation binding the contract event 0xd59334be1cb8a5ea0e1e4479039a4b41e2e6d0d42c71c924087f13c67fa1a339.
+//
+// Solidity: event TokensEscrowed(address indexed recipient, uint256 amount)
+func (_TeleporterTokenDestination *TeleporterTokenDestinationFilterer) ParseTokensEscrowed(log types.Log) (*TeleporterTokenDestinationTokensEscrowed, error) {
+	event := new(TeleporterTokenDestinationTokensEscrowed)
+	if err := _TeleporterTokenDestination.contract.UnpackLog(event, "TokensEscrowed", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // TeleporterTokenDestinationTokensSentIterator is returned from FilterTokensSent and is used to iterate over the raw logs and unpacked data for TokensSent events raised by the TeleporterTokenDestination contract.
 type TeleporterTokenDestinationTokensSentIterator struct {
 	Event *TeleporterTokenDestinationTokensSent // Event containing the contract specifics and raw log
@@ -0,0 +1,14 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package bindings contains the abigen Go bindings of the bridge contracts, with one package per contract.
+// The bindings are a separate module, depending only on subnet-evm and go-ethereum, so that they can be imported
+// by other services without the dependencies of the tests and tooling in this repository. Releases of the module
+// are tagged abi-bindings/go/vX.Y.Z.
+//
+// The bindings are generated from the Foundry artifacts of the contracts by cmd/abi-bindings-gen, by running
+//
+//	go generate ./cmd/abi-bindings-gen
+//
+// from the root of the repository, which requires forge to be installed.
+package bindings
@@ -0,0 +1,391 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package exampleerc20swapreceiver
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = interfaces.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ExampleERC20SwapReceiverMetaData contains all meta data concerning the ExampleERC20SwapReceiver contract.
+var ExampleERC20SwapReceiverMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"dexAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"dex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractMockDEX\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"receiveTokens\",\"inputs\":[{\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"originSenderAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"payload\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"TokensSwapped\",\"inputs\":[{\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"originSenderAddress\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false}]",
+}
+
+// ExampleERC20SwapReceiverABI is the input ABI used to generate the binding from.
+// Deprecated: Use ExampleERC20SwapReceiverMetaData.ABI instead.
+var ExampleERC20SwapReceiverABI = ExampleERC20SwapReceiverMetaData.ABI
+
+// ExampleERC20SwapReceiver is an auto generated Go binding around an Ethereum contract.
+type ExampleERC20SwapReceiver struct {
+	ExampleERC20SwapReceiverCaller     // Read-only binding to the contract
+	ExampleERC20SwapReceiverTransactor // Write-only binding to the contract
+	ExampleERC20SwapReceiverFilterer   // Log filterer for contract events
+}
+
+// ExampleERC20SwapReceiverCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ExampleERC20SwapReceiverCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExampleERC20SwapReceiverTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ExampleERC20SwapReceiverTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExampleERC20SwapReceiverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ExampleERC20SwapReceiverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ExampleERC20SwapReceiverSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ExampleERC20SwapReceiverSession struct {
+	Contract     *ExampleERC20SwapReceiver // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts             // Call options to use throughout this session
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ExampleERC20SwapReceiverCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ExampleERC20SwapReceiverCallerSession struct {
+	Contract *ExampleERC20SwapReceiverCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                   // Call options to use throughout this session
+}
+
+// ExampleERC20SwapReceiverTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ExampleERC20SwapReceiverTransactorSession struct {
+	Contract     *ExampleERC20SwapReceiverTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                   // Transaction auth options to use throughout this session
+}
+
+// ExampleERC20SwapReceiverRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ExampleERC20SwapReceiverRaw struct {
+	Contract *ExampleERC20SwapReceiver // Generic contract binding to access the raw methods on
+}
+
+// ExampleERC20SwapReceiverCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ExampleERC20SwapReceiverCallerRaw struct {
+	Contract *ExampleERC20SwapReceiverCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ExampleERC20SwapReceiverTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ExampleERC20SwapReceiverTransactorRaw struct {
+	Contract *ExampleERC20SwapReceiverTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewExampleERC20SwapReceiver creates a new instance of ExampleERC20SwapReceiver, bound to a specific deployed contract.
+func NewExampleERC20SwapReceiver(address common.Address, backend bind.ContractBackend) (*ExampleERC20SwapReceiver, error) {
+	contract, err := bindExampleERC20SwapReceiver(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ExampleERC20SwapReceiver{ExampleERC20SwapReceiverCaller: ExampleERC20SwapReceiverCaller{contract: contract}, ExampleERC20SwapReceiverTransactor: ExampleERC20SwapReceiverTransactor{contract: contract}, ExampleERC20SwapReceiverFilterer: ExampleERC20SwapReceiverFilterer{contract: contract}}, nil
+}
+
+// NewExampleERC20SwapReceiverCaller creates a new read-only instance of ExampleERC20SwapReceiver, bound to a specific deployed contract.
+func NewExampleERC20SwapReceiverCaller(address common.Address, caller bind.ContractCaller) (*ExampleERC20SwapReceiverCaller, error) {
+	contract, err := bindExampleERC20SwapReceiver(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ExampleERC20SwapReceiverCaller{contract: contract}, nil
+}
+
+// NewExampleERC20SwapReceiverTransactor creates a new write-only instance of ExampleERC20SwapReceiver, bound to a specific deployed contract.
+func NewExampleERC20SwapReceiverTransactor(address common.Address, transactor bind.ContractTransactor) (*ExampleERC20SwapReceiverTransactor, error) {
+	contract, err := bindExampleERC20SwapReceiver(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ExampleERC20SwapReceiverTransactor{contract: contract}, nil
+}
+
+// NewExampleERC20SwapReceiverFilterer creates a new log filterer instance of ExampleERC20SwapReceiver, bound to a specific deployed contract.
+func NewExampleERC20SwapReceiverFilterer(address common.Address, filterer bind.ContractFilterer) (*ExampleERC20SwapReceiverFilterer, error) {
+	contract, err := bindExampleERC20SwapReceiver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ExampleERC20SwapReceiverFilterer{contract: contract}, nil
+}
+
+// bindExampleERC20SwapReceiver binds a generic wrapper to an already deployed contract.
+func bindExampleERC20SwapReceiver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ExampleERC20SwapReceiverMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ExampleERC20SwapReceiver.Contract.ExampleERC20SwapReceiverCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExampleERC20SwapReceiver.Contract.ExampleERC20SwapReceiverTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ExampleERC20SwapReceiver.Contract.ExampleERC20SwapReceiverTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ExampleERC20SwapReceiver.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ExampleERC20SwapReceiver.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ExampleERC20SwapReceiver.Contract.contract.Transact(opts, method, params...)
+}
+
+// Dex is a free data retrieval call binding the contract method 0x692058c2.
+//
+// Solidity: function dex() view returns(address)
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverCaller) Dex(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ExampleERC20SwapReceiver.contract.Call(opts, &out, "dex")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Dex is a free data retrieval call binding the contract method 0x692058c2.
+//
+// Solidity: function dex() view returns(address)
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverSession) Dex() (common.Address, error) {
+	return _ExampleERC20SwapReceiver.Contract.Dex(&_ExampleERC20SwapReceiver.CallOpts)
+}
+
+// Dex is a free data retrieval call binding the contract method 0x692058c2.
+//
+// Solidity: function dex() view returns(address)
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverCallerSession) Dex() (common.Address, error) {
+	return _ExampleERC20SwapReceiver.Contract.Dex(&_ExampleERC20SwapReceiver.CallOpts)
+}
+
+// ReceiveTokens is a paid mutator transaction binding the contract method 0x329d940d.
+//
+// Solidity: function receiveTokens(bytes32 sourceBlockchainID, address originSenderAddress, address token, uint256 amount, bytes payload) returns()
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverTransactor) ReceiveTokens(opts *bind.TransactOpts, sourceBlockchainID [32]byte, originSenderAddress common.Address, token common.Address, amount *big.Int, payload []byte) (*types.Transaction, error) {
+	return _ExampleERC20SwapReceiver.contract.Transact(opts, "receiveTokens", sourceBlockchainID, originSenderAddress, token, amount, payload)
+}
+
+// ReceiveTokens is a paid mutator transaction binding the contract method 0x329d940d.
+//
+// Solidity: function receiveTokens(bytes32 sourceBlockchainID, address originSenderAddress, address token, uint256 amount, bytes payload) returns()
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverSession) ReceiveTokens(sourceBlockchainID [32]byte, originSenderAddress common.Address, token common.Address, amount *big.Int, payload []byte) (*types.Transaction, error) {
+	return _ExampleERC20SwapReceiver.Contract.ReceiveTokens(&_ExampleERC20SwapReceiver.TransactOpts, sourceBlockchainID, originSenderAddress, token, amount, payload)
+}
+
+// ReceiveTokens is a paid mutator transaction binding the contract method 0x329d940d.
+//
+// Solidity: function receiveTokens(bytes32 sourceBlockchainID, address originSenderAddress, address token, uint256 amount, bytes payload) returns()
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverTransactorSession) ReceiveTokens(sourceBlockchainID [32]byte, originSenderAddress common.Address, token common.Address, amount *big.Int, payload []byte) (*types.Transaction, error) {
+	return _ExampleERC20SwapReceiver.Contract.ReceiveTokens(&_ExampleERC20SwapReceiver.TransactOpts, sourceBlockchainID, originSenderAddress, token, amount, payload)
+}
+
+// ExampleERC20SwapReceiverTokensSwappedIterator is returned from FilterTokensSwapped and is used to iterate over the raw logs and unpacked data for TokensSwapped events raised by the ExampleERC20SwapReceiver contract.
+type ExampleERC20SwapReceiverTokensSwappedIterator struct {
+	Event *ExampleERC20SwapReceiverTokensSwapped // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *ExampleERC20SwapReceiverTokensSwappedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(ExampleERC20SwapReceiverTokensSwapped)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(ExampleERC20SwapReceiverTokensSwapped)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *ExampleERC20SwapReceiverTokensSwappedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *ExampleERC20SwapReceiverTokensSwappedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// ExampleERC20SwapReceiverTokensSwapped represents a TokensSwapped event raised by the ExampleERC20SwapReceiver contract.
+type ExampleERC20SwapReceiverTokensSwapped struct {
+	SourceBlockchainID  [32]byte
+	OriginSenderAddress common.Address
+	TokenIn             common.Address
+	AmountIn            *big.Int
+	TokenOut            common.Address
+	AmountOut           *big.Int
+	Recipient           common.Address
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokensSwapped is a free log retrieval operation binding the contract event 0xdbcc50749822c1b731951b1b843f1c05661b1ecc9d66a46bdfc5fe5a2c3fd623.
+//
+// Solidity: event TokensSwapped(bytes32 indexed sourceBlockchainID, address indexed originSenderAddress, address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut, address recipient)
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverFilterer) FilterTokensSwapped(opts *bind.FilterOpts, sourceBlockchainID [][32]byte, originSenderAddress []common.Address) (*ExampleERC20SwapReceiverTokensSwappedIterator, error) {
+
+	var sourceBlockchainIDRule []interface{}
+	for _, sourceBlockchainIDItem := range sourceBlockchainID {
+		sourceBlockchainIDRule = append(sourceBlockchainIDRule, sourceBlockchainIDItem)
+	}
+	var originSenderAddressRule []interface{}
+	for _, originSenderAddressItem := range originSenderAddress {
+		originSenderAddressRule = append(originSenderAddressRule, originSenderAddressItem)
+	}
+
+	logs, sub, err := _ExampleERC20SwapReceiver.contract.FilterLogs(opts, "TokensSwapped", sourceBlockchainIDRule, originSenderAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &ExampleERC20SwapReceiverTokensSwappedIterator{contract: _ExampleERC20SwapReceiver.contract, event: "TokensSwapped", logs: logs, sub: sub}, nil
+}
+
+// WatchTokensSwapped is a free log subscription operation binding the contract event 0xdbcc50749822c1b731951b1b843f1c05661b1ecc9d66a46bdfc5fe5a2c3fd623.
+//
+// Solidity: event TokensSwapped(bytes32 indexed sourceBlockchainID, address indexed originSenderAddress, address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut, address recipient)
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverFilterer) WatchTokensSwapped(opts *bind.WatchOpts, sink chan<- *ExampleERC20SwapReceiverTokensSwapped, sourceBlockchainID [][32]byte, originSenderAddress []common.Address) (event.Subscription, error) {
+
+	var sourceBlockchainIDRule []interface{}
+	for _, sourceBlockchainIDItem := range sourceBlockchainID {
+		sourceBlockchainIDRule = append(sourceBlockchainIDRule, sourceBlockchainIDItem)
+	}
+	var originSenderAddressRule []interface{}
+	for _, originSenderAddressItem := range originSenderAddress {
+		originSenderAddressRule = append(originSenderAddressRule, originSenderAddressItem)
+	}
+
+	logs, sub, err := _ExampleERC20SwapReceiver.contract.WatchLogs(opts, "TokensSwapped", sourceBlockchainIDRule, originSenderAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(ExampleERC20SwapReceiverTokensSwapped)
+				if err := _ExampleERC20SwapReceiver.contract.UnpackLog(event, "TokensSwapped", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokensSwapped is a log parse operation binding the contract event 0xdbcc50749822c1b731951b1b843f1c05661b1ecc9d66a46bdfc5fe5a2c3fd623.
+//
+// Solidity: event TokensSwapped(bytes32 indexed sourceBlockchainID, address indexed originSenderAddress, address tokenIn, uint256 amountIn, address tokenOut, uint256 amountOut, address recipient)
+func (_ExampleERC20SwapReceiver *ExampleERC20SwapReceiverFilterer) ParseTokensSwapped(log types.Log) (*ExampleERC20SwapReceiverTokensSwapped, error) {
+	event := new(ExampleERC20SwapReceiverTokensSwapped)
+	if err := _ExampleERC20SwapReceiver.contract.UnpackLog(event, "TokensSwapped", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,481 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package mockdex
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = interfaces.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MockDEXMetaData contains all meta data concerning the MockDEX contract.
+var MockDEXMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"RATE_DENOMINATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAmountOut\",\"inputs\":[{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"rates\",\"inputs\":[{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"rate\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setRate\",\"inputs\":[{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"rate\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"swap\",\"inputs\":[{\"name\":\"tokenIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"minAmountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"Swap\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenIn\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"tokenOut\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amountOut\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false}]",
+}
+
+// MockDEXABI is the input ABI used to generate the binding from.
+// Deprecated: Use MockDEXMetaData.ABI instead.
+var MockDEXABI = MockDEXMetaData.ABI
+
+// MockDEX is an auto generated Go binding around an Ethereum contract.
+type MockDEX struct {
+	MockDEXCaller     // Read-only binding to the contract
+	MockDEXTransactor // Write-only binding to the contract
+	MockDEXFilterer   // Log filterer for contract events
+}
+
+// MockDEXCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MockDEXCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockDEXTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MockDEXTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockDEXFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MockDEXFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockDEXSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MockDEXSession struct {
+	Contract     *MockDEX          // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MockDEXCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MockDEXCallerSession struct {
+	Contract *MockDEXCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts  // Call options to use throughout this session
+}
+
+// MockDEXTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MockDEXTransactorSession struct {
+	Contract     *MockDEXTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// MockDEXRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MockDEXRaw struct {
+	Contract *MockDEX // Generic contract binding to access the raw methods on
+}
+
+// MockDEXCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MockDEXCallerRaw struct {
+	Contract *MockDEXCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MockDEXTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MockDEXTransactorRaw struct {
+	Contract *MockDEXTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMockDEX creates a new instance of MockDEX, bound to a specific deployed contract.
+func NewMockDEX(address common.Address, backend bind.ContractBackend) (*MockDEX, error) {
+	contract, err := bindMockDEX(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MockDEX{MockDEXCaller: MockDEXCaller{contract: contract}, MockDEXTransactor: MockDEXTransactor{contract: contract}, MockDEXFilterer: MockDEXFilterer{contract: contract}}, nil
+}
+
+// NewMockDEXCaller creates a new read-only instance of MockDEX, bound to a specific deployed contract.
+func NewMockDEXCaller(address common.Address, caller bind.ContractCaller) (*MockDEXCaller, error) {
+	contract, err := bindMockDEX(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MockDEXCaller{contract: contract}, nil
+}
+
+// NewMockDEXTransactor creates a new write-only instance of MockDEX, bound to a specific deployed contract.
+func NewMockDEXTransactor(address common.Address, transactor bind.ContractTransactor) (*MockDEXTransactor, error) {
+	contract, err := bindMockDEX(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MockDEXTransactor{contract: contract}, nil
+}
+
+// NewMockDEXFilterer creates a new log filterer instance of MockDEX, bound to a specific deployed contract.
+func NewMockDEXFilterer(address common.Address, filterer bind.ContractFilterer) (*MockDEXFilterer, error) {
+	contract, err := bindMockDEX(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MockDEXFilterer{contract: contract}, nil
+}
+
+// bindMockDEX binds a generic wrapper to an already deployed contract.
+func bindMockDEX(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MockDEXMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MockDEX *MockDEXRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MockDEX.Contract.MockDEXCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MockDEX *MockDEXRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MockDEX.Contract.MockDEXTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MockDEX *MockDEXRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MockDEX.Contract.MockDEXTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MockDEX *MockDEXCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MockDEX.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MockDEX *MockDEXTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MockDEX.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MockDEX *MockDEXTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MockDEX.Contract.contract.Transact(opts, method, params...)
+}
+
+// RATEDENOMINATOR is a free data retrieval call binding the contract method 0x7efad8e0.
+//
+// Solidity: function RATE_DENOMINATOR() view returns(uint256)
+func (_MockDEX *MockDEXCaller) RATEDENOMINATOR(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MockDEX.contract.Call(opts, &out, "RATE_DENOMINATOR")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// RATEDENOMINATOR is a free data retrieval call binding the contract method 0x7efad8e0.
+//
+// Solidity: function RATE_DENOMINATOR() view returns(uint256)
+func (_MockDEX *MockDEXSession) RATEDENOMINATOR() (*big.Int, error) {
+	return _MockDEX.Contract.RATEDENOMINATOR(&_MockDEX.CallOpts)
+}
+
+// RATEDENOMINATOR is a free data retrieval call binding the contract method 0x7efad8e0.
+//
+// Solidity: function RATE_DENOMINATOR() view returns(uint256)
+func (_MockDEX *MockDEXCallerSession) RATEDENOMINATOR() (*big.Int, error) {
+	return _MockDEX.Contract.RATEDENOMINATOR(&_MockDEX.CallOpts)
+}
+
+// GetAmountOut is a free data retrieval call binding the contract method 0x4aa06652.
+//
+// Solidity: function getAmountOut(address tokenIn, address tokenOut, uint256 amountIn) view returns(uint256)
+func (_MockDEX *MockDEXCaller) GetAmountOut(opts *bind.CallOpts, tokenIn common.Address, tokenOut common.Address, amountIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _MockDEX.contract.Call(opts, &out, "getAmountOut", tokenIn, tokenOut, amountIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetAmountOut is a free data retrieval call binding the contract method 0x4aa06652.
+//
+// Solidity: function getAmountOut(address tokenIn, address tokenOut, uint256 amountIn) view returns(uint256)
+func (_MockDEX *MockDEXSession) GetAmountOut(tokenIn common.Address, tokenOut common.Address, amountIn *big.Int) (*big.Int, error) {
+	return _MockDEX.Contract.GetAmountOut(&_MockDEX.CallOpts, tokenIn, tokenOut, amountIn)
+}
+
+// GetAmountOut is a free data retrieval call binding the contract method 0x4aa06652.
+//
+// Solidity: function getAmountOut(address tokenIn, address tokenOut, uint256 amountIn) view returns(uint256)
+func (_MockDEX *MockDEXCallerSession) GetAmountOut(tokenIn common.Address, tokenOut common.Address, amountIn *big.Int) (*big.Int, error) {
+	return _MockDEX.Contract.GetAmountOut(&_MockDEX.CallOpts, tokenIn, tokenOut, amountIn)
+}
+
+// Rates is a free data retrieval call binding the contract method 0x7b0cf44d.
+//
+// Solidity: function rates(address tokenIn, address tokenOut) view returns(uint256 rate)
+func (_MockDEX *MockDEXCaller) Rates(opts *bind.CallOpts, tokenIn common.Address, tokenOut common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MockDEX.contract.Call(opts, &out, "rates", tokenIn, tokenOut)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Rates is a free data retrieval call binding the contract method 0x7b0cf44d.
+//
+// Solidity: function rates(address tokenIn, address tokenOut) view returns(uint256 rate)
+func (_MockDEX *MockDEXSession) Rates(tokenIn common.Address, tokenOut common.Address) (*big.Int, error) {
+	return _MockDEX.Contract.Rates(&_MockDEX.CallOpts, tokenIn, tokenOut)
+}
+
+// Rates is a free data retrieval call binding the contract method 0x7b0cf44d.
+//
+// Solidity: function rates(address tokenIn, address tokenOut) view returns(uint256 rate)
+func (_MockDEX *MockDEXCallerSession) Rates(tokenIn common.Address, tokenOut common.Address) (*big.Int, error) {
+	return _MockDEX.Contract.Rates(&_MockDEX.CallOpts, tokenIn, tokenOut)
+}
+
+// SetRate is a paid mutator transaction binding the contract method 0x5911fb9a.
+//
+// Solidity: function setRate(address tokenIn, address tokenOut, uint256 rate) returns()
+func (_MockDEX *MockDEXTransactor) SetRate(opts *bind.TransactOpts, tokenIn common.Address, tokenOut common.Address, rate *big.Int) (*types.Transaction, error) {
+	return _MockDEX.contract.Transact(opts, "setRate", tokenIn, tokenOut, rate)
+}
+
+// SetRate is a paid mutator transaction binding the contract method 0x5911fb9a.
+//
+// Solidity: function setRate(address tokenIn, address tokenOut, uint256 rate) returns()
+func (_MockDEX *MockDEXSession) SetRate(tokenIn common.Address, tokenOut common.Address, rate *big.Int) (*types.Transaction, error) {
+	return _MockDEX.Contract.SetRate(&_MockDEX.TransactOpts, tokenIn, tokenOut, rate)
+}
+
+// SetRate is a paid mutator transaction binding the contract method 0x5911fb9a.
+//
+// Solidity: function setRate(address tokenIn, address tokenOut, uint256 rate) returns()
+func (_MockDEX *MockDEXTransactorSession) SetRate(tokenIn common.Address, tokenOut common.Address, rate *big.Int) (*types.Transaction, error) {
+	return _MockDEX.Contract.SetRate(&_MockDEX.TransactOpts, tokenIn, tokenOut, rate)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0xd5bcb9b5.
+//
+// Solidity: function swap(address tokenIn, address tokenOut, uint256 amountIn, uint256 minAmountOut, address recipient) returns(uint256 amountOut)
+func (_MockDEX *MockDEXTransactor) Swap(opts *bind.TransactOpts, tokenIn common.Address, tokenOut common.Address, amountIn *big.Int, minAmountOut *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _MockDEX.contract.Transact(opts, "swap", tokenIn, tokenOut, amountIn, minAmountOut, recipient)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0xd5bcb9b5.
+//
+// Solidity: function swap(address tokenIn, address tokenOut, uint256 amountIn, uint256 minAmountOut, address recipient) returns(uint256 amountOut)
+func (_MockDEX *MockDEXSession) Swap(tokenIn common.Address, tokenOut common.Address, amountIn *big.Int, minAmountOut *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _MockDEX.Contract.Swap(&_MockDEX.TransactOpts, tokenIn, tokenOut, amountIn, minAmountOut, recipient)
+}
+
+// Swap is a paid mutator transaction binding the contract method 0xd5bcb9b5.
+//
+// Solidity: function swap(address tokenIn, address tokenOut, uint256 amountIn, uint256 minAmountOut, address recipient) returns(uint256 amountOut)
+func (_MockDEX *MockDEXTransactorSession) Swap(tokenIn common.Address, tokenOut common.Address, amountIn *big.Int, minAmountOut *big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _MockDEX.Contract.Swap(&_MockDEX.TransactOpts, tokenIn, tokenOut, amountIn, minAmountOut, recipient)
+}
+
+// MockDEXSwapIterator is returned from FilterSwap and is used to iterate over the raw logs and unpacked data for Swap events raised by the MockDEX contract.
+type MockDEXSwapIterator struct {
+	Event *MockDEXSwap // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MockDEXSwapIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MockDEXSwap)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MockDEXSwap)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MockDEXSwapIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MockDEXSwapIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MockDEXSwap represents a Swap event raised by the MockDEX contract.
+type MockDEXSwap struct {
+	Sender    common.Address
+	TokenIn   common.Address
+	TokenOut  common.Address
+	AmountIn  *big.Int
+	AmountOut *big.Int
+	Recipient common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSwap is a free log retrieval operation binding the contract event 0x54787c404bb33c88e86f4baf88183a3b0141d0a848e6a9f7a13b66ae3a9b73d1.
+//
+// Solidity: event Swap(address indexed sender, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut, address recipient)
+func (_MockDEX *MockDEXFilterer) FilterSwap(opts *bind.FilterOpts, sender []common.Address, tokenIn []common.Address, tokenOut []common.Address) (*MockDEXSwapIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+	var tokenOutRule []interface{}
+	for _, tokenOutItem := range tokenOut {
+		tokenOutRule = append(tokenOutRule, tokenOutItem)
+	}
+
+	logs, sub, err := _MockDEX.contract.FilterLogs(opts, "Swap", senderRule, tokenInRule, tokenOutRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MockDEXSwapIterator{contract: _MockDEX.contract, event: "Swap", logs: logs, sub: sub}, nil
+}
+
+// WatchSwap is a free log subscription operation binding the contract event 0x54787c404bb33c88e86f4baf88183a3b0141d0a848e6a9f7a13b66ae3a9b73d1.
+//
+// Solidity: event Swap(address indexed sender, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut, address recipient)
+func (_MockDEX *MockDEXFilterer) WatchSwap(opts *bind.WatchOpts, sink chan<- *MockDEXSwap, sender []common.Address, tokenIn []common.Address, tokenOut []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+	var tokenInRule []interface{}
+	for _, tokenInItem := range tokenIn {
+		tokenInRule = append(tokenInRule, tokenInItem)
+	}
+	var tokenOutRule []interface{}
+	for _, tokenOutItem := range tokenOut {
+		tokenOutRule = append(tokenOutRule, tokenOutItem)
+	}
+
+	logs, sub, err := _MockDEX.contract.WatchLogs(opts, "Swap", senderRule, tokenInRule, tokenOutRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MockDEXSwap)
+				if err := _MockDEX.contract.UnpackLog(event, "Swap", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSwap is a log parse operation binding the contract event 0x54787c404bb33c88e86f4baf88183a3b0141d0a848e6a9f7a13b66ae3a9b73d1.
+//
+// Solidity: event Swap(address indexed sender, address indexed tokenIn, address indexed tokenOut, uint256 amountIn, uint256 amountOut, address recipient)
+func (_MockDEX *MockDEXFilterer) ParseSwap(log types.Log) (*MockDEXSwap, error) {
+	event := new(MockDEXSwap)
+	if err := _MockDEX.contract.UnpackLog(event, "Swap", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
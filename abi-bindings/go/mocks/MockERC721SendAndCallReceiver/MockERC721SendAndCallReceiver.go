@@ -0,0 +1,410 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package mockerc721sendandcallreceiver
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = interfaces.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// MockERC721SendAndCallReceiverMetaData contains all meta data concerning the MockERC721SendAndCallReceiver contract.
+var MockERC721SendAndCallReceiverMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"}],\"name\":\"TokenReceived\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"blockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"senderAddress\",\"type\":\"address\"}],\"name\":\"blockSender\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"blockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"senderAddress\",\"type\":\"address\"}],\"name\":\"blockedSenders\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"blocked\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"sourceBlockchainID\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"originSenderAddress\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"}],\"name\":\"receiveToken\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+}
+
+// MockERC721SendAndCallReceiverABI is the input ABI used to generate the binding from.
+// Deprecated: Use MockERC721SendAndCallReceiverMetaData.ABI instead.
+var MockERC721SendAndCallReceiverABI = MockERC721SendAndCallReceiverMetaData.ABI
+
+// MockERC721SendAndCallReceiver is an auto generated Go binding around an Ethereum contract.
+type MockERC721SendAndCallReceiver struct {
+	MockERC721SendAndCallReceiverCaller     // Read-only binding to the contract
+	MockERC721SendAndCallReceiverTransactor // Write-only binding to the contract
+	MockERC721SendAndCallReceiverFilterer   // Log filterer for contract events
+}
+
+// MockERC721SendAndCallReceiverCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MockERC721SendAndCallReceiverCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockERC721SendAndCallReceiverTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MockERC721SendAndCallReceiverTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockERC721SendAndCallReceiverFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MockERC721SendAndCallReceiverFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MockERC721SendAndCallReceiverSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MockERC721SendAndCallReceiverSession struct {
+	Contract     *MockERC721SendAndCallReceiver // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                  // Call options to use throughout this session
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// MockERC721SendAndCallReceiverCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MockERC721SendAndCallReceiverCallerSession struct {
+	Contract *MockERC721SendAndCallReceiverCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                        // Call options to use throughout this session
+}
+
+// MockERC721SendAndCallReceiverTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MockERC721SendAndCallReceiverTransactorSession struct {
+	Contract     *MockERC721SendAndCallReceiverTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                        // Transaction auth options to use throughout this session
+}
+
+// MockERC721SendAndCallReceiverRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MockERC721SendAndCallReceiverRaw struct {
+	Contract *MockERC721SendAndCallReceiver // Generic contract binding to access the raw methods on
+}
+
+// MockERC721SendAndCallReceiverCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MockERC721SendAndCallReceiverCallerRaw struct {
+	Contract *MockERC721SendAndCallReceiverCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MockERC721SendAndCallReceiverTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MockERC721SendAndCallReceiverTransactorRaw struct {
+	Contract *MockERC721SendAndCallReceiverTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMockERC721SendAndCallReceiver creates a new instance of MockERC721SendAndCallReceiver, bound to a specific deployed contract.
+func NewMockERC721SendAndCallReceiver(address common.Address, backend bind.ContractBackend) (*MockERC721SendAndCallReceiver, error) {
+	contract, err := bindMockERC721SendAndCallReceiver(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MockERC721SendAndCallReceiver{MockERC721SendAndCallReceiverCaller: MockERC721SendAndCallReceiverCaller{contract: contract}, MockERC721SendAndCallReceiverTransactor: MockERC721SendAndCallReceiverTransactor{contract: contract}, MockERC721SendAndCallReceiverFilterer: MockERC721SendAndCallReceiverFilterer{contract: contract}}, nil
+}
+
+// NewMockERC721SendAndCallReceiverCaller creates a new read-only instance of MockERC721SendAndCallReceiver, bound to a specific deployed contract.
+func NewMockERC721SendAndCallReceiverCaller(address common.Address, caller bind.ContractCaller) (*MockERC721SendAndCallReceiverCaller, error) {
+	contract, err := bindMockERC721SendAndCallReceiver(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MockERC721SendAndCallReceiverCaller{contract: contract}, nil
+}
+
+// NewMockERC721SendAndCallReceiverTransactor creates a new write-only instance of MockERC721SendAndCallReceiver, bound to a specific deployed contract.
+func NewMockERC721SendAndCallReceiverTransactor(address common.Address, transactor bind.ContractTransactor) (*MockERC721SendAndCallReceiverTransactor, error) {
+	contract, err := bindMockERC721SendAndCallReceiver(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MockERC721SendAndCallReceiverTransactor{contract: contract}, nil
+}
+
+// NewMockERC721SendAndCallReceiverFilterer creates a new log filterer instance of MockERC721SendAndCallReceiver, bound to a specific deployed contract.
+func NewMockERC721SendAndCallReceiverFilterer(address common.Address, filterer bind.ContractFilterer) (*MockERC721SendAndCallReceiverFilterer, error) {
+	contract, err := bindMockERC721SendAndCallReceiver(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MockERC721SendAndCallReceiverFilterer{contract: contract}, nil
+}
+
+// bindMockERC721SendAndCallReceiver binds a generic wrapper to an already deployed contract.
+func bindMockERC721SendAndCallReceiver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MockERC721SendAndCallReceiverMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MockERC721SendAndCallReceiver.Contract.MockERC721SendAndCallReceiverCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.Contract.MockERC721SendAndCallReceiverTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.Contract.MockERC721SendAndCallReceiverTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MockERC721SendAndCallReceiver.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.Contract.contract.Transact(opts, method, params...)
+}
+
+// BlockedSenders is a free data retrieval call binding the contract method 0x487bd69e.
+//
+// Solidity: function blockedSenders(bytes32 blockchainID, address senderAddress) view returns(bool blocked)
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverCaller) BlockedSenders(opts *bind.CallOpts, blockchainID [32]byte, senderAddress common.Address) (bool, error) {
+	var out []interface{}
+	err := _MockERC721SendAndCallReceiver.contract.Call(opts, &out, "blockedSenders", blockchainID, senderAddress)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// BlockedSenders is a free data retrieval call binding the contract method 0x487bd69e.
+//
+// Solidity: function blockedSenders(bytes32 blockchainID, address senderAddress) view returns(bool blocked)
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverSession) BlockedSenders(blockchainID [32]byte, senderAddress common.Address) (bool, error) {
+	return _MockERC721SendAndCallReceiver.Contract.BlockedSenders(&_MockERC721SendAndCallReceiver.CallOpts, blockchainID, senderAddress)
+}
+
+// BlockedSenders is a free data retrieval call binding the contract method 0x487bd69e.
+//
+// Solidity: function blockedSenders(bytes32 blockchainID, address senderAddress) view returns(bool blocked)
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverCallerSession) BlockedSenders(blockchainID [32]byte, senderAddress common.Address) (bool, error) {
+	return _MockERC721SendAndCallReceiver.Contract.BlockedSenders(&_MockERC721SendAndCallReceiver.CallOpts, blockchainID, senderAddress)
+}
+
+// BlockSender is a paid mutator transaction binding the contract method 0x7f450d8d.
+//
+// Solidity: function blockSender(bytes32 blockchainID, address senderAddress) returns()
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverTransactor) BlockSender(opts *bind.TransactOpts, blockchainID [32]byte, senderAddress common.Address) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.contract.Transact(opts, "blockSender", blockchainID, senderAddress)
+}
+
+// BlockSender is a paid mutator transaction binding the contract method 0x7f450d8d.
+//
+// Solidity: function blockSender(bytes32 blockchainID, address senderAddress) returns()
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverSession) BlockSender(blockchainID [32]byte, senderAddress common.Address) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.Contract.BlockSender(&_MockERC721SendAndCallReceiver.TransactOpts, blockchainID, senderAddress)
+}
+
+// BlockSender is a paid mutator transaction binding the contract method 0x7f450d8d.
+//
+// Solidity: function blockSender(bytes32 blockchainID, address senderAddress) returns()
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverTransactorSession) BlockSender(blockchainID [32]byte, senderAddress common.Address) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.Contract.BlockSender(&_MockERC721SendAndCallReceiver.TransactOpts, blockchainID, senderAddress)
+}
+
+// ReceiveToken is a paid mutator transaction binding the contract method 0x218c3cdd.
+//
+// Solidity: function receiveToken(bytes32 sourceBlockchainID, address originSenderAddress, address token, uint256 tokenId, bytes payload) returns()
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverTransactor) ReceiveToken(opts *bind.TransactOpts, sourceBlockchainID [32]byte, originSenderAddress common.Address, token common.Address, tokenId *big.Int, payload []byte) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.contract.Transact(opts, "receiveToken", sourceBlockchainID, originSenderAddress, token, tokenId, payload)
+}
+
+// ReceiveToken is a paid mutator transaction binding the contract method 0x218c3cdd.
+//
+// Solidity: function receiveToken(bytes32 sourceBlockchainID, address originSenderAddress, address token, uint256 tokenId, bytes payload) returns()
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverSession) ReceiveToken(sourceBlockchainID [32]byte, originSenderAddress common.Address, token common.Address, tokenId *big.Int, payload []byte) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.Contract.ReceiveToken(&_MockERC721SendAndCallReceiver.TransactOpts, sourceBlockchainID, originSenderAddress, token, tokenId, payload)
+}
+
+// ReceiveToken is a paid mutator transaction binding the contract method 0x218c3cdd.
+//
+// Solidity: function receiveToken(bytes32 sourceBlockchainID, address originSenderAddress, address token, uint256 tokenId, bytes payload) returns()
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverTransactorSession) ReceiveToken(sourceBlockchainID [32]byte, originSenderAddress common.Address, token common.Address, tokenId *big.Int, payload []byte) (*types.Transaction, error) {
+	return _MockERC721SendAndCallReceiver.Contract.ReceiveToken(&_MockERC721SendAndCallReceiver.TransactOpts, sourceBlockchainID, originSenderAddress, token, tokenId, payload)
+}
+
+// MockERC721SendAndCallReceiverTokenReceivedIterator is returned from FilterTokenReceived and is used to iterate over the raw logs and unpacked data for TokenReceived events raised by the MockERC721SendAndCallReceiver contract.
+type MockERC721SendAndCallReceiverTokenReceivedIterator struct {
+	Event *MockERC721SendAndCallReceiverTokenReceived // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log          // Log channel receiving the found contract events
+	sub  interfaces.Subscription // Subscription for errors, completion and termination
+	done bool                    // Whether the subscription completed delivering logs
+	fail error                   // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *MockERC721SendAndCallReceiverTokenReceivedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(MockERC721SendAndCallReceiverTokenReceived)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(MockERC721SendAndCallReceiverTokenReceived)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *MockERC721SendAndCallReceiverTokenReceivedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *MockERC721SendAndCallReceiverTokenReceivedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// MockERC721SendAndCallReceiverTokenReceived represents a TokenReceived event raised by the MockERC721SendAndCallReceiver contract.
+type MockERC721SendAndCallReceiverTokenReceived struct {
+	SourceBlockchainID  [32]byte
+	OriginSenderAddress common.Address
+	Token               common.Address
+	TokenId             *big.Int
+	Payload             []byte
+	Raw                 types.Log // Blockchain specific contextual infos
+}
+
+// FilterTokenReceived is a free log retrieval operation binding the contract event 0x2ad905dff330a136bf9450e633bd389b5ad2eb97d8873b262a72a11644dc5dc9.
+//
+// Solidity: event TokenReceived(bytes32 indexed sourceBlockchainID, address indexed originSenderAddress, address token, uint256 tokenId, bytes payload)
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverFilterer) FilterTokenReceived(opts *bind.FilterOpts, sourceBlockchainID [][32]byte, originSenderAddress []common.Address) (*MockERC721SendAndCallReceiverTokenReceivedIterator, error) {
+
+	var sourceBlockchainIDRule []interface{}
+	for _, sourceBlockchainIDItem := range sourceBlockchainID {
+		sourceBlockchainIDRule = append(sourceBlockchainIDRule, sourceBlockchainIDItem)
+	}
+	var originSenderAddressRule []interface{}
+	for _, originSenderAddressItem := range originSenderAddress {
+		originSenderAddressRule = append(originSenderAddressRule, originSenderAddressItem)
+	}
+
+	logs, sub, err := _MockERC721SendAndCallReceiver.contract.FilterLogs(opts, "TokenReceived", sourceBlockchainIDRule, originSenderAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return &MockERC721SendAndCallReceiverTokenReceivedIterator{contract: _MockERC721SendAndCallReceiver.contract, event: "TokenReceived", logs: logs, sub: sub}, nil
+}
+
+// WatchTokenReceived is a free log subscription operation binding the contract event 0x2ad905dff330a136bf9450e633bd389b5ad2eb97d8873b262a72a11644dc5dc9.
+//
+// Solidity: event TokenReceived(bytes32 indexed sourceBlockchainID, address indexed originSenderAddress, address token, uint256 tokenId, bytes payload)
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverFilterer) WatchTokenReceived(opts *bind.WatchOpts, sink chan<- *MockERC721SendAndCallReceiverTokenReceived, sourceBlockchainID [][32]byte, originSenderAddress []common.Address) (event.Subscription, error) {
+
+	var sourceBlockchainIDRule []interface{}
+	for _, sourceBlockchainIDItem := range sourceBlockchainID {
+		sourceBlockchainIDRule = append(sourceBlockchainIDRule, sourceBlockchainIDItem)
+	}
+	var originSenderAddressRule []interface{}
+	for _, originSenderAddressItem := range originSenderAddress {
+		originSenderAddressRule = append(originSenderAddressRule, originSenderAddressItem)
+	}
+
+	logs, sub, err := _MockERC721SendAndCallReceiver.contract.WatchLogs(opts, "TokenReceived", sourceBlockchainIDRule, originSenderAddressRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(MockERC721SendAndCallReceiverTokenReceived)
+				if err := _MockERC721SendAndCallReceiver.contract.UnpackLog(event, "TokenReceived", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTokenReceived is a log parse operation binding the contract event 0x2ad905dff330a136bf9450e633bd389b5ad2eb97d8873b262a72a11644dc5dc9.
+//
+// Solidity: event TokenReceived(bytes32 indexed sourceBlockchainID, address indexed originSenderAddress, address token, uint256 tokenId, bytes payload)
+func (_MockERC721SendAndCallReceiver *MockERC721SendAndCallReceiverFilterer) ParseTokenReceived(log types.Log) (*MockERC721SendAndCallReceiverTokenReceived, error) {
+	event := new(MockERC721SendAndCallReceiverTokenReceived)
+	if err := _MockERC721SendAndCallReceiver.contract.UnpackLog(event, "TokenReceived", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/ethclient"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The subset of the ERC20 ABI used to manage allowances and check balances
+const erc20AllowanceABI = `[
+	{
+		"type": "function",
+		"name": "balanceOf",
+		"stateMutability": "view",
+		"inputs": [{"name": "account", "type": "address"}],
+		"outputs": [{"name": "", "type": "uint256"}]
+	},
+	{
+		"type": "function",
+		"name": "allowance",
+		"stateMutability": "view",
+		"inputs": [{"name": "owner", "type": "address"}, {"name": "spender", "type": "address"}],
+		"outputs": [{"name": "", "type": "uint256"}]
+	},
+	{
+		"type": "function",
+		"name": "approve",
+		"stateMutability": "nonpayable",
+		"inputs": [{"name": "spender", "type": "address"}, {"name": "amount", "type": "uint256"}],
+		"outputs": [{"name": "", "type": "bool"}]
+	}
+]`
+
+var parsedERC20AllowanceABI abi.ABI
+
+func init() {
+	var err error
+	parsedERC20AllowanceABI, err = abi.JSON(strings.NewReader(erc20AllowanceABI))
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse ERC20 allowance ABI: %v", err))
+	}
+}
+
+// AllowanceManager approves ERC20 tokens to bridge contracts only when the current allowance does not cover
+// the amount about to be spent. The zero value approves the exact amount needed.
+type AllowanceManager struct {
+	// ApprovalAmount, if set, is approved instead of the exact amount whenever an approval is needed, for example
+	// abi.MaxUint256 to approve once for every later send. Amounts larger than it are still approved exactly.
+	ApprovalAmount *big.Int
+}
+
+// ApprovalNeeded returns the amount that the owner needs to approve for the spender to spend amount of the token,
+// or nil if the owner's current allowance already covers it
+func (m *AllowanceManager) ApprovalNeeded(
+	ctx context.Context,
+	client ethclient.Client,
+	owner common.Address,
+	token common.Address,
+	spender common.Address,
+	amount *big.Int,
+) (*big.Int, error) {
+	contract := bind.NewBoundContract(token, parsedERC20AllowanceABI, client, client, client)
+	var results []interface{}
+	err := contract.Call(&bind.CallOpts{Context: ctx}, &results, "allowance", owner, spender)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get allowance of token %s: %w", token, err)
+	}
+	allowance := *abi.ConvertType(results[0], new(big.Int)).(*big.Int)
+	if allowance.Cmp(amount) >= 0 {
+		return nil, nil
+	}
+	if m != nil && m.ApprovalAmount != nil && m.ApprovalAmount.Cmp(amount) > 0 {
+		return m.ApprovalAmount, nil
+	}
+	return amount, nil
+}
+
+// EnsureAllowance approves the spender to spend amount of the token on behalf of opts.From, if its current
+// allowance is lower. Returns the receipt of the approval, or nil if no approval was needed.
+func (m *AllowanceManager) EnsureAllowance(
+	ctx context.Context,
+	client ethclient.Client,
+	opts *bind.TransactOpts,
+	token common.Address,
+	spender common.Address,
+	amount *big.Int,
+) (*types.Receipt, error) {
+	approval, err := m.ApprovalNeeded(ctx, client, opts.From, token, spender, amount)
+	if err != nil || approval == nil {
+		return nil, err
+	}
+	txOpts := *opts
+	txOpts.Context = ctx
+	contract := bind.NewBoundContract(token, parsedERC20AllowanceABI, client, client, client)
+	tx, err := contract.Transact(&txOpts, "approve", spender, approval)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve token %s: %w", token, err)
+	}
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for approval %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("approval %s of token %s failed", tx.Hash(), token)
+	}
+	return receipt, nil
+}
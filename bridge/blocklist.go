@@ -0,0 +1,95 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BlockedRecipient is the blocklist state of a recipient on a token destination
+type BlockedRecipient struct {
+	Recipient common.Address `json:"recipient"`
+	Blocked   bool           `json:"blocked"`
+	// Escrowed is the amount, in the destination's token denomination, received for the recipient while it was
+	// blocked and held by the destination until the recipient claims it.
+	Escrowed *big.Int `json:"escrowed"`
+}
+
+// GetBlockedRecipient returns whether the recipient is blocked by the token destination, and the amount held in
+// escrow for it
+func GetBlockedRecipient(
+	ctx context.Context,
+	destination Endpoint,
+	recipient common.Address,
+) (*BlockedRecipient, error) {
+	tokenDestination, err := teleportertokendestination.NewTeleporterTokenDestination(
+		destination.Address,
+		destination.Chain.Client,
+	)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	blocked, err := tokenDestination.BlockedRecipients(opts, recipient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get whether recipient %s is blocked: %w", recipient, err)
+	}
+	escrowed, err := tokenDestination.EscrowedBalances(opts, recipient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get escrowed balance of recipient %s: %w", recipient, err)
+	}
+	return &BlockedRecipient{
+		Recipient: recipient,
+		Blocked:   blocked,
+		Escrowed:  escrowed,
+	}, nil
+}
+
+// SetRecipientBlocked blocks or unblocks the recipient on the token destination, and returns the receipt of the
+// transaction once it is mined. The transaction must be sent by the destination's owner. Tokens received for a
+// blocked recipient are held in escrow by the destination, and can be claimed by the recipient once it is unblocked.
+func SetRecipientBlocked(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	destination Endpoint,
+	recipient common.Address,
+	blocked bool,
+) (*types.Receipt, error) {
+	tokenDestination, err := teleportertokendestination.NewTeleporterTokenDestination(
+		destination.Address,
+		destination.Chain.Client,
+	)
+	if err != nil {
+		return nil, err
+	}
+	txOpts := *opts
+	txOpts.Context = ctx
+	action := "unblock"
+	var tx *types.Transaction
+	if blocked {
+		action = "block"
+		tx, err = tokenDestination.BlockRecipient(&txOpts, recipient)
+	} else {
+		tx, err = tokenDestination.UnblockRecipient(&txOpts, recipient)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s recipient %s: %w", action, recipient, err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, destination.Chain.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for %s %s: %w", action, tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%s %s failed", action, tx.Hash())
+	}
+	return receipt, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ClaimableWithdrawal returns the amount of native tokens that the native token bridge contract could not send to
+// the recipient, such as a contract that cannot receive native tokens, and holds for it to be claimed
+func ClaimableWithdrawal(ctx context.Context, endpoint Endpoint, recipient common.Address) (*big.Int, error) {
+	contract, err := claimableContract(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := contract.ClaimableWithdrawals(&bind.CallOpts{Context: ctx}, recipient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claimable withdrawal of %s: %w", recipient, err)
+	}
+	return amount, nil
+}
+
+// Claim sends the native tokens held for the recipient by the native token bridge contract to the recipient, as the
+// wrapped native token, and returns the receipt of the transaction once it is mined. The transaction can be sent
+// from any account, since the tokens are only ever sent to the recipient.
+func Claim(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	endpoint Endpoint,
+	recipient common.Address,
+) (*types.Receipt, error) {
+	contract, err := claimableContract(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	txOpts := *opts
+	txOpts.Context = ctx
+	tx, err := contract.ClaimWithdrawal(&txOpts, recipient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim withdrawal of %s: %w", recipient, err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, endpoint.Chain.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for claim %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("claim %s failed", tx.Hash())
+	}
+	return receipt, nil
+}
+
+// Both native token bridge contracts define the claim functions, so the source binding is used for either
+func claimableContract(endpoint Endpoint) (*nativetokensource.NativeTokenSource, error) {
+	if endpoint.Type != events.NativeTokenSource && endpoint.Type != events.NativeTokenDestination {
+		return nil, fmt.Errorf("withdrawals can only be claimed from native token bridges, not %s", endpoint.Type)
+	}
+	return nativetokensource.NewNativeTokenSource(endpoint.Address, endpoint.Chain.Client)
+}
@@ -0,0 +1,236 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/set"
+	avalancheWarp "github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/subnet-evm/params"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	predicateutils "github.com/ava-labs/subnet-evm/predicate"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	gasUtils "github.com/ava-labs/teleporter/utils/gas-utils"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// DefaultNumSigners is the number of validator signatures that the receive transaction is estimated for, if
+	// not set. Each signature adds warp.GasCostPerWarpSigner gas.
+	DefaultNumSigners = 100
+
+	// The margin added to the measured execution gas of a delivery, for state that changes before the message is
+	// delivered, such as the first transfer to a new recipient
+	deliveryGasMarginPercent = 20
+)
+
+var errNoCallRecipient = errors.New("sendAndCall recipient contract must be set")
+
+// DeliveryGasOptions describe the transfer whose delivery gas is estimated. The zero value estimates a send to the
+// zero address.
+type DeliveryGasOptions struct {
+	// Recipient is the recipient of a send on the destination. Ignored if Call is set.
+	Recipient common.Address
+	// Call, if set, estimates the delivery of a sendAndCall to its RecipientContract, with its RecipientPayload,
+	// RecipientGasLimit and FallbackRecipient. Its other fields are ignored.
+	Call *SendAndCallInput
+	// Sender is the account sending the transfer, which is passed to a sendAndCall's recipient contract as the
+	// origin sender
+	Sender common.Address
+	// SourceChain is the chain of the token source that multi-hop transfers are routed through.
+	// Required to estimate the delivery of multi-hop transfers, which is of their second hop.
+	SourceChain *events.Chain
+	// NumSigners is the number of validators whose aggregate signature the Warp message is delivered with.
+	// Defaults to DefaultNumSigners.
+	NumSigners int
+}
+
+// DeliveryGasEstimate is the gas of delivering a transfer to the destination bridge contract
+type DeliveryGasEstimate struct {
+	Route Route `json:"route"`
+	// ExecutionGas is the gas used by the destination bridge contract to process the transfer's message, measured by
+	// simulating its delivery from the destination's Teleporter messenger
+	ExecutionGas uint64 `json:"executionGas"`
+	// RequiredGasLimit is ExecutionGas with a margin, to use as the requiredGasLimit of the send or sendAndCall
+	RequiredGasLimit *big.Int `json:"requiredGasLimit"`
+	// ReceiveGasLimit is the gas limit of the transaction delivering the message to the destination's Teleporter
+	// messenger, covering RequiredGasLimit and the verification of the Warp message carrying it
+	ReceiveGasLimit uint64 `json:"receiveGasLimit"`
+	// ReceiveFee is the cost of the receive transaction at the destination chain's current gas price, in its
+	// native token
+	ReceiveFee *big.Int `json:"receiveFee"`
+}
+
+// EstimateDeliveryGas estimates the gas of delivering amount sent from the source bridge contract to the
+// destination bridge contract, for relayers that deliver their own transfers. The message the transfer results in
+// is delivered to the destination with eth_estimateGas from its Teleporter messenger, so the estimate includes the
+// gas of decoding a sendAndCall's payload and the recipient gas limit made available to its recipient contract.
+// The receive transaction is then estimated for the Warp message carrying the message, which is charged per byte,
+// the same way as by relayers. For multi-hop transfers, the delivery of the second hop is estimated.
+func EstimateDeliveryGas(
+	ctx context.Context,
+	source Endpoint,
+	destination Endpoint,
+	amount *big.Int,
+	options DeliveryGasOptions,
+) (*DeliveryGasEstimate, error) {
+	quote, err := Quote(ctx, source, destination, amount, QuoteOptions{SourceChain: options.SourceChain})
+	if err != nil {
+		return nil, err
+	}
+
+	delivery := simulatedDelivery{
+		receiver:            destination,
+		sourceBlockchainID:  source.Chain.BlockchainID,
+		originSenderAddress: source.Address,
+	}
+	deliveredAmount := quote.DestinationAmount
+	switch quote.Route {
+	case DestinationToSource:
+		// Sources scale the amount sent by a destination down when they receive it
+		deliveredAmount = amount
+	case MultiHop:
+		settings, err := getDestinationSettings(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+		delivery.sourceBlockchainID = options.SourceChain.BlockchainID
+		delivery.originSenderAddress = settings.tokenSourceAddress
+	}
+	if options.Call != nil {
+		if options.Call.RecipientContract == (common.Address{}) {
+			return nil, errNoCallRecipient
+		}
+		delivery.message = messages.SingleHopCallMessage{
+			SourceBlockchainID:  source.Chain.BlockchainID,
+			OriginSenderAddress: options.Sender,
+			RecipientContract:   options.Call.RecipientContract,
+			Amount:              deliveredAmount,
+			RecipientPayload:    options.Call.RecipientPayload,
+			RecipientGasLimit:   options.Call.RecipientGasLimit,
+			FallbackRecipient:   options.Call.FallbackRecipient,
+		}
+	} else {
+		delivery.message = messages.SingleHopSendMessage{Recipient: options.Recipient, Amount: deliveredAmount}
+	}
+
+	messenger, data, err := packDelivery(ctx, delivery)
+	if err != nil {
+		return nil, err
+	}
+	gas, err := destination.Chain.Client.EstimateGas(ctx, interfaces.CallMsg{
+		From: messenger,
+		To:   &destination.Address,
+		Data: data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(
+			"delivery of %s to %s would revert: %w",
+			delivery.message.Type(),
+			destination.Address,
+			err,
+		)
+	}
+	estimate := &DeliveryGasEstimate{
+		Route:        quote.Route,
+		ExecutionGas: gas - intrinsicCallGas(data),
+	}
+	estimate.RequiredGasLimit = new(big.Int).SetUint64(estimate.ExecutionGas * (100 + deliveryGasMarginPercent) / 100)
+
+	numSigners := options.NumSigners
+	if numSigners == 0 {
+		numSigners = DefaultNumSigners
+	}
+	predicateBytes, err := deliveryPredicateSize(messenger, destination, delivery, estimate.RequiredGasLimit, numSigners)
+	if err != nil {
+		return nil, err
+	}
+	receiveGasLimit, err := gasUtils.CalculateReceiveMessageGasLimit(numSigners, estimate.RequiredGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	estimate.ReceiveGasLimit = receiveGasLimit + warp.GasCostPerWarpMessageBytes*uint64(predicateBytes)
+	estimate.ReceiveFee, err = deliveryFee(ctx, destination.Chain, new(big.Int).SetUint64(estimate.ReceiveGasLimit))
+	if err != nil {
+		return nil, err
+	}
+	return estimate, nil
+}
+
+// Returns the intrinsic gas of a call with the call data, which eth_estimateGas includes in its estimate but the
+// Teleporter messenger does not spend from the required gas limit
+func intrinsicCallGas(data []byte) uint64 {
+	gas := params.TxGas
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+	return gas
+}
+
+// Returns the size of the predicate that the signed Warp message carrying the delivery is included in the receive
+// transaction as. The size only depends on the lengths of the Teleporter message and the signers' bit set, so the
+// message is signed with an empty signature. Any receipts the Teleporter message carries back are not included.
+func deliveryPredicateSize(
+	messenger common.Address,
+	destination Endpoint,
+	delivery simulatedDelivery,
+	requiredGasLimit *big.Int,
+	numSigners int,
+) (int, error) {
+	message, err := messages.Encode(delivery.message)
+	if err != nil {
+		return 0, err
+	}
+	teleporterMessage, err := teleportermessenger.PackTeleporterMessage(teleportermessenger.TeleporterMessage{
+		MessageNonce:            big.NewInt(0),
+		OriginSenderAddress:     delivery.originSenderAddress,
+		DestinationBlockchainID: destination.Chain.BlockchainID,
+		DestinationAddress:      destination.Address,
+		RequiredGasLimit:        requiredGasLimit,
+		AllowedRelayerAddresses: []common.Address{},
+		Receipts:                []teleportermessenger.TeleporterMessageReceipt{},
+		Message:                 message,
+	})
+	if err != nil {
+		return 0, err
+	}
+	addressedCall, err := payload.NewAddressedCall(messenger.Bytes(), teleporterMessage)
+	if err != nil {
+		return 0, err
+	}
+	unsignedMessage, err := avalancheWarp.NewUnsignedMessage(
+		constants.MainnetID,
+		delivery.sourceBlockchainID,
+		addressedCall.Bytes(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	signers := set.NewBits()
+	for i := 0; i < numSigners; i++ {
+		signers.Add(i)
+	}
+	signedMessage, err := avalancheWarp.NewMessage(unsignedMessage, &avalancheWarp.BitSetSignature{
+		Signers:   signers.Bytes(),
+		Signature: [bls.SignatureLen]byte{},
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(predicateutils.PackPredicate(signedMessage.Bytes())), nil
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The interval WaitForFinality polls the chain at
+const finalityPollInterval = time.Second
+
+// ErrReorged is returned when the block a transaction was included in is no longer part of the chain
+var ErrReorged = errors.New("transaction block was reorged out of the chain")
+
+// IsFinal returns whether the block of the receipt has at least the chain's ConfirmationDepth blocks built on it,
+// after which a send in it is treated as final. Returns ErrReorged if the block is no longer the chain's block at
+// its height, in which case the transaction may be included again in another block.
+func IsFinal(ctx context.Context, chain *events.Chain, receipt *types.Receipt) (bool, error) {
+	header, err := chain.Client.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil {
+		return false, fmt.Errorf("failed to get block %s of chain %s: %w", receipt.BlockNumber, chain.Name, err)
+	}
+	if header.Hash() != receipt.BlockHash {
+		return false, fmt.Errorf(
+			"%w: block %s of chain %s is now %s",
+			ErrReorged,
+			receipt.BlockHash,
+			chain.Name,
+			header.Hash(),
+		)
+	}
+	final, err := chain.FinalBlock(ctx)
+	if err != nil {
+		return false, err
+	}
+	return new(big.Int).SetUint64(final).Cmp(receipt.BlockNumber) >= 0, nil
+}
+
+// WaitForFinality waits until the transaction is included in a block with the chain's ConfirmationDepth of blocks
+// built on it, and returns its receipt from that block. A transaction whose block is reorged out is followed until
+// it is included again, so the returned receipt may differ from the one the send returned, including in its
+// status. The wait ends with the context, as for a transaction that is never included again.
+func WaitForFinality(ctx context.Context, chain *events.Chain, txHash common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(finalityPollInterval)
+	defer ticker.Stop()
+	for {
+		receipt, err := chain.Client.TransactionReceipt(ctx, txHash)
+		switch {
+		case errors.Is(err, interfaces.NotFound):
+		case err != nil:
+			return nil, fmt.Errorf("failed to get receipt of transaction %s: %w", txHash, err)
+		default:
+			final, err := IsFinal(ctx, chain, receipt)
+			if err != nil && !errors.Is(err, ErrReorged) {
+				return nil, err
+			}
+			if final {
+				return receipt, nil
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("transaction %s was not final: %w", txHash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+)
+
+// SendsPaused returns whether sends from the bridge contract are paused by its owner
+func SendsPaused(ctx context.Context, endpoint Endpoint) (bool, error) {
+	contract, err := pausableContract(endpoint)
+	if err != nil {
+		return false, err
+	}
+	paused, err := contract.SendsPaused(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return false, fmt.Errorf("failed to get whether sends are paused: %w", err)
+	}
+	return paused, nil
+}
+
+// SetSendsPaused pauses or unpauses sends from the bridge contract, and returns the receipt of the transaction
+// once it is mined. The transaction must be sent by the contract's owner. Messages received by a contract are
+// still processed while its sends are paused, so transfers already in flight settle.
+func SetSendsPaused(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	endpoint Endpoint,
+	paused bool,
+) (*types.Receipt, error) {
+	contract, err := pausableContract(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	txOpts := *opts
+	txOpts.Context = ctx
+	action := "unpause"
+	var tx *types.Transaction
+	if paused {
+		action = "pause"
+		tx, err = contract.PauseSends(&txOpts)
+	} else {
+		tx, err = contract.UnpauseSends(&txOpts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s sends: %w", action, err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, endpoint.Chain.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for %s %s: %w", action, tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("%s %s failed", action, tx.Hash())
+	}
+	return receipt, nil
+}
+
+// Every bridge contract defines the send pause functions, so the source binding is used for every contract
+func pausableContract(endpoint Endpoint) (*teleportertokensource.TeleporterTokenSource, error) {
+	return teleportertokensource.NewTeleporterTokenSource(endpoint.Address, endpoint.Chain.Client)
+}
@@ -0,0 +1,299 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/rpc"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ProblemKind is a reason that a send would revert
+type ProblemKind string
+
+const (
+	// The sender holds less of the bridged token, or of the native token, than the send spends
+	InsufficientBalance ProblemKind = "insufficient-balance"
+	// The sender has approved the source less of the bridged token than the send spends
+	InsufficientAllowance ProblemKind = "insufficient-allowance"
+	// The sender holds less of the primary fee token than the fee, when it is not the bridged token
+	InsufficientFeeBalance ProblemKind = "insufficient-fee-balance"
+	// The sender has approved the source less of the primary fee token than the fee, when it is not the bridged token
+	InsufficientFeeAllowance ProblemKind = "insufficient-fee-allowance"
+	// The destination is not registered with the token source that the send is delivered to
+	DestinationNotRegistered ProblemKind = "destination-not-registered"
+	// The destination that the tokens are sent to or from still needs collateral
+	DestinationNotCollateralized ProblemKind = "destination-not-collateralized"
+)
+
+// Problem is a reason that a send would revert, found before sending
+type Problem struct {
+	Kind ProblemKind `json:"kind"`
+	// Token is the ERC20 token of a balance or allowance problem, or the zero address for the native token
+	Token common.Address `json:"token"`
+	// Required and Available are the amounts the send needs and the sender has, for balance and allowance
+	// problems. Required is the collateral still needed for collateralization problems.
+	Required  *big.Int `json:"required,omitempty"`
+	Available *big.Int `json:"available,omitempty"`
+	Message   string   `json:"message"`
+}
+
+// PreflightError is returned by Send when Preflight finds problems that would revert the send
+type PreflightError struct {
+	Problems []Problem
+}
+
+func (e *PreflightError) Error() string {
+	messages := make([]string, len(e.Problems))
+	for i, problem := range e.Problems {
+		messages[i] = problem.Message
+	}
+	return fmt.Sprintf("send would revert: %s", strings.Join(messages, "; "))
+}
+
+// Preflight checks that sending amount from the source bridge contract would not revert for the most common
+// reasons, and returns each problem found, or none if the send can proceed. It checks the sender's balance and
+// allowance of the tokens the send spends, including a primary fee in another token, and that the destination is
+// registered with the token source and collateralized. For sends from a destination, the registration of the
+// destination with its source is read on the source's chain, so only the target of a single hop and the
+// destination's own collateralization are checked. The state is read with a single JSON-RPC batch of calls, at
+// the source chain's latest block.
+func Preflight(
+	ctx context.Context,
+	sender common.Address,
+	source Endpoint,
+	input SendTokensInput,
+	amount *big.Int,
+) ([]Problem, error) {
+	token, err := bridgedToken(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	block, err := source.Chain.Client.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest block of chain %s: %w", source.Chain.Name, err)
+	}
+	sourceABI, err := teleportertokensource.TeleporterTokenSourceMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	destinationABI, err := teleportertokendestination.TeleporterTokenDestinationMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	batch := &callBatch{block: new(big.Int).SetUint64(block)}
+
+	var problems []Problem
+	addProblem := func(problem Problem) {
+		problems = append(problems, problem)
+	}
+	if token == (common.Address{}) {
+		batch.balance(sender, func(balance *big.Int) {
+			if balance.Cmp(amount) < 0 {
+				addProblem(Problem{
+					Kind:      InsufficientBalance,
+					Required:  amount,
+					Available: balance,
+					Message: fmt.Sprintf(
+						"sender %s holds %s of the native token, less than %s",
+						sender, balance, amount,
+					),
+				})
+			}
+		})
+	}
+	for _, spend := range tokenSpends(token, input, amount) {
+		spend := spend
+		balanceKind, allowanceKind := InsufficientBalance, InsufficientAllowance
+		if spend.token != token {
+			balanceKind, allowanceKind = InsufficientFeeBalance, InsufficientFeeAllowance
+		}
+		batch.call(spend.token, &parsedERC20AllowanceABI, "balanceOf", []interface{}{sender}, func(out []interface{}) {
+			balance := *abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+			if balance.Cmp(spend.amount) < 0 {
+				addProblem(Problem{
+					Kind:      balanceKind,
+					Token:     spend.token,
+					Required:  spend.amount,
+					Available: &balance,
+					Message: fmt.Sprintf(
+						"sender %s holds %s of token %s, less than %s",
+						sender, &balance, spend.token, spend.amount,
+					),
+				})
+			}
+		})
+		batch.call(
+			spend.token,
+			&parsedERC20AllowanceABI,
+			"allowance",
+			[]interface{}{sender, source.Address},
+			func(out []interface{}) {
+				allowance := *abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+				if allowance.Cmp(spend.amount) < 0 {
+					addProblem(Problem{
+						Kind:      allowanceKind,
+						Token:     spend.token,
+						Required:  spend.amount,
+						Available: &allowance,
+						Message: fmt.Sprintf(
+							"sender %s has approved %s %s of token %s, less than %s",
+							sender, source.Address, &allowance, spend.token, spend.amount,
+						),
+					})
+				}
+			},
+		)
+	}
+
+	destinationBlockchainID := ids.ID(input.DestinationBlockchainID)
+	switch {
+	case source.Type.IsSource():
+		batch.call(
+			source.Address,
+			sourceABI,
+			"registeredDestinations",
+			[]interface{}{input.DestinationBlockchainID, input.DestinationBridgeAddress},
+			func(out []interface{}) {
+				registered := *abi.ConvertType(out[0], new(bool)).(*bool)
+				collateralNeeded := *abi.ConvertType(out[1], new(big.Int)).(*big.Int)
+				if !registered {
+					addProblem(Problem{
+						Kind: DestinationNotRegistered,
+						Message: fmt.Sprintf(
+							"destination %s on %s is not registered with source %s",
+							input.DestinationBridgeAddress, destinationBlockchainID, source.Address,
+						),
+					})
+				} else if collateralNeeded.Sign() > 0 {
+					addProblem(Problem{
+						Kind:     DestinationNotCollateralized,
+						Required: &collateralNeeded,
+						Message: fmt.Sprintf(
+							"destination %s on %s still needs %s collateral",
+							input.DestinationBridgeAddress, destinationBlockchainID, &collateralNeeded,
+						),
+					})
+				}
+			},
+		)
+	case source.Type.IsDestination():
+		var sourceBlockchainID ids.ID
+		batch.call(source.Address, destinationABI, "sourceBlockchainID", nil, func(out []interface{}) {
+			sourceBlockchainID = ids.ID(*abi.ConvertType(out[0], new([32]byte)).(*[32]byte))
+		})
+		// Decoded after sourceBlockchainID, since the calls are decoded in order
+		batch.call(source.Address, destinationABI, "tokenSourceAddress", nil, func(out []interface{}) {
+			tokenSourceAddress := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+			if destinationBlockchainID == sourceBlockchainID && input.DestinationBridgeAddress != tokenSourceAddress {
+				addProblem(Problem{
+					Kind: DestinationNotRegistered,
+					Message: fmt.Sprintf(
+						"destination %s only sends to its token source %s on %s, not %s",
+						source.Address, tokenSourceAddress, sourceBlockchainID, input.DestinationBridgeAddress,
+					),
+				})
+			}
+		})
+		batch.call(source.Address, destinationABI, "isCollateralized", nil, func(out []interface{}) {
+			if !*abi.ConvertType(out[0], new(bool)).(*bool) {
+				addProblem(Problem{
+					Kind:    DestinationNotCollateralized,
+					Message: fmt.Sprintf("destination %s is not collateralized", source.Address),
+				})
+			}
+		})
+	default:
+		return nil, fmt.Errorf("unknown contract type %s", source.Type)
+	}
+
+	if err := batch.run(ctx, source.Chain); err != nil {
+		return nil, err
+	}
+	return problems, nil
+}
+
+// A batch of read-only calls, sent as a single JSON-RPC batch and all read at the same block.
+// The results of the calls are decoded in the order they were added.
+type callBatch struct {
+	block    *big.Int
+	elems    []rpc.BatchElem
+	decoders []func(result interface{}) error
+	err      error
+}
+
+// Adds an eth_call of the contract's method, whose unpacked outputs are passed to decode
+func (b *callBatch) call(
+	to common.Address,
+	contractABI *abi.ABI,
+	method string,
+	args []interface{},
+	decode func(out []interface{}),
+) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		if b.err == nil {
+			b.err = fmt.Errorf("failed to pack %s: %w", method, err)
+		}
+		return
+	}
+	b.elems = append(b.elems, rpc.BatchElem{
+		Method: "eth_call",
+		Args: []interface{}{
+			map[string]interface{}{"to": to, "data": hexutil.Bytes(data)},
+			hexutil.EncodeBig(b.block),
+		},
+		Result: new(hexutil.Bytes),
+	})
+	b.decoders = append(b.decoders, func(result interface{}) error {
+		out, err := contractABI.Unpack(method, *result.(*hexutil.Bytes))
+		if err != nil {
+			return fmt.Errorf("failed to unpack %s of %s: %w", method, to, err)
+		}
+		decode(out)
+		return nil
+	})
+}
+
+// Adds an eth_getBalance of the account's native token balance
+func (b *callBatch) balance(account common.Address, decode func(balance *big.Int)) {
+	b.elems = append(b.elems, rpc.BatchElem{
+		Method: "eth_getBalance",
+		Args:   []interface{}{account, hexutil.EncodeBig(b.block)},
+		Result: new(hexutil.Big),
+	})
+	b.decoders = append(b.decoders, func(result interface{}) error {
+		decode(result.(*hexutil.Big).ToInt())
+		return nil
+	})
+}
+
+// Sends the batch to the chain and decodes the result of each call
+func (b *callBatch) run(ctx context.Context, chain *events.Chain) error {
+	if b.err != nil {
+		return b.err
+	}
+	if err := chain.Client.Client().BatchCallContext(ctx, b.elems); err != nil {
+		return fmt.Errorf("failed to call chain %s: %w", chain.Name, err)
+	}
+	for i, elem := range b.elems {
+		if elem.Error != nil {
+			return fmt.Errorf("%s failed on chain %s: %w", elem.Method, chain.Name, elem.Error)
+		}
+		if err := b.decoders[i](elem.Result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
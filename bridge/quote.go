@@ -0,0 +1,519 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package bridge quotes transfers between the deployed contracts of a Teleporter token bridge,
+// applying the same scaling and checks as the contracts, for use by frontends and other off-chain clients.
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// DefaultERC20RequiredGasLimit is the gas limit required to deliver a transfer to an ERC20 bridge contract
+	DefaultERC20RequiredGasLimit = big.NewInt(85_000)
+	// DefaultNativeTokenRequiredGasLimit is the gas limit required to deliver a transfer to a native token
+	// bridge contract
+	DefaultNativeTokenRequiredGasLimit = big.NewInt(120_000)
+
+	errSameEndpoint = errors.New("source and destination must be different bridge contracts")
+)
+
+// Route is the path a transfer takes between two bridge contracts
+type Route string
+
+const (
+	// SourceToDestination is a single hop from a token source to one of its registered destinations
+	SourceToDestination Route = "source-to-destination"
+	// DestinationToSource is a single hop from a destination back to its token source
+	DestinationToSource Route = "destination-to-source"
+	// MultiHop is a transfer from one destination to another, routed through their shared token source
+	MultiHop Route = "multi-hop"
+)
+
+// Endpoint is a bridge contract deployed to a chain
+type Endpoint struct {
+	Chain   *events.Chain
+	Address common.Address
+	Type    events.ContractType
+	// RequiredGasLimit, if set, is the gas limit of deliveries of transfers to the bridge contract, in place of the
+	// default of its type. Set it for bridge contracts whose receive costs more than the default, such as those of
+	// tokens with transfer hooks, since a delivery with too low a limit fails to execute and must be retried.
+	RequiredGasLimit *big.Int
+}
+
+// QuoteOptions optionally refine a quote. The zero value is a valid set of options.
+type QuoteOptions struct {
+	// RequiredGasLimit is the gas limit of the delivery to the destination, overriding the destination's own
+	// RequiredGasLimit for this transfer. Defaults to the destination's RequiredGasLimit if set, and otherwise to
+	// DefaultERC20RequiredGasLimit or DefaultNativeTokenRequiredGasLimit, depending on the destination.
+	RequiredGasLimit *big.Int
+	// SecondaryFee is the fee, in the source destination's tokens, that the sender will pay for the second hop of
+	// a multi-hop transfer. It is deducted from the transferred amount on the intermediate chain.
+	SecondaryFee *big.Int
+	// SourceChain is the chain of the token source that multi-hop transfers are routed through.
+	// Required to quote the primary fee of multi-hop transfers, since it is paid for the hop to that chain.
+	SourceChain *events.Chain
+	// Sender is the account the send's gas is estimated for. If not set, the gas is not estimated.
+	// When sending ERC20 tokens, the sender must already have approved the amount to the source contract.
+	Sender common.Address
+	// Recipient is the recipient of the tokens on the destination. Defaults to Sender.
+	Recipient common.Address
+}
+
+// TransferQuote is the expected outcome of a transfer
+type TransferQuote struct {
+	Route Route `json:"route"`
+	// DestinationAmount is the amount received on the destination, in the destination's denomination
+	DestinationAmount *big.Int `json:"destinationAmount"`
+	// PrimaryRequiredGasLimit is the gas limit of the Teleporter message sent by the send, and
+	// SecondaryRequiredGasLimit is the gas limit of the second hop of a multi-hop transfer
+	PrimaryRequiredGasLimit   *big.Int `json:"primaryRequiredGasLimit"`
+	SecondaryRequiredGasLimit *big.Int `json:"secondaryRequiredGasLimit,omitempty"`
+	// PrimaryFee and SecondaryFee are the cost to a relayer of delivering each hop at the current gas price of the
+	// chain it is delivered to, in that chain's native token. The bridge contracts charge no fees of their own, so
+	// these are the minimum fees a relayer can be expected to accept, once converted to the fee token.
+	PrimaryFee   *big.Int `json:"primaryFee"`
+	SecondaryFee *big.Int `json:"secondaryFee,omitempty"`
+	// EstimatedGas is the estimated gas of the send on the source chain. Zero if no sender was given.
+	EstimatedGas uint64 `json:"estimatedGas"`
+}
+
+// Quote returns the amount received when sending amount from the source bridge contract to the destination
+// bridge contract, along with the gas limits and fees of each hop, and the gas of the send itself. It reads the
+// token multipliers and registration state from the contracts, and errors if the contracts would reject the send.
+func Quote(
+	ctx context.Context,
+	source Endpoint,
+	destination Endpoint,
+	amount *big.Int,
+	options QuoteOptions,
+) (*TransferQuote, error) {
+	if source.Chain.BlockchainID == destination.Chain.BlockchainID && source.Address == destination.Address {
+		return nil, errSameEndpoint
+	}
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("amount must be positive, got %s", amount)
+	}
+	requiredGasLimit := options.RequiredGasLimit
+	if requiredGasLimit == nil {
+		requiredGasLimit = RequiredGasLimit(destination)
+	}
+	secondaryFee := options.SecondaryFee
+	if secondaryFee == nil {
+		secondaryFee = big.NewInt(0)
+	}
+
+	var (
+		quote *TransferQuote
+		err   error
+	)
+	switch {
+	case source.Type.IsSource() && destination.Type.IsDestination():
+		quote, err = quoteSourceToDestination(ctx, source, destination, amount, requiredGasLimit)
+	case source.Type.IsDestination() && destination.Type.IsSource():
+		quote, err = quoteDestinationToSource(ctx, source, destination, amount, requiredGasLimit)
+	case source.Type.IsDestination() && destination.Type.IsDestination():
+		quote, err = quoteMultiHop(ctx, source, destination, amount, requiredGasLimit, secondaryFee, options.SourceChain)
+	default:
+		return nil, fmt.Errorf("cannot send from %s to %s", source.Type, destination.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Sender != (common.Address{}) {
+		recipient := options.Recipient
+		if recipient == (common.Address{}) {
+			recipient = options.Sender
+		}
+		quote.EstimatedGas, err = estimateSendGas(ctx, source, destination, amount, recipient, options.Sender, quote)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return quote, nil
+}
+
+func quoteSourceToDestination(
+	ctx context.Context,
+	source Endpoint,
+	destination Endpoint,
+	amount *big.Int,
+	requiredGasLimit *big.Int,
+) (*TransferQuote, error) {
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(source.Address, source.Chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := tokenSource.RegisteredDestinations(
+		&bind.CallOpts{Context: ctx},
+		destination.Chain.BlockchainID,
+		destination.Address,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination settings: %w", err)
+	}
+	if !settings.Registered {
+		return nil, fmt.Errorf("destination %s is not registered with the source", destination.Address)
+	}
+	if settings.CollateralNeeded.Sign() != 0 {
+		return nil, fmt.Errorf(
+			"destination %s needs %s more collateral",
+			destination.Address,
+			settings.CollateralNeeded,
+		)
+	}
+	destinationAmount := tokenscaling.ApplyTokenScale(settings.TokenMultiplier, settings.MultiplyOnDestination, amount)
+	if destinationAmount.Sign() == 0 {
+		return nil, fmt.Errorf("amount %s is scaled to zero on the destination", amount)
+	}
+	withinRateLimit, err := isWithinRateLimit(ctx, source, destination, amount)
+	if err != nil {
+		return nil, err
+	}
+	if !withinRateLimit {
+		return nil, fmt.Errorf("amount %s exceeds the remaining rate limit of destination %s", amount, destination.Address)
+	}
+	primaryFee, err := deliveryFee(ctx, destination.Chain, requiredGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferQuote{
+		Route:                   SourceToDestination,
+		DestinationAmount:       destinationAmount,
+		PrimaryRequiredGasLimit: requiredGasLimit,
+		PrimaryFee:              primaryFee,
+	}, nil
+}
+
+func quoteDestinationToSource(
+	ctx context.Context,
+	source Endpoint,
+	destination Endpoint,
+	amount *big.Int,
+	requiredGasLimit *big.Int,
+) (*TransferQuote, error) {
+	settings, err := getDestinationSettings(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	if settings.sourceBlockchainID != destination.Chain.BlockchainID ||
+		settings.tokenSourceAddress != destination.Address {
+		return nil, fmt.Errorf("destination %s is not a destination of source %s", source.Address, destination.Address)
+	}
+	destinationAmount := tokenscaling.RemoveTokenScale(settings.tokenMultiplier, settings.multiplyOnDestination, amount)
+	if destinationAmount.Sign() == 0 {
+		return nil, fmt.Errorf("amount %s is scaled to zero on the source", amount)
+	}
+	primaryFee, err := deliveryFee(ctx, destination.Chain, requiredGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferQuote{
+		Route:                   DestinationToSource,
+		DestinationAmount:       destinationAmount,
+		PrimaryRequiredGasLimit: requiredGasLimit,
+		PrimaryFee:              primaryFee,
+	}, nil
+}
+
+func quoteMultiHop(
+	ctx context.Context,
+	source Endpoint,
+	destination Endpoint,
+	amount *big.Int,
+	requiredGasLimit *big.Int,
+	secondaryFee *big.Int,
+	sourceChain *events.Chain,
+) (*TransferQuote, error) {
+	if sourceChain == nil {
+		return nil, errors.New("the source chain is required to quote multi-hop transfers")
+	}
+	sourceSettings, err := getDestinationSettings(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	destinationSettings, err := getDestinationSettings(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+	if sourceSettings.sourceBlockchainID != destinationSettings.sourceBlockchainID ||
+		sourceSettings.tokenSourceAddress != destinationSettings.tokenSourceAddress {
+		return nil, fmt.Errorf(
+			"destinations %s and %s do not share a token source",
+			source.Address,
+			destination.Address,
+		)
+	}
+	if sourceChain.BlockchainID != sourceSettings.sourceBlockchainID {
+		return nil, fmt.Errorf(
+			"source chain %s is not the chain of the token source %s",
+			sourceChain.BlockchainID,
+			sourceSettings.sourceBlockchainID,
+		)
+	}
+
+	// The token source sends the second hop to the multi-hop fallback rather than reverting if the destination is
+	// not registered with it, is not collateralized, or the amount is scaled to zero or exceeds its rate limit, so
+	// these are checked on the source chain rather than quoting a transfer that would not reach the destination
+	tokenSourceEndpoint := Endpoint{Chain: sourceChain, Address: sourceSettings.tokenSourceAddress}
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(tokenSourceEndpoint.Address, sourceChain.Client)
+	if err != nil {
+		return nil, err
+	}
+	registration, err := tokenSource.RegisteredDestinations(
+		&bind.CallOpts{Context: ctx},
+		destination.Chain.BlockchainID,
+		destination.Address,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination settings: %w", err)
+	}
+	if !registration.Registered {
+		return nil, fmt.Errorf(
+			"destination %s is not registered with the source, so the transfer would be sent to the multi-hop fallback",
+			destination.Address,
+		)
+	}
+	if registration.CollateralNeeded.Sign() != 0 {
+		return nil, fmt.Errorf(
+			"destination %s needs %s more collateral, so the transfer would be sent to the multi-hop fallback",
+			destination.Address,
+			registration.CollateralNeeded,
+		)
+	}
+
+	// The first hop removes the source destination's scaling, and the secondary fee is deducted from the
+	// source amount before the second hop applies the destination's scaling, as in TeleporterTokenSource.sol
+	sourceAmount := tokenscaling.RemoveTokenScale(
+		sourceSettings.tokenMultiplier,
+		sourceSettings.multiplyOnDestination,
+		amount,
+	)
+	sourceFee := tokenscaling.RemoveTokenScale(
+		sourceSettings.tokenMultiplier,
+		sourceSettings.multiplyOnDestination,
+		secondaryFee,
+	)
+	if sourceAmount.Cmp(sourceFee) <= 0 {
+		return nil, fmt.Errorf("amount %s does not cover the secondary fee %s", amount, secondaryFee)
+	}
+	routedAmount := new(big.Int).Sub(sourceAmount, sourceFee)
+	destinationAmount := tokenscaling.ApplyTokenScale(
+		registration.TokenMultiplier,
+		registration.MultiplyOnDestination,
+		routedAmount,
+	)
+	if destinationAmount.Sign() == 0 {
+		return nil, fmt.Errorf(
+			"amount %s is scaled to zero on the destination, so the transfer would be sent to the multi-hop fallback",
+			amount,
+		)
+	}
+	withinRateLimit, err := isWithinRateLimit(ctx, tokenSourceEndpoint, destination, routedAmount)
+	if err != nil {
+		return nil, err
+	}
+	if !withinRateLimit {
+		return nil, fmt.Errorf(
+			"amount %s exceeds the remaining rate limit of destination %s, so the transfer would be sent to the "+
+				"multi-hop fallback",
+			amount,
+			destination.Address,
+		)
+	}
+
+	primaryFee, err := deliveryFee(ctx, sourceChain, sourceSettings.multiHopRequiredGas)
+	if err != nil {
+		return nil, err
+	}
+	destinationFee, err := deliveryFee(ctx, destination.Chain, requiredGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &TransferQuote{
+		Route:                     MultiHop,
+		DestinationAmount:         destinationAmount,
+		PrimaryRequiredGasLimit:   sourceSettings.multiHopRequiredGas,
+		SecondaryRequiredGasLimit: requiredGasLimit,
+		PrimaryFee:                primaryFee,
+		SecondaryFee:              destinationFee,
+	}, nil
+}
+
+// Returns whether sourceAmount, in the token source's denomination, is within the remaining rate limit of the
+// token source on sends to the destination
+func isWithinRateLimit(
+	ctx context.Context,
+	source Endpoint,
+	destination Endpoint,
+	sourceAmount *big.Int,
+) (bool, error) {
+	rateLimit, err := GetRateLimit(ctx, source, destination.Chain.BlockchainID, destination.Address)
+	if err != nil {
+		return false, err
+	}
+	return rateLimit.Remaining == nil || sourceAmount.Cmp(rateLimit.Remaining) <= 0, nil
+}
+
+// The settings of a TeleporterTokenDestination, read from its chain
+type destinationSettings struct {
+	sourceBlockchainID    ids.ID
+	tokenSourceAddress    common.Address
+	tokenMultiplier       *big.Int
+	multiplyOnDestination bool
+	multiHopRequiredGas   *big.Int
+}
+
+func getDestinationSettings(ctx context.Context, endpoint Endpoint) (*destinationSettings, error) {
+	destination, err := teleportertokendestination.NewTeleporterTokenDestination(
+		endpoint.Address,
+		endpoint.Chain.Client,
+	)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	sourceBlockchainID, err := destination.SourceBlockchainID(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source blockchain ID: %w", err)
+	}
+	tokenSourceAddress, err := destination.TokenSourceAddress(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token source address: %w", err)
+	}
+	tokenMultiplier, err := destination.TokenMultiplier(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token multiplier: %w", err)
+	}
+	multiplyOnDestination, err := destination.MultiplyOnDestination(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multiply on destination: %w", err)
+	}
+	multiHopRequiredGas, err := destination.MULTIHOPREQUIREDGAS(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multi-hop required gas: %w", err)
+	}
+	return &destinationSettings{
+		sourceBlockchainID:    ids.ID(sourceBlockchainID),
+		tokenSourceAddress:    tokenSourceAddress,
+		tokenMultiplier:       tokenMultiplier,
+		multiplyOnDestination: multiplyOnDestination,
+		multiHopRequiredGas:   multiHopRequiredGas,
+	}, nil
+}
+
+// Returns the cost of delivering a message with the required gas limit at the chain's current gas price
+func deliveryFee(ctx context.Context, chain *events.Chain, requiredGasLimit *big.Int) (*big.Int, error) {
+	gasPrice, err := chain.Client.SuggestGasPrice(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gas price of chain %s: %w", chain.Name, err)
+	}
+	return new(big.Int).Mul(gasPrice, requiredGasLimit), nil
+}
+
+// RequiredGasLimit returns the gas limit of deliveries of transfers to the bridge contract: its configured
+// RequiredGasLimit if set, and otherwise the default of its type
+func RequiredGasLimit(endpoint Endpoint) *big.Int {
+	if endpoint.RequiredGasLimit != nil {
+		return endpoint.RequiredGasLimit
+	}
+	return defaultRequiredGasLimit(endpoint.Type)
+}
+
+func defaultRequiredGasLimit(destinationType events.ContractType) *big.Int {
+	if destinationType == events.NativeTokenSource || destinationType == events.NativeTokenDestination {
+		return DefaultNativeTokenRequiredGasLimit
+	}
+	return DefaultERC20RequiredGasLimit
+}
+
+// Estimates the gas of the send from the source without fees, since paying the fees in a separate token
+// requires an approval that the sender may not have made yet
+func estimateSendGas(
+	ctx context.Context,
+	source Endpoint,
+	destination Endpoint,
+	amount *big.Int,
+	recipient common.Address,
+	sender common.Address,
+	quote *TransferQuote,
+) (uint64, error) {
+	input := SendTokensInput{
+		DestinationBlockchainID:  destination.Chain.BlockchainID,
+		DestinationBridgeAddress: destination.Address,
+		Recipient:                recipient,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         quote.PrimaryRequiredGasLimit,
+	}
+	if quote.Route == MultiHop {
+		input.RequiredGasLimit = quote.SecondaryRequiredGasLimit
+		input.MultiHopFallback = recipient
+	}
+
+	data, value, err := packSend(source, input, amount)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := source.Chain.Client.EstimateGas(ctx, interfaces.CallMsg{
+		From:  sender,
+		To:    &source.Address,
+		Value: value,
+		Data:  data,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate gas of send: %w", err)
+	}
+	return gas, nil
+}
+
+// Packs the call data and value of a send from the source. The SendTokensInput types of the bindings are
+// identical, so the ERC20Source type is packed for every contract.
+func packSend(source Endpoint, input SendTokensInput, amount *big.Int) ([]byte, *big.Int, error) {
+	var (
+		metaData *bind.MetaData
+		args     = []interface{}{input, amount}
+		value    *big.Int
+	)
+	switch source.Type {
+	case events.ERC20Source:
+		metaData = erc20source.ERC20SourceMetaData
+	case events.ERC20Destination:
+		metaData = erc20destination.ERC20DestinationMetaData
+	case events.NativeTokenSource:
+		metaData = nativetokensource.NativeTokenSourceMetaData
+		args, value = []interface{}{input}, amount
+	case events.NativeTokenDestination:
+		metaData = nativetokendestination.NativeTokenDestinationMetaData
+		args, value = []interface{}{input}, amount
+	default:
+		return nil, nil, fmt.Errorf("unknown contract type %s", source.Type)
+	}
+	parsedABI, err := metaData.GetAbi()
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := parsedABI.Pack("send", args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, value, nil
+}
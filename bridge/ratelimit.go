@@ -0,0 +1,122 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/vmerrs"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// RateLimit is the limit set by a token source's owner on the tokens sent to one of its destinations within each
+// window. Sends initiated on the source chain that would exceed the limit revert, and multi-hop transfers that
+// would exceed it are sent to their multi-hop fallback.
+type RateLimit struct {
+	// Limit is the maximum amount, in the source's token denomination, sent to the destination in each window.
+	// Zero if sends to the destination are not limited.
+	Limit  *big.Int      `json:"limit"`
+	Window time.Duration `json:"window"`
+	// WindowStart is when the window of the last send, or of the last update of the limit, started
+	WindowStart time.Time `json:"windowStart"`
+	// AmountSent is the amount sent to the destination in the window starting at WindowStart
+	AmountSent *big.Int `json:"amountSent"`
+	// Remaining is the amount that can still be sent to the destination in the current window, which is the full
+	// limit if the window starting at WindowStart has ended. Nil if sends to the destination are not limited.
+	Remaining *big.Int `json:"remaining,omitempty"`
+}
+
+// GetRateLimit returns the rate limit of the token source on the tokens it sends to the destination. Token sources
+// deployed before rate limits were added revert calls of the rate limit views, and are returned as not limiting
+// sends to the destination.
+func GetRateLimit(
+	ctx context.Context,
+	source Endpoint,
+	destinationBlockchainID ids.ID,
+	destinationAddress common.Address,
+) (*RateLimit, error) {
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(source.Address, source.Chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	rateLimit, err := tokenSource.RateLimits(opts, destinationBlockchainID, destinationAddress)
+	if isRevert(err) {
+		return &RateLimit{Limit: big.NewInt(0), AmountSent: big.NewInt(0)}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rate limit of destination %s: %w", destinationAddress, err)
+	}
+	remaining, err := tokenSource.RateLimitRemaining(opts, destinationBlockchainID, destinationAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remaining rate limit of destination %s: %w", destinationAddress, err)
+	}
+	if remaining.Cmp(math.MaxBig256) == 0 {
+		remaining = nil
+	}
+	return &RateLimit{
+		Limit:       rateLimit.Limit,
+		Window:      time.Duration(rateLimit.Window.Int64()) * time.Second,
+		WindowStart: time.Unix(rateLimit.WindowStart.Int64(), 0),
+		AmountSent:  rateLimit.AmountSent,
+		Remaining:   remaining,
+	}, nil
+}
+
+// Returns whether the error is of a call that reverted, such as of a function that the contract does not have
+func isRevert(err error) bool {
+	return err != nil && strings.Contains(err.Error(), vmerrs.ErrExecutionReverted.Error())
+}
+
+// SetRateLimit limits the tokens the token source sends to the destination to limit, in the source's token
+// denomination, within each window, and starts a new window. A zero limit removes the rate limit. The window must
+// be a whole number of seconds, and the transaction must be sent by the source's owner. Returns the receipt of the
+// transaction once it is mined.
+func SetRateLimit(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	source Endpoint,
+	destinationBlockchainID ids.ID,
+	destinationAddress common.Address,
+	limit *big.Int,
+	window time.Duration,
+) (*types.Receipt, error) {
+	if window < 0 || window%time.Second != 0 {
+		return nil, fmt.Errorf("rate limit window %s is not a whole number of seconds", window)
+	}
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(source.Address, source.Chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	txOpts := *opts
+	txOpts.Context = ctx
+	tx, err := tokenSource.SetRateLimit(
+		&txOpts,
+		destinationBlockchainID,
+		destinationAddress,
+		limit,
+		big.NewInt(int64(window/time.Second)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set rate limit of destination %s: %w", destinationAddress, err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, source.Chain.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for rate limit update %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("rate limit update %s failed", tx.Hash())
+	}
+	return receipt, nil
+}
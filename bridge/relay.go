@@ -0,0 +1,244 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	avalancheWarp "github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/avalanchego/vms/platformvm/warp/payload"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	predicateutils "github.com/ava-labs/subnet-evm/predicate"
+	warpBackend "github.com/ava-labs/subnet-evm/warp"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	gasUtils "github.com/ava-labs/teleporter/utils/gas-utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SentMessage is a Teleporter message sent by a transaction, along with the Warp message that carries it
+type SentMessage struct {
+	MessageID ids.ID
+	Message   teleportermessenger.TeleporterMessage
+	// TeleporterAddress is the address of the Teleporter messenger that sent the message, which must also
+	// receive it on the destination
+	TeleporterAddress common.Address
+	UnsignedWarp      *avalancheWarp.UnsignedMessage
+}
+
+// SentMessages returns the Teleporter messages sent to the destination chain by the source chain transaction,
+// each paired with the Warp message that carries it. Messages sent by any version of the Teleporter messenger are
+// returned.
+func SentMessages(
+	ctx context.Context,
+	source *events.Chain,
+	destinationBlockchainID ids.ID,
+	txHash common.Hash,
+) ([]*SentMessage, error) {
+	receipt, err := source.Client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt of transaction %s: %w", txHash, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s failed, so sent no messages", txHash)
+	}
+	messengerABI, err := teleportermessenger.TeleporterMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := teleportermessenger.NewTeleporterMessengerFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Warp messages are matched to the Teleporter messages they carry by their payload
+	var unsignedWarpMessages []*avalancheWarp.UnsignedMessage
+	for _, receiptLog := range receipt.Logs {
+		if receiptLog.Address != warp.ContractAddress {
+			continue
+		}
+		unsignedWarp, err := warp.UnpackSendWarpEventDataToMessage(receiptLog.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack Warp message: %w", err)
+		}
+		unsignedWarpMessages = append(unsignedWarpMessages, unsignedWarp)
+	}
+
+	var sent []*SentMessage
+	sendEventID := messengerABI.Events["SendCrossChainMessage"].ID
+	for _, receiptLog := range receipt.Logs {
+		if len(receiptLog.Topics) == 0 || receiptLog.Topics[0] != sendEventID {
+			continue
+		}
+		event, err := filterer.ParseSendCrossChainMessage(*receiptLog)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse SendCrossChainMessage event: %w", err)
+		}
+		if ids.ID(event.DestinationBlockchainID) != destinationBlockchainID {
+			continue
+		}
+		packed, err := teleportermessenger.PackTeleporterMessage(event.Message)
+		if err != nil {
+			return nil, err
+		}
+		message := &SentMessage{
+			MessageID:         ids.ID(event.MessageID),
+			Message:           event.Message,
+			TeleporterAddress: receiptLog.Address,
+		}
+		for _, unsignedWarp := range unsignedWarpMessages {
+			addressedCall, err := payload.ParseAddressedCall(unsignedWarp.Payload)
+			if err != nil {
+				continue
+			}
+			if common.BytesToAddress(addressedCall.SourceAddress) == receiptLog.Address &&
+				bytes.Equal(addressedCall.Payload, packed) {
+				message.UnsignedWarp = unsignedWarp
+				break
+			}
+		}
+		if message.UnsignedWarp == nil {
+			return nil, fmt.Errorf("no Warp message found for Teleporter message %s", message.MessageID)
+		}
+		sent = append(sent, message)
+	}
+	return sent, nil
+}
+
+// SigningSubnetID returns the ID of the subnet whose validators sign the source chain's Warp messages for the
+// destination, read from the P-Chain API of the node at nodeURI. Messages sent from the primary network are
+// verified against the validators of the destination's subnet.
+func SigningSubnetID(
+	ctx context.Context,
+	nodeURI string,
+	sourceBlockchainID ids.ID,
+	destinationBlockchainID ids.ID,
+) (ids.ID, error) {
+	pChainClient := platformvm.NewClient(nodeURI)
+	subnetID, err := pChainClient.ValidatedBy(ctx, sourceBlockchainID)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("failed to get subnet validating %s: %w", sourceBlockchainID, err)
+	}
+	if subnetID != constants.PrimaryNetworkID {
+		return subnetID, nil
+	}
+	subnetID, err = pChainClient.ValidatedBy(ctx, destinationBlockchainID)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("failed to get subnet validating %s: %w", destinationBlockchainID, err)
+	}
+	return subnetID, nil
+}
+
+// SignMessage fetches the aggregate signature of the validators of the signing subnet over the message's Warp
+// message, from the Warp API of the source chain on the node at nodeURI
+func SignMessage(
+	ctx context.Context,
+	nodeURI string,
+	sourceBlockchainID ids.ID,
+	signingSubnetID ids.ID,
+	message *SentMessage,
+) (*avalancheWarp.Message, error) {
+	warpClient, err := warpBackend.NewClient(nodeURI, sourceBlockchainID.String())
+	if err != nil {
+		return nil, err
+	}
+	signedBytes, err := warpClient.GetMessageAggregateSignature(
+		ctx,
+		message.UnsignedWarp.ID(),
+		warp.WarpDefaultQuorumNumerator,
+		signingSubnetID.String(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate signature of message %s: %w", message.MessageID, err)
+	}
+	signedMessage, err := avalancheWarp.ParseMessage(signedBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse signed Warp message: %w", err)
+	}
+	return signedMessage, nil
+}
+
+// DeliverMessage sends the signed Warp message to the destination's Teleporter messenger from the key's account,
+// which is also the reward address of the delivery, with enough gas to execute the message. Returns the receipt of
+// the delivery once it is accepted. The delivery succeeds even if the message's execution fails, in which case the
+// receipt holds a MessageExecutionFailed event, and the execution can be retried with retryMessageExecution.
+func DeliverMessage(
+	ctx context.Context,
+	destination *events.Chain,
+	message *SentMessage,
+	signedMessage *avalancheWarp.Message,
+	key *ecdsa.PrivateKey,
+) (*types.Receipt, error) {
+	relayerAddress := crypto.PubkeyToAddress(key.PublicKey)
+	numSigners, err := signedMessage.Signature.NumSigners()
+	if err != nil {
+		return nil, err
+	}
+	gasLimit, err := gasUtils.CalculateReceiveMessageGasLimit(numSigners, message.Message.RequiredGasLimit)
+	if err != nil {
+		return nil, err
+	}
+	callData, err := teleportermessenger.PackReceiveCrossChainMessage(0, relayerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	chainID, err := destination.Client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination chain ID: %w", err)
+	}
+	nonce, err := destination.Client.NonceAt(ctx, relayerAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce of %s: %w", relayerAddress, err)
+	}
+	gasTipCap, err := destination.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gas tip cap: %w", err)
+	}
+	header, err := destination.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest header: %w", err)
+	}
+	// Allow the base fee to double before the delivery is accepted
+	gasFeeCap := new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), gasTipCap)
+
+	tx := predicateutils.NewPredicateTx(
+		chainID,
+		nonce,
+		&message.TeleporterAddress,
+		gasLimit,
+		gasFeeCap,
+		gasTipCap,
+		big.NewInt(0),
+		callData,
+		types.AccessList{},
+		warp.ContractAddress,
+		signedMessage.Bytes(),
+	)
+	tx, err = types.SignTx(tx, types.LatestSignerForChainID(chainID), key)
+	if err != nil {
+		return nil, err
+	}
+	if err := destination.Client.SendTransaction(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to send delivery of message %s: %w", message.MessageID, err)
+	}
+	receipt, err := bind.WaitMined(ctx, destination.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for delivery of message %s: %w", message.MessageID, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("delivery of message %s in transaction %s reverted", message.MessageID, tx.Hash())
+	}
+	return receipt, nil
+}
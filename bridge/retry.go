@@ -0,0 +1,110 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FailedMessage is a Teleporter message that was delivered, but whose execution failed, such as a transfer received
+// by a bridge contract that has paused the Teleporter messenger delivering it. The receiving messenger keeps the
+// message, so that its execution can be retried once the cause of the failure is resolved. Until then, the tokens
+// of a failed transfer stay locked in, or burned by, the bridge contract that sent it.
+type FailedMessage struct {
+	MessageID          ids.ID
+	SourceBlockchainID ids.ID
+	Message            teleportermessenger.TeleporterMessage
+	// TeleporterAddress is the address of the Teleporter messenger that received the message, which the execution
+	// is retried through
+	TeleporterAddress common.Address
+}
+
+// FailedMessages returns the Teleporter messages whose execution failed in the delivery transaction on the chain.
+// Messages received by any version of the Teleporter messenger are returned.
+func FailedMessages(ctx context.Context, chain *events.Chain, txHash common.Hash) ([]*FailedMessage, error) {
+	receipt, err := chain.Client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt of transaction %s: %w", txHash, err)
+	}
+	messengerABI, err := teleportermessenger.TeleporterMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	filterer, err := teleportermessenger.NewTeleporterMessengerFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var failed []*FailedMessage
+	failedEventID := messengerABI.Events["MessageExecutionFailed"].ID
+	for _, receiptLog := range receipt.Logs {
+		if len(receiptLog.Topics) == 0 || receiptLog.Topics[0] != failedEventID {
+			continue
+		}
+		event, err := filterer.ParseMessageExecutionFailed(*receiptLog)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse MessageExecutionFailed event: %w", err)
+		}
+		failed = append(failed, &FailedMessage{
+			MessageID:          ids.ID(event.MessageID),
+			SourceBlockchainID: ids.ID(event.SourceBlockchainID),
+			Message:            event.Message,
+			TeleporterAddress:  receiptLog.Address,
+		})
+	}
+	return failed, nil
+}
+
+// Retryable returns whether the failed message's execution can still be retried, which is no longer the case once
+// a retry has succeeded
+func (m *FailedMessage) Retryable(ctx context.Context, chain *events.Chain) (bool, error) {
+	messenger, err := teleportermessenger.NewTeleporterMessenger(m.TeleporterAddress, chain.Client)
+	if err != nil {
+		return false, err
+	}
+	hash, err := messenger.ReceivedFailedMessageHashes(&bind.CallOpts{Context: ctx}, m.MessageID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get failed message hash of %s: %w", m.MessageID, err)
+	}
+	return hash != [32]byte{}, nil
+}
+
+// RetryMessage retries the execution of the failed message on the chain it was delivered to, and returns the
+// receipt of the transaction once it is mined. The retry can be sent from any account, and is given all of the gas
+// of the transaction rather than only the message's required gas limit. A retry that would fail again, because its
+// cause is not yet resolved, returns an error from estimating its gas without sending a transaction.
+func RetryMessage(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	chain *events.Chain,
+	message *FailedMessage,
+) (*types.Receipt, error) {
+	messenger, err := teleportermessenger.NewTeleporterMessenger(message.TeleporterAddress, chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	txOpts := *opts
+	txOpts.Context = ctx
+	tx, err := messenger.RetryMessageExecution(&txOpts, message.SourceBlockchainID, message.Message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retry execution of message %s: %w", message.MessageID, err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, chain.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for retry %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("retry %s of message %s failed", tx.Hash(), message.MessageID)
+	}
+	return receipt, nil
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DiscoverOptions configure the scan for the destinations registered with a token source.
+// The zero value scans from the genesis block.
+type DiscoverOptions struct {
+	// FromBlock is the first block scanned for registrations. It should be at or before the block the source was
+	// deployed in, or destinations registered before it are missed.
+	FromBlock uint64
+	// MaxBlockRange is the maximum number of blocks per eth_getLogs request, defaulting to the events package's
+	MaxBlockRange uint64
+	// DestinationChains are the chains that destinations may be deployed on, keyed by blockchain ID. The
+	// collateralization of a destination is only read from its own chain if its chain is included.
+	DestinationChains map[ids.ID]*events.Chain
+}
+
+// RegisteredDestination is a destination registered with a token source, and so a route that tokens can be sent
+// over from the source, or between it and any other registered destination through the source
+type RegisteredDestination struct {
+	BlockchainID ids.ID         `json:"blockchainID"`
+	Address      common.Address `json:"address"`
+	// RegisteredBlock is the block of the source chain that the destination was registered in
+	RegisteredBlock uint64 `json:"registeredBlock"`
+	// TokenMultiplier and MultiplyOnDestination scale amounts sent between the source and the destination, as
+	// applied by the tokenscaling package
+	TokenMultiplier       *big.Int `json:"tokenMultiplier"`
+	MultiplyOnDestination bool     `json:"multiplyOnDestination"`
+	// CollateralNeeded is the collateral still needed by the destination, as tracked by the source. Tokens cannot
+	// be sent to the destination until it is zero.
+	CollateralNeeded *big.Int `json:"collateralNeeded"`
+	// Collateralized is whether the destination considers itself collateralized, read from the destination's chain.
+	// Nil if the destination's chain is not in DiscoverOptions.DestinationChains.
+	Collateralized *bool `json:"collateralized,omitempty"`
+}
+
+// DiscoverDestinations returns the destinations registered with the token source, in the order they were
+// registered. Destinations are found from the source's DestinationRegistered events, and their settings are then
+// read from the source's current state, so that the routes available from a source don't need to be hard-coded.
+func DiscoverDestinations(
+	ctx context.Context,
+	source Endpoint,
+	options DiscoverOptions,
+) ([]*RegisteredDestination, error) {
+	if !source.Type.IsSource() {
+		return nil, fmt.Errorf("%s is not a token source type", source.Type)
+	}
+	decoder, err := events.NewDecoder()
+	if err != nil {
+		return nil, err
+	}
+
+	var destinations []*RegisteredDestination
+	type destinationKey struct {
+		blockchainID ids.ID
+		address      common.Address
+	}
+	registered := make(map[destinationKey]struct{})
+	poller := events.NewPoller(
+		logging.NoLog{},
+		source.Chain,
+		decoder,
+		map[common.Address]events.ContractInfo{source.Address: {Type: source.Type}},
+		events.PollerConfig{MaxBlockRange: options.MaxBlockRange},
+		func(_ context.Context, bridgeEvents []*events.Event, _ uint64) error {
+			for _, event := range bridgeEvents {
+				if event.Type != events.DestinationRegistered {
+					continue
+				}
+				key := destinationKey{event.DestinationBlockchainID, event.DestinationBridgeAddress}
+				if _, ok := registered[key]; ok {
+					continue
+				}
+				registered[key] = struct{}{}
+				destinations = append(destinations, &RegisteredDestination{
+					BlockchainID:    event.DestinationBlockchainID,
+					Address:         event.DestinationBridgeAddress,
+					RegisteredBlock: event.BlockNumber,
+				})
+			}
+			return nil
+		},
+	)
+	poller.SetNextBlock(options.FromBlock)
+	for caughtUp := false; !caughtUp; {
+		if caughtUp, err = poller.Poll(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(source.Address, source.Chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	for _, destination := range destinations {
+		settings, err := tokenSource.RegisteredDestinations(opts, destination.BlockchainID, destination.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get settings of destination %s: %w", destination.Address, err)
+		}
+		destination.TokenMultiplier = settings.TokenMultiplier
+		destination.MultiplyOnDestination = settings.MultiplyOnDestination
+		destination.CollateralNeeded = settings.CollateralNeeded
+
+		chain, ok := options.DestinationChains[destination.BlockchainID]
+		if !ok {
+			continue
+		}
+		tokenDestination, err := teleportertokendestination.NewTeleporterTokenDestination(
+			destination.Address,
+			chain.Client,
+		)
+		if err != nil {
+			return nil, err
+		}
+		collateralized, err := tokenDestination.IsCollateralized(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get collateralization of destination %s: %w", destination.Address, err)
+		}
+		destination.Collateralized = &collateralized
+	}
+	return destinations, nil
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SendTokensInput is the input of a send, identical for every bridge contract
+type SendTokensInput = erc20source.SendTokensInput
+
+// SendAndCallInput is the input of a sendAndCall, identical for every bridge contract
+type SendAndCallInput = erc20source.SendAndCallInput
+
+// Send sends amount from the source bridge contract, first approving the source to spend the amount and the
+// primary fee through allowances where the current allowances do not cover them. A nil allowances approves
+// exact amounts. Returns a *PreflightError without sending if Preflight finds any problem other than an
+// allowance. Returns the receipt of the send once it is mined.
+func Send(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	source Endpoint,
+	input SendTokensInput,
+	amount *big.Int,
+	allowances *AllowanceManager,
+) (*types.Receipt, error) {
+	problems, err := Preflight(ctx, opts.From, source, input, amount)
+	if err != nil {
+		return nil, err
+	}
+	// The allowances that the send spends are approved below
+	var blocking []Problem
+	for _, problem := range problems {
+		if problem.Kind != InsufficientAllowance && problem.Kind != InsufficientFeeAllowance {
+			blocking = append(blocking, problem)
+		}
+	}
+	if len(blocking) > 0 {
+		return nil, &PreflightError{Problems: blocking}
+	}
+
+	if err := approveSend(ctx, opts, source, input, amount, allowances); err != nil {
+		return nil, err
+	}
+
+	client := source.Chain.Client
+	txOpts := *opts
+	txOpts.Context = ctx
+	var tx *types.Transaction
+	switch source.Type {
+	case events.ERC20Source:
+		var bridge *erc20source.ERC20Source
+		if bridge, err = erc20source.NewERC20Source(source.Address, client); err == nil {
+			tx, err = bridge.Send(&txOpts, input, amount)
+		}
+	case events.ERC20Destination:
+		var bridge *erc20destination.ERC20Destination
+		if bridge, err = erc20destination.NewERC20Destination(source.Address, client); err == nil {
+			tx, err = bridge.Send(&txOpts, erc20destination.SendTokensInput(input), amount)
+		}
+	case events.NativeTokenSource:
+		var bridge *nativetokensource.NativeTokenSource
+		if bridge, err = nativetokensource.NewNativeTokenSource(source.Address, client); err == nil {
+			txOpts.Value = amount
+			tx, err = bridge.Send(&txOpts, nativetokensource.SendTokensInput(input))
+		}
+	case events.NativeTokenDestination:
+		var bridge *nativetokendestination.NativeTokenDestination
+		if bridge, err = nativetokendestination.NewNativeTokenDestination(source.Address, client); err == nil {
+			txOpts.Value = amount
+			tx, err = bridge.Send(&txOpts, nativetokendestination.SendTokensInput(input))
+		}
+	default:
+		return nil, fmt.Errorf("unknown contract type %s", source.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to send: %w", err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for send %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("send %s failed", tx.Hash())
+	}
+	return receipt, nil
+}
+
+// A token amount spent by a send from the sender's allowance of the source bridge contract
+type sendSpend struct {
+	token  common.Address
+	amount *big.Int
+}
+
+// Returns the tokens spent by the send. ERC20 bridge contracts spend the sent amount of their token, along with
+// the primary fee, which is spent together with the amount when it is paid in the same token.
+func sendSpends(ctx context.Context, source Endpoint, input SendTokensInput, amount *big.Int) ([]sendSpend, error) {
+	token, err := bridgedToken(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	return tokenSpends(token, input, amount), nil
+}
+
+// Returns the tokens spent by a send from a bridge contract of the token, or of the native token if token is the
+// zero address
+func tokenSpends(token common.Address, input SendTokensInput, amount *big.Int) []sendSpend {
+	primaryFee := input.PrimaryFee
+	if primaryFee == nil {
+		primaryFee = big.NewInt(0)
+	}
+	var spends []sendSpend
+	if token != (common.Address{}) {
+		spent := amount
+		if input.PrimaryFeeTokenAddress == token {
+			spent = new(big.Int).Add(amount, primaryFee)
+		}
+		spends = append(spends, sendSpend{token: token, amount: spent})
+	}
+	if primaryFee.Sign() > 0 && input.PrimaryFeeTokenAddress != token {
+		spends = append(spends, sendSpend{token: input.PrimaryFeeTokenAddress, amount: primaryFee})
+	}
+	return spends
+}
+
+// Approves the tokens spent by the send, where the current allowances do not cover them
+func approveSend(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	source Endpoint,
+	input SendTokensInput,
+	amount *big.Int,
+	allowances *AllowanceManager,
+) error {
+	spends, err := sendSpends(ctx, source, input, amount)
+	if err != nil {
+		return err
+	}
+	for _, spend := range spends {
+		_, err := allowances.EnsureAllowance(ctx, source.Chain.Client, opts, spend.token, source.Address, spend.amount)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Returns the ERC20 token bridged by the bridge contract, or the zero address for native tokens
+func bridgedToken(ctx context.Context, endpoint Endpoint) (common.Address, error) {
+	switch endpoint.Type {
+	case events.ERC20Source:
+		tokenSource, err := teleportertokensource.NewTeleporterTokenSource(endpoint.Address, endpoint.Chain.Client)
+		if err != nil {
+			return common.Address{}, err
+		}
+		token, err := tokenSource.TokenAddress(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return common.Address{}, fmt.Errorf("failed to get source token address: %w", err)
+		}
+		return token, nil
+	case events.ERC20Destination:
+		// The destination is its own token
+		return endpoint.Address, nil
+	default:
+		return common.Address{}, nil
+	}
+}
@@ -0,0 +1,376 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The interval the source is polled at for the delivery of a registration, if not set in SetupOptions
+const defaultRegistrationPollInterval = 2 * time.Second
+
+// SetupStep is a step of setting up a destination with its token source
+type SetupStep string
+
+const (
+	// StepRegister sends the destination's registration to the source, from the destination chain
+	StepRegister SetupStep = "register"
+	// StepDeliverRegistration waits for the registration to be delivered to the source
+	StepDeliverRegistration SetupStep = "deliver-registration"
+	// StepApproveCollateral approves the collateral to an ERC20Source, on the source chain
+	StepApproveCollateral SetupStep = "approve-collateral"
+	// StepAddCollateral adds the collateral the source needs before tokens can be sent to the destination
+	StepAddCollateral SetupStep = "add-collateral"
+)
+
+// SetupOptions configures the transactions of SetupDestination
+type SetupOptions struct {
+	// DestinationOpts sign the registration on the destination chain. The registration pays no relayer fee, since
+	// the fee would be paid from the destination's own balance, so is delivered by Relay or a relayer that accepts
+	// unpaid messages.
+	DestinationOpts *bind.TransactOpts
+	// SourceOpts sign the approval and addition of the collateral on the source chain
+	SourceOpts *bind.TransactOpts
+	// RegistrationTx, if set, is the transaction of a registration that was sent by an earlier setup but not yet
+	// delivered, which is waited for instead of sending another
+	RegistrationTx common.Hash
+	// Relay, if set, is called with the receipt of the registration to deliver it to the source. Otherwise the
+	// registration is left to the relayers of the chains.
+	Relay func(ctx context.Context, registration *types.Receipt) error
+	// PollInterval is how often the source is checked for the delivery of the registration, 2s by default. The
+	// wait ends with the context.
+	PollInterval time.Duration
+}
+
+// SetupResult is the outcome of the steps of a setup
+type SetupResult struct {
+	// Completed are the steps that are done, including those done before the setup was run, in order
+	Completed []SetupStep
+	// RegistrationTx is the transaction of the registration, if sent or waited for by the setup
+	RegistrationTx common.Hash
+	// CollateralTx is the transaction that added the collateral, if any was needed
+	CollateralTx common.Hash
+	// Collateral is the amount of collateral added
+	Collateral *big.Int
+}
+
+// SetupError is returned by SetupDestination when a step fails, after the steps in Completed are done. Since the
+// registration and the collateral are transactions on different chains, joined by the delivery of a Teleporter
+// message, they cannot be reverted together, and Guidance describes how to recover from the failed step.
+type SetupError struct {
+	Step      SetupStep
+	Completed []SetupStep
+	// RegistrationTx is the transaction of the registration, if it was sent
+	RegistrationTx common.Hash
+	Err            error
+}
+
+func (e *SetupError) Error() string {
+	return fmt.Sprintf("setup failed at step %s: %v", e.Step, e.Err)
+}
+
+func (e *SetupError) Unwrap() error {
+	return e.Err
+}
+
+// Guidance describes what state the failed setup left on chain, and how to complete or abandon it. A delivered
+// registration cannot be undone, but no tokens can be sent to the destination until its collateral is added, so
+// running the setup again resumes from the failed step.
+func (e *SetupError) Guidance() string {
+	switch e.Step {
+	case StepRegister:
+		return "Nothing was changed on chain. Run the setup again once the cause is resolved."
+	case StepDeliverRegistration:
+		return fmt.Sprintf(
+			"The registration was sent in transaction %s but has not been delivered to the source. Do not send "+
+				"another registration: deliver it, or run the setup again with that registration transaction, which "+
+				"waits for it and adds the collateral. If it is never delivered, nothing needs to be rolled back.",
+			e.RegistrationTx.Hex(),
+		)
+	case StepApproveCollateral:
+		return "The destination is registered with the source, but no collateral was added, so sends to it revert. " +
+			"Run the setup again to add the collateral; it skips the registration. A registration cannot be undone, " +
+			"so an abandoned destination stays registered and unusable."
+	default:
+		return "The destination is registered with the source, but its collateral was not added, so sends to it " +
+			"revert. Run the setup again to add the remaining collateral; it skips the registration and approves " +
+			"the collateral again if needed. If abandoning the destination, revoke any approval of the collateral " +
+			"left to the source by approving zero. A registration cannot be undone."
+	}
+}
+
+// SetupDestination registers the destination with its token source and adds the initial collateral the source
+// needs for it, as one sequence of transactions on the destination and source chains. The balance of the
+// collateral is checked before anything is sent, so that a registration is not sent that the account cannot
+// collateralize. Steps found done on chain are skipped, so after a failure, which is returned as a *SetupError,
+// the same call resumes the setup.
+func SetupDestination(
+	ctx context.Context,
+	source Endpoint,
+	destination Endpoint,
+	options SetupOptions,
+) (*SetupResult, error) {
+	if !source.Type.IsSource() {
+		return nil, fmt.Errorf("%s is not a token source", source.Address)
+	}
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(source.Address, source.Chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	tokenDestination, err := teleportertokendestination.NewTeleporterTokenDestination(
+		destination.Address,
+		destination.Chain.Client,
+	)
+	if err != nil {
+		return nil, err
+	}
+	callOpts := &bind.CallOpts{Context: ctx}
+	sourceBlockchainID, err := tokenDestination.SourceBlockchainID(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source blockchain ID of destination: %w", err)
+	}
+	sourceAddress, err := tokenDestination.TokenSourceAddress(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token source address of destination: %w", err)
+	}
+	if ids.ID(sourceBlockchainID) != source.Chain.BlockchainID || sourceAddress != source.Address {
+		return nil, fmt.Errorf(
+			"destination %s bridges from %s on %s, not from %s",
+			destination.Address,
+			sourceAddress,
+			ids.ID(sourceBlockchainID),
+			source.Address,
+		)
+	}
+	settings, err := tokenSource.RegisteredDestinations(callOpts, destination.Chain.BlockchainID, destination.Address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination settings: %w", err)
+	}
+
+	result := &SetupResult{RegistrationTx: options.RegistrationTx}
+	fail := func(step SetupStep, err error) (*SetupResult, error) {
+		return result, &SetupError{
+			Step:           step,
+			Completed:      result.Completed,
+			RegistrationTx: result.RegistrationTx,
+			Err:            err,
+		}
+	}
+
+	collateral := settings.CollateralNeeded
+	if !settings.Registered {
+		expected, err := registrationCollateral(ctx, tokenDestination)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkCollateralBalance(ctx, source, options.SourceOpts.From, expected); err != nil {
+			return nil, err
+		}
+
+		var registration *types.Receipt
+		if result.RegistrationTx == (common.Hash{}) {
+			registration, err = sendRegistration(ctx, destination, tokenDestination, options)
+			if err != nil {
+				return fail(StepRegister, err)
+			}
+			result.RegistrationTx = registration.TxHash
+		} else if options.Relay != nil {
+			registration, err = destination.Chain.Client.TransactionReceipt(ctx, result.RegistrationTx)
+			if err != nil {
+				return fail(StepDeliverRegistration, fmt.Errorf(
+					"failed to get receipt of registration %s: %w", result.RegistrationTx, err,
+				))
+			}
+		}
+		result.Completed = append(result.Completed, StepRegister)
+
+		if options.Relay != nil {
+			if err := options.Relay(ctx, registration); err != nil {
+				return fail(StepDeliverRegistration, fmt.Errorf("failed to relay registration: %w", err))
+			}
+		}
+		collateral, err = waitForRegistration(ctx, tokenSource, destination, options.PollInterval)
+		if err != nil {
+			return fail(StepDeliverRegistration, err)
+		}
+	} else {
+		result.Completed = append(result.Completed, StepRegister)
+	}
+	result.Completed = append(result.Completed, StepDeliverRegistration)
+
+	result.Collateral = collateral
+	if collateral.Sign() == 0 {
+		result.Completed = append(result.Completed, StepApproveCollateral, StepAddCollateral)
+		return result, nil
+	}
+	txOpts := *options.SourceOpts
+	txOpts.Context = ctx
+	var tx *types.Transaction
+	switch source.Type {
+	case events.ERC20Source:
+		token, err := bridgedToken(ctx, source)
+		if err != nil {
+			return fail(StepApproveCollateral, err)
+		}
+		manager := &AllowanceManager{}
+		_, err = manager.EnsureAllowance(ctx, source.Chain.Client, &txOpts, token, source.Address, collateral)
+		if err != nil {
+			return fail(StepApproveCollateral, err)
+		}
+		result.Completed = append(result.Completed, StepApproveCollateral)
+
+		contract, err := erc20source.NewERC20Source(source.Address, source.Chain.Client)
+		if err != nil {
+			return fail(StepAddCollateral, err)
+		}
+		tx, err = contract.AddCollateral(&txOpts, destination.Chain.BlockchainID, destination.Address, collateral)
+		if err != nil {
+			return fail(StepAddCollateral, fmt.Errorf("failed to add collateral: %w", err))
+		}
+	default:
+		result.Completed = append(result.Completed, StepApproveCollateral)
+		contract, err := nativetokensource.NewNativeTokenSource(source.Address, source.Chain.Client)
+		if err != nil {
+			return fail(StepAddCollateral, err)
+		}
+		txOpts.Value = collateral
+		tx, err = contract.AddCollateral(&txOpts, destination.Chain.BlockchainID, destination.Address)
+		if err != nil {
+			return fail(StepAddCollateral, fmt.Errorf("failed to add collateral: %w", err))
+		}
+	}
+	receipt, err := bind.WaitMined(ctx, source.Chain.Client, tx)
+	if err != nil {
+		return fail(StepAddCollateral, fmt.Errorf("failed to wait for collateral %s: %w", tx.Hash(), err))
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fail(StepAddCollateral, fmt.Errorf("collateral %s failed", tx.Hash()))
+	}
+	result.CollateralTx = receipt.TxHash
+	result.Completed = append(result.Completed, StepAddCollateral)
+	return result, nil
+}
+
+// Returns the collateral the source will need once the destination is registered, from the destination's initial
+// reserve imbalance and scaling
+func registrationCollateral(
+	ctx context.Context,
+	tokenDestination *teleportertokendestination.TeleporterTokenDestination,
+) (*big.Int, error) {
+	callOpts := &bind.CallOpts{Context: ctx}
+	initialReserveImbalance, err := tokenDestination.InitialReserveImbalance(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get initial reserve imbalance: %w", err)
+	}
+	tokenMultiplier, err := tokenDestination.TokenMultiplier(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get token multiplier: %w", err)
+	}
+	multiplyOnDestination, err := tokenDestination.MultiplyOnDestination(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multiply on destination: %w", err)
+	}
+	return tokenscaling.CollateralNeeded(tokenMultiplier, multiplyOnDestination, initialReserveImbalance), nil
+}
+
+// Returns an error if the account holds less than the collateral of the source's token
+func checkCollateralBalance(ctx context.Context, source Endpoint, account common.Address, collateral *big.Int) error {
+	if collateral.Sign() == 0 {
+		return nil
+	}
+	token, err := bridgedToken(ctx, source)
+	if err != nil {
+		return err
+	}
+	var balance *big.Int
+	switch source.Type {
+	case events.ERC20Source:
+		client := source.Chain.Client
+		contract := bind.NewBoundContract(token, parsedERC20AllowanceABI, client, client, client)
+		var results []interface{}
+		err := contract.Call(&bind.CallOpts{Context: ctx}, &results, "balanceOf", account)
+		if err != nil {
+			return fmt.Errorf("failed to get balance of token %s: %w", token, err)
+		}
+		balance = abi.ConvertType(results[0], new(big.Int)).(*big.Int)
+	default:
+		if balance, err = source.Chain.Client.BalanceAt(ctx, account, nil); err != nil {
+			return fmt.Errorf("failed to get native balance of %s: %w", account, err)
+		}
+	}
+	if balance.Cmp(collateral) < 0 {
+		return fmt.Errorf("account %s holds %s, less than the collateral of %s needed", account, balance, collateral)
+	}
+	return nil
+}
+
+func sendRegistration(
+	ctx context.Context,
+	destination Endpoint,
+	tokenDestination *teleportertokendestination.TeleporterTokenDestination,
+	options SetupOptions,
+) (*types.Receipt, error) {
+	txOpts := *options.DestinationOpts
+	txOpts.Context = ctx
+	tx, err := tokenDestination.RegisterWithSource(&txOpts, teleportertokendestination.TeleporterFeeInfo{
+		FeeTokenAddress: common.Address{},
+		Amount:          big.NewInt(0),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register: %w", err)
+	}
+	receipt, err := bind.WaitMined(ctx, destination.Chain.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for registration %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("registration %s failed", tx.Hash())
+	}
+	return receipt, nil
+}
+
+// Polls the source until the destination is registered with it, and returns the collateral the source needs
+func waitForRegistration(
+	ctx context.Context,
+	tokenSource *teleportertokensource.TeleporterTokenSource,
+	destination Endpoint,
+	pollInterval time.Duration,
+) (*big.Int, error) {
+	if pollInterval == 0 {
+		pollInterval = defaultRegistrationPollInterval
+	}
+	for {
+		settings, err := tokenSource.RegisteredDestinations(
+			&bind.CallOpts{Context: ctx},
+			destination.Chain.BlockchainID,
+			destination.Address,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get destination settings: %w", err)
+		}
+		if settings.Registered {
+			return settings.CollateralNeeded, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("registration was not delivered: %w", ctx.Err())
+		case <-time.After(pollInterval):
+		}
+	}
+}
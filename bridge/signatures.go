@@ -0,0 +1,204 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	avalancheWarp "github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	warpBackend "github.com/ava-labs/subnet-evm/warp"
+)
+
+// SignatureStatus is whether a validator is known to have signed a Warp message
+type SignatureStatus string
+
+const (
+	SignatureSigned SignatureStatus = "signed"
+	// The validator's node was queried, and did not return a valid signature of the message
+	SignatureMissing SignatureStatus = "missing"
+	// The validator's node was not queried, and its signature was not included in the aggregate signature
+	SignatureUnknown SignatureStatus = "unknown"
+)
+
+// ValidatorSignature is the signature status of one validator of the signing subnet. Validators that share a BLS
+// key sign as one, so are reported together.
+type ValidatorSignature struct {
+	NodeIDs []ids.NodeID    `json:"nodeIDs"`
+	Weight  uint64          `json:"weight"`
+	Status  SignatureStatus `json:"status"`
+	// Error is why a missing validator's signature could not be fetched or verified
+	Error string `json:"error,omitempty"`
+}
+
+// SignatureReport reports which validators of the signing subnet have signed a message's Warp message, and whether
+// their combined weight is enough for the message to be delivered
+type SignatureReport struct {
+	MessageID       ids.ID `json:"messageID"`
+	WarpMessageID   ids.ID `json:"warpMessageID"`
+	SigningSubnetID ids.ID `json:"signingSubnetID"`
+	// PChainHeight is the P-Chain height the validator set was read at
+	PChainHeight uint64 `json:"pChainHeight"`
+	TotalWeight  uint64 `json:"totalWeight"`
+	SignedWeight uint64 `json:"signedWeight"`
+	// QuorumWeight is the signed weight needed to deliver the message at the default quorum
+	QuorumWeight uint64 `json:"quorumWeight"`
+	// AggregateError is why the aggregate signature could not be fetched, and is empty if it was
+	AggregateError string                `json:"aggregateError,omitempty"`
+	Validators     []*ValidatorSignature `json:"validators"`
+}
+
+// QuorumMet returns whether the validators known to have signed the message hold enough weight to deliver it
+func (r *SignatureReport) QuorumMet() bool {
+	return r.TotalWeight > 0 && r.SignedWeight >= r.QuorumWeight
+}
+
+// DiagnoseSignatures reports which validators of the signing subnet have signed the message's Warp message. The
+// validator set is read from the P-Chain API of the node at nodeURI, which is also asked for the aggregate
+// signature of the message, as a relayer would. The node stops collecting signatures once the quorum is reached, so
+// validators left out of the aggregate signature are only reported as missing if their own node is passed in
+// validatorURIs, and asked for its signature directly.
+func DiagnoseSignatures(
+	ctx context.Context,
+	nodeURI string,
+	validatorURIs []string,
+	sourceBlockchainID ids.ID,
+	signingSubnetID ids.ID,
+	message *SentMessage,
+) (*SignatureReport, error) {
+	pChainClient := platformvm.NewClient(nodeURI)
+	pChainHeight, err := pChainClient.GetHeight(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get P-Chain height: %w", err)
+	}
+	canonicalValidators, totalWeight, err := avalancheWarp.GetCanonicalValidatorSet(
+		ctx,
+		pChainValidatorState{pChainClient},
+		pChainHeight,
+		signingSubnetID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get validator set of subnet %s: %w", signingSubnetID, err)
+	}
+
+	report := &SignatureReport{
+		MessageID:       message.MessageID,
+		WarpMessageID:   message.UnsignedWarp.ID(),
+		SigningSubnetID: signingSubnetID,
+		PChainHeight:    pChainHeight,
+		TotalWeight:     totalWeight,
+		QuorumWeight:    quorumWeight(totalWeight, warp.WarpDefaultQuorumNumerator),
+	}
+	validatorIndexes := make(map[ids.NodeID]int)
+	for i, validator := range canonicalValidators {
+		report.Validators = append(report.Validators, &ValidatorSignature{
+			NodeIDs: validator.NodeIDs,
+			Weight:  validator.Weight,
+			Status:  SignatureUnknown,
+		})
+		for _, nodeID := range validator.NodeIDs {
+			validatorIndexes[nodeID] = i
+		}
+	}
+
+	// The signers of the aggregate signature are indexes into the canonical validator set, which is the same as the
+	// node's as long as the validator set did not change in between
+	signedMessage, err := SignMessage(ctx, nodeURI, sourceBlockchainID, signingSubnetID, message)
+	if err != nil {
+		report.AggregateError = err.Error()
+	} else if signature, ok := signedMessage.Signature.(*avalancheWarp.BitSetSignature); ok {
+		signers := set.BitsFromBytes(signature.Signers)
+		for i, validator := range report.Validators {
+			if signers.Contains(i) {
+				validator.Status = SignatureSigned
+			}
+		}
+	}
+
+	for _, uri := range validatorURIs {
+		nodeID, _, err := info.NewClient(uri).GetNodeID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get node ID of %s: %w", uri, err)
+		}
+		i, ok := validatorIndexes[nodeID]
+		if !ok {
+			return nil, fmt.Errorf("node %s at %s is not a validator of subnet %s", nodeID, uri, signingSubnetID)
+		}
+		if report.Validators[i].Status == SignatureSigned {
+			continue
+		}
+		if err := verifyValidatorSignature(ctx, uri, sourceBlockchainID, canonicalValidators[i], message); err != nil {
+			report.Validators[i].Status = SignatureMissing
+			report.Validators[i].Error = err.Error()
+			continue
+		}
+		report.Validators[i].Status = SignatureSigned
+		report.Validators[i].Error = ""
+	}
+
+	for _, validator := range report.Validators {
+		if validator.Status == SignatureSigned {
+			report.SignedWeight += validator.Weight
+		}
+	}
+	return report, nil
+}
+
+// Fetches the validator's signature of the message from the Warp API of the source chain on its node at uri, and
+// checks that it was signed with the validator's BLS key
+func verifyValidatorSignature(
+	ctx context.Context,
+	uri string,
+	sourceBlockchainID ids.ID,
+	validator *avalancheWarp.Validator,
+	message *SentMessage,
+) error {
+	warpClient, err := warpBackend.NewClient(uri, sourceBlockchainID.String())
+	if err != nil {
+		return err
+	}
+	signatureBytes, err := warpClient.GetMessageSignature(ctx, message.UnsignedWarp.ID())
+	if err != nil {
+		return fmt.Errorf("failed to get signature: %w", err)
+	}
+	signature, err := bls.SignatureFromBytes(signatureBytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse signature: %w", err)
+	}
+	if !bls.Verify(validator.PublicKey, signature, message.UnsignedWarp.Bytes()) {
+		return fmt.Errorf("signature does not match the validator's BLS key")
+	}
+	return nil
+}
+
+// Returns the smallest weight that meets the quorum of the total weight, rounding up as Warp message verification
+// does
+func quorumWeight(totalWeight uint64, quorumNumerator uint64) uint64 {
+	weight := new(big.Int).Mul(new(big.Int).SetUint64(totalWeight), new(big.Int).SetUint64(quorumNumerator))
+	denominator := new(big.Int).SetUint64(warp.WarpQuorumDenominator)
+	weight.Add(weight, new(big.Int).Sub(denominator, big.NewInt(1)))
+	return weight.Div(weight, denominator).Uint64()
+}
+
+// Reads validator sets from the P-Chain API, so that the canonical validator set can be built as the Warp
+// precompile builds it
+type pChainValidatorState struct {
+	client platformvm.Client
+}
+
+func (s pChainValidatorState) GetValidatorSet(
+	ctx context.Context,
+	height uint64,
+	subnetID ids.ID,
+) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	return s.client.GetValidatorsAt(ctx, subnetID, height)
+}
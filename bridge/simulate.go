@@ -0,0 +1,383 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/subnet-evm/params"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SimulateOptions optionally refine a simulation. The zero value is a valid set of options.
+type SimulateOptions struct {
+	// SourceChain is the chain of the token source that multi-hop transfers are routed through.
+	// Required to simulate multi-hop transfers.
+	SourceChain *events.Chain
+}
+
+// Approval is an ERC20 approval that the sender must make before sending
+type Approval struct {
+	Token   common.Address `json:"token"`
+	Spender common.Address `json:"spender"`
+	Amount  *big.Int       `json:"amount"`
+}
+
+// BalanceChange is the predicted change of an account's balance of a token
+type BalanceChange struct {
+	Chain   string         `json:"chain"`
+	Account common.Address `json:"account"`
+	// Token is the ERC20 token of the balance, or the zero address for the chain's native token
+	Token  common.Address `json:"token"`
+	Amount *big.Int       `json:"amount"`
+}
+
+// Simulation is the predicted outcome of a send, checked against the current state of each chain it reaches
+type Simulation struct {
+	Quote *TransferQuote `json:"quote"`
+	// Approvals are the approvals the sender must make before the send can succeed. If any are needed, the send is
+	// not simulated, and Events and BalanceChanges are empty.
+	Approvals []Approval `json:"approvals,omitempty"`
+	// Events are the bridge contract events emitted by the send and each delivery, in order
+	Events []events.Event `json:"events,omitempty"`
+	// BalanceChanges are the changes to the sender's and recipient's balances, excluding the gas of the send
+	BalanceChanges []BalanceChange `json:"balanceChanges,omitempty"`
+}
+
+// A delivery of a Teleporter message to a bridge contract, simulated by calling the contract from the Teleporter
+// messenger it accepts messages from
+type simulatedDelivery struct {
+	receiver            Endpoint
+	sourceBlockchainID  ids.ID
+	originSenderAddress common.Address
+	message             messages.Message
+	requiredGasLimit    *big.Int
+}
+
+// SimulateSend dry-runs sending amount from the source bridge contract with eth_call, without broadcasting any
+// transaction. The send is called from the sender on the source chain, and the delivery of each Teleporter
+// message it results in is called on the chain it is delivered to, from that chain's Teleporter messenger.
+// Returns the events and balance changes the send is expected to result in, or an error with the revert reason
+// if the send or any delivery would revert.
+func SimulateSend(
+	ctx context.Context,
+	sender common.Address,
+	source Endpoint,
+	destination Endpoint,
+	input SendTokensInput,
+	amount *big.Int,
+	options SimulateOptions,
+) (*Simulation, error) {
+	if input.DestinationBlockchainID != destination.Chain.BlockchainID ||
+		input.DestinationBridgeAddress != destination.Address {
+		return nil, fmt.Errorf(
+			"input destination %s on %s does not match destination %s on %s",
+			input.DestinationBridgeAddress,
+			ids.ID(input.DestinationBlockchainID),
+			destination.Address,
+			destination.Chain.BlockchainID,
+		)
+	}
+	quote, err := Quote(ctx, source, destination, amount, QuoteOptions{
+		RequiredGasLimit: input.RequiredGasLimit,
+		SecondaryFee:     input.SecondaryFee,
+		SourceChain:      options.SourceChain,
+	})
+	if err != nil {
+		return nil, err
+	}
+	simulation := &Simulation{Quote: quote}
+
+	// The send would revert without the allowances it spends
+	spends, err := sendSpends(ctx, source, input, amount)
+	if err != nil {
+		return nil, err
+	}
+	for _, spend := range spends {
+		approval, err := (*AllowanceManager)(nil).ApprovalNeeded(
+			ctx,
+			source.Chain.Client,
+			sender,
+			spend.token,
+			source.Address,
+			spend.amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if approval != nil {
+			simulation.Approvals = append(simulation.Approvals, Approval{
+				Token:   spend.token,
+				Spender: source.Address,
+				Amount:  approval,
+			})
+		}
+	}
+	if len(simulation.Approvals) != 0 {
+		return simulation, nil
+	}
+
+	if err := simulateSendCall(ctx, sender, source, input, amount); err != nil {
+		return nil, err
+	}
+	multiHop := quote.Route == MultiHop
+	receiver := destination
+	if multiHop {
+		settings, err := getDestinationSettings(ctx, source)
+		if err != nil {
+			return nil, err
+		}
+		receiver = Endpoint{Chain: options.SourceChain, Address: settings.tokenSourceAddress}
+	}
+	messageID, err := nextMessageID(ctx, source, receiver.Chain.BlockchainID)
+	if err != nil {
+		return nil, err
+	}
+	sentAmount := amount
+	if quote.Route == SourceToDestination {
+		sentAmount = quote.DestinationAmount
+	}
+	simulation.Events = append(simulation.Events, events.Event{
+		Type:                     events.TokensSent,
+		Chain:                    source.Chain.Name,
+		Contract:                 source.Address,
+		TeleporterMessageID:      messageID,
+		DestinationBlockchainID:  ids.ID(input.DestinationBlockchainID),
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		Sender:                   sender,
+		Recipient:                input.Recipient,
+		Amount:                   sentAmount,
+		PrimaryFeeTokenAddress:   input.PrimaryFeeTokenAddress,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+		RequiredGasLimit:         input.RequiredGasLimit,
+	})
+
+	// Destinations only send to their token source, which routes multi-hop transfers on to the final destination
+	delivery := simulatedDelivery{
+		receiver:            receiver,
+		sourceBlockchainID:  source.Chain.BlockchainID,
+		originSenderAddress: source.Address,
+		message:             messages.SingleHopSendMessage{Recipient: input.Recipient, Amount: sentAmount},
+		requiredGasLimit:    quote.PrimaryRequiredGasLimit,
+	}
+	if multiHop {
+		delivery.message = messages.MultiHopSendMessage{
+			DestinationBlockchainID:  input.DestinationBlockchainID,
+			DestinationBridgeAddress: input.DestinationBridgeAddress,
+			Recipient:                input.Recipient,
+			Amount:                   amount,
+			SecondaryFee:             input.SecondaryFee,
+			SecondaryGasLimit:        input.RequiredGasLimit,
+			MultiHopFallback:         input.MultiHopFallback,
+		}
+	}
+	if err := simulateDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	withdrawnFrom := source
+	if multiHop {
+		routedMessageID, err := nextMessageID(ctx, receiver, destination.Chain.BlockchainID)
+		if err != nil {
+			return nil, err
+		}
+		simulation.Events = append(simulation.Events, events.Event{
+			Type:                     events.TokensRouted,
+			Chain:                    receiver.Chain.Name,
+			Contract:                 receiver.Address,
+			TeleporterMessageID:      routedMessageID,
+			ReceivedMessageID:        messageID,
+			SourceBlockchainID:       source.Chain.BlockchainID,
+			SourceBridgeAddress:      source.Address,
+			DestinationBlockchainID:  destination.Chain.BlockchainID,
+			DestinationBridgeAddress: destination.Address,
+			Recipient:                input.Recipient,
+			Amount:                   quote.DestinationAmount,
+			RequiredGasLimit:         input.RequiredGasLimit,
+		})
+
+		err = simulateDelivery(ctx, simulatedDelivery{
+			receiver:            destination,
+			sourceBlockchainID:  receiver.Chain.BlockchainID,
+			originSenderAddress: receiver.Address,
+			message:             messages.SingleHopSendMessage{Recipient: input.Recipient, Amount: quote.DestinationAmount},
+			requiredGasLimit:    quote.SecondaryRequiredGasLimit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		messageID = routedMessageID
+		withdrawnFrom = receiver
+	}
+	simulation.Events = append(simulation.Events, events.Event{
+		Type:                events.TokensWithdrawn,
+		Chain:               destination.Chain.Name,
+		Contract:            destination.Address,
+		ReceivedMessageID:   messageID,
+		SourceBlockchainID:  withdrawnFrom.Chain.BlockchainID,
+		SourceBridgeAddress: withdrawnFrom.Address,
+		Recipient:           input.Recipient,
+		Amount:              quote.DestinationAmount,
+	})
+
+	simulation.BalanceChanges, err = simulatedBalanceChanges(
+		ctx,
+		sender,
+		input.Recipient,
+		source,
+		destination,
+		amount,
+		spends,
+		quote.DestinationAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return simulation, nil
+}
+
+// Calls the send from the sender, returning the revert reason if it would revert
+func simulateSendCall(
+	ctx context.Context,
+	sender common.Address,
+	source Endpoint,
+	input SendTokensInput,
+	amount *big.Int,
+) error {
+	data, value, err := packSend(source, input, amount)
+	if err != nil {
+		return err
+	}
+	_, err = source.Chain.Client.CallContract(ctx, interfaces.CallMsg{
+		From:  sender,
+		To:    &source.Address,
+		Value: value,
+		Data:  data,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf("send from %s would revert: %w", source.Address, err)
+	}
+	return nil
+}
+
+// Calls receiveTeleporterMessage on the receiver from its latest Teleporter messenger, with the gas the messenger
+// would forward to it, returning the revert reason if the delivery would revert
+func simulateDelivery(ctx context.Context, delivery simulatedDelivery) error {
+	messenger, data, err := packDelivery(ctx, delivery)
+	if err != nil {
+		return err
+	}
+	gas := delivery.requiredGasLimit.Uint64() + params.TxGas + params.TxDataNonZeroGasEIP2028*uint64(len(data))
+	_, err = delivery.receiver.Chain.Client.CallContract(ctx, interfaces.CallMsg{
+		From: messenger,
+		To:   &delivery.receiver.Address,
+		Gas:  gas,
+		Data: data,
+	}, nil)
+	if err != nil {
+		return fmt.Errorf(
+			"delivery of %s to %s would revert: %w",
+			delivery.message.Type(),
+			delivery.receiver.Address,
+			err,
+		)
+	}
+	return nil
+}
+
+// Returns the Teleporter messenger that the delivery is called from, and the call data of the receiver's
+// receiveTeleporterMessage for the delivery
+func packDelivery(ctx context.Context, delivery simulatedDelivery) (common.Address, []byte, error) {
+	teleporter, err := ResolveTeleporter(ctx, delivery.receiver)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	message, err := messages.Encode(delivery.message)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	// receiveTeleporterMessage is defined by ITeleporterReceiver, so the source ABI is packed for every contract
+	receiverABI, err := teleportertokensource.TeleporterTokenSourceMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	data, err := receiverABI.Pack(
+		"receiveTeleporterMessage",
+		delivery.sourceBlockchainID,
+		delivery.originSenderAddress,
+		message,
+	)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return teleporter.Address, data, nil
+}
+
+// Returns the ID of the next Teleporter message that the bridge contract sends to the destination blockchain
+func nextMessageID(ctx context.Context, endpoint Endpoint, destinationBlockchainID ids.ID) (ids.ID, error) {
+	teleporter, err := ResolveTeleporter(ctx, endpoint)
+	if err != nil {
+		return ids.ID{}, err
+	}
+	messenger, err := teleportermessenger.NewTeleporterMessenger(teleporter.Address, endpoint.Chain.Client)
+	if err != nil {
+		return ids.ID{}, err
+	}
+	messageID, err := messenger.GetNextMessageID(&bind.CallOpts{Context: ctx}, destinationBlockchainID)
+	if err != nil {
+		return ids.ID{}, fmt.Errorf("failed to get next Teleporter message ID: %w", err)
+	}
+	return ids.ID(messageID), nil
+}
+
+// Returns the changes to the sender's balances of the tokens spent by the send, and to the recipient's balance of
+// the token received on the destination
+func simulatedBalanceChanges(
+	ctx context.Context,
+	sender common.Address,
+	recipient common.Address,
+	source Endpoint,
+	destination Endpoint,
+	amount *big.Int,
+	spends []sendSpend,
+	destinationAmount *big.Int,
+) ([]BalanceChange, error) {
+	var changes []BalanceChange
+	if source.Type == events.NativeTokenSource || source.Type == events.NativeTokenDestination {
+		// Native tokens are sent as the value of the send, rather than spent from an allowance
+		changes = append(changes, BalanceChange{
+			Chain:   source.Chain.Name,
+			Account: sender,
+			Amount:  new(big.Int).Neg(amount),
+		})
+	}
+	for _, spend := range spends {
+		changes = append(changes, BalanceChange{
+			Chain:   source.Chain.Name,
+			Account: sender,
+			Token:   spend.token,
+			Amount:  new(big.Int).Neg(spend.amount),
+		})
+	}
+
+	token, err := bridgedToken(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+	return append(changes, BalanceChange{
+		Chain:   destination.Chain.Name,
+		Account: recipient,
+		Token:   token,
+		Amount:  destinationAmount,
+	}), nil
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	teleporterregistry "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/upgrades/TeleporterRegistry"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Teleporter is the Teleporter messenger that a bridge contract sends messages through, resolved through the
+// TeleporterRegistry configured on the contract
+type Teleporter struct {
+	Address         common.Address `json:"address"`
+	RegistryAddress common.Address `json:"registryAddress"`
+	// Version is the messenger's version in the registry, which is the registry's latest version
+	Version *big.Int `json:"version"`
+	// MinVersion is the minimum Teleporter version that the contract accepts messages from
+	MinVersion *big.Int `json:"minVersion"`
+}
+
+// ResolveTeleporter returns the Teleporter messenger that the bridge contract sends messages through, which is
+// the latest version registered with the contract's TeleporterRegistry, so follows the registry as new versions
+// are registered. The messenger is also one that the contract accepts deliveries from, since the contract's
+// minimum version cannot exceed the latest version. Returns an error if the contract has paused the messenger.
+// Every bridge contract defines the Teleporter upgrade functions, so the source binding is used for every contract.
+func ResolveTeleporter(ctx context.Context, endpoint Endpoint) (*Teleporter, error) {
+	bridge, err := teleportertokensource.NewTeleporterTokenSource(endpoint.Address, endpoint.Chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	registryAddress, err := bridge.TeleporterRegistry(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Teleporter registry of %s: %w", endpoint.Address, err)
+	}
+	minVersion, err := bridge.GetMinTeleporterVersion(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get minimum Teleporter version of %s: %w", endpoint.Address, err)
+	}
+	registry, err := teleporterregistry.NewTeleporterRegistry(registryAddress, endpoint.Chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	version, err := registry.LatestVersion(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest Teleporter version of registry %s: %w", registryAddress, err)
+	}
+	address, err := registry.GetAddressFromVersion(opts, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Teleporter messenger at version %s: %w", version, err)
+	}
+	paused, err := bridge.IsTeleporterAddressPaused(opts, address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check whether Teleporter messenger %s is paused: %w", address, err)
+	}
+	if paused {
+		return nil, fmt.Errorf("%s has paused its latest Teleporter messenger %s", endpoint.Address, address)
+	}
+	return &Teleporter{
+		Address:         address,
+		RegistryAddress: registryAddress,
+		Version:         version,
+		MinVersion:      minVersion,
+	}, nil
+}
@@ -0,0 +1,392 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package canary sends small canary transfers over configured bridge routes and measures their end-to-end
+// delivery latency and cost, for tracking service level objectives against live networks.
+package canary
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+)
+
+// Report is the outcome of a canary transfer over a route
+type Report struct {
+	Route      string      `json:"route"`
+	SendTxHash common.Hash `json:"sendTxHash"`
+	SentAt     time.Time   `json:"sentAt"`
+	// SourceGasUsed and SourceGasCost are the gas used by the send, excluding any approvals, and its cost in
+	// the native token of the sending chain
+	SourceGasUsed uint64   `json:"sourceGasUsed"`
+	SourceGasCost *big.Int `json:"sourceGasCost"`
+	// PrimaryFee and SecondaryFee are the relayer fees paid by the send, as emitted by the sending contract.
+	// The secondary fee is paid in the sent token on the chain of the token source.
+	PrimaryFeeTokenAddress common.Address `json:"primaryFeeTokenAddress"`
+	PrimaryFee             *big.Int       `json:"primaryFee"`
+	SecondaryFee           *big.Int       `json:"secondaryFee"`
+	// Hops are the deliveries of each Teleporter message carrying the transfer, in order
+	Hops []*HopReport `json:"hops"`
+	// DestinationGasUsed and DestinationGasCost are the gas used by the delivery of every hop, and its cost in
+	// the native tokens of the receiving chains, paid by the relayers
+	DestinationGasUsed uint64   `json:"destinationGasUsed"`
+	DestinationGasCost *big.Int `json:"destinationGasCost"`
+	// LatencySeconds is the time from the block of the send to the block of the delivery to the final destination
+	LatencySeconds  float64 `json:"latencySeconds"`
+	Delivered       bool    `json:"delivered"`
+	LatencyExceeded bool    `json:"latencyExceeded"`
+	Error           string  `json:"error,omitempty"`
+}
+
+// HopReport is the delivery of a single Teleporter message carrying a canary transfer
+type HopReport struct {
+	Chain          string         `json:"chain"`
+	MessageID      ids.ID         `json:"messageID"`
+	DeliveryTxHash common.Hash    `json:"deliveryTxHash"`
+	Relayer        common.Address `json:"relayer"`
+	DeliveredAt    time.Time      `json:"deliveredAt"`
+	GasUsed        uint64         `json:"gasUsed"`
+	GasCost        *big.Int       `json:"gasCost"`
+	// LatencySeconds is the time from the block of the previous hop's send to the block of this delivery
+	LatencySeconds float64 `json:"latencySeconds"`
+}
+
+// Canary sends the canary transfer of each configured route from a funded account
+type Canary struct {
+	logger  logging.Logger
+	config  *Config
+	chains  map[string]*events.Chain
+	key     *ecdsa.PrivateKey
+	decoder *events.Decoder
+
+	teleporter     *teleportermessenger.TeleporterMessengerFilterer
+	receiveMessage common.Hash
+}
+
+// NewCanary connects to each configured chain and loads the account that sends the canary transfers
+func NewCanary(ctx context.Context, logger logging.Logger, config *Config) (*Canary, error) {
+	key, err := config.LoadPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := events.NewDecoder()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event decoder: %w", err)
+	}
+	teleporter, err := teleportermessenger.NewTeleporterMessengerFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	teleporterABI, err := teleportermessenger.TeleporterMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	chains, err := events.ConnectChains(ctx, config.Chains)
+	if err != nil {
+		return nil, err
+	}
+	return &Canary{
+		logger:         logger,
+		config:         config,
+		chains:         chains,
+		key:            key,
+		decoder:        decoder,
+		teleporter:     teleporter,
+		receiveMessage: teleporterABI.Events["ReceiveCrossChainMessage"].ID,
+	}, nil
+}
+
+// Run sends the canary transfer of each route in turn, waiting for each to be delivered or time out before
+// sending the next. A failed route is reported with its error, and does not stop the remaining routes.
+func (c *Canary) Run(ctx context.Context) []*Report {
+	reports := make([]*Report, 0, len(c.config.Routes))
+	for _, route := range c.config.Routes {
+		report := &Report{Route: route.Name}
+		if err := c.transfer(ctx, route, report); err != nil {
+			c.logger.Error("Canary transfer failed", zap.String("route", route.Name), zap.Error(err))
+			report.Error = err.Error()
+		}
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+func (c *Canary) transfer(ctx context.Context, route RouteConfig, report *Report) error {
+	from := c.endpoint(route.From)
+	to := c.endpoint(route.To)
+	sender := crypto.PubkeyToAddress(c.key.PublicKey)
+
+	// The amounts were validated when the config was loaded
+	amount, _ := parseAmount("amount", route.Amount)
+	primaryFee, _ := parseAmount("primary fee", route.PrimaryFee)
+	if primaryFee == nil {
+		primaryFee = big.NewInt(0)
+	}
+	var options bridge.QuoteOptions
+	options.SecondaryFee, _ = parseAmount("secondary fee", route.SecondaryFee)
+	options.RequiredGasLimit, _ = parseAmount("required gas limit", route.RequiredGasLimit)
+	if route.Via != "" {
+		options.SourceChain = c.chains[route.Via]
+	}
+	quote, err := bridge.Quote(ctx, from, to, amount, options)
+	if err != nil {
+		return fmt.Errorf("failed to quote transfer: %w", err)
+	}
+
+	recipient := sender
+	if route.Recipient != "" {
+		recipient = common.HexToAddress(route.Recipient)
+	}
+	input := bridge.SendTokensInput{
+		DestinationBlockchainID:  to.Chain.BlockchainID,
+		DestinationBridgeAddress: to.Address,
+		Recipient:                recipient,
+		PrimaryFeeTokenAddress:   common.HexToAddress(route.PrimaryFeeTokenAddress),
+		PrimaryFee:               primaryFee,
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         quote.PrimaryRequiredGasLimit,
+	}
+	// The receiving chain of each hop, in order
+	hopChains := []*events.Chain{to.Chain}
+	if quote.Route == bridge.MultiHop {
+		if options.SecondaryFee != nil {
+			input.SecondaryFee = options.SecondaryFee
+		}
+		input.RequiredGasLimit = quote.SecondaryRequiredGasLimit
+		input.MultiHopFallback = recipient
+		hopChains = []*events.Chain{options.SourceChain, to.Chain}
+	}
+
+	// Deliveries are searched for from the latest block of each receiving chain before the send
+	fromBlocks := make([]uint64, len(hopChains))
+	for i, chain := range hopChains {
+		if fromBlocks[i], err = chain.Client.BlockNumber(ctx); err != nil {
+			return fmt.Errorf("failed to get latest block of chain %s: %w", chain.Name, err)
+		}
+	}
+
+	chainID, err := from.Chain.Client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID of %s: %w", from.Chain.Name, err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(c.key, chainID)
+	if err != nil {
+		return err
+	}
+	receipt, err := bridge.Send(ctx, opts, from, input, amount, &bridge.AllowanceManager{})
+	if err != nil {
+		return err
+	}
+	report.SendTxHash = receipt.TxHash
+	report.SourceGasUsed = receipt.GasUsed
+	report.SourceGasCost = gasCost(receipt)
+	if report.SentAt, err = blockTime(ctx, from.Chain, receipt.BlockNumber); err != nil {
+		return err
+	}
+	send, err := c.decodeSend(receipt.Logs, from.Address, route.From.Type)
+	if err != nil {
+		return err
+	}
+	report.PrimaryFeeTokenAddress = send.PrimaryFeeTokenAddress
+	report.PrimaryFee = send.PrimaryFee
+	report.SecondaryFee = send.SecondaryFee
+	c.logger.Info(
+		"Sent canary transfer",
+		zap.String("route", route.Name),
+		zap.Stringer("txHash", receipt.TxHash),
+		zap.Stringer("messageID", send.TeleporterMessageID),
+	)
+
+	ctx, cancel := context.WithTimeout(ctx, c.config.timeout())
+	defer cancel()
+	report.DestinationGasCost = big.NewInt(0)
+	messageID := send.TeleporterMessageID
+	previous := report.SentAt
+	for i, chain := range hopChains {
+		hop, delivery, err := c.waitForDelivery(ctx, chain, fromBlocks[i], messageID)
+		if err != nil {
+			return fmt.Errorf("failed to wait for delivery of message %s to chain %s: %w", messageID, chain.Name, err)
+		}
+		hop.LatencySeconds = hop.DeliveredAt.Sub(previous).Seconds()
+		previous = hop.DeliveredAt
+		report.Hops = append(report.Hops, hop)
+		report.DestinationGasUsed += hop.GasUsed
+		report.DestinationGasCost.Add(report.DestinationGasCost, hop.GasCost)
+
+		if i < len(hopChains)-1 {
+			// The token source routes the transfer to the final destination in the delivery of the first hop.
+			// The source ABI is shared by both source types.
+			routed, err := c.decodeSend(delivery.logs, delivery.receiver, events.ERC20Source)
+			if err != nil {
+				return err
+			}
+			messageID = routed.TeleporterMessageID
+		}
+	}
+
+	latency := previous.Sub(report.SentAt)
+	maxLatency := time.Duration(route.MaxLatencySeconds) * time.Second
+	report.Delivered = true
+	report.LatencySeconds = latency.Seconds()
+	report.LatencyExceeded = maxLatency != 0 && latency > maxLatency
+	c.logger.Info(
+		"Canary transfer delivered",
+		zap.String("route", route.Name),
+		zap.Duration("latency", latency),
+		zap.Bool("latencyExceeded", report.LatencyExceeded),
+	)
+	return nil
+}
+
+// The delivery transaction of a Teleporter message
+type delivery struct {
+	// receiver is the contract that the message was delivered to
+	receiver common.Address
+	logs     []*types.Log
+}
+
+// Waits until the Teleporter message is delivered to the chain, searching from the given block. RPC errors are
+// logged and retried until the context is done.
+func (c *Canary) waitForDelivery(
+	ctx context.Context,
+	chain *events.Chain,
+	fromBlock uint64,
+	messageID ids.ID,
+) (*HopReport, *delivery, error) {
+	ticker := time.NewTicker(c.config.pollInterval())
+	defer ticker.Stop()
+	for {
+		hop, delivery, nextBlock, err := c.findDelivery(ctx, chain, fromBlock, messageID)
+		if err != nil && ctx.Err() == nil {
+			c.logger.Warn(
+				"Failed to check for delivery",
+				zap.String("chain", chain.Name),
+				zap.Stringer("messageID", messageID),
+				zap.Error(err),
+			)
+		}
+		if hop != nil {
+			return hop, delivery, nil
+		}
+		fromBlock = nextBlock
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, nil, fmt.Errorf("not delivered within %s", c.config.timeout())
+			}
+			return nil, nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Searches the blocks of the chain from fromBlock for the delivery of the Teleporter message. Returns the next
+// block to search from if the message has not been delivered yet.
+func (c *Canary) findDelivery(
+	ctx context.Context,
+	chain *events.Chain,
+	fromBlock uint64,
+	messageID ids.ID,
+) (*HopReport, *delivery, uint64, error) {
+	latest, err := chain.Client.BlockNumber(ctx)
+	if err != nil {
+		return nil, nil, fromBlock, fmt.Errorf("failed to get latest block: %w", err)
+	}
+	if latest < fromBlock {
+		return nil, nil, fromBlock, nil
+	}
+	// Message IDs are unique to the messenger that sends them, so the logs of any messenger are searched
+	logs, err := chain.Client.FilterLogs(ctx, interfaces.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(latest),
+		Topics:    [][]common.Hash{{c.receiveMessage}, {common.Hash(messageID)}},
+	})
+	if err != nil {
+		return nil, nil, fromBlock, fmt.Errorf("failed to get logs: %w", err)
+	}
+	for _, log := range logs {
+		received, err := c.teleporter.ParseReceiveCrossChainMessage(log)
+		if err != nil {
+			continue
+		}
+		receipt, err := chain.Client.TransactionReceipt(ctx, log.TxHash)
+		if err != nil {
+			return nil, nil, fromBlock, fmt.Errorf("failed to get delivery receipt %s: %w", log.TxHash, err)
+		}
+		deliveredAt, err := blockTime(ctx, chain, receipt.BlockNumber)
+		if err != nil {
+			return nil, nil, fromBlock, err
+		}
+		hop := &HopReport{
+			Chain:          chain.Name,
+			MessageID:      messageID,
+			DeliveryTxHash: receipt.TxHash,
+			Relayer:        received.Deliverer,
+			DeliveredAt:    deliveredAt,
+			GasUsed:        receipt.GasUsed,
+			GasCost:        gasCost(receipt),
+		}
+		return hop, &delivery{receiver: received.Message.DestinationAddress, logs: receipt.Logs}, latest + 1, nil
+	}
+	return nil, nil, latest + 1, nil
+}
+
+// Returns the event of the bridge contract sending tokens in the transaction with the given logs
+func (c *Canary) decodeSend(logs []*types.Log, address common.Address, contractType events.ContractType) (
+	*events.Event,
+	error,
+) {
+	for _, log := range logs {
+		if log.Address != address {
+			continue
+		}
+		event, ok, err := c.decoder.Decode(*log, events.ContractInfo{Type: contractType})
+		if err != nil {
+			return nil, err
+		}
+		if ok && event.Type.IsSend() {
+			return event, nil
+		}
+	}
+	return nil, fmt.Errorf("no tokens sent by %s", address)
+}
+
+func (c *Canary) endpoint(contract events.ContractConfig) bridge.Endpoint {
+	return bridge.Endpoint{
+		Chain:   c.chains[contract.Chain],
+		Address: common.HexToAddress(contract.Address),
+		Type:    contract.Type,
+	}
+}
+
+// Returns the cost of the transaction in the native token of its chain
+func gasCost(receipt *types.Receipt) *big.Int {
+	if receipt.EffectiveGasPrice == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+}
+
+func blockTime(ctx context.Context, chain *events.Chain, blockNumber *big.Int) (time.Time, error) {
+	header, err := chain.Client.HeaderByNumber(ctx, blockNumber)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get block %s of chain %s: %w", blockNumber, chain.Name, err)
+	}
+	return time.Unix(int64(header.Time), 0), nil
+}
@@ -0,0 +1,212 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package canary
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/signer"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	defaultTimeoutSeconds      = 300
+	defaultPollIntervalSeconds = 2
+)
+
+// Config is the configuration of the canary transfers
+type Config struct {
+	// PrivateKeyFile is the path of a file containing the hex encoded private key of the account that sends
+	// the canary transfers. It must hold the tokens sent on each route, and any fees, along with the native
+	// token to pay for gas.
+	PrivateKeyFile string `json:"private-key-file"`
+	// KeystoreFile is the path of an encrypted keystore file holding the same key, in place of PrivateKeyFile, so
+	// that the key is not kept on disk in plaintext
+	KeystoreFile string `json:"keystore-file"`
+	// KeystorePassphraseEnv is the environment variable holding the passphrase of KeystoreFile
+	KeystorePassphraseEnv string `json:"keystore-passphrase-env"`
+	// TimeoutSeconds is how long to wait for each canary transfer to be delivered to its final destination
+	TimeoutSeconds uint64 `json:"timeout-seconds"`
+	// PollIntervalSeconds is how often the receiving chain of each hop is checked for its delivery
+	PollIntervalSeconds uint64 `json:"poll-interval-seconds"`
+
+	Chains []events.ChainConfig `json:"chains"`
+	Routes []RouteConfig        `json:"routes"`
+}
+
+// RouteConfig specifies a canary transfer between two bridge contracts
+type RouteConfig struct {
+	Name string                `json:"name"`
+	From events.ContractConfig `json:"from"`
+	To   events.ContractConfig `json:"to"`
+	// Via is the name of the chain of the token source that transfers between two destinations are routed through
+	Via string `json:"via"`
+	// Amount is the amount sent, as a decimal integer in the sending contract's denomination
+	Amount string `json:"amount"`
+	// Recipient is the recipient of the canary transfer on the destination. Defaults to the sender.
+	Recipient string `json:"recipient"`
+
+	// PrimaryFeeTokenAddress, PrimaryFee and SecondaryFee are the relayer fees paid by the send. The fees
+	// default to zero.
+	PrimaryFeeTokenAddress string `json:"primary-fee-token-address"`
+	PrimaryFee             string `json:"primary-fee"`
+	SecondaryFee           string `json:"secondary-fee"`
+	// RequiredGasLimit is the gas limit of the delivery to the destination. Defaults to the gas limit quoted
+	// for the destination's type.
+	RequiredGasLimit string `json:"required-gas-limit"`
+
+	// MaxLatencySeconds is the end-to-end delivery latency objective of the route. Unchecked if zero.
+	MaxLatencySeconds uint64 `json:"max-latency-seconds"`
+}
+
+// LoadConfig reads and validates the JSON configuration file at the given path,
+// filling in defaults for unset optional values.
+func LoadConfig(path string) (*Config, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var config Config
+	if err := json.Unmarshal(bytes, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	config.setDefaults()
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return &config, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.TimeoutSeconds == 0 {
+		c.TimeoutSeconds = defaultTimeoutSeconds
+	}
+	if c.PollIntervalSeconds == 0 {
+		c.PollIntervalSeconds = defaultPollIntervalSeconds
+	}
+}
+
+// Validate checks that the configuration is well formed
+func (c *Config) Validate() error {
+	if c.PrivateKeyFile == "" && c.KeystoreFile == "" {
+		return fmt.Errorf("private key file or keystore file must be set")
+	}
+	if err := c.validateKeystore(); err != nil {
+		return err
+	}
+	if len(c.Routes) == 0 {
+		return fmt.Errorf("no routes configured")
+	}
+	if err := events.Validate(c.Chains, nil); err != nil {
+		return err
+	}
+	chainNames := make(map[string]struct{}, len(c.Chains))
+	for _, chain := range c.Chains {
+		chainNames[chain.Name] = struct{}{}
+	}
+
+	routeNames := make(map[string]struct{}, len(c.Routes))
+	for _, route := range c.Routes {
+		if route.Name == "" {
+			return fmt.Errorf("route name must be set")
+		}
+		if _, ok := routeNames[route.Name]; ok {
+			return fmt.Errorf("duplicate route name %s", route.Name)
+		}
+		routeNames[route.Name] = struct{}{}
+		if err := route.validate(chainNames); err != nil {
+			return fmt.Errorf("invalid route %s: %w", route.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *RouteConfig) validate(chainNames map[string]struct{}) error {
+	for _, contract := range []events.ContractConfig{r.From, r.To} {
+		if !contract.Type.IsSource() && !contract.Type.IsDestination() {
+			return fmt.Errorf("invalid contract type %s", contract.Type)
+		}
+		if _, ok := chainNames[contract.Chain]; !ok {
+			return fmt.Errorf("unknown chain %s", contract.Chain)
+		}
+		if !common.IsHexAddress(contract.Address) {
+			return fmt.Errorf("invalid address %s", contract.Address)
+		}
+	}
+	if r.From.Type.IsDestination() && r.To.Type.IsDestination() {
+		if _, ok := chainNames[r.Via]; !ok {
+			return fmt.Errorf("via must be set to a configured chain for transfers between destinations, got %q", r.Via)
+		}
+	}
+	if r.Recipient != "" && !common.IsHexAddress(r.Recipient) {
+		return fmt.Errorf("invalid recipient %s", r.Recipient)
+	}
+	if r.PrimaryFeeTokenAddress != "" && !common.IsHexAddress(r.PrimaryFeeTokenAddress) {
+		return fmt.Errorf("invalid primary fee token address %s", r.PrimaryFeeTokenAddress)
+	}
+	amount, err := parseAmount("amount", r.Amount)
+	if err != nil {
+		return err
+	}
+	if amount == nil || amount.Sign() == 0 {
+		return fmt.Errorf("amount must be positive")
+	}
+	for description, value := range map[string]string{
+		"primary fee":        r.PrimaryFee,
+		"secondary fee":      r.SecondaryFee,
+		"required gas limit": r.RequiredGasLimit,
+	} {
+		if value == "" {
+			continue
+		}
+		if _, err := parseAmount(description, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadPrivateKey reads the private key of the account that sends the canary transfers
+func (c *Config) LoadPrivateKey() (*ecdsa.PrivateKey, error) {
+	if c.KeystoreFile != "" {
+		return signer.LoadKeystore(c.KeystoreFile, c.KeystorePassphraseEnv)
+	}
+	return signer.LoadKeyFile(c.PrivateKeyFile)
+}
+
+func (c *Config) validateKeystore() error {
+	if c.PrivateKeyFile != "" && c.KeystoreFile != "" {
+		return fmt.Errorf("only one of private key file and keystore file may be set")
+	}
+	if c.KeystoreFile != "" && c.KeystorePassphraseEnv == "" {
+		return fmt.Errorf("keystore passphrase env must be set along with the keystore file")
+	}
+	return nil
+}
+
+func (c *Config) timeout() time.Duration {
+	return time.Duration(c.TimeoutSeconds) * time.Second
+}
+
+func (c *Config) pollInterval() time.Duration {
+	return time.Duration(c.PollIntervalSeconds) * time.Second
+}
+
+// Parses a non-negative decimal integer, or returns nil for the empty string
+func parseAmount(description string, amount string) (*big.Int, error) {
+	if amount == "" {
+		return nil, nil
+	}
+	parsed, ok := new(big.Int).SetString(amount, 10)
+	if !ok || parsed.Sign() < 0 {
+		return nil, fmt.Errorf("invalid %s %q", description, amount)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// abi-bindings-gen compiles the bridge contracts with Foundry, and generates their abigen Go bindings under
+// abi-bindings/go. With --check, the bindings are not written, and the command fails if any of the checked-in
+// bindings differ from the generated ones. The bindings are a separate module, so go:generate invokes the
+// command from its own package rather than from the bindings.
+//
+// Exit codes:
+//   - 0: the bindings were generated, or are up to date
+//   - 1: at least one checked-in binding differs from its generated binding
+//   - 2: the bindings could not be generated
+package main
+
+//go:generate go run . --root ../..
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+)
+
+const (
+	exitDrift = 1
+	exitError = 2
+)
+
+// Contracts to generate Go bindings for by default
+var defaultContracts = []string{
+	"TeleporterTokenSource",
+	"TeleporterTokenDestination",
+	"ERC20Source",
+	"ERC20Destination",
+	"NativeTokenSource",
+	"NativeTokenDestination",
+	"ERC721Source",
+	"ERC721Destination",
+	"ExampleWAVAX",
+	"MockERC20SendAndCallReceiver",
+	"MockNativeSendAndCallReceiver",
+	"MockDEX",
+	"ExampleERC20SwapReceiver",
+	"ExampleERC721",
+	"MockERC721SendAndCallReceiver",
+}
+
+func main() {
+	root := flag.String("root", ".", "Path to the root of the repository")
+	forge := flag.String("forge", "forge", "Path to the forge binary")
+	skipBuild := flag.Bool("skip-build", false, "Use the existing Foundry artifacts rather than recompiling")
+	check := flag.Bool("check", false, "Fail if the checked-in bindings differ, rather than writing them")
+	contractsFlag := flag.String(
+		"contracts",
+		"",
+		"Comma or space separated contract names to generate bindings for. Defaults to all bridge contracts",
+	)
+	flag.Parse()
+
+	contracts := defaultContracts
+	if *contractsFlag != "" {
+		contracts = strings.Fields(strings.ReplaceAll(*contractsFlag, ",", " "))
+	}
+
+	drifted, err := run(*root, *forge, contracts, !*skipBuild, *check)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "abi-bindings-gen: %v\n", err)
+		os.Exit(exitError)
+	}
+	if len(drifted) > 0 {
+		fmt.Fprintln(os.Stderr, "abi-bindings-gen: the following bindings differ from the contracts:")
+		for _, file := range drifted {
+			fmt.Fprintf(os.Stderr, "  %s\n", file)
+		}
+		fmt.Fprintln(os.Stderr, "Run `go generate ./cmd/abi-bindings-gen` to regenerate them.")
+		os.Exit(exitDrift)
+	}
+}
+
+// Generates the bindings of the contracts, returning the paths of those that differ from the checked-in bindings
+// if check is set, or writing them otherwise.
+func run(root string, forge string, contracts []string, build bool, check bool) ([]string, error) {
+	contractsDir := filepath.Join(root, "contracts")
+	if build {
+		// Force recompile of all contracts to prevent against using previous
+		// compilations that did not generate new ABI files.
+		cmd := exec.Command(forge, "build", "--force", "--extra-output-files", "abi", "bin")
+		cmd.Dir = contractsDir
+		cmd.Stdout = os.Stderr
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return nil, fmt.Errorf("failed to build contracts: %w", err)
+		}
+	}
+
+	sources, err := findSources(filepath.Join(contractsDir, "src"))
+	if err != nil {
+		return nil, err
+	}
+
+	var drifted []string
+	for _, contract := range contracts {
+		dir, ok := sources[contract]
+		if !ok {
+			return nil, fmt.Errorf("source of contract %s not found", contract)
+		}
+		code, err := generate(filepath.Join(contractsDir, "out"), contract)
+		if err != nil {
+			return nil, err
+		}
+
+		// Bindings are placed at the same relative path as the contract's source
+		bindingFile := filepath.Join(root, "abi-bindings", "go", dir, contract, contract+".go")
+		if !check {
+			if err := os.MkdirAll(filepath.Dir(bindingFile), 0o755); err != nil {
+				return nil, err
+			}
+			if err := os.WriteFile(bindingFile, code, 0o644); err != nil {
+				return nil, fmt.Errorf("failed to write binding of %s: %w", contract, err)
+			}
+			fmt.Fprintf(os.Stderr, "Generated Go bindings for %s\n", contract)
+			continue
+		}
+
+		existing, err := os.ReadFile(bindingFile)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		if !bytes.Equal(existing, code) {
+			drifted = append(drifted, bindingFile)
+		}
+	}
+	sort.Strings(drifted)
+	return drifted, nil
+}
+
+// Returns the directory of each contract's source file relative to the source directory, by contract name
+func findSources(srcDir string) (map[string]string, error) {
+	sources := make(map[string]string)
+	err := filepath.WalkDir(srcDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || filepath.Ext(path) != ".sol" {
+			return err
+		}
+		dir, err := filepath.Rel(srcDir, filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		sources[strings.TrimSuffix(d.Name(), ".sol")] = dir
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find contract sources: %w", err)
+	}
+	return sources, nil
+}
+
+// Generates the Go binding of the contract from its ABI and bytecode in the Foundry output directory
+func generate(outDir string, contract string) ([]byte, error) {
+	artifactDir := filepath.Join(outDir, contract+".sol")
+	abiJSON, err := os.ReadFile(filepath.Join(artifactDir, contract+".abi.json"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ABI of %s: %w", contract, err)
+	}
+	bin, err := os.ReadFile(filepath.Join(artifactDir, contract+".bin"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bytecode of %s: %w", contract, err)
+	}
+	if strings.Contains(string(bin), "__$") {
+		return nil, fmt.Errorf("bytecode of %s has unlinked library references", contract)
+	}
+
+	code, err := bind.Bind(
+		[]string{contract},
+		[]string{string(abiJSON)},
+		[]string{string(bin)},
+		nil,
+		strings.ToLower(contract),
+		bind.LangGo,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate binding of %s: %w", contract, err)
+	}
+	return []byte(code), nil
+}
@@ -0,0 +1,267 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// abi-compat checks the public ABI surface of the bridge contracts' Go bindings against the golden files under
+// abi-bindings/surface, which list each contract's functions and events. The check fails if a function or event of
+// a golden file is changed or removed by the bindings, so that breaking changes to the contracts' interfaces are
+// made deliberately, by updating the golden files with --update in the same change. Functions and events added by
+// the bindings are reported, but do not fail the check.
+//
+// Exit codes:
+//   - 0: the bindings are compatible with the golden files, or the golden files were updated
+//   - 1: at least one function or event of a golden file is changed or removed by the bindings
+//   - 2: the check could not be run
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	erc721destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Destination"
+	erc721source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Source"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+)
+
+const (
+	exitBreaking = 1
+	exitError    = 2
+)
+
+// Bridge contracts whose ABI surface is checked, by contract name
+var contracts = map[string]*bind.MetaData{
+	"TeleporterTokenSource":      teleportertokensource.TeleporterTokenSourceMetaData,
+	"TeleporterTokenDestination": teleportertokendestination.TeleporterTokenDestinationMetaData,
+	"ERC20Source":                erc20source.ERC20SourceMetaData,
+	"ERC20Destination":           erc20destination.ERC20DestinationMetaData,
+	"NativeTokenSource":          nativetokensource.NativeTokenSourceMetaData,
+	"NativeTokenDestination":     nativetokendestination.NativeTokenDestinationMetaData,
+	"ERC721Source":               erc721source.ERC721SourceMetaData,
+	"ERC721Destination":          erc721destination.ERC721DestinationMetaData,
+}
+
+// Entry is a function or event of a contract's ABI surface. Arguments include their names, since the names of
+// event arguments and tuple components are part of the Go bindings' API.
+type Entry struct {
+	Type            string   `json:"type"`
+	Signature       string   `json:"signature"`
+	Inputs          []string `json:"inputs"`
+	Outputs         []string `json:"outputs,omitempty"`
+	StateMutability string   `json:"state-mutability,omitempty"`
+	Anonymous       bool     `json:"anonymous,omitempty"`
+}
+
+func (e Entry) String() string {
+	s := fmt.Sprintf("%s %s(%s)", e.Type, e.Signature[:strings.Index(e.Signature, "(")], strings.Join(e.Inputs, ", "))
+	if e.StateMutability != "" {
+		s += " " + e.StateMutability
+	}
+	if len(e.Outputs) > 0 {
+		s += fmt.Sprintf(" returns (%s)", strings.Join(e.Outputs, ", "))
+	}
+	if e.Anonymous {
+		s += " anonymous"
+	}
+	return s
+}
+
+func (e Entry) key() string {
+	return e.Type + " " + e.Signature
+}
+
+func (e Entry) equal(other Entry) bool {
+	return e.String() == other.String()
+}
+
+func main() {
+	root := flag.String("root", ".", "Path to the root of the repository")
+	update := flag.Bool(
+		"update",
+		false,
+		"Write the ABI surface of the bindings to the golden files, rather than checking it",
+	)
+	flag.Parse()
+
+	surfaceDir := filepath.Join(*root, "abi-bindings", "surface")
+	names := make([]string, 0, len(contracts))
+	for name := range contracts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var breaking []string
+	for _, name := range names {
+		surface, err := abiSurface(contracts[name])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "abi-compat: failed to get ABI surface of %s: %v\n", name, err)
+			os.Exit(exitError)
+		}
+		goldenFile := filepath.Join(surfaceDir, name+".json")
+		if *update {
+			if err := writeSurface(goldenFile, surface); err != nil {
+				fmt.Fprintf(os.Stderr, "abi-compat: %v\n", err)
+				os.Exit(exitError)
+			}
+			fmt.Fprintf(os.Stderr, "Wrote ABI surface of %s\n", name)
+			continue
+		}
+
+		golden, err := readSurface(goldenFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "abi-compat: %v\n", err)
+			os.Exit(exitError)
+		}
+		changes, additions := compare(golden, surface)
+		for _, change := range changes {
+			breaking = append(breaking, fmt.Sprintf("%s: %s", name, change))
+		}
+		for _, addition := range additions {
+			fmt.Fprintf(os.Stderr, "abi-compat: %s: added %s\n", name, addition)
+		}
+	}
+	if len(breaking) > 0 {
+		fmt.Fprintln(os.Stderr, "abi-compat: the bindings break the following functions and events of the golden files:")
+		for _, change := range breaking {
+			fmt.Fprintf(os.Stderr, "  %s\n", change)
+		}
+		fmt.Fprintln(
+			os.Stderr,
+			"If the changes are intended, run `go run ./cmd/abi-compat --update` to update the golden files.",
+		)
+		os.Exit(exitBreaking)
+	}
+}
+
+// Returns the functions and events of the contract's ABI, sorted by type and signature
+func abiSurface(metadata *bind.MetaData) ([]Entry, error) {
+	contractABI, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	var surface []Entry
+	for _, method := range contractABI.Methods {
+		surface = append(surface, Entry{
+			Type:            "function",
+			Signature:       method.Sig,
+			Inputs:          formatArguments(method.Inputs),
+			Outputs:         formatArguments(method.Outputs),
+			StateMutability: method.StateMutability,
+		})
+	}
+	for _, event := range contractABI.Events {
+		surface = append(surface, Entry{
+			Type:      "event",
+			Signature: event.Sig,
+			Inputs:    formatArguments(event.Inputs),
+			Anonymous: event.Anonymous,
+		})
+	}
+	sort.Slice(surface, func(i, j int) bool {
+		return surface[i].key() < surface[j].key()
+	})
+	return surface, nil
+}
+
+func formatArguments(arguments abi.Arguments) []string {
+	formatted := make([]string, 0, len(arguments))
+	for _, argument := range arguments {
+		s := formatType(argument.Type)
+		if argument.Indexed {
+			s += " indexed"
+		}
+		if argument.Name != "" {
+			s += " " + argument.Name
+		}
+		formatted = append(formatted, s)
+	}
+	return formatted
+}
+
+// Formats the type like its canonical form, but with the names of tuple components
+func formatType(t abi.Type) string {
+	switch t.T {
+	case abi.TupleTy:
+		components := make([]string, len(t.TupleElems))
+		for i, elem := range t.TupleElems {
+			components[i] = formatType(*elem) + " " + t.TupleRawNames[i]
+		}
+		return "(" + strings.Join(components, ", ") + ")"
+	case abi.SliceTy:
+		return formatType(*t.Elem) + "[]"
+	case abi.ArrayTy:
+		return fmt.Sprintf("%s[%d]", formatType(*t.Elem), t.Size)
+	default:
+		return t.String()
+	}
+}
+
+// Returns the functions and events of the golden surface that are changed or removed in the current surface, and
+// those of the current surface that are not in the golden surface
+func compare(golden []Entry, current []Entry) ([]string, []string) {
+	currentEntries := make(map[string]Entry, len(current))
+	for _, entry := range current {
+		currentEntries[entry.key()] = entry
+	}
+	goldenEntries := make(map[string]struct{}, len(golden))
+	var changes []string
+	for _, entry := range golden {
+		goldenEntries[entry.key()] = struct{}{}
+		currentEntry, ok := currentEntries[entry.key()]
+		switch {
+		case !ok:
+			changes = append(changes, fmt.Sprintf("removed %s", entry))
+		case !entry.equal(currentEntry):
+			changes = append(changes, fmt.Sprintf("changed %s to %s", entry, currentEntry))
+		}
+	}
+	var additions []string
+	for _, entry := range current {
+		if _, ok := goldenEntries[entry.key()]; !ok {
+			additions = append(additions, entry.String())
+		}
+	}
+	return changes, additions
+}
+
+// Reads the golden surface, which is empty if the golden file does not exist yet
+func readSurface(path string) ([]Entry, error) {
+	bytes, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read golden file: %w", err)
+	}
+	var surface []Entry
+	if err := json.Unmarshal(bytes, &surface); err != nil {
+		return nil, fmt.Errorf("failed to parse golden file %s: %w", path, err)
+	}
+	return surface, nil
+}
+
+func writeSurface(path string, surface []Entry) error {
+	bytes, err := json.MarshalIndent(surface, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(bytes, '\n'), 0o644); err != nil {
+		return fmt.Errorf("failed to write golden file: %w", err)
+	}
+	return nil
+}
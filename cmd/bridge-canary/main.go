@@ -0,0 +1,133 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// bridge-canary sends a small canary transfer over each configured route once, waits for it to be delivered, and
+// reports its end-to-end delivery latency, the gas used on the sending and receiving chains, and the relayer fees
+// paid. It is intended to be run periodically, e.g. by cron, to track delivery service level objectives.
+//
+// Exit codes:
+//   - 0: every canary transfer was delivered within its route's latency objective
+//   - 1: at least one canary transfer was delivered, but exceeded its route's latency objective
+//   - 2: at least one canary transfer could not be sent or was not delivered before the timeout
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/canary"
+)
+
+const (
+	exitLatencyExceeded = 1
+	exitError           = 2
+)
+
+func main() {
+	configFile := flag.String("config-file", "", "Path to the JSON configuration file")
+	logLevel := flag.String("log-level", logging.Info.LowerString(), "Log level i.e. debug, info...")
+	outputJSON := flag.Bool("json", false, "Print the canary reports as JSON")
+	flag.Parse()
+
+	reports, err := run(*configFile, *logLevel)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-canary: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	if *outputJSON {
+		err = printJSON(os.Stdout, reports)
+	} else {
+		err = printTable(os.Stdout, reports)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-canary: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	exitCode := 0
+	for _, report := range reports {
+		switch {
+		case !report.Delivered:
+			exitCode = exitError
+		case report.LatencyExceeded:
+			exitCode = max(exitCode, exitLatencyExceeded)
+		}
+	}
+	os.Exit(exitCode)
+}
+
+func run(configFile string, logLevelArg string) ([]*canary.Report, error) {
+	if configFile == "" {
+		return nil, errors.New("--config-file must be set")
+	}
+	config, err := canary.LoadConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	logLevel, err := logging.ToLevel(logLevelArg)
+	if err != nil {
+		return nil, err
+	}
+	// Logs are written to stderr so that the reports can be piped
+	logger := logging.NewLogger(
+		"bridge-canary",
+		logging.NewWrappedCore(
+			logLevel,
+			os.Stderr,
+			logging.Plain.ConsoleEncoder(),
+		),
+	)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	c, err := canary.NewCanary(ctx, logger, config)
+	if err != nil {
+		return nil, err
+	}
+	return c.Run(ctx), nil
+}
+
+func printJSON(w io.Writer, reports []*canary.Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(reports)
+}
+
+func printTable(w io.Writer, reports []*canary.Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ROUTE\tLATENCY\tSOURCE GAS\tSOURCE COST\tDESTINATION GAS\tDESTINATION COST\tRELAYER FEE\tSTATUS")
+	for _, report := range reports {
+		status := "ok"
+		switch {
+		case !report.Delivered:
+			status = "FAILED: " + report.Error
+		case report.LatencyExceeded:
+			status = "SLOW"
+		}
+		fmt.Fprintf(
+			tw,
+			"%s\t%.0fs\t%d\t%s\t%d\t%s\t%s\t%s\n",
+			report.Route,
+			report.LatencySeconds,
+			report.SourceGasUsed,
+			report.SourceGasCost,
+			report.DestinationGasUsed,
+			report.DestinationGasCost,
+			report.PrimaryFee,
+			status,
+		)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	blocklistRPC       string
+	blocklistAddress   string
+	blocklistRecipient string
+	blocklistBlock     bool
+	blocklistUnblock   bool
+	blocklistKeyEnv    string
+	blocklistJSON      bool
+)
+
+var blocklistCmd = &cobra.Command{
+	Use:   "blocklist --rpc url --address destination --recipient address [--block | --unblock]",
+	Short: "Prints or updates whether a recipient is blocked by a token destination",
+	Long: `Prints whether the token destination at --address blocks --recipient, such as
+a sanctioned address, and the amount held in escrow for it. Tokens received for a
+blocked recipient, or by a sendAndCall whose recipient contract or fallback
+recipient is blocked, are held in escrow by the destination rather than
+released, and can be claimed by the recipient once it is unblocked.
+
+With --block or --unblock, the recipient is first blocked or unblocked. The
+transaction is sent from the destination's owner, whose hex encoded private key
+is read from the environment variable named by --private-key-env. Nothing is
+sent if the recipient is already in the requested state.`,
+	Args: cobra.NoArgs,
+	Run:  blocklistRun,
+}
+
+func blocklistRun(cmd *cobra.Command, args []string) {
+	recipient, err := getBlockedRecipient(cmd.Context(), cmd.OutOrStdout())
+	cobra.CheckErr(err)
+	if blocklistJSON {
+		err = printBlockedRecipientJSON(cmd.OutOrStdout(), recipient)
+	} else {
+		err = printBlockedRecipientTable(cmd.OutOrStdout(), recipient)
+	}
+	cobra.CheckErr(err)
+}
+
+func getBlockedRecipient(ctx context.Context, w io.Writer) (*bridge.BlockedRecipient, error) {
+	address, err := parseAddress("address", blocklistAddress)
+	if err != nil {
+		return nil, err
+	}
+	recipient, err := parseAddress("recipient", blocklistRecipient)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := events.DialChain(ctx, "destination", blocklistRPC)
+	if err != nil {
+		return nil, err
+	}
+	destination := bridge.Endpoint{
+		Chain:   chain,
+		Address: address,
+	}
+
+	current, err := bridge.GetBlockedRecipient(ctx, destination, recipient)
+	if err != nil {
+		return nil, err
+	}
+	if !blocklistBlock && !blocklistUnblock {
+		return current, nil
+	}
+	blocked := blocklistBlock
+	if current.Blocked == blocked {
+		fmt.Fprintf(w, "Recipient %s is already %s\n", recipient, blockedState(blocked))
+		return current, nil
+	}
+
+	key, err := loadPrivateKey(blocklistKeyEnv, "send the transaction from")
+	if err != nil {
+		return nil, err
+	}
+	chainID, err := chain.Client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(
+		"Setting recipient blocked",
+		zap.Stringer("address", address),
+		zap.Stringer("blockchainID", chain.BlockchainID),
+		zap.Stringer("recipient", recipient),
+		zap.Bool("blocked", blocked),
+	)
+	receipt, err := bridge.SetRecipientBlocked(ctx, opts, destination, recipient, blocked)
+	if err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(w, "Recipient %s is %s, in transaction %s\n", recipient, blockedState(blocked), receipt.TxHash)
+	return bridge.GetBlockedRecipient(ctx, destination, recipient)
+}
+
+func blockedState(blocked bool) string {
+	if blocked {
+		return "blocked"
+	}
+	return "unblocked"
+}
+
+func printBlockedRecipientJSON(w io.Writer, recipient *bridge.BlockedRecipient) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(recipient)
+}
+
+func printBlockedRecipientTable(w io.Writer, recipient *bridge.BlockedRecipient) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Recipient:\t%s\n", recipient.Recipient)
+	fmt.Fprintf(tw, "Blocked:\t%t\n", recipient.Blocked)
+	fmt.Fprintf(tw, "Escrowed:\t%s\n", recipient.Escrowed)
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(blocklistCmd)
+	blocklistCmd.Flags().StringVar(&blocklistRPC, "rpc", "", "RPC endpoint of the token destination's chain")
+	blocklistCmd.Flags().StringVar(&blocklistAddress, "address", "", "Address of the token destination")
+	blocklistCmd.Flags().StringVar(&blocklistRecipient, "recipient", "", "Address of the recipient")
+	blocklistCmd.Flags().BoolVar(&blocklistBlock, "block", false, "Block the recipient")
+	blocklistCmd.Flags().BoolVar(&blocklistUnblock, "unblock", false, "Unblock the recipient")
+	blocklistCmd.Flags().StringVar(
+		&blocklistKeyEnv,
+		"private-key-env",
+		defaultPrivateKeyEnv,
+		"Environment variable holding the private key of the destination's owner",
+	)
+	blocklistCmd.Flags().BoolVar(&blocklistJSON, "json", false, "Print the recipient's blocklist state as JSON")
+
+	for _, flag := range []string{"rpc", "address", "recipient"} {
+		cobra.CheckErr(blocklistCmd.MarkFlagRequired(flag))
+	}
+	blocklistCmd.MarkFlagsMutuallyExclusive("block", "unblock")
+}
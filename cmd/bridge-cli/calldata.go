@@ -0,0 +1,377 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+// Version of the Safe Transaction Builder batch file format
+const safeBatchVersion = "1.0"
+
+var (
+	calldataAddress string
+	calldataChainID string
+	calldataSafe    bool
+
+	registerFeeToken  string
+	registerFeeAmount string
+
+	collateralType                     string
+	collateralToken                    string
+	collateralDestinationBlockchainID  string
+	collateralDestinationBridgeAddress string
+	collateralAmount                   string
+
+	minTeleporterVersion uint64
+)
+
+var calldataCmd = &cobra.Command{
+	Use:   "calldata",
+	Short: "Prints the calldata of bridge operations, for owners that cannot sign them directly",
+	Long: `Prints the transactions that perform a bridge operation, without sending them,
+so that they can be proposed to a multisig that owns or operates a bridge contract.
+Each transaction is printed with its target, value in wei and raw calldata, in the
+order they must be executed. With --safe, the transactions are instead printed as
+a Safe Transaction Builder batch for the chain with --chain-id, which executes
+them atomically when imported into the Safe.`,
+	Args: cobra.NoArgs,
+}
+
+var registerCalldataCmd = &cobra.Command{
+	Use:   "register --address destination",
+	Short: "Prints the calldata registering a destination with its token source",
+	Long: `Prints the calldata of registerWithSource on the TeleporterTokenDestination at
+--address. The registration message's fee is paid from the destination's own
+balance of the fee token, so a non-zero --fee-amount of --fee-token is first
+transferred to the destination.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		calldataRun(cmd, "Register destination", getRegisterCalldata)
+	},
+}
+
+var addCollateralCalldataCmd = &cobra.Command{
+	Use:   "add-collateral --address source --type type --destination-blockchain-id id ... --amount amount",
+	Short: "Prints the calldata adding collateral for a destination to its token source",
+	Long: `Prints the calldata of addCollateral on the token source at --address, of type
+erc20-source or native-source, for the destination bridge on the given blockchain.
+The blockchain ID is either hex or CB58 encoded. An ERC20Source transfers the
+collateral from the sender, so the --amount of --token is first approved to it. A
+NativeTokenSource is instead sent the --amount as the transaction's value. Any
+amount in excess of the collateral still needed by the destination is returned
+to the sender.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		calldataRun(cmd, "Add collateral", getAddCollateralCalldata)
+	},
+}
+
+var updateMinTeleporterVersionCalldataCmd = &cobra.Command{
+	Use:   "update-min-teleporter-version --address bridge --version version",
+	Short: "Prints the calldata updating the minimum Teleporter version of a bridge contract",
+	Long: `Prints the calldata of updateMinTeleporterVersion on the bridge contract at
+--address, which must be sent by the contract's owner. Messages delivered by
+Teleporter versions below --version are rejected once it is executed.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		calldataRun(cmd, "Update minimum Teleporter version", getUpdateMinTeleporterVersionCalldata)
+	},
+}
+
+// A transaction to be proposed to the owner of a bridge contract
+type calldataTransaction struct {
+	To    common.Address `json:"to"`
+	Value *big.Int       `json:"value"`
+	Data  hexutil.Bytes  `json:"data"`
+}
+
+// The batch file format imported by the Safe Transaction Builder, which expects values as decimal strings
+type safeBatch struct {
+	Version      string                 `json:"version"`
+	ChainID      string                 `json:"chainId"`
+	CreatedAt    int64                  `json:"createdAt"`
+	Meta         safeBatchMeta          `json:"meta"`
+	Transactions []safeBatchTransaction `json:"transactions"`
+}
+
+type safeBatchMeta struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type safeBatchTransaction struct {
+	To    string `json:"to"`
+	Value string `json:"value"`
+	Data  string `json:"data"`
+}
+
+func calldataRun(cmd *cobra.Command, name string, getTransactions func() ([]calldataTransaction, error)) {
+	transactions, err := getTransactions()
+	cobra.CheckErr(err)
+	if calldataSafe {
+		err = printSafeBatch(cmd.OutOrStdout(), name, transactions)
+	} else {
+		err = printCalldataTable(cmd.OutOrStdout(), transactions)
+	}
+	cobra.CheckErr(err)
+}
+
+func getRegisterCalldata() ([]calldataTransaction, error) {
+	destinationAddress, err := parseAddress("address", calldataAddress)
+	if err != nil {
+		return nil, err
+	}
+	feeInfo := teleportertokendestination.TeleporterFeeInfo{Amount: big.NewInt(0)}
+	if registerFeeAmount != "" {
+		if feeInfo.Amount, err = parseAmount("fee-amount", registerFeeAmount); err != nil {
+			return nil, err
+		}
+	}
+	if registerFeeToken != "" {
+		if feeInfo.FeeTokenAddress, err = parseAddress("fee-token", registerFeeToken); err != nil {
+			return nil, err
+		}
+	} else if feeInfo.Amount.Sign() != 0 {
+		return nil, fmt.Errorf("--fee-token is required with a non-zero --fee-amount")
+	}
+
+	destinationABI, err := teleportertokendestination.TeleporterTokenDestinationMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse destination ABI: %w", err)
+	}
+	var transactions []calldataTransaction
+	if feeInfo.Amount.Sign() != 0 {
+		transfer, err := packERC20Call(feeInfo.FeeTokenAddress, "transfer", destinationAddress, feeInfo.Amount)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transfer)
+	}
+	register, err := packCalldata(destinationABI, destinationAddress, nil, "registerWithSource", feeInfo)
+	if err != nil {
+		return nil, err
+	}
+	return append(transactions, register), nil
+}
+
+func getAddCollateralCalldata() ([]calldataTransaction, error) {
+	sourceAddress, err := parseAddress("address", calldataAddress)
+	if err != nil {
+		return nil, err
+	}
+	destinationBlockchainID, err := parseID("destination-blockchain-id", collateralDestinationBlockchainID)
+	if err != nil {
+		return nil, err
+	}
+	destinationBridgeAddress, err := parseAddress("destination-bridge-address", collateralDestinationBridgeAddress)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount("amount", collateralAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	switch events.ContractType(collateralType) {
+	case events.ERC20Source:
+		if collateralToken == "" {
+			return nil, fmt.Errorf("--token is required to add collateral to an %s", events.ERC20Source)
+		}
+		tokenAddress, err := parseAddress("token", collateralToken)
+		if err != nil {
+			return nil, err
+		}
+		approve, err := packERC20Call(tokenAddress, "approve", sourceAddress, amount)
+		if err != nil {
+			return nil, err
+		}
+		sourceABI, err := erc20source.ERC20SourceMetaData.GetAbi()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse ERC20Source ABI: %w", err)
+		}
+		addCollateral, err := packCalldata(
+			sourceABI,
+			sourceAddress,
+			nil,
+			"addCollateral",
+			[32]byte(destinationBlockchainID),
+			destinationBridgeAddress,
+			amount,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return []calldataTransaction{approve, addCollateral}, nil
+	case events.NativeTokenSource:
+		sourceABI, err := nativetokensource.NativeTokenSourceMetaData.GetAbi()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse NativeTokenSource ABI: %w", err)
+		}
+		addCollateral, err := packCalldata(
+			sourceABI,
+			sourceAddress,
+			amount,
+			"addCollateral",
+			[32]byte(destinationBlockchainID),
+			destinationBridgeAddress,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return []calldataTransaction{addCollateral}, nil
+	default:
+		return nil, fmt.Errorf("invalid --type %s, expected %s or %s",
+			collateralType, events.ERC20Source, events.NativeTokenSource)
+	}
+}
+
+func getUpdateMinTeleporterVersionCalldata() ([]calldataTransaction, error) {
+	bridgeAddress, err := parseAddress("address", calldataAddress)
+	if err != nil {
+		return nil, err
+	}
+	if minTeleporterVersion == 0 {
+		return nil, fmt.Errorf("invalid --version 0")
+	}
+	// Every bridge contract inherits updateMinTeleporterVersion from TeleporterUpgradeable
+	bridgeABI, err := teleportertokendestination.TeleporterTokenDestinationMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse destination ABI: %w", err)
+	}
+	update, err := packCalldata(
+		bridgeABI,
+		bridgeAddress,
+		nil,
+		"updateMinTeleporterVersion",
+		new(big.Int).SetUint64(minTeleporterVersion),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return []calldataTransaction{update}, nil
+}
+
+func packERC20Call(tokenAddress common.Address, method string, args ...interface{}) (calldataTransaction, error) {
+	tokenABI, err := exampleerc20.ExampleERC20MetaData.GetAbi()
+	if err != nil {
+		return calldataTransaction{}, fmt.Errorf("failed to parse ERC20 ABI: %w", err)
+	}
+	return packCalldata(tokenABI, tokenAddress, nil, method, args...)
+}
+
+func packCalldata(
+	contractABI *abi.ABI,
+	to common.Address,
+	value *big.Int,
+	method string,
+	args ...interface{},
+) (calldataTransaction, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return calldataTransaction{}, fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+	if value == nil {
+		value = big.NewInt(0)
+	}
+	return calldataTransaction{
+		To:    to,
+		Value: value,
+		Data:  data,
+	}, nil
+}
+
+func printCalldataTable(w io.Writer, transactions []calldataTransaction) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TO\tVALUE\tDATA")
+	for _, transaction := range transactions {
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", transaction.To, transaction.Value, transaction.Data)
+	}
+	return tw.Flush()
+}
+
+func printSafeBatch(w io.Writer, name string, transactions []calldataTransaction) error {
+	if calldataChainID == "" {
+		return fmt.Errorf("--chain-id is required with --safe")
+	}
+	if _, err := strconv.ParseUint(calldataChainID, 10, 64); err != nil {
+		return fmt.Errorf("invalid --chain-id %s", calldataChainID)
+	}
+	batch := safeBatch{
+		Version:   safeBatchVersion,
+		ChainID:   calldataChainID,
+		CreatedAt: time.Now().UnixMilli(),
+		Meta: safeBatchMeta{
+			Name:        name,
+			Description: fmt.Sprintf("%s on %s", name, calldataAddress),
+		},
+	}
+	for _, transaction := range transactions {
+		batch.Transactions = append(batch.Transactions, safeBatchTransaction{
+			To:    transaction.To.Hex(),
+			Value: transaction.Value.String(),
+			Data:  transaction.Data.String(),
+		})
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(batch)
+}
+
+func init() {
+	rootCmd.AddCommand(calldataCmd)
+	calldataCmd.PersistentFlags().StringVar(&calldataAddress, "address", "", "Address of the bridge contract")
+	calldataCmd.PersistentFlags().StringVar(&calldataChainID, "chain-id", "", "EVM chain ID of the Safe's chain")
+	calldataCmd.PersistentFlags().BoolVar(&calldataSafe, "safe", false, "Print a Safe Transaction Builder batch")
+	cobra.CheckErr(calldataCmd.MarkPersistentFlagRequired("address"))
+
+	calldataCmd.AddCommand(registerCalldataCmd)
+	registerCalldataCmd.Flags().StringVar(&registerFeeToken, "fee-token", "", "Fee token of the registration message")
+	registerCalldataCmd.Flags().StringVar(&registerFeeAmount, "fee-amount", "", "Fee of the registration message")
+
+	calldataCmd.AddCommand(addCollateralCalldataCmd)
+	addCollateralCalldataCmd.Flags().StringVar(&collateralType, "type", "", "Type of the token source")
+	addCollateralCalldataCmd.Flags().StringVar(&collateralToken, "token", "", "Token of an ERC20Source")
+	addCollateralCalldataCmd.Flags().StringVar(
+		&collateralDestinationBlockchainID,
+		"destination-blockchain-id",
+		"",
+		"Blockchain ID of the destination",
+	)
+	addCollateralCalldataCmd.Flags().StringVar(
+		&collateralDestinationBridgeAddress,
+		"destination-bridge-address",
+		"",
+		"Address of the destination bridge contract",
+	)
+	addCollateralCalldataCmd.Flags().StringVar(&collateralAmount, "amount", "", "Amount of collateral to add")
+	for _, flag := range []string{"type", "destination-blockchain-id", "destination-bridge-address", "amount"} {
+		cobra.CheckErr(addCollateralCalldataCmd.MarkFlagRequired(flag))
+	}
+
+	calldataCmd.AddCommand(updateMinTeleporterVersionCalldataCmd)
+	updateMinTeleporterVersionCalldataCmd.Flags().Uint64Var(
+		&minTeleporterVersion,
+		"version",
+		0,
+		"Minimum Teleporter version to accept messages from",
+	)
+	cobra.CheckErr(updateMinTeleporterVersionCalldataCmd.MarkFlagRequired("version"))
+}
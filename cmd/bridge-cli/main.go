@@ -0,0 +1,104 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"os"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/signer"
+	"github.com/spf13/cobra"
+)
+
+var logger logging.Logger
+
+var (
+	mnemonicEnv    string
+	derivationPath string
+	accountIndex   uint32
+
+	keystoreFile          string
+	keystorePassphraseEnv string
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "bridge-cli",
+	Short: "A CLI for inspecting Teleporter token bridges",
+	Long: `A CLI for inspecting Teleporter token bridges, and the state of their
+deployed source and destination contracts.`,
+}
+
+// Execute adds all child commands to the root command and sets flags appropriately.
+// This is called by main.main(). It only needs to happen once to the rootCmd.
+func Execute() {
+	err := rootCmd.Execute()
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+func init() {
+	rootCmd.CompletionOptions.DisableDefaultCmd = true
+	logLevelArg := rootCmd.PersistentFlags().StringP("log", "l", "", "Log level i.e. debug, info...")
+	rootCmd.PersistentFlags().StringVar(
+		&mnemonicEnv,
+		"mnemonic-env",
+		"",
+		"Environment variable holding a mnemonic to derive the key that transactions are sent from, "+
+			"instead of reading it from --private-key-env",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&derivationPath,
+		"derivation-path",
+		signer.DefaultBasePath.String(),
+		"BIP-44 path that --account-index is appended to, to derive the key from the mnemonic",
+	)
+	rootCmd.PersistentFlags().Uint32Var(
+		&accountIndex,
+		"account-index",
+		0,
+		"Index of the account of the mnemonic that transactions are sent from",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&keystoreFile,
+		"keystore-file",
+		"",
+		"Encrypted keystore file holding the key that transactions are sent from, "+
+			"instead of reading it from --private-key-env",
+	)
+	rootCmd.PersistentFlags().StringVar(
+		&keystorePassphraseEnv,
+		"keystore-passphrase-env",
+		defaultKeystorePassphraseEnv,
+		"Environment variable holding the passphrase of --keystore-file",
+	)
+	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		return rootPreRunE(logLevelArg)
+	}
+}
+
+func rootPreRunE(logLevelArg *string) error {
+	if *logLevelArg == "" {
+		*logLevelArg = logging.Info.LowerString()
+	}
+
+	logLevel, err := logging.ToLevel(*logLevelArg)
+	if err != nil {
+		return err
+	}
+	// Logs are written to stderr so that command output can be piped
+	logger = logging.NewLogger(
+		"bridge-cli",
+		logging.NewWrappedCore(
+			logLevel,
+			os.Stderr,
+			logging.Plain.ConsoleEncoder(),
+		),
+	)
+	return nil
+}
+
+func main() {
+	Execute()
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+
+	"github.com/ava-labs/teleporter-token-bridge/signer"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+)
+
+// Derives the key of --account-index from the mnemonic held by the --mnemonic-env environment variable. use
+// describes what the key is used for, in the error returned when the variable is not set.
+func deriveMnemonicKey(use string) (*ecdsa.PrivateKey, error) {
+	mnemonic := os.Getenv(mnemonicEnv)
+	if mnemonic == "" {
+		return nil, fmt.Errorf("%s must be set to the mnemonic of the account to %s", mnemonicEnv, use)
+	}
+	basePath, err := accounts.ParseDerivationPath(derivationPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --derivation-path %s: %w", derivationPath, err)
+	}
+	wallet, err := signer.NewHDWallet(mnemonic, "", basePath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid mnemonic in %s", mnemonicEnv)
+	}
+	key, err := wallet.Key(accountIndex)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info(
+		"Derived key from mnemonic",
+		zap.String("derivationPath", derivationPath),
+		zap.Uint32("accountIndex", accountIndex),
+		zap.Stringer("address", crypto.PubkeyToAddress(key.PublicKey)),
+	)
+	return key, nil
+}
@@ -0,0 +1,176 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
+	"github.com/spf13/cobra"
+)
+
+var (
+	multiplierSourceDecimals      uint8
+	multiplierDestinationDecimals uint8
+	multiplierAmount              string
+	multiplierJSON                bool
+)
+
+var multiplierCmd = &cobra.Command{
+	Use:   "multiplier --source-decimals decimals --destination-decimals decimals",
+	Short: "Prints the token scaling constructor arguments of a destination",
+	Long: `Given the decimals of the source token, and the decimals of the token on the
+destination chain, such as 18 for a NativeTokenDestination's native token, prints
+the decimalsShift and multiplyOnDestination constructor arguments of the
+destination, and the resulting tokenMultiplier. With these arguments, one whole
+source token is bridged as one whole destination token. Worked examples of
+bridging --amount, in the source token's smallest unit and defaulting to one whole
+token, are printed along with the amount that is lost to rounding in each
+direction. ERC20Destination does not scale amounts, and should be deployed with
+the source token's decimals instead.`,
+	Args: cobra.NoArgs,
+	Run:  multiplierRun,
+}
+
+type multiplierSettings struct {
+	SourceDecimals        uint8          `json:"sourceDecimals"`
+	DestinationDecimals   uint8          `json:"destinationDecimals"`
+	DecimalsShift         uint8          `json:"decimalsShift"`
+	MultiplyOnDestination bool           `json:"multiplyOnDestination"`
+	TokenMultiplier       *big.Int       `json:"tokenMultiplier"`
+	Example               scalingExample `json:"example"`
+}
+
+// A worked example of bridging an amount to the destination and back with the settings
+type scalingExample struct {
+	// SourceAmount is sent from the source, and DestinationAmount is received on the destination
+	SourceAmount      *big.Int `json:"sourceAmount"`
+	DestinationAmount *big.Int `json:"destinationAmount"`
+	// SourceDust is the part of SourceAmount that is not bridged, since it is less than the destination's
+	// smallest unit. It stays locked in the source.
+	SourceDust *big.Int `json:"sourceDust"`
+	// ReturnedAmount is received on the source when bridging DestinationAmount back
+	ReturnedAmount *big.Int `json:"returnedAmount"`
+	// MinDestinationAmount is the smallest amount of destination tokens that can be bridged back to the source.
+	// Amounts that are not a multiple of it lose the remainder, which is burned by the destination.
+	MinDestinationAmount *big.Int `json:"minDestinationAmount"`
+}
+
+func multiplierRun(cmd *cobra.Command, args []string) {
+	settings, err := getMultiplierSettings()
+	cobra.CheckErr(err)
+	if multiplierJSON {
+		err = printMultiplierJSON(cmd.OutOrStdout(), settings)
+	} else {
+		err = printMultiplierTable(cmd.OutOrStdout(), settings)
+	}
+	cobra.CheckErr(err)
+}
+
+func getMultiplierSettings() (*multiplierSettings, error) {
+	decimalsShift, multiplyOnDestination, err := tokenscaling.DecimalsShift(
+		multiplierSourceDecimals,
+		multiplierDestinationDecimals,
+	)
+	if err != nil {
+		return nil, err
+	}
+	tokenMultiplier := tokenscaling.TokenMultiplier(decimalsShift)
+
+	// One whole source token must be bridged as exactly one whole destination token, and back
+	sourceUnit := tokenscaling.TokenMultiplier(multiplierSourceDecimals)
+	destinationUnit := tokenscaling.TokenMultiplier(multiplierDestinationDecimals)
+	scaled := tokenscaling.ApplyTokenScale(tokenMultiplier, multiplyOnDestination, sourceUnit)
+	if scaled.Cmp(destinationUnit) != 0 {
+		return nil, fmt.Errorf("one source token scales to %s destination units, expected %s", scaled, destinationUnit)
+	}
+	returned := tokenscaling.RemoveTokenScale(tokenMultiplier, multiplyOnDestination, destinationUnit)
+	if returned.Cmp(sourceUnit) != 0 {
+		return nil, fmt.Errorf("one destination token scales to %s source units, expected %s", returned, sourceUnit)
+	}
+
+	amount := sourceUnit
+	if multiplierAmount != "" {
+		if amount, err = parseAmount("amount", multiplierAmount); err != nil {
+			return nil, err
+		}
+	}
+	destinationAmount := tokenscaling.ApplyTokenScale(tokenMultiplier, multiplyOnDestination, amount)
+	returnedAmount := tokenscaling.RemoveTokenScale(tokenMultiplier, multiplyOnDestination, destinationAmount)
+	minDestinationAmount := big.NewInt(1)
+	if multiplyOnDestination {
+		minDestinationAmount = tokenMultiplier
+	}
+	return &multiplierSettings{
+		SourceDecimals:        multiplierSourceDecimals,
+		DestinationDecimals:   multiplierDestinationDecimals,
+		DecimalsShift:         decimalsShift,
+		MultiplyOnDestination: multiplyOnDestination,
+		TokenMultiplier:       tokenMultiplier,
+		Example: scalingExample{
+			SourceAmount:         amount,
+			DestinationAmount:    destinationAmount,
+			SourceDust:           new(big.Int).Sub(amount, returnedAmount),
+			ReturnedAmount:       returnedAmount,
+			MinDestinationAmount: minDestinationAmount,
+		},
+	}, nil
+}
+
+func printMultiplierJSON(w io.Writer, settings *multiplierSettings) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(settings)
+}
+
+func printMultiplierTable(w io.Writer, settings *multiplierSettings) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "decimalsShift:\t%d\n", settings.DecimalsShift)
+	fmt.Fprintf(tw, "multiplyOnDestination:\t%t\n", settings.MultiplyOnDestination)
+	fmt.Fprintf(tw, "tokenMultiplier:\t%s\n", settings.TokenMultiplier)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	example := settings.Example
+	operation := "divided"
+	if settings.MultiplyOnDestination {
+		operation = "multiplied"
+	}
+	fmt.Fprintf(w, "\nAmounts sent to the destination are %s by %s, and the reverse when sent back.\n",
+		operation, settings.TokenMultiplier)
+	fmt.Fprintf(w, "Sending %s source units (%d decimals) delivers %s destination units (%d decimals).\n",
+		example.SourceAmount, settings.SourceDecimals, example.DestinationAmount, settings.DestinationDecimals)
+	if example.SourceDust.Sign() != 0 {
+		fmt.Fprintf(w, "The remaining %s source units are less than one destination unit, so stay in the source.\n",
+			example.SourceDust)
+	}
+	fmt.Fprintf(w, "Sending %s destination units back returns %s source units.\n",
+		example.DestinationAmount, example.ReturnedAmount)
+	if example.MinDestinationAmount.Cmp(big.NewInt(1)) != 0 {
+		fmt.Fprintf(w, "Only multiples of %s destination units are bridged back; the remainder is burned.\n",
+			example.MinDestinationAmount)
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(multiplierCmd)
+	multiplierCmd.Flags().Uint8Var(&multiplierSourceDecimals, "source-decimals", 0, "Decimals of the source token")
+	multiplierCmd.Flags().Uint8Var(
+		&multiplierDestinationDecimals,
+		"destination-decimals",
+		0,
+		"Decimals of the destination token, such as 18 for a native token",
+	)
+	multiplierCmd.Flags().StringVar(&multiplierAmount, "amount", "", "Source amount of the worked example")
+	multiplierCmd.Flags().BoolVar(&multiplierJSON, "json", false, "Print the settings as JSON")
+
+	cobra.CheckErr(multiplierCmd.MarkFlagRequired("source-decimals"))
+	cobra.CheckErr(multiplierCmd.MarkFlagRequired("destination-decimals"))
+}
@@ -0,0 +1,112 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	pauseRPC     string
+	pauseAddress string
+	pauseKeyEnv  string
+	pauseUnpause bool
+)
+
+var pauseCmd = &cobra.Command{
+	Use:   "pause --rpc url --address address [--unpause]",
+	Short: "Pauses or unpauses sends from a bridge contract",
+	Long: `Pauses sends from the bridge contract at --address, or unpauses them with
+--unpause, as an emergency brake. While sends are paused, sends and sendAndCalls
+from the contract revert, but messages it receives are still processed, so
+transfers already in flight settle, and multi-hop transfers routed through a
+paused token source are still forwarded. The transaction is sent from the
+contract's owner, whose hex encoded private key is read from the environment
+variable named by --private-key-env. Nothing is sent if the contract's sends
+are already in the requested state.`,
+	Args: cobra.NoArgs,
+	Run:  pauseRun,
+}
+
+func pauseRun(cmd *cobra.Command, args []string) {
+	cobra.CheckErr(setSendsPaused(cmd.Context(), cmd.OutOrStdout(), !pauseUnpause))
+}
+
+func setSendsPaused(ctx context.Context, w io.Writer, paused bool) error {
+	address, err := parseAddress("address", pauseAddress)
+	if err != nil {
+		return err
+	}
+	key, err := loadPrivateKey(pauseKeyEnv, "send the transaction from")
+	if err != nil {
+		return err
+	}
+	chain, err := events.DialChain(ctx, "bridge", pauseRPC)
+	if err != nil {
+		return err
+	}
+	endpoint := bridge.Endpoint{
+		Chain:   chain,
+		Address: address,
+	}
+
+	state := "unpaused"
+	if paused {
+		state = "paused"
+	}
+	current, err := bridge.SendsPaused(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	if current == paused {
+		fmt.Fprintf(w, "Sends from %s are already %s\n", address, state)
+		return nil
+	}
+
+	chainID, err := chain.Client.ChainID(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get chain ID: %w", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return err
+	}
+	logger.Info(
+		"Setting sends paused",
+		zap.Stringer("address", address),
+		zap.Stringer("blockchainID", chain.BlockchainID),
+		zap.Bool("paused", paused),
+	)
+	receipt, err := bridge.SetSendsPaused(ctx, opts, endpoint, paused)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Sends from %s are %s, in transaction %s\n", address, state, receipt.TxHash)
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(pauseCmd)
+	pauseCmd.Flags().StringVar(&pauseRPC, "rpc", "", "RPC endpoint of the bridge contract's chain")
+	pauseCmd.Flags().StringVar(&pauseAddress, "address", "", "Address of the bridge contract")
+	pauseCmd.Flags().StringVar(
+		&pauseKeyEnv,
+		"private-key-env",
+		defaultPrivateKeyEnv,
+		"Environment variable holding the private key of the contract's owner",
+	)
+	pauseCmd.Flags().BoolVar(&pauseUnpause, "unpause", false, "Unpause sends, rather than pausing them")
+
+	for _, flag := range []string{"rpc", "address"} {
+		cobra.CheckErr(pauseCmd.MarkFlagRequired(flag))
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	quoteFromRPC      string
+	quoteFromAddress  string
+	quoteFromType     string
+	quoteToRPC        string
+	quoteToAddress    string
+	quoteToType       string
+	quoteViaRPC       string
+	quoteAmount       string
+	quoteSecondaryFee string
+	quoteGasLimit     string
+	quoteSender       string
+	quoteRecipient    string
+	quoteJSON         bool
+)
+
+var quoteCmd = &cobra.Command{
+	Use:   "quote --from-rpc url --from-address address --from-type type --to-rpc url ... --amount amount",
+	Short: "Quotes the amount received and the fees of a transfer between two bridge contracts",
+	Long: `Prints the amount received on the destination when sending --amount from one
+bridge contract to another, along with the required gas limit and the relayer's
+cost of delivering each hop at the current gas price. Contract types are one of
+erc20-source, native-source, erc20-destination and native-destination. Transfers
+between two destinations are routed through their token source, whose chain must
+be passed with --via-rpc. If --sender is set, the gas of the send is estimated;
+ERC20 senders must already have approved the amount to the sending contract.`,
+	Args: cobra.NoArgs,
+	Run:  quoteRun,
+}
+
+func quoteRun(cmd *cobra.Command, args []string) {
+	quote, err := getQuote(cmd.Context())
+	cobra.CheckErr(err)
+	if quoteJSON {
+		err = printQuoteJSON(cmd.OutOrStdout(), quote)
+	} else {
+		err = printQuoteTable(cmd.OutOrStdout(), quote)
+	}
+	cobra.CheckErr(err)
+}
+
+func getQuote(ctx context.Context) (*bridge.TransferQuote, error) {
+	source, err := dialEndpoint(ctx, "from", quoteFromRPC, quoteFromAddress, quoteFromType)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := dialEndpoint(ctx, "to", quoteToRPC, quoteToAddress, quoteToType)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount("amount", quoteAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	var options bridge.QuoteOptions
+	if quoteSecondaryFee != "" {
+		if options.SecondaryFee, err = parseAmount("secondary-fee", quoteSecondaryFee); err != nil {
+			return nil, err
+		}
+	}
+	if quoteGasLimit != "" {
+		if options.RequiredGasLimit, err = parseAmount("required-gas-limit", quoteGasLimit); err != nil {
+			return nil, err
+		}
+	}
+	if quoteViaRPC != "" {
+		if options.SourceChain, err = events.DialChain(ctx, "via", quoteViaRPC); err != nil {
+			return nil, err
+		}
+	}
+	if quoteSender != "" {
+		if options.Sender, err = parseAddress("sender", quoteSender); err != nil {
+			return nil, err
+		}
+	}
+	if quoteRecipient != "" {
+		if options.Recipient, err = parseAddress("recipient", quoteRecipient); err != nil {
+			return nil, err
+		}
+	}
+	return bridge.Quote(ctx, source, destination, amount, options)
+}
+
+func dialEndpoint(
+	ctx context.Context,
+	name string,
+	rpc string,
+	address string,
+	contractType string,
+) (bridge.Endpoint, error) {
+	endpointAddress, err := parseAddress(name+"-address", address)
+	if err != nil {
+		return bridge.Endpoint{}, err
+	}
+	endpointType := events.ContractType(contractType)
+	if !endpointType.IsSource() && !endpointType.IsDestination() {
+		return bridge.Endpoint{}, fmt.Errorf("invalid %s-type %s", name, contractType)
+	}
+	chain, err := events.DialChain(ctx, name, rpc)
+	if err != nil {
+		return bridge.Endpoint{}, err
+	}
+	return bridge.Endpoint{
+		Chain:   chain,
+		Address: endpointAddress,
+		Type:    endpointType,
+	}, nil
+}
+
+func parseAddress(flag string, address string) (common.Address, error) {
+	if !common.IsHexAddress(address) {
+		return common.Address{}, fmt.Errorf("invalid --%s %s", flag, address)
+	}
+	return common.HexToAddress(address), nil
+}
+
+func parseAmount(flag string, amount string) (*big.Int, error) {
+	parsed, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid --%s %s", flag, amount)
+	}
+	return parsed, nil
+}
+
+func printQuoteJSON(w io.Writer, quote *bridge.TransferQuote) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(quote)
+}
+
+func printQuoteTable(w io.Writer, quote *bridge.TransferQuote) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Route:\t%s\n", quote.Route)
+	fmt.Fprintf(tw, "Destination amount:\t%s\n", quote.DestinationAmount)
+	fmt.Fprintf(tw, "Primary required gas limit:\t%s\n", quote.PrimaryRequiredGasLimit)
+	fmt.Fprintf(tw, "Primary fee:\t%s\n", quote.PrimaryFee)
+	if quote.Route == bridge.MultiHop {
+		fmt.Fprintf(tw, "Secondary required gas limit:\t%s\n", quote.SecondaryRequiredGasLimit)
+		fmt.Fprintf(tw, "Secondary fee:\t%s\n", quote.SecondaryFee)
+	}
+	if quote.EstimatedGas != 0 {
+		fmt.Fprintf(tw, "Estimated gas:\t%d\n", quote.EstimatedGas)
+	}
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(quoteCmd)
+	quoteCmd.Flags().StringVar(&quoteFromRPC, "from-rpc", "", "RPC endpoint of the sending chain")
+	quoteCmd.Flags().StringVar(&quoteFromAddress, "from-address", "", "Address of the sending bridge contract")
+	quoteCmd.Flags().StringVar(&quoteFromType, "from-type", "", "Type of the sending bridge contract")
+	quoteCmd.Flags().StringVar(&quoteToRPC, "to-rpc", "", "RPC endpoint of the receiving chain")
+	quoteCmd.Flags().StringVar(&quoteToAddress, "to-address", "", "Address of the receiving bridge contract")
+	quoteCmd.Flags().StringVar(&quoteToType, "to-type", "", "Type of the receiving bridge contract")
+	quoteCmd.Flags().StringVar(&quoteViaRPC, "via-rpc", "", "RPC endpoint of the token source's chain, for multi-hops")
+	quoteCmd.Flags().StringVar(&quoteAmount, "amount", "", "Amount to send, in the sending contract's denomination")
+	quoteCmd.Flags().StringVar(&quoteSecondaryFee, "secondary-fee", "", "Secondary fee of a multi-hop transfer")
+	quoteCmd.Flags().StringVar(&quoteGasLimit, "required-gas-limit", "", "Gas limit of the delivery to the destination")
+	quoteCmd.Flags().StringVar(&quoteSender, "sender", "", "Account to estimate the gas of the send for")
+	quoteCmd.Flags().StringVar(&quoteRecipient, "recipient", "", "Recipient on the destination, defaults to --sender")
+	quoteCmd.Flags().BoolVar(&quoteJSON, "json", false, "Print the quote as JSON")
+
+	for _, flag := range []string{"from-rpc", "from-address", "from-type", "to-rpc", "to-address", "to-type", "amount"} {
+		cobra.CheckErr(quoteCmd.MarkFlagRequired(flag))
+	}
+}
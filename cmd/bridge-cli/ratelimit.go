@@ -0,0 +1,176 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	rateLimitRPC                      string
+	rateLimitAddress                  string
+	rateLimitDestinationBlockchainID  string
+	rateLimitDestinationBridgeAddress string
+	rateLimitLimit                    string
+	rateLimitWindow                   time.Duration
+	rateLimitKeyEnv                   string
+	rateLimitJSON                     bool
+)
+
+var rateLimitCmd = &cobra.Command{
+	Use:   "rate-limit --rpc url --address source --destination-blockchain-id id ... [--limit amount --window duration]",
+	Short: "Prints or updates the rate limit of a token source on a destination",
+	Long: `Prints the rate limit of the token source at --address on the tokens it sends
+to a destination: the maximum amount sent in each window, the amount sent in the
+current window, and the amount that can still be sent in it. Sends that would
+exceed the limit revert, and multi-hop transfers that would exceed it are sent
+to their multi-hop fallback.
+
+With --limit, the rate limit is first updated to --limit tokens, in the source's
+denomination, per --window, starting a new window. A --limit of 0 removes the
+rate limit. The transaction is sent from the source's owner, whose hex encoded
+private key is read from the environment variable named by --private-key-env.`,
+	Args: cobra.NoArgs,
+	Run:  rateLimitRun,
+}
+
+func rateLimitRun(cmd *cobra.Command, args []string) {
+	rateLimit, err := getRateLimit(cmd.Context(), cmd.OutOrStdout(), cmd.Flags().Changed("limit"))
+	cobra.CheckErr(err)
+	if rateLimitJSON {
+		err = printRateLimitJSON(cmd.OutOrStdout(), rateLimit)
+	} else {
+		err = printRateLimitTable(cmd.OutOrStdout(), rateLimit)
+	}
+	cobra.CheckErr(err)
+}
+
+func getRateLimit(ctx context.Context, w io.Writer, update bool) (*bridge.RateLimit, error) {
+	address, err := parseAddress("address", rateLimitAddress)
+	if err != nil {
+		return nil, err
+	}
+	destinationBlockchainID, err := parseID("destination-blockchain-id", rateLimitDestinationBlockchainID)
+	if err != nil {
+		return nil, err
+	}
+	destinationAddress, err := parseAddress("destination-bridge-address", rateLimitDestinationBridgeAddress)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := events.DialChain(ctx, "source", rateLimitRPC)
+	if err != nil {
+		return nil, err
+	}
+	source := bridge.Endpoint{
+		Chain:   chain,
+		Address: address,
+	}
+
+	if update {
+		limit, err := parseAmount("limit", rateLimitLimit)
+		if err != nil {
+			return nil, err
+		}
+		if limit.Sign() > 0 && rateLimitWindow <= 0 {
+			return nil, fmt.Errorf("--window must be set with a non-zero --limit")
+		}
+		key, err := loadPrivateKey(rateLimitKeyEnv, "send the transaction from")
+		if err != nil {
+			return nil, err
+		}
+		chainID, err := chain.Client.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get chain ID: %w", err)
+		}
+		opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(
+			"Setting rate limit",
+			zap.Stringer("address", address),
+			zap.Stringer("destinationBlockchainID", destinationBlockchainID),
+			zap.Stringer("destinationAddress", destinationAddress),
+			zap.Stringer("limit", limit),
+			zap.Duration("window", rateLimitWindow),
+		)
+		receipt, err := bridge.SetRateLimit(
+			ctx,
+			opts,
+			source,
+			destinationBlockchainID,
+			destinationAddress,
+			limit,
+			rateLimitWindow,
+		)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(w, "Rate limit of %s updated in transaction %s\n", destinationAddress, receipt.TxHash)
+	}
+	return bridge.GetRateLimit(ctx, source, destinationBlockchainID, destinationAddress)
+}
+
+func printRateLimitJSON(w io.Writer, rateLimit *bridge.RateLimit) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(rateLimit)
+}
+
+func printRateLimitTable(w io.Writer, rateLimit *bridge.RateLimit) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if rateLimit.Remaining == nil {
+		fmt.Fprintf(tw, "Limit:\tnone\n")
+		return tw.Flush()
+	}
+	fmt.Fprintf(tw, "Limit:\t%s\n", rateLimit.Limit)
+	fmt.Fprintf(tw, "Window:\t%s\n", rateLimit.Window)
+	fmt.Fprintf(tw, "Window start:\t%s\n", formatTime(&rateLimit.WindowStart))
+	fmt.Fprintf(tw, "Amount sent:\t%s\n", rateLimit.AmountSent)
+	fmt.Fprintf(tw, "Remaining:\t%s\n", rateLimit.Remaining)
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(rateLimitCmd)
+	rateLimitCmd.Flags().StringVar(&rateLimitRPC, "rpc", "", "RPC endpoint of the token source's chain")
+	rateLimitCmd.Flags().StringVar(&rateLimitAddress, "address", "", "Address of the token source")
+	rateLimitCmd.Flags().StringVar(
+		&rateLimitDestinationBlockchainID,
+		"destination-blockchain-id",
+		"",
+		"Blockchain ID of the destination",
+	)
+	rateLimitCmd.Flags().StringVar(
+		&rateLimitDestinationBridgeAddress,
+		"destination-bridge-address",
+		"",
+		"Address of the destination bridge contract",
+	)
+	rateLimitCmd.Flags().StringVar(&rateLimitLimit, "limit", "", "Update the limit to this amount per --window")
+	rateLimitCmd.Flags().DurationVar(&rateLimitWindow, "window", 0, "Window of the updated limit, in whole seconds")
+	rateLimitCmd.Flags().StringVar(
+		&rateLimitKeyEnv,
+		"private-key-env",
+		defaultPrivateKeyEnv,
+		"Environment variable holding the private key of the source's owner",
+	)
+	rateLimitCmd.Flags().BoolVar(&rateLimitJSON, "json", false, "Print the rate limit as JSON")
+
+	for _, flag := range []string{"rpc", "address", "destination-blockchain-id", "destination-bridge-address"} {
+		cobra.CheckErr(rateLimitCmd.MarkFlagRequired(flag))
+	}
+}
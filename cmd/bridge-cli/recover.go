@@ -0,0 +1,293 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ava-labs/avalanchego/ids"
+	avalancheWarp "github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/signer"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+const (
+	// The default environment variable holding the private key of commands that send transactions
+	defaultPrivateKeyEnv = "BRIDGE_CLI_PRIVATE_KEY"
+	// The default environment variable holding the passphrase of --keystore-file
+	defaultKeystorePassphraseEnv = "BRIDGE_CLI_KEYSTORE_PASSPHRASE"
+)
+
+var (
+	recoverSourceRPC      string
+	recoverDestinationRPC string
+	recoverTxHash         string
+	recoverNodeURI        string
+	recoverMessageID      string
+	recoverKeyEnv         string
+	recoverDryRun         bool
+)
+
+var recoverCmd = &cobra.Command{
+	Use:   "recover --source-rpc url --destination-rpc url --tx-hash hash",
+	Short: "Delivers the undelivered Teleporter messages sent by a source chain transaction",
+	Long: `Given the hash of a transaction on the source chain that sent one or more
+Teleporter messages, such as a bridge send, delivers each message to the chain of
+--destination-rpc that has not yet been received there, as a relayer would. The
+aggregate signature of each message's Warp message is fetched from the Warp API of
+the source chain node at --node-uri, which defaults to the host of --source-rpc.
+The delivery is sent from the account whose hex encoded private key is read from
+the environment variable named by --private-key-env, which is also the reward
+address of the delivery. Pass --message-id to only deliver one of the messages,
+and --dry-run to check that the messages can be signed without delivering them.`,
+	Args: cobra.NoArgs,
+	Run:  recoverRun,
+}
+
+// The outcome of recovering a Teleporter message
+type recoveryStatus string
+
+const (
+	recoveryAlreadyDelivered recoveryStatus = "already delivered"
+	recoverySigned           recoveryStatus = "signed"
+	recoveryExecuted         recoveryStatus = "delivered and executed"
+	// The message was delivered, but its execution failed, so it can be retried with retryMessageExecution
+	recoveryExecutionFailed recoveryStatus = "delivered, execution failed"
+)
+
+type recoveredMessage struct {
+	MessageID ids.ID
+	Status    recoveryStatus
+	// The transaction delivering the message, if it was delivered by the command
+	DeliveryTxHash common.Hash
+}
+
+func recoverRun(cmd *cobra.Command, args []string) {
+	recovered, err := recoverMessages(cmd.Context())
+	cobra.CheckErr(err)
+	cobra.CheckErr(printRecoveredMessages(cmd.OutOrStdout(), recovered))
+}
+
+func recoverMessages(ctx context.Context) ([]recoveredMessage, error) {
+	if !strings.HasPrefix(recoverTxHash, "0x") || len(recoverTxHash) != 2+2*common.HashLength {
+		return nil, fmt.Errorf("invalid --tx-hash %s", recoverTxHash)
+	}
+	txHash := common.HexToHash(recoverTxHash)
+	var messageID ids.ID
+	if recoverMessageID != "" {
+		var err error
+		if messageID, err = parseID("message-id", recoverMessageID); err != nil {
+			return nil, err
+		}
+	}
+	nodeURI, err := getRecoverNodeURI()
+	if err != nil {
+		return nil, err
+	}
+
+	var key *ecdsa.PrivateKey
+	if !recoverDryRun {
+		if key, err = loadPrivateKey(recoverKeyEnv, "deliver messages from"); err != nil {
+			return nil, err
+		}
+	}
+
+	source, err := events.DialChain(ctx, "source", recoverSourceRPC)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := events.DialChain(ctx, "destination", recoverDestinationRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := bridge.SentMessages(ctx, source, destination.BlockchainID, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if messageID != ids.Empty {
+		sent = filterSentMessages(sent, messageID)
+	}
+	if len(sent) == 0 {
+		return nil, fmt.Errorf("transaction %s sent no matching Teleporter messages to %s", txHash, destination.BlockchainID)
+	}
+
+	signingSubnetID, err := bridge.SigningSubnetID(ctx, nodeURI, source.BlockchainID, destination.BlockchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	recovered := make([]recoveredMessage, 0, len(sent))
+	for _, message := range sent {
+		messenger, err := teleportermessenger.NewTeleporterMessenger(message.TeleporterAddress, destination.Client)
+		if err != nil {
+			return nil, err
+		}
+		delivered, err := messenger.MessageReceived(&bind.CallOpts{Context: ctx}, message.MessageID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if message %s was received: %w", message.MessageID, err)
+		}
+		if delivered {
+			logger.Info("Message already delivered", zap.Stringer("messageID", message.MessageID))
+			recovered = append(recovered, recoveredMessage{MessageID: message.MessageID, Status: recoveryAlreadyDelivered})
+			continue
+		}
+
+		logger.Info(
+			"Fetching aggregate signature",
+			zap.Stringer("messageID", message.MessageID),
+			zap.Stringer("warpMessageID", message.UnsignedWarp.ID()),
+			zap.Stringer("signingSubnetID", signingSubnetID),
+		)
+		signedMessage, err := bridge.SignMessage(ctx, nodeURI, source.BlockchainID, signingSubnetID, message)
+		if err != nil {
+			return nil, err
+		}
+		if recoverDryRun {
+			recovered = append(recovered, recoveredMessage{MessageID: message.MessageID, Status: recoverySigned})
+			continue
+		}
+
+		result, err := deliverMessage(ctx, destination, messenger, message, signedMessage, key)
+		if err != nil {
+			return nil, err
+		}
+		recovered = append(recovered, *result)
+	}
+	return recovered, nil
+}
+
+// Delivers the signed Warp message to the destination's Teleporter messenger, and waits for the delivery to be
+// accepted
+func deliverMessage(
+	ctx context.Context,
+	destination *events.Chain,
+	messenger *teleportermessenger.TeleporterMessenger,
+	message *bridge.SentMessage,
+	signedMessage *avalancheWarp.Message,
+	key *ecdsa.PrivateKey,
+) (*recoveredMessage, error) {
+	logger.Info("Delivering message", zap.Stringer("messageID", message.MessageID))
+	receipt, err := bridge.DeliverMessage(ctx, destination, message, signedMessage, key)
+	if err != nil {
+		return nil, err
+	}
+
+	recovered := &recoveredMessage{MessageID: message.MessageID, Status: recoveryExecuted, DeliveryTxHash: receipt.TxHash}
+	for _, receiptLog := range receipt.Logs {
+		if _, err := messenger.ParseMessageExecutionFailed(*receiptLog); err == nil {
+			recovered.Status = recoveryExecutionFailed
+		}
+	}
+	return recovered, nil
+}
+
+func filterSentMessages(sent []*bridge.SentMessage, messageID ids.ID) []*bridge.SentMessage {
+	for _, message := range sent {
+		if message.MessageID == messageID {
+			return []*bridge.SentMessage{message}
+		}
+	}
+	return nil
+}
+
+// Returns the base URI of the node serving the Warp and P-Chain APIs
+func getRecoverNodeURI() (string, error) {
+	if recoverNodeURI != "" {
+		return strings.TrimSuffix(recoverNodeURI, "/"), nil
+	}
+	rpcURL, err := url.Parse(recoverSourceRPC)
+	if err != nil || rpcURL.Host == "" {
+		return "", fmt.Errorf("invalid --source-rpc %s", recoverSourceRPC)
+	}
+	return rpcURL.Scheme + "://" + rpcURL.Host, nil
+}
+
+// Loads the hex encoded private key from the keyEnv environment variable, or if --mnemonic-env is set, derives
+// the key of --account-index from the mnemonic it names instead, or if --keystore-file is set, decrypts the key
+// it holds with the passphrase in --keystore-passphrase-env. use describes what the key is used for, in the error
+// returned when the variable is not set.
+func loadPrivateKey(keyEnv string, use string) (*ecdsa.PrivateKey, error) {
+	if mnemonicEnv != "" && keystoreFile != "" {
+		return nil, fmt.Errorf("only one of --mnemonic-env and --keystore-file may be set")
+	}
+	if mnemonicEnv != "" {
+		return deriveMnemonicKey(use)
+	}
+	if keystoreFile != "" {
+		return signer.LoadKeystore(keystoreFile, keystorePassphraseEnv)
+	}
+	hexKey := os.Getenv(keyEnv)
+	if hexKey == "" {
+		return nil, fmt.Errorf("%s must be set to the private key to %s", keyEnv, use)
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key in %s", keyEnv)
+	}
+	return key, nil
+}
+
+// Parses a Teleporter message ID or blockchain ID, as either hex or the CB58 encoding used in logs
+func parseID(flag string, id string) (ids.ID, error) {
+	if strings.HasPrefix(id, "0x") {
+		decoded := common.FromHex(id)
+		if len(decoded) != len(ids.Empty) {
+			return ids.Empty, fmt.Errorf("invalid --%s %s", flag, id)
+		}
+		return ids.ID(common.BytesToHash(decoded)), nil
+	}
+	parsed, err := ids.FromString(id)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("invalid --%s %s", flag, id)
+	}
+	return parsed, nil
+}
+
+func printRecoveredMessages(w io.Writer, recovered []recoveredMessage) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MESSAGE ID\tSTATUS\tDELIVERY TX")
+	for _, message := range recovered {
+		deliveryTx := "-"
+		if message.DeliveryTxHash != (common.Hash{}) {
+			deliveryTx = message.DeliveryTxHash.Hex()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", message.MessageID, message.Status, deliveryTx)
+	}
+	return tw.Flush()
+}
+
+func init() {
+	rootCmd.AddCommand(recoverCmd)
+	recoverCmd.Flags().StringVar(&recoverSourceRPC, "source-rpc", "", "RPC endpoint of the chain that sent the messages")
+	recoverCmd.Flags().StringVar(&recoverDestinationRPC, "destination-rpc", "", "RPC endpoint of the chain to deliver to")
+	recoverCmd.Flags().StringVar(&recoverTxHash, "tx-hash", "", "Hash of the source chain transaction")
+	recoverCmd.Flags().StringVar(&recoverNodeURI, "node-uri", "", "URI of a source chain node, for the Warp API")
+	recoverCmd.Flags().StringVar(&recoverMessageID, "message-id", "", "Only deliver the Teleporter message with this ID")
+	recoverCmd.Flags().StringVar(
+		&recoverKeyEnv,
+		"private-key-env",
+		defaultPrivateKeyEnv,
+		"Environment variable holding the private key to deliver from",
+	)
+	recoverCmd.Flags().BoolVar(&recoverDryRun, "dry-run", false, "Fetch the signatures without delivering")
+
+	for _, flag := range []string{"source-rpc", "destination-rpc", "tx-hash"} {
+		cobra.CheckErr(recoverCmd.MarkFlagRequired(flag))
+	}
+}
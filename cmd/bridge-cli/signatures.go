@@ -0,0 +1,236 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	signaturesSourceRPC      string
+	signaturesDestinationRPC string
+	signaturesTxHash         string
+	signaturesNodeURI        string
+	signaturesValidatorURIs  []string
+	signaturesMessageID      string
+	signaturesJSON           bool
+)
+
+var signaturesCmd = &cobra.Command{
+	Use:   "signatures --source-rpc url --destination-rpc url --tx-hash hash [--validator-uri uri,...]",
+	Short: "Reports which validators have signed the pending messages sent by a source chain transaction",
+	Long: `Given the hash of a transaction on the source chain that sent one or more
+Teleporter messages, reports for each message not yet received on the chain of
+--destination-rpc which validators of the signing subnet have signed its Warp
+message, and whether their combined weight meets the quorum needed to deliver it.
+If the quorum is met, a stalled delivery is a relaying problem, and otherwise a
+signing problem.
+
+The validator set and the aggregate signature are read from the node at
+--node-uri, which defaults to the host of --source-rpc. The node stops collecting
+signatures once the quorum is met, so validators not included in the aggregate
+signature are reported as unknown, unless the URI of their own node is passed
+with --validator-uri, in which case their signature is fetched and verified
+directly. Pass --message-id to only report on one of the messages.`,
+	Args: cobra.NoArgs,
+	Run:  signaturesRun,
+}
+
+func signaturesRun(cmd *cobra.Command, args []string) {
+	reports, err := diagnoseSignatures(cmd.Context())
+	cobra.CheckErr(err)
+	if signaturesJSON {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		cobra.CheckErr(encoder.Encode(reports))
+		return
+	}
+	cobra.CheckErr(printSignatureReports(cmd.OutOrStdout(), reports))
+}
+
+func diagnoseSignatures(ctx context.Context) ([]*bridge.SignatureReport, error) {
+	if !strings.HasPrefix(signaturesTxHash, "0x") || len(signaturesTxHash) != 2+2*common.HashLength {
+		return nil, fmt.Errorf("invalid --tx-hash %s", signaturesTxHash)
+	}
+	txHash := common.HexToHash(signaturesTxHash)
+	var messageID ids.ID
+	if signaturesMessageID != "" {
+		var err error
+		if messageID, err = parseID("message-id", signaturesMessageID); err != nil {
+			return nil, err
+		}
+	}
+	nodeURI, err := getSignaturesNodeURI()
+	if err != nil {
+		return nil, err
+	}
+	validatorURIs := make([]string, 0, len(signaturesValidatorURIs))
+	for _, uri := range signaturesValidatorURIs {
+		validatorURIs = append(validatorURIs, strings.TrimSuffix(uri, "/"))
+	}
+
+	source, err := events.DialChain(ctx, "source", signaturesSourceRPC)
+	if err != nil {
+		return nil, err
+	}
+	destination, err := events.DialChain(ctx, "destination", signaturesDestinationRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := bridge.SentMessages(ctx, source, destination.BlockchainID, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if messageID != ids.Empty {
+		sent = filterSentMessages(sent, messageID)
+	}
+	if len(sent) == 0 {
+		return nil, fmt.Errorf("transaction %s sent no matching Teleporter messages to %s", txHash, destination.BlockchainID)
+	}
+
+	signingSubnetID, err := bridge.SigningSubnetID(ctx, nodeURI, source.BlockchainID, destination.BlockchainID)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := make([]*bridge.SignatureReport, 0, len(sent))
+	for _, message := range sent {
+		messenger, err := teleportermessenger.NewTeleporterMessenger(message.TeleporterAddress, destination.Client)
+		if err != nil {
+			return nil, err
+		}
+		delivered, err := messenger.MessageReceived(&bind.CallOpts{Context: ctx}, message.MessageID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if message %s was received: %w", message.MessageID, err)
+		}
+		if delivered {
+			logger.Info("Message already delivered", zap.Stringer("messageID", message.MessageID))
+			continue
+		}
+
+		logger.Info(
+			"Diagnosing signatures",
+			zap.Stringer("messageID", message.MessageID),
+			zap.Stringer("warpMessageID", message.UnsignedWarp.ID()),
+			zap.Stringer("signingSubnetID", signingSubnetID),
+		)
+		report, err := bridge.DiagnoseSignatures(
+			ctx,
+			nodeURI,
+			validatorURIs,
+			source.BlockchainID,
+			signingSubnetID,
+			message,
+		)
+		if err != nil {
+			return nil, err
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// Returns the base URI of the node serving the Warp and P-Chain APIs
+func getSignaturesNodeURI() (string, error) {
+	if signaturesNodeURI != "" {
+		return strings.TrimSuffix(signaturesNodeURI, "/"), nil
+	}
+	rpcURL, err := url.Parse(signaturesSourceRPC)
+	if err != nil || rpcURL.Host == "" {
+		return "", fmt.Errorf("invalid --source-rpc %s", signaturesSourceRPC)
+	}
+	return rpcURL.Scheme + "://" + rpcURL.Host, nil
+}
+
+func printSignatureReports(w io.Writer, reports []*bridge.SignatureReport) error {
+	if len(reports) == 0 {
+		fmt.Fprintln(w, "All messages have been delivered")
+		return nil
+	}
+	for i, report := range reports {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		quorum := "not met, so the message cannot be delivered until more validators sign it"
+		if report.QuorumMet() {
+			quorum = "met, so the message can be delivered"
+		}
+		fmt.Fprintf(w, "Message:        %s\n", report.MessageID)
+		fmt.Fprintf(w, "Warp message:   %s\n", report.WarpMessageID)
+		fmt.Fprintf(w, "Signing subnet: %s at P-Chain height %d\n", report.SigningSubnetID, report.PChainHeight)
+		fmt.Fprintf(w, "Signed weight:  %d of %d, quorum of %d %s\n",
+			report.SignedWeight, report.TotalWeight, report.QuorumWeight, quorum)
+		if report.AggregateError != "" {
+			fmt.Fprintf(w, "Aggregation:    %s\n", report.AggregateError)
+		}
+		fmt.Fprintln(w)
+
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "NODE ID\tWEIGHT\tSTATUS\tERROR")
+		for _, validator := range report.Validators {
+			nodeIDs := make([]string, 0, len(validator.NodeIDs))
+			for _, nodeID := range validator.NodeIDs {
+				nodeIDs = append(nodeIDs, nodeID.String())
+			}
+			fmt.Fprintf(
+				tw,
+				"%s\t%d\t%s\t%s\n",
+				strings.Join(nodeIDs, ","),
+				validator.Weight,
+				validator.Status,
+				validator.Error,
+			)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func init() {
+	statusCmd.AddCommand(signaturesCmd)
+	signaturesCmd.Flags().StringVar(&signaturesSourceRPC, "source-rpc", "", "RPC endpoint of the source chain")
+	signaturesCmd.Flags().StringVar(
+		&signaturesDestinationRPC,
+		"destination-rpc",
+		"",
+		"RPC endpoint of the destination chain",
+	)
+	signaturesCmd.Flags().StringVar(&signaturesTxHash, "tx-hash", "", "Hash of the source chain transaction")
+	signaturesCmd.Flags().StringVar(
+		&signaturesNodeURI,
+		"node-uri",
+		"",
+		"Base URI of the node serving the source chain's Warp API, defaults to the host of --source-rpc",
+	)
+	signaturesCmd.Flags().StringSliceVar(
+		&signaturesValidatorURIs,
+		"validator-uri",
+		[]string{},
+		"Base URIs of validator nodes to fetch signatures from directly",
+	)
+	signaturesCmd.Flags().StringVar(&signaturesMessageID, "message-id", "", "Only report on the message with this ID")
+	signaturesCmd.Flags().BoolVar(&signaturesJSON, "json", false, "Print the reports as JSON")
+
+	cobra.CheckErr(signaturesCmd.MarkFlagRequired("source-rpc"))
+	cobra.CheckErr(signaturesCmd.MarkFlagRequired("destination-rpc"))
+	cobra.CheckErr(signaturesCmd.MarkFlagRequired("tx-hash"))
+}
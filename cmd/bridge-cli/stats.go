@@ -0,0 +1,185 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/indexer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+)
+
+var (
+	statsDriver string
+	statsDSN    string
+	statsBridge string
+	statsWindow time.Duration
+	statsTo     string
+	statsJSON   bool
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats --dsn dsn [--driver sqlite|postgres] [--window duration] [--to time] [--bridge name]",
+	Short: "Prints the usage of each route of the bridges indexed by bridge-indexer",
+	Long: `Reads the database of bridge-indexer, and prints the usage of each route, from
+the bridge contract transfers are sent from to their final destination, over the
+transfers sent in the --window before --to. For each route, prints the number of
+transfers sent and delivered, their volume, the number of unique senders, the
+average latency from the send to the delivery on the final destination, and the
+relayer fees paid. Volume is in the denomination of the sending contract, primary
+fees are in their fee token, and secondary fees, paid for the second hop of routed
+transfers, are in the bridged token. Deliveries are counted once indexed, so the
+latest transfers may still be pending while their destination chain is indexed.`,
+	Args: cobra.NoArgs,
+	Run:  statsRun,
+}
+
+type routeStats struct {
+	Bridge                  string         `json:"bridge"`
+	SourceBlockchainID      ids.ID         `json:"sourceBlockchainID"`
+	SourceAddress           common.Address `json:"sourceAddress"`
+	DestinationBlockchainID ids.ID         `json:"destinationBlockchainID"`
+	DestinationAddress      common.Address `json:"destinationAddress"`
+	Transfers               int            `json:"transfers"`
+	Delivered               int            `json:"delivered"`
+	UniqueSenders           int            `json:"uniqueSenders"`
+	// Amounts are decimal strings, as in the indexer's API, since they may exceed the precision of JSON numbers
+	Volume                string            `json:"volume"`
+	AverageLatencySeconds float64           `json:"averageLatencySeconds"`
+	PrimaryFees           map[string]string `json:"primaryFees"`
+	SecondaryFees         string            `json:"secondaryFees"`
+}
+
+type statsReport struct {
+	From   time.Time     `json:"from"`
+	To     time.Time     `json:"to"`
+	Routes []*routeStats `json:"routes"`
+}
+
+func statsRun(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	to := time.Now()
+	if statsTo != "" {
+		var err error
+		to, err = time.Parse(time.RFC3339, statsTo)
+		cobra.CheckErr(err)
+	}
+	if statsWindow <= 0 {
+		cobra.CheckErr(fmt.Errorf("invalid window %s", statsWindow))
+	}
+	query := indexer.StatsQuery{From: to.Add(-statsWindow), To: to, Bridge: statsBridge}
+
+	store, err := indexer.OpenStore(ctx, indexer.DatabaseConfig{Driver: indexer.Driver(statsDriver), DSN: statsDSN})
+	cobra.CheckErr(err)
+	defer store.Close()
+	stats, err := store.Stats(ctx, query)
+	cobra.CheckErr(err)
+
+	report := &statsReport{From: query.From.UTC(), To: query.To.UTC(), Routes: make([]*routeStats, 0, len(stats))}
+	for _, route := range stats {
+		primaryFees := make(map[string]string, len(route.PrimaryFees))
+		for token, fee := range route.PrimaryFees {
+			primaryFees[token.Hex()] = fee.String()
+		}
+		report.Routes = append(report.Routes, &routeStats{
+			Bridge:                  route.Bridge,
+			SourceBlockchainID:      route.SourceBlockchainID,
+			SourceAddress:           route.SourceAddress,
+			DestinationBlockchainID: route.DestinationBlockchainID,
+			DestinationAddress:      route.DestinationAddress,
+			Transfers:               route.Transfers,
+			Delivered:               route.Delivered,
+			UniqueSenders:           route.UniqueSenders,
+			Volume:                  route.Volume.String(),
+			AverageLatencySeconds:   route.AverageLatency.Seconds(),
+			PrimaryFees:             primaryFees,
+			SecondaryFees:           route.SecondaryFees.String(),
+		})
+	}
+	if statsJSON {
+		err = printStatsJSON(cmd.OutOrStdout(), report)
+	} else {
+		err = printStatsTable(cmd.OutOrStdout(), report)
+	}
+	cobra.CheckErr(err)
+}
+
+func printStatsJSON(w io.Writer, report *statsReport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(report)
+}
+
+func printStatsTable(w io.Writer, report *statsReport) error {
+	fmt.Fprintf(w, "Transfers sent from %s to %s\n\n", report.From.Format(time.RFC3339), report.To.Format(time.RFC3339))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BRIDGE\tSOURCE\tSOURCE ADDRESS\tDESTINATION\tDESTINATION ADDRESS\tTRANSFERS\tDELIVERED\t"+
+		"VOLUME\tSENDERS\tAVERAGE LATENCY\tPRIMARY FEES\tSECONDARY FEES")
+	for _, route := range report.Routes {
+		latency := "n/a"
+		if route.Delivered > 0 {
+			latency = time.Duration(route.AverageLatencySeconds * float64(time.Second)).Round(time.Second).String()
+		}
+		fmt.Fprintf(
+			tw,
+			"%s\t%s\t%s\t%s\t%s\t%d\t%d\t%s\t%d\t%s\t%s\t%s\n",
+			route.Bridge,
+			route.SourceBlockchainID,
+			route.SourceAddress.Hex(),
+			route.DestinationBlockchainID,
+			route.DestinationAddress.Hex(),
+			route.Transfers,
+			route.Delivered,
+			route.Volume,
+			route.UniqueSenders,
+			latency,
+			formatFees(route.PrimaryFees),
+			route.SecondaryFees,
+		)
+	}
+	return tw.Flush()
+}
+
+// Formats the fees paid in each token as a list of amounts followed by their token, in token order
+func formatFees(fees map[string]string) string {
+	if len(fees) == 0 {
+		return "0"
+	}
+	tokens := make([]string, 0, len(fees))
+	for token := range fees {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+	formatted := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		formatted = append(formatted, fees[token]+" "+token)
+	}
+	return strings.Join(formatted, ",")
+}
+
+func init() {
+	rootCmd.AddCommand(statsCmd)
+	statsCmd.Flags().StringVar(&statsDriver, "driver", string(indexer.SQLite), "Driver of the indexer's database")
+	statsCmd.Flags().StringVar(
+		&statsDSN,
+		"dsn",
+		"",
+		"Path of the indexer's SQLite database file, or its Postgres connection string",
+	)
+	statsCmd.Flags().StringVar(&statsBridge, "bridge", "", "Name of the bridge to limit the stats to")
+	statsCmd.Flags().DurationVar(&statsWindow, "window", 24*time.Hour, "Length of the time window")
+	statsCmd.Flags().StringVar(&statsTo, "to", "", "End of the time window, in RFC3339 format (default now)")
+	statsCmd.Flags().BoolVar(&statsJSON, "json", false, "Print the stats as JSON")
+
+	cobra.CheckErr(statsCmd.MarkFlagRequired("dsn"))
+}
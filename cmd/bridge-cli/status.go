@@ -0,0 +1,340 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	teleporterregistry "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/upgrades/TeleporterRegistry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+)
+
+var (
+	sourceRPC       string
+	sourceAddress   string
+	destinationRPCs []string
+	fromBlock       uint64
+	maxBlockRange   uint64
+	statusJSON      bool
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status --source-rpc url --source-address address [--destination-rpc url,...]",
+	Short: "Prints the status of a bridge's source and its registered destinations",
+	Long: `Given a TeleporterTokenSource and the RPC endpoint of its chain, prints each
+registered destination along with its collateralization, token multiplier,
+minimum Teleporter version, and the time of the last transfer in each direction.
+Destinations are discovered from the source's DestinationRegistered events, so
+--from-block should be at or before the block the source was deployed in.
+Destination state that can only be read from the destination chain, such as
+whether it considers itself collateralized, is only included for destinations
+on chains whose RPC endpoint is passed with --destination-rpc.`,
+	Args: cobra.NoArgs,
+	Run:  statusRun,
+}
+
+type bridgeStatus struct {
+	SourceBlockchainID      ids.ID               `json:"sourceBlockchainID"`
+	SourceAddress           common.Address       `json:"sourceAddress"`
+	TokenAddress            common.Address       `json:"tokenAddress"`
+	MinTeleporterVersion    *big.Int             `json:"minTeleporterVersion"`
+	LatestTeleporterVersion *big.Int             `json:"latestTeleporterVersion"`
+	Destinations            []*destinationStatus `json:"destinations"`
+
+	// Populated from the source's events, in the order destinations were registered
+	registered   []destinationKey
+	lastSent     map[destinationKey]time.Time
+	lastReceived map[destinationKey]time.Time
+}
+
+type destinationStatus struct {
+	BlockchainID          ids.ID         `json:"blockchainID"`
+	Address               common.Address `json:"address"`
+	TokenMultiplier       *big.Int       `json:"tokenMultiplier"`
+	MultiplyOnDestination bool           `json:"multiplyOnDestination"`
+	// CollateralNeeded is the collateral still needed by the destination, as tracked by the source
+	CollateralNeeded *big.Int `json:"collateralNeeded"`
+	BridgedBalance   *big.Int `json:"bridgedBalance"`
+	// The following are read from the destination chain, and are nil if its RPC endpoint was not given
+	Collateralized       *bool    `json:"collateralized,omitempty"`
+	MinTeleporterVersion *big.Int `json:"minTeleporterVersion,omitempty"`
+	// LastSent and LastReceived are the block times of the last transfer from the source to the
+	// destination and from the destination to the source, and are nil if there has been none
+	LastSent     *time.Time `json:"lastSent,omitempty"`
+	LastReceived *time.Time `json:"lastReceived,omitempty"`
+}
+
+type destinationKey struct {
+	blockchainID ids.ID
+	address      common.Address
+}
+
+func statusRun(cmd *cobra.Command, args []string) {
+	ctx := cmd.Context()
+	status, err := getBridgeStatus(ctx)
+	cobra.CheckErr(err)
+	if statusJSON {
+		err = printStatusJSON(cmd.OutOrStdout(), status)
+	} else {
+		err = printStatusTable(cmd.OutOrStdout(), status)
+	}
+	cobra.CheckErr(err)
+}
+
+func getBridgeStatus(ctx context.Context) (*bridgeStatus, error) {
+	if !common.IsHexAddress(sourceAddress) {
+		return nil, fmt.Errorf("invalid source address %s", sourceAddress)
+	}
+	address := common.HexToAddress(sourceAddress)
+	sourceChain, err := events.DialChain(ctx, "source", sourceRPC)
+	if err != nil {
+		return nil, err
+	}
+	destinationChains := make(map[ids.ID]*events.Chain, len(destinationRPCs))
+	for i, rpc := range destinationRPCs {
+		chain, err := events.DialChain(ctx, "destination-"+strconv.Itoa(i), rpc)
+		if err != nil {
+			return nil, err
+		}
+		destinationChains[chain.BlockchainID] = chain
+	}
+
+	status, err := getSourceStatus(ctx, sourceChain, address)
+	if err != nil {
+		return nil, err
+	}
+	if err := scanSourceEvents(ctx, sourceChain, address, status); err != nil {
+		return nil, err
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	source, err := teleportertokensource.NewTeleporterTokenSource(address, sourceChain.Client)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range status.registered {
+		settings, err := source.RegisteredDestinations(opts, key.blockchainID, key.address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get destination settings: %w", err)
+		}
+		bridgedBalance, err := source.BridgedBalances(opts, key.blockchainID, key.address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bridged balance: %w", err)
+		}
+		destination := &destinationStatus{
+			BlockchainID:          key.blockchainID,
+			Address:               key.address,
+			TokenMultiplier:       settings.TokenMultiplier,
+			MultiplyOnDestination: settings.MultiplyOnDestination,
+			CollateralNeeded:      settings.CollateralNeeded,
+			BridgedBalance:        bridgedBalance,
+		}
+		if lastSent, ok := status.lastSent[key]; ok {
+			destination.LastSent = &lastSent
+		}
+		if lastReceived, ok := status.lastReceived[key]; ok {
+			destination.LastReceived = &lastReceived
+		}
+		if chain, ok := destinationChains[key.blockchainID]; ok {
+			if err := setDestinationChainStatus(ctx, chain, destination); err != nil {
+				return nil, err
+			}
+		}
+		status.Destinations = append(status.Destinations, destination)
+	}
+	return status, nil
+}
+
+func getSourceStatus(ctx context.Context, chain *events.Chain, address common.Address) (*bridgeStatus, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	source, err := teleportertokensource.NewTeleporterTokenSource(address, chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	tokenAddress, err := source.TokenAddress(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source token address: %w", err)
+	}
+	minVersion, err := source.GetMinTeleporterVersion(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source minimum Teleporter version: %w", err)
+	}
+	registryAddress, err := source.TeleporterRegistry(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Teleporter registry address: %w", err)
+	}
+	registry, err := teleporterregistry.NewTeleporterRegistry(registryAddress, chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	latestVersion, err := registry.LatestVersion(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest Teleporter version: %w", err)
+	}
+	return &bridgeStatus{
+		SourceBlockchainID:      chain.BlockchainID,
+		SourceAddress:           address,
+		TokenAddress:            tokenAddress,
+		MinTeleporterVersion:    minVersion,
+		LatestTeleporterVersion: latestVersion,
+		lastSent:                make(map[destinationKey]time.Time),
+		lastReceived:            make(map[destinationKey]time.Time),
+	}, nil
+}
+
+// Scans the source's events from --from-block to the latest block, recording each registered destination
+// and the time of the last transfer to and from it.
+func scanSourceEvents(ctx context.Context, chain *events.Chain, address common.Address, status *bridgeStatus) error {
+	decoder, err := events.NewDecoder()
+	if err != nil {
+		return err
+	}
+	registered := make(map[destinationKey]struct{})
+	poller := events.NewPoller(
+		logger,
+		chain,
+		decoder,
+		map[common.Address]events.ContractInfo{
+			// Both source types emit the same events
+			address: {Type: events.ERC20Source},
+		},
+		events.PollerConfig{MaxBlockRange: maxBlockRange},
+		func(_ context.Context, bridgeEvents []*events.Event, _ uint64) error {
+			for _, event := range bridgeEvents {
+				if event.Type == events.DestinationRegistered {
+					key := destinationKey{event.DestinationBlockchainID, event.DestinationBridgeAddress}
+					if _, ok := registered[key]; !ok {
+						registered[key] = struct{}{}
+						status.registered = append(status.registered, key)
+					}
+				}
+				if event.Type.IsSend() {
+					status.lastSent[destinationKey{event.DestinationBlockchainID, event.DestinationBridgeAddress}] =
+						event.BlockTime
+				}
+				if event.Type.IsDelivery() && event.ReceivedMessageID != ids.Empty {
+					status.lastReceived[destinationKey{event.SourceBlockchainID, event.SourceBridgeAddress}] =
+						event.BlockTime
+				}
+			}
+			return nil
+		},
+	)
+	poller.SetNextBlock(fromBlock)
+	for {
+		caughtUp, err := poller.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		if caughtUp {
+			return nil
+		}
+	}
+}
+
+func setDestinationChainStatus(ctx context.Context, chain *events.Chain, status *destinationStatus) error {
+	logger.Debug(
+		"Reading destination state",
+		zap.Stringer("blockchainID", status.BlockchainID),
+		zap.Stringer("address", status.Address),
+	)
+	opts := &bind.CallOpts{Context: ctx}
+	destination, err := teleportertokendestination.NewTeleporterTokenDestination(status.Address, chain.Client)
+	if err != nil {
+		return err
+	}
+	collateralized, err := destination.IsCollateralized(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get destination collateralization: %w", err)
+	}
+	minVersion, err := destination.GetMinTeleporterVersion(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get destination minimum Teleporter version: %w", err)
+	}
+	status.Collateralized = &collateralized
+	status.MinTeleporterVersion = minVersion
+	return nil
+}
+
+func printStatusJSON(w io.Writer, status *bridgeStatus) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(status)
+}
+
+func printStatusTable(w io.Writer, status *bridgeStatus) error {
+	fmt.Fprintf(w, "Source:                    %s on %s\n", status.SourceAddress.Hex(), status.SourceBlockchainID)
+	fmt.Fprintf(w, "Token:                     %s\n", status.TokenAddress.Hex())
+	fmt.Fprintf(w, "Min Teleporter version:    %s\n", status.MinTeleporterVersion)
+	fmt.Fprintf(w, "Latest Teleporter version: %s\n\n", status.LatestTeleporterVersion)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BLOCKCHAIN\tADDRESS\tMULTIPLIER\tCOLLATERAL NEEDED\tCOLLATERALIZED\tBRIDGED\t"+
+		"MIN TELEPORTER VERSION\tLAST SENT\tLAST RECEIVED")
+	for _, destination := range status.Destinations {
+		// Amounts are multiplied or divided by the multiplier when bridged to the destination
+		multiplier := "/" + destination.TokenMultiplier.String()
+		if destination.MultiplyOnDestination {
+			multiplier = "*" + destination.TokenMultiplier.String()
+		}
+		collateralized, minVersion := "unknown", "unknown"
+		if destination.Collateralized != nil {
+			collateralized = strconv.FormatBool(*destination.Collateralized)
+			minVersion = destination.MinTeleporterVersion.String()
+		}
+		fmt.Fprintf(
+			tw,
+			"%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			destination.BlockchainID,
+			destination.Address.Hex(),
+			multiplier,
+			destination.CollateralNeeded,
+			collateralized,
+			destination.BridgedBalance,
+			minVersion,
+			formatTime(destination.LastSent),
+			formatTime(destination.LastReceived),
+		)
+	}
+	return tw.Flush()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "never"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+	statusCmd.Flags().StringVar(&sourceRPC, "source-rpc", "", "RPC endpoint of the source chain")
+	statusCmd.Flags().StringVar(&sourceAddress, "source-address", "", "Address of the TeleporterTokenSource")
+	statusCmd.Flags().StringSliceVar(
+		&destinationRPCs,
+		"destination-rpc",
+		[]string{},
+		"RPC endpoints of destination chains",
+	)
+	statusCmd.Flags().Uint64Var(&fromBlock, "from-block", 0, "Block to begin scanning source events from")
+	statusCmd.Flags().Uint64Var(&maxBlockRange, "max-block-range", 0, "Maximum blocks per eth_getLogs request")
+	statusCmd.Flags().BoolVar(&statusJSON, "json", false, "Print the status as JSON")
+
+	cobra.CheckErr(statusCmd.MarkFlagRequired("source-rpc"))
+	cobra.CheckErr(statusCmd.MarkFlagRequired("source-address"))
+}
@@ -0,0 +1,946 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	examplewavax "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/ExampleWAVAX"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// wavaxType is the deploy kind of an ExampleWAVAX wrapped native token, which is named as a plain ERC20 token
+const wavaxType = "wavax"
+
+type command struct {
+	usage string
+	help  string
+	// The number of arguments accepted, between minArgs and maxArgs inclusive
+	minArgs int
+	maxArgs int
+	run     func(ctx context.Context, s *session, args []string) error
+}
+
+// The commands of the console, keyed by name. Initialized in init, since help refers to the table.
+var commands map[string]*command
+
+// The order commands are listed in by help
+var commandNames = []string{
+	"chains", "account", "registry", "contract", "contracts", "deploy", "register", "setup", "send", "relay", "balance",
+	"inspect", "tx", "help", "exit",
+}
+
+func init() {
+	commands = map[string]*command{
+		"chains": {
+			usage: "chains",
+			help:  "List the attached chains",
+			run:   chainsRun,
+		},
+		"account": {
+			usage: "account",
+			help:  "Print the account transactions are sent from, and its native balance on each chain",
+			run:   accountRun,
+		},
+		"registry": {
+			usage:   "registry <chain> <address>",
+			help:    "Set the TeleporterRegistry that bridge contracts deployed to the chain use",
+			minArgs: 2,
+			maxArgs: 2,
+			run:     registryRun,
+		},
+		"contract": {
+			usage: "contract <name> <chain> <type> <address>",
+			help: "Name an existing contract, of type erc20, erc20-source, native-source, erc20-destination or " +
+				"native-destination",
+			minArgs: 4,
+			maxArgs: 4,
+			run:     contractRun,
+		},
+		"contracts": {
+			usage: "contracts",
+			help:  "List the named contracts",
+			run:   contractsRun,
+		},
+		"deploy": {
+			usage: "deploy erc20|wavax <name> <chain>\n" +
+				"  deploy erc20-source <name> <chain> <token>\n" +
+				"  deploy native-source <name> <chain> <wrapped-token>\n" +
+				"  deploy erc20-destination <name> <chain> <source> <token-name> <token-symbol> <token-decimals>",
+			help: "Deploy an example token, or a bridge contract owned by the account and using the chain's registry. " +
+				"The contract is named for use in other commands",
+			minArgs: 3,
+			maxArgs: 7,
+			run:     deployRun,
+		},
+		"register": {
+			usage:   "register <destination>",
+			help:    "Send the registration of the destination with its source, to be relayed",
+			minArgs: 1,
+			maxArgs: 1,
+			run:     registerRun,
+		},
+		"setup": {
+			usage: "setup <source> <destination> [<registration-tx-hash>]",
+			help: "Register the destination with its source, relay the registration, and add the collateral it " +
+				"needs. Resumes a setup that failed, waiting for the given registration instead of sending another",
+			minArgs: 2,
+			maxArgs: 3,
+			run:     setupRun,
+		},
+		"send": {
+			usage:   "send <from> <to> <recipient> <amount>",
+			help:    "Send the amount, in the smallest denomination of the token, between bridge contracts, to be relayed",
+			minArgs: 4,
+			maxArgs: 4,
+			run:     sendRun,
+		},
+		"relay": {
+			usage: "relay [<chain> <tx-hash>]",
+			help: "Deliver the Teleporter messages sent by the transaction to the attached chains they are sent to. " +
+				"Defaults to the last transaction sent by the console, so multi-hop transfers are relayed by relaying twice",
+			maxArgs: 2,
+			run:     relayRun,
+		},
+		"balance": {
+			usage:   "balance <chain|token> <account>",
+			help:    "Print the account's native balance on the chain, or its balance of the token",
+			minArgs: 2,
+			maxArgs: 2,
+			run:     balanceRun,
+		},
+		"inspect": {
+			usage:   "inspect <contract>",
+			help:    "Print the state of the contract, and for a source the state of each registered destination",
+			minArgs: 1,
+			maxArgs: 1,
+			run:     inspectRun,
+		},
+		"tx": {
+			usage:   "tx <chain> <tx-hash>",
+			help:    "Print the outcome of the transaction, and the events of named bridge contracts it emitted",
+			minArgs: 2,
+			maxArgs: 2,
+			run:     txRun,
+		},
+		"help": {
+			usage: "help",
+			help:  "List the commands. Accounts are a contract name, me, or an address",
+			run:   helpRun,
+		},
+		"exit": {
+			usage: "exit",
+			help:  "End the session",
+			run: func(context.Context, *session, []string) error {
+				return errExit
+			},
+		},
+	}
+}
+
+// Runs the command named by the first of the words, with the remaining words as its arguments
+func (s *session) run(ctx context.Context, words []string) error {
+	c, ok := commands[words[0]]
+	if !ok {
+		return fmt.Errorf("unknown command %s, run help to list the commands", words[0])
+	}
+	args := words[1:]
+	if len(args) < c.minArgs || len(args) > c.maxArgs {
+		return fmt.Errorf("usage: %s", c.usage)
+	}
+	return c.run(ctx, s, args)
+}
+
+func helpRun(_ context.Context, s *session, _ []string) error {
+	for _, name := range commandNames {
+		fmt.Fprintf(s.out, "  %s\n      %s\n", commands[name].usage, commands[name].help)
+	}
+	return nil
+}
+
+func chainsRun(_ context.Context, s *session, _ []string) error {
+	tw := tabwriter.NewWriter(s.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tBLOCKCHAIN ID\tREGISTRY\tRPC")
+	for _, chain := range s.chains {
+		registry := "-"
+		if address, ok := s.registries[chain.BlockchainID]; ok {
+			registry = address.Hex()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", chain.Name, chain.BlockchainID, registry, chain.rpcEndpoint)
+	}
+	return tw.Flush()
+}
+
+func accountRun(ctx context.Context, s *session, _ []string) error {
+	address, err := s.address("me")
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(s.out, "Account %s\n", address)
+	tw := tabwriter.NewWriter(s.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHAIN\tBALANCE")
+	for _, chain := range s.chains {
+		balance, err := chain.Client.BalanceAt(ctx, address, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get balance on %s: %w", chain.Name, err)
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", chain.Name, balance)
+	}
+	return tw.Flush()
+}
+
+func registryRun(_ context.Context, s *session, args []string) error {
+	chain, err := s.chain(args[0])
+	if err != nil {
+		return err
+	}
+	if !common.IsHexAddress(args[1]) {
+		return fmt.Errorf("invalid registry address %s", args[1])
+	}
+	s.registries[chain.BlockchainID] = common.HexToAddress(args[1])
+	return nil
+}
+
+func contractRun(ctx context.Context, s *session, args []string) error {
+	name, contractType := args[0], events.ContractType(args[2])
+	chain, err := s.chain(args[1])
+	if err != nil {
+		return err
+	}
+	if contractType != tokenType && !contractType.IsSource() && !contractType.IsDestination() {
+		return fmt.Errorf("unknown contract type %s", contractType)
+	}
+	if !common.IsHexAddress(args[3]) {
+		return fmt.Errorf("invalid contract address %s", args[3])
+	}
+	address := common.HexToAddress(args[3])
+	code, err := chain.Client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get code of %s: %w", address, err)
+	}
+	if len(code) == 0 {
+		return fmt.Errorf("no contract is deployed at %s on %s", address, chain.Name)
+	}
+	s.addContract(name, chain, address, contractType)
+	return nil
+}
+
+func contractsRun(_ context.Context, s *session, _ []string) error {
+	tw := tabwriter.NewWriter(s.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tTYPE\tCHAIN\tADDRESS")
+	for _, name := range s.contractNames {
+		endpoint := s.contracts[name].endpoint
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", name, endpoint.Type, endpoint.Chain.Name, endpoint.Address)
+	}
+	return tw.Flush()
+}
+
+func deployRun(ctx context.Context, s *session, args []string) error {
+	kind, name := args[0], args[1]
+	chain, err := s.chain(args[2])
+	if err != nil {
+		return err
+	}
+	if _, ok := s.contracts[name]; ok {
+		return fmt.Errorf("a contract is already named %s", name)
+	}
+	opts, err := s.transactOpts(ctx, chain)
+	if err != nil {
+		return err
+	}
+	usage := fmt.Errorf("usage: %s", commands["deploy"].usage)
+
+	contractType := events.ContractType(kind)
+	var registry common.Address
+	if contractType.IsSource() || contractType.IsDestination() {
+		var ok bool
+		if registry, ok = s.registries[chain.BlockchainID]; !ok {
+			return fmt.Errorf("no registry is set for %s, set it with registry", chain.Name)
+		}
+	}
+
+	var (
+		address common.Address
+		tx      *types.Transaction
+	)
+	switch kind {
+	case string(tokenType), wavaxType:
+		if len(args) != 3 {
+			return usage
+		}
+		if kind == wavaxType {
+			address, tx, _, err = examplewavax.DeployExampleWAVAX(opts, chain.Client)
+		} else {
+			address, tx, _, err = exampleerc20.DeployExampleERC20(opts, chain.Client)
+		}
+		contractType = tokenType
+	case string(events.ERC20Source), string(events.NativeTokenSource):
+		if len(args) != 4 {
+			return usage
+		}
+		token, err := s.address(args[3])
+		if err != nil {
+			return err
+		}
+		if contractType == events.ERC20Source {
+			address, tx, _, err = erc20source.DeployERC20Source(opts, chain.Client, registry, opts.From, token)
+		} else {
+			address, tx, _, err = nativetokensource.DeployNativeTokenSource(opts, chain.Client, registry, opts.From, token)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to deploy %s: %w", kind, err)
+		}
+	case string(events.ERC20Destination):
+		if len(args) != 7 {
+			return usage
+		}
+		source, err := s.contract(args[3])
+		if err != nil {
+			return err
+		}
+		if !source.endpoint.Type.IsSource() {
+			return fmt.Errorf("%s is not a token source", source.name)
+		}
+		decimals, err := strconv.ParseUint(args[6], 10, 8)
+		if err != nil {
+			return fmt.Errorf("invalid token decimals %s", args[6])
+		}
+		address, tx, _, err = erc20destination.DeployERC20Destination(
+			opts,
+			chain.Client,
+			registry,
+			opts.From,
+			source.endpoint.Chain.BlockchainID,
+			source.endpoint.Address,
+			args[4],
+			args[5],
+			uint8(decimals),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to deploy %s: %w", kind, err)
+		}
+	case string(events.NativeTokenDestination):
+		return fmt.Errorf("%s needs the native minter precompile enabled for it, deploy it with the E2E "+
+			"suite or a script and name it with contract", kind)
+	default:
+		return usage
+	}
+	if err != nil {
+		return fmt.Errorf("failed to deploy %s: %w", kind, err)
+	}
+
+	if _, err := s.waitForSuccess(ctx, chain, tx); err != nil {
+		return err
+	}
+	s.addContract(name, chain, address, contractType)
+	fmt.Fprintf(s.out, "Deployed %s %s at %s on %s, in transaction %s\n", kind, name, address, chain.Name, tx.Hash())
+	return nil
+}
+
+func registerRun(ctx context.Context, s *session, args []string) error {
+	destination, err := s.contract(args[0])
+	if err != nil {
+		return err
+	}
+	if !destination.endpoint.Type.IsDestination() {
+		return fmt.Errorf("%s is not a token destination", destination.name)
+	}
+	chain := s.chainByID(destination.endpoint.Chain.BlockchainID)
+	opts, err := s.transactOpts(ctx, chain)
+	if err != nil {
+		return err
+	}
+	contract, err := teleportertokendestination.NewTeleporterTokenDestination(
+		destination.endpoint.Address,
+		chain.Client,
+	)
+	if err != nil {
+		return err
+	}
+	tx, err := contract.RegisterWithSource(
+		opts,
+		teleportertokendestination.TeleporterFeeInfo{FeeTokenAddress: common.Address{}, Amount: big.NewInt(0)},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register %s: %w", destination.name, err)
+	}
+	if _, err := s.waitForSuccess(ctx, chain, tx); err != nil {
+		return err
+	}
+	fmt.Fprintf(
+		s.out,
+		"Sent the registration of %s in transaction %s, run relay to deliver it\n",
+		destination.name,
+		tx.Hash(),
+	)
+	return nil
+}
+
+func setupRun(ctx context.Context, s *session, args []string) error {
+	source, err := s.contract(args[0])
+	if err != nil {
+		return err
+	}
+	destination, err := s.contract(args[1])
+	if err != nil {
+		return err
+	}
+	if !destination.endpoint.Type.IsDestination() {
+		return fmt.Errorf("%s is not a token destination", destination.name)
+	}
+	sourceChain := s.chainByID(source.endpoint.Chain.BlockchainID)
+	destinationChain := s.chainByID(destination.endpoint.Chain.BlockchainID)
+	sourceOpts, err := s.transactOpts(ctx, sourceChain)
+	if err != nil {
+		return err
+	}
+	destinationOpts, err := s.transactOpts(ctx, destinationChain)
+	if err != nil {
+		return err
+	}
+	nodeURI, err := s.relayNodeURI(destinationChain)
+	if err != nil {
+		return err
+	}
+	options := bridge.SetupOptions{
+		DestinationOpts: destinationOpts,
+		SourceOpts:      sourceOpts,
+		Relay: func(ctx context.Context, registration *types.Receipt) error {
+			fmt.Fprintf(s.out, "Sent the registration of %s in transaction %s\n", destination.name, registration.TxHash)
+			sent, err := bridge.SentMessages(ctx, destinationChain.Chain, sourceChain.BlockchainID, registration.TxHash)
+			if err != nil {
+				return err
+			}
+			for _, message := range sent {
+				if err := s.relayMessage(ctx, nodeURI, destinationChain, sourceChain, message); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+	if len(args) == 3 {
+		options.RegistrationTx = common.HexToHash(args[2])
+	}
+
+	result, err := bridge.SetupDestination(ctx, source.endpoint, destination.endpoint, options)
+	var setupErr *bridge.SetupError
+	if errors.As(err, &setupErr) {
+		fmt.Fprintf(s.out, "%s\n", setupErr.Guidance())
+	}
+	if err != nil {
+		return err
+	}
+	if result.CollateralTx != (common.Hash{}) {
+		s.lastTxChain, s.lastTxHash = sourceChain, result.CollateralTx
+		fmt.Fprintf(
+			s.out,
+			"Added %s collateral for %s in transaction %s\n",
+			result.Collateral,
+			destination.name,
+			result.CollateralTx,
+		)
+	}
+	fmt.Fprintf(s.out, "%s is registered with %s and collateralized\n", destination.name, source.name)
+	return nil
+}
+
+func sendRun(ctx context.Context, s *session, args []string) error {
+	from, err := s.contract(args[0])
+	if err != nil {
+		return err
+	}
+	to, err := s.contract(args[1])
+	if err != nil {
+		return err
+	}
+	recipient, err := s.address(args[2])
+	if err != nil {
+		return err
+	}
+	amount, err := parseAmount(args[3])
+	if err != nil {
+		return err
+	}
+	chain := s.chainByID(from.endpoint.Chain.BlockchainID)
+	opts, err := s.transactOpts(ctx, chain)
+	if err != nil {
+		return err
+	}
+
+	// Transfers between destinations are routed through the chain of their source, which must be attached
+	var options bridge.QuoteOptions
+	if from.endpoint.Type.IsDestination() && to.endpoint.Type.IsDestination() {
+		contract, err := teleportertokendestination.NewTeleporterTokenDestination(from.endpoint.Address, chain.Client)
+		if err != nil {
+			return err
+		}
+		sourceBlockchainID, err := contract.SourceBlockchainID(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return fmt.Errorf("failed to get source blockchain ID of %s: %w", from.name, err)
+		}
+		sourceChain := s.chainByID(sourceBlockchainID)
+		if sourceChain == nil {
+			return fmt.Errorf("the source chain %s of %s is not attached", ids.ID(sourceBlockchainID), from.name)
+		}
+		options.SourceChain = sourceChain.Chain
+	}
+	quote, err := bridge.Quote(ctx, from.endpoint, to.endpoint, amount, options)
+	if err != nil {
+		return err
+	}
+
+	input := bridge.SendTokensInput{
+		DestinationBlockchainID:  to.endpoint.Chain.BlockchainID,
+		DestinationBridgeAddress: to.endpoint.Address,
+		Recipient:                recipient,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         quote.PrimaryRequiredGasLimit,
+	}
+	if quote.Route == bridge.MultiHop {
+		input.RequiredGasLimit = quote.SecondaryRequiredGasLimit
+		input.MultiHopFallback = recipient
+	}
+	receipt, err := bridge.Send(ctx, opts, from.endpoint, input, amount, nil)
+	if err != nil {
+		return err
+	}
+	s.lastTxChain, s.lastTxHash = chain, receipt.TxHash
+	fmt.Fprintf(
+		s.out,
+		"Sent %s from %s to %s, for %s to receive %s, in transaction %s, run relay to deliver it\n",
+		amount,
+		from.name,
+		to.name,
+		recipient,
+		quote.DestinationAmount,
+		receipt.TxHash,
+	)
+	return nil
+}
+
+func relayRun(ctx context.Context, s *session, args []string) error {
+	source, txHash := s.lastTxChain, s.lastTxHash
+	switch len(args) {
+	case 0:
+		if source == nil {
+			return fmt.Errorf("no transaction has been sent, usage: %s", commands["relay"].usage)
+		}
+	case 2:
+		var err error
+		if source, err = s.chain(args[0]); err != nil {
+			return err
+		}
+		txHash = common.HexToHash(args[1])
+	default:
+		return fmt.Errorf("usage: %s", commands["relay"].usage)
+	}
+	if s.key == nil {
+		return errNoKey
+	}
+	nodeURI, err := s.relayNodeURI(source)
+	if err != nil {
+		return err
+	}
+
+	delivered := false
+	for _, destination := range s.chains {
+		if destination.BlockchainID == source.BlockchainID {
+			continue
+		}
+		sent, err := bridge.SentMessages(ctx, source.Chain, destination.BlockchainID, txHash)
+		if err != nil {
+			return err
+		}
+		for _, message := range sent {
+			if err := s.relayMessage(ctx, nodeURI, source, destination, message); err != nil {
+				return err
+			}
+			delivered = true
+		}
+	}
+	if !delivered {
+		return fmt.Errorf("transaction %s sent no Teleporter messages to the attached chains", txHash)
+	}
+	return nil
+}
+
+func (s *session) relayMessage(
+	ctx context.Context,
+	nodeURI string,
+	source *attachedChain,
+	destination *attachedChain,
+	message *bridge.SentMessage,
+) error {
+	messenger, err := teleportermessenger.NewTeleporterMessenger(message.TeleporterAddress, destination.Client)
+	if err != nil {
+		return err
+	}
+	received, err := messenger.MessageReceived(&bind.CallOpts{Context: ctx}, message.MessageID)
+	if err != nil {
+		return fmt.Errorf("failed to check if message %s was received: %w", message.MessageID, err)
+	}
+	if received {
+		fmt.Fprintf(s.out, "Message %s was already delivered to %s\n", message.MessageID, destination.Name)
+		return nil
+	}
+
+	signingSubnetID, err := bridge.SigningSubnetID(ctx, nodeURI, source.BlockchainID, destination.BlockchainID)
+	if err != nil {
+		return err
+	}
+	signedMessage, err := bridge.SignMessage(ctx, nodeURI, source.BlockchainID, signingSubnetID, message)
+	if err != nil {
+		return err
+	}
+	receipt, err := bridge.DeliverMessage(ctx, destination.Chain, message, signedMessage, s.key)
+	if err != nil {
+		return err
+	}
+	s.lastTxChain, s.lastTxHash = destination, receipt.TxHash
+
+	outcome := "executed"
+	for _, receiptLog := range receipt.Logs {
+		if _, err := messenger.ParseMessageExecutionFailed(*receiptLog); err == nil {
+			outcome = "failed to execute, and can be retried with retryMessageExecution"
+		}
+	}
+	fmt.Fprintf(
+		s.out,
+		"Delivered message %s to %s in transaction %s, where it %s\n",
+		message.MessageID,
+		destination.Name,
+		receipt.TxHash,
+		outcome,
+	)
+	return nil
+}
+
+func balanceRun(ctx context.Context, s *session, args []string) error {
+	account, err := s.address(args[1])
+	if err != nil {
+		return err
+	}
+	if chain := s.findChain(args[0]); chain != nil {
+		balance, err := chain.Client.BalanceAt(ctx, account, nil)
+		if err != nil {
+			return fmt.Errorf("failed to get balance on %s: %w", chain.Name, err)
+		}
+		fmt.Fprintln(s.out, balance)
+		return nil
+	}
+
+	token, err := s.contract(args[0])
+	if err != nil {
+		return err
+	}
+	// Token sources are not tokens, but the wrapped native token of a NativeTokenDestination is an ERC20
+	if token.endpoint.Type.IsSource() {
+		return fmt.Errorf("%s is a token source rather than a token, name its token with contract", token.name)
+	}
+	erc20, err := exampleerc20.NewExampleERC20(token.endpoint.Address, token.endpoint.Chain.Client)
+	if err != nil {
+		return err
+	}
+	balance, err := erc20.BalanceOf(&bind.CallOpts{Context: ctx}, account)
+	if err != nil {
+		return fmt.Errorf("failed to get balance of %s: %w", account, err)
+	}
+	fmt.Fprintln(s.out, balance)
+	return nil
+}
+
+func inspectRun(ctx context.Context, s *session, args []string) error {
+	contract, err := s.contract(args[0])
+	if err != nil {
+		return err
+	}
+	endpoint := contract.endpoint
+	tw := tabwriter.NewWriter(s.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Name\t%s\nType\t%s\nChain\t%s\nAddress\t%s\n", contract.name, endpoint.Type, endpoint.Chain.Name,
+		endpoint.Address)
+
+	switch {
+	case endpoint.Type == tokenType:
+		err = inspectToken(ctx, tw, endpoint)
+	case endpoint.Type.IsSource():
+		err = s.inspectSource(ctx, tw, endpoint)
+	default:
+		err = s.inspectDestination(ctx, tw, endpoint)
+	}
+	if err != nil {
+		return err
+	}
+	return tw.Flush()
+}
+
+func inspectToken(ctx context.Context, tw *tabwriter.Writer, endpoint bridge.Endpoint) error {
+	opts := &bind.CallOpts{Context: ctx}
+	token, err := exampleerc20.NewExampleERC20(endpoint.Address, endpoint.Chain.Client)
+	if err != nil {
+		return err
+	}
+	name, err := token.Name(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get token name: %w", err)
+	}
+	symbol, err := token.Symbol(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get token symbol: %w", err)
+	}
+	decimals, err := token.Decimals(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get token decimals: %w", err)
+	}
+	totalSupply, err := token.TotalSupply(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get total supply: %w", err)
+	}
+	fmt.Fprintf(tw, "Token\t%s (%s), %d decimals\nTotal supply\t%s\n", name, symbol, decimals, totalSupply)
+	return nil
+}
+
+func (s *session) inspectSource(ctx context.Context, tw *tabwriter.Writer, endpoint bridge.Endpoint) error {
+	opts := &bind.CallOpts{Context: ctx}
+	source, err := teleportertokensource.NewTeleporterTokenSource(endpoint.Address, endpoint.Chain.Client)
+	if err != nil {
+		return err
+	}
+	tokenAddress, err := source.TokenAddress(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get token address: %w", err)
+	}
+	paused, err := bridge.SendsPaused(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(tw, "Token address\t%s\nSends paused\t%t\n", tokenAddress, paused)
+
+	destinationChains := make(map[ids.ID]*events.Chain, len(s.chains))
+	for _, chain := range s.chains {
+		destinationChains[chain.BlockchainID] = chain.Chain
+	}
+	destinations, err := bridge.DiscoverDestinations(
+		ctx,
+		endpoint,
+		bridge.DiscoverOptions{DestinationChains: destinationChains},
+	)
+	if err != nil {
+		return err
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(destinations) == 0 {
+		fmt.Fprintln(tw, "No destinations are registered")
+		return nil
+	}
+
+	fmt.Fprintln(tw, "\nDESTINATION\tCHAIN\tMULTIPLIER\tBRIDGED BALANCE\tCOLLATERAL NEEDED\tCOLLATERALIZED")
+	for _, destination := range destinations {
+		bridgedBalance, err := source.BridgedBalances(opts, destination.BlockchainID, destination.Address)
+		if err != nil {
+			return fmt.Errorf("failed to get bridged balance: %w", err)
+		}
+		multiplier := "/" + destination.TokenMultiplier.String()
+		if destination.MultiplyOnDestination {
+			multiplier = "x" + destination.TokenMultiplier.String()
+		}
+		collateralized := "-"
+		if destination.Collateralized != nil {
+			collateralized = strconv.FormatBool(*destination.Collateralized)
+		}
+		fmt.Fprintf(
+			tw,
+			"%s\t%s\t%s\t%s\t%s\t%s\n",
+			s.contractName(destination.BlockchainID, destination.Address),
+			s.chainName(destination.BlockchainID),
+			multiplier,
+			bridgedBalance,
+			destination.CollateralNeeded,
+			collateralized,
+		)
+	}
+	return nil
+}
+
+func (s *session) inspectDestination(ctx context.Context, tw *tabwriter.Writer, endpoint bridge.Endpoint) error {
+	opts := &bind.CallOpts{Context: ctx}
+	destination, err := teleportertokendestination.NewTeleporterTokenDestination(
+		endpoint.Address,
+		endpoint.Chain.Client,
+	)
+	if err != nil {
+		return err
+	}
+	sourceBlockchainID, err := destination.SourceBlockchainID(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get source blockchain ID: %w", err)
+	}
+	sourceAddress, err := destination.TokenSourceAddress(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get token source address: %w", err)
+	}
+	registered, err := destination.IsRegistered(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get registration: %w", err)
+	}
+	collateralized, err := destination.IsCollateralized(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get collateralization: %w", err)
+	}
+	initialReserveImbalance, err := destination.InitialReserveImbalance(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get initial reserve imbalance: %w", err)
+	}
+	paused, err := bridge.SendsPaused(ctx, endpoint)
+	if err != nil {
+		return err
+	}
+	// Both destination types are ERC20 tokens, the wrapped native token in the case of a NativeTokenDestination
+	token, err := exampleerc20.NewExampleERC20(endpoint.Address, endpoint.Chain.Client)
+	if err != nil {
+		return err
+	}
+	totalSupply, err := token.TotalSupply(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get total supply: %w", err)
+	}
+
+	fmt.Fprintf(
+		tw,
+		"Source\t%s on %s\nRegistered\t%t\nCollateralized\t%t\nInitial reserve imbalance\t%s\nSends paused\t%t\n"+
+			"Total supply\t%s\n",
+		s.contractName(ids.ID(sourceBlockchainID), sourceAddress),
+		s.chainName(ids.ID(sourceBlockchainID)),
+		registered,
+		collateralized,
+		initialReserveImbalance,
+		paused,
+		totalSupply,
+	)
+	if endpoint.Type == events.NativeTokenDestination {
+		native, err := nativetokendestination.NewNativeTokenDestination(endpoint.Address, endpoint.Chain.Client)
+		if err != nil {
+			return err
+		}
+		nativeSupply, err := native.TotalNativeAssetSupply(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get total native asset supply: %w", err)
+		}
+		fmt.Fprintf(tw, "Native asset supply\t%s\n", nativeSupply)
+	}
+	return nil
+}
+
+func txRun(ctx context.Context, s *session, args []string) error {
+	chain, err := s.chain(args[0])
+	if err != nil {
+		return err
+	}
+	txHash := common.HexToHash(args[1])
+	receipt, err := chain.Client.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return fmt.Errorf("failed to get receipt of transaction %s: %w", txHash, err)
+	}
+	status := "succeeded"
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		status = "reverted"
+	}
+	fmt.Fprintf(s.out, "Transaction %s %s in block %s, using %d gas\n", txHash, status, receipt.BlockNumber,
+		receipt.GasUsed)
+
+	decoder, err := events.NewDecoder()
+	if err != nil {
+		return err
+	}
+	if received, ok := decoder.DecodeReceivedMessage(receipt.Logs); ok {
+		fmt.Fprintf(s.out, "Delivered message %s from %s\n", received.MessageID, s.chainName(received.SourceBlockchainID))
+	}
+	tw := tabwriter.NewWriter(s.out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "EVENT\tCONTRACT\tMESSAGE ID\tTO\tRECIPIENT\tAMOUNT")
+	for _, receiptLog := range receipt.Logs {
+		contract := s.contractAt(chain.BlockchainID, receiptLog.Address)
+		if contract == nil || contract.endpoint.Type == tokenType {
+			continue
+		}
+		info := events.ContractInfo{Bridge: contract.name, Type: contract.endpoint.Type}
+		event, ok, err := decoder.Decode(*receiptLog, info)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		messageID, to, recipient, amount := "-", "-", "-", "-"
+		if event.TeleporterMessageID != ids.Empty {
+			messageID = event.TeleporterMessageID.String()
+		}
+		if event.DestinationBlockchainID != ids.Empty {
+			to = s.contractName(event.DestinationBlockchainID, event.DestinationBridgeAddress)
+		}
+		if event.Recipient != (common.Address{}) {
+			recipient = event.Recipient.Hex()
+		}
+		if event.Amount != nil {
+			amount = event.Amount.String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", event.Type, contract.name, messageID, to, recipient, amount)
+	}
+	return tw.Flush()
+}
+
+// Waits for the transaction to be accepted, and records it as the last transaction sent by the session
+func (s *session) waitForSuccess(
+	ctx context.Context,
+	chain *attachedChain,
+	tx *types.Transaction,
+) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, chain.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	s.lastTxChain, s.lastTxHash = chain, tx.Hash()
+	return receipt, nil
+}
+
+func (s *session) contractAt(blockchainID ids.ID, address common.Address) *namedContract {
+	for _, name := range s.contractNames {
+		contract := s.contracts[name]
+		if contract.endpoint.Chain.BlockchainID == blockchainID && contract.endpoint.Address == address {
+			return contract
+		}
+	}
+	return nil
+}
+
+// Returns the name of the contract at the address on the chain, or the address if the contract is not named
+func (s *session) contractName(blockchainID ids.ID, address common.Address) string {
+	if contract := s.contractAt(blockchainID, address); contract != nil {
+		return contract.name
+	}
+	return address.Hex()
+}
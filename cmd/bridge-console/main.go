@@ -0,0 +1,199 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// bridge-console is an interactive shell for working with bridge contracts on a running local network, or on any
+// other chains given by their RPC endpoints. It deploys tokens and bridge contracts, registers destinations, sends
+// transfers, relays the Teleporter messages they send, and inspects contract state and transaction events, in
+// place of throwaway programs written against a local deployment. Commands are read line by line from stdin, so
+// a file of commands can also be piped in to script a deployment. Run help in the console for its commands.
+package main
+
+import (
+	"bufio"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/signer"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+)
+
+const (
+	// The default environment variable holding the private key that transactions are sent from
+	defaultPrivateKeyEnv = "BRIDGE_CONSOLE_PRIVATE_KEY"
+	// The default environment variable holding the passphrase of --keystore-file
+	defaultKeystorePassphraseEnv = "BRIDGE_CONSOLE_KEYSTORE_PASSPHRASE"
+)
+
+const prompt = "bridge> "
+
+var logger logging.Logger
+
+// rpcFlags collects the repeated --rpc flags, each naming the RPC endpoint of a chain as name=url
+type rpcFlags []string
+
+func (f *rpcFlags) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *rpcFlags) Set(value string) error {
+	if name, url, ok := strings.Cut(value, "="); !ok || name == "" || url == "" {
+		return fmt.Errorf("expected name=url, got %s", value)
+	}
+	*f = append(*f, value)
+	return nil
+}
+
+func main() {
+	var rpcs rpcFlags
+	nodeURI := flag.String(
+		"node-uri",
+		"",
+		"URI of a node of a running local network, whose EVM chains are attached to, and whose Warp API signs "+
+			"relayed messages",
+	)
+	flag.Var(&rpcs, "rpc", "RPC endpoint of a chain to attach to, as name=url. May be repeated")
+	keyEnv := flag.String(
+		"private-key-env",
+		defaultPrivateKeyEnv,
+		"Environment variable holding the hex encoded private key that transactions are sent from",
+	)
+	mnemonicEnv := flag.String(
+		"mnemonic-env",
+		"",
+		"Environment variable holding a mnemonic to derive the key that transactions are sent from, "+
+			"instead of reading it from --private-key-env",
+	)
+	derivationPath := flag.String(
+		"derivation-path",
+		signer.DefaultBasePath.String(),
+		"BIP-44 path that --account-index is appended to, to derive the key from the mnemonic",
+	)
+	accountIndex := flag.Uint("account-index", 0, "Index of the account of the mnemonic that transactions are sent from")
+	keystoreFile := flag.String(
+		"keystore-file",
+		"",
+		"Encrypted keystore file holding the key that transactions are sent from, "+
+			"instead of reading it from --private-key-env",
+	)
+	keystorePassphraseEnv := flag.String(
+		"keystore-passphrase-env",
+		defaultKeystorePassphraseEnv,
+		"Environment variable holding the passphrase of --keystore-file",
+	)
+	logLevelArg := flag.String("log-level", logging.Info.LowerString(), "Log level i.e. debug, info...")
+	flag.Parse()
+
+	logLevel, err := logging.ToLevel(*logLevelArg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-console: %v\n", err)
+		os.Exit(1)
+	}
+	// Logs are written to stderr, so that they are not interleaved with the output of scripted sessions
+	logger = logging.NewLogger(
+		"bridge-console",
+		logging.NewWrappedCore(logLevel, os.Stderr, logging.Plain.ConsoleEncoder()),
+	)
+
+	var key *ecdsa.PrivateKey
+	switch {
+	case *mnemonicEnv != "" && *keystoreFile != "":
+		err = fmt.Errorf("only one of --mnemonic-env and --keystore-file may be set")
+	case *keystoreFile != "":
+		key, err = signer.LoadKeystore(*keystoreFile, *keystorePassphraseEnv)
+	default:
+		key, err = loadKey(*keyEnv, *mnemonicEnv, *derivationPath, uint32(*accountIndex))
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-console: %v\n", err)
+		os.Exit(1)
+	}
+	ctx := context.Background()
+	s, err := newSession(ctx, os.Stdout, strings.TrimSuffix(*nodeURI, "/"), rpcs, key)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-console: %v\n", err)
+		os.Exit(1)
+	}
+	if err := repl(ctx, s, os.Stdin); err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-console: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// Reads and runs commands from the reader until it is exhausted or the exit command is run. Errors of individual
+// commands are printed rather than ending the session. Interrupting a running command cancels it.
+func repl(ctx context.Context, s *session, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for {
+		fmt.Fprint(s.out, prompt)
+		if !scanner.Scan() {
+			fmt.Fprintln(s.out)
+			return scanner.Err()
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		commandCtx, stop := signal.NotifyContext(ctx, os.Interrupt)
+		err := s.run(commandCtx, strings.Fields(line))
+		stop()
+		if errors.Is(err, errExit) {
+			return nil
+		}
+		if err != nil {
+			fmt.Fprintf(s.out, "error: %v\n", err)
+		}
+	}
+}
+
+// Loads the key that transactions are sent from, derived from the mnemonic in mnemonicEnv if it is set, or
+// otherwise read from keyEnv. Returns a nil key if neither environment variable is set, in which case only the
+// commands that send no transactions can be run.
+func loadKey(keyEnv string, mnemonicEnv string, derivationPath string, accountIndex uint32) (*ecdsa.PrivateKey, error) {
+	if mnemonicEnv != "" {
+		mnemonic := os.Getenv(mnemonicEnv)
+		if mnemonic == "" {
+			return nil, fmt.Errorf("%s must be set to the mnemonic of the account to send transactions from", mnemonicEnv)
+		}
+		basePath, err := accounts.ParseDerivationPath(derivationPath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --derivation-path %s: %w", derivationPath, err)
+		}
+		wallet, err := signer.NewHDWallet(mnemonic, "", basePath)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mnemonic in %s", mnemonicEnv)
+		}
+		key, err := wallet.Key(accountIndex)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info(
+			"Derived key from mnemonic",
+			zap.String("derivationPath", derivationPath),
+			zap.Uint32("accountIndex", accountIndex),
+			zap.Stringer("address", crypto.PubkeyToAddress(key.PublicKey)),
+		)
+		return key, nil
+	}
+
+	hexKey := os.Getenv(keyEnv)
+	if hexKey == "" {
+		logger.Warn("No private key set, so no transactions can be sent", zap.String("env", keyEnv))
+		return nil, nil
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(hexKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key in %s", keyEnv)
+	}
+	return key, nil
+}
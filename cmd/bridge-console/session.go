@@ -0,0 +1,263 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+)
+
+// tokenType is the type of named contracts that are plain ERC20 tokens, rather than bridge contracts
+const tokenType events.ContractType = "erc20"
+
+// errExit is returned by the exit command to end the session
+var errExit = errors.New("exit")
+
+// errNoKey is returned by the commands that send transactions when no private key was given
+var errNoKey = errors.New(
+	"no private key is set, set --private-key-env, --mnemonic-env or --keystore-file to send transactions",
+)
+
+// A chain attached to by the session, along with the RPC endpoint it was dialed at
+type attachedChain struct {
+	*events.Chain
+	rpcEndpoint string
+}
+
+// A contract named in the session, either when it was deployed or with the contract command
+type namedContract struct {
+	name     string
+	endpoint bridge.Endpoint
+}
+
+type session struct {
+	out     io.Writer
+	nodeURI string
+	key     *ecdsa.PrivateKey
+
+	// chains are in the order they were attached to
+	chains []*attachedChain
+	// contracts are keyed and listed by name
+	contracts     map[string]*namedContract
+	contractNames []string
+	// registries are the TeleporterRegistry contracts that bridge contracts deployed to each chain use
+	registries map[ids.ID]common.Address
+
+	// The chain and hash of the last transaction sent by the session, which relay defaults to
+	lastTxChain *attachedChain
+	lastTxHash  common.Hash
+}
+
+// Attaches to the EVM chains of the local network whose node serves the API at nodeURI, if set, and to the chains
+// of the RPC endpoints, each given as name=url
+func newSession(
+	ctx context.Context,
+	out io.Writer,
+	nodeURI string,
+	rpcs []string,
+	key *ecdsa.PrivateKey,
+) (*session, error) {
+	s := &session{
+		out:        out,
+		nodeURI:    nodeURI,
+		key:        key,
+		contracts:  make(map[string]*namedContract),
+		registries: make(map[ids.ID]common.Address),
+	}
+	if nodeURI != "" {
+		if err := s.attachLocalNetwork(ctx); err != nil {
+			return nil, err
+		}
+	}
+	for _, rpc := range rpcs {
+		name, rpcEndpoint, _ := strings.Cut(rpc, "=")
+		if err := s.attach(ctx, name, rpcEndpoint); err != nil {
+			return nil, err
+		}
+	}
+	if len(s.chains) == 0 {
+		return nil, errors.New("no chains to attach to, set --node-uri or --rpc")
+	}
+	return s, nil
+}
+
+// Attaches to the C-Chain and to every subnet chain of the local network that serves the Ethereum RPC API.
+// Chains that do not, such as those of other VMs, are skipped.
+func (s *session) attachLocalNetwork(ctx context.Context) error {
+	cChainID, err := info.NewClient(s.nodeURI).GetBlockchainID(ctx, "C")
+	if err != nil {
+		return fmt.Errorf("failed to get C-Chain ID from %s: %w", s.nodeURI, err)
+	}
+	if err := s.attach(ctx, "c-chain", s.nodeURI+"/ext/bc/C/rpc"); err != nil {
+		return err
+	}
+
+	blockchains, err := platformvm.NewClient(s.nodeURI).GetBlockchains(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get blockchains from %s: %w", s.nodeURI, err)
+	}
+	for _, blockchain := range blockchains {
+		if blockchain.ID == cChainID || blockchain.SubnetID == constants.PrimaryNetworkID {
+			continue
+		}
+		name := strings.ToLower(blockchain.Name)
+		if name == "" || s.findChain(name) != nil {
+			name = blockchain.ID.String()
+		}
+		rpcEndpoint := s.nodeURI + "/ext/bc/" + blockchain.ID.String() + "/rpc"
+		if err := s.attach(ctx, name, rpcEndpoint); err != nil {
+			logger.Warn("Skipping chain", zap.Stringer("blockchainID", blockchain.ID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (s *session) attach(ctx context.Context, name string, rpcEndpoint string) error {
+	if s.findChain(name) != nil {
+		return fmt.Errorf("chain %s is already attached", name)
+	}
+	chain, err := events.DialChain(ctx, name, rpcEndpoint)
+	if err != nil {
+		return err
+	}
+	s.chains = append(s.chains, &attachedChain{Chain: chain, rpcEndpoint: rpcEndpoint})
+	logger.Info("Attached to chain", zap.String("name", name), zap.Stringer("blockchainID", chain.BlockchainID))
+	return nil
+}
+
+// Returns the attached chain with the name, compared case insensitively, or with the blockchain ID
+func (s *session) findChain(nameOrID string) *attachedChain {
+	for _, chain := range s.chains {
+		if strings.EqualFold(chain.Name, nameOrID) || chain.BlockchainID.String() == nameOrID {
+			return chain
+		}
+	}
+	return nil
+}
+
+func (s *session) chain(nameOrID string) (*attachedChain, error) {
+	chain := s.findChain(nameOrID)
+	if chain == nil {
+		return nil, fmt.Errorf("no chain %s is attached, run chains to list them", nameOrID)
+	}
+	return chain, nil
+}
+
+func (s *session) chainByID(blockchainID ids.ID) *attachedChain {
+	for _, chain := range s.chains {
+		if chain.BlockchainID == blockchainID {
+			return chain
+		}
+	}
+	return nil
+}
+
+// Returns the name of the attached chain with the blockchain ID, or the ID if the chain is not attached
+func (s *session) chainName(blockchainID ids.ID) string {
+	if chain := s.chainByID(blockchainID); chain != nil {
+		return chain.Name
+	}
+	return blockchainID.String()
+}
+
+func (s *session) contract(name string) (*namedContract, error) {
+	contract, ok := s.contracts[name]
+	if !ok {
+		return nil, fmt.Errorf("no contract is named %s, run contracts to list them", name)
+	}
+	return contract, nil
+}
+
+func (s *session) addContract(
+	name string,
+	chain *attachedChain,
+	address common.Address,
+	contractType events.ContractType,
+) {
+	if _, ok := s.contracts[name]; !ok {
+		s.contractNames = append(s.contractNames, name)
+	}
+	s.contracts[name] = &namedContract{
+		name: name,
+		endpoint: bridge.Endpoint{
+			Chain:   chain.Chain,
+			Address: address,
+			Type:    contractType,
+		},
+	}
+}
+
+// Resolves an account argument, which is either the name of a contract, me for the account transactions are sent
+// from, or a hex address
+func (s *session) address(arg string) (common.Address, error) {
+	if contract, ok := s.contracts[arg]; ok {
+		return contract.endpoint.Address, nil
+	}
+	if arg == "me" {
+		if s.key == nil {
+			return common.Address{}, errNoKey
+		}
+		return crypto.PubkeyToAddress(s.key.PublicKey), nil
+	}
+	if !common.IsHexAddress(arg) {
+		return common.Address{}, fmt.Errorf("%s is neither a contract name nor an address", arg)
+	}
+	return common.HexToAddress(arg), nil
+}
+
+// Returns transaction options for the chain, sending from the session's key
+func (s *session) transactOpts(ctx context.Context, chain *attachedChain) (*bind.TransactOpts, error) {
+	if s.key == nil {
+		return nil, errNoKey
+	}
+	chainID, err := chain.Client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID of %s: %w", chain.Name, err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(s.key, chainID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx
+	return opts, nil
+}
+
+// Returns the base URI of the node serving the Warp and P-Chain APIs used when relaying from the chain, which is
+// the local network's node if attached to one, and otherwise the host of the chain's RPC endpoint
+func (s *session) relayNodeURI(chain *attachedChain) (string, error) {
+	if s.nodeURI != "" {
+		return s.nodeURI, nil
+	}
+	rpcURL, err := url.Parse(chain.rpcEndpoint)
+	if err != nil || rpcURL.Host == "" {
+		return "", fmt.Errorf("invalid RPC endpoint %s of %s", chain.rpcEndpoint, chain.Name)
+	}
+	return rpcURL.Scheme + "://" + rpcURL.Host, nil
+}
+
+// Parses an amount in the smallest denomination of a token, as a decimal integer
+func parseAmount(arg string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(arg, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount %s", arg)
+	}
+	return amount, nil
+}
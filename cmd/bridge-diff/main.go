@@ -0,0 +1,107 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// bridge-diff compares a deployment manifest with the state of its bridge contracts on chain, and reports each
+// difference: code hashes, owners, the token source of each destination, the destinations registered with each
+// source, and their token multipliers. It is intended for audits of a release, and for verifying a deployment after
+// an incident.
+//
+// Exit codes:
+//   - 0: the deployment matches the manifest
+//   - 1: at least one difference was found
+//   - 2: the deployment could not be compared
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ava-labs/teleporter-token-bridge/deployment"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+)
+
+const (
+	exitDifferences = 1
+	exitError       = 2
+)
+
+func main() {
+	manifestFile := flag.String("manifest-file", "", "Path to the JSON deployment manifest")
+	outputJSON := flag.Bool("json", false, "Print the differences as JSON")
+	flag.Parse()
+
+	differences, err := diff(*manifestFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-diff: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	if *outputJSON {
+		err = printJSON(os.Stdout, differences)
+	} else {
+		err = printTable(os.Stdout, differences)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-diff: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	if len(differences) > 0 {
+		os.Exit(exitDifferences)
+	}
+}
+
+func diff(manifestFile string) ([]deployment.Difference, error) {
+	if manifestFile == "" {
+		return nil, errors.New("--manifest-file must be set")
+	}
+	manifest, err := deployment.LoadManifest(manifestFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	chains, err := events.ConnectChains(ctx, manifest.Chains)
+	if err != nil {
+		return nil, err
+	}
+	return deployment.Diff(ctx, chains, manifest)
+}
+
+func printJSON(w io.Writer, differences []deployment.Difference) error {
+	// An empty list rather than null, so that consumers can always iterate over the output
+	if differences == nil {
+		differences = []deployment.Difference{}
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(differences)
+}
+
+func printTable(w io.Writer, differences []deployment.Difference) error {
+	if len(differences) == 0 {
+		_, err := fmt.Fprintln(w, "The deployment matches the manifest")
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BRIDGE\tCONTRACT\tFIELD\tEXPECTED\tACTUAL")
+	for _, difference := range differences {
+		fmt.Fprintf(
+			tw,
+			"%s\t%s/%s\t%s\t%s\t%s\n",
+			difference.Bridge,
+			difference.Chain,
+			difference.Contract.Hex(),
+			difference.Field,
+			difference.Expected,
+			difference.Actual,
+		)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/indexer"
+	"go.uber.org/zap"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+func main() {
+	configFile := flag.String("config-file", "", "Path to the JSON configuration file")
+	flag.Parse()
+
+	if err := run(*configFile); err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-indexer: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(configFile string) error {
+	if configFile == "" {
+		return errors.New("--config-file must be set")
+	}
+	config, err := indexer.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	logLevel, err := logging.ToLevel(config.LogLevel)
+	if err != nil {
+		return err
+	}
+	logger := logging.NewLogger(
+		"bridge-indexer",
+		logging.NewWrappedCore(
+			logLevel,
+			os.Stdout,
+			logging.JSON.ConsoleEncoder(),
+		),
+	)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	store, err := indexer.OpenStore(ctx, config.Database)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			logger.Warn("Failed to close database", zap.Error(err))
+		}
+	}()
+
+	i, err := indexer.NewIndexer(ctx, logger, config, store)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", config.APIPort),
+		Handler:           indexer.NewAPI(logger, store),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		logger.Info("Serving transfer API", zap.String("address", server.Addr))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Transfer API server failed", zap.Error(err))
+			cancel()
+		}
+	}()
+
+	logger.Info("Indexing bridge events", zap.String("driver", string(config.Database.Driver)))
+	runErr := i.Run(ctx)
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer shutdownCancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("Failed to shut down transfer API server", zap.Error(err))
+	}
+	return runErr
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/monitor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+)
+
+const shutdownTimeout = 5 * time.Second
+
+func main() {
+	configFile := flag.String("config-file", "", "Path to the JSON configuration file")
+	flag.Parse()
+
+	if err := run(*configFile); err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-metrics: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(configFile string) error {
+	if configFile == "" {
+		return errors.New("--config-file must be set")
+	}
+	config, err := monitor.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	logLevel, err := logging.ToLevel(config.LogLevel)
+	if err != nil {
+		return err
+	}
+	logger := logging.NewLogger(
+		"bridge-metrics",
+		logging.NewWrappedCore(
+			logLevel,
+			os.Stdout,
+			logging.JSON.ConsoleEncoder(),
+		),
+	)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	registry := prometheus.NewRegistry()
+	m, err := monitor.NewMonitor(ctx, logger, config, registry)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.Close(); err != nil {
+			logger.Warn("Failed to flush traces", zap.Error(err))
+		}
+	}()
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", config.MetricsPort),
+		Handler:           promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		logger.Info("Serving metrics", zap.String("address", server.Addr))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Metrics server failed", zap.Error(err))
+			cancel()
+		}
+	}()
+
+	runErr := m.Run(ctx)
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer shutdownCancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Warn("Failed to shut down metrics server", zap.Error(err))
+	}
+	return runErr
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// bridge-reconcile compares the balances locked in bridge sources with the supply of their destinations once,
+// and exits with a non-zero status if any drift exceeds its configured tolerance. It is intended to be run
+// periodically, e.g. by cron, with the same configuration file as bridge-metrics.
+//
+// Exit codes:
+//   - 0: all bridges reconciled within tolerance
+//   - 1: the drift of at least one bridge exceeds its tolerance
+//   - 2: reconciliation could not be performed
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/monitor"
+)
+
+const (
+	exitDriftExceeded = 1
+	exitError         = 2
+)
+
+func main() {
+	configFile := flag.String("config-file", "", "Path to the JSON configuration file")
+	outputJSON := flag.Bool("json", false, "Print the reconciliation reports as JSON")
+	flag.Parse()
+
+	reports, err := reconcile(*configFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-reconcile: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	if *outputJSON {
+		err = printJSON(os.Stdout, reports)
+	} else {
+		err = printTable(os.Stdout, reports)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-reconcile: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	for _, report := range reports {
+		if report.DriftExceeded {
+			os.Exit(exitDriftExceeded)
+		}
+	}
+}
+
+func reconcile(configFile string) ([]*monitor.BridgeReport, error) {
+	if configFile == "" {
+		return nil, errors.New("--config-file must be set")
+	}
+	config, err := monitor.LoadConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := context.Background()
+	chains, err := events.ConnectChains(ctx, config.Chains)
+	if err != nil {
+		return nil, err
+	}
+	reconciler := monitor.NewReconciler(chains, config.Bridges, config.GetDriftTolerances())
+	reconciler.SetVerifyStorageProofs(config.VerifyStorageProofs)
+	return reconciler.Reconcile(ctx)
+}
+
+func printJSON(w io.Writer, reports []*monitor.BridgeReport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(reports)
+}
+
+func printTable(w io.Writer, reports []*monitor.BridgeReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BRIDGE\tCONTRACT\tACTUAL\tEXPECTED\tDRIFT\tSTATUS")
+	for _, report := range reports {
+		status := "ok"
+		if report.DriftExceeded {
+			status = "DRIFT"
+		}
+		fmt.Fprintf(
+			tw,
+			"%s\tsource\t%s\t%s\t%s\t%s\n",
+			report.Bridge,
+			report.LockedBalance,
+			report.ExpectedLockedBalance,
+			report.Drift,
+			status,
+		)
+		for _, destination := range report.Destinations {
+			expected := new(big.Int).Add(destination.BridgedBalance, destination.InitialReserveImbalance)
+			fmt.Fprintf(
+				tw,
+				"%s\t%s/%s\t%s\t%s\t%s\t\n",
+				report.Bridge,
+				destination.Chain,
+				destination.Address.Hex(),
+				destination.Supply,
+				expected,
+				destination.Drift,
+			)
+		}
+	}
+	return tw.Flush()
+}
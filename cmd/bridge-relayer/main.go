@@ -0,0 +1,62 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// bridge-relayer is a minimal relayer that delivers the Teleporter messages sent by the configured bridge contracts
+// between the configured chains. It is intended for local development, and as a fallback when no other relayer
+// delivers a bridge's messages.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/relayer"
+)
+
+func main() {
+	configFile := flag.String("config-file", "", "Path to the JSON configuration file")
+	flag.Parse()
+
+	if err := run(*configFile); err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-relayer: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(configFile string) error {
+	if configFile == "" {
+		return errors.New("--config-file must be set")
+	}
+	config, err := relayer.LoadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	logLevel, err := logging.ToLevel(config.LogLevel)
+	if err != nil {
+		return err
+	}
+	logger := logging.NewLogger(
+		"bridge-relayer",
+		logging.NewWrappedCore(
+			logLevel,
+			os.Stdout,
+			logging.JSON.ConsoleEncoder(),
+		),
+	)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	r, err := relayer.NewRelayer(ctx, logger, config)
+	if err != nil {
+		return err
+	}
+	return r.Run(ctx)
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// bridge-sweep sweeps the configured bridge contracts for fee tokens that have been left with them once, and
+// exits with a non-zero status if any are found. Each fee a bridge contract pays is transferred to it and approved
+// to the Teleporter messenger, which transfers it out again in the same transaction, so any balance or allowance of
+// a fee token left with a contract is an anomaly, and an early sign of an accounting bug. The relayer rewards
+// accumulated for the bridges' messages are also reported. It is intended to be run periodically, e.g. by cron,
+// with the same configuration file as bridge-metrics. The events of each chain are scanned from its start-block.
+//
+// Exit codes:
+//   - 0: no contract holds residual fee tokens or allowances
+//   - 1: at least one contract holds residual fee tokens or allowances
+//   - 2: the sweep could not be performed
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/monitor"
+)
+
+const (
+	exitAnomalies = 1
+	exitError     = 2
+)
+
+func main() {
+	configFile := flag.String("config-file", "", "Path to the JSON configuration file")
+	outputJSON := flag.Bool("json", false, "Print the fee reports as JSON")
+	flag.Parse()
+
+	reports, err := sweep(*configFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-sweep: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	if *outputJSON {
+		err = printJSON(os.Stdout, reports)
+	} else {
+		err = printTable(os.Stdout, reports)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "bridge-sweep: %v\n", err)
+		os.Exit(exitError)
+	}
+
+	for _, report := range reports {
+		if report.Anomalous {
+			os.Exit(exitAnomalies)
+		}
+	}
+}
+
+func sweep(configFile string) ([]*monitor.FeeReport, error) {
+	if configFile == "" {
+		return nil, errors.New("--config-file must be set")
+	}
+	config, err := monitor.LoadConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+	logLevel, err := logging.ToLevel(config.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+	// Logs are written to stderr so that the reports can be piped
+	logger := logging.NewLogger(
+		"bridge-sweep",
+		logging.NewWrappedCore(
+			logLevel,
+			os.Stderr,
+			logging.Plain.ConsoleEncoder(),
+		),
+	)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	chains, err := events.ConnectChains(ctx, config.Chains)
+	if err != nil {
+		return nil, err
+	}
+	auditor, err := monitor.NewFeeAuditor(logger, chains, config.Bridges, config.MaxBlockRange)
+	if err != nil {
+		return nil, err
+	}
+	return auditor.Audit(ctx)
+}
+
+func printJSON(w io.Writer, reports []*monitor.FeeReport) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(reports)
+}
+
+func printTable(w io.Writer, reports []*monitor.FeeReport) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BRIDGE\tCONTRACT\tFEE TOKENS\tSTATUS")
+	for _, report := range reports {
+		for _, contract := range report.Contracts {
+			status := "ok"
+			if len(contract.Anomalies) > 0 {
+				status = "RESIDUE: " + strings.Join(contract.Anomalies, "; ")
+			}
+			fmt.Fprintf(
+				tw,
+				"%s\t%s/%s\t%d\t%s\n",
+				report.Bridge,
+				contract.Chain,
+				contract.Address.Hex(),
+				len(contract.FeeTokens),
+				status,
+			)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "BRIDGE\tCHAIN\tRELAYER\tFEE TOKEN\tREDEEMABLE")
+	for _, report := range reports {
+		for _, reward := range report.RelayerRewards {
+			fmt.Fprintf(
+				tw,
+				"%s\t%s\t%s\t%s\t%s\n",
+				report.Bridge,
+				reward.Chain,
+				reward.Relayer.Hex(),
+				reward.FeeToken.Hex(),
+				reward.Amount,
+			)
+		}
+	}
+	return tw.Flush()
+}
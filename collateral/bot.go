@@ -0,0 +1,280 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package collateral
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+)
+
+// Bot periodically checks the collateral still needed by each configured NativeTokenDestination,
+// and adds collateral to it through its source from the configured account once the amount needed
+// exceeds the bridge's threshold.
+type Bot struct {
+	logger  logging.Logger
+	config  *Config
+	metrics *Metrics
+
+	chains map[string]*events.Chain
+	// key is nil in dry-run mode if neither a private key file nor a keystore file is set
+	key     *ecdsa.PrivateKey
+	account common.Address
+}
+
+// NewBot connects to each configured chain, loads the account that collateral is added from,
+// and registers the collateral bot metrics with the registerer
+func NewBot(
+	ctx context.Context,
+	logger logging.Logger,
+	config *Config,
+	registerer prometheus.Registerer,
+) (*Bot, error) {
+	metrics, err := NewMetrics(registerer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics: %w", err)
+	}
+	key, err := config.LoadPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	chains, err := events.ConnectChains(ctx, config.Chains)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Bot{
+		logger:  logger,
+		config:  config,
+		metrics: metrics,
+		chains:  chains,
+		key:     key,
+	}
+	if key != nil {
+		b.account = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return b, nil
+}
+
+// Run checks every NativeTokenDestination on each check interval until the context is cancelled
+func (b *Bot) Run(ctx context.Context) error {
+	b.logger.Info(
+		"Starting collateral bot",
+		zap.Stringer("account", b.account),
+		zap.Bool("dryRun", b.config.DryRun),
+	)
+	ticker := time.NewTicker(b.config.checkInterval())
+	defer ticker.Stop()
+	for {
+		for _, bridge := range b.config.Bridges {
+			if err := b.checkBridge(ctx, bridge); err != nil {
+				// Check errors are transient RPC or transaction failures, so log and retry on the next interval
+				b.logger.Warn("Failed to check collateral", zap.String("bridge", bridge.Name), zap.Error(err))
+			}
+		}
+		b.metrics.lastCheck.SetToCurrentTime()
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (b *Bot) checkBridge(ctx context.Context, bridgeConfig events.BridgeConfig) error {
+	sourceChain := b.chains[bridgeConfig.Source.Chain]
+	sourceAddress := common.HexToAddress(bridgeConfig.Source.Address)
+	source, err := teleportertokensource.NewTeleporterTokenSource(sourceAddress, sourceChain.Client)
+	if err != nil {
+		return err
+	}
+
+	threshold := b.config.GetThresholds()[bridgeConfig.Name]
+	if threshold == nil {
+		threshold = big.NewInt(0)
+	}
+	maxTopUp := b.config.GetMaxTopUps()[bridgeConfig.Name]
+
+	for _, destinationConfig := range bridgeConfig.Destinations {
+		if destinationConfig.Type != events.NativeTokenDestination {
+			continue
+		}
+		destinationChain := b.chains[destinationConfig.Chain]
+		destinationAddress := common.HexToAddress(destinationConfig.Address)
+		labels := []string{bridgeConfig.Name, destinationChain.Name, destinationAddress.Hex()}
+
+		settings, err := source.RegisteredDestinations(
+			&bind.CallOpts{Context: ctx},
+			destinationChain.BlockchainID,
+			destinationAddress,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get destination settings: %w", err)
+		}
+		b.metrics.collateralNeeded.WithLabelValues(labels...).Set(toFloat(settings.CollateralNeeded))
+
+		// Collateral can only be added once the destination is registered with the source
+		if !settings.Registered || settings.CollateralNeeded.Cmp(threshold) <= 0 {
+			continue
+		}
+
+		amount := settings.CollateralNeeded
+		if maxTopUp != nil && amount.Cmp(maxTopUp) > 0 {
+			amount = maxTopUp
+		}
+		fields := []zap.Field{
+			zap.String("bridge", bridgeConfig.Name),
+			zap.String("destinationChain", destinationChain.Name),
+			zap.Stringer("destinationAddress", destinationAddress),
+			zap.Stringer("collateralNeeded", settings.CollateralNeeded),
+			zap.Stringer("amount", amount),
+		}
+
+		if b.config.DryRun {
+			b.logger.Info("Would add collateral", fields...)
+			b.metrics.topUps.WithLabelValues(append(labels, resultDryRun)...).Inc()
+			continue
+		}
+
+		balance, err := b.sourceTokenBalance(ctx, sourceChain, bridgeConfig.Source, source)
+		if err != nil {
+			return err
+		}
+		b.metrics.accountBalance.WithLabelValues(bridgeConfig.Name, sourceChain.Name).Set(toFloat(balance))
+		if balance.Cmp(amount) < 0 {
+			b.logger.Error("Insufficient balance to add collateral", append(fields, zap.Stringer("balance", balance))...)
+			b.metrics.topUps.WithLabelValues(append(labels, resultInsufficientFunds)...).Inc()
+			continue
+		}
+
+		receipt, err := b.addCollateral(ctx, sourceChain, bridgeConfig.Source, destinationChain, destinationAddress, amount)
+		if err != nil {
+			b.metrics.topUps.WithLabelValues(append(labels, resultFailed)...).Inc()
+			return fmt.Errorf("failed to add collateral to %s on %s: %w", destinationAddress, destinationChain.Name, err)
+		}
+		b.logger.Info("Added collateral", append(fields, zap.Stringer("txHash", receipt.TxHash))...)
+		b.metrics.topUps.WithLabelValues(append(labels, resultAdded)...).Inc()
+		b.metrics.collateralAdded.WithLabelValues(labels...).Add(toFloat(amount))
+	}
+	return nil
+}
+
+// Returns the account's balance of the token that is added as collateral through the source:
+// the native token for a NativeTokenSource, or the source token for an ERC20Source
+func (b *Bot) sourceTokenBalance(
+	ctx context.Context,
+	sourceChain *events.Chain,
+	sourceConfig events.ContractConfig,
+	source *teleportertokensource.TeleporterTokenSource,
+) (*big.Int, error) {
+	if sourceConfig.Type == events.NativeTokenSource {
+		balance, err := sourceChain.Client.BalanceAt(ctx, b.account, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get native balance of %s: %w", b.account, err)
+		}
+		return balance, nil
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	tokenAddress, err := source.TokenAddress(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source token address: %w", err)
+	}
+	token, err := exampleerc20.NewExampleERC20(tokenAddress, sourceChain.Client)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := token.BalanceOf(opts, b.account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source token balance of %s: %w", b.account, err)
+	}
+	return balance, nil
+}
+
+// Adds amount of collateral to the destination through the source, approving the source token first
+// for an ERC20Source, and waits for the transaction to be accepted
+func (b *Bot) addCollateral(
+	ctx context.Context,
+	sourceChain *events.Chain,
+	sourceConfig events.ContractConfig,
+	destinationChain *events.Chain,
+	destinationAddress common.Address,
+	amount *big.Int,
+) (*types.Receipt, error) {
+	chainID, err := sourceChain.Client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chain ID of %s: %w", sourceChain.Name, err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(b.key, chainID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx
+	sourceAddress := common.HexToAddress(sourceConfig.Address)
+
+	var tx *types.Transaction
+	switch sourceConfig.Type {
+	case events.ERC20Source:
+		source, err := erc20source.NewERC20Source(sourceAddress, sourceChain.Client)
+		if err != nil {
+			return nil, err
+		}
+		tokenAddress, err := source.Token(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get source token address: %w", err)
+		}
+		allowances := &bridge.AllowanceManager{}
+		if _, err := allowances.EnsureAllowance(
+			ctx,
+			sourceChain.Client,
+			opts,
+			tokenAddress,
+			sourceAddress,
+			amount,
+		); err != nil {
+			return nil, err
+		}
+		tx, err = source.AddCollateral(opts, destinationChain.BlockchainID, destinationAddress, amount)
+		if err != nil {
+			return nil, err
+		}
+	case events.NativeTokenSource:
+		source, err := nativetokensource.NewNativeTokenSource(sourceAddress, sourceChain.Client)
+		if err != nil {
+			return nil, err
+		}
+		opts.Value = amount
+		tx, err = source.AddCollateral(opts, destinationChain.BlockchainID, destinationAddress)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported source type %s", sourceConfig.Type)
+	}
+
+	receipt, err := bind.WaitMined(ctx, sourceChain.Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("transaction %s failed", tx.Hash())
+	}
+	return receipt, nil
+}
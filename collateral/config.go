@@ -0,0 +1,161 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package collateral
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/signer"
+)
+
+const (
+	defaultMetricsPort          = 9091
+	defaultCheckIntervalSeconds = 30
+)
+
+// Config is the configuration of the collateral bot
+type Config struct {
+	LogLevel    string `json:"log-level"`
+	MetricsPort uint16 `json:"metrics-port"`
+	// CheckIntervalSeconds is how often the collateral of each destination is checked
+	CheckIntervalSeconds uint64 `json:"check-interval-seconds"`
+	// PrivateKeyFile is the path of a file containing the hex encoded private key of the account that collateral
+	// is added from. It must hold the native token of the chain of each NativeTokenSource, and the token of each
+	// ERC20Source, along with the native token to pay for gas.
+	PrivateKeyFile string `json:"private-key-file"`
+	// KeystoreFile is the path of an encrypted keystore file holding the same key, in place of PrivateKeyFile, so
+	// that the key is not kept on disk in plaintext
+	KeystoreFile string `json:"keystore-file"`
+	// KeystorePassphraseEnv is the environment variable holding the passphrase of KeystoreFile
+	KeystorePassphraseEnv string `json:"keystore-passphrase-env"`
+	// DryRun logs the collateral that would be added without sending any transactions
+	DryRun bool `json:"dry-run"`
+
+	// Thresholds maps bridge names to the collateral a NativeTokenDestination may still need, as a decimal integer
+	// in the smallest denomination of the source token, before it is topped up. Bridges without a threshold are
+	// topped up whenever any collateral is needed.
+	Thresholds map[string]string `json:"thresholds"`
+	// MaxTopUps maps bridge names to the maximum collateral added to a destination in a single check, as a decimal
+	// integer in the smallest denomination of the source token. Bridges without a maximum are topped up with all of
+	// the collateral needed at once.
+	MaxTopUps map[string]string `json:"max-top-ups"`
+
+	Chains  []events.ChainConfig  `json:"chains"`
+	Bridges []events.BridgeConfig `json:"bridges"`
+
+	thresholds map[string]*big.Int
+	maxTopUps  map[string]*big.Int
+}
+
+// LoadConfig reads and validates the JSON configuration file at the given path,
+// filling in defaults for unset optional values.
+func LoadConfig(path string) (*Config, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var config Config
+	if err := json.Unmarshal(bytes, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	config.setDefaults()
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return &config, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.LogLevel == "" {
+		c.LogLevel = logging.Info.LowerString()
+	}
+	if c.MetricsPort == 0 {
+		c.MetricsPort = defaultMetricsPort
+	}
+	if c.CheckIntervalSeconds == 0 {
+		c.CheckIntervalSeconds = defaultCheckIntervalSeconds
+	}
+}
+
+// Validate checks that the configuration is well formed
+func (c *Config) Validate() error {
+	if _, err := logging.ToLevel(c.LogLevel); err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+	if c.PrivateKeyFile == "" && c.KeystoreFile == "" && !c.DryRun {
+		return fmt.Errorf("private key file or keystore file must be set unless running in dry-run mode")
+	}
+	if err := c.validateKeystore(); err != nil {
+		return err
+	}
+	if len(c.Bridges) == 0 {
+		return fmt.Errorf("no bridges configured")
+	}
+	if err := events.Validate(c.Chains, c.Bridges); err != nil {
+		return err
+	}
+
+	bridgeNames := make(map[string]struct{}, len(c.Bridges))
+	for _, bridge := range c.Bridges {
+		bridgeNames[bridge.Name] = struct{}{}
+	}
+	var err error
+	c.thresholds, err = events.ParseBridgeAmounts(c.Thresholds, bridgeNames, "collateral threshold")
+	if err != nil {
+		return err
+	}
+	c.maxTopUps, err = events.ParseBridgeAmounts(c.MaxTopUps, bridgeNames, "maximum top-up")
+	if err != nil {
+		return err
+	}
+	for bridge, maxTopUp := range c.maxTopUps {
+		if maxTopUp.Sign() == 0 {
+			return fmt.Errorf("maximum top-up for bridge %s must be positive", bridge)
+		}
+	}
+	return nil
+}
+
+// GetThresholds returns the parsed collateral thresholds, keyed by bridge name
+func (c *Config) GetThresholds() map[string]*big.Int {
+	return c.thresholds
+}
+
+// GetMaxTopUps returns the parsed maximum top-ups, keyed by bridge name
+func (c *Config) GetMaxTopUps() map[string]*big.Int {
+	return c.maxTopUps
+}
+
+// LoadPrivateKey reads the private key of the account that collateral is added from,
+// or returns nil if neither a private key file nor a keystore file is set
+func (c *Config) LoadPrivateKey() (*ecdsa.PrivateKey, error) {
+	if c.PrivateKeyFile == "" && c.KeystoreFile == "" {
+		return nil, nil
+	}
+	if c.KeystoreFile != "" {
+		return signer.LoadKeystore(c.KeystoreFile, c.KeystorePassphraseEnv)
+	}
+	return signer.LoadKeyFile(c.PrivateKeyFile)
+}
+
+func (c *Config) validateKeystore() error {
+	if c.PrivateKeyFile != "" && c.KeystoreFile != "" {
+		return fmt.Errorf("only one of private key file and keystore file may be set")
+	}
+	if c.KeystoreFile != "" && c.KeystorePassphraseEnv == "" {
+		return fmt.Errorf("keystore passphrase env must be set along with the keystore file")
+	}
+	return nil
+}
+
+func (c *Config) checkInterval() time.Duration {
+	return time.Duration(c.CheckIntervalSeconds) * time.Second
+}
@@ -0,0 +1,97 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package collateral
+
+import (
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "collateral_bot"
+
+// The results of a top-up, exported as the result label of the top-ups metric
+const (
+	resultAdded             = "added"
+	resultDryRun            = "dry-run"
+	resultInsufficientFunds = "insufficient-funds"
+	resultFailed            = "failed"
+)
+
+// Metrics are the Prometheus metrics exported by the collateral bot.
+// Token amounts are reported in the smallest denomination of the source token.
+type Metrics struct {
+	collateralNeeded *prometheus.GaugeVec
+	topUps           *prometheus.CounterVec
+	collateralAdded  *prometheus.CounterVec
+	accountBalance   *prometheus.GaugeVec
+	lastCheck        prometheus.Gauge
+}
+
+// NewMetrics creates the collateral bot metrics and registers them with the registerer
+func NewMetrics(registerer prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		collateralNeeded: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "collateral_needed",
+				Help:      "Collateral still needed by a native token destination, as tracked by the source",
+			},
+			[]string{"bridge", "destination_chain", "destination_address"},
+		),
+		topUps: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "top_ups_total",
+				Help:      "Number of times collateral was added, or would have been added, to a destination, by result",
+			},
+			[]string{"bridge", "destination_chain", "destination_address", "result"},
+		),
+		collateralAdded: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "collateral_added_total",
+				Help:      "Amount of collateral added to a destination",
+			},
+			[]string{"bridge", "destination_chain", "destination_address"},
+		),
+		accountBalance: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "account_balance",
+				Help:      "Balance of the bot's account of the token it adds as collateral to a bridge",
+			},
+			[]string{"bridge", "chain"},
+		),
+		lastCheck: prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "last_check_timestamp_seconds",
+				Help:      "Unix time of the last completed check of every destination",
+			},
+		),
+	}
+
+	for _, collector := range []prometheus.Collector{
+		m.collateralNeeded,
+		m.topUps,
+		m.collateralAdded,
+		m.accountBalance,
+		m.lastCheck,
+	} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Converts a token amount to a float for export as a metric. Precision loss is acceptable for monitoring.
+func toFloat(amount *big.Int) float64 {
+	if amount == nil {
+		return 0
+	}
+	f, _ := new(big.Float).SetInt(amount).Float64()
+	return f
+}
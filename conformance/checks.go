@@ -0,0 +1,394 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package conformance checks that a deployment of bridge contracts, described by a manifest of the chains they are
+// deployed to and the bridges they form, is configured and behaves as the bridge contracts require. Subnet teams
+// can run the checks against their own contracts and RPC endpoints, either directly with Run or as ginkgo specs
+// with DescribeConformance.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/monitor"
+	teleporterregistry "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/upgrades/TeleporterRegistry"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Check is a conformance check of a single bridge of a deployment
+type Check struct {
+	Name        string
+	Description string
+	run         func(ctx context.Context, d *Deployment, bridge events.BridgeConfig) error
+}
+
+// Checks are the conformance checks run against each bridge, in order
+var Checks = []Check{
+	{
+		Name:        "registration",
+		Description: "every destination is registered with the source",
+		run:         checkRegistration,
+	},
+	{
+		Name:        "destination-source",
+		Description: "every destination sends to and receives from the source",
+		run:         checkDestinationSource,
+	},
+	{
+		Name:        "scaling",
+		Description: "every destination scales amounts as registered with the source",
+		run:         checkScaling,
+	},
+	{
+		Name:        "collateral",
+		Description: "every destination is collateralized, and agrees with the source on its collateral",
+		run:         checkCollateral,
+	},
+	{
+		Name:        "teleporter-version",
+		Description: "every contract accepts the latest Teleporter version of its registry",
+		run:         checkTeleporterVersion,
+	},
+	{
+		Name:        "quote",
+		Description: "transfers can be quoted in both directions between the source and every destination",
+		run:         checkQuote,
+	},
+	{
+		Name:        "reconcile",
+		Description: "the balances of the source and every destination reconcile within the drift tolerance",
+		run:         checkReconcile,
+	},
+	{
+		Name:        "address-parity",
+		Description: "every destination has the same address, for bridges deployed with CREATE2",
+		run:         checkAddressParity,
+	},
+}
+
+// Result is the outcome of a check of a bridge. Err is nil if the bridge conforms.
+type Result struct {
+	Bridge string
+	Check  string
+	Err    error
+}
+
+// Deployment is a connection to the chains of a manifest's bridges
+type Deployment struct {
+	manifest *Manifest
+	chains   map[string]*events.Chain
+}
+
+// NewDeployment connects to each chain of the manifest
+func NewDeployment(ctx context.Context, manifest *Manifest) (*Deployment, error) {
+	chains, err := events.ConnectChains(ctx, manifest.Chains)
+	if err != nil {
+		return nil, err
+	}
+	return &Deployment{
+		manifest: manifest,
+		chains:   chains,
+	}, nil
+}
+
+// Run runs every check against every bridge of the manifest, in order
+func (d *Deployment) Run(ctx context.Context) []Result {
+	var results []Result
+	for _, bridge := range d.manifest.Bridges {
+		for _, check := range Checks {
+			results = append(results, Result{
+				Bridge: bridge.Name,
+				Check:  check.Name,
+				Err:    d.RunCheck(ctx, check, bridge),
+			})
+		}
+	}
+	return results
+}
+
+// RunCheck runs the check against the bridge
+func (d *Deployment) RunCheck(ctx context.Context, check Check, bridge events.BridgeConfig) error {
+	return check.run(ctx, d, bridge)
+}
+
+func (d *Deployment) endpoint(contract events.ContractConfig) bridge.Endpoint {
+	return bridge.Endpoint{
+		Chain:   d.chains[contract.Chain],
+		Address: common.HexToAddress(contract.Address),
+		Type:    contract.Type,
+	}
+}
+
+// The settings of a destination as registered with its source
+type destinationSettings struct {
+	Registered            bool
+	CollateralNeeded      *big.Int
+	TokenMultiplier       *big.Int
+	MultiplyOnDestination bool
+}
+
+// Calls the function with the registered settings of each destination of the bridge, along with a binding of
+// the destination
+func (d *Deployment) forEachDestination(
+	ctx context.Context,
+	bridgeConfig events.BridgeConfig,
+	f func(settings destinationSettings, destination *teleportertokendestination.TeleporterTokenDestination) error,
+) error {
+	source := d.endpoint(bridgeConfig.Source)
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(source.Address, source.Chain.Client)
+	if err != nil {
+		return err
+	}
+	for _, destinationConfig := range bridgeConfig.Destinations {
+		destination := d.endpoint(destinationConfig)
+		settings, err := tokenSource.RegisteredDestinations(
+			&bind.CallOpts{Context: ctx},
+			destination.Chain.BlockchainID,
+			destination.Address,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get settings of destination %s: %w", destination.Address, err)
+		}
+		binding, err := teleportertokendestination.NewTeleporterTokenDestination(
+			destination.Address,
+			destination.Chain.Client,
+		)
+		if err != nil {
+			return err
+		}
+		if err := f(destinationSettings(settings), binding); err != nil {
+			return fmt.Errorf("destination %s on %s: %w", destination.Address, destination.Chain.Name, err)
+		}
+	}
+	return nil
+}
+
+func checkRegistration(ctx context.Context, d *Deployment, bridgeConfig events.BridgeConfig) error {
+	return d.forEachDestination(ctx, bridgeConfig, func(
+		settings destinationSettings,
+		_ *teleportertokendestination.TeleporterTokenDestination,
+	) error {
+		if !settings.Registered {
+			return fmt.Errorf("not registered with the source")
+		}
+		return nil
+	})
+}
+
+func checkDestinationSource(ctx context.Context, d *Deployment, bridgeConfig events.BridgeConfig) error {
+	source := d.endpoint(bridgeConfig.Source)
+	opts := &bind.CallOpts{Context: ctx}
+	return d.forEachDestination(ctx, bridgeConfig, func(
+		_ destinationSettings,
+		destination *teleportertokendestination.TeleporterTokenDestination,
+	) error {
+		sourceBlockchainID, err := destination.SourceBlockchainID(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get source blockchain ID: %w", err)
+		}
+		if ids.ID(sourceBlockchainID) != source.Chain.BlockchainID {
+			return fmt.Errorf(
+				"source blockchain ID is %s, expected %s",
+				ids.ID(sourceBlockchainID),
+				source.Chain.BlockchainID,
+			)
+		}
+		tokenSourceAddress, err := destination.TokenSourceAddress(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get token source address: %w", err)
+		}
+		if tokenSourceAddress != source.Address {
+			return fmt.Errorf("token source address is %s, expected %s", tokenSourceAddress, source.Address)
+		}
+		return nil
+	})
+}
+
+func checkScaling(ctx context.Context, d *Deployment, bridgeConfig events.BridgeConfig) error {
+	opts := &bind.CallOpts{Context: ctx}
+	return d.forEachDestination(ctx, bridgeConfig, func(
+		settings destinationSettings,
+		destination *teleportertokendestination.TeleporterTokenDestination,
+	) error {
+		tokenMultiplier, err := destination.TokenMultiplier(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get token multiplier: %w", err)
+		}
+		multiplyOnDestination, err := destination.MultiplyOnDestination(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get multiply on destination: %w", err)
+		}
+		if tokenMultiplier.Cmp(settings.TokenMultiplier) != 0 ||
+			multiplyOnDestination != settings.MultiplyOnDestination {
+			return fmt.Errorf(
+				"scales by %s with multiplyOnDestination %t, but is registered with %s and %t",
+				tokenMultiplier,
+				multiplyOnDestination,
+				settings.TokenMultiplier,
+				settings.MultiplyOnDestination,
+			)
+		}
+		return nil
+	})
+}
+
+func checkCollateral(ctx context.Context, d *Deployment, bridgeConfig events.BridgeConfig) error {
+	opts := &bind.CallOpts{Context: ctx}
+	return d.forEachDestination(ctx, bridgeConfig, func(
+		settings destinationSettings,
+		destination *teleportertokendestination.TeleporterTokenDestination,
+	) error {
+		collateralized, err := destination.IsCollateralized(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get collateralization: %w", err)
+		}
+		if !collateralized {
+			return fmt.Errorf("not collateralized")
+		}
+		if settings.CollateralNeeded.Sign() != 0 {
+			return fmt.Errorf("collateralized, but the source tracks %s collateral still needed", settings.CollateralNeeded)
+		}
+		return nil
+	})
+}
+
+func checkTeleporterVersion(ctx context.Context, d *Deployment, bridgeConfig events.BridgeConfig) error {
+	for _, contract := range append([]events.ContractConfig{bridgeConfig.Source}, bridgeConfig.Destinations...) {
+		endpoint := d.endpoint(contract)
+		if err := checkContractTeleporterVersion(ctx, endpoint); err != nil {
+			return fmt.Errorf("contract %s on %s: %w", endpoint.Address, endpoint.Chain.Name, err)
+		}
+	}
+	return nil
+}
+
+// Every bridge contract defines the Teleporter version functions, so the source binding is used for every contract
+func checkContractTeleporterVersion(ctx context.Context, endpoint bridge.Endpoint) error {
+	opts := &bind.CallOpts{Context: ctx}
+	contract, err := teleportertokensource.NewTeleporterTokenSource(endpoint.Address, endpoint.Chain.Client)
+	if err != nil {
+		return err
+	}
+	minVersion, err := contract.GetMinTeleporterVersion(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get minimum Teleporter version: %w", err)
+	}
+	registryAddress, err := contract.TeleporterRegistry(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get Teleporter registry: %w", err)
+	}
+	registry, err := teleporterregistry.NewTeleporterRegistry(registryAddress, endpoint.Chain.Client)
+	if err != nil {
+		return err
+	}
+	latestVersion, err := registry.LatestVersion(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get latest Teleporter version: %w", err)
+	}
+	if minVersion.Cmp(latestVersion) > 0 {
+		return fmt.Errorf(
+			"minimum Teleporter version %s is above the latest version %s of registry %s",
+			minVersion,
+			latestVersion,
+			registryAddress,
+		)
+	}
+	return nil
+}
+
+func checkQuote(ctx context.Context, d *Deployment, bridgeConfig events.BridgeConfig) error {
+	source := d.endpoint(bridgeConfig.Source)
+	amount := d.manifest.quoteAmount(bridgeConfig.Name)
+	for _, destinationConfig := range bridgeConfig.Destinations {
+		destination := d.endpoint(destinationConfig)
+		if _, err := bridge.Quote(ctx, source, destination, amount, bridge.QuoteOptions{}); err != nil {
+			return fmt.Errorf("failed to quote transfer to %s on %s: %w", destination.Address, destination.Chain.Name, err)
+		}
+		if _, err := bridge.Quote(ctx, destination, source, amount, bridge.QuoteOptions{}); err != nil {
+			return fmt.Errorf(
+				"failed to quote transfer from %s on %s: %w",
+				destination.Address,
+				destination.Chain.Name,
+				err,
+			)
+		}
+	}
+	return nil
+}
+
+func checkReconcile(ctx context.Context, d *Deployment, bridgeConfig events.BridgeConfig) error {
+	reconciler := monitor.NewReconciler(d.chains, d.manifest.Bridges, d.manifest.driftTolerances)
+	report, err := reconciler.ReconcileBridge(ctx, bridgeConfig)
+	if err != nil {
+		return err
+	}
+	if !report.DriftExceeded {
+		return nil
+	}
+	drifts := []string{fmt.Sprintf("source drifts by %s", report.Drift)}
+	for _, destination := range report.Destinations {
+		drifts = append(
+			drifts,
+			fmt.Sprintf("destination %s on %s drifts by %s", destination.Address, destination.Chain, destination.Drift),
+		)
+	}
+	return fmt.Errorf("drift exceeds tolerance: %s", strings.Join(drifts, ", "))
+}
+
+// The destinations of a bridge deployed with CREATE2 share an address, and since their init code is the same, their
+// runtime code differs only in immutables such as the blockchain ID, which don't change its length
+func checkAddressParity(ctx context.Context, d *Deployment, bridgeConfig events.BridgeConfig) error {
+	if !d.manifest.addressParity(bridgeConfig.Name) {
+		return nil
+	}
+	var (
+		expectedAddress  common.Address
+		expectedCodeSize int
+	)
+	for i, destinationConfig := range bridgeConfig.Destinations {
+		destination := d.endpoint(destinationConfig)
+		code, err := destination.Chain.Client.CodeAt(ctx, destination.Address, nil)
+		if err != nil {
+			return fmt.Errorf(
+				"failed to get code of destination %s on %s: %w",
+				destination.Address,
+				destination.Chain.Name,
+				err,
+			)
+		}
+		if len(code) == 0 {
+			return fmt.Errorf("destination %s on %s has no code", destination.Address, destination.Chain.Name)
+		}
+		if i == 0 {
+			expectedAddress, expectedCodeSize = destination.Address, len(code)
+			continue
+		}
+		if destination.Address != expectedAddress {
+			return fmt.Errorf(
+				"destination on %s is at %s, expected %s",
+				destination.Chain.Name,
+				destination.Address,
+				expectedAddress,
+			)
+		}
+		if len(code) != expectedCodeSize {
+			return fmt.Errorf(
+				"destination %s on %s has %d bytes of code, expected %d",
+				destination.Address,
+				destination.Chain.Name,
+				len(code),
+				expectedCodeSize,
+			)
+		}
+	}
+	return nil
+}
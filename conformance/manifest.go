@@ -0,0 +1,101 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ava-labs/teleporter-token-bridge/events"
+)
+
+// defaultQuoteAmount is quoted over each route of bridges without a quote amount, one whole token of 18 decimals
+var defaultQuoteAmount = big.NewInt(1e18)
+
+// Manifest describes a deployment of bridge contracts to check for conformance
+type Manifest struct {
+	Chains  []events.ChainConfig  `json:"chains"`
+	Bridges []events.BridgeConfig `json:"bridges"`
+
+	// QuoteAmounts maps bridge names to the amount quoted over each of the bridge's routes, as a decimal integer
+	// in the sending contract's denomination. Defaults to 10^18.
+	QuoteAmounts map[string]string `json:"quote-amounts"`
+	// DriftTolerances maps bridge names to the drift allowed when reconciling the bridge's balances, as a decimal
+	// integer in the smallest denomination of the token. Defaults to zero.
+	DriftTolerances map[string]string `json:"drift-tolerances"`
+	// AddressParity names the bridges whose destinations were deployed with CREATE2 from the same factory, salt and
+	// init code, and so must share an address on every chain
+	AddressParity []string `json:"address-parity"`
+
+	quoteAmounts    map[string]*big.Int
+	driftTolerances map[string]*big.Int
+}
+
+// LoadManifest reads and validates the JSON deployment manifest at the given path
+func LoadManifest(path string) (*Manifest, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(bytes, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// Validate checks that the manifest is well formed
+func (m *Manifest) Validate() error {
+	if len(m.Bridges) == 0 {
+		return fmt.Errorf("no bridges configured")
+	}
+	if err := events.Validate(m.Chains, m.Bridges); err != nil {
+		return err
+	}
+	bridgeNames := make(map[string]struct{}, len(m.Bridges))
+	for _, bridge := range m.Bridges {
+		bridgeNames[bridge.Name] = struct{}{}
+	}
+	var err error
+	m.quoteAmounts, err = events.ParseBridgeAmounts(m.QuoteAmounts, bridgeNames, "quote amount")
+	if err != nil {
+		return err
+	}
+	for bridge, amount := range m.quoteAmounts {
+		if amount.Sign() == 0 {
+			return fmt.Errorf("quote amount for bridge %s must be positive", bridge)
+		}
+	}
+	m.driftTolerances, err = events.ParseBridgeAmounts(m.DriftTolerances, bridgeNames, "drift tolerance")
+	if err != nil {
+		return err
+	}
+	for _, bridge := range m.AddressParity {
+		if _, ok := bridgeNames[bridge]; !ok {
+			return fmt.Errorf("address parity set for unknown bridge %s", bridge)
+		}
+	}
+	return nil
+}
+
+func (m *Manifest) addressParity(bridge string) bool {
+	for _, name := range m.AddressParity {
+		if name == bridge {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manifest) quoteAmount(bridge string) *big.Int {
+	if amount, ok := m.quoteAmounts[bridge]; ok {
+		return amount
+	}
+	return defaultQuoteAmount
+}
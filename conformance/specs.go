@@ -0,0 +1,42 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package conformance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// DescribeConformance registers a ginkgo container with a spec for each check of each of the manifest's bridges.
+// Call it at the top level of a ginkgo suite, for example:
+//
+//	var _ = conformance.DescribeConformance(manifest)
+func DescribeConformance(manifest *Manifest) bool {
+	return ginkgo.Describe("[Bridge conformance]", ginkgo.Ordered, func() {
+		var deployment *Deployment
+
+		ginkgo.BeforeAll(func(ctx context.Context) {
+			var err error
+			deployment, err = NewDeployment(ctx, manifest)
+			Expect(err).Should(BeNil())
+		})
+
+		for _, bridge := range manifest.Bridges {
+			bridge := bridge
+			for _, check := range Checks {
+				check := check
+				ginkgo.It(
+					fmt.Sprintf("%s: %s", bridge.Name, check.Description),
+					ginkgo.Label(check.Name),
+					func(ctx context.Context) {
+						Expect(deployment.RunCheck(ctx, check, bridge)).Should(Succeed())
+					},
+				)
+			}
+		}
+	})
+}
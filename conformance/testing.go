@@ -0,0 +1,47 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package conformance
+
+import (
+	"context"
+	"testing"
+)
+
+// RunConformance runs each check of each of the manifest's bridges as a subtest of t, named by the bridge and
+// then the check, for suites run with plain go test rather than ginkgo. Checks can be selected with -run, such as
+// -run 'TestConformance/.*/registration'. Call it from a test function, for example:
+//
+//	func TestConformance(t *testing.T) {
+//		manifest, err := conformance.LoadManifest(os.Getenv("MANIFEST_FILE"))
+//		if err != nil {
+//			t.Fatal(err)
+//		}
+//		conformance.RunConformance(t, manifest)
+//	}
+func RunConformance(t *testing.T, manifest *Manifest) {
+	ctx := context.Background()
+	if deadline, ok := t.Deadline(); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+		defer cancel()
+	}
+
+	deployment, err := NewDeployment(ctx, manifest)
+	if err != nil {
+		t.Fatalf("failed to connect to the chains of the manifest: %v", err)
+	}
+	for _, bridge := range manifest.Bridges {
+		bridge := bridge
+		t.Run(bridge.Name, func(t *testing.T) {
+			for _, check := range Checks {
+				check := check
+				t.Run(check.Name, func(t *testing.T) {
+					if err := deployment.RunCheck(ctx, check, bridge); err != nil {
+						t.Errorf("%s: %v", check.Description, err)
+					}
+				})
+			}
+		})
+	}
+}
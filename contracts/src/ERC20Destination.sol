@@ -109,6 +109,20 @@ contract ERC20Destination is IERC20Bridge, TeleporterTokenDestination, ERC20 {
         _mint(recipient, amount);
     }
 
+    /**
+     * @dev See {TeleporterTokenDestination-_mintEscrow}
+     */
+    function _mintEscrow(uint256 amount) internal virtual override {
+        _mint(address(this), amount);
+    }
+
+    /**
+     * @dev See {TeleporterTokenDestination-_releaseEscrow}
+     */
+    function _releaseEscrow(address recipient, uint256 amount) internal virtual override {
+        _transfer(address(this), recipient, amount);
+    }
+
     /**
      * @dev See {TeleporterTokenDestination-_burn}
      *
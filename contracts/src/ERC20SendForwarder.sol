@@ -0,0 +1,133 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {IERC20SendForwarder, SendRequest} from "./interfaces/IERC20SendForwarder.sol";
+import {IERC20Bridge} from "./interfaces/IERC20Bridge.sol";
+import {SendTokensInput} from "./interfaces/ITeleporterTokenBridge.sol";
+import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
+import {SafeERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/utils/SafeERC20.sol";
+import {EIP712} from "@openzeppelin/contracts@4.8.1/utils/cryptography/EIP712.sol";
+import {ECDSA} from "@openzeppelin/contracts@4.8.1/utils/cryptography/ECDSA.sol";
+import {ReentrancyGuard} from "@openzeppelin/contracts@4.8.1/security/ReentrancyGuard.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @title ERC20SendForwarder
+ * @notice This contract is an {IERC20SendForwarder} that executes EIP-712 signed {SendRequest}s on
+ * {IERC20Bridge} instances, so that a relayer can pay the gas of bridging tokens for a token holder.
+ *
+ * The forwarder is the sender of the bridged tokens as seen by the bridge, and only ever holds tokens
+ * for the duration of {execute}.
+ *
+ * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
+ */
+contract ERC20SendForwarder is IERC20SendForwarder, EIP712, ReentrancyGuard {
+    using SafeERC20 for IERC20;
+
+    bytes32 public constant SEND_TOKENS_INPUT_TYPEHASH = keccak256(
+        "SendTokensInput(bytes32 destinationBlockchainID,address destinationBridgeAddress,address recipient,"
+        "address primaryFeeTokenAddress,uint256 primaryFee,uint256 secondaryFee,uint256 requiredGasLimit,"
+        "address multiHopFallback)"
+    );
+
+    bytes32 public constant SEND_REQUEST_TYPEHASH = keccak256(
+        "SendRequest(address from,address bridge,address token,SendTokensInput input,uint256 amount,"
+        "uint256 nonce,uint256 deadline)"
+        "SendTokensInput(bytes32 destinationBlockchainID,address destinationBridgeAddress,address recipient,"
+        "address primaryFeeTokenAddress,uint256 primaryFee,uint256 secondaryFee,uint256 requiredGasLimit,"
+        "address multiHopFallback)"
+    );
+
+    /// @notice The next nonce of each token holder.
+    mapping(address from => uint256 nonce) public nonces;
+
+    constructor() EIP712("ERC20SendForwarder", "1") {}
+
+    /**
+     * @dev See {IERC20SendForwarder-execute}
+     *
+     * Requirements:
+     *
+     * - {request.deadline} must not have passed
+     * - {request.nonce} must be the next nonce of {request.from}
+     * - {signature} must be a valid signature of {request} by {request.from}
+     */
+    function execute(
+        SendRequest calldata request,
+        bytes calldata signature
+    ) external nonReentrant {
+        require(block.timestamp <= request.deadline, "ERC20SendForwarder: expired request");
+        require(request.nonce == nonces[request.from], "ERC20SendForwarder: invalid nonce");
+        require(
+            ECDSA.recover(_hashTypedDataV4(_hashSendRequest(request)), signature) == request.from,
+            "ERC20SendForwarder: invalid signature"
+        );
+        nonces[request.from] = request.nonce + 1;
+
+        // Pull the tokens and the fee from the token holder, and approve the bridge to spend them.
+        IERC20 token = IERC20(request.token);
+        token.safeTransferFrom(request.from, address(this), request.amount);
+        token.safeIncreaseAllowance(request.bridge, request.amount);
+        if (request.input.primaryFee > 0) {
+            IERC20 feeToken = IERC20(request.input.primaryFeeTokenAddress);
+            feeToken.safeTransferFrom(request.from, address(this), request.input.primaryFee);
+            feeToken.safeIncreaseAllowance(request.bridge, request.input.primaryFee);
+        }
+
+        IERC20Bridge(request.bridge).send(request.input, request.amount);
+
+        emit SendRequestExecuted(request.from, request.bridge, request.nonce, msg.sender);
+    }
+
+    /**
+     * @dev See {IERC20SendForwarder-hashSendRequest}
+     */
+    function hashSendRequest(SendRequest calldata request) external view returns (bytes32) {
+        return _hashTypedDataV4(_hashSendRequest(request));
+    }
+
+    /**
+     * @dev Returns the EIP-712 struct hash of the request
+     */
+    function _hashSendRequest(SendRequest calldata request) private pure returns (bytes32) {
+        return keccak256(
+            abi.encode(
+                SEND_REQUEST_TYPEHASH,
+                request.from,
+                request.bridge,
+                request.token,
+                _hashSendTokensInput(request.input),
+                request.amount,
+                request.nonce,
+                request.deadline
+            )
+        );
+    }
+
+    /**
+     * @dev Returns the EIP-712 struct hash of the input
+     */
+    function _hashSendTokensInput(SendTokensInput calldata input) private pure returns (bytes32) {
+        return keccak256(
+            abi.encode(
+                SEND_TOKENS_INPUT_TYPEHASH,
+                input.destinationBlockchainID,
+                input.destinationBridgeAddress,
+                input.recipient,
+                input.primaryFeeTokenAddress,
+                input.primaryFee,
+                input.secondaryFee,
+                input.requiredGasLimit,
+                input.multiHopFallback
+            )
+        );
+    }
+}
@@ -0,0 +1,503 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {TeleporterMessageInput, TeleporterFeeInfo} from "@teleporter/ITeleporterMessenger.sol";
+import {TeleporterOwnerUpgradeable} from "@teleporter/upgrades/TeleporterOwnerUpgradeable.sol";
+import {IERC721SendAndCallReceiver} from "./interfaces/IERC721SendAndCallReceiver.sol";
+import {
+    SendTokensInput,
+    SendAndCallInput,
+    BridgeMessageType,
+    BridgeMessage
+} from "./interfaces/ITeleporterTokenBridge.sol";
+import {
+    IERC721Bridge,
+    ERC721SingleHopSendMessage,
+    ERC721SingleHopCallMessage,
+    ERC721MultiHopSendMessage,
+    ERC721MultiHopCallMessage
+} from "./interfaces/IERC721Bridge.sol";
+import {SendReentrancyGuard} from "./utils/SendReentrancyGuard.sol";
+import {SendPausable} from "./utils/SendPausable.sol";
+import {CallUtils} from "./utils/CallUtils.sol";
+import {IWarpMessenger} from
+    "@avalabs/subnet-evm-contracts@1.2.0/contracts/interfaces/IWarpMessenger.sol";
+import {SafeERC20TransferFrom} from "@teleporter/SafeERC20TransferFrom.sol";
+import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
+import {ERC721, ERC721URIStorage} from
+    "@openzeppelin/contracts@4.8.1/token/ERC721/extensions/ERC721URIStorage.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @title ERC721Destination
+ * @notice This contract is an {IERC721Bridge} that receives tokens from another chain's
+ * {ERC721Source} instance, and represents each received token with an ERC721 token of the
+ * same ID and token URI on this destination chain.
+ *
+ * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
+ */
+contract ERC721Destination is
+    IERC721Bridge,
+    TeleporterOwnerUpgradeable,
+    SendReentrancyGuard,
+    SendPausable,
+    ERC721URIStorage
+{
+    /// @notice The blockchain ID of the chain this contract is deployed on.
+    bytes32 public immutable blockchainID;
+
+    /// @notice The blockchain ID of the source chain this contract receives tokens from.
+    bytes32 public immutable sourceBlockchainID;
+    /// @notice The address of the source token bridge instance this contract receives tokens from.
+    address public immutable tokenSourceAddress;
+
+    /**
+     * @notice Whether or not the contract is known to be registered with its specified source contract.
+     * This is set to true when the first message is received from the source contract.
+     */
+    bool public isRegistered;
+
+    /**
+     * @notice Fixed gas cost for performing a multi-hop transfer on the {sourceBlockchainID},
+     * before forwarding to the final destination bridge instance.
+     */
+    uint256 public constant MULTI_HOP_REQUIRED_GAS = 300_000;
+
+    /**
+     * @notice The amount of gas added to the required gas limit for a multi-hop call message
+     * for each 32-byte word of the recipient payload.
+     */
+    uint256 public constant MULTI_HOP_CALL_GAS_PER_WORD = 8_500;
+
+    /**
+     * @notice Fixed gas cost for registering the destination contract on the source contract.
+     */
+    uint256 public constant REGISTER_DESTINATION_REQUIRED_GAS = 150_000;
+
+    /**
+     * @notice Initializes this destination token bridge instance to receive
+     * tokens from the specified source chain and token bridge instance.
+     */
+    constructor(
+        address teleporterRegistryAddress,
+        address teleporterManager,
+        bytes32 sourceBlockchainID_,
+        address tokenSourceAddress_,
+        string memory tokenName,
+        string memory tokenSymbol
+    )
+        TeleporterOwnerUpgradeable(teleporterRegistryAddress, teleporterManager)
+        ERC721(tokenName, tokenSymbol)
+    {
+        blockchainID = IWarpMessenger(0x0200000000000000000000000000000000000005).getBlockchainID();
+        require(sourceBlockchainID_ != bytes32(0), "ERC721Destination: zero source blockchain ID");
+        require(
+            sourceBlockchainID_ != blockchainID,
+            "ERC721Destination: cannot deploy to same blockchain as source"
+        );
+        require(
+            tokenSourceAddress_ != address(0), "ERC721Destination: zero token source address"
+        );
+        sourceBlockchainID = sourceBlockchainID_;
+        tokenSourceAddress = tokenSourceAddress_;
+    }
+
+    /**
+     * @dev See {SendPausable-_checkSendPauseAccess}
+     *
+     * Checks that the caller is the owner of the contract for send pause access.
+     */
+    function _checkSendPauseAccess() internal view virtual override {
+        _checkOwner();
+    }
+
+    /**
+     * @notice Sends a message to the contract's specified source token bridge instance to register this destination
+     * instance. Destination instances must be registered with their source contract's prior to being able to receive
+     * tokens from them.
+     */
+    function registerWithSource(TeleporterFeeInfo calldata feeInfo) external {
+        require(!isRegistered, "ERC721Destination: already registered");
+
+        BridgeMessage memory message =
+            BridgeMessage({messageType: BridgeMessageType.REGISTER_DESTINATION, payload: ""});
+        _sendTeleporterMessage(
+            TeleporterMessageInput({
+                destinationBlockchainID: sourceBlockchainID,
+                destinationAddress: tokenSourceAddress,
+                feeInfo: feeInfo,
+                requiredGasLimit: REGISTER_DESTINATION_REQUIRED_GAS,
+                allowedRelayerAddresses: new address[](0),
+                message: abi.encode(message)
+            })
+        );
+    }
+
+    /**
+     * @dev Calculates the number of 32-byte words required to fit a payload of a given length.
+     * The payloads are padded to have a length that is a multiple of 32.
+     */
+    function calculateNumWords(uint256 payloadSize) public pure returns (uint256) {
+        // Add 31 to effectively round up to the nearest multiple of 32.
+        // Right-shift by 5 bits to divide by 32.
+        return (payloadSize + 31) >> 5;
+    }
+
+    /**
+     * @notice For transfers to an {input.destinationBlockchainID} that is not the {sourceBlockchainID},
+     * a multi-hop transfer is performed, where the token is sent back to the token source chain
+     * first, and then forwarded to the final destination chain.
+     *
+     * @dev Burns the token, and uses Teleporter to send a cross chain message.
+     * See {IERC721Bridge-send}
+     * Requirements:
+     *
+     * - the sender must own the token or be approved to transfer it
+     * - {input.recipient} cannot be the zero address
+     * - {input.secondaryFee} must be zero
+     * - sends must not be paused
+     */
+    function send(
+        SendTokensInput calldata input,
+        uint256 tokenId
+    ) external sendNonReentrant whenSendsNotPaused {
+        require(input.recipient != address(0), "ERC721Destination: zero recipient address");
+        require(input.requiredGasLimit > 0, "ERC721Destination: zero required gas limit");
+
+        uint256 primaryFee = _prepareSend({
+            destinationBlockchainID: input.destinationBlockchainID,
+            destinationBridgeAddress: input.destinationBridgeAddress,
+            tokenId: tokenId,
+            primaryFeeTokenAddress: input.primaryFeeTokenAddress,
+            primaryFee: input.primaryFee,
+            secondaryFee: input.secondaryFee
+        });
+
+        // If the destination blockchain is the source blockchain, no multi-hop is needed.
+        // Else, the first hop back to {sourceBlockchainID} requires {MULTI_HOP_REQUIRED_GAS},
+        // and the second hop to the final destination requires {input.requiredGasLimit}.
+        BridgeMessage memory message;
+        uint256 messageRequiredGasLimit = input.requiredGasLimit;
+        if (input.destinationBlockchainID == sourceBlockchainID) {
+            require(
+                input.destinationBridgeAddress == tokenSourceAddress,
+                "ERC721Destination: invalid destination bridge address"
+            );
+            require(
+                input.multiHopFallback == address(0),
+                "ERC721Destination: non-zero multi-hop fallback"
+            );
+            message = BridgeMessage({
+                messageType: BridgeMessageType.SINGLE_HOP_SEND,
+                payload: abi.encode(
+                    ERC721SingleHopSendMessage({
+                        recipient: input.recipient,
+                        tokenId: tokenId,
+                        tokenURI: ""
+                    })
+                    )
+            });
+        } else {
+            // Require a multi-hop fallback in case the message sent to the intermediate source
+            // chain fails to route the token to the final destination.
+            require(
+                input.multiHopFallback != address(0), "ERC721Destination: zero multi-hop fallback"
+            );
+            if (input.destinationBlockchainID == blockchainID) {
+                require(
+                    input.destinationBridgeAddress != address(this),
+                    "ERC721Destination: invalid destination bridge address"
+                );
+            }
+            message = BridgeMessage({
+                messageType: BridgeMessageType.MULTI_HOP_SEND,
+                payload: abi.encode(
+                    ERC721MultiHopSendMessage({
+                        destinationBlockchainID: input.destinationBlockchainID,
+                        destinationBridgeAddress: input.destinationBridgeAddress,
+                        recipient: input.recipient,
+                        tokenId: tokenId,
+                        secondaryGasLimit: input.requiredGasLimit,
+                        multiHopFallback: input.multiHopFallback
+                    })
+                    )
+            });
+            messageRequiredGasLimit = MULTI_HOP_REQUIRED_GAS;
+        }
+
+        bytes32 messageID = _sendTeleporterMessage(
+            TeleporterMessageInput({
+                destinationBlockchainID: sourceBlockchainID,
+                destinationAddress: tokenSourceAddress,
+                feeInfo: TeleporterFeeInfo({
+                    feeTokenAddress: input.primaryFeeTokenAddress,
+                    amount: primaryFee
+                }),
+                requiredGasLimit: messageRequiredGasLimit,
+                allowedRelayerAddresses: new address[](0),
+                message: abi.encode(message)
+            })
+        );
+
+        emit TokenSent(messageID, msg.sender, input, tokenId);
+    }
+
+    /**
+     * @notice Sends the token to the specified recipient contract on the destination chain by
+     * calling the {receiveToken} method of the respective recipient.
+     *
+     * @dev Burns the token, and uses Teleporter to send a cross chain message.
+     * See {IERC721Bridge-sendAndCall}
+     * Requirements:
+     *
+     * - the sender must own the token or be approved to transfer it
+     * - {input.secondaryFee} must be zero
+     * - sends must not be paused
+     */
+    function sendAndCall(
+        SendAndCallInput calldata input,
+        uint256 tokenId
+    ) external sendNonReentrant whenSendsNotPaused {
+        require(
+            input.recipientContract != address(0),
+            "ERC721Destination: zero recipient contract address"
+        );
+        require(input.requiredGasLimit > 0, "ERC721Destination: zero required gas limit");
+        require(input.recipientGasLimit > 0, "ERC721Destination: zero recipient gas limit");
+        require(
+            input.recipientGasLimit < input.requiredGasLimit,
+            "ERC721Destination: invalid recipient gas limit"
+        );
+        require(
+            input.fallbackRecipient != address(0),
+            "ERC721Destination: zero fallback recipient address"
+        );
+
+        uint256 primaryFee = _prepareSend({
+            destinationBlockchainID: input.destinationBlockchainID,
+            destinationBridgeAddress: input.destinationBridgeAddress,
+            tokenId: tokenId,
+            primaryFeeTokenAddress: input.primaryFeeTokenAddress,
+            primaryFee: input.primaryFee,
+            secondaryFee: input.secondaryFee
+        });
+
+        BridgeMessage memory message;
+        uint256 messageRequiredGasLimit = input.requiredGasLimit;
+        if (input.destinationBlockchainID == sourceBlockchainID) {
+            require(
+                input.destinationBridgeAddress == tokenSourceAddress,
+                "ERC721Destination: invalid destination bridge address"
+            );
+            require(
+                input.multiHopFallback == address(0),
+                "ERC721Destination: non-zero multi-hop fallback"
+            );
+            message = BridgeMessage({
+                messageType: BridgeMessageType.SINGLE_HOP_CALL,
+                payload: abi.encode(
+                    ERC721SingleHopCallMessage({
+                        sourceBlockchainID: blockchainID,
+                        originSenderAddress: msg.sender,
+                        recipientContract: input.recipientContract,
+                        tokenId: tokenId,
+                        tokenURI: "",
+                        recipientPayload: input.recipientPayload,
+                        recipientGasLimit: input.recipientGasLimit,
+                        fallbackRecipient: input.fallbackRecipient
+                    })
+                    )
+            });
+        } else {
+            require(
+                input.multiHopFallback != address(0), "ERC721Destination: zero multi-hop fallback"
+            );
+            if (input.destinationBlockchainID == blockchainID) {
+                require(
+                    input.destinationBridgeAddress != address(this),
+                    "ERC721Destination: invalid destination bridge address"
+                );
+            }
+            message = BridgeMessage({
+                messageType: BridgeMessageType.MULTI_HOP_CALL,
+                payload: abi.encode(
+                    ERC721MultiHopCallMessage({
+                        originSenderAddress: msg.sender,
+                        destinationBlockchainID: input.destinationBlockchainID,
+                        destinationBridgeAddress: input.destinationBridgeAddress,
+                        recipientContract: input.recipientContract,
+                        tokenId: tokenId,
+                        recipientPayload: input.recipientPayload,
+                        recipientGasLimit: input.recipientGasLimit,
+                        fallbackRecipient: input.fallbackRecipient,
+                        secondaryRequiredGasLimit: input.requiredGasLimit,
+                        multiHopFallback: input.multiHopFallback
+                    })
+                    )
+            });
+
+            // The required gas limit for the first message sent back to the source chain
+            // needs to account for the number of words in the payload, which each use additional
+            // gas to send in a message to the final destination chain.
+            messageRequiredGasLimit = MULTI_HOP_REQUIRED_GAS
+                + (calculateNumWords(input.recipientPayload.length) * MULTI_HOP_CALL_GAS_PER_WORD);
+        }
+
+        bytes32 messageID = _sendTeleporterMessage(
+            TeleporterMessageInput({
+                destinationBlockchainID: sourceBlockchainID,
+                destinationAddress: tokenSourceAddress,
+                feeInfo: TeleporterFeeInfo({
+                    feeTokenAddress: input.primaryFeeTokenAddress,
+                    amount: primaryFee
+                }),
+                requiredGasLimit: messageRequiredGasLimit,
+                allowedRelayerAddresses: new address[](0),
+                message: abi.encode(message)
+            })
+        );
+
+        emit TokenAndCallSent(messageID, msg.sender, input, tokenId);
+    }
+
+    /**
+     * @notice Verifies the source token bridge instance, and mints the token to the recipient address.
+     *
+     * @dev See {ITeleporterUpgradeable-_receiveTeleporterMessage}
+     */
+    function _receiveTeleporterMessage(
+        bytes32 sourceBlockchainID_,
+        address originSenderAddress,
+        bytes memory message
+    ) internal override {
+        require(
+            sourceBlockchainID_ == sourceBlockchainID, "ERC721Destination: invalid source chain"
+        );
+        require(
+            originSenderAddress == tokenSourceAddress,
+            "ERC721Destination: invalid token source address"
+        );
+        BridgeMessage memory bridgeMessage = abi.decode(message, (BridgeMessage));
+
+        // If the contract was not previously known to be registered, it is now given that
+        // the source has sent a message to mint a token.
+        if (!isRegistered) {
+            isRegistered = true;
+        }
+
+        // Destination contracts should only ever receive single-hop messages because
+        // multi-hop messages are always routed through the source contract.
+        if (bridgeMessage.messageType == BridgeMessageType.SINGLE_HOP_SEND) {
+            ERC721SingleHopSendMessage memory payload =
+                abi.decode(bridgeMessage.payload, (ERC721SingleHopSendMessage));
+            _withdraw(payload.recipient, payload.tokenId, payload.tokenURI);
+        } else if (bridgeMessage.messageType == BridgeMessageType.SINGLE_HOP_CALL) {
+            ERC721SingleHopCallMessage memory payload =
+                abi.decode(bridgeMessage.payload, (ERC721SingleHopCallMessage));
+            _handleSendAndCall(payload);
+        } else {
+            revert("ERC721Destination: invalid message type");
+        }
+    }
+
+    /**
+     * @notice Mints the token to the recipient address. The token is minted rather than safely
+     * minted, so that a recipient that cannot receive ERC721 tokens does not block the message.
+     */
+    function _withdraw(address recipient, uint256 tokenId, string memory uri) internal {
+        emit TokenWithdrawn(recipient, tokenId);
+        _mint(recipient, tokenId);
+        _setTokenURI(tokenId, uri);
+    }
+
+    /**
+     * @notice Processes a send and call message by minting the token to this contract, approving
+     * the recipient contract to transfer it, and calling {IERC721SendAndCallReceiver-receiveToken}
+     * on it. If the call fails or the recipient does not transfer the token, the token is sent to
+     * the fallback recipient.
+     * @param message The send and call message include recipient calldata
+     */
+    function _handleSendAndCall(ERC721SingleHopCallMessage memory message) internal {
+        // Mint the token to this contract address.
+        _mint(address(this), message.tokenId);
+        _setTokenURI(message.tokenId, message.tokenURI);
+
+        // Approve the recipient contract to transfer the token.
+        _approve(message.recipientContract, message.tokenId);
+
+        // Encode the call to {IERC721SendAndCallReceiver-receiveToken}
+        bytes memory payload = abi.encodeCall(
+            IERC721SendAndCallReceiver.receiveToken,
+            (
+                message.sourceBlockchainID,
+                message.originSenderAddress,
+                address(this),
+                message.tokenId,
+                message.recipientPayload
+            )
+        );
+
+        // Call the recipient contract with the given payload and gas amount.
+        bool success = CallUtils._callWithExactGas(
+            message.recipientGasLimit, message.recipientContract, payload
+        );
+
+        if (success) {
+            emit CallSucceeded(message.recipientContract, message.tokenId);
+        } else {
+            emit CallFailed(message.recipientContract, message.tokenId);
+        }
+
+        // Transfer the token to the fallback recipient if the recipient contract did not take it,
+        // which also clears its approval.
+        if (ownerOf(message.tokenId) == address(this)) {
+            _transfer(address(this), message.fallbackRecipient, message.tokenId);
+        }
+    }
+
+    /**
+     * @dev Prepares the token to be sent to another chain by checking that the sender can
+     * transfer it, transferring the primary fee, and burning the token.
+     * @return The primary fee amount transferred to this contract.
+     */
+    function _prepareSend(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress,
+        uint256 tokenId,
+        address primaryFeeTokenAddress,
+        uint256 primaryFee,
+        uint256 secondaryFee
+    ) private returns (uint256) {
+        require(
+            destinationBlockchainID != bytes32(0),
+            "ERC721Destination: zero destination blockchain ID"
+        );
+        require(
+            destinationBridgeAddress != address(0),
+            "ERC721Destination: zero destination bridge address"
+        );
+        require(secondaryFee == 0, "ERC721Destination: non-zero secondary fee");
+        require(
+            _isApprovedOrOwner(msg.sender, tokenId),
+            "ERC721Destination: caller not token owner or approved"
+        );
+
+        if (primaryFee > 0) {
+            primaryFee =
+                SafeERC20TransferFrom.safeTransferFrom(IERC20(primaryFeeTokenAddress), primaryFee);
+        }
+
+        // Burn the token that will be bridged.
+        _burn(tokenId);
+
+        return primaryFee;
+    }
+}
@@ -0,0 +1,527 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {TeleporterMessageInput, TeleporterFeeInfo} from "@teleporter/ITeleporterMessenger.sol";
+import {TeleporterOwnerUpgradeable} from "@teleporter/upgrades/TeleporterOwnerUpgradeable.sol";
+import {IERC721Source} from "./interfaces/IERC721Source.sol";
+import {IERC721SendAndCallReceiver} from "./interfaces/IERC721SendAndCallReceiver.sol";
+import {
+    SendTokensInput,
+    SendAndCallInput,
+    BridgeMessageType,
+    BridgeMessage
+} from "./interfaces/ITeleporterTokenBridge.sol";
+import {
+    ERC721SingleHopSendMessage,
+    ERC721SingleHopCallMessage,
+    ERC721MultiHopSendMessage,
+    ERC721MultiHopCallMessage
+} from "./interfaces/IERC721Bridge.sol";
+import {SendReentrancyGuard} from "./utils/SendReentrancyGuard.sol";
+import {SendPausable} from "./utils/SendPausable.sol";
+import {CallUtils} from "./utils/CallUtils.sol";
+import {IWarpMessenger} from
+    "@avalabs/subnet-evm-contracts@1.2.0/contracts/interfaces/IWarpMessenger.sol";
+import {SafeERC20TransferFrom} from "@teleporter/SafeERC20TransferFrom.sol";
+import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
+import {IERC721} from "@openzeppelin/contracts@4.8.1/token/ERC721/IERC721.sol";
+import {IERC721Metadata} from
+    "@openzeppelin/contracts@4.8.1/token/ERC721/extensions/IERC721Metadata.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice The destination bridge instance that a token locked in the source bridge was sent to.
+ * Only that destination bridge instance can send the token back, or route it to another destination.
+ */
+struct BridgedToken {
+    bytes32 destinationBlockchainID;
+    address destinationBridgeAddress;
+}
+
+/**
+ * @title ERC721Source
+ * @notice An {IERC721Bridge} that locks ERC721 tokens on this chain, and sends them to
+ * {ERC721Destination} instances on other chains, which mint a token with the same ID to the recipient.
+ *
+ * This contract also handles multi-hop transfers, where tokens sent from an {ERC721Destination}
+ * instance are forwarded to another {ERC721Destination} instance.
+ *
+ * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
+ */
+contract ERC721Source is
+    IERC721Source,
+    TeleporterOwnerUpgradeable,
+    SendReentrancyGuard,
+    SendPausable
+{
+    /// @notice The blockchain ID of the chain this contract is deployed on.
+    bytes32 public immutable blockchainID;
+
+    /// @notice The ERC721 token this source contract bridges to destination instances.
+    address public immutable tokenAddress;
+
+    /**
+     * @notice Tracks the destination bridge instances registered with this contract. Destination bridge
+     * instances must register with their {ERC721Source} contract via Teleporter message to be able to
+     * receive tokens from this contract.
+     */
+    mapping(
+        bytes32 destinationBlockchainID
+            => mapping(address destinationBridgeAddress => bool registered)
+    ) public registeredDestinations;
+
+    /**
+     * @notice Tracks the destination bridge instance each token locked in this contract was sent to.
+     * @dev tokenId -> destination bridge instance
+     */
+    mapping(uint256 tokenId => BridgedToken bridgedToken) public bridgedTokens;
+
+    /**
+     * @notice Initializes this source token bridge instance to send
+     * tokens of the given ERC721 contract to destination token bridge instances.
+     */
+    constructor(
+        address teleporterRegistryAddress,
+        address teleporterManager,
+        address tokenAddress_
+    ) TeleporterOwnerUpgradeable(teleporterRegistryAddress, teleporterManager) {
+        blockchainID = IWarpMessenger(0x0200000000000000000000000000000000000005).getBlockchainID();
+        require(tokenAddress_ != address(0), "ERC721Source: zero token address");
+        tokenAddress = tokenAddress_;
+    }
+
+    /**
+     * @dev See {SendPausable-_checkSendPauseAccess}
+     *
+     * Checks that the caller is the owner of the contract for send pause access.
+     */
+    function _checkSendPauseAccess() internal view virtual override {
+        _checkOwner();
+    }
+
+    /**
+     * @notice The sender must own the token or be approved to transfer it, and this contract must be
+     * approved to transfer it.
+     *
+     * @dev See {IERC721Bridge-send}
+     */
+    function send(SendTokensInput calldata input, uint256 tokenId) external {
+        _send(input, tokenId, false);
+    }
+
+    /**
+     * @dev See {IERC721Bridge-sendAndCall}
+     */
+    function sendAndCall(SendAndCallInput calldata input, uint256 tokenId) external {
+        _sendAndCall(blockchainID, msg.sender, input, tokenId, false);
+    }
+
+    /**
+     * @notice Sends the token to the specified destination token bridge instance.
+     *
+     * @dev Locks the token in this contract if the send is initiated on this chain, records the
+     * destination it is sent to, and uses Teleporter to send a cross chain message.
+     * Requirements:
+     *
+     * - {input.recipient} cannot be the zero address
+     * - {input.secondaryFee} must be zero
+     * - the destination must be registered, unless {isMultiHop} is true, in which case the token
+     *   is sent to {input.multiHopFallback} instead
+     * - sends must not be paused, unless {isMultiHop} is true
+     */
+    function _send(
+        SendTokensInput memory input,
+        uint256 tokenId,
+        bool isMultiHop
+    ) internal sendNonReentrant {
+        require(input.recipient != address(0), "ERC721Source: zero recipient address");
+        require(input.requiredGasLimit > 0, "ERC721Source: zero required gas limit");
+        require(input.secondaryFee == 0, "ERC721Source: non-zero secondary fee");
+
+        uint256 feeAmount = input.primaryFee;
+        if (isMultiHop) {
+            // If the final destination is not registered, send the token to the multi-hop fallback.
+            if (!_isRegistered(input.destinationBlockchainID, input.destinationBridgeAddress)) {
+                _withdraw(input.multiHopFallback, tokenId);
+                return;
+            }
+        } else {
+            // Sends initiated on this chain are paused, but multi-hop transfers are still routed
+            // so that tokens in flight are not stranded.
+            _requireSendsNotPaused();
+            // Require that a single hop transfer does not have a multi-hop fallback recipient.
+            require(
+                input.multiHopFallback == address(0), "ERC721Source: non-zero multi-hop fallback"
+            );
+            feeAmount = _prepareSend(
+                input.destinationBlockchainID,
+                input.destinationBridgeAddress,
+                tokenId,
+                input.primaryFeeTokenAddress,
+                input.primaryFee
+            );
+        }
+
+        bridgedTokens[tokenId] = BridgedToken({
+            destinationBlockchainID: input.destinationBlockchainID,
+            destinationBridgeAddress: input.destinationBridgeAddress
+        });
+
+        BridgeMessage memory message = BridgeMessage({
+            messageType: BridgeMessageType.SINGLE_HOP_SEND,
+            payload: abi.encode(
+                ERC721SingleHopSendMessage({
+                    recipient: input.recipient,
+                    tokenId: tokenId,
+                    tokenURI: _tokenURI(tokenId)
+                })
+                )
+        });
+
+        // Send message to the destination bridge address
+        bytes32 messageID = _sendTeleporterMessage(
+            TeleporterMessageInput({
+                destinationBlockchainID: input.destinationBlockchainID,
+                destinationAddress: input.destinationBridgeAddress,
+                feeInfo: TeleporterFeeInfo({
+                    feeTokenAddress: input.primaryFeeTokenAddress,
+                    amount: feeAmount
+                }),
+                requiredGasLimit: input.requiredGasLimit,
+                allowedRelayerAddresses: new address[](0),
+                message: abi.encode(message)
+            })
+        );
+
+        if (isMultiHop) {
+            emit TokenRouted(messageID, input, tokenId);
+        } else {
+            emit TokenSent(messageID, msg.sender, input, tokenId);
+        }
+    }
+
+    function _sendAndCall(
+        bytes32 sourceBlockchainID,
+        address originSenderAddress,
+        SendAndCallInput memory input,
+        uint256 tokenId,
+        bool isMultiHop
+    ) internal sendNonReentrant {
+        require(
+            input.recipientContract != address(0), "ERC721Source: zero recipient contract address"
+        );
+        require(input.requiredGasLimit > 0, "ERC721Source: zero required gas limit");
+        require(input.recipientGasLimit > 0, "ERC721Source: zero recipient gas limit");
+        require(
+            input.recipientGasLimit < input.requiredGasLimit,
+            "ERC721Source: invalid recipient gas limit"
+        );
+        require(
+            input.fallbackRecipient != address(0), "ERC721Source: zero fallback recipient address"
+        );
+        require(input.secondaryFee == 0, "ERC721Source: non-zero secondary fee");
+
+        uint256 feeAmount = input.primaryFee;
+        if (isMultiHop) {
+            // If the final destination is not registered, send the token to the multi-hop fallback.
+            if (!_isRegistered(input.destinationBlockchainID, input.destinationBridgeAddress)) {
+                _withdraw(input.multiHopFallback, tokenId);
+                return;
+            }
+        } else {
+            // Sends initiated on this chain are paused, but multi-hop transfers are still routed
+            // so that tokens in flight are not stranded.
+            _requireSendsNotPaused();
+            // Require that a single hop transfer does not have a multi-hop fallback recipient.
+            require(
+                input.multiHopFallback == address(0), "ERC721Source: non-zero multi-hop fallback"
+            );
+            feeAmount = _prepareSend(
+                input.destinationBlockchainID,
+                input.destinationBridgeAddress,
+                tokenId,
+                input.primaryFeeTokenAddress,
+                input.primaryFee
+            );
+        }
+
+        bridgedTokens[tokenId] = BridgedToken({
+            destinationBlockchainID: input.destinationBlockchainID,
+            destinationBridgeAddress: input.destinationBridgeAddress
+        });
+
+        BridgeMessage memory message = BridgeMessage({
+            messageType: BridgeMessageType.SINGLE_HOP_CALL,
+            payload: abi.encode(
+                ERC721SingleHopCallMessage({
+                    sourceBlockchainID: sourceBlockchainID,
+                    originSenderAddress: originSenderAddress,
+                    recipientContract: input.recipientContract,
+                    tokenId: tokenId,
+                    tokenURI: _tokenURI(tokenId),
+                    recipientPayload: input.recipientPayload,
+                    recipientGasLimit: input.recipientGasLimit,
+                    fallbackRecipient: input.fallbackRecipient
+                })
+                )
+        });
+
+        // Send message to the destination bridge address
+        bytes32 messageID = _sendTeleporterMessage(
+            TeleporterMessageInput({
+                destinationBlockchainID: input.destinationBlockchainID,
+                destinationAddress: input.destinationBridgeAddress,
+                feeInfo: TeleporterFeeInfo({
+                    feeTokenAddress: input.primaryFeeTokenAddress,
+                    amount: feeAmount
+                }),
+                requiredGasLimit: input.requiredGasLimit,
+                allowedRelayerAddresses: new address[](0),
+                message: abi.encode(message)
+            })
+        );
+
+        if (isMultiHop) {
+            emit TokenAndCallRouted(messageID, input, tokenId);
+        } else {
+            emit TokenAndCallSent(messageID, originSenderAddress, input, tokenId);
+        }
+    }
+
+    /**
+     * @dev See {ITeleporterUpgradeable-_receiveTeleporterMessage}
+     *
+     * Verifies that the token was sent to the destination bridge instance sending it back. If the
+     * final destination for the token is this chain, the token is withdrawn and sent to the recipient.
+     * Otherwise, a multi-hop is performed, and the token is forwarded to the final destination bridge.
+     * Requirements:
+     *
+     * - the token was sent to {sourceBlockchainID} and {originSenderAddress}
+     */
+    function _receiveTeleporterMessage(
+        bytes32 sourceBlockchainID,
+        address originSenderAddress,
+        bytes memory message
+    ) internal override {
+        BridgeMessage memory bridgeMessage = abi.decode(message, (BridgeMessage));
+        if (bridgeMessage.messageType == BridgeMessageType.SINGLE_HOP_SEND) {
+            ERC721SingleHopSendMessage memory payload =
+                abi.decode(bridgeMessage.payload, (ERC721SingleHopSendMessage));
+            _processReceivedToken(sourceBlockchainID, originSenderAddress, payload.tokenId);
+
+            // Send the token to the recipient.
+            _withdraw(payload.recipient, payload.tokenId);
+            return;
+        } else if (bridgeMessage.messageType == BridgeMessageType.SINGLE_HOP_CALL) {
+            ERC721SingleHopCallMessage memory payload =
+                abi.decode(bridgeMessage.payload, (ERC721SingleHopCallMessage));
+
+            // Verify that the payload's source blockchain ID matches the source blockchain ID passed from Teleporter.
+            require(
+                payload.sourceBlockchainID == sourceBlockchainID,
+                "ERC721Source: mismatched source blockchain ID"
+            );
+            _processReceivedToken(sourceBlockchainID, originSenderAddress, payload.tokenId);
+
+            _handleSendAndCall(payload);
+            return;
+        } else if (bridgeMessage.messageType == BridgeMessageType.MULTI_HOP_SEND) {
+            ERC721MultiHopSendMessage memory payload =
+                abi.decode(bridgeMessage.payload, (ERC721MultiHopSendMessage));
+            _processReceivedToken(sourceBlockchainID, originSenderAddress, payload.tokenId);
+
+            // There is no fee for the second hop, since there is no fungible token
+            // deposited in this contract to pay it from.
+            _send(
+                SendTokensInput({
+                    destinationBlockchainID: payload.destinationBlockchainID,
+                    destinationBridgeAddress: payload.destinationBridgeAddress,
+                    recipient: payload.recipient,
+                    primaryFeeTokenAddress: address(0),
+                    primaryFee: 0,
+                    secondaryFee: 0,
+                    requiredGasLimit: payload.secondaryGasLimit,
+                    multiHopFallback: payload.multiHopFallback
+                }),
+                payload.tokenId,
+                true
+            );
+            return;
+        } else if (bridgeMessage.messageType == BridgeMessageType.MULTI_HOP_CALL) {
+            ERC721MultiHopCallMessage memory payload =
+                abi.decode(bridgeMessage.payload, (ERC721MultiHopCallMessage));
+            _processReceivedToken(sourceBlockchainID, originSenderAddress, payload.tokenId);
+
+            _sendAndCall(
+                sourceBlockchainID,
+                payload.originSenderAddress,
+                SendAndCallInput({
+                    destinationBlockchainID: payload.destinationBlockchainID,
+                    destinationBridgeAddress: payload.destinationBridgeAddress,
+                    recipientContract: payload.recipientContract,
+                    recipientPayload: payload.recipientPayload,
+                    requiredGasLimit: payload.secondaryRequiredGasLimit,
+                    recipientGasLimit: payload.recipientGasLimit,
+                    multiHopFallback: payload.multiHopFallback,
+                    fallbackRecipient: payload.fallbackRecipient,
+                    primaryFeeTokenAddress: address(0),
+                    primaryFee: 0,
+                    secondaryFee: 0
+                }),
+                payload.tokenId,
+                true
+            );
+            return;
+        } else if (bridgeMessage.messageType == BridgeMessageType.REGISTER_DESTINATION) {
+            _registerDestination(sourceBlockchainID, originSenderAddress);
+        }
+    }
+
+    function _registerDestination(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress
+    ) internal {
+        require(
+            destinationBlockchainID != bytes32(0), "ERC721Source: zero destination blockchain ID"
+        );
+        require(
+            destinationBlockchainID != blockchainID,
+            "ERC721Source: cannot register bridge on same chain"
+        );
+        require(
+            destinationBridgeAddress != address(0), "ERC721Source: zero destination bridge address"
+        );
+        require(
+            !registeredDestinations[destinationBlockchainID][destinationBridgeAddress],
+            "ERC721Source: destination already registered"
+        );
+
+        registeredDestinations[destinationBlockchainID][destinationBridgeAddress] = true;
+        emit DestinationRegistered(destinationBlockchainID, destinationBridgeAddress);
+    }
+
+    /**
+     * @notice Transfers the locked token to the recipient address.
+     * @param recipient The address to withdraw the token to
+     * @param tokenId The ID of the token to withdraw
+     */
+    function _withdraw(address recipient, uint256 tokenId) internal {
+        emit TokenWithdrawn(recipient, tokenId);
+        IERC721(tokenAddress).transferFrom(address(this), recipient, tokenId);
+    }
+
+    /**
+     * @notice Processes a send and call message by approving the recipient contract to transfer the
+     * token, and calling {IERC721SendAndCallReceiver-receiveToken} on it. If the call fails or the
+     * recipient does not transfer the token, the token is sent to the fallback recipient.
+     * @param message The send and call message include recipient calldata
+     */
+    function _handleSendAndCall(ERC721SingleHopCallMessage memory message) internal {
+        IERC721 token = IERC721(tokenAddress);
+
+        // Approve the recipient contract to transfer the token.
+        token.approve(message.recipientContract, message.tokenId);
+
+        // Encode the call to {IERC721SendAndCallReceiver-receiveToken}
+        bytes memory payload = abi.encodeCall(
+            IERC721SendAndCallReceiver.receiveToken,
+            (
+                message.sourceBlockchainID,
+                message.originSenderAddress,
+                tokenAddress,
+                message.tokenId,
+                message.recipientPayload
+            )
+        );
+
+        // Call the recipient contract with the given payload and gas amount.
+        bool success = CallUtils._callWithExactGas(
+            message.recipientGasLimit, message.recipientContract, payload
+        );
+
+        if (success) {
+            emit CallSucceeded(message.recipientContract, message.tokenId);
+        } else {
+            emit CallFailed(message.recipientContract, message.tokenId);
+        }
+
+        // Transfer the token to the fallback recipient if the recipient contract did not take it,
+        // which also clears its approval.
+        if (token.ownerOf(message.tokenId) == address(this)) {
+            _withdraw(message.fallbackRecipient, message.tokenId);
+        }
+    }
+
+    /**
+     * @dev Prepares the token to be sent to another chain by checking that the destination is
+     * registered, locking the token in this contract, and transferring the primary fee.
+     * @return The primary fee amount transferred to this contract.
+     */
+    function _prepareSend(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress,
+        uint256 tokenId,
+        address primaryFeeTokenAddress,
+        uint256 feeAmount
+    ) private returns (uint256) {
+        require(
+            _isRegistered(destinationBlockchainID, destinationBridgeAddress),
+            "ERC721Source: destination not registered"
+        );
+
+        // Lock the token in this contract. {IERC721-transferFrom} checks that the sender owns
+        // the token or is approved to transfer it.
+        IERC721(tokenAddress).transferFrom(msg.sender, address(this), tokenId);
+
+        if (feeAmount > 0) {
+            feeAmount =
+                SafeERC20TransferFrom.safeTransferFrom(IERC20(primaryFeeTokenAddress), feeAmount);
+        }
+        return feeAmount;
+    }
+
+    /**
+     * @notice Verifies that a token received back from a destination bridge instance was sent to it,
+     * and clears the record of the destination the token was sent to.
+     */
+    function _processReceivedToken(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress,
+        uint256 tokenId
+    ) private {
+        BridgedToken memory bridgedToken = bridgedTokens[tokenId];
+        require(
+            bridgedToken.destinationBlockchainID == destinationBlockchainID
+                && bridgedToken.destinationBridgeAddress == destinationBridgeAddress,
+            "ERC721Source: token not bridged to sender"
+        );
+        delete bridgedTokens[tokenId];
+    }
+
+    function _isRegistered(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress
+    ) private view returns (bool) {
+        return registeredDestinations[destinationBlockchainID][destinationBridgeAddress];
+    }
+
+    /**
+     * @notice Returns the URI of the token's metadata, or an empty string if the token contract
+     * does not implement {IERC721Metadata}.
+     */
+    function _tokenURI(uint256 tokenId) private view returns (string memory) {
+        try IERC721Metadata(tokenAddress).tokenURI(tokenId) returns (string memory uri) {
+            return uri;
+        } catch {
+            return "";
+        }
+    }
+}
@@ -30,6 +30,7 @@ import {
 import {ERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
 import {SendReentrancyGuard} from "./utils/SendReentrancyGuard.sol";
 import {CallUtils} from "./utils/CallUtils.sol";
+import {ClaimableNativeWithdrawals} from "./utils/ClaimableNativeWithdrawals.sol";
 import {TokenScalingUtils} from "./utils/TokenScalingUtils.sol";
 import {Address} from "@openzeppelin/contracts@4.8.1/utils/Address.sol";
 
@@ -76,7 +77,8 @@ contract NativeTokenDestination is
     INativeTokenDestination,
     SendReentrancyGuard,
     TeleporterTokenDestination,
-    IWrappedNativeToken
+    IWrappedNativeToken,
+    ClaimableNativeWithdrawals
 {
     using Address for address payable;
 
@@ -312,6 +314,26 @@ contract NativeTokenDestination is
         _mintNativeCoin(recipient, amount);
     }
 
+    /**
+     * @dev See {TeleporterTokenDestination-_mintEscrow}
+     *
+     * The escrowed native tokens are held by this contract without being credited as the wrapped native token.
+     */
+    function _mintEscrow(uint256 amount) internal virtual override {
+        _mintNativeCoin(address(this), amount);
+    }
+
+    /**
+     * @dev See {TeleporterTokenDestination-_releaseEscrow}
+     *
+     * Escrowed tokens that can't be sent to the recipient, such as a contract that cannot receive native tokens,
+     * are held to be claimed as the wrapped native token, rather than reverting the claim, which would leave them
+     * in escrow for good.
+     */
+    function _releaseEscrow(address recipient, uint256 amount) internal virtual override {
+        _sendNativeOrHold(recipient, amount);
+    }
+
     /**
      * @dev See {TeleporterTokenDestination-_burn}
      *
@@ -333,7 +355,8 @@ contract NativeTokenDestination is
      *
      * Mints the tokens to this contract, and send them to the recipient contract as a
      * part of the call to {INativeSendAndCallReceiver-receiveTokens} on the recipient contract.
-     * If the call fails, the amount is sent to the fallback recipient.
+     * If the call fails, the amount is sent to the fallback recipient, or held to be
+     * claimed by it if it cannot receive native tokens.
      *
      * Note: If the recipient contract does not properly handle the full msg.value sent,
      * the balance can be locked in the recipient contract. Receiving contracts must make
@@ -362,10 +385,32 @@ contract NativeTokenDestination is
             emit CallSucceeded(message.recipientContract, amount);
         } else {
             emit CallFailed(message.recipientContract, amount);
-            payable(message.fallbackRecipient).sendValue(amount);
+            _sendNativeOrHold(message.fallbackRecipient, amount);
         }
     }
 
+    /**
+     * @dev See {ClaimableNativeWithdrawals-_holdFailedWithdrawal}
+     *
+     * The native tokens remain held by this contract, and are credited as the wrapped
+     * native token when claimed.
+     */
+    function _holdFailedWithdrawal(uint256) internal pure virtual override {
+        return;
+    }
+
+    /**
+     * @dev See {ClaimableNativeWithdrawals-_releaseClaimableWithdrawal}
+     *
+     * Credits the held native tokens to the recipient as the wrapped native token.
+     */
+    function _releaseClaimableWithdrawal(
+        address recipient,
+        uint256 amount
+    ) internal virtual override {
+        _mint(recipient, amount);
+    }
+
     /**
      * @dev Mints coins to the recipient through the NativeMinter precompile.
      */
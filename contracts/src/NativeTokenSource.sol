@@ -15,8 +15,9 @@ import {
 } from "./interfaces/ITeleporterTokenBridge.sol";
 import {IWrappedNativeToken} from "./interfaces/IWrappedNativeToken.sol";
 import {CallUtils} from "./utils/CallUtils.sol";
+import {ClaimableNativeWithdrawals} from "./utils/ClaimableNativeWithdrawals.sol";
 import {SafeWrappedNativeTokenDeposit} from "./utils/SafeWrappedNativeTokenDeposit.sol";
-import {Address} from "@openzeppelin/contracts@4.8.1/utils/Address.sol";
+import {SafeERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/utils/SafeERC20.sol";
 
 /**
  * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
@@ -31,8 +32,12 @@ import {Address} from "@openzeppelin/contracts@4.8.1/utils/Address.sol";
  *
  * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
  */
-contract NativeTokenSource is INativeTokenBridge, TeleporterTokenSource {
-    using Address for address payable;
+contract NativeTokenSource is
+    INativeTokenBridge,
+    TeleporterTokenSource,
+    ClaimableNativeWithdrawals
+{
+    using SafeERC20 for IWrappedNativeToken;
 
     /**
      * @notice The wrapped native token contract that represents the native tokens on this chain.
@@ -95,12 +100,13 @@ contract NativeTokenSource is INativeTokenBridge, TeleporterTokenSource {
     /**
      * @dev See {TeleportTokenSource-_withdraw}
      * Withdraws the wrapped tokens for native tokens,
-     * and sends them to the recipient.
+     * and sends them to the recipient. If the recipient cannot receive
+     * native tokens, they are held to be claimed as the wrapped token.
      */
     function _withdraw(address recipient, uint256 amount) internal virtual override {
         emit TokensWithdrawn(recipient, amount);
         wrappedToken.withdraw(amount);
-        payable(recipient).sendValue(amount);
+        _sendNativeOrHold(recipient, amount);
     }
 
     /**
@@ -109,7 +115,8 @@ contract NativeTokenSource is INativeTokenBridge, TeleporterTokenSource {
      * Send the native tokens to the recipient contract as a part of the call to
      * {INativeSendAndCallReceiver-receiveTokens} on the recipient contract.
      * If the call fails or doesn't spend all of the tokens, the remaining amount is
-     * sent to the fallback recipient.
+     * sent to the fallback recipient, or held to be claimed by it if it cannot receive
+     * native tokens.
      */
     function _handleSendAndCall(
         SingleHopCallMessage memory message,
@@ -134,7 +141,26 @@ contract NativeTokenSource is INativeTokenBridge, TeleporterTokenSource {
             emit CallSucceeded(message.recipientContract, amount);
         } else {
             emit CallFailed(message.recipientContract, amount);
-            payable(message.fallbackRecipient).sendValue(amount);
+            _sendNativeOrHold(message.fallbackRecipient, amount);
         }
     }
+
+    /**
+     * @dev See {ClaimableNativeWithdrawals-_holdFailedWithdrawal}
+     *
+     * Deposits the native tokens back into the wrapped native token contract.
+     */
+    function _holdFailedWithdrawal(uint256 amount) internal virtual override {
+        wrappedToken.deposit{value: amount}();
+    }
+
+    /**
+     * @dev See {ClaimableNativeWithdrawals-_releaseClaimableWithdrawal}
+     */
+    function _releaseClaimableWithdrawal(
+        address recipient,
+        uint256 amount
+    ) internal virtual override {
+        wrappedToken.safeTransfer(recipient, amount);
+    }
 }
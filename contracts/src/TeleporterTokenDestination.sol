@@ -20,6 +20,8 @@ import {
 } from "./interfaces/ITeleporterTokenBridge.sol";
 import {ITeleporterTokenDestination} from "./interfaces/ITeleporterTokenDestination.sol";
 import {SendReentrancyGuard} from "./utils/SendReentrancyGuard.sol";
+import {SendPausable} from "./utils/SendPausable.sol";
+import {RecipientBlocklist} from "./utils/RecipientBlocklist.sol";
 import {TokenScalingUtils} from "./utils/TokenScalingUtils.sol";
 import {IWarpMessenger} from
     "@avalabs/subnet-evm-contracts@1.2.0/contracts/interfaces/IWarpMessenger.sol";
@@ -40,7 +42,9 @@ import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
 abstract contract TeleporterTokenDestination is
     ITeleporterTokenDestination,
     TeleporterOwnerUpgradeable,
-    SendReentrancyGuard
+    SendReentrancyGuard,
+    SendPausable,
+    RecipientBlocklist
 {
     /// @notice The blockchain ID of the chain this contract is deployed on.
     bytes32 public immutable blockchainID;
@@ -92,6 +96,12 @@ abstract contract TeleporterTokenDestination is
      */
     bool public isRegistered;
 
+    /**
+     * @notice The tokens held in escrow for each recipient, received while it was blocked.
+     * Escrowed tokens are minted to this contract, so that they remain part of the supply backed by the source.
+     */
+    mapping(address recipient => uint256 amount) public escrowedBalances;
+
     /**
      * @notice Fixed gas cost for performing a multi-hop transfer on the {sourceBlockchainID},
      * before forwarding to the final destination bridge instance.
@@ -144,6 +154,24 @@ abstract contract TeleporterTokenDestination is
         multiplyOnDestination = multiplyOnDestination_;
     }
 
+    /**
+     * @dev See {SendPausable-_checkSendPauseAccess}
+     *
+     * Checks that the caller is the owner of the contract for send pause access.
+     */
+    function _checkSendPauseAccess() internal view virtual override {
+        _checkOwner();
+    }
+
+    /**
+     * @dev See {RecipientBlocklist-_checkBlocklistAccess}
+     *
+     * Checks that the caller is the owner of the contract for blocklist access.
+     */
+    function _checkBlocklistAccess() internal view virtual override {
+        _checkOwner();
+    }
+
     /**
      * @notice Sends a message to the contract's specified source token bridge instance to register this destination
      * instance. Destination instances must be registered with their source contract's prior to being able to receive
@@ -174,6 +202,18 @@ abstract contract TeleporterTokenDestination is
         );
     }
 
+    /**
+     * @dev See {ITeleporterTokenDestination-claimEscrowedTokens}
+     */
+    function claimEscrowedTokens() external sendNonReentrant {
+        uint256 amount = escrowedBalances[msg.sender];
+        require(amount > 0, "TeleporterTokenDestination: no escrowed tokens");
+        _requireRecipientNotBlocked(msg.sender);
+        escrowedBalances[msg.sender] = 0;
+        emit EscrowClaimed(msg.sender, amount);
+        _releaseEscrow(msg.sender, amount);
+    }
+
     /**
      * @dev Calculates the number of 32-byte words required to fit a payload of a given length.
      * The payloads are padded to have a length that is a multiple of 32.
@@ -196,8 +236,12 @@ abstract contract TeleporterTokenDestination is
      * - {input.recipient} cannot be the zero address
      * - {amount} must be greater than 0
      * - {amount} must be greater than {input.primaryFee}
+     * - sends must not be paused
      */
-    function _send(SendTokensInput calldata input, uint256 amount) internal sendNonReentrant {
+    function _send(
+        SendTokensInput calldata input,
+        uint256 amount
+    ) internal sendNonReentrant whenSendsNotPaused {
         require(input.recipient != address(0), "TeleporterTokenDestination: zero recipient address");
         require(input.requiredGasLimit > 0, "TeleporterTokenDestination: zero required gas limit");
 
@@ -294,11 +338,14 @@ abstract contract TeleporterTokenDestination is
      * @dev Burns the bridged amount, and uses Teleporter to send a cross chain message.
      * Tokens and data can be sent to the same blockchain this bridge instance is deployed on,
      * to another destination bridge instance.
+     * Requirements:
+     *
+     * - sends must not be paused
      */
     function _sendAndCall(
         SendAndCallInput calldata input,
         uint256 amount
-    ) internal sendNonReentrant {
+    ) internal sendNonReentrant whenSendsNotPaused {
         require(
             input.recipientContract != address(0),
             "TeleporterTokenDestination: zero recipient contract address"
@@ -443,10 +490,23 @@ abstract contract TeleporterTokenDestination is
         if (bridgeMessage.messageType == BridgeMessageType.SINGLE_HOP_SEND) {
             SingleHopSendMessage memory payload =
                 abi.decode(bridgeMessage.payload, (SingleHopSendMessage));
+            if (blockedRecipients[payload.recipient]) {
+                _escrow(payload.recipient, payload.amount);
+                return;
+            }
             _withdraw(payload.recipient, payload.amount);
         } else if (bridgeMessage.messageType == BridgeMessageType.SINGLE_HOP_CALL) {
             SingleHopCallMessage memory payload =
                 abi.decode(bridgeMessage.payload, (SingleHopCallMessage));
+            // If either the recipient contract or the fallback recipient is blocked, the call is not made,
+            // since the tokens could otherwise reach the fallback recipient.
+            if (
+                blockedRecipients[payload.recipientContract]
+                    || blockedRecipients[payload.fallbackRecipient]
+            ) {
+                _escrow(payload.fallbackRecipient, payload.amount);
+                return;
+            }
             _handleSendAndCall(payload, payload.amount);
         } else {
             revert("TeleporterTokenDestination: invalid message type");
@@ -468,6 +528,19 @@ abstract contract TeleporterTokenDestination is
      */
     function _withdraw(address recipient, uint256 amount) internal virtual;
 
+    /**
+     * @notice Mints tokens to this contract to be held in escrow.
+     * @param amount The amount of tokens to hold in escrow
+     */
+    function _mintEscrow(uint256 amount) internal virtual;
+
+    /**
+     * @notice Releases tokens held in escrow by this contract to the recipient address.
+     * @param recipient The address to release tokens to
+     * @param amount The amount of tokens to release
+     */
+    function _releaseEscrow(address recipient, uint256 amount) internal virtual;
+
     /**
      * @notice Burns a fee adjusted amount of tokens that the user
      * has deposited to this token bridge instance.
@@ -543,4 +616,13 @@ abstract contract TeleporterTokenDestination is
         // Return the amount in this contract's local denomination and the primary fee.
         return (amount, primaryFee);
     }
+
+    /**
+     * @dev Holds the tokens received for a blocked recipient in escrow, to be claimed once it is unblocked.
+     */
+    function _escrow(address recipient, uint256 amount) private {
+        escrowedBalances[recipient] += amount;
+        emit TokensEscrowed(recipient, amount);
+        _mintEscrow(amount);
+    }
 }
@@ -20,6 +20,7 @@ import {
     RegisterDestinationMessage
 } from "./interfaces/ITeleporterTokenBridge.sol";
 import {SendReentrancyGuard} from "./utils/SendReentrancyGuard.sol";
+import {SendPausable} from "./utils/SendPausable.sol";
 import {TokenScalingUtils} from "./utils/TokenScalingUtils.sol";
 import {IWarpMessenger} from
     "@avalabs/subnet-evm-contracts@1.2.0/contracts/interfaces/IWarpMessenger.sol";
@@ -47,6 +48,22 @@ struct DestinationBridgeSettings {
     bool multiplyOnDestination;
 }
 
+/**
+ * @notice The owner of the source token bridge can limit the amount of tokens sent to each destination
+ * bridge instance within a time window, to bound the outflow to a destination if it is compromised.
+ * @param limit the maximum amount of tokens, in source token denomination, that can be sent to the
+ * destination bridge in each window. A limit of zero means sends are not limited.
+ * @param window the length of each window, in seconds.
+ * @param windowStart the timestamp the current window started at.
+ * @param amountSent the amount of tokens, in source token denomination, sent in the current window.
+ */
+struct RateLimit {
+    uint256 limit;
+    uint256 window;
+    uint256 windowStart;
+    uint256 amountSent;
+}
+
 /**
  * @title TeleporterTokenSource
  * @dev Abstract contract for a Teleporter token bridge that sends tokens to {TeleporterTokenDestination} instances.
@@ -59,7 +76,8 @@ struct DestinationBridgeSettings {
 abstract contract TeleporterTokenSource is
     ITeleporterTokenSource,
     TeleporterOwnerUpgradeable,
-    SendReentrancyGuard
+    SendReentrancyGuard,
+    SendPausable
 {
     /// @notice The blockchain ID of the chain this contract is deployed on.
     bytes32 public immutable blockchainID;
@@ -94,6 +112,16 @@ abstract contract TeleporterTokenSource is
             => mapping(address destinationBridgeAddress => uint256 balance)
     ) public bridgedBalances;
 
+    /**
+     * @notice Tracks the rate limit of tokens sent to each destination bridge instance, set by the owner.
+     * Both sends initiated on this chain and multi-hop transfers routed through it count towards the limit.
+     * @dev (destinationBlockchainID, destinationBridgeAddress) -> rate limit
+     */
+    mapping(
+        bytes32 destinationBlockchainID
+            => mapping(address destinationBridgeAddress => RateLimit rateLimit)
+    ) public rateLimits;
+
     uint256 public constant MAX_TOKEN_MULTIPLIER = 1e18;
 
     /**
@@ -110,6 +138,60 @@ abstract contract TeleporterTokenSource is
         tokenAddress = tokenAddress_;
     }
 
+    /**
+     * @dev See {SendPausable-_checkSendPauseAccess}
+     *
+     * Checks that the caller is the owner of the contract for send pause access.
+     */
+    function _checkSendPauseAccess() internal view virtual override {
+        _checkOwner();
+    }
+
+    /**
+     * @notice Sets the maximum amount of tokens that can be sent to the destination bridge instance
+     * within each window of {window} seconds, and starts a new window. A {limit} of zero removes the
+     * rate limit. The destination does not need to be registered yet.
+     *
+     * Requirements:
+     *
+     * - the caller must be the owner of the contract
+     * - {window} must be greater than zero if {limit} is non-zero
+     */
+    function setRateLimit(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress,
+        uint256 limit,
+        uint256 window
+    ) external onlyOwner {
+        require(limit == 0 || window > 0, "TeleporterTokenSource: zero rate limit window");
+        rateLimits[destinationBlockchainID][destinationBridgeAddress] = RateLimit({
+            limit: limit,
+            window: window,
+            windowStart: block.timestamp,
+            amountSent: 0
+        });
+        emit RateLimitUpdated(destinationBlockchainID, destinationBridgeAddress, limit, window);
+    }
+
+    /**
+     * @notice Returns the amount of tokens, in source token denomination, that can still be sent to the
+     * destination bridge instance in the current window. Returns the maximum uint256 value if sends to
+     * the destination are not rate limited.
+     */
+    function rateLimitRemaining(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress
+    ) public view returns (uint256) {
+        RateLimit memory rateLimit = rateLimits[destinationBlockchainID][destinationBridgeAddress];
+        if (rateLimit.limit == 0) {
+            return type(uint256).max;
+        }
+        if (block.timestamp >= rateLimit.windowStart + rateLimit.window) {
+            return rateLimit.limit;
+        }
+        return rateLimit.limit - rateLimit.amountSent;
+    }
+
     function _registerDestination(
         bytes32 destinationBlockchainID,
         address destinationBridgeAddress,
@@ -180,6 +262,9 @@ abstract contract TeleporterTokenSource is
      * - {input.recipient} cannot be the zero address
      * - {amount} must be greater than 0
      * - {amount} must be greater than {input.primaryFee}
+     * - sends must not be paused, unless {isMultiHop} is true
+     * - {amount} must be within the rate limit of the destination, unless {isMultiHop} is true, in which
+     *   case the tokens are sent to {input.multiHopFallback} instead
      */
     function _send(
         SendTokensInput memory input,
@@ -207,6 +292,9 @@ abstract contract TeleporterTokenSource is
                 return;
             }
         } else {
+            // Sends initiated on this chain are paused, but multi-hop transfers are still routed
+            // so that tokens in flight are not stranded.
+            _requireSendsNotPaused();
             // Require that a single hop transfer does not have a multi-hop fallback recipient.
             require(
                 input.multiHopFallback == address(0),
@@ -290,6 +378,9 @@ abstract contract TeleporterTokenSource is
                 return;
             }
         } else {
+            // Sends initiated on this chain are paused, but multi-hop transfers are still routed
+            // so that tokens in flight are not stranded.
+            _requireSendsNotPaused();
             // Require that a single hop transfer does not have a multi-hop fallback recipient.
             require(
                 input.multiHopFallback == address(0),
@@ -629,7 +720,8 @@ abstract contract TeleporterTokenSource is
      * and adjusting the amount to be sent.
      * @return The scaled amount to be sent to the destination bridge. If zero is returned,
      * the tokens are sent to the fallback recipient. Zero can be returned if the
-     * destination is not registered, needs collateral, or the scaled amount is zero.
+     * destination is not registered, needs collateral, the scaled amount is zero,
+     * or the amount exceeds the rate limit of the destination.
      */
     function _prepareMultiHopRouting(
         bytes32 destinationBlockchainID,
@@ -655,6 +747,11 @@ abstract contract TeleporterTokenSource is
             return 0;
         }
 
+        // Route the tokens to the fallback rather than exceeding the rate limit of the destination.
+        if (!_consumeRateLimit(destinationBlockchainID, destinationBridgeAddress, amount)) {
+            return 0;
+        }
+
         // Increase the balance of the destination bridge by the scaled amount.
         bridgedBalances[destinationBlockchainID][destinationBridgeAddress] += scaledAmount;
 
@@ -695,6 +792,10 @@ abstract contract TeleporterTokenSource is
             destinationSettings.tokenMultiplier, destinationSettings.multiplyOnDestination, amount
         );
         require(scaledAmount > 0, "TeleporterTokenSource: zero scaled amount");
+        require(
+            _consumeRateLimit(destinationBlockchainID, destinationBridgeAddress, amount),
+            "TeleporterTokenSource: rate limit exceeded"
+        );
 
         // Increase the balance of the destination bridge by the scaled amount.
         bridgedBalances[destinationBlockchainID][destinationBridgeAddress] += scaledAmount;
@@ -702,6 +803,31 @@ abstract contract TeleporterTokenSource is
         return (scaledAmount, feeAmount);
     }
 
+    /**
+     * @notice Counts {amount} towards the rate limit of the destination bridge instance, starting a new
+     * window if the current one has ended.
+     * @return Whether {amount} is within the rate limit. If not, nothing is counted.
+     */
+    function _consumeRateLimit(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress,
+        uint256 amount
+    ) private returns (bool) {
+        RateLimit storage rateLimit = rateLimits[destinationBlockchainID][destinationBridgeAddress];
+        if (rateLimit.limit == 0) {
+            return true;
+        }
+        if (block.timestamp >= rateLimit.windowStart + rateLimit.window) {
+            rateLimit.windowStart = block.timestamp;
+            rateLimit.amountSent = 0;
+        }
+        if (amount > rateLimit.limit - rateLimit.amountSent) {
+            return false;
+        }
+        rateLimit.amountSent += amount;
+        return true;
+    }
+
     function _deductSenderBalance(
         bytes32 destinationBlockchainID,
         address destinationBridgeAddress,
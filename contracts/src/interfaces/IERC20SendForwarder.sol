@@ -0,0 +1,70 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {SendTokensInput} from "./ITeleporterTokenBridge.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice A request signed by a token holder to send ERC20 tokens through an {IERC20Bridge} instance,
+ * submitted on their behalf by a relayer that pays the gas of the transaction.
+ * @param from address of the token holder that signed the request
+ * @param bridge address of the {IERC20Bridge} instance to send the tokens through
+ * @param token address of the ERC20 token sent by {bridge}
+ * @param input specifies information for delivery of the tokens
+ * @param amount amount of tokens to send
+ * @param nonce the next nonce of {from} in the forwarder, which prevents the request from being replayed
+ * @param deadline timestamp after which the request can no longer be executed
+ */
+struct SendRequest {
+    address from;
+    address bridge;
+    address token;
+    SendTokensInput input;
+    uint256 amount;
+    uint256 nonce;
+    uint256 deadline;
+}
+
+/**
+ * @notice Interface for a forwarder that executes EIP-712 signed {SendRequest}s, allowing a relayer
+ * to pay the gas of bridging tokens on behalf of the token holder.
+ *
+ * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
+ */
+interface IERC20SendForwarder {
+    /**
+     * @notice Emitted when a signed send request is executed
+     */
+    event SendRequestExecuted(
+        address indexed from, address indexed bridge, uint256 nonce, address relayer
+    );
+
+    /**
+     * @notice Transfers {request.amount} of {request.token}, and {request.input.primaryFee} of
+     * {request.input.primaryFeeTokenAddress}, from {request.from} to the forwarder, and sends them
+     * through {request.bridge}. {request.from} must have approved the forwarder to spend the tokens.
+     * @param request the send request signed by {request.from}
+     * @param signature the EIP-712 signature of {request} by {request.from}
+     */
+    function execute(SendRequest calldata request, bytes calldata signature) external;
+
+    /**
+     * @notice Returns the next nonce of the token holder, which must be included in their next request
+     * @param from address of the token holder
+     */
+    function nonces(address from) external view returns (uint256);
+
+    /**
+     * @notice Returns the EIP-712 digest of the request, which is signed by {request.from}
+     * @param request the send request to hash
+     */
+    function hashSendRequest(SendRequest calldata request) external view returns (bytes32);
+}
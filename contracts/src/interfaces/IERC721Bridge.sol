@@ -0,0 +1,141 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {ITeleporterReceiver} from "@teleporter/ITeleporterReceiver.sol";
+import {SendTokensInput, SendAndCallInput} from "./ITeleporterTokenBridge.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @dev Single hop send message payloads include the recipient address and the bridged token ID.
+ * The destination chain and bridge address are defined by the Teleporter message.
+ * @param tokenURI the URI of the token's metadata on the source chain, which the destination bridge
+ * sets on the token it mints. Empty for messages sent back to the source bridge.
+ */
+struct ERC721SingleHopSendMessage {
+    address recipient;
+    uint256 tokenId;
+    string tokenURI;
+}
+
+/**
+ * @dev Single hop call message payloads include the required information to call
+ * the target contract on the destination chain. The destination chain and bridge
+ * address are defined by the Teleporter message. The message also includes the
+ * blockchain ID and address of the original sender.
+ * @param tokenURI the URI of the token's metadata on the source chain, which the destination bridge
+ * sets on the token it mints. Empty for messages sent back to the source bridge.
+ */
+struct ERC721SingleHopCallMessage {
+    bytes32 sourceBlockchainID;
+    address originSenderAddress;
+    address recipientContract;
+    uint256 tokenId;
+    string tokenURI;
+    bytes recipientPayload;
+    uint256 recipientGasLimit;
+    address fallbackRecipient;
+}
+
+/**
+ * @dev Multi hop send message payloads include the recipient address as well as all
+ * the information the intermediate (source) chain bridge contract needs to route
+ * the send message on to its final destination. There is no secondary fee, since there
+ * is no fungible token on the intermediate chain to pay it from.
+ */
+struct ERC721MultiHopSendMessage {
+    bytes32 destinationBlockchainID;
+    address destinationBridgeAddress;
+    address recipient;
+    uint256 tokenId;
+    uint256 secondaryGasLimit;
+    address multiHopFallback;
+}
+
+/**
+ * @dev Multi hop call message payloads include the required information to call the target contract on the
+ * destination chain, as well as the information the intermediate (source) chain bridge contract needs to route
+ * the call message on to its final destination. The secondaryRequiredGasLimit is the required gas limit set for
+ * the second Teleporter message, and will always be greater than the recipientGasLimit. The source blockchain ID
+ * of the sender is known from the Teleporter message.
+ */
+struct ERC721MultiHopCallMessage {
+    address originSenderAddress;
+    bytes32 destinationBlockchainID;
+    address destinationBridgeAddress;
+    address recipientContract;
+    uint256 tokenId;
+    bytes recipientPayload;
+    uint256 recipientGasLimit;
+    address fallbackRecipient;
+    uint256 secondaryRequiredGasLimit;
+    address multiHopFallback;
+}
+
+/**
+ * @notice Interface for a Teleporter token bridge that sends ERC721 tokens to another chain.
+ * Sends use the same {SendTokensInput} and {SendAndCallInput} parameters as the fungible token
+ * bridges, with the {secondaryFee} required to be zero. Messages between ERC721 bridge contracts are
+ * wrapped in a {BridgeMessage} with the same message types as the fungible token bridges, where
+ * register destination messages have an empty payload since there are no token scaling settings.
+ *
+ * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
+ */
+interface IERC721Bridge is ITeleporterReceiver {
+    /**
+     * @notice Emitted when a token is sent to another chain.
+     */
+    event TokenSent(
+        bytes32 indexed teleporterMessageID,
+        address indexed sender,
+        SendTokensInput input,
+        uint256 tokenId
+    );
+
+    /**
+     * @notice Emitted when a token is sent to another chain with calldata for a contract recipient.
+     */
+    event TokenAndCallSent(
+        bytes32 indexed teleporterMessageID,
+        address indexed sender,
+        SendAndCallInput input,
+        uint256 tokenId
+    );
+
+    /**
+     * @notice Emitted when a token is withdrawn from the token bridge contract.
+     */
+    event TokenWithdrawn(address indexed recipient, uint256 tokenId);
+
+    /**
+     * @notice Emitted when a call to a recipient contract to receive a token succeeds.
+     */
+    event CallSucceeded(address indexed recipientContract, uint256 tokenId);
+
+    /**
+     * @notice Emitted when a call to a recipient contract to receive a token fails, and the token is sent
+     * to a fallback recipient.
+     */
+    event CallFailed(address indexed recipientContract, uint256 tokenId);
+
+    /**
+     * @notice Sends the token to the destination token bridge instance.
+     * @param input specifies information for delivery of the token
+     * @param tokenId ID of the token to send
+     */
+    function send(SendTokensInput calldata input, uint256 tokenId) external;
+
+    /**
+     * @notice Sends the token to a contract on the destination chain, and calls it with the token.
+     * @param input specifies information for delivery of the token and the call
+     * @param tokenId ID of the token to send
+     */
+    function sendAndCall(SendAndCallInput calldata input, uint256 tokenId) external;
+}
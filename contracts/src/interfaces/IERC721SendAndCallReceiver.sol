@@ -0,0 +1,33 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice Interface for a contracts that are called to receive bridged ERC721 tokens.
+ */
+interface IERC721SendAndCallReceiver {
+    /**
+     * @notice Called to receive the given token. The caller approves the recipient to transfer the
+     * token, and sends it to a fallback recipient if the recipient does not.
+     * @param sourceBlockchainID blockchain ID that the transfer originated from
+     * @param originSenderAddress address of the sender that sent the transfer
+     * @param token address of the ERC721 contract of the token
+     * @param tokenId ID of the token to be received
+     * @param payload arbitrary data provided by the caller
+     */
+    function receiveToken(
+        bytes32 sourceBlockchainID,
+        address originSenderAddress,
+        address token,
+        uint256 tokenId,
+        bytes calldata payload
+    ) external;
+}
@@ -0,0 +1,40 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {IERC721Bridge} from "./IERC721Bridge.sol";
+import {SendTokensInput, SendAndCallInput} from "./ITeleporterTokenBridge.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @dev Interface for a "home" or "source" bridge contract that locks ERC721 tokens on its chain
+ * to be bridged to supported destination bridge contracts on other chains.
+ */
+interface IERC721Source is IERC721Bridge {
+    /**
+     * @notice Emitted when a destination is registered with the token bridge.
+     */
+    event DestinationRegistered(
+        bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress
+    );
+
+    /**
+     * @notice Emitted when a token is routed from a multi-hop send message to another chain.
+     */
+    event TokenRouted(bytes32 indexed teleporterMessageID, SendTokensInput input, uint256 tokenId);
+
+    /**
+     * @notice Emitted when a token is routed from a multi-hop send message,
+     * with calldata for a contract recipient, to another chain.
+     */
+    event TokenAndCallRouted(
+        bytes32 indexed teleporterMessageID, SendAndCallInput input, uint256 tokenId
+    );
+}
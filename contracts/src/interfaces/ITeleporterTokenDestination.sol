@@ -18,9 +18,25 @@ import {TeleporterFeeInfo} from "@teleporter/ITeleporterMessenger.sol";
  * for burning that token to redeem the backing asset on the source chain, or briding to other destinations.
  */
 interface ITeleporterTokenDestination is ITeleporterTokenBridge {
+    /**
+     * @notice Emitted when tokens received for a blocked recipient are held in escrow for it.
+     */
+    event TokensEscrowed(address indexed recipient, uint256 amount);
+
+    /**
+     * @notice Emitted when a recipient claims the tokens held in escrow for it.
+     */
+    event EscrowClaimed(address indexed recipient, uint256 amount);
+
     /**
      * @notice Sends a Teleporter message to register the destination instance with its configured source.
      * @param feeInfo the fee asset and amount for the Teleporter message
      */
     function registerWithSource(TeleporterFeeInfo calldata feeInfo) external;
+
+    /**
+     * @notice Claims the tokens held in escrow for the caller, which were received while it was blocked.
+     * The caller must no longer be blocked.
+     */
+    function claimEscrowedTokens() external;
 }
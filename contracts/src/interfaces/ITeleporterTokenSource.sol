@@ -41,6 +41,17 @@ interface ITeleporterTokenSource is ITeleporterTokenBridge {
         bool multiplyOnDestination
     );
 
+    /**
+     * @notice Emitted when the owner sets the rate limit of tokens sent to a destination bridge.
+     * A {limit} of zero means sends to the destination are not limited.
+     */
+    event RateLimitUpdated(
+        bytes32 indexed destinationBlockchainID,
+        address indexed destinationBridgeAddress,
+        uint256 limit,
+        uint256 window
+    );
+
     /**
      * @notice Emitted when tokens are routed from a multi-hop send message to another chain.
      */
@@ -0,0 +1,50 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {SafeERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/utils/SafeERC20.sol";
+import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
+import {Address} from "@openzeppelin/contracts@4.8.1/utils/Address.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice This is a helper to be used in tests, which funds many accounts in a single transaction.
+ * It holds no state or balance between calls, so can be used by any account.
+ */
+contract BatchTransfer {
+    using SafeERC20 for IERC20;
+
+    /**
+     * @notice Transfers {amount} of the native token to each of the {recipients}.
+     * The value sent must equal {amount} times the number of recipients.
+     */
+    function transferNative(address[] calldata recipients, uint256 amount) external payable {
+        require(
+            msg.value == amount * recipients.length, "BatchTransfer: incorrect value"
+        );
+        for (uint256 i; i < recipients.length; ++i) {
+            Address.sendValue(payable(recipients[i]), amount);
+        }
+    }
+
+    /**
+     * @notice Transfers {amount} of {token} from the sender to each of the {recipients}.
+     * The sender must have approved this contract to spend {amount} times the number of recipients.
+     */
+    function transferERC20(
+        IERC20 token,
+        address[] calldata recipients,
+        uint256 amount
+    ) external {
+        for (uint256 i; i < recipients.length; ++i) {
+            token.safeTransferFrom(msg.sender, recipients[i], amount);
+        }
+    }
+}
@@ -0,0 +1,46 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {Create2} from "@openzeppelin/contracts@4.8.1/utils/Create2.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice This is a helper to be used in tests, which deploys contracts with CREATE2. A contract
+ * deployed from the same salt and init code lands at the same address on every chain this deployer
+ * has the same address on. It holds no state, so can be used by any account.
+ */
+contract Create2Deployer {
+    /**
+     * @notice Emitted when a contract is deployed
+     */
+    event Deployed(address indexed deployedAddress, bytes32 indexed salt);
+
+    /**
+     * @notice Deploys {initCode} from {salt}, reverting if a contract is already deployed at its
+     * address. Contracts that set an owner from msg.sender will be owned by this deployer, so must
+     * take their owner as a constructor argument instead.
+     * @return deployedAddress The address of the deployed contract
+     */
+    function deploy(
+        bytes32 salt,
+        bytes calldata initCode
+    ) external returns (address deployedAddress) {
+        deployedAddress = Create2.deploy(0, salt, initCode);
+        emit Deployed(deployedAddress, salt);
+    }
+
+    /**
+     * @notice Returns the address a contract with {initCodeHash} is deployed to from {salt}
+     */
+    function computeAddress(bytes32 salt, bytes32 initCodeHash) external view returns (address) {
+        return Create2.computeAddress(salt, initCodeHash);
+    }
+}
@@ -0,0 +1,93 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {IERC20SendAndCallReceiver} from "../interfaces/IERC20SendAndCallReceiver.sol";
+import {MockDEX} from "./MockDEX.sol";
+import {SafeERC20TransferFrom} from "@teleporter/SafeERC20TransferFrom.sol";
+import {SafeERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/utils/SafeERC20.sol";
+import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice The payload of a sendAndCall to {ExampleERC20SwapReceiver}.
+ * @param tokenOut address of the token to swap the bridged tokens for
+ * @param minAmountOut minimum amount of {tokenOut} to receive, below which the swap fails
+ * @param recipient address that receives the {tokenOut} bought
+ */
+struct SwapPayload {
+    address tokenOut;
+    uint256 minAmountOut;
+    address recipient;
+}
+
+/**
+ * @notice This is an example implementation of {receiveTokens} that swaps the tokens bridged to it
+ * on a {MockDEX}, and sends the tokens bought to the recipient in the payload. If the swap fails,
+ * {receiveTokens} reverts, and the bridge sends the bridged tokens to the fallback recipient of the transfer.
+ */
+contract ExampleERC20SwapReceiver is IERC20SendAndCallReceiver {
+    using SafeERC20 for IERC20;
+
+    /// @notice The DEX that bridged tokens are swapped on.
+    MockDEX public immutable dex;
+
+    /**
+     * @dev Emitted when bridged tokens are swapped.
+     */
+    event TokensSwapped(
+        bytes32 indexed sourceBlockchainID,
+        address indexed originSenderAddress,
+        address tokenIn,
+        uint256 amountIn,
+        address tokenOut,
+        uint256 amountOut,
+        address recipient
+    );
+
+    constructor(address dexAddress) {
+        require(dexAddress != address(0), "ExampleERC20SwapReceiver: zero DEX address");
+        dex = MockDEX(dexAddress);
+    }
+
+    /**
+     * @dev See {IERC20SendAndCallReceiver-receiveTokens}
+     *
+     * The payload is the ABI encoding of a {SwapPayload}.
+     */
+    function receiveTokens(
+        bytes32 sourceBlockchainID,
+        address originSenderAddress,
+        address token,
+        uint256 amount,
+        bytes calldata payload
+    ) external {
+        SwapPayload memory swapPayload = abi.decode(payload, (SwapPayload));
+        require(
+            swapPayload.recipient != address(0), "ExampleERC20SwapReceiver: zero recipient address"
+        );
+
+        uint256 amountIn = SafeERC20TransferFrom.safeTransferFrom(IERC20(token), amount);
+        IERC20(token).safeIncreaseAllowance(address(dex), amountIn);
+        uint256 amountOut = dex.swap(
+            token, swapPayload.tokenOut, amountIn, swapPayload.minAmountOut, swapPayload.recipient
+        );
+
+        emit TokensSwapped(
+            sourceBlockchainID,
+            originSenderAddress,
+            token,
+            amountIn,
+            swapPayload.tokenOut,
+            amountOut,
+            swapPayload.recipient
+        );
+    }
+}
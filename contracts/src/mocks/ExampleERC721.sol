@@ -0,0 +1,25 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {ERC721, ERC721URIStorage} from
+    "@openzeppelin/contracts@4.8.1/token/ERC721/extensions/ERC721URIStorage.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+contract ExampleERC721 is ERC721URIStorage {
+    constructor() ERC721("Mock NFT", "NFT") {}
+
+    /**
+     * @notice Mints the token with the given ID and token URI to {to}. Anyone can mint.
+     */
+    function mint(address to, uint256 tokenId, string calldata uri) external {
+        _mint(to, tokenId);
+        _setTokenURI(tokenId, uri);
+    }
+}
@@ -0,0 +1,23 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {ERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice An ERC20 token whose entire supply is the maximum uint256 value, minted to the deployer,
+ * so that tests can bridge amounts close to the uint256 limit.
+ */
+contract MaxSupplyERC20 is ERC20 {
+    constructor() ERC20("Max Supply Token", "MAX") {
+        _mint(msg.sender, type(uint256).max);
+    }
+}
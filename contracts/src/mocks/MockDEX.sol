@@ -0,0 +1,81 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {SafeERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/utils/SafeERC20.sol";
+import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/ERC20.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice This is a mock DEX to be used in tests, which swaps tokens at fixed rates out of
+ * the liquidity transferred to it. Rates can be set by any account.
+ */
+contract MockDEX {
+    using SafeERC20 for IERC20;
+
+    /// @notice The denominator of swap rates, so that a rate of 1e18 swaps tokens one for one.
+    uint256 public constant RATE_DENOMINATOR = 1e18;
+
+    mapping(address tokenIn => mapping(address tokenOut => uint256 rate)) public rates;
+
+    /**
+     * @dev Emitted when tokens are swapped.
+     */
+    event Swap(
+        address indexed sender,
+        address indexed tokenIn,
+        address indexed tokenOut,
+        uint256 amountIn,
+        uint256 amountOut,
+        address recipient
+    );
+
+    /**
+     * @notice Sets the rate at which {tokenIn} is swapped for {tokenOut}.
+     * @param tokenIn The token sold to the DEX.
+     * @param tokenOut The token bought from the DEX.
+     * @param rate The amount of {tokenOut} bought per {RATE_DENOMINATOR} of {tokenIn}.
+     */
+    function setRate(address tokenIn, address tokenOut, uint256 rate) external {
+        rates[tokenIn][tokenOut] = rate;
+    }
+
+    /**
+     * @notice Returns the amount of {tokenOut} bought for {amountIn} of {tokenIn}.
+     */
+    function getAmountOut(
+        address tokenIn,
+        address tokenOut,
+        uint256 amountIn
+    ) public view returns (uint256) {
+        return amountIn * rates[tokenIn][tokenOut] / RATE_DENOMINATOR;
+    }
+
+    /**
+     * @notice Swaps {amountIn} of {tokenIn} from the caller for {tokenOut}, which is sent to {recipient}.
+     * The caller must have approved the DEX to spend {amountIn} of {tokenIn}.
+     * @return amountOut The amount of {tokenOut} sent to {recipient}.
+     */
+    function swap(
+        address tokenIn,
+        address tokenOut,
+        uint256 amountIn,
+        uint256 minAmountOut,
+        address recipient
+    ) external returns (uint256 amountOut) {
+        amountOut = getAmountOut(tokenIn, tokenOut, amountIn);
+        require(amountOut > 0, "MockDEX: zero output amount");
+        require(amountOut >= minAmountOut, "MockDEX: insufficient output amount");
+
+        IERC20(tokenIn).safeTransferFrom(msg.sender, address(this), amountIn);
+        IERC20(tokenOut).safeTransfer(recipient, amountOut);
+        emit Swap(msg.sender, tokenIn, tokenOut, amountIn, amountOut, recipient);
+    }
+}
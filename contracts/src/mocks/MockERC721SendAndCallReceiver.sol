@@ -0,0 +1,65 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {IERC721SendAndCallReceiver} from "../interfaces/IERC721SendAndCallReceiver.sol";
+import {IERC721} from "@openzeppelin/contracts@4.8.1/token/ERC721/IERC721.sol";
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @notice This is mock implementation of {receiveToken} to be used in tests.
+ * This contract DOES NOT provide a mechanism for accessing the tokens transfered to it.
+ * Real implementations must ensure that tokens are properly handled and not incorrectly locked.
+ */
+contract MockERC721SendAndCallReceiver is IERC721SendAndCallReceiver {
+    mapping(bytes32 blockchainID => mapping(address senderAddress => bool blocked)) public
+        blockedSenders;
+
+    /**
+     * @dev Emitted when receiveToken is called.
+     */
+    event TokenReceived(
+        bytes32 indexed sourceBlockchainID,
+        address indexed originSenderAddress,
+        address token,
+        uint256 tokenId,
+        bytes payload
+    );
+
+    /**
+     * @dev See {IERC721SendAndCallReceiver-receiveToken}
+     */
+    function receiveToken(
+        bytes32 sourceBlockchainID,
+        address originSenderAddress,
+        address token,
+        uint256 tokenId,
+        bytes calldata payload
+    ) external {
+        require(
+            !blockedSenders[sourceBlockchainID][originSenderAddress],
+            "MockERC721SendAndCallReceiver: sender blocked"
+        );
+        emit TokenReceived(sourceBlockchainID, originSenderAddress, token, tokenId, payload);
+
+        require(payload.length > 0, "MockERC721SendAndCallReceiver: empty payload");
+
+        IERC721(token).transferFrom(msg.sender, address(this), tokenId);
+    }
+
+    /**
+     * @notice Block a sender from sending tokens to this contract.
+     * @param blockchainID The blockchain ID of the sender.
+     * @param senderAddress The address of the sender.
+     */
+    function blockSender(bytes32 blockchainID, address senderAddress) external {
+        blockedSenders[blockchainID][senderAddress] = true;
+    }
+}
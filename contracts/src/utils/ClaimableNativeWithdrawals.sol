@@ -0,0 +1,82 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @dev Abstract contract for native token bridges that holds native tokens that could not be sent to their
+ * recipient, such as a contract that cannot receive native tokens, so that they can be claimed later.
+ *
+ * Reverting instead would leave the Teleporter message delivering the tokens failed, and retrying it would fail
+ * the same way. The held tokens are claimed as the wrapped native token, which any recipient can receive.
+ *
+ * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
+ */
+abstract contract ClaimableNativeWithdrawals {
+    /// @notice The native tokens that could not be sent to each recipient, to be claimed as the wrapped native token.
+    mapping(address recipient => uint256 amount) public claimableWithdrawals;
+
+    /// @notice The sum of {claimableWithdrawals}, which is held by this contract without backing any bridged balance.
+    uint256 public totalClaimableWithdrawals;
+
+    /**
+     * @notice Emitted when {amount} native tokens could not be sent to {recipient}, and are held to be claimed.
+     */
+    event WithdrawalFailed(address indexed recipient, uint256 amount);
+
+    /**
+     * @notice Emitted when the {amount} held for {recipient} is claimed.
+     */
+    event WithdrawalClaimed(address indexed recipient, uint256 amount);
+
+    /**
+     * @notice Sends the tokens held for {recipient} to it as the wrapped native token.
+     * Anyone can claim on behalf of a recipient, since the tokens are only ever sent to the recipient.
+     *
+     * Requirements:
+     *
+     * - tokens must be held for {recipient}
+     */
+    function claimWithdrawal(address recipient) external {
+        uint256 amount = claimableWithdrawals[recipient];
+        require(amount > 0, "ClaimableNativeWithdrawals: no claimable withdrawal");
+        claimableWithdrawals[recipient] = 0;
+        totalClaimableWithdrawals -= amount;
+        emit WithdrawalClaimed(recipient, amount);
+        _releaseClaimableWithdrawal(recipient, amount);
+    }
+
+    /**
+     * @dev Sends {amount} native tokens held by this contract to {recipient}. If the transfer fails, the tokens
+     * are held to be claimed by {recipient} instead of reverting.
+     */
+    function _sendNativeOrHold(address recipient, uint256 amount) internal {
+        // solhint-disable-next-line avoid-low-level-calls
+        (bool success,) = payable(recipient).call{value: amount}("");
+        if (success) {
+            return;
+        }
+        claimableWithdrawals[recipient] += amount;
+        totalClaimableWithdrawals += amount;
+        emit WithdrawalFailed(recipient, amount);
+        _holdFailedWithdrawal(amount);
+    }
+
+    /**
+     * @dev Holds {amount} native tokens that could not be sent, such that they can later be released as the
+     * wrapped native token.
+     */
+    function _holdFailedWithdrawal(uint256 amount) internal virtual;
+
+    /**
+     * @dev Sends {amount} of the wrapped native token held for claimable withdrawals to {recipient}.
+     */
+    function _releaseClaimableWithdrawal(address recipient, uint256 amount) internal virtual;
+}
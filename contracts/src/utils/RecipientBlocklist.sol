@@ -0,0 +1,77 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @dev Abstract contract that maintains a list of recipients, such as sanctioned addresses, that a Teleporter
+ * token bridge does not release tokens to.
+ *
+ * The list is empty unless recipients are blocked. Tokens received for a blocked recipient are held in escrow
+ * by the bridge rather than rejected, since rejecting them would leave them stranded in the source, and can be
+ * claimed by the recipient once it is unblocked.
+ *
+ * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
+ */
+abstract contract RecipientBlocklist {
+    /// @notice Whether tokens are released to each recipient, or held in escrow for it.
+    mapping(address recipient => bool blocked) public blockedRecipients;
+
+    /**
+     * @notice Emitted when {recipient} is blocked by {account}.
+     */
+    event RecipientBlocked(address indexed recipient, address indexed account);
+
+    /**
+     * @notice Emitted when {recipient} is unblocked by {account}.
+     */
+    event RecipientUnblocked(address indexed recipient, address indexed account);
+
+    /**
+     * @notice Blocks tokens from being released to {recipient}.
+     *
+     * Requirements:
+     *
+     * - the caller must have blocklist access, as checked by {_checkBlocklistAccess}
+     * - {recipient} cannot be the zero address
+     * - {recipient} must not already be blocked
+     */
+    function blockRecipient(address recipient) external {
+        _checkBlocklistAccess();
+        require(recipient != address(0), "RecipientBlocklist: zero recipient address");
+        require(!blockedRecipients[recipient], "RecipientBlocklist: recipient already blocked");
+        blockedRecipients[recipient] = true;
+        emit RecipientBlocked(recipient, msg.sender);
+    }
+
+    /**
+     * @notice Unblocks {recipient}, allowing it to receive tokens and to claim the tokens held in escrow for it.
+     *
+     * Requirements:
+     *
+     * - the caller must have blocklist access, as checked by {_checkBlocklistAccess}
+     * - {recipient} must be blocked
+     */
+    function unblockRecipient(address recipient) external {
+        _checkBlocklistAccess();
+        require(blockedRecipients[recipient], "RecipientBlocklist: recipient not blocked");
+        blockedRecipients[recipient] = false;
+        emit RecipientUnblocked(recipient, msg.sender);
+    }
+
+    function _requireRecipientNotBlocked(address recipient) internal view {
+        require(!blockedRecipients[recipient], "RecipientBlocklist: recipient blocked");
+    }
+
+    /**
+     * @dev Checks that the caller is allowed to block and unblock recipients. Reverts if not.
+     */
+    function _checkBlocklistAccess() internal view virtual;
+}
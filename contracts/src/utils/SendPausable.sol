@@ -0,0 +1,81 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+/**
+ * THIS IS AN EXAMPLE CONTRACT THAT USES UN-AUDITED CODE.
+ * DO NOT USE THIS CODE IN PRODUCTION.
+ */
+
+/**
+ * @dev Abstract contract that allows Teleporter token bridge {_send} and {_sendAndCall} functions to be paused
+ * as an emergency brake.
+ *
+ * Only sends initiated on this chain are paused. Messages received from other bridge instances are still
+ * processed while sends are paused, including the routing of multi-hop transfers, so that tokens already
+ * in flight are settled rather than stranded.
+ *
+ * @custom:security-contact https://github.com/ava-labs/teleporter-token-bridge/blob/main/SECURITY.md
+ */
+abstract contract SendPausable {
+    /// @notice Whether sends from this token bridge instance are paused.
+    bool public sendsPaused;
+
+    /**
+     * @notice Emitted when sends are paused by {account}.
+     */
+    event SendsPaused(address indexed account);
+
+    /**
+     * @notice Emitted when sends are unpaused by {account}.
+     */
+    event SendsUnpaused(address indexed account);
+
+    // whenSendsNotPaused modifier makes sure sends are not paused.
+    modifier whenSendsNotPaused() {
+        _requireSendsNotPaused();
+        _;
+    }
+
+    /**
+     * @notice Pauses sends from this token bridge instance.
+     *
+     * Requirements:
+     *
+     * - the caller must have send pause access, as checked by {_checkSendPauseAccess}
+     * - sends must not already be paused
+     */
+    function pauseSends() external {
+        _checkSendPauseAccess();
+        require(!sendsPaused, "SendPausable: sends already paused");
+        sendsPaused = true;
+        emit SendsPaused(msg.sender);
+    }
+
+    /**
+     * @notice Unpauses sends from this token bridge instance.
+     *
+     * Requirements:
+     *
+     * - the caller must have send pause access, as checked by {_checkSendPauseAccess}
+     * - sends must be paused
+     */
+    function unpauseSends() external {
+        _checkSendPauseAccess();
+        require(sendsPaused, "SendPausable: sends not paused");
+        sendsPaused = false;
+        emit SendsUnpaused(msg.sender);
+    }
+
+    function _requireSendsNotPaused() internal view {
+        require(!sendsPaused, "SendPausable: sends paused");
+    }
+
+    /**
+     * @dev Checks that the caller is allowed to pause and unpause sends. Reverts if not.
+     */
+    function _checkSendPauseAccess() internal view virtual;
+}
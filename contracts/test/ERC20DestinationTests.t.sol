@@ -92,6 +92,14 @@ contract ERC20DestinationTest is ERC20BridgeTest, TeleporterTokenDestinationTest
         assertEq(MOCK_TOKEN_DECIMALS, res);
     }
 
+    // The storage slots are read directly by the Go proofs package, and must match its constants.
+    function testStorageSlots() public {
+        assertGt(app.totalSupply(), 0);
+        assertEq(uint256(vm.load(address(app), bytes32(uint256(11)))), app.totalSupply());
+        assertTrue(app.isCollateralized());
+        assertEq(uint8(uint256(vm.load(address(app), bytes32(uint256(7))))), 1);
+    }
+
     function testDeployToSameBlockchain() public {
         vm.expectRevert(_formatErrorMessage("cannot deploy to same blockchain as source"));
         new ERC20Destination({
@@ -0,0 +1,395 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {Test} from "forge-std/Test.sol";
+import {ERC721Destination, IWarpMessenger} from "../src/ERC721Destination.sol";
+import {TeleporterRegistry} from "@teleporter/upgrades/TeleporterRegistry.sol";
+import {
+    ITeleporterMessenger,
+    TeleporterMessageInput,
+    TeleporterFeeInfo
+} from "@teleporter/ITeleporterMessenger.sol";
+import {
+    SendTokensInput,
+    BridgeMessageType,
+    BridgeMessage
+} from "../src/interfaces/ITeleporterTokenBridge.sol";
+import {
+    ERC721SingleHopSendMessage,
+    ERC721SingleHopCallMessage,
+    ERC721MultiHopSendMessage
+} from "../src/interfaces/IERC721Bridge.sol";
+import {MockERC721SendAndCallReceiver} from "../src/mocks/MockERC721SendAndCallReceiver.sol";
+
+contract ERC721DestinationTest is Test {
+    bytes32 public constant DEFAULT_SOURCE_BLOCKCHAIN_ID =
+        bytes32(hex"abcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcd");
+    bytes32 public constant DEFAULT_DESTINATION_BLOCKCHAIN_ID =
+        bytes32(hex"1234567812345678123456781234567812345678123456781234567812345678");
+    bytes32 public constant OTHER_BLOCKCHAIN_ID =
+        bytes32(hex"9876987698769876987698769876987698769876987698769876987698769876");
+    address public constant DEFAULT_DESTINATION_ADDRESS = 0xd878229c9c3575F224784DE610911B5607a3ad15;
+    address public constant TOKEN_SOURCE_ADDRESS = 0xd54e3E251b9b0EEd3ed70A858e927bbC2659587d;
+    address public constant DEFAULT_RECIPIENT_ADDRESS = 0xABCDabcdABcDabcDaBCDAbcdABcdAbCdABcDABCd;
+    address public constant DEFAULT_MULTIHOP_FALLBACK_ADDRESS =
+        0x043448b3FE2F24522D9CeB32AD8623c0b6b53E26;
+    address public constant DEFAULT_FALLBACK_RECIPIENT_ADDRESS =
+        0xe69Ea1BAF997002602c0A3D451b2b5c9B7F8E6A1;
+    uint256 public constant DEFAULT_REQUIRED_GAS_LIMIT = 200_000;
+    uint256 public constant DEFAULT_RECIPIENT_GAS_LIMIT = 100_000;
+    address public constant WARP_PRECOMPILE_ADDRESS = 0x0200000000000000000000000000000000000005;
+    address public constant MOCK_TELEPORTER_MESSENGER_ADDRESS =
+        0x644E5b7c5D4Bc8073732CEa72c66e0BB90dFC00f;
+    address public constant MOCK_TELEPORTER_REGISTRY_ADDRESS =
+        0xf9FA4a0c696b659328DDaaBCB46Ae4eBFC9e68e4;
+    bytes32 internal constant _MOCK_MESSAGE_ID =
+        bytes32(hex"1111111111111111111111111111111111111111111111111111111111111111");
+
+    uint256 internal constant _DEFAULT_TOKEN_ID = 1;
+    string internal constant _DEFAULT_TOKEN_URI = "ipfs://token/1";
+
+    ERC721Destination public app;
+
+    event TokenSent(
+        bytes32 indexed teleporterMessageID,
+        address indexed sender,
+        SendTokensInput input,
+        uint256 tokenId
+    );
+    event TokenWithdrawn(address indexed recipient, uint256 tokenId);
+    event CallSucceeded(address indexed recipientContract, uint256 tokenId);
+    event CallFailed(address indexed recipientContract, uint256 tokenId);
+
+    function setUp() public {
+        vm.mockCall(
+            MOCK_TELEPORTER_MESSENGER_ADDRESS,
+            abi.encodeWithSelector(ITeleporterMessenger.sendCrossChainMessage.selector),
+            abi.encode(_MOCK_MESSAGE_ID)
+        );
+        vm.mockCall(
+            WARP_PRECOMPILE_ADDRESS,
+            abi.encodeWithSelector(IWarpMessenger.getBlockchainID.selector),
+            abi.encode(DEFAULT_DESTINATION_BLOCKCHAIN_ID)
+        );
+        vm.mockCall(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            abi.encodeWithSelector(
+                TeleporterRegistry(MOCK_TELEPORTER_REGISTRY_ADDRESS).latestVersion.selector
+            ),
+            abi.encode(1)
+        );
+        vm.mockCall(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            abi.encodeWithSelector(TeleporterRegistry.getVersionFromAddress.selector),
+            abi.encode(1)
+        );
+        vm.mockCall(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            abi.encodeWithSelector(TeleporterRegistry.getLatestTeleporter.selector),
+            abi.encode(ITeleporterMessenger(MOCK_TELEPORTER_MESSENGER_ADDRESS))
+        );
+
+        app = new ERC721Destination(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            MOCK_TELEPORTER_MESSENGER_ADDRESS,
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            "Bridged NFT",
+            "BNFT"
+        );
+    }
+
+    /**
+     * Initialization unit tests
+     */
+    function testZeroSourceBlockchainID() public {
+        vm.expectRevert("ERC721Destination: zero source blockchain ID");
+        new ERC721Destination(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            address(this),
+            bytes32(0),
+            TOKEN_SOURCE_ADDRESS,
+            "Bridged NFT",
+            "BNFT"
+        );
+    }
+
+    function testDeployToSameBlockchain() public {
+        vm.expectRevert("ERC721Destination: cannot deploy to same blockchain as source");
+        new ERC721Destination(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            address(this),
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            "Bridged NFT",
+            "BNFT"
+        );
+    }
+
+    function testRegisterWithSource() public {
+        TeleporterMessageInput memory expectedMessageInput = TeleporterMessageInput({
+            destinationBlockchainID: DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            destinationAddress: TOKEN_SOURCE_ADDRESS,
+            feeInfo: TeleporterFeeInfo({feeTokenAddress: address(0), amount: 0}),
+            requiredGasLimit: app.REGISTER_DESTINATION_REQUIRED_GAS(),
+            allowedRelayerAddresses: new address[](0),
+            message: abi.encode(
+                BridgeMessage({messageType: BridgeMessageType.REGISTER_DESTINATION, payload: ""})
+                )
+        });
+        vm.expectCall(
+            MOCK_TELEPORTER_MESSENGER_ADDRESS,
+            abi.encodeCall(ITeleporterMessenger.sendCrossChainMessage, (expectedMessageInput))
+        );
+        app.registerWithSource(TeleporterFeeInfo({feeTokenAddress: address(0), amount: 0}));
+    }
+
+    /**
+     * Receive unit tests
+     */
+    function testReceiveInvalidSourceChain() public {
+        vm.expectRevert("ERC721Destination: invalid source chain");
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            OTHER_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            _encodeSingleHopSendMessage(DEFAULT_RECIPIENT_ADDRESS)
+        );
+    }
+
+    function testReceiveInvalidTokenSourceAddress() public {
+        vm.expectRevert("ERC721Destination: invalid token source address");
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeSingleHopSendMessage(DEFAULT_RECIPIENT_ADDRESS)
+        );
+    }
+
+    function testReceiveInvalidMessageType() public {
+        vm.expectRevert("ERC721Destination: invalid message type");
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            abi.encode(
+                BridgeMessage({messageType: BridgeMessageType.MULTI_HOP_SEND, payload: ""})
+            )
+        );
+    }
+
+    function testReceiveMintsToken() public {
+        vm.expectEmit(true, true, true, true, address(app));
+        emit TokenWithdrawn(DEFAULT_RECIPIENT_ADDRESS, _DEFAULT_TOKEN_ID);
+        _receiveDefaultToken(DEFAULT_RECIPIENT_ADDRESS);
+        assertEq(app.ownerOf(_DEFAULT_TOKEN_ID), DEFAULT_RECIPIENT_ADDRESS);
+        assertEq(app.tokenURI(_DEFAULT_TOKEN_ID), _DEFAULT_TOKEN_URI);
+        assertTrue(app.isRegistered());
+    }
+
+    function testReceiveSendAndCallSucceeds() public {
+        MockERC721SendAndCallReceiver receiver = new MockERC721SendAndCallReceiver();
+        vm.expectEmit(true, true, true, true, address(app));
+        emit CallSucceeded(address(receiver), _DEFAULT_TOKEN_ID);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            _encodeSingleHopCallMessage(address(receiver), hex"1234")
+        );
+        assertEq(app.ownerOf(_DEFAULT_TOKEN_ID), address(receiver));
+    }
+
+    function testReceiveSendAndCallFailsSendsToFallback() public {
+        MockERC721SendAndCallReceiver receiver = new MockERC721SendAndCallReceiver();
+        vm.expectEmit(true, true, true, true, address(app));
+        emit CallFailed(address(receiver), _DEFAULT_TOKEN_ID);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            _encodeSingleHopCallMessage(address(receiver), "")
+        );
+        assertEq(app.ownerOf(_DEFAULT_TOKEN_ID), DEFAULT_FALLBACK_RECIPIENT_ADDRESS);
+        assertEq(app.getApproved(_DEFAULT_TOKEN_ID), address(0));
+    }
+
+    /**
+     * Send unit tests
+     */
+    function testSendNotTokenOwner() public {
+        _receiveDefaultToken(DEFAULT_RECIPIENT_ADDRESS);
+        vm.expectRevert("ERC721Destination: caller not token owner or approved");
+        app.send(_createDefaultSendTokensInput(), _DEFAULT_TOKEN_ID);
+    }
+
+    function testSendNonZeroSecondaryFee() public {
+        _receiveDefaultToken(address(this));
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        input.secondaryFee = 1;
+        vm.expectRevert("ERC721Destination: non-zero secondary fee");
+        app.send(input, _DEFAULT_TOKEN_ID);
+    }
+
+    function testSendInvalidDestinationBridgeAddress() public {
+        _receiveDefaultToken(address(this));
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        input.destinationBridgeAddress = DEFAULT_DESTINATION_ADDRESS;
+        vm.expectRevert("ERC721Destination: invalid destination bridge address");
+        app.send(input, _DEFAULT_TOKEN_ID);
+    }
+
+    function testSendWhenPaused() public {
+        _receiveDefaultToken(address(this));
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.pauseSends();
+        vm.expectRevert("SendPausable: sends paused");
+        app.send(_createDefaultSendTokensInput(), _DEFAULT_TOKEN_ID);
+    }
+
+    function testSendBurnsToken() public {
+        _receiveDefaultToken(address(this));
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        _expectSendToSource(
+            DEFAULT_REQUIRED_GAS_LIMIT,
+            BridgeMessage({
+                messageType: BridgeMessageType.SINGLE_HOP_SEND,
+                payload: abi.encode(
+                    ERC721SingleHopSendMessage({
+                        recipient: DEFAULT_RECIPIENT_ADDRESS,
+                        tokenId: _DEFAULT_TOKEN_ID,
+                        tokenURI: ""
+                    })
+                    )
+            })
+        );
+        vm.expectEmit(true, true, true, true, address(app));
+        emit TokenSent(_MOCK_MESSAGE_ID, address(this), input, _DEFAULT_TOKEN_ID);
+        app.send(input, _DEFAULT_TOKEN_ID);
+
+        vm.expectRevert("ERC721: invalid token ID");
+        app.ownerOf(_DEFAULT_TOKEN_ID);
+    }
+
+    function testSendApproved() public {
+        _receiveDefaultToken(DEFAULT_RECIPIENT_ADDRESS);
+        vm.prank(DEFAULT_RECIPIENT_ADDRESS);
+        app.approve(address(this), _DEFAULT_TOKEN_ID);
+        app.send(_createDefaultSendTokensInput(), _DEFAULT_TOKEN_ID);
+        assertEq(app.balanceOf(DEFAULT_RECIPIENT_ADDRESS), 0);
+    }
+
+    function testMultiHopSendZeroFallback() public {
+        _receiveDefaultToken(address(this));
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        input.destinationBlockchainID = OTHER_BLOCKCHAIN_ID;
+        input.destinationBridgeAddress = DEFAULT_DESTINATION_ADDRESS;
+        vm.expectRevert("ERC721Destination: zero multi-hop fallback");
+        app.send(input, _DEFAULT_TOKEN_ID);
+    }
+
+    function testMultiHopSend() public {
+        _receiveDefaultToken(address(this));
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        input.destinationBlockchainID = OTHER_BLOCKCHAIN_ID;
+        input.destinationBridgeAddress = DEFAULT_DESTINATION_ADDRESS;
+        input.multiHopFallback = DEFAULT_MULTIHOP_FALLBACK_ADDRESS;
+        _expectSendToSource(
+            app.MULTI_HOP_REQUIRED_GAS(),
+            BridgeMessage({
+                messageType: BridgeMessageType.MULTI_HOP_SEND,
+                payload: abi.encode(
+                    ERC721MultiHopSendMessage({
+                        destinationBlockchainID: OTHER_BLOCKCHAIN_ID,
+                        destinationBridgeAddress: DEFAULT_DESTINATION_ADDRESS,
+                        recipient: DEFAULT_RECIPIENT_ADDRESS,
+                        tokenId: _DEFAULT_TOKEN_ID,
+                        secondaryGasLimit: DEFAULT_REQUIRED_GAS_LIMIT,
+                        multiHopFallback: DEFAULT_MULTIHOP_FALLBACK_ADDRESS
+                    })
+                    )
+            })
+        );
+        app.send(input, _DEFAULT_TOKEN_ID);
+    }
+
+    function _receiveDefaultToken(address recipient) internal {
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            _encodeSingleHopSendMessage(recipient)
+        );
+    }
+
+    function _expectSendToSource(uint256 requiredGasLimit, BridgeMessage memory message) internal {
+        TeleporterMessageInput memory expectedMessageInput = TeleporterMessageInput({
+            destinationBlockchainID: DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            destinationAddress: TOKEN_SOURCE_ADDRESS,
+            feeInfo: TeleporterFeeInfo({feeTokenAddress: address(app), amount: 0}),
+            requiredGasLimit: requiredGasLimit,
+            allowedRelayerAddresses: new address[](0),
+            message: abi.encode(message)
+        });
+        vm.expectCall(
+            MOCK_TELEPORTER_MESSENGER_ADDRESS,
+            abi.encodeCall(ITeleporterMessenger.sendCrossChainMessage, (expectedMessageInput))
+        );
+    }
+
+    function _createDefaultSendTokensInput() internal view returns (SendTokensInput memory) {
+        return SendTokensInput({
+            destinationBlockchainID: DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            destinationBridgeAddress: TOKEN_SOURCE_ADDRESS,
+            recipient: DEFAULT_RECIPIENT_ADDRESS,
+            primaryFeeTokenAddress: address(app),
+            primaryFee: 0,
+            secondaryFee: 0,
+            requiredGasLimit: DEFAULT_REQUIRED_GAS_LIMIT,
+            multiHopFallback: address(0)
+        });
+    }
+
+    function _encodeSingleHopSendMessage(address recipient) internal pure returns (bytes memory) {
+        return abi.encode(
+            BridgeMessage({
+                messageType: BridgeMessageType.SINGLE_HOP_SEND,
+                payload: abi.encode(
+                    ERC721SingleHopSendMessage({
+                        recipient: recipient,
+                        tokenId: _DEFAULT_TOKEN_ID,
+                        tokenURI: _DEFAULT_TOKEN_URI
+                    })
+                    )
+            })
+        );
+    }
+
+    function _encodeSingleHopCallMessage(
+        address recipientContract,
+        bytes memory recipientPayload
+    ) internal view returns (bytes memory) {
+        return abi.encode(
+            BridgeMessage({
+                messageType: BridgeMessageType.SINGLE_HOP_CALL,
+                payload: abi.encode(
+                    ERC721SingleHopCallMessage({
+                        sourceBlockchainID: DEFAULT_SOURCE_BLOCKCHAIN_ID,
+                        originSenderAddress: address(this),
+                        recipientContract: recipientContract,
+                        tokenId: _DEFAULT_TOKEN_ID,
+                        tokenURI: _DEFAULT_TOKEN_URI,
+                        recipientPayload: recipientPayload,
+                        recipientGasLimit: DEFAULT_RECIPIENT_GAS_LIMIT,
+                        fallbackRecipient: DEFAULT_FALLBACK_RECIPIENT_ADDRESS
+                    })
+                    )
+            })
+        );
+    }
+}
@@ -0,0 +1,417 @@
+// (c) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// SPDX-License-Identifier: Ecosystem
+
+pragma solidity 0.8.18;
+
+import {Test} from "forge-std/Test.sol";
+import {ERC721Source, IWarpMessenger} from "../src/ERC721Source.sol";
+import {TeleporterRegistry} from "@teleporter/upgrades/TeleporterRegistry.sol";
+import {
+    ITeleporterMessenger,
+    TeleporterMessageInput,
+    TeleporterFeeInfo
+} from "@teleporter/ITeleporterMessenger.sol";
+import {
+    SendTokensInput,
+    BridgeMessageType,
+    BridgeMessage
+} from "../src/interfaces/ITeleporterTokenBridge.sol";
+import {
+    ERC721SingleHopSendMessage,
+    ERC721SingleHopCallMessage,
+    ERC721MultiHopSendMessage
+} from "../src/interfaces/IERC721Bridge.sol";
+import {ExampleERC721} from "../src/mocks/ExampleERC721.sol";
+import {MockERC721SendAndCallReceiver} from "../src/mocks/MockERC721SendAndCallReceiver.sol";
+
+contract ERC721SourceTest is Test {
+    bytes32 public constant DEFAULT_SOURCE_BLOCKCHAIN_ID =
+        bytes32(hex"abcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcdefabcd");
+    bytes32 public constant DEFAULT_DESTINATION_BLOCKCHAIN_ID =
+        bytes32(hex"1234567812345678123456781234567812345678123456781234567812345678");
+    bytes32 public constant OTHER_BLOCKCHAIN_ID =
+        bytes32(hex"9876987698769876987698769876987698769876987698769876987698769876");
+    address public constant DEFAULT_DESTINATION_ADDRESS = 0xd878229c9c3575F224784DE610911B5607a3ad15;
+    address public constant DEFAULT_RECIPIENT_ADDRESS = 0xABCDabcdABcDabcDaBCDAbcdABcdAbCdABcDABCd;
+    address public constant DEFAULT_MULTIHOP_FALLBACK_ADDRESS =
+        0x043448b3FE2F24522D9CeB32AD8623c0b6b53E26;
+    address public constant DEFAULT_FALLBACK_RECIPIENT_ADDRESS =
+        0xe69Ea1BAF997002602c0A3D451b2b5c9B7F8E6A1;
+    uint256 public constant DEFAULT_REQUIRED_GAS_LIMIT = 200_000;
+    uint256 public constant DEFAULT_RECIPIENT_GAS_LIMIT = 100_000;
+    address public constant WARP_PRECOMPILE_ADDRESS = 0x0200000000000000000000000000000000000005;
+    address public constant MOCK_TELEPORTER_MESSENGER_ADDRESS =
+        0x644E5b7c5D4Bc8073732CEa72c66e0BB90dFC00f;
+    address public constant MOCK_TELEPORTER_REGISTRY_ADDRESS =
+        0xf9FA4a0c696b659328DDaaBCB46Ae4eBFC9e68e4;
+    bytes32 internal constant _MOCK_MESSAGE_ID =
+        bytes32(hex"1111111111111111111111111111111111111111111111111111111111111111");
+
+    uint256 internal constant _DEFAULT_TOKEN_ID = 1;
+    string internal constant _DEFAULT_TOKEN_URI = "ipfs://token/1";
+
+    ERC721Source public app;
+    ExampleERC721 public mockERC721;
+
+    event DestinationRegistered(
+        bytes32 indexed destinationBlockchainID, address indexed destinationBridgeAddress
+    );
+    event TokenSent(
+        bytes32 indexed teleporterMessageID,
+        address indexed sender,
+        SendTokensInput input,
+        uint256 tokenId
+    );
+    event TokenRouted(bytes32 indexed teleporterMessageID, SendTokensInput input, uint256 tokenId);
+    event TokenWithdrawn(address indexed recipient, uint256 tokenId);
+    event CallSucceeded(address indexed recipientContract, uint256 tokenId);
+    event CallFailed(address indexed recipientContract, uint256 tokenId);
+
+    function setUp() public {
+        vm.mockCall(
+            MOCK_TELEPORTER_MESSENGER_ADDRESS,
+            abi.encodeWithSelector(ITeleporterMessenger.sendCrossChainMessage.selector),
+            abi.encode(_MOCK_MESSAGE_ID)
+        );
+        vm.mockCall(
+            WARP_PRECOMPILE_ADDRESS,
+            abi.encodeWithSelector(IWarpMessenger.getBlockchainID.selector),
+            abi.encode(DEFAULT_SOURCE_BLOCKCHAIN_ID)
+        );
+        vm.mockCall(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            abi.encodeWithSelector(
+                TeleporterRegistry(MOCK_TELEPORTER_REGISTRY_ADDRESS).latestVersion.selector
+            ),
+            abi.encode(1)
+        );
+        vm.mockCall(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            abi.encodeWithSelector(TeleporterRegistry.getVersionFromAddress.selector),
+            abi.encode(1)
+        );
+        vm.mockCall(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            abi.encodeWithSelector(TeleporterRegistry.getLatestTeleporter.selector),
+            abi.encode(ITeleporterMessenger(MOCK_TELEPORTER_MESSENGER_ADDRESS))
+        );
+
+        mockERC721 = new ExampleERC721();
+        mockERC721.mint(address(this), _DEFAULT_TOKEN_ID, _DEFAULT_TOKEN_URI);
+        app = new ERC721Source(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            MOCK_TELEPORTER_MESSENGER_ADDRESS,
+            address(mockERC721)
+        );
+    }
+
+    /**
+     * Initialization unit tests
+     */
+    function testZeroTokenAddress() public {
+        vm.expectRevert("ERC721Source: zero token address");
+        new ERC721Source(
+            MOCK_TELEPORTER_REGISTRY_ADDRESS,
+            address(this),
+            address(0)
+        );
+    }
+
+    /**
+     * Registration unit tests
+     */
+    function testRegisterDestination() public {
+        vm.expectEmit(true, true, true, true, address(app));
+        emit DestinationRegistered(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        _registerDestination(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        assertTrue(
+            app.registeredDestinations(
+                DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS
+            )
+        );
+    }
+
+    function testRegisterDestinationTwice() public {
+        _registerDestination(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        vm.expectRevert("ERC721Source: destination already registered");
+        _registerDestination(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+    }
+
+    function testRegisterDestinationSameChain() public {
+        vm.expectRevert("ERC721Source: cannot register bridge on same chain");
+        _registerDestination(DEFAULT_SOURCE_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+    }
+
+    /**
+     * Send unit tests
+     */
+    function testSendToUnregisteredDestination() public {
+        mockERC721.approve(address(app), _DEFAULT_TOKEN_ID);
+        vm.expectRevert("ERC721Source: destination not registered");
+        app.send(_createDefaultSendTokensInput(), _DEFAULT_TOKEN_ID);
+    }
+
+    function testSendNonZeroSecondaryFee() public {
+        _registerDestination(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        input.secondaryFee = 1;
+        mockERC721.approve(address(app), _DEFAULT_TOKEN_ID);
+        vm.expectRevert("ERC721Source: non-zero secondary fee");
+        app.send(input, _DEFAULT_TOKEN_ID);
+    }
+
+    function testSendNotApproved() public {
+        _registerDestination(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        vm.expectRevert("ERC721: caller is not token owner or approved");
+        app.send(_createDefaultSendTokensInput(), _DEFAULT_TOKEN_ID);
+    }
+
+    function testSendWhenPaused() public {
+        _registerDestination(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.pauseSends();
+        mockERC721.approve(address(app), _DEFAULT_TOKEN_ID);
+        vm.expectRevert("SendPausable: sends paused");
+        app.send(_createDefaultSendTokensInput(), _DEFAULT_TOKEN_ID);
+    }
+
+    function testSendLocksToken() public {
+        _registerDestination(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        _expectSingleHopSend(input);
+
+        vm.expectEmit(true, true, true, true, address(app));
+        emit TokenSent(_MOCK_MESSAGE_ID, address(this), input, _DEFAULT_TOKEN_ID);
+        mockERC721.approve(address(app), _DEFAULT_TOKEN_ID);
+        app.send(input, _DEFAULT_TOKEN_ID);
+
+        assertEq(mockERC721.ownerOf(_DEFAULT_TOKEN_ID), address(app));
+        (bytes32 destinationBlockchainID, address destinationBridgeAddress) =
+            app.bridgedTokens(_DEFAULT_TOKEN_ID);
+        assertEq(destinationBlockchainID, DEFAULT_DESTINATION_BLOCKCHAIN_ID);
+        assertEq(destinationBridgeAddress, DEFAULT_DESTINATION_ADDRESS);
+    }
+
+    /**
+     * Receive unit tests
+     */
+    function testReceiveTokenNotBridgedToSender() public {
+        _sendDefaultToken();
+        vm.expectRevert("ERC721Source: token not bridged to sender");
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            OTHER_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeSingleHopSendMessage(DEFAULT_RECIPIENT_ADDRESS, _DEFAULT_TOKEN_ID)
+        );
+    }
+
+    function testReceiveWithdrawsToken() public {
+        _sendDefaultToken();
+        vm.expectEmit(true, true, true, true, address(app));
+        emit TokenWithdrawn(DEFAULT_RECIPIENT_ADDRESS, _DEFAULT_TOKEN_ID);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeSingleHopSendMessage(DEFAULT_RECIPIENT_ADDRESS, _DEFAULT_TOKEN_ID)
+        );
+        assertEq(mockERC721.ownerOf(_DEFAULT_TOKEN_ID), DEFAULT_RECIPIENT_ADDRESS);
+        (bytes32 destinationBlockchainID,) = app.bridgedTokens(_DEFAULT_TOKEN_ID);
+        assertEq(destinationBlockchainID, bytes32(0));
+    }
+
+    function testMultiHopToUnregisteredDestinationSendsToFallback() public {
+        _sendDefaultToken();
+        vm.expectEmit(true, true, true, true, address(app));
+        emit TokenWithdrawn(DEFAULT_MULTIHOP_FALLBACK_ADDRESS, _DEFAULT_TOKEN_ID);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeMultiHopSendMessage(OTHER_BLOCKCHAIN_ID, _DEFAULT_TOKEN_ID)
+        );
+        assertEq(mockERC721.ownerOf(_DEFAULT_TOKEN_ID), DEFAULT_MULTIHOP_FALLBACK_ADDRESS);
+    }
+
+    function testMultiHopRoutesToken() public {
+        _sendDefaultToken();
+        _registerDestination(OTHER_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+
+        SendTokensInput memory input = SendTokensInput({
+            destinationBlockchainID: OTHER_BLOCKCHAIN_ID,
+            destinationBridgeAddress: DEFAULT_DESTINATION_ADDRESS,
+            recipient: DEFAULT_RECIPIENT_ADDRESS,
+            primaryFeeTokenAddress: address(0),
+            primaryFee: 0,
+            secondaryFee: 0,
+            requiredGasLimit: DEFAULT_REQUIRED_GAS_LIMIT,
+            multiHopFallback: DEFAULT_MULTIHOP_FALLBACK_ADDRESS
+        });
+        _expectSingleHopSend(input);
+        vm.expectEmit(true, true, true, true, address(app));
+        emit TokenRouted(_MOCK_MESSAGE_ID, input, _DEFAULT_TOKEN_ID);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeMultiHopSendMessage(OTHER_BLOCKCHAIN_ID, _DEFAULT_TOKEN_ID)
+        );
+
+        assertEq(mockERC721.ownerOf(_DEFAULT_TOKEN_ID), address(app));
+        (bytes32 destinationBlockchainID,) = app.bridgedTokens(_DEFAULT_TOKEN_ID);
+        assertEq(destinationBlockchainID, OTHER_BLOCKCHAIN_ID);
+    }
+
+    function testReceiveSendAndCallSucceeds() public {
+        _sendDefaultToken();
+        MockERC721SendAndCallReceiver receiver = new MockERC721SendAndCallReceiver();
+        vm.expectEmit(true, true, true, true, address(app));
+        emit CallSucceeded(address(receiver), _DEFAULT_TOKEN_ID);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeSingleHopCallMessage(address(receiver), hex"1234")
+        );
+        assertEq(mockERC721.ownerOf(_DEFAULT_TOKEN_ID), address(receiver));
+    }
+
+    function testReceiveSendAndCallFailsSendsToFallback() public {
+        _sendDefaultToken();
+        MockERC721SendAndCallReceiver receiver = new MockERC721SendAndCallReceiver();
+        vm.expectEmit(true, true, true, true, address(app));
+        emit CallFailed(address(receiver), _DEFAULT_TOKEN_ID);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeSingleHopCallMessage(address(receiver), "")
+        );
+        assertEq(mockERC721.ownerOf(_DEFAULT_TOKEN_ID), DEFAULT_FALLBACK_RECIPIENT_ADDRESS);
+        assertEq(mockERC721.getApproved(_DEFAULT_TOKEN_ID), address(0));
+    }
+
+    function _registerDestination(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress
+    ) internal {
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        app.receiveTeleporterMessage(
+            destinationBlockchainID,
+            destinationBridgeAddress,
+            abi.encode(
+                BridgeMessage({messageType: BridgeMessageType.REGISTER_DESTINATION, payload: ""})
+            )
+        );
+    }
+
+    function _sendDefaultToken() internal {
+        _registerDestination(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        mockERC721.approve(address(app), _DEFAULT_TOKEN_ID);
+        app.send(_createDefaultSendTokensInput(), _DEFAULT_TOKEN_ID);
+    }
+
+    function _expectSingleHopSend(SendTokensInput memory input) internal {
+        TeleporterMessageInput memory expectedMessageInput = TeleporterMessageInput({
+            destinationBlockchainID: input.destinationBlockchainID,
+            destinationAddress: input.destinationBridgeAddress,
+            feeInfo: TeleporterFeeInfo({feeTokenAddress: input.primaryFeeTokenAddress, amount: 0}),
+            requiredGasLimit: input.requiredGasLimit,
+            allowedRelayerAddresses: new address[](0),
+            message: abi.encode(
+                BridgeMessage({
+                    messageType: BridgeMessageType.SINGLE_HOP_SEND,
+                    payload: abi.encode(
+                        ERC721SingleHopSendMessage({
+                            recipient: input.recipient,
+                            tokenId: _DEFAULT_TOKEN_ID,
+                            tokenURI: _DEFAULT_TOKEN_URI
+                        })
+                        )
+                })
+                )
+        });
+        vm.expectCall(
+            MOCK_TELEPORTER_MESSENGER_ADDRESS,
+            abi.encodeCall(ITeleporterMessenger.sendCrossChainMessage, (expectedMessageInput))
+        );
+    }
+
+    function _createDefaultSendTokensInput() internal view returns (SendTokensInput memory) {
+        return SendTokensInput({
+            destinationBlockchainID: DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            destinationBridgeAddress: DEFAULT_DESTINATION_ADDRESS,
+            recipient: DEFAULT_RECIPIENT_ADDRESS,
+            primaryFeeTokenAddress: address(mockERC721),
+            primaryFee: 0,
+            secondaryFee: 0,
+            requiredGasLimit: DEFAULT_REQUIRED_GAS_LIMIT,
+            multiHopFallback: address(0)
+        });
+    }
+
+    function _encodeSingleHopSendMessage(
+        address recipient,
+        uint256 tokenId
+    ) internal pure returns (bytes memory) {
+        return abi.encode(
+            BridgeMessage({
+                messageType: BridgeMessageType.SINGLE_HOP_SEND,
+                payload: abi.encode(
+                    ERC721SingleHopSendMessage({
+                        recipient: recipient,
+                        tokenId: tokenId,
+                        tokenURI: ""
+                    })
+                    )
+            })
+        );
+    }
+
+    function _encodeSingleHopCallMessage(
+        address recipientContract,
+        bytes memory recipientPayload
+    ) internal view returns (bytes memory) {
+        return abi.encode(
+            BridgeMessage({
+                messageType: BridgeMessageType.SINGLE_HOP_CALL,
+                payload: abi.encode(
+                    ERC721SingleHopCallMessage({
+                        sourceBlockchainID: DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+                        originSenderAddress: address(this),
+                        recipientContract: recipientContract,
+                        tokenId: _DEFAULT_TOKEN_ID,
+                        tokenURI: "",
+                        recipientPayload: recipientPayload,
+                        recipientGasLimit: DEFAULT_RECIPIENT_GAS_LIMIT,
+                        fallbackRecipient: DEFAULT_FALLBACK_RECIPIENT_ADDRESS
+                    })
+                    )
+            })
+        );
+    }
+
+    function _encodeMultiHopSendMessage(
+        bytes32 destinationBlockchainID,
+        uint256 tokenId
+    ) internal pure returns (bytes memory) {
+        return abi.encode(
+            BridgeMessage({
+                messageType: BridgeMessageType.MULTI_HOP_SEND,
+                payload: abi.encode(
+                    ERC721MultiHopSendMessage({
+                        destinationBlockchainID: destinationBlockchainID,
+                        destinationBridgeAddress: DEFAULT_DESTINATION_ADDRESS,
+                        recipient: DEFAULT_RECIPIENT_ADDRESS,
+                        tokenId: tokenId,
+                        secondaryGasLimit: DEFAULT_REQUIRED_GAS_LIMIT,
+                        multiHopFallback: DEFAULT_MULTIHOP_FALLBACK_ADDRESS
+                    })
+                    )
+            })
+        );
+    }
+}
@@ -12,8 +12,13 @@ import {SendTokensInput, SendAndCallInput} from "../src/interfaces/ITeleporterTo
 abstract contract NativeTokenBridgeTest is TeleporterTokenBridgeTest {
     INativeTokenBridge public nativeTokenBridge;
 
+    // Code of a contract that reverts on every call, so it cannot receive native tokens.
+    bytes public constant NON_PAYABLE_CODE = hex"60006000fd";
+
     event Deposit(address indexed sender, uint256 amount);
     event Withdrawal(address indexed sender, uint256 amount);
+    event WithdrawalFailed(address indexed recipient, uint256 amount);
+    event WithdrawalClaimed(address indexed recipient, uint256 amount);
 
     function testZeroSendAmount() public {
         SendTokensInput memory input = _createDefaultSendTokensInput();
@@ -212,6 +212,17 @@ contract NativeTokenDestinationTest is NativeTokenBridgeTest, TeleporterTokenDes
         assertEq(app.totalSupply(), 2);
     }
 
+    // The storage slots are read directly by the Go proofs package, and must match its constants.
+    function testStorageSlots() public {
+        vm.store(address(app), bytes32(uint256(16)), bytes32(uint256(42)));
+        assertEq(app.totalMinted(), 42);
+
+        app.deposit{value: 2}();
+        assertEq(uint256(vm.load(address(app), bytes32(uint256(2)))), app.totalSupply());
+        assertTrue(app.isCollateralized());
+        assertEq(uint8(uint256(vm.load(address(app), bytes32(uint256(12))))), 1);
+    }
+
     function testTransferToSource() public {
         SendTokensInput memory input = _createDefaultSendTokensInput();
         uint256 amount = _DEFAULT_TRANSFER_AMOUNT;
@@ -426,6 +437,77 @@ contract NativeTokenDestinationTest is NativeTokenBridgeTest, TeleporterTokenDes
         vm.deal(recipient, amount);
     }
 
+    function testReceiveSendAndCallNonPayableFallback() public {
+        uint256 amount = 2;
+        bytes memory payload = hex"DEADBEEF";
+        _setUpExpectedSendAndCall({
+            sourceBlockchainID: DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            originSenderAddress: address(this),
+            recipient: DEFAULT_RECIPIENT_CONTRACT_ADDRESS,
+            amount: amount,
+            payload: payload,
+            gasLimit: DEFAULT_RECIPIENT_GAS_LIMIT,
+            targetHasCode: true,
+            expectSuccess: false
+        });
+        vm.etch(DEFAULT_FALLBACK_RECIPIENT_ADDRESS, NON_PAYABLE_CODE);
+
+        // The native tokens remain held by the contract to be claimed.
+        vm.expectEmit(true, true, true, true, address(app));
+        emit WithdrawalFailed(DEFAULT_FALLBACK_RECIPIENT_ADDRESS, amount);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenDestination.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            _encodeSingleHopCallMessage({
+                sourceBlockchainID: DEFAULT_SOURCE_BLOCKCHAIN_ID,
+                originSenderAddress: address(this),
+                amount: amount,
+                recipientContract: DEFAULT_RECIPIENT_CONTRACT_ADDRESS,
+                recipientPayload: payload,
+                recipientGasLimit: DEFAULT_RECIPIENT_GAS_LIMIT,
+                fallbackRecipient: DEFAULT_FALLBACK_RECIPIENT_ADDRESS
+            })
+        );
+        assertEq(app.claimableWithdrawals(DEFAULT_FALLBACK_RECIPIENT_ADDRESS), amount);
+        assertEq(app.totalClaimableWithdrawals(), amount);
+
+        // The claimed tokens are credited to the fallback recipient as the wrapped native token.
+        vm.expectEmit(true, true, true, true, address(app));
+        emit WithdrawalClaimed(DEFAULT_FALLBACK_RECIPIENT_ADDRESS, amount);
+        vm.expectEmit(true, true, true, true, address(app));
+        emit Transfer(address(0), DEFAULT_FALLBACK_RECIPIENT_ADDRESS, amount);
+        app.claimWithdrawal(DEFAULT_FALLBACK_RECIPIENT_ADDRESS);
+        assertEq(app.claimableWithdrawals(DEFAULT_FALLBACK_RECIPIENT_ADDRESS), 0);
+        assertEq(app.totalClaimableWithdrawals(), 0);
+        assertEq(app.balanceOf(DEFAULT_FALLBACK_RECIPIENT_ADDRESS), amount);
+    }
+
+    function testClaimEscrowedTokensNonPayableRecipient() public {
+        uint256 amount = 200;
+        _blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+        _receiveEscrowedWithdrawal(DEFAULT_RECIPIENT_ADDRESS, amount);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenDestination.unblockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+        vm.etch(DEFAULT_RECIPIENT_ADDRESS, NON_PAYABLE_CODE);
+
+        // The escrowed tokens can't be sent to the recipient, so are held to be claimed rather than reverting.
+        vm.expectEmit(true, true, true, true, address(app));
+        emit EscrowClaimed(DEFAULT_RECIPIENT_ADDRESS, amount);
+        vm.expectEmit(true, true, true, true, address(app));
+        emit WithdrawalFailed(DEFAULT_RECIPIENT_ADDRESS, amount);
+        vm.prank(DEFAULT_RECIPIENT_ADDRESS);
+        tokenDestination.claimEscrowedTokens();
+        assertEq(tokenDestination.escrowedBalances(DEFAULT_RECIPIENT_ADDRESS), 0);
+        assertEq(app.claimableWithdrawals(DEFAULT_RECIPIENT_ADDRESS), amount);
+
+        // The held tokens are credited to the recipient as the wrapped native token.
+        vm.expectEmit(true, true, true, true, address(app));
+        emit WithdrawalClaimed(DEFAULT_RECIPIENT_ADDRESS, amount);
+        app.claimWithdrawal(DEFAULT_RECIPIENT_ADDRESS);
+        assertEq(app.balanceOf(DEFAULT_RECIPIENT_ADDRESS), amount);
+    }
+
     function _setUpExpectedSendAndCall(
         bytes32 sourceBlockchainID,
         address originSenderAddress,
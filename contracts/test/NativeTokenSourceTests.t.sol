@@ -57,6 +57,42 @@ contract NativeTokenSourceTest is NativeTokenBridgeTest, TeleporterTokenSourceTe
         new NativeTokenSource(MOCK_TELEPORTER_REGISTRY_ADDRESS, address(this), address(0));
     }
 
+    function testReceiveWithdrawNonPayableRecipient() public {
+        uint256 amount = 200;
+        _sendSingleHopSendSuccess(amount, 0);
+        vm.etch(DEFAULT_RECIPIENT_ADDRESS, NON_PAYABLE_CODE);
+
+        // The native tokens are deposited back into the wrapped token to be claimed.
+        _checkExpectedWithdrawal(DEFAULT_RECIPIENT_ADDRESS, amount);
+        vm.expectEmit(true, true, true, true, address(app));
+        emit WithdrawalFailed(DEFAULT_RECIPIENT_ADDRESS, amount);
+        vm.expectEmit(true, true, true, true, address(mockWrappedToken));
+        emit Deposit(address(app), amount);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenSource.receiveTeleporterMessage(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeSingleHopSendMessage(amount, DEFAULT_RECIPIENT_ADDRESS)
+        );
+        assertEq(app.claimableWithdrawals(DEFAULT_RECIPIENT_ADDRESS), amount);
+        assertEq(app.totalClaimableWithdrawals(), amount);
+
+        // Anyone can claim the withdrawal, which is sent to the recipient as the wrapped token.
+        vm.expectEmit(true, true, true, true, address(app));
+        emit WithdrawalClaimed(DEFAULT_RECIPIENT_ADDRESS, amount);
+        vm.expectEmit(true, true, true, true, address(mockWrappedToken));
+        emit Transfer(address(app), DEFAULT_RECIPIENT_ADDRESS, amount);
+        app.claimWithdrawal(DEFAULT_RECIPIENT_ADDRESS);
+        assertEq(app.claimableWithdrawals(DEFAULT_RECIPIENT_ADDRESS), 0);
+        assertEq(app.totalClaimableWithdrawals(), 0);
+        assertEq(mockWrappedToken.balanceOf(DEFAULT_RECIPIENT_ADDRESS), amount);
+    }
+
+    function testClaimWithdrawalNoClaimableWithdrawal() public {
+        vm.expectRevert("ClaimableNativeWithdrawals: no claimable withdrawal");
+        app.claimWithdrawal(DEFAULT_RECIPIENT_ADDRESS);
+    }
+
     function _checkExpectedWithdrawal(address recipient, uint256 amount) internal override {
         vm.expectEmit(true, true, true, true, address(tokenSource));
         emit TokensWithdrawn(recipient, amount);
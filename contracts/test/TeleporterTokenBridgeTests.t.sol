@@ -23,6 +23,7 @@ import {
     MultiHopSendMessage,
     MultiHopCallMessage
 } from "../src/interfaces/ITeleporterTokenBridge.sol";
+import {SendPausable} from "../src/utils/SendPausable.sol";
 import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/IERC20.sol";
 import {SafeERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/utils/SafeERC20.sol";
 
@@ -94,6 +95,8 @@ abstract contract TeleporterTokenBridgeTest is Test {
     event CallFailed(address indexed recipientContract, uint256 amount);
     event Transfer(address indexed from, address indexed to, uint256 value);
     event Approval(address indexed owner, address indexed spender, uint256 value);
+    event SendsPaused(address indexed account);
+    event SendsUnpaused(address indexed account);
 
     function testSendZeroRecipient() public {
         SendTokensInput memory input = _createDefaultSendTokensInput();
@@ -164,6 +167,66 @@ abstract contract TeleporterTokenBridgeTest is Test {
         _sendSingleHopCallSuccess(amount, primaryFee);
     }
 
+    function testPauseSends() public {
+        vm.expectEmit(true, true, true, true, address(tokenBridge));
+        emit SendsPaused(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        _pauseSends();
+        assertTrue(SendPausable(address(tokenBridge)).sendsPaused());
+    }
+
+    function testPauseSendsNonOwner() public {
+        vm.expectRevert("Ownable: caller is not the owner");
+        SendPausable(address(tokenBridge)).pauseSends();
+    }
+
+    function testPauseSendsAlreadyPaused() public {
+        _pauseSends();
+        vm.expectRevert("SendPausable: sends already paused");
+        _pauseSends();
+    }
+
+    function testUnpauseSendsNotPaused() public {
+        vm.expectRevert("SendPausable: sends not paused");
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        SendPausable(address(tokenBridge)).unpauseSends();
+    }
+
+    function testUnpauseSendsNonOwner() public {
+        _pauseSends();
+        vm.expectRevert("Ownable: caller is not the owner");
+        SendPausable(address(tokenBridge)).unpauseSends();
+    }
+
+    function testSendWhenPaused() public {
+        _pauseSends();
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        bridgedToken.approve(address(tokenBridge), _DEFAULT_TRANSFER_AMOUNT);
+        vm.expectRevert("SendPausable: sends paused");
+        _send(input, _DEFAULT_TRANSFER_AMOUNT);
+    }
+
+    function testSendAndCallWhenPaused() public {
+        _pauseSends();
+        SendAndCallInput memory input = _createDefaultSendAndCallInput();
+        bridgedToken.approve(address(tokenBridge), _DEFAULT_TRANSFER_AMOUNT);
+        vm.expectRevert("SendPausable: sends paused");
+        _sendAndCall(input, _DEFAULT_TRANSFER_AMOUNT);
+    }
+
+    function testSendAfterUnpause() public {
+        _pauseSends();
+        vm.expectEmit(true, true, true, true, address(tokenBridge));
+        emit SendsUnpaused(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        SendPausable(address(tokenBridge)).unpauseSends();
+        _sendSingleHopSendSuccess(200_000, 100);
+    }
+
+    function _pauseSends() internal {
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        SendPausable(address(tokenBridge)).pauseSends();
+    }
+
     function _initMockTeleporterRegistry() internal {
         vm.mockCall(
             MOCK_TELEPORTER_REGISTRY_ADDRESS,
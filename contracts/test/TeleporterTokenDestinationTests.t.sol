@@ -12,6 +12,7 @@ import {TeleporterRegistry} from "@teleporter/upgrades/TeleporterRegistry.sol";
 import {SendTokensInput, SendAndCallInput} from "../src/interfaces/ITeleporterTokenBridge.sol";
 import {ITeleporterMessenger} from "@teleporter/ITeleporterMessenger.sol";
 import {TokenScalingUtils} from "../src/utils/TokenScalingUtils.sol";
+import {RecipientBlocklist} from "../src/utils/RecipientBlocklist.sol";
 import {
     SendTokensInput,
     SendAndCallInput,
@@ -24,6 +25,11 @@ import {IERC20} from "@openzeppelin/contracts@4.8.1/token/ERC20/IERC20.sol";
 abstract contract TeleporterTokenDestinationTest is TeleporterTokenBridgeTest {
     TeleporterTokenDestination public tokenDestination;
 
+    event TokensEscrowed(address indexed recipient, uint256 amount);
+    event EscrowClaimed(address indexed recipient, uint256 amount);
+    event RecipientBlocked(address indexed recipient, address indexed account);
+    event RecipientUnblocked(address indexed recipient, address indexed account);
+
     function setUp() public virtual {
         vm.mockCall(
             MOCK_TELEPORTER_MESSENGER_ADDRESS,
@@ -452,6 +458,141 @@ abstract contract TeleporterTokenDestinationTest is TeleporterTokenBridgeTest {
         );
     }
 
+    function testBlockRecipient() public {
+        vm.expectEmit(true, true, true, true, address(tokenDestination));
+        emit RecipientBlocked(DEFAULT_RECIPIENT_ADDRESS, MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        _blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+        assertTrue(tokenDestination.blockedRecipients(DEFAULT_RECIPIENT_ADDRESS));
+    }
+
+    function testBlockRecipientNonOwner() public {
+        vm.expectRevert("Ownable: caller is not the owner");
+        tokenDestination.blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+    }
+
+    function testBlockZeroRecipient() public {
+        vm.expectRevert("RecipientBlocklist: zero recipient address");
+        _blockRecipient(address(0));
+    }
+
+    function testBlockRecipientAlreadyBlocked() public {
+        _blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+        vm.expectRevert("RecipientBlocklist: recipient already blocked");
+        _blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+    }
+
+    function testUnblockRecipientNotBlocked() public {
+        vm.expectRevert("RecipientBlocklist: recipient not blocked");
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenDestination.unblockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+    }
+
+    function testUnblockRecipientNonOwner() public {
+        _blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+        vm.expectRevert("Ownable: caller is not the owner");
+        tokenDestination.unblockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+    }
+
+    function testReceiveWithdrawBlockedRecipient() public {
+        uint256 amount = 200;
+        _blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+        _receiveEscrowedWithdrawal(DEFAULT_RECIPIENT_ADDRESS, amount);
+        assertEq(tokenDestination.escrowedBalances(DEFAULT_RECIPIENT_ADDRESS), amount);
+
+        // A second transfer adds to the escrowed balance.
+        _receiveEscrowedWithdrawal(DEFAULT_RECIPIENT_ADDRESS, amount);
+        assertEq(tokenDestination.escrowedBalances(DEFAULT_RECIPIENT_ADDRESS), 2 * amount);
+    }
+
+    function testReceiveSendAndCallBlockedRecipientContract() public {
+        _blockRecipient(DEFAULT_RECIPIENT_CONTRACT_ADDRESS);
+        _receiveEscrowedSendAndCall(2);
+    }
+
+    function testReceiveSendAndCallBlockedFallbackRecipient() public {
+        _blockRecipient(DEFAULT_FALLBACK_RECIPIENT_ADDRESS);
+        _receiveEscrowedSendAndCall(2);
+    }
+
+    function testClaimEscrowedTokensNoBalance() public {
+        vm.expectRevert(_formatErrorMessage("no escrowed tokens"));
+        vm.prank(DEFAULT_RECIPIENT_ADDRESS);
+        tokenDestination.claimEscrowedTokens();
+    }
+
+    function testClaimEscrowedTokensBlocked() public {
+        _blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+        _receiveEscrowedWithdrawal(DEFAULT_RECIPIENT_ADDRESS, 200);
+        vm.expectRevert("RecipientBlocklist: recipient blocked");
+        vm.prank(DEFAULT_RECIPIENT_ADDRESS);
+        tokenDestination.claimEscrowedTokens();
+    }
+
+    function testClaimEscrowedTokensAfterUnblock() public {
+        uint256 amount = 200;
+        _blockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+        _receiveEscrowedWithdrawal(DEFAULT_RECIPIENT_ADDRESS, amount);
+
+        vm.expectEmit(true, true, true, true, address(tokenDestination));
+        emit RecipientUnblocked(DEFAULT_RECIPIENT_ADDRESS, MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenDestination.unblockRecipient(DEFAULT_RECIPIENT_ADDRESS);
+
+        vm.expectEmit(true, true, true, true, address(tokenDestination));
+        emit EscrowClaimed(DEFAULT_RECIPIENT_ADDRESS, amount);
+        vm.prank(DEFAULT_RECIPIENT_ADDRESS);
+        tokenDestination.claimEscrowedTokens();
+        assertEq(tokenDestination.escrowedBalances(DEFAULT_RECIPIENT_ADDRESS), 0);
+
+        // Later transfers are withdrawn to the recipient directly.
+        _checkExpectedWithdrawal(DEFAULT_RECIPIENT_ADDRESS, amount);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenDestination.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            _encodeSingleHopSendMessage(amount, DEFAULT_RECIPIENT_ADDRESS)
+        );
+    }
+
+    function _blockRecipient(address recipient) internal {
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        RecipientBlocklist(address(tokenDestination)).blockRecipient(recipient);
+    }
+
+    function _receiveEscrowedWithdrawal(address recipient, uint256 amount) internal {
+        _setUpMockMint(address(tokenDestination), amount);
+        vm.expectEmit(true, true, true, true, address(tokenDestination));
+        emit TokensEscrowed(recipient, amount);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenDestination.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            TOKEN_SOURCE_ADDRESS,
+            _encodeSingleHopSendMessage(amount, recipient)
+        );
+    }
+
+    function _receiveEscrowedSendAndCall(uint256 amount) internal {
+        bytes memory message = _encodeSingleHopCallMessage({
+            sourceBlockchainID: DEFAULT_SOURCE_BLOCKCHAIN_ID,
+            originSenderAddress: address(this),
+            amount: amount,
+            recipientContract: DEFAULT_RECIPIENT_CONTRACT_ADDRESS,
+            recipientPayload: hex"DEADBEEF",
+            recipientGasLimit: DEFAULT_RECIPIENT_GAS_LIMIT,
+            fallbackRecipient: DEFAULT_FALLBACK_RECIPIENT_ADDRESS
+        });
+
+        // The recipient contract is not called, and the amount is held in escrow for the fallback recipient.
+        _setUpMockMint(address(tokenDestination), amount);
+        vm.expectEmit(true, true, true, true, address(tokenDestination));
+        emit TokensEscrowed(DEFAULT_FALLBACK_RECIPIENT_ADDRESS, amount);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenDestination.receiveTeleporterMessage(
+            DEFAULT_SOURCE_BLOCKCHAIN_ID, TOKEN_SOURCE_ADDRESS, message
+        );
+        assertEq(tokenDestination.escrowedBalances(DEFAULT_FALLBACK_RECIPIENT_ADDRESS), amount);
+    }
+
     function _sendMultiHopSendSuccess(
         bytes32 destinationBlockchainID,
         uint256 amount,
@@ -32,6 +32,13 @@ abstract contract TeleporterTokenSourceTest is TeleporterTokenBridgeTest {
         uint256 remaining
     );
 
+    event RateLimitUpdated(
+        bytes32 indexed destinationBlockchainID,
+        address indexed destinationBridgeAddress,
+        uint256 limit,
+        uint256 window
+    );
+
     function setUp() public virtual {
         vm.mockCall(
             MOCK_TELEPORTER_MESSENGER_ADDRESS,
@@ -724,6 +731,38 @@ abstract contract TeleporterTokenSourceTest is TeleporterTokenBridgeTest {
         assertEq(collateralNeeded, 2);
     }
 
+    // The storage slots are read directly by the Go proofs package, and must match its constants.
+    function testBridgedBalancesStorageSlot() public {
+        bytes32 slot = keccak256(
+            abi.encode(
+                DEFAULT_DESTINATION_ADDRESS,
+                keccak256(abi.encode(DEFAULT_DESTINATION_BLOCKCHAIN_ID, uint256(7)))
+            )
+        );
+        vm.store(address(tokenSource), slot, bytes32(uint256(42)));
+        assertEq(
+            tokenSource.bridgedBalances(
+                DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS
+            ),
+            42
+        );
+    }
+
+    function testCollateralNeededStorageSlot() public {
+        _setUpRegisteredDestination(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 100
+        );
+        bytes32 settingsSlot = keccak256(
+            abi.encode(
+                DEFAULT_DESTINATION_ADDRESS,
+                keccak256(abi.encode(DEFAULT_DESTINATION_BLOCKCHAIN_ID, uint256(6)))
+            )
+        );
+        bytes32 collateralNeeded =
+            vm.load(address(tokenSource), bytes32(uint256(settingsSlot) + 1));
+        assertEq(uint256(collateralNeeded), 100);
+    }
+
     function testRegisterDestinationAlreadyReigstered() public {
         _setUpRegisteredDestination(
             DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 0
@@ -734,6 +773,158 @@ abstract contract TeleporterTokenSourceTest is TeleporterTokenBridgeTest {
         );
     }
 
+    function testSetRateLimit() public {
+        vm.expectEmit(true, true, true, true, address(tokenSource));
+        emit RateLimitUpdated(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 1_000, 3_600
+        );
+        _setRateLimit(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 1_000, 3_600);
+
+        (uint256 limit, uint256 window, uint256 windowStart, uint256 amountSent) =
+            tokenSource.rateLimits(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS);
+        assertEq(limit, 1_000);
+        assertEq(window, 3_600);
+        assertEq(windowStart, block.timestamp);
+        assertEq(amountSent, 0);
+        assertEq(
+            tokenSource.rateLimitRemaining(
+                DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS
+            ),
+            1_000
+        );
+    }
+
+    function testSetRateLimitNonOwner() public {
+        vm.expectRevert("Ownable: caller is not the owner");
+        tokenSource.setRateLimit(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 1_000, 3_600
+        );
+    }
+
+    function testSetRateLimitZeroWindow() public {
+        vm.expectRevert(_formatErrorMessage("zero rate limit window"));
+        _setRateLimit(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 1_000, 0);
+    }
+
+    function testRateLimitRemainingNoLimit() public {
+        assertEq(
+            tokenSource.rateLimitRemaining(
+                DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS
+            ),
+            type(uint256).max
+        );
+
+        // Setting a zero limit removes the rate limit.
+        _setRateLimit(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 1_000, 3_600);
+        _setRateLimit(DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 0, 0);
+        assertEq(
+            tokenSource.rateLimitRemaining(
+                DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS
+            ),
+            type(uint256).max
+        );
+    }
+
+    function testSendExceedsRateLimit() public {
+        uint256 amount = 200_000;
+        _setRateLimit(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, amount, 3_600
+        );
+        _sendSingleHopSendSuccess(amount, 0);
+        assertEq(
+            tokenSource.rateLimitRemaining(
+                DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS
+            ),
+            0
+        );
+
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        _setUpDeposit(1);
+        vm.expectRevert(_formatErrorMessage("rate limit exceeded"));
+        _send(input, 1);
+    }
+
+    function testSendAndCallExceedsRateLimit() public {
+        uint256 amount = 200_000;
+        _setRateLimit(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, amount, 3_600
+        );
+        _sendSingleHopCallSuccess(amount, 0);
+
+        SendAndCallInput memory input = _createDefaultSendAndCallInput();
+        _setUpDeposit(1);
+        vm.expectRevert(_formatErrorMessage("rate limit exceeded"));
+        _sendAndCall(input, 1);
+    }
+
+    function testSendAfterRateLimitWindow() public {
+        uint256 amount = 200_000;
+        _setRateLimit(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, amount, 3_600
+        );
+        _sendSingleHopSendSuccess(amount, 0);
+
+        // Once the window ends, the full limit can be sent again.
+        vm.warp(block.timestamp + 3_600);
+        assertEq(
+            tokenSource.rateLimitRemaining(
+                DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS
+            ),
+            amount
+        );
+        SendTokensInput memory input = _createDefaultSendTokensInput();
+        _setUpExpectedDeposit(amount, 0);
+        _checkExpectedTeleporterCallsForSend(_createSingleHopTeleporterMessageInput(input, amount));
+        vm.expectEmit(true, true, true, true, address(tokenBridge));
+        emit TokensSent(_MOCK_MESSAGE_ID, address(this), input, amount);
+        _send(input, amount);
+    }
+
+    function testSetRateLimitStartsNewWindow() public {
+        uint256 amount = 200_000;
+        _setRateLimit(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, amount, 3_600
+        );
+        _sendSingleHopSendSuccess(amount, 0);
+
+        _setRateLimit(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, amount, 3_600
+        );
+        assertEq(
+            tokenSource.rateLimitRemaining(
+                DEFAULT_DESTINATION_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS
+            ),
+            amount
+        );
+    }
+
+    function testMultiHopExceedsRateLimitSendsToFallback() public {
+        // First send to destination blockchain to increase the bridge balance
+        uint256 amount = 200_000;
+        _sendSingleHopSendSuccess(amount, 0);
+
+        _setUpRegisteredDestination(OTHER_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, 0);
+        _setRateLimit(OTHER_BLOCKCHAIN_ID, DEFAULT_DESTINATION_ADDRESS, amount - 1, 3_600);
+
+        // The multi-hop will not be routed to the OTHER_BLOCKCHAIN_ID destination since the amount
+        // exceeds its rate limit. Instead, the tokens are sent to the multi-hop fallback.
+        _checkExpectedWithdrawal(DEFAULT_MULTIHOP_FALLBACK_ADDRESS, amount);
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenSource.receiveTeleporterMessage(
+            DEFAULT_DESTINATION_BLOCKCHAIN_ID,
+            DEFAULT_DESTINATION_ADDRESS,
+            _encodeMultiHopSendMessage({
+                amount: amount,
+                destinationBlockchainID: OTHER_BLOCKCHAIN_ID,
+                destinationBridgeAddress: DEFAULT_DESTINATION_ADDRESS,
+                recipient: DEFAULT_RECIPIENT_ADDRESS,
+                secondaryFee: 0,
+                secondaryGasLimit: 500_000,
+                multiHopFallback: DEFAULT_MULTIHOP_FALLBACK_ADDRESS
+            })
+        );
+    }
+
     function testSendScaledAmount() public {
         uint256 amount = 100;
         uint256 feeAmount = 1;
@@ -789,6 +980,16 @@ abstract contract TeleporterTokenSourceTest is TeleporterTokenBridgeTest {
         _send(input, amount);
     }
 
+    function _setRateLimit(
+        bytes32 destinationBlockchainID,
+        address destinationBridgeAddress,
+        uint256 limit,
+        uint256 window
+    ) internal {
+        vm.prank(MOCK_TELEPORTER_MESSENGER_ADDRESS);
+        tokenSource.setRateLimit(destinationBlockchainID, destinationBridgeAddress, limit, window);
+    }
+
     function _setUpExpectedSendAndCall(
         bytes32 sourceBlockchainID,
         address originSenderAddress,
@@ -0,0 +1,300 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Field is a value of a bridge contract that is compared with the manifest
+type Field string
+
+const (
+	// The hash of the contract's runtime bytecode, or no code if none is deployed at its address
+	CodeHash Field = "code-hash"
+	// The owner of the contract
+	Owner Field = "owner"
+	// The token source that a destination sends to and receives from, as set by its constructor
+	TokenSource Field = "token-source"
+	// Whether a destination is registered with the token source. Destinations registered with the source that are
+	// not in the manifest are also reported.
+	Registration Field = "registration"
+	// The token multiplier and scaling direction of a destination, as set by its constructor
+	TokenMultiplier       Field = "token-multiplier"
+	MultiplyOnDestination Field = "multiply-on-destination"
+	// The token multiplier and scaling direction of a destination, as registered with the token source
+	RegisteredTokenMultiplier       Field = "registered-token-multiplier"
+	RegisteredMultiplyOnDestination Field = "registered-multiply-on-destination"
+)
+
+// noCode is the actual code hash reported for an address without code
+const noCode = "none"
+
+// Difference is a value of a bridge contract whose state on chain differs from the manifest
+type Difference struct {
+	Bridge   string         `json:"bridge"`
+	Chain    string         `json:"chain"`
+	Contract common.Address `json:"contract"`
+	Field    Field          `json:"field"`
+	Expected string         `json:"expected"`
+	Actual   string         `json:"actual"`
+}
+
+// Diff compares the manifest with the state of its bridge contracts at the latest block of each chain, and returns
+// each difference found, in the order of the manifest's bridges and contracts. An empty result means the
+// deployment matches the manifest. The chains are keyed by name, as returned by events.ConnectChains.
+func Diff(ctx context.Context, chains map[string]*events.Chain, manifest *Manifest) ([]Difference, error) {
+	chainNames := make(map[ids.ID]string, len(chains))
+	for name, chain := range chains {
+		chainNames[chain.BlockchainID] = name
+	}
+
+	var differences []Difference
+	for _, bridgeManifest := range manifest.Bridges {
+		d := &differ{bridge: bridgeManifest.Name}
+		if err := d.diffBridge(ctx, chains, chainNames, &bridgeManifest); err != nil {
+			return nil, fmt.Errorf("failed to compare bridge %s: %w", bridgeManifest.Name, err)
+		}
+		differences = append(differences, d.differences...)
+	}
+	return differences, nil
+}
+
+// Collects the differences of a single bridge
+type differ struct {
+	bridge      string
+	differences []Difference
+}
+
+func (d *differ) add(chain string, contract common.Address, field Field, expected string, actual string) {
+	d.differences = append(d.differences, Difference{
+		Bridge:   d.bridge,
+		Chain:    chain,
+		Contract: contract,
+		Field:    field,
+		Expected: expected,
+		Actual:   actual,
+	})
+}
+
+func (d *differ) diffBridge(
+	ctx context.Context,
+	chains map[string]*events.Chain,
+	chainNames map[ids.ID]string,
+	bridgeManifest *BridgeManifest,
+) error {
+	opts := &bind.CallOpts{Context: ctx}
+	sourceChain := chains[bridgeManifest.Source.Chain]
+	sourceAddress := common.HexToAddress(bridgeManifest.Source.Address)
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(sourceAddress, sourceChain.Client)
+	if err != nil {
+		return err
+	}
+	sourceDeployed, err := d.diffContract(ctx, sourceChain, &bridgeManifest.Source, tokenSource.Owner)
+	if err != nil {
+		return err
+	}
+	// Without a source, only the destinations themselves can be compared
+	var discovered []*bridge.RegisteredDestination
+	if sourceDeployed {
+		discovered, err = bridge.DiscoverDestinations(
+			ctx,
+			bridge.Endpoint{Chain: sourceChain, Address: sourceAddress, Type: bridgeManifest.Source.Type},
+			bridge.DiscoverOptions{FromBlock: sourceChain.StartBlock},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	listed := make(map[*bridge.RegisteredDestination]struct{})
+
+	for i := range bridgeManifest.Destinations {
+		destinationManifest := &bridgeManifest.Destinations[i]
+		chain := chains[destinationManifest.Chain]
+		address := common.HexToAddress(destinationManifest.Address)
+		tokenDestination, err := teleportertokendestination.NewTeleporterTokenDestination(address, chain.Client)
+		if err != nil {
+			return err
+		}
+		deployed, err := d.diffContract(ctx, chain, &destinationManifest.ContractManifest, tokenDestination.Owner)
+		if err != nil {
+			return err
+		}
+		if deployed {
+			if err := d.diffDestination(
+				opts,
+				chain,
+				sourceChain,
+				sourceAddress,
+				tokenDestination,
+				destinationManifest,
+			); err != nil {
+				return err
+			}
+		}
+
+		if !deployed || !sourceDeployed {
+			continue
+		}
+		registration := findRegistration(discovered, chain.BlockchainID, address)
+		if registration == nil {
+			d.add(chain.Name, address, Registration, "registered", "unregistered")
+			continue
+		}
+		listed[registration] = struct{}{}
+		if destinationManifest.tokenMultiplier != nil &&
+			registration.TokenMultiplier.Cmp(destinationManifest.tokenMultiplier) != 0 {
+			d.add(
+				chain.Name,
+				address,
+				RegisteredTokenMultiplier,
+				destinationManifest.tokenMultiplier.String(),
+				registration.TokenMultiplier.String(),
+			)
+		}
+		if destinationManifest.MultiplyOnDestination != nil &&
+			registration.MultiplyOnDestination != *destinationManifest.MultiplyOnDestination {
+			d.add(
+				chain.Name,
+				address,
+				RegisteredMultiplyOnDestination,
+				strconv.FormatBool(*destinationManifest.MultiplyOnDestination),
+				strconv.FormatBool(registration.MultiplyOnDestination),
+			)
+		}
+	}
+
+	// Report the registered destinations missing from the manifest, in the order they were registered
+	for _, destination := range discovered {
+		if _, ok := listed[destination]; ok {
+			continue
+		}
+		chainName, ok := chainNames[destination.BlockchainID]
+		if !ok {
+			chainName = destination.BlockchainID.String()
+		}
+		d.add(chainName, destination.Address, Registration, "unregistered", "registered")
+	}
+	return nil
+}
+
+// Compares the code hash and owner of the contract, and returns whether any code is deployed at its address
+func (d *differ) diffContract(
+	ctx context.Context,
+	chain *events.Chain,
+	contract *ContractManifest,
+	owner func(opts *bind.CallOpts) (common.Address, error),
+) (bool, error) {
+	address := common.HexToAddress(contract.Address)
+	code, err := chain.Client.CodeAt(ctx, address, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to get code of %s on chain %s: %w", address, chain.Name, err)
+	}
+	if len(code) == 0 {
+		d.add(chain.Name, address, CodeHash, expectedOr(contract.CodeHash, "any"), noCode)
+		return false, nil
+	}
+	if contract.CodeHash != "" {
+		expected := common.HexToHash(contract.CodeHash)
+		if actual := crypto.Keccak256Hash(code); actual != expected {
+			d.add(chain.Name, address, CodeHash, expected.Hex(), actual.Hex())
+		}
+	}
+	if contract.Owner != "" {
+		actual, err := owner(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return false, fmt.Errorf("failed to get owner of %s on chain %s: %w", address, chain.Name, err)
+		}
+		if expected := common.HexToAddress(contract.Owner); actual != expected {
+			d.add(chain.Name, address, Owner, expected.Hex(), actual.Hex())
+		}
+	}
+	return true, nil
+}
+
+// Compares the token source and scaling that the destination was constructed with
+func (d *differ) diffDestination(
+	opts *bind.CallOpts,
+	chain *events.Chain,
+	sourceChain *events.Chain,
+	sourceAddress common.Address,
+	tokenDestination *teleportertokendestination.TeleporterTokenDestination,
+	destinationManifest *DestinationManifest,
+) error {
+	address := common.HexToAddress(destinationManifest.Address)
+	sourceBlockchainID, err := tokenDestination.SourceBlockchainID(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get source blockchain ID of %s: %w", address, err)
+	}
+	tokenSourceAddress, err := tokenDestination.TokenSourceAddress(opts)
+	if err != nil {
+		return fmt.Errorf("failed to get token source address of %s: %w", address, err)
+	}
+	if ids.ID(sourceBlockchainID) != sourceChain.BlockchainID || tokenSourceAddress != sourceAddress {
+		d.add(
+			chain.Name,
+			address,
+			TokenSource,
+			fmt.Sprintf("%s on %s", sourceAddress.Hex(), sourceChain.BlockchainID),
+			fmt.Sprintf("%s on %s", tokenSourceAddress.Hex(), ids.ID(sourceBlockchainID)),
+		)
+	}
+
+	if destinationManifest.tokenMultiplier != nil {
+		multiplier, err := tokenDestination.TokenMultiplier(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get token multiplier of %s: %w", address, err)
+		}
+		if multiplier.Cmp(destinationManifest.tokenMultiplier) != 0 {
+			d.add(chain.Name, address, TokenMultiplier, destinationManifest.tokenMultiplier.String(), multiplier.String())
+		}
+	}
+	if destinationManifest.MultiplyOnDestination != nil {
+		multiplyOnDestination, err := tokenDestination.MultiplyOnDestination(opts)
+		if err != nil {
+			return fmt.Errorf("failed to get scaling direction of %s: %w", address, err)
+		}
+		if multiplyOnDestination != *destinationManifest.MultiplyOnDestination {
+			d.add(
+				chain.Name,
+				address,
+				MultiplyOnDestination,
+				strconv.FormatBool(*destinationManifest.MultiplyOnDestination),
+				strconv.FormatBool(multiplyOnDestination),
+			)
+		}
+	}
+	return nil
+}
+
+func findRegistration(
+	discovered []*bridge.RegisteredDestination,
+	blockchainID ids.ID,
+	address common.Address,
+) *bridge.RegisteredDestination {
+	for _, destination := range discovered {
+		if destination.BlockchainID == blockchainID && destination.Address == address {
+			return destination
+		}
+	}
+	return nil
+}
+
+func expectedOr(expected string, unset string) string {
+	if expected == "" {
+		return unset
+	}
+	return expected
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package deployment compares the expected state of a deployment of bridge contracts, as recorded in a manifest
+// at release time, with the state of the contracts on chain, and reports each difference found.
+package deployment
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Manifest is the expected state of the bridge contracts of a deployment
+type Manifest struct {
+	Chains  []events.ChainConfig `json:"chains"`
+	Bridges []BridgeManifest     `json:"bridges"`
+}
+
+// BridgeManifest is the expected state of a token source and each of the destinations registered with it. The
+// destinations registered with the source on chain are discovered from the source chain's start-block, or from
+// its genesis block if unset, and any that are not listed are reported.
+type BridgeManifest struct {
+	Name         string                `json:"name"`
+	Source       ContractManifest      `json:"source"`
+	Destinations []DestinationManifest `json:"destinations"`
+}
+
+// ContractManifest is the expected state of a bridge contract. Unset values are not compared.
+type ContractManifest struct {
+	events.ContractConfig
+	// CodeHash is the keccak256 hash of the contract's runtime bytecode, which includes its immutable
+	// constructor arguments, so differs between deployments of the same contract
+	CodeHash string `json:"code-hash"`
+	// Owner is the owner of the contract, which controls its upgrades, pausing and rate limits
+	Owner string `json:"owner"`
+}
+
+// DestinationManifest is the expected state of a destination, and of its registration with the token source
+type DestinationManifest struct {
+	ContractManifest
+	// TokenMultiplier and MultiplyOnDestination are the scaling of amounts between the source and the destination,
+	// compared with both the destination's constructor arguments and its registration with the source
+	TokenMultiplier       string `json:"token-multiplier"`
+	MultiplyOnDestination *bool  `json:"multiply-on-destination"`
+
+	tokenMultiplier *big.Int
+}
+
+// LoadManifest reads and validates the JSON deployment manifest at the given path
+func LoadManifest(path string) (*Manifest, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(bytes, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// Validate checks that the manifest is well formed
+func (m *Manifest) Validate() error {
+	if len(m.Bridges) == 0 {
+		return fmt.Errorf("no bridges configured")
+	}
+	if err := events.Validate(m.Chains, m.BridgeConfigs()); err != nil {
+		return err
+	}
+	for _, bridge := range m.Bridges {
+		if err := bridge.Source.validate(); err != nil {
+			return fmt.Errorf("invalid source for bridge %s: %w", bridge.Name, err)
+		}
+		for i := range bridge.Destinations {
+			destination := &bridge.Destinations[i]
+			if err := destination.validate(); err != nil {
+				return fmt.Errorf("invalid destination for bridge %s: %w", bridge.Name, err)
+			}
+			destination.tokenMultiplier = nil
+			if destination.TokenMultiplier == "" {
+				continue
+			}
+			multiplier, ok := new(big.Int).SetString(destination.TokenMultiplier, 10)
+			if !ok || multiplier.Sign() <= 0 {
+				return fmt.Errorf(
+					"invalid token multiplier %s for destination %s of bridge %s",
+					destination.TokenMultiplier,
+					destination.Address,
+					bridge.Name,
+				)
+			}
+			destination.tokenMultiplier = multiplier
+		}
+	}
+	return nil
+}
+
+// BridgeConfigs returns the contracts of the manifest's bridges, without their expected state
+func (m *Manifest) BridgeConfigs() []events.BridgeConfig {
+	configs := make([]events.BridgeConfig, 0, len(m.Bridges))
+	for _, bridge := range m.Bridges {
+		config := events.BridgeConfig{
+			Name:   bridge.Name,
+			Source: bridge.Source.ContractConfig,
+		}
+		for _, destination := range bridge.Destinations {
+			config.Destinations = append(config.Destinations, destination.ContractConfig)
+		}
+		configs = append(configs, config)
+	}
+	return configs
+}
+
+func (c *ContractManifest) validate() error {
+	if c.CodeHash != "" && len(common.FromHex(c.CodeHash)) != common.HashLength {
+		return fmt.Errorf("invalid code hash %s of %s", c.CodeHash, c.Address)
+	}
+	if c.Owner != "" && !common.IsHexAddress(c.Owner) {
+		return fmt.Errorf("invalid owner %s of %s", c.Owner, c.Address)
+	}
+	return nil
+}
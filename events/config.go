@@ -0,0 +1,159 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ContractType identifies which token bridge contract is deployed at an address
+type ContractType string
+
+const (
+	ERC20Source            ContractType = "erc20-source"
+	NativeTokenSource      ContractType = "native-source"
+	ERC20Destination       ContractType = "erc20-destination"
+	NativeTokenDestination ContractType = "native-destination"
+)
+
+// IsSource returns true if the contract type is a TeleporterTokenSource
+func (t ContractType) IsSource() bool {
+	return t == ERC20Source || t == NativeTokenSource
+}
+
+// IsDestination returns true if the contract type is a TeleporterTokenDestination
+func (t ContractType) IsDestination() bool {
+	return t == ERC20Destination || t == NativeTokenDestination
+}
+
+// ChainConfig specifies how to connect to a chain that hosts bridge contracts
+type ChainConfig struct {
+	// Name is used to refer to the chain elsewhere in the configuration, and in logs and metrics
+	Name         string `json:"name"`
+	BlockchainID string `json:"blockchain-id"`
+	RPCEndpoint  string `json:"rpc-endpoint"`
+	// StartBlock is the first block to process. If zero, processing starts at the latest block.
+	StartBlock uint64 `json:"start-block"`
+	// ConfirmationDepth is the number of blocks that must be built on a block before its events are processed,
+	// and before sends in it are treated as final. Zero, the default, suits Avalanche chains, whose accepted blocks
+	// are never reverted. Chains or RPC endpoints with weaker finality should set the depth of reorgs they may see.
+	ConfirmationDepth uint64 `json:"confirmation-depth"`
+}
+
+// ContractConfig specifies a single bridge contract deployment
+type ContractConfig struct {
+	Chain   string       `json:"chain"`
+	Address string       `json:"address"`
+	Type    ContractType `json:"type"`
+}
+
+// BridgeConfig specifies a token source and the destinations registered with it
+type BridgeConfig struct {
+	Name         string           `json:"name"`
+	Source       ContractConfig   `json:"source"`
+	Destinations []ContractConfig `json:"destinations"`
+}
+
+// Validate checks that the chains and bridges are well formed,
+// and that every contract refers to a configured chain.
+func Validate(chains []ChainConfig, bridges []BridgeConfig) error {
+	if len(chains) == 0 {
+		return fmt.Errorf("no chains configured")
+	}
+	chainNames := make(map[string]struct{}, len(chains))
+	for _, chain := range chains {
+		if chain.Name == "" {
+			return fmt.Errorf("chain name must be set")
+		}
+		if _, ok := chainNames[chain.Name]; ok {
+			return fmt.Errorf("duplicate chain name %s", chain.Name)
+		}
+		chainNames[chain.Name] = struct{}{}
+		if _, err := ids.FromString(chain.BlockchainID); err != nil {
+			return fmt.Errorf("invalid blockchain ID for chain %s: %w", chain.Name, err)
+		}
+		if chain.RPCEndpoint == "" {
+			return fmt.Errorf("rpc endpoint must be set for chain %s", chain.Name)
+		}
+	}
+
+	bridgeNames := make(map[string]struct{}, len(bridges))
+	for _, bridge := range bridges {
+		if bridge.Name == "" {
+			return fmt.Errorf("bridge name must be set")
+		}
+		if _, ok := bridgeNames[bridge.Name]; ok {
+			return fmt.Errorf("duplicate bridge name %s", bridge.Name)
+		}
+		bridgeNames[bridge.Name] = struct{}{}
+
+		if !bridge.Source.Type.IsSource() {
+			return fmt.Errorf("invalid source type %s for bridge %s", bridge.Source.Type, bridge.Name)
+		}
+		if err := validateContract(bridge.Source, chainNames); err != nil {
+			return fmt.Errorf("invalid source for bridge %s: %w", bridge.Name, err)
+		}
+		for _, destination := range bridge.Destinations {
+			if !destination.Type.IsDestination() {
+				return fmt.Errorf("invalid destination type %s for bridge %s", destination.Type, bridge.Name)
+			}
+			if err := validateContract(destination, chainNames); err != nil {
+				return fmt.Errorf("invalid destination for bridge %s: %w", bridge.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateContract(contract ContractConfig, chainNames map[string]struct{}) error {
+	if _, ok := chainNames[contract.Chain]; !ok {
+		return fmt.Errorf("unknown chain %s", contract.Chain)
+	}
+	if !common.IsHexAddress(contract.Address) {
+		return fmt.Errorf("invalid address %s", contract.Address)
+	}
+	return nil
+}
+
+// ContractsOnChain returns the bridge contracts deployed on the named chain, keyed by address
+func ContractsOnChain(bridges []BridgeConfig, chain string) map[common.Address]ContractInfo {
+	contracts := make(map[common.Address]ContractInfo)
+	for _, bridge := range bridges {
+		for _, contract := range append([]ContractConfig{bridge.Source}, bridge.Destinations...) {
+			if contract.Chain != chain {
+				continue
+			}
+			contracts[common.HexToAddress(contract.Address)] = ContractInfo{
+				Bridge: bridge.Name,
+				Type:   contract.Type,
+			}
+		}
+	}
+	return contracts
+}
+
+// ParseBridgeAmounts parses a map of bridge names to non-negative decimal integer amounts, such as per bridge
+// thresholds, checking that each is set for one of the named bridges
+func ParseBridgeAmounts(
+	amounts map[string]string,
+	bridgeNames map[string]struct{},
+	description string,
+) (map[string]*big.Int, error) {
+	parsed := make(map[string]*big.Int, len(amounts))
+	for bridge, amount := range amounts {
+		if _, ok := bridgeNames[bridge]; !ok {
+			return nil, fmt.Errorf("%s set for unknown bridge %s", description, bridge)
+		}
+		value, ok := new(big.Int).SetString(amount, 10)
+		if !ok || value.Sign() < 0 {
+			return nil, fmt.Errorf("invalid %s %s for bridge %s", description, amount, bridge)
+		}
+		parsed[bridge] = value
+	}
+	return parsed, nil
+}
@@ -0,0 +1,374 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/core/types"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var errUnhandledEvent = errors.New("unhandled event")
+
+// EventType is the name of a bridge contract event
+type EventType string
+
+const (
+	TokensSent            EventType = "TokensSent"
+	TokensAndCallSent     EventType = "TokensAndCallSent"
+	TokensRouted          EventType = "TokensRouted"
+	TokensAndCallRouted   EventType = "TokensAndCallRouted"
+	TokensWithdrawn       EventType = "TokensWithdrawn"
+	CallSucceeded         EventType = "CallSucceeded"
+	CallFailed            EventType = "CallFailed"
+	TokensEscrowed        EventType = "TokensEscrowed"
+	EscrowClaimed         EventType = "EscrowClaimed"
+	CollateralAdded       EventType = "CollateralAdded"
+	DestinationRegistered EventType = "DestinationRegistered"
+	ReportBurnedTxFees    EventType = "ReportBurnedTxFees"
+)
+
+// IsSend returns true for events that emit a new Teleporter message carrying tokens
+func (t EventType) IsSend() bool {
+	return t == TokensSent || t == TokensAndCallSent || t == TokensRouted || t == TokensAndCallRouted
+}
+
+// IsDelivery returns true for events that mark the delivery of tokens to a recipient or the next hop
+func (t EventType) IsDelivery() bool {
+	return t == TokensWithdrawn || t == CallSucceeded || t == CallFailed || t == TokensEscrowed ||
+		t == TokensRouted || t == TokensAndCallRouted
+}
+
+// Event is a bridge contract event in a form common to all of the bridge contract types.
+// Fields that do not apply to the event type are left as their zero values.
+type Event struct {
+	Type     EventType
+	Bridge   string
+	Chain    string
+	Contract common.Address
+
+	BlockNumber uint64
+	BlockTime   time.Time
+	TxHash      common.Hash
+	LogIndex    uint
+
+	// TeleporterMessageID is the ID of the Teleporter message sent by the event, if any.
+	TeleporterMessageID ids.ID
+	// ReceivedMessageID is the ID of the Teleporter message delivered in the same transaction, if any.
+	ReceivedMessageID  ids.ID
+	SourceBlockchainID ids.ID
+	// SourceBridgeAddress is the bridge contract that sent the delivered message, if any.
+	SourceBridgeAddress common.Address
+
+	DestinationBlockchainID  ids.ID
+	DestinationBridgeAddress common.Address
+	Sender                   common.Address
+	Recipient                common.Address
+	Amount                   *big.Int
+
+	PrimaryFeeTokenAddress common.Address
+	PrimaryFee             *big.Int
+	SecondaryFee           *big.Int
+	RequiredGasLimit       *big.Int
+}
+
+// ContractInfo identifies the bridge contract that emitted a log
+type ContractInfo struct {
+	Bridge string
+	Type   ContractType
+}
+
+// Decoder decodes raw logs emitted by the bridge contracts into Events
+type Decoder struct {
+	source      *teleportertokensource.TeleporterTokenSourceFilterer
+	destination *teleportertokendestination.TeleporterTokenDestinationFilterer
+	native      *nativetokendestination.NativeTokenDestinationFilterer
+	teleporter  *teleportermessenger.TeleporterMessengerFilterer
+
+	sourceABI                *abi.ABI
+	destinationABI           *abi.ABI
+	nativeABI                *abi.ABI
+	receiveCrossChainMessage common.Hash
+}
+
+// NewDecoder creates a decoder for all of the bridge contract events
+func NewDecoder() (*Decoder, error) {
+	var (
+		d   Decoder
+		err error
+	)
+	if d.source, err = teleportertokensource.NewTeleporterTokenSourceFilterer(common.Address{}, nil); err != nil {
+		return nil, err
+	}
+	if d.destination, err = teleportertokendestination.NewTeleporterTokenDestinationFilterer(
+		common.Address{},
+		nil,
+	); err != nil {
+		return nil, err
+	}
+	if d.native, err = nativetokendestination.NewNativeTokenDestinationFilterer(common.Address{}, nil); err != nil {
+		return nil, err
+	}
+	if d.teleporter, err = teleportermessenger.NewTeleporterMessengerFilterer(common.Address{}, nil); err != nil {
+		return nil, err
+	}
+	if d.sourceABI, err = teleportertokensource.TeleporterTokenSourceMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+	if d.destinationABI, err = teleportertokendestination.TeleporterTokenDestinationMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+	if d.nativeABI, err = nativetokendestination.NativeTokenDestinationMetaData.GetAbi(); err != nil {
+		return nil, err
+	}
+	teleporterABI, err := teleportermessenger.TeleporterMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	d.receiveCrossChainMessage = teleporterABI.Events["ReceiveCrossChainMessage"].ID
+	return &d, nil
+}
+
+// Decode decodes a log emitted by a bridge contract of the given type.
+// Returns false if the log is not a bridge event that the decoder handles.
+func (d *Decoder) Decode(log types.Log, contract ContractInfo) (*Event, bool, error) {
+	if len(log.Topics) == 0 {
+		return nil, false, nil
+	}
+	eventABI := d.sourceABI
+	if contract.Type.IsDestination() {
+		eventABI = d.destinationABI
+		if contract.Type == NativeTokenDestination {
+			eventABI = d.nativeABI
+		}
+	}
+	abiEvent, err := eventABI.EventByID(log.Topics[0])
+	if err != nil {
+		// Not an event defined by the contract's ABI
+		return nil, false, nil
+	}
+
+	event := &Event{
+		Type:        EventType(abiEvent.Name),
+		Bridge:      contract.Bridge,
+		Contract:    log.Address,
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+	}
+	if contract.Type.IsSource() {
+		err = d.decodeSourceEvent(log, event)
+	} else {
+		err = d.decodeDestinationEvent(log, event)
+	}
+	if err == errUnhandledEvent {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to decode %s event: %w", event.Type, err)
+	}
+	return event, true, nil
+}
+
+// ReceivedMessage identifies a Teleporter message delivered to a bridge contract
+type ReceivedMessage struct {
+	MessageID           ids.ID
+	SourceBlockchainID  ids.ID
+	OriginSenderAddress common.Address
+}
+
+// DecodeReceivedMessage returns the Teleporter message delivered in the transaction with the given logs, if any.
+func (d *Decoder) DecodeReceivedMessage(logs []*types.Log) (ReceivedMessage, bool) {
+	for _, log := range logs {
+		if len(log.Topics) == 0 || log.Topics[0] != d.receiveCrossChainMessage {
+			continue
+		}
+		received, err := d.teleporter.ParseReceiveCrossChainMessage(*log)
+		if err != nil {
+			continue
+		}
+		return ReceivedMessage{
+			MessageID:           ids.ID(received.MessageID),
+			SourceBlockchainID:  ids.ID(received.SourceBlockchainID),
+			OriginSenderAddress: received.Message.OriginSenderAddress,
+		}, true
+	}
+	return ReceivedMessage{}, false
+}
+
+func (d *Decoder) decodeSourceEvent(log types.Log, event *Event) error {
+	switch event.Type {
+	case TokensSent:
+		e, err := d.source.ParseTokensSent(log)
+		if err != nil {
+			return err
+		}
+		event.TeleporterMessageID = e.TeleporterMessageID
+		event.Sender = e.Sender
+		event.Amount = e.Amount
+		setSendTokensInput(event, teleportertokendestination.SendTokensInput(e.Input))
+	case TokensAndCallSent:
+		e, err := d.source.ParseTokensAndCallSent(log)
+		if err != nil {
+			return err
+		}
+		event.TeleporterMessageID = e.TeleporterMessageID
+		event.Sender = e.Sender
+		event.Amount = e.Amount
+		setSendAndCallInput(event, teleportertokendestination.SendAndCallInput(e.Input))
+	case TokensRouted:
+		e, err := d.source.ParseTokensRouted(log)
+		if err != nil {
+			return err
+		}
+		event.TeleporterMessageID = e.TeleporterMessageID
+		event.Amount = e.Amount
+		setSendTokensInput(event, teleportertokendestination.SendTokensInput(e.Input))
+	case TokensAndCallRouted:
+		e, err := d.source.ParseTokensAndCallRouted(log)
+		if err != nil {
+			return err
+		}
+		event.TeleporterMessageID = e.TeleporterMessageID
+		event.Amount = e.Amount
+		setSendAndCallInput(event, teleportertokendestination.SendAndCallInput(e.Input))
+	case TokensWithdrawn:
+		e, err := d.source.ParseTokensWithdrawn(log)
+		if err != nil {
+			return err
+		}
+		event.Recipient = e.Recipient
+		event.Amount = e.Amount
+	case CallSucceeded:
+		e, err := d.source.ParseCallSucceeded(log)
+		if err != nil {
+			return err
+		}
+		event.Recipient = e.RecipientContract
+		event.Amount = e.Amount
+	case CallFailed:
+		e, err := d.source.ParseCallFailed(log)
+		if err != nil {
+			return err
+		}
+		event.Recipient = e.RecipientContract
+		event.Amount = e.Amount
+	case CollateralAdded:
+		e, err := d.source.ParseCollateralAdded(log)
+		if err != nil {
+			return err
+		}
+		event.DestinationBlockchainID = e.DestinationBlockchainID
+		event.DestinationBridgeAddress = e.DestinationBridgeAddress
+		event.Amount = e.Amount
+	case DestinationRegistered:
+		e, err := d.source.ParseDestinationRegistered(log)
+		if err != nil {
+			return err
+		}
+		event.DestinationBlockchainID = e.DestinationBlockchainID
+		event.DestinationBridgeAddress = e.DestinationBridgeAddress
+	default:
+		return errUnhandledEvent
+	}
+	return nil
+}
+
+func (d *Decoder) decodeDestinationEvent(log types.Log, event *Event) error {
+	switch event.Type {
+	case TokensSent:
+		e, err := d.destination.ParseTokensSent(log)
+		if err != nil {
+			return err
+		}
+		event.TeleporterMessageID = e.TeleporterMessageID
+		event.Sender = e.Sender
+		event.Amount = e.Amount
+		setSendTokensInput(event, e.Input)
+	case TokensAndCallSent:
+		e, err := d.destination.ParseTokensAndCallSent(log)
+		if err != nil {
+			return err
+		}
+		event.TeleporterMessageID = e.TeleporterMessageID
+		event.Sender = e.Sender
+		event.Amount = e.Amount
+		setSendAndCallInput(event, e.Input)
+	case TokensWithdrawn:
+		e, err := d.destination.ParseTokensWithdrawn(log)
+		if err != nil {
+			return err
+		}
+		event.Recipient = e.Recipient
+		event.Amount = e.Amount
+	case CallSucceeded:
+		e, err := d.destination.ParseCallSucceeded(log)
+		if err != nil {
+			return err
+		}
+		event.Recipient = e.RecipientContract
+		event.Amount = e.Amount
+	case CallFailed:
+		e, err := d.destination.ParseCallFailed(log)
+		if err != nil {
+			return err
+		}
+		event.Recipient = e.RecipientContract
+		event.Amount = e.Amount
+	case TokensEscrowed:
+		e, err := d.destination.ParseTokensEscrowed(log)
+		if err != nil {
+			return err
+		}
+		event.Recipient = e.Recipient
+		event.Amount = e.Amount
+	case EscrowClaimed:
+		e, err := d.destination.ParseEscrowClaimed(log)
+		if err != nil {
+			return err
+		}
+		event.Recipient = e.Recipient
+		event.Amount = e.Amount
+	case ReportBurnedTxFees:
+		e, err := d.native.ParseReportBurnedTxFees(log)
+		if err != nil {
+			return err
+		}
+		event.TeleporterMessageID = e.TeleporterMessageID
+		event.Amount = e.FeesBurned
+	default:
+		return errUnhandledEvent
+	}
+	return nil
+}
+
+func setSendTokensInput(event *Event, input teleportertokendestination.SendTokensInput) {
+	event.DestinationBlockchainID = input.DestinationBlockchainID
+	event.DestinationBridgeAddress = input.DestinationBridgeAddress
+	event.Recipient = input.Recipient
+	event.PrimaryFeeTokenAddress = input.PrimaryFeeTokenAddress
+	event.PrimaryFee = input.PrimaryFee
+	event.SecondaryFee = input.SecondaryFee
+	event.RequiredGasLimit = input.RequiredGasLimit
+}
+
+func setSendAndCallInput(event *Event, input teleportertokendestination.SendAndCallInput) {
+	event.DestinationBlockchainID = input.DestinationBlockchainID
+	event.DestinationBridgeAddress = input.DestinationBridgeAddress
+	event.Recipient = input.RecipientContract
+	event.PrimaryFeeTokenAddress = input.PrimaryFeeTokenAddress
+	event.PrimaryFee = input.PrimaryFee
+	event.SecondaryFee = input.SecondaryFee
+	event.RequiredGasLimit = input.RequiredGasLimit
+}
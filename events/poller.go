@@ -0,0 +1,289 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package events
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/subnet-evm/ethclient"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultPollInterval  = 5 * time.Second
+	defaultMaxBlockRange = 2048
+)
+
+// Chain is a connection to a chain that hosts bridge contracts
+type Chain struct {
+	Name         string
+	BlockchainID ids.ID
+	Client       ethclient.Client
+	StartBlock   uint64
+	// ConfirmationDepth is the number of blocks built on a block before it is treated as final
+	ConfirmationDepth uint64
+}
+
+// NewChain dials the RPC endpoint of the configured chain
+func NewChain(ctx context.Context, config ChainConfig) (*Chain, error) {
+	blockchainID, err := ids.FromString(config.BlockchainID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blockchain ID for chain %s: %w", config.Name, err)
+	}
+	client, err := ethclient.DialContext(ctx, config.RPCEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial chain %s: %w", config.Name, err)
+	}
+	return &Chain{
+		Name:              config.Name,
+		BlockchainID:      blockchainID,
+		Client:            client,
+		StartBlock:        config.StartBlock,
+		ConfirmationDepth: config.ConfirmationDepth,
+	}, nil
+}
+
+// DialChain dials the RPC endpoint of a chain, reading its blockchain ID from the Warp precompile
+func DialChain(ctx context.Context, name string, rpcEndpoint string) (*Chain, error) {
+	client, err := ethclient.DialContext(ctx, rpcEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial chain %s: %w", name, err)
+	}
+	input, err := warp.PackGetBlockchainID()
+	if err != nil {
+		return nil, err
+	}
+	output, err := client.CallContract(ctx, interfaces.CallMsg{
+		To:   &warp.ContractAddress,
+		Data: input,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blockchain ID of chain %s: %w", name, err)
+	}
+	var blockchainID common.Hash
+	if err := warp.WarpABI.UnpackIntoInterface(&blockchainID, "getBlockchainID", output); err != nil {
+		return nil, fmt.Errorf("failed to unpack blockchain ID of chain %s: %w", name, err)
+	}
+	return &Chain{
+		Name:         name,
+		BlockchainID: ids.ID(blockchainID),
+		Client:       client,
+	}, nil
+}
+
+// ConnectChains dials each of the configured chains, keyed by name
+func ConnectChains(ctx context.Context, configs []ChainConfig) (map[string]*Chain, error) {
+	chains := make(map[string]*Chain, len(configs))
+	for _, config := range configs {
+		chain, err := NewChain(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+		chains[chain.Name] = chain
+	}
+	return chains, nil
+}
+
+// FinalBlock returns the latest block of the chain that has at least ConfirmationDepth blocks built on it, or the
+// genesis block while the chain is shorter than the depth
+func (c *Chain) FinalBlock(ctx context.Context) (uint64, error) {
+	latest, err := c.Client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest block of chain %s: %w", c.Name, err)
+	}
+	if latest < c.ConfirmationDepth {
+		return 0, nil
+	}
+	return latest - c.ConfirmationDepth, nil
+}
+
+// Handler is called with the events decoded from each polled block range, in log order.
+// toBlock is the last block of the range, which is fully processed once the handler returns.
+type Handler func(ctx context.Context, events []*Event, toBlock uint64) error
+
+// PollerConfig configures how a Poller follows new blocks
+type PollerConfig struct {
+	PollInterval  time.Duration
+	MaxBlockRange uint64
+}
+
+// Poller follows the blocks of a single chain and decodes the events
+// emitted by the bridge contracts deployed on it.
+type Poller struct {
+	logger    logging.Logger
+	chain     *Chain
+	decoder   *Decoder
+	contracts map[common.Address]ContractInfo
+	config    PollerConfig
+	handler   Handler
+
+	nextBlock uint64
+}
+
+// NewPoller creates a poller for the given contracts on the chain.
+// Processing begins at the chain's start block, or the latest block if not set.
+func NewPoller(
+	logger logging.Logger,
+	chain *Chain,
+	decoder *Decoder,
+	contracts map[common.Address]ContractInfo,
+	config PollerConfig,
+	handler Handler,
+) *Poller {
+	if config.PollInterval == 0 {
+		config.PollInterval = defaultPollInterval
+	}
+	if config.MaxBlockRange == 0 {
+		config.MaxBlockRange = defaultMaxBlockRange
+	}
+	return &Poller{
+		logger:    logger,
+		chain:     chain,
+		decoder:   decoder,
+		contracts: contracts,
+		config:    config,
+		handler:   handler,
+		nextBlock: chain.StartBlock,
+	}
+}
+
+// SetNextBlock sets the next block to be processed, for resuming from a checkpoint
+func (p *Poller) SetNextBlock(block uint64) {
+	p.nextBlock = block
+}
+
+// Run polls for new blocks until the context is cancelled or an error occurs
+func (p *Poller) Run(ctx context.Context) error {
+	if p.nextBlock == 0 {
+		final, err := p.chain.FinalBlock(ctx)
+		if err != nil {
+			return err
+		}
+		p.nextBlock = final
+	}
+
+	ticker := time.NewTicker(p.config.PollInterval)
+	defer ticker.Stop()
+	for {
+		caughtUp, err := p.Poll(ctx)
+		if err != nil {
+			return err
+		}
+		if !caughtUp {
+			// Continue immediately while backfilling
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll processes the next range of blocks, up to the chain's final block.
+// Returns true if the poller has caught up with the final block.
+// The latest block reported by an Avalanche node is its last accepted block, which Snowman consensus never
+// reverts, so processed ranges are never revisited for reorgs. On chains with weaker finality, blocks are only
+// processed once the chain's confirmation depth of blocks has been built on them, so that reorgs shallower than the
+// depth never revert processed events.
+func (p *Poller) Poll(ctx context.Context) (bool, error) {
+	latest, err := p.chain.FinalBlock(ctx)
+	if err != nil {
+		return false, err
+	}
+	if p.nextBlock > latest {
+		return true, nil
+	}
+	toBlock := latest
+	if toBlock-p.nextBlock+1 > p.config.MaxBlockRange {
+		toBlock = p.nextBlock + p.config.MaxBlockRange - 1
+	}
+
+	events, err := p.FetchEvents(ctx, p.nextBlock, toBlock)
+	if err != nil {
+		return false, err
+	}
+	if err := p.handler(ctx, events, toBlock); err != nil {
+		return false, err
+	}
+	p.logger.Debug(
+		"Processed blocks",
+		zap.String("chain", p.chain.Name),
+		zap.Uint64("fromBlock", p.nextBlock),
+		zap.Uint64("toBlock", toBlock),
+		zap.Int("numEvents", len(events)),
+	)
+	p.nextBlock = toBlock + 1
+	return toBlock == latest, nil
+}
+
+// FetchEvents returns the bridge events emitted in the given inclusive block range
+func (p *Poller) FetchEvents(ctx context.Context, fromBlock uint64, toBlock uint64) ([]*Event, error) {
+	addresses := make([]common.Address, 0, len(p.contracts))
+	for address := range p.contracts {
+		addresses = append(addresses, address)
+	}
+	logs, err := p.chain.Client.FilterLogs(ctx, interfaces.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Addresses: addresses,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to filter logs on chain %s: %w", p.chain.Name, err)
+	}
+
+	var (
+		events     []*Event
+		blockTimes = make(map[uint64]time.Time)
+		received   = make(map[common.Hash]ReceivedMessage)
+	)
+	for _, log := range logs {
+		event, ok, err := p.decoder.Decode(log, p.contracts[log.Address])
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		event.Chain = p.chain.Name
+
+		blockTime, ok := blockTimes[log.BlockNumber]
+		if !ok {
+			header, err := p.chain.Client.HeaderByNumber(ctx, new(big.Int).SetUint64(log.BlockNumber))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get block %d of chain %s: %w", log.BlockNumber, p.chain.Name, err)
+			}
+			blockTime = time.Unix(int64(header.Time), 0)
+			blockTimes[log.BlockNumber] = blockTime
+		}
+		event.BlockTime = blockTime
+
+		// Events emitted while receiving a Teleporter message are matched with the delivered message
+		if event.Type.IsDelivery() || event.Type == DestinationRegistered {
+			message, ok := received[log.TxHash]
+			if !ok {
+				receipt, err := p.chain.Client.TransactionReceipt(ctx, log.TxHash)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get receipt %s on chain %s: %w", log.TxHash, p.chain.Name, err)
+				}
+				message, _ = p.decoder.DecodeReceivedMessage(receipt.Logs)
+				received[log.TxHash] = message
+			}
+			event.ReceivedMessageID = message.MessageID
+			event.SourceBlockchainID = message.SourceBlockchainID
+			event.SourceBridgeAddress = message.OriginSenderAddress
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
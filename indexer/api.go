@@ -0,0 +1,252 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultTransfersLimit = 50
+	maxTransfersLimit     = 500
+)
+
+// TransferStatus is the cross-chain status of a transfer
+type TransferStatus string
+
+const (
+	// Pending transfers have not yet been delivered to their final destination
+	Pending TransferStatus = "pending"
+	// Delivered transfers have been delivered to their final destination. The recipient contract call of
+	// a sendAndCall transfer may still have failed, in which case the tokens are sent to its fallback recipient.
+	Delivered TransferStatus = "delivered"
+)
+
+// TransferResponse is the JSON representation of a transfer returned by the API.
+// Amounts are decimal strings, since they may exceed the precision of JSON numbers.
+type TransferResponse struct {
+	MessageID               ids.ID           `json:"messageID"`
+	Bridge                  string           `json:"bridge"`
+	Type                    events.EventType `json:"type"`
+	Status                  TransferStatus   `json:"status"`
+	SourceBlockchainID      ids.ID           `json:"sourceBlockchainID"`
+	SourceAddress           common.Address   `json:"sourceAddress"`
+	TxHash                  common.Hash      `json:"txHash"`
+	BlockNumber             uint64           `json:"blockNumber"`
+	BlockTime               time.Time        `json:"blockTime"`
+	Sender                  common.Address   `json:"sender"`
+	Recipient               common.Address   `json:"recipient"`
+	Amount                  string           `json:"amount"`
+	DestinationBlockchainID ids.ID           `json:"destinationBlockchainID"`
+	DestinationAddress      common.Address   `json:"destinationAddress"`
+	// DestinationReceipt is the delivery of the transfer on its final destination, once delivered
+	DestinationReceipt *HopEventResponse `json:"destinationReceipt,omitempty"`
+	// CallSucceeded is whether the recipient contract call succeeded, for delivered sendAndCall transfers
+	CallSucceeded *bool          `json:"callSucceeded,omitempty"`
+	Hops          []*HopResponse `json:"hops"`
+}
+
+// HopResponse is the JSON representation of a hop returned by the API
+type HopResponse struct {
+	MessageID               ids.ID            `json:"messageID"`
+	SourceBlockchainID      ids.ID            `json:"sourceBlockchainID"`
+	DestinationBlockchainID ids.ID            `json:"destinationBlockchainID"`
+	DestinationAddress      common.Address    `json:"destinationAddress"`
+	Send                    *HopEventResponse `json:"send,omitempty"`
+	Delivery                *HopEventResponse `json:"delivery,omitempty"`
+}
+
+// HopEventResponse is the JSON representation of the send or delivery of a hop
+type HopEventResponse struct {
+	Type        events.EventType `json:"type"`
+	TxHash      common.Hash      `json:"txHash"`
+	BlockNumber uint64           `json:"blockNumber"`
+	BlockTime   time.Time        `json:"blockTime"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+// API serves the status of indexed transfers over HTTP:
+//   - GET /transfer/{txHash} returns the first transfer initiated in the transaction
+//   - GET /transfers?recipient={address}[&limit={n}] returns the most recent transfers to the recipient
+type API struct {
+	logger logging.Logger
+	store  *Store
+	mux    *http.ServeMux
+}
+
+// NewAPI creates an API serving transfers from the store
+func NewAPI(logger logging.Logger, store *Store) *API {
+	a := &API{
+		logger: logger,
+		store:  store,
+		mux:    http.NewServeMux(),
+	}
+	a.mux.HandleFunc("/transfer/", a.handleTransfer)
+	a.mux.HandleFunc("/transfers", a.handleTransfers)
+	return a
+}
+
+// ServeHTTP implements http.Handler
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mux.ServeHTTP(w, r)
+}
+
+func (a *API) handleTransfer(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		a.writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	txHash := strings.TrimPrefix(r.URL.Path, "/transfer/")
+	if len(common.FromHex(txHash)) != common.HashLength {
+		a.writeError(w, http.StatusBadRequest, "invalid transaction hash")
+		return
+	}
+	transfers, err := a.store.TransfersByTxHash(r.Context(), common.HexToHash(txHash))
+	if err != nil {
+		a.writeInternalError(w, err)
+		return
+	}
+	if len(transfers) == 0 {
+		a.writeError(w, http.StatusNotFound, "transfer not found")
+		return
+	}
+	response, err := a.transferResponse(r.Context(), transfers[0])
+	if err != nil {
+		a.writeInternalError(w, err)
+		return
+	}
+	a.writeJSON(w, http.StatusOK, response)
+}
+
+func (a *API) handleTransfers(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		a.writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	query := r.URL.Query()
+	recipient := query.Get("recipient")
+	if !common.IsHexAddress(recipient) {
+		a.writeError(w, http.StatusBadRequest, "invalid or missing recipient address")
+		return
+	}
+	limit := defaultTransfersLimit
+	if limitArg := query.Get("limit"); limitArg != "" {
+		parsed, err := strconv.Atoi(limitArg)
+		if err != nil || parsed <= 0 || parsed > maxTransfersLimit {
+			a.writeError(w, http.StatusBadRequest, "limit must be between 1 and "+strconv.Itoa(maxTransfersLimit))
+			return
+		}
+		limit = parsed
+	}
+
+	transfers, err := a.store.TransfersByRecipient(r.Context(), common.HexToAddress(recipient), limit)
+	if err != nil {
+		a.writeInternalError(w, err)
+		return
+	}
+	responses := make([]*TransferResponse, 0, len(transfers))
+	for _, transfer := range transfers {
+		response, err := a.transferResponse(r.Context(), transfer)
+		if err != nil {
+			a.writeInternalError(w, err)
+			return
+		}
+		responses = append(responses, response)
+	}
+	a.writeJSON(w, http.StatusOK, responses)
+}
+
+func (a *API) transferResponse(ctx context.Context, transfer *Transfer) (*TransferResponse, error) {
+	hops, err := a.store.Hops(ctx, transfer.MessageID)
+	if err != nil {
+		return nil, err
+	}
+	response := &TransferResponse{
+		MessageID:               transfer.MessageID,
+		Bridge:                  transfer.Bridge,
+		Type:                    transfer.Type,
+		Status:                  Pending,
+		SourceBlockchainID:      transfer.SourceBlockchainID,
+		SourceAddress:           transfer.SourceAddress,
+		TxHash:                  transfer.TxHash,
+		BlockNumber:             transfer.BlockNumber,
+		BlockTime:               transfer.BlockTime,
+		Sender:                  transfer.Sender,
+		Recipient:               transfer.Recipient,
+		Amount:                  transfer.Amount.String(),
+		DestinationBlockchainID: transfer.DestinationBlockchainID,
+		DestinationAddress:      transfer.DestinationAddress,
+		Hops:                    make([]*HopResponse, 0, len(hops)),
+	}
+	for _, hop := range hops {
+		response.Hops = append(response.Hops, &HopResponse{
+			MessageID:               hop.MessageID,
+			SourceBlockchainID:      hop.SourceBlockchainID,
+			DestinationBlockchainID: hop.DestinationBlockchainID,
+			DestinationAddress:      hop.DestinationAddress,
+			Send:                    hopEventResponse(hop.Send),
+			Delivery:                hopEventResponse(hop.Delivery),
+		})
+	}
+
+	// A transfer is delivered once its last hop is delivered to the final destination, rather than routed
+	if len(hops) == 0 {
+		return response, nil
+	}
+	last := hops[len(hops)-1]
+	if last.Delivery == nil || last.DestinationBlockchainID != transfer.DestinationBlockchainID ||
+		last.Delivery.Type == events.TokensRouted || last.Delivery.Type == events.TokensAndCallRouted {
+		return response, nil
+	}
+	response.Status = Delivered
+	response.DestinationReceipt = hopEventResponse(last.Delivery)
+	if last.Delivery.Type == events.CallSucceeded || last.Delivery.Type == events.CallFailed {
+		callSucceeded := last.Delivery.Type == events.CallSucceeded
+		response.CallSucceeded = &callSucceeded
+	}
+	return response, nil
+}
+
+func hopEventResponse(event *HopEvent) *HopEventResponse {
+	if event == nil {
+		return nil
+	}
+	return &HopEventResponse{
+		Type:        event.Type,
+		TxHash:      event.TxHash,
+		BlockNumber: event.BlockNumber,
+		BlockTime:   event.BlockTime,
+	}
+}
+
+func (a *API) writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		a.logger.Debug("Failed to write response", zap.Error(err))
+	}
+}
+
+func (a *API) writeError(w http.ResponseWriter, status int, message string) {
+	a.writeJSON(w, status, errorResponse{Error: message})
+}
+
+func (a *API) writeInternalError(w http.ResponseWriter, err error) {
+	a.logger.Error("Failed to serve request", zap.Error(err))
+	a.writeError(w, http.StatusInternalServerError, "internal error")
+}
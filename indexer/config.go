@@ -0,0 +1,99 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+)
+
+const (
+	defaultAPIPort             = 8080
+	defaultPollIntervalSeconds = 5
+)
+
+// DatabaseConfig selects the database that bridge events are indexed into
+type DatabaseConfig struct {
+	// Driver is either sqlite or postgres
+	Driver Driver `json:"driver"`
+	// DSN is the path of the SQLite database file, or the Postgres connection string
+	DSN string `json:"dsn"`
+}
+
+// Config is the configuration of the bridge indexer
+type Config struct {
+	LogLevel string         `json:"log-level"`
+	Database DatabaseConfig `json:"database"`
+	// APIPort is the port the transfer status API is served on
+	APIPort uint16 `json:"api-port"`
+	// PollIntervalSeconds is how often each chain is polled for new bridge events once caught up
+	PollIntervalSeconds uint64 `json:"poll-interval-seconds"`
+	// MaxBlockRange is the maximum number of blocks requested in a single eth_getLogs call
+	MaxBlockRange uint64 `json:"max-block-range"`
+
+	// Chains are backfilled from their start block, which should be at or before the deployment of the first
+	// bridge contract on the chain, then followed. Chains without a start block are followed from the latest
+	// block. Once indexed, chains resume from their last checkpoint.
+	Chains  []events.ChainConfig  `json:"chains"`
+	Bridges []events.BridgeConfig `json:"bridges"`
+}
+
+// LoadConfig reads and validates the JSON configuration file at the given path,
+// filling in defaults for unset optional values.
+func LoadConfig(path string) (*Config, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var config Config
+	if err := json.Unmarshal(bytes, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	config.setDefaults()
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return &config, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.LogLevel == "" {
+		c.LogLevel = logging.Info.LowerString()
+	}
+	if c.APIPort == 0 {
+		c.APIPort = defaultAPIPort
+	}
+	if c.PollIntervalSeconds == 0 {
+		c.PollIntervalSeconds = defaultPollIntervalSeconds
+	}
+}
+
+// Validate checks that the configuration is well formed
+func (c *Config) Validate() error {
+	if _, err := logging.ToLevel(c.LogLevel); err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+	if c.Database.Driver != SQLite && c.Database.Driver != Postgres {
+		return fmt.Errorf("invalid database driver %s", c.Database.Driver)
+	}
+	if c.Database.DSN == "" {
+		return fmt.Errorf("database dsn must be set")
+	}
+	if len(c.Bridges) == 0 {
+		return fmt.Errorf("no bridges configured")
+	}
+	return events.Validate(c.Chains, c.Bridges)
+}
+
+func (c *Config) pollerConfig() events.PollerConfig {
+	return events.PollerConfig{
+		PollInterval:  time.Duration(c.PollIntervalSeconds) * time.Second,
+		MaxBlockRange: c.MaxBlockRange,
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Indexer backfills and follows the events of the configured bridges into a Store
+type Indexer struct {
+	logger  logging.Logger
+	config  *Config
+	store   *Store
+	decoder *events.Decoder
+
+	chains  map[string]*events.Chain
+	bridges map[string]events.BridgeConfig
+}
+
+// NewIndexer connects to each configured chain, indexing their events into the store
+func NewIndexer(ctx context.Context, logger logging.Logger, config *Config, store *Store) (*Indexer, error) {
+	decoder, err := events.NewDecoder()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event decoder: %w", err)
+	}
+	chains, err := events.ConnectChains(ctx, config.Chains)
+	if err != nil {
+		return nil, err
+	}
+	bridges := make(map[string]events.BridgeConfig, len(config.Bridges))
+	for _, bridge := range config.Bridges {
+		bridges[bridge.Name] = bridge
+	}
+	return &Indexer{
+		logger:  logger,
+		config:  config,
+		store:   store,
+		decoder: decoder,
+		chains:  chains,
+		bridges: bridges,
+	}, nil
+}
+
+// Run indexes every chain hosting bridge contracts, resuming from the last checkpoint of each,
+// until the context is cancelled or an error occurs.
+func (i *Indexer) Run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, chain := range i.chains {
+		chain := chain
+		contracts := events.ContractsOnChain(i.config.Bridges, chain.Name)
+		if len(contracts) == 0 {
+			continue
+		}
+		poller := events.NewPoller(
+			i.logger,
+			chain,
+			i.decoder,
+			contracts,
+			i.config.pollerConfig(),
+			func(ctx context.Context, bridgeEvents []*events.Event, toBlock uint64) error {
+				return i.store.Apply(ctx, chain.Name, i.buildBatch(chain, bridgeEvents), toBlock+1)
+			},
+		)
+		nextBlock, ok, err := i.store.Checkpoint(ctx, chain.Name)
+		if err != nil {
+			return err
+		}
+		if ok {
+			i.logger.Info("Resuming from checkpoint", zap.String("chain", chain.Name), zap.Uint64("block", nextBlock))
+			poller.SetNextBlock(nextBlock)
+		}
+		g.Go(func() error {
+			return poller.Run(ctx)
+		})
+	}
+	return g.Wait()
+}
+
+func (i *Indexer) buildBatch(chain *events.Chain, bridgeEvents []*events.Event) *Batch {
+	batch := &Batch{}
+	for _, event := range bridgeEvents {
+		if event.Type.IsSend() {
+			i.addSend(batch, chain, event)
+		}
+		// Deliveries are only indexed when the delivered message is known, which should always be the case
+		if event.Type.IsDelivery() && event.ReceivedMessageID != ids.Empty {
+			i.addDelivery(batch, chain, event)
+		}
+	}
+	return batch
+}
+
+func (i *Indexer) addSend(batch *Batch, chain *events.Chain, event *events.Event) {
+	// Destinations always send to the source, which routes the tokens on to the final destination if needed
+	nextBlockchainID, nextAddress := event.DestinationBlockchainID, event.DestinationBridgeAddress
+	if bridge, ok := i.bridges[event.Bridge]; ok && event.Contract != common.HexToAddress(bridge.Source.Address) {
+		nextBlockchainID = i.chains[bridge.Source.Chain].BlockchainID
+		nextAddress = common.HexToAddress(bridge.Source.Address)
+	}
+
+	hop := &HopSend{
+		MessageID:               event.TeleporterMessageID,
+		Bridge:                  event.Bridge,
+		SourceBlockchainID:      chain.BlockchainID,
+		DestinationBlockchainID: nextBlockchainID,
+		DestinationAddress:      nextAddress,
+		Type:                    event.Type,
+		TxHash:                  event.TxHash,
+		BlockNumber:             event.BlockNumber,
+		BlockTime:               event.BlockTime,
+	}
+	if event.Type == events.TokensRouted || event.Type == events.TokensAndCallRouted {
+		hop.PreviousMessageID = event.ReceivedMessageID
+	} else {
+		batch.Transfers = append(batch.Transfers, &Transfer{
+			MessageID:               event.TeleporterMessageID,
+			Bridge:                  event.Bridge,
+			Type:                    event.Type,
+			SourceBlockchainID:      chain.BlockchainID,
+			SourceAddress:           event.Contract,
+			TxHash:                  event.TxHash,
+			BlockNumber:             event.BlockNumber,
+			BlockTime:               event.BlockTime,
+			LogIndex:                event.LogIndex,
+			Sender:                  event.Sender,
+			Recipient:               event.Recipient,
+			Amount:                  event.Amount,
+			DestinationBlockchainID: event.DestinationBlockchainID,
+			DestinationAddress:      event.DestinationBridgeAddress,
+		})
+	}
+	batch.HopSends = append(batch.HopSends, hop)
+
+	if event.PrimaryFee != nil {
+		secondaryFee := event.SecondaryFee
+		if secondaryFee == nil {
+			secondaryFee = big.NewInt(0)
+		}
+		batch.Fees = append(batch.Fees, &Fee{
+			MessageID:    event.TeleporterMessageID,
+			Bridge:       event.Bridge,
+			BlockchainID: chain.BlockchainID,
+			FeeToken:     event.PrimaryFeeTokenAddress,
+			PrimaryFee:   event.PrimaryFee,
+			SecondaryFee: secondaryFee,
+		})
+	}
+}
+
+func (i *Indexer) addDelivery(batch *Batch, chain *events.Chain, event *events.Event) {
+	batch.HopDeliveries = append(batch.HopDeliveries, &HopDelivery{
+		MessageID:               event.ReceivedMessageID,
+		Bridge:                  event.Bridge,
+		SourceBlockchainID:      event.SourceBlockchainID,
+		DestinationBlockchainID: chain.BlockchainID,
+		DestinationAddress:      event.Contract,
+		Type:                    event.Type,
+		TxHash:                  event.TxHash,
+		BlockNumber:             event.BlockNumber,
+		BlockTime:               event.BlockTime,
+	})
+	if event.Type == events.CallSucceeded || event.Type == events.CallFailed {
+		batch.CallResults = append(batch.CallResults, &CallResult{
+			MessageID:         event.ReceivedMessageID,
+			Bridge:            event.Bridge,
+			BlockchainID:      chain.BlockchainID,
+			TxHash:            event.TxHash,
+			BlockNumber:       event.BlockNumber,
+			BlockTime:         event.BlockTime,
+			RecipientContract: event.Recipient,
+			Amount:            event.Amount,
+			Succeeded:         event.Type == events.CallSucceeded,
+		})
+	}
+}
@@ -0,0 +1,182 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Transfers between destinations are routed through the source, so have at most two hops
+const maxHops = 2
+
+// Hop is a Teleporter message carrying the tokens of a transfer. Send and Delivery are nil until indexed.
+type Hop struct {
+	MessageID               ids.ID
+	PreviousMessageID       ids.ID
+	Bridge                  string
+	SourceBlockchainID      ids.ID
+	DestinationBlockchainID ids.ID
+	DestinationAddress      common.Address
+	Send                    *HopEvent
+	Delivery                *HopEvent
+}
+
+// HopEvent is the send or delivery of a hop
+type HopEvent struct {
+	Type        events.EventType
+	TxHash      common.Hash
+	BlockNumber uint64
+	BlockTime   time.Time
+}
+
+const transferColumns = `message_id, bridge, type, source_blockchain_id, source_address, tx_hash, block_number,
+	block_time, log_index, sender, recipient, amount, destination_blockchain_id, destination_address`
+
+// TransfersByTxHash returns the transfers initiated in the given transaction, in log order
+func (s *Store) TransfersByTxHash(ctx context.Context, txHash common.Hash) ([]*Transfer, error) {
+	return s.queryTransfers(
+		ctx,
+		`SELECT `+transferColumns+` FROM transfers WHERE tx_hash = ? ORDER BY log_index`,
+		txHash.Hex(),
+	)
+}
+
+// TransfersByRecipient returns up to limit transfers to the given recipient, most recent first
+func (s *Store) TransfersByRecipient(ctx context.Context, recipient common.Address, limit int) ([]*Transfer, error) {
+	return s.queryTransfers(
+		ctx,
+		`SELECT `+transferColumns+` FROM transfers WHERE recipient = ?
+			ORDER BY block_time DESC, message_id LIMIT ?`,
+		recipient.Hex(),
+		limit,
+	)
+}
+
+func (s *Store) queryTransfers(ctx context.Context, query string, args ...interface{}) ([]*Transfer, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(query), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query transfers: %w", err)
+	}
+	defer rows.Close()
+
+	var transfers []*Transfer
+	for rows.Next() {
+		var (
+			t                                                  Transfer
+			messageID, sourceBlockchainID, destBlockchainID    string
+			sourceAddress, txHash, sender, recipient, destAddr string
+			transferType, amount                               string
+			blockTime                                          int64
+		)
+		if err := rows.Scan(
+			&messageID, &t.Bridge, &transferType, &sourceBlockchainID, &sourceAddress, &txHash, &t.BlockNumber,
+			&blockTime, &t.LogIndex, &sender, &recipient, &amount, &destBlockchainID, &destAddr,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan transfer: %w", err)
+		}
+		if t.MessageID, err = ids.FromString(messageID); err != nil {
+			return nil, err
+		}
+		if t.SourceBlockchainID, err = ids.FromString(sourceBlockchainID); err != nil {
+			return nil, err
+		}
+		if t.DestinationBlockchainID, err = ids.FromString(destBlockchainID); err != nil {
+			return nil, err
+		}
+		var ok bool
+		if t.Amount, ok = new(big.Int).SetString(amount, 10); !ok {
+			return nil, fmt.Errorf("invalid amount %s of transfer %s", amount, messageID)
+		}
+		t.Type = events.EventType(transferType)
+		t.SourceAddress = common.HexToAddress(sourceAddress)
+		t.TxHash = common.HexToHash(txHash)
+		t.BlockTime = time.Unix(blockTime, 0).UTC()
+		t.Sender = common.HexToAddress(sender)
+		t.Recipient = common.HexToAddress(recipient)
+		t.DestinationAddress = common.HexToAddress(destAddr)
+		transfers = append(transfers, &t)
+	}
+	return transfers, rows.Err()
+}
+
+// Hops returns the indexed hops of the transfer with the given message ID, in order
+func (s *Store) Hops(ctx context.Context, messageID ids.ID) ([]*Hop, error) {
+	var hops []*Hop
+	hop, err := s.queryHop(ctx, `message_id = ?`, messageID.String())
+	for hop != nil && len(hops) < maxHops {
+		hops = append(hops, hop)
+		hop, err = s.queryHop(ctx, `previous_message_id = ?`, hop.MessageID.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hops, nil
+}
+
+// Returns the hop matching the condition, or nil if there is none
+func (s *Store) queryHop(ctx context.Context, condition string, arg string) (*Hop, error) {
+	var (
+		hop                                                Hop
+		messageID, sourceBlockchainID, destBlockchainID    string
+		previousMessageID, destAddr                        sql.NullString
+		sendType, sendTxHash, deliveryType, deliveryTxHash sql.NullString
+		sendBlock, sendTime, deliveryBlock, deliveryTime   sql.NullInt64
+	)
+	err := s.db.QueryRowContext(ctx, s.rebind(`SELECT
+			message_id, previous_message_id, bridge, source_blockchain_id, destination_blockchain_id,
+			destination_address, send_type, send_tx_hash, send_block_number, send_block_time,
+			delivery_type, delivery_tx_hash, delivery_block_number, delivery_block_time
+		FROM hops WHERE `+condition), arg).Scan(
+		&messageID, &previousMessageID, &hop.Bridge, &sourceBlockchainID, &destBlockchainID,
+		&destAddr, &sendType, &sendTxHash, &sendBlock, &sendTime,
+		&deliveryType, &deliveryTxHash, &deliveryBlock, &deliveryTime,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to query hop: %w", err)
+	}
+
+	if hop.MessageID, err = ids.FromString(messageID); err != nil {
+		return nil, err
+	}
+	if previousMessageID.Valid {
+		if hop.PreviousMessageID, err = ids.FromString(previousMessageID.String); err != nil {
+			return nil, err
+		}
+	}
+	if hop.SourceBlockchainID, err = ids.FromString(sourceBlockchainID); err != nil {
+		return nil, err
+	}
+	if hop.DestinationBlockchainID, err = ids.FromString(destBlockchainID); err != nil {
+		return nil, err
+	}
+	hop.DestinationAddress = common.HexToAddress(destAddr.String)
+	if sendTxHash.Valid {
+		hop.Send = &HopEvent{
+			Type:        events.EventType(sendType.String),
+			TxHash:      common.HexToHash(sendTxHash.String),
+			BlockNumber: uint64(sendBlock.Int64),
+			BlockTime:   time.Unix(sendTime.Int64, 0).UTC(),
+		}
+	}
+	if deliveryTxHash.Valid {
+		hop.Delivery = &HopEvent{
+			Type:        events.EventType(deliveryType.String),
+			TxHash:      common.HexToHash(deliveryTxHash.String),
+			BlockNumber: uint64(deliveryBlock.Int64),
+			BlockTime:   time.Unix(deliveryTime.Int64, 0).UTC(),
+		}
+	}
+	return &hop, nil
+}
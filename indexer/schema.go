@@ -0,0 +1,79 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+// The schema is shared by SQLite and Postgres, so only uses types and syntax common to both.
+// Token amounts are stored as decimal strings since they may exceed 64 bits, block times as
+// unix seconds, blockchain and message IDs in their cb58 encoding, and addresses and hashes
+// as checksummed hex.
+//
+// A transfer is made up of one hop per Teleporter message carrying its tokens. Transfers between
+// two destinations are routed through the source, and so have two hops. Since chains are indexed
+// independently, the delivery of a hop may be indexed before its send, so all send and delivery
+// columns of hops are nullable.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS transfers (
+		message_id TEXT PRIMARY KEY,
+		bridge TEXT NOT NULL,
+		type TEXT NOT NULL,
+		source_blockchain_id TEXT NOT NULL,
+		source_address TEXT NOT NULL,
+		tx_hash TEXT NOT NULL,
+		block_number BIGINT NOT NULL,
+		block_time BIGINT NOT NULL,
+		log_index BIGINT NOT NULL,
+		sender TEXT NOT NULL,
+		recipient TEXT NOT NULL,
+		amount TEXT NOT NULL,
+		destination_blockchain_id TEXT NOT NULL,
+		destination_address TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS transfers_tx_hash ON transfers (tx_hash)`,
+	`CREATE INDEX IF NOT EXISTS transfers_sender ON transfers (sender)`,
+	`CREATE INDEX IF NOT EXISTS transfers_recipient ON transfers (recipient)`,
+
+	`CREATE TABLE IF NOT EXISTS hops (
+		message_id TEXT PRIMARY KEY,
+		previous_message_id TEXT,
+		bridge TEXT NOT NULL,
+		source_blockchain_id TEXT NOT NULL,
+		destination_blockchain_id TEXT NOT NULL,
+		destination_address TEXT,
+		send_type TEXT,
+		send_tx_hash TEXT,
+		send_block_number BIGINT,
+		send_block_time BIGINT,
+		delivery_type TEXT,
+		delivery_tx_hash TEXT,
+		delivery_block_number BIGINT,
+		delivery_block_time BIGINT
+	)`,
+	`CREATE INDEX IF NOT EXISTS hops_previous_message_id ON hops (previous_message_id)`,
+
+	`CREATE TABLE IF NOT EXISTS call_results (
+		message_id TEXT PRIMARY KEY,
+		bridge TEXT NOT NULL,
+		blockchain_id TEXT NOT NULL,
+		tx_hash TEXT NOT NULL,
+		block_number BIGINT NOT NULL,
+		block_time BIGINT NOT NULL,
+		recipient_contract TEXT NOT NULL,
+		amount TEXT NOT NULL,
+		succeeded INTEGER NOT NULL
+	)`,
+
+	`CREATE TABLE IF NOT EXISTS fees (
+		message_id TEXT PRIMARY KEY,
+		bridge TEXT NOT NULL,
+		blockchain_id TEXT NOT NULL,
+		fee_token TEXT NOT NULL,
+		primary_fee TEXT NOT NULL,
+		secondary_fee TEXT NOT NULL
+	)`,
+
+	`CREATE TABLE IF NOT EXISTS checkpoints (
+		chain TEXT PRIMARY KEY,
+		next_block BIGINT NOT NULL
+	)`,
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// StatsQuery selects the transfers that Stats are computed over
+type StatsQuery struct {
+	// From and To bound the block times of the transfers' initial sends, From inclusive and To exclusive
+	From time.Time
+	To   time.Time
+	// Bridge limits the stats to the transfers of a single bridge, if set
+	Bridge string
+}
+
+// RouteStats is the usage of a route, from the bridge contract transfers are sent from to their final destination,
+// over the transfers matching a StatsQuery
+type RouteStats struct {
+	Bridge                  string
+	SourceBlockchainID      ids.ID
+	SourceAddress           common.Address
+	DestinationBlockchainID ids.ID
+	DestinationAddress      common.Address
+	// Transfers is the number of transfers sent, of which Delivered have been delivered to the final destination
+	Transfers     int
+	Delivered     int
+	UniqueSenders int
+	// Volume is the total amount sent, in the denomination of the bridge contract transfers are sent from
+	Volume *big.Int
+	// AverageLatency is the average time from the block of a delivered transfer's send to the block of its
+	// delivery to the final destination, and is zero if no transfer has been delivered
+	AverageLatency time.Duration
+	// PrimaryFees are the relayer fees paid for the first hop of each transfer, by fee token
+	PrimaryFees map[common.Address]*big.Int
+	// SecondaryFees are the relayer fees paid in the bridged token for the second hop of routed transfers
+	SecondaryFees *big.Int
+}
+
+type routeKey struct {
+	bridge                  string
+	sourceBlockchainID      string
+	sourceAddress           string
+	destinationBlockchainID string
+	destinationAddress      string
+}
+
+// Stats returns the usage of each route over the transfers matching the query, ordered by bridge and then by
+// route. A transfer's delivery is counted once indexed, whenever it happened.
+func (s *Store) Stats(ctx context.Context, query StatsQuery) ([]*RouteStats, error) {
+	condition, args := `t.block_time >= ? AND t.block_time < ?`, []interface{}{query.From.Unix(), query.To.Unix()}
+	if query.Bridge != "" {
+		condition += ` AND t.bridge = ?`
+		args = append(args, query.Bridge)
+	}
+	// The last hop of a transfer is the routed hop if there is one, or else the first hop
+	rows, err := s.db.QueryContext(ctx, s.rebind(`SELECT
+			t.bridge, t.source_blockchain_id, t.source_address, t.destination_blockchain_id, t.destination_address,
+			t.sender, t.amount, t.block_time,
+			COALESCE(h2.destination_blockchain_id, h1.destination_blockchain_id),
+			COALESCE(h2.delivery_type, h1.delivery_type),
+			COALESCE(h2.delivery_block_time, h1.delivery_block_time),
+			f.fee_token, f.primary_fee, f.secondary_fee
+		FROM transfers t
+		LEFT JOIN hops h1 ON h1.message_id = t.message_id
+		LEFT JOIN hops h2 ON h2.previous_message_id = t.message_id
+		LEFT JOIN fees f ON f.message_id = t.message_id
+		WHERE `+condition), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stats: %w", err)
+	}
+	defer rows.Close()
+
+	routes := make(map[routeKey]*RouteStats)
+	senders := make(map[routeKey]map[string]struct{})
+	latencies := make(map[routeKey]time.Duration)
+	for rows.Next() {
+		var (
+			key                                routeKey
+			sender, amount                     string
+			blockTime                          int64
+			lastDestination, deliveryType      sql.NullString
+			deliveryTime                       sql.NullInt64
+			feeToken, primaryFee, secondaryFee sql.NullString
+		)
+		if err := rows.Scan(
+			&key.bridge, &key.sourceBlockchainID, &key.sourceAddress, &key.destinationBlockchainID,
+			&key.destinationAddress, &sender, &amount, &blockTime,
+			&lastDestination, &deliveryType, &deliveryTime,
+			&feeToken, &primaryFee, &secondaryFee,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan stats: %w", err)
+		}
+		route, ok := routes[key]
+		if !ok {
+			if route, err = newRouteStats(key); err != nil {
+				return nil, err
+			}
+			routes[key] = route
+			senders[key] = make(map[string]struct{})
+		}
+
+		route.Transfers++
+		senders[key][sender] = struct{}{}
+		if err := addAmount(route.Volume, amount); err != nil {
+			return nil, err
+		}
+		// A transfer is delivered once its last hop is delivered to the final destination, rather than routed
+		if deliveryType.Valid && deliveryTime.Valid && lastDestination.String == key.destinationBlockchainID &&
+			events.EventType(deliveryType.String) != events.TokensRouted &&
+			events.EventType(deliveryType.String) != events.TokensAndCallRouted {
+			route.Delivered++
+			latencies[key] += time.Duration(deliveryTime.Int64-blockTime) * time.Second
+		}
+		if feeToken.Valid {
+			token := common.HexToAddress(feeToken.String)
+			if route.PrimaryFees[token] == nil {
+				route.PrimaryFees[token] = new(big.Int)
+			}
+			if err := addAmount(route.PrimaryFees[token], primaryFee.String); err != nil {
+				return nil, err
+			}
+			if err := addAmount(route.SecondaryFees, secondaryFee.String); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	keys := make([]routeKey, 0, len(routes))
+	for key := range routes {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.bridge != b.bridge {
+			return a.bridge < b.bridge
+		}
+		if a.sourceBlockchainID != b.sourceBlockchainID {
+			return a.sourceBlockchainID < b.sourceBlockchainID
+		}
+		if a.sourceAddress != b.sourceAddress {
+			return a.sourceAddress < b.sourceAddress
+		}
+		if a.destinationBlockchainID != b.destinationBlockchainID {
+			return a.destinationBlockchainID < b.destinationBlockchainID
+		}
+		return a.destinationAddress < b.destinationAddress
+	})
+	stats := make([]*RouteStats, 0, len(keys))
+	for _, key := range keys {
+		route := routes[key]
+		route.UniqueSenders = len(senders[key])
+		if route.Delivered > 0 {
+			route.AverageLatency = latencies[key] / time.Duration(route.Delivered)
+		}
+		stats = append(stats, route)
+	}
+	return stats, nil
+}
+
+func newRouteStats(key routeKey) (*RouteStats, error) {
+	sourceBlockchainID, err := ids.FromString(key.sourceBlockchainID)
+	if err != nil {
+		return nil, err
+	}
+	destinationBlockchainID, err := ids.FromString(key.destinationBlockchainID)
+	if err != nil {
+		return nil, err
+	}
+	return &RouteStats{
+		Bridge:                  key.bridge,
+		SourceBlockchainID:      sourceBlockchainID,
+		SourceAddress:           common.HexToAddress(key.sourceAddress),
+		DestinationBlockchainID: destinationBlockchainID,
+		DestinationAddress:      common.HexToAddress(key.destinationAddress),
+		Volume:                  new(big.Int),
+		PrimaryFees:             make(map[common.Address]*big.Int),
+		SecondaryFees:           new(big.Int),
+	}, nil
+}
+
+// Adds the decimal amount, as stored, to the total
+func addAmount(total *big.Int, amount string) error {
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return fmt.Errorf("invalid amount %s", amount)
+	}
+	total.Add(total, value)
+	return nil
+}
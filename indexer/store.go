@@ -0,0 +1,286 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+
+	// Database drivers
+	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
+)
+
+// Driver is a supported database driver
+type Driver string
+
+const (
+	SQLite   Driver = "sqlite"
+	Postgres Driver = "postgres"
+)
+
+// Transfer is the initial send of tokens by a sender, identified by the ID of its first Teleporter message
+type Transfer struct {
+	MessageID          ids.ID
+	Bridge             string
+	Type               events.EventType
+	SourceBlockchainID ids.ID
+	// SourceAddress is the bridge contract the transfer was sent from
+	SourceAddress common.Address
+	TxHash        common.Hash
+	BlockNumber   uint64
+	BlockTime     time.Time
+	LogIndex      uint
+	Sender        common.Address
+	// Recipient is the recipient on the final destination, or the recipient contract for TokensAndCallSent
+	Recipient common.Address
+	Amount    *big.Int
+	// DestinationBlockchainID and DestinationAddress are the final destination of the transfer
+	DestinationBlockchainID ids.ID
+	DestinationAddress      common.Address
+}
+
+// HopSend is the send of a Teleporter message carrying the tokens of a transfer, either by the transfer's
+// initial send, or by the source routing a transfer between destinations.
+type HopSend struct {
+	MessageID ids.ID
+	// PreviousMessageID is the message delivering the tokens that were routed by this hop, if any
+	PreviousMessageID       ids.ID
+	Bridge                  string
+	SourceBlockchainID      ids.ID
+	DestinationBlockchainID ids.ID
+	// DestinationAddress is the bridge contract the message is sent to
+	DestinationAddress common.Address
+	Type               events.EventType
+	TxHash             common.Hash
+	BlockNumber        uint64
+	BlockTime          time.Time
+}
+
+// HopDelivery is the delivery of a Teleporter message carrying the tokens of a transfer
+type HopDelivery struct {
+	MessageID               ids.ID
+	Bridge                  string
+	SourceBlockchainID      ids.ID
+	DestinationBlockchainID ids.ID
+	DestinationAddress      common.Address
+	Type                    events.EventType
+	TxHash                  common.Hash
+	BlockNumber             uint64
+	BlockTime               time.Time
+}
+
+// CallResult is the result of calling the recipient contract of a TokensAndCallSent transfer
+type CallResult struct {
+	MessageID         ids.ID
+	Bridge            string
+	BlockchainID      ids.ID
+	TxHash            common.Hash
+	BlockNumber       uint64
+	BlockTime         time.Time
+	RecipientContract common.Address
+	Amount            *big.Int
+	Succeeded         bool
+}
+
+// Fee is the relayer fees paid for a hop
+type Fee struct {
+	MessageID    ids.ID
+	Bridge       string
+	BlockchainID ids.ID
+	FeeToken     common.Address
+	PrimaryFee   *big.Int
+	SecondaryFee *big.Int
+}
+
+// Batch is the rows derived from the events of a range of blocks of a single chain
+type Batch struct {
+	Transfers     []*Transfer
+	HopSends      []*HopSend
+	HopDeliveries []*HopDelivery
+	CallResults   []*CallResult
+	Fees          []*Fee
+}
+
+// Store persists indexed bridge events, along with a checkpoint of the next block to index for each chain
+type Store struct {
+	db     *sql.DB
+	driver Driver
+}
+
+// OpenStore connects to the configured database and creates the schema if it does not exist
+func OpenStore(ctx context.Context, config DatabaseConfig) (*Store, error) {
+	db, err := sql.Open(string(config.Driver), config.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	if config.Driver == SQLite {
+		// SQLite only supports a single writer, so serialize access rather than retrying busy errors
+		db.SetMaxOpenConns(1)
+	}
+	store := &Store{
+		db:     db,
+		driver: config.Driver,
+	}
+	for _, statement := range schema {
+		if _, err := db.ExecContext(ctx, statement); err != nil {
+			db.Close()
+			return nil, fmt.Errorf("failed to create schema: %w", err)
+		}
+	}
+	return store, nil
+}
+
+// Close closes the database connection
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Checkpoint returns the next block to index for the chain, or false if the chain has not been indexed
+func (s *Store) Checkpoint(ctx context.Context, chain string) (uint64, bool, error) {
+	var nextBlock uint64
+	err := s.db.QueryRowContext(
+		ctx,
+		s.rebind(`SELECT next_block FROM checkpoints WHERE chain = ?`),
+		chain,
+	).Scan(&nextBlock)
+	if err == sql.ErrNoRows {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to get checkpoint of chain %s: %w", chain, err)
+	}
+	return nextBlock, true, nil
+}
+
+// Apply writes the batch and advances the chain's checkpoint to nextBlock in a single transaction.
+// Writes are idempotent, so a batch may be safely re-applied after a failure.
+func (s *Store) Apply(ctx context.Context, chain string, batch *Batch, nextBlock uint64) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback() //nolint:errcheck
+
+	for _, t := range batch.Transfers {
+		if err := s.exec(ctx, tx, `INSERT INTO transfers (
+				message_id, bridge, type, source_blockchain_id, source_address, tx_hash, block_number,
+				block_time, log_index, sender, recipient, amount, destination_blockchain_id, destination_address
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (message_id) DO NOTHING`,
+			t.MessageID.String(), t.Bridge, string(t.Type), t.SourceBlockchainID.String(), t.SourceAddress.Hex(),
+			t.TxHash.Hex(), t.BlockNumber, t.BlockTime.Unix(), t.LogIndex, t.Sender.Hex(), t.Recipient.Hex(),
+			t.Amount.String(), t.DestinationBlockchainID.String(), t.DestinationAddress.Hex(),
+		); err != nil {
+			return fmt.Errorf("failed to insert transfer: %w", err)
+		}
+	}
+	for _, h := range batch.HopSends {
+		var previousMessageID sql.NullString
+		if h.PreviousMessageID != ids.Empty {
+			previousMessageID = sql.NullString{String: h.PreviousMessageID.String(), Valid: true}
+		}
+		if err := s.exec(ctx, tx, `INSERT INTO hops (
+				message_id, previous_message_id, bridge, source_blockchain_id, destination_blockchain_id,
+				destination_address, send_type, send_tx_hash, send_block_number, send_block_time
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (message_id) DO UPDATE SET
+				previous_message_id = excluded.previous_message_id,
+				send_type = excluded.send_type,
+				send_tx_hash = excluded.send_tx_hash,
+				send_block_number = excluded.send_block_number,
+				send_block_time = excluded.send_block_time`,
+			h.MessageID.String(), previousMessageID, h.Bridge, h.SourceBlockchainID.String(),
+			h.DestinationBlockchainID.String(), h.DestinationAddress.Hex(), string(h.Type), h.TxHash.Hex(),
+			h.BlockNumber, h.BlockTime.Unix(),
+		); err != nil {
+			return fmt.Errorf("failed to insert hop send: %w", err)
+		}
+	}
+	for _, h := range batch.HopDeliveries {
+		if err := s.exec(ctx, tx, `INSERT INTO hops (
+				message_id, bridge, source_blockchain_id, destination_blockchain_id, destination_address,
+				delivery_type, delivery_tx_hash, delivery_block_number, delivery_block_time
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (message_id) DO UPDATE SET
+				delivery_type = excluded.delivery_type,
+				delivery_tx_hash = excluded.delivery_tx_hash,
+				delivery_block_number = excluded.delivery_block_number,
+				delivery_block_time = excluded.delivery_block_time`,
+			h.MessageID.String(), h.Bridge, h.SourceBlockchainID.String(), h.DestinationBlockchainID.String(),
+			h.DestinationAddress.Hex(), string(h.Type), h.TxHash.Hex(), h.BlockNumber, h.BlockTime.Unix(),
+		); err != nil {
+			return fmt.Errorf("failed to insert hop delivery: %w", err)
+		}
+	}
+	for _, c := range batch.CallResults {
+		succeeded := 0
+		if c.Succeeded {
+			succeeded = 1
+		}
+		if err := s.exec(ctx, tx, `INSERT INTO call_results (
+				message_id, bridge, blockchain_id, tx_hash, block_number, block_time,
+				recipient_contract, amount, succeeded
+			) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (message_id) DO NOTHING`,
+			c.MessageID.String(), c.Bridge, c.BlockchainID.String(), c.TxHash.Hex(), c.BlockNumber,
+			c.BlockTime.Unix(), c.RecipientContract.Hex(), c.Amount.String(), succeeded,
+		); err != nil {
+			return fmt.Errorf("failed to insert call result: %w", err)
+		}
+	}
+	for _, f := range batch.Fees {
+		if err := s.exec(ctx, tx, `INSERT INTO fees (
+				message_id, bridge, blockchain_id, fee_token, primary_fee, secondary_fee
+			) VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (message_id) DO NOTHING`,
+			f.MessageID.String(), f.Bridge, f.BlockchainID.String(), f.FeeToken.Hex(), f.PrimaryFee.String(),
+			f.SecondaryFee.String(),
+		); err != nil {
+			return fmt.Errorf("failed to insert fee: %w", err)
+		}
+	}
+
+	if err := s.exec(ctx, tx, `INSERT INTO checkpoints (chain, next_block) VALUES (?, ?)
+		ON CONFLICT (chain) DO UPDATE SET next_block = excluded.next_block`,
+		chain, nextBlock,
+	); err != nil {
+		return fmt.Errorf("failed to update checkpoint: %w", err)
+	}
+	return tx.Commit()
+}
+
+func (s *Store) exec(ctx context.Context, tx *sql.Tx, query string, args ...interface{}) error {
+	_, err := tx.ExecContext(ctx, s.rebind(query), args...)
+	return err
+}
+
+// Queries are written with ? placeholders, which Postgres requires to be numbered
+func (s *Store) rebind(query string) string {
+	if s.driver != Postgres {
+		return query
+	}
+	var (
+		builder strings.Builder
+		n       int
+	)
+	for _, r := range query {
+		if r != '?' {
+			builder.WriteRune(r)
+			continue
+		}
+		n++
+		builder.WriteString("$" + strconv.Itoa(n))
+	}
+	return builder.String()
+}
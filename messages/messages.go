@@ -0,0 +1,251 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package messages encodes and decodes the messages sent between bridge contracts over Teleporter, as defined
+// in ITeleporterTokenBridge.sol, so that pending messages can be inspected off-chain without a contract call.
+package messages
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrUnknownMessageType = errors.New("unknown bridge message type")
+
+// MessageType is the type of a bridge message, matching the BridgeMessageType enum defined in
+// ITeleporterTokenBridge.sol
+type MessageType uint8
+
+const (
+	RegisterDestination MessageType = iota
+	SingleHopSend
+	SingleHopCall
+	MultiHopSend
+	MultiHopCall
+)
+
+func (t MessageType) String() string {
+	switch t {
+	case RegisterDestination:
+		return "RegisterDestination"
+	case SingleHopSend:
+		return "SingleHopSend"
+	case SingleHopCall:
+		return "SingleHopCall"
+	case MultiHopSend:
+		return "MultiHopSend"
+	case MultiHopCall:
+		return "MultiHopCall"
+	default:
+		return fmt.Sprintf("MessageType(%d)", uint8(t))
+	}
+}
+
+// The structs below mirror the message structs defined in ITeleporterTokenBridge.sol. abigen does not generate
+// bindings for standalone structs, so they must be kept up-to-date with the contract definitions manually.
+
+// BridgeMessage wraps the payload of each message between two bridge contracts with its message type
+type BridgeMessage struct {
+	MessageType uint8
+	Payload     []byte
+}
+
+// Message is the decoded payload of a bridge message
+type Message interface {
+	Type() MessageType
+}
+
+// RegisterDestinationMessage is sent by a destination to register itself with its source
+type RegisterDestinationMessage struct {
+	InitialReserveImbalance *big.Int
+	TokenMultiplier         *big.Int
+	MultiplyOnDestination   bool
+}
+
+// SingleHopSendMessage sends tokens to a recipient on the chain receiving the message
+type SingleHopSendMessage struct {
+	Recipient common.Address
+	Amount    *big.Int
+}
+
+// SingleHopCallMessage sends tokens to a recipient contract on the chain receiving the message, and calls it
+type SingleHopCallMessage struct {
+	SourceBlockchainID  [32]byte
+	OriginSenderAddress common.Address
+	RecipientContract   common.Address
+	Amount              *big.Int
+	RecipientPayload    []byte
+	RecipientGasLimit   *big.Int
+	FallbackRecipient   common.Address
+}
+
+// MultiHopSendMessage is sent by a destination to its source, to route tokens on to another destination
+type MultiHopSendMessage struct {
+	DestinationBlockchainID  [32]byte
+	DestinationBridgeAddress common.Address
+	Recipient                common.Address
+	Amount                   *big.Int
+	SecondaryFee             *big.Int
+	SecondaryGasLimit        *big.Int
+	MultiHopFallback         common.Address
+}
+
+// MultiHopCallMessage is sent by a destination to its source, to route tokens on to a recipient contract on
+// another destination, and call it
+type MultiHopCallMessage struct {
+	OriginSenderAddress       common.Address
+	DestinationBlockchainID   [32]byte
+	DestinationBridgeAddress  common.Address
+	RecipientContract         common.Address
+	Amount                    *big.Int
+	RecipientPayload          []byte
+	RecipientGasLimit         *big.Int
+	FallbackRecipient         common.Address
+	SecondaryRequiredGasLimit *big.Int
+	MultiHopFallback          common.Address
+	SecondaryFee              *big.Int
+}
+
+func (RegisterDestinationMessage) Type() MessageType { return RegisterDestination }
+func (SingleHopSendMessage) Type() MessageType       { return SingleHopSend }
+func (SingleHopCallMessage) Type() MessageType       { return SingleHopCall }
+func (MultiHopSendMessage) Type() MessageType        { return MultiHopSend }
+func (MultiHopCallMessage) Type() MessageType        { return MultiHopCall }
+
+var (
+	bridgeMessageArgs abi.Arguments
+	payloadArgs       map[MessageType]abi.Arguments
+)
+
+func init() {
+	bridgeMessageArgs = newTupleArguments("BridgeMessage", []abi.ArgumentMarshaling{
+		{Name: "messageType", Type: "uint8"},
+		{Name: "payload", Type: "bytes"},
+	})
+	payloadArgs = map[MessageType]abi.Arguments{
+		RegisterDestination: newTupleArguments("RegisterDestinationMessage", []abi.ArgumentMarshaling{
+			{Name: "initialReserveImbalance", Type: "uint256"},
+			{Name: "tokenMultiplier", Type: "uint256"},
+			{Name: "multiplyOnDestination", Type: "bool"},
+		}),
+		SingleHopSend: newTupleArguments("SingleHopSendMessage", []abi.ArgumentMarshaling{
+			{Name: "recipient", Type: "address"},
+			{Name: "amount", Type: "uint256"},
+		}),
+		SingleHopCall: newTupleArguments("SingleHopCallMessage", []abi.ArgumentMarshaling{
+			{Name: "sourceBlockchainID", Type: "bytes32"},
+			{Name: "originSenderAddress", Type: "address"},
+			{Name: "recipientContract", Type: "address"},
+			{Name: "amount", Type: "uint256"},
+			{Name: "recipientPayload", Type: "bytes"},
+			{Name: "recipientGasLimit", Type: "uint256"},
+			{Name: "fallbackRecipient", Type: "address"},
+		}),
+		MultiHopSend: newTupleArguments("MultiHopSendMessage", []abi.ArgumentMarshaling{
+			{Name: "destinationBlockchainID", Type: "bytes32"},
+			{Name: "destinationBridgeAddress", Type: "address"},
+			{Name: "recipient", Type: "address"},
+			{Name: "amount", Type: "uint256"},
+			{Name: "secondaryFee", Type: "uint256"},
+			{Name: "secondaryGasLimit", Type: "uint256"},
+			{Name: "multiHopFallback", Type: "address"},
+		}),
+		MultiHopCall: newTupleArguments("MultiHopCallMessage", []abi.ArgumentMarshaling{
+			{Name: "originSenderAddress", Type: "address"},
+			{Name: "destinationBlockchainID", Type: "bytes32"},
+			{Name: "destinationBridgeAddress", Type: "address"},
+			{Name: "recipientContract", Type: "address"},
+			{Name: "amount", Type: "uint256"},
+			{Name: "recipientPayload", Type: "bytes"},
+			{Name: "recipientGasLimit", Type: "uint256"},
+			{Name: "fallbackRecipient", Type: "address"},
+			{Name: "secondaryRequiredGasLimit", Type: "uint256"},
+			{Name: "multiHopFallback", Type: "address"},
+			{Name: "secondaryFee", Type: "uint256"},
+		}),
+	}
+}
+
+// Returns the arguments of a single struct, as ABI encoded by abi.encode in Solidity
+func newTupleArguments(name string, components []abi.ArgumentMarshaling) abi.Arguments {
+	tupleType, err := abi.NewType("tuple", "struct "+name, components)
+	if err != nil {
+		panic(fmt.Sprintf("failed to create %s ABI type: %v", name, err))
+	}
+	return abi.Arguments{{Type: tupleType}}
+}
+
+// EncodeBridgeMessage ABI encodes a BridgeMessage with the given type and payload the same way the bridge
+// contracts do, for use as the message of a Teleporter message
+func EncodeBridgeMessage(messageType MessageType, payload []byte) ([]byte, error) {
+	return bridgeMessageArgs.Pack(BridgeMessage{
+		MessageType: uint8(messageType),
+		Payload:     payload,
+	})
+}
+
+// DecodeBridgeMessage decodes the message of a Teleporter message sent by a bridge contract into its type and
+// still encoded payload
+func DecodeBridgeMessage(data []byte) (*BridgeMessage, error) {
+	values, err := bridgeMessageArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bridge message: %w", err)
+	}
+	return abi.ConvertType(values[0], new(BridgeMessage)).(*BridgeMessage), nil
+}
+
+// EncodePayload ABI encodes a message as the payload of a BridgeMessage
+func EncodePayload(message Message) ([]byte, error) {
+	args, ok := payloadArgs[message.Type()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMessageType, message.Type())
+	}
+	return args.Pack(message)
+}
+
+// DecodePayload decodes the payload of a BridgeMessage of the given type
+func DecodePayload(messageType MessageType, payload []byte) (Message, error) {
+	args, ok := payloadArgs[messageType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownMessageType, messageType)
+	}
+	values, err := args.Unpack(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode %s payload: %w", messageType, err)
+	}
+	switch messageType {
+	case RegisterDestination:
+		return *abi.ConvertType(values[0], new(RegisterDestinationMessage)).(*RegisterDestinationMessage), nil
+	case SingleHopSend:
+		return *abi.ConvertType(values[0], new(SingleHopSendMessage)).(*SingleHopSendMessage), nil
+	case SingleHopCall:
+		return *abi.ConvertType(values[0], new(SingleHopCallMessage)).(*SingleHopCallMessage), nil
+	case MultiHopSend:
+		return *abi.ConvertType(values[0], new(MultiHopSendMessage)).(*MultiHopSendMessage), nil
+	default:
+		return *abi.ConvertType(values[0], new(MultiHopCallMessage)).(*MultiHopCallMessage), nil
+	}
+}
+
+// Encode ABI encodes a message as a BridgeMessage, for use as the message of a Teleporter message
+func Encode(message Message) ([]byte, error) {
+	payload, err := EncodePayload(message)
+	if err != nil {
+		return nil, err
+	}
+	return EncodeBridgeMessage(message.Type(), payload)
+}
+
+// Decode decodes the message of a Teleporter message sent by a bridge contract. The returned message is
+// one of the message structs of this package, which can be distinguished by its Type.
+func Decode(data []byte) (Message, error) {
+	bridgeMessage, err := DecodeBridgeMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	return DecodePayload(MessageType(bridgeMessage.MessageType), bridgeMessage.Payload)
+}
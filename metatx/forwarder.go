@@ -0,0 +1,191 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package metatx
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// The subset of the ABI of ERC20SendForwarder.sol used to relay send requests
+const forwarderABI = `[
+	{
+		"type": "function",
+		"name": "execute",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "request", "type": "tuple", "components": [
+				{"name": "from", "type": "address"},
+				{"name": "bridge", "type": "address"},
+				{"name": "token", "type": "address"},
+				{"name": "input", "type": "tuple", "components": [
+					{"name": "destinationBlockchainID", "type": "bytes32"},
+					{"name": "destinationBridgeAddress", "type": "address"},
+					{"name": "recipient", "type": "address"},
+					{"name": "primaryFeeTokenAddress", "type": "address"},
+					{"name": "primaryFee", "type": "uint256"},
+					{"name": "secondaryFee", "type": "uint256"},
+					{"name": "requiredGasLimit", "type": "uint256"},
+					{"name": "multiHopFallback", "type": "address"}
+				]},
+				{"name": "amount", "type": "uint256"},
+				{"name": "nonce", "type": "uint256"},
+				{"name": "deadline", "type": "uint256"}
+			]},
+			{"name": "signature", "type": "bytes"}
+		],
+		"outputs": []
+	},
+	{
+		"type": "function",
+		"name": "hashSendRequest",
+		"stateMutability": "view",
+		"inputs": [
+			{"name": "request", "type": "tuple", "components": [
+				{"name": "from", "type": "address"},
+				{"name": "bridge", "type": "address"},
+				{"name": "token", "type": "address"},
+				{"name": "input", "type": "tuple", "components": [
+					{"name": "destinationBlockchainID", "type": "bytes32"},
+					{"name": "destinationBridgeAddress", "type": "address"},
+					{"name": "recipient", "type": "address"},
+					{"name": "primaryFeeTokenAddress", "type": "address"},
+					{"name": "primaryFee", "type": "uint256"},
+					{"name": "secondaryFee", "type": "uint256"},
+					{"name": "requiredGasLimit", "type": "uint256"},
+					{"name": "multiHopFallback", "type": "address"}
+				]},
+				{"name": "amount", "type": "uint256"},
+				{"name": "nonce", "type": "uint256"},
+				{"name": "deadline", "type": "uint256"}
+			]}
+		],
+		"outputs": [{"name": "", "type": "bytes32"}]
+	},
+	{
+		"type": "function",
+		"name": "nonces",
+		"stateMutability": "view",
+		"inputs": [{"name": "from", "type": "address"}],
+		"outputs": [{"name": "nonce", "type": "uint256"}]
+	},
+	{
+		"type": "event",
+		"name": "SendRequestExecuted",
+		"anonymous": false,
+		"inputs": [
+			{"name": "from", "type": "address", "indexed": true},
+			{"name": "bridge", "type": "address", "indexed": true},
+			{"name": "nonce", "type": "uint256", "indexed": false},
+			{"name": "relayer", "type": "address", "indexed": false}
+		]
+	}
+]`
+
+var parsedForwarderABI abi.ABI
+
+func init() {
+	var err error
+	parsedForwarderABI, err = abi.JSON(strings.NewReader(forwarderABI))
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse forwarder ABI: %v", err))
+	}
+}
+
+// SendRequestExecuted is the event emitted by the forwarder when it executes a send request
+type SendRequestExecuted struct {
+	From    common.Address
+	Bridge  common.Address
+	Nonce   *big.Int
+	Relayer common.Address
+}
+
+// Forwarder is a client of an ERC20SendForwarder deployment, used by relayers to submit send requests
+type Forwarder struct {
+	Address common.Address
+
+	chainID  *big.Int
+	contract *bind.BoundContract
+}
+
+// NewForwarder returns a client of the forwarder deployed at address on the chain with the given EVM chain ID
+func NewForwarder(address common.Address, chainID *big.Int, backend bind.ContractBackend) *Forwarder {
+	return &Forwarder{
+		Address:  address,
+		chainID:  chainID,
+		contract: bind.NewBoundContract(address, parsedForwarderABI, backend, backend, backend),
+	}
+}
+
+// Nonce returns the nonce that the next request of the token holder must include
+func (f *Forwarder) Nonce(opts *bind.CallOpts, from common.Address) (*big.Int, error) {
+	var out []interface{}
+	if err := f.contract.Call(opts, &out, "nonces", from); err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out[0], new(*big.Int)).(**big.Int), nil
+}
+
+// HashSendRequest returns the EIP-712 digest of the request as computed by the forwarder
+func (f *Forwarder) HashSendRequest(opts *bind.CallOpts, request SendRequest) (common.Hash, error) {
+	var out []interface{}
+	if err := f.contract.Call(opts, &out, "hashSendRequest", request); err != nil {
+		return common.Hash{}, err
+	}
+	return *abi.ConvertType(out[0], new([32]byte)).(*[32]byte), nil
+}
+
+// Sign returns the signature of the request by key for this forwarder
+func (f *Forwarder) Sign(key *ecdsa.PrivateKey, request SendRequest) ([]byte, error) {
+	return Sign(key, f.chainID, f.Address, request)
+}
+
+// Execute submits the signed request to the forwarder as is
+func (f *Forwarder) Execute(
+	opts *bind.TransactOpts,
+	request SendRequest,
+	signature []byte,
+) (*types.Transaction, error) {
+	return f.contract.Transact(opts, "execute", request, signature)
+}
+
+// Relay checks that the request is signed by its token holder and carries their next nonce,
+// and submits it to the forwarder on their behalf. The transaction is paid for by opts.From.
+func (f *Forwarder) Relay(
+	opts *bind.TransactOpts,
+	request SendRequest,
+	signature []byte,
+) (*types.Transaction, error) {
+	signer, err := Recover(f.chainID, f.Address, request, signature)
+	if err != nil {
+		return nil, err
+	}
+	if signer != request.From {
+		return nil, fmt.Errorf("%w: signed by %s rather than %s", errInvalidSignature, signer, request.From)
+	}
+	nonce, err := f.Nonce(&bind.CallOpts{Context: opts.Context}, request.From)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get nonce of %s: %w", request.From, err)
+	}
+	if request.Nonce == nil || nonce.Cmp(request.Nonce) != 0 {
+		return nil, fmt.Errorf("invalid nonce %v, the next nonce of %s is %v", request.Nonce, request.From, nonce)
+	}
+	return f.Execute(opts, request, signature)
+}
+
+// ParseSendRequestExecuted parses a SendRequestExecuted event emitted by the forwarder
+func (f *Forwarder) ParseSendRequestExecuted(log types.Log) (*SendRequestExecuted, error) {
+	event := new(SendRequestExecuted)
+	if err := f.contract.UnpackLog(event, "SendRequestExecuted", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
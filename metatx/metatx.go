@@ -0,0 +1,168 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package metatx signs EIP-712 typed send requests for ERC20SendForwarder.sol, and submits them on behalf
+// of the token holder, so that a relayer such as a wallet can pay the gas of bridging tokens for its users.
+package metatx
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+const (
+	// DomainName is the EIP-712 domain name of ERC20SendForwarder
+	DomainName = "ERC20SendForwarder"
+	// DomainVersion is the EIP-712 domain version of ERC20SendForwarder
+	DomainVersion = "1"
+
+	primaryType = "SendRequest"
+)
+
+var errInvalidSignature = errors.New("invalid signature")
+
+// SendRequest is a request signed by a token holder to send ERC20 tokens through a bridge,
+// matching the SendRequest struct of IERC20SendForwarder.sol
+type SendRequest struct {
+	From     common.Address
+	Bridge   common.Address
+	Token    common.Address
+	Input    erc20source.SendTokensInput
+	Amount   *big.Int
+	Nonce    *big.Int
+	Deadline *big.Int
+}
+
+var sendRequestTypes = apitypes.Types{
+	"EIP712Domain": {
+		{Name: "name", Type: "string"},
+		{Name: "version", Type: "string"},
+		{Name: "chainId", Type: "uint256"},
+		{Name: "verifyingContract", Type: "address"},
+	},
+	"SendRequest": {
+		{Name: "from", Type: "address"},
+		{Name: "bridge", Type: "address"},
+		{Name: "token", Type: "address"},
+		{Name: "input", Type: "SendTokensInput"},
+		{Name: "amount", Type: "uint256"},
+		{Name: "nonce", Type: "uint256"},
+		{Name: "deadline", Type: "uint256"},
+	},
+	"SendTokensInput": {
+		{Name: "destinationBlockchainID", Type: "bytes32"},
+		{Name: "destinationBridgeAddress", Type: "address"},
+		{Name: "recipient", Type: "address"},
+		{Name: "primaryFeeTokenAddress", Type: "address"},
+		{Name: "primaryFee", Type: "uint256"},
+		{Name: "secondaryFee", Type: "uint256"},
+		{Name: "requiredGasLimit", Type: "uint256"},
+		{Name: "multiHopFallback", Type: "address"},
+	},
+}
+
+// TypedData returns the EIP-712 typed data of the request for the forwarder deployed at forwarderAddress
+// on the chain with the given EVM chain ID. The typed data is JSON encoded in the form expected by
+// eth_signTypedData_v4, for requests that are signed by a wallet.
+func TypedData(chainID *big.Int, forwarderAddress common.Address, request SendRequest) apitypes.TypedData {
+	return apitypes.TypedData{
+		Types:       sendRequestTypes,
+		PrimaryType: primaryType,
+		Domain: apitypes.TypedDataDomain{
+			Name:              DomainName,
+			Version:           DomainVersion,
+			ChainId:           (*math.HexOrDecimal256)(chainID),
+			VerifyingContract: forwarderAddress.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"from":   request.From.Hex(),
+			"bridge": request.Bridge.Hex(),
+			"token":  request.Token.Hex(),
+			"input": apitypes.TypedDataMessage{
+				"destinationBlockchainID":  hexutil.Encode(request.Input.DestinationBlockchainID[:]),
+				"destinationBridgeAddress": request.Input.DestinationBridgeAddress.Hex(),
+				"recipient":                request.Input.Recipient.Hex(),
+				"primaryFeeTokenAddress":   request.Input.PrimaryFeeTokenAddress.Hex(),
+				"primaryFee":               bigString(request.Input.PrimaryFee),
+				"secondaryFee":             bigString(request.Input.SecondaryFee),
+				"requiredGasLimit":         bigString(request.Input.RequiredGasLimit),
+				"multiHopFallback":         request.Input.MultiHopFallback.Hex(),
+			},
+			"amount":   bigString(request.Amount),
+			"nonce":    bigString(request.Nonce),
+			"deadline": bigString(request.Deadline),
+		},
+	}
+}
+
+// Hash returns the EIP-712 digest of the request, which is signed by the token holder
+func Hash(chainID *big.Int, forwarderAddress common.Address, request SendRequest) (common.Hash, error) {
+	hash, _, err := apitypes.TypedDataAndHash(TypedData(chainID, forwarderAddress, request))
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("failed to hash send request: %w", err)
+	}
+	return common.BytesToHash(hash), nil
+}
+
+// Sign returns the signature of the request by key, in the form expected by ERC20SendForwarder.execute
+func Sign(
+	key *ecdsa.PrivateKey,
+	chainID *big.Int,
+	forwarderAddress common.Address,
+	request SendRequest,
+) ([]byte, error) {
+	hash, err := Hash(chainID, forwarderAddress, request)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.Sign(hash.Bytes(), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign send request: %w", err)
+	}
+	// The forwarder expects the recovery ID to be offset by 27, as produced by wallets
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// Recover returns the address that signed the request, so that a relayer can reject requests
+// that would revert before submitting them
+func Recover(
+	chainID *big.Int,
+	forwarderAddress common.Address,
+	request SendRequest,
+	signature []byte,
+) (common.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return common.Address{}, errInvalidSignature
+	}
+	hash, err := Hash(chainID, forwarderAddress, request)
+	if err != nil {
+		return common.Address{}, err
+	}
+	sig := common.CopyBytes(signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	publicKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", errInvalidSignature, err)
+	}
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+// Unsigned integers are encoded as decimal strings, since JSON numbers cannot represent all uint256 values
+func bigString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+	return value.String()
+}
@@ -0,0 +1,190 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"go.uber.org/zap"
+)
+
+const alertRequestTimeout = 10 * time.Second
+
+// WebhookFormat determines the body of the requests sent to a webhook
+type WebhookFormat string
+
+const (
+	// GenericWebhook posts the Alert as JSON
+	GenericWebhook WebhookFormat = "generic"
+	// SlackWebhook posts a Slack incoming webhook message
+	SlackWebhook WebhookFormat = "slack"
+	// PagerDutyWebhook posts a PagerDuty Events API v2 event
+	PagerDutyWebhook WebhookFormat = "pagerduty"
+)
+
+// WebhookConfig is an HTTP endpoint that alerts are POSTed to
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// Format defaults to generic
+	Format WebhookFormat `json:"format"`
+	// RoutingKey is the PagerDuty integration key, required for the pagerduty format
+	RoutingKey string `json:"routing-key"`
+	// Headers are added to each request, for example to authenticate with the endpoint
+	Headers map[string]string `json:"headers"`
+}
+
+func (w *WebhookConfig) validate() error {
+	if w.URL == "" {
+		return fmt.Errorf("webhook url must be set")
+	}
+	switch w.Format {
+	case GenericWebhook, SlackWebhook:
+	case PagerDutyWebhook:
+		if w.RoutingKey == "" {
+			return fmt.Errorf("webhook %s must set a routing key", w.URL)
+		}
+	default:
+		return fmt.Errorf("invalid format %s for webhook %s", w.Format, w.URL)
+	}
+	return nil
+}
+
+// AlertsConfig configures the webhooks notified when a bridge becomes unhealthy
+type AlertsConfig struct {
+	Webhooks []WebhookConfig `json:"webhooks"`
+	// BurnedFeesThresholds maps bridge names to the maximum amount of transaction fees, as a decimal
+	// integer in the native token's smallest denomination, that may be burned on a NativeTokenDestination's
+	// chain without being reported to the source before alerting. Bridges without a threshold are not alerted on.
+	BurnedFeesThresholds map[string]string `json:"burned-fees-thresholds"`
+	// UnreachableSeconds is how long polls of a chain must have been failing, such as while its validators are
+	// halted, before alerting that the chain is unreachable. Defaults to 60.
+	UnreachableSeconds uint64 `json:"unreachable-seconds"`
+}
+
+// Alert is a condition of a bridge that needs the attention of an operator. An alert is sent
+// when the condition starts, and again with Resolved set once it no longer holds.
+type Alert struct {
+	// Key uniquely identifies the condition, and is used by the receiver to group notifications
+	Key      string    `json:"key"`
+	Bridge   string    `json:"bridge"`
+	Chain    string    `json:"chain"`
+	Address  string    `json:"address"`
+	Summary  string    `json:"summary"`
+	Resolved bool      `json:"resolved"`
+	Time     time.Time `json:"time"`
+}
+
+// Alerter notifies the configured webhooks of changes in the state of alert conditions
+type Alerter struct {
+	logger   logging.Logger
+	client   *http.Client
+	webhooks []WebhookConfig
+
+	lock   sync.Mutex
+	firing map[string]bool
+}
+
+// NewAlerter creates an alerter for the given webhooks
+func NewAlerter(logger logging.Logger, webhooks []WebhookConfig) *Alerter {
+	return &Alerter{
+		logger:   logger,
+		client:   &http.Client{Timeout: alertRequestTimeout},
+		webhooks: webhooks,
+		firing:   make(map[string]bool),
+	}
+}
+
+// Update records whether the condition of the alert holds, notifying the webhooks if this differs from the
+// last update with the same key. Conditions are initially assumed not to hold.
+func (a *Alerter) Update(ctx context.Context, alert Alert, firing bool) {
+	a.lock.Lock()
+	changed := a.firing[alert.Key] != firing
+	a.firing[alert.Key] = firing
+	a.lock.Unlock()
+	if !changed {
+		return
+	}
+
+	alert.Resolved = !firing
+	alert.Time = time.Now()
+	a.logger.Warn(
+		"Bridge alert state changed",
+		zap.String("key", alert.Key),
+		zap.String("summary", alert.Summary),
+		zap.Bool("resolved", alert.Resolved),
+	)
+	for _, webhook := range a.webhooks {
+		if err := a.send(ctx, webhook, alert); err != nil {
+			// Delivery is not retried, since the condition is re-evaluated and alerted on independently of
+			// webhook failures. Operators should also alert on the exported metrics.
+			a.logger.Error("Failed to send alert", zap.String("url", webhook.URL), zap.Error(err))
+		}
+	}
+}
+
+func (a *Alerter) send(ctx context.Context, webhook WebhookConfig, alert Alert) error {
+	body, err := json.Marshal(webhookBody(webhook, alert))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range webhook.Headers {
+		req.Header.Set(key, value)
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
+	}
+	return nil
+}
+
+func webhookBody(webhook WebhookConfig, alert Alert) interface{} {
+	switch webhook.Format {
+	case SlackWebhook:
+		status := "FIRING"
+		if alert.Resolved {
+			status = "RESOLVED"
+		}
+		return map[string]string{
+			"text": fmt.Sprintf("[%s] %s", status, alert.Summary),
+		}
+	case PagerDutyWebhook:
+		action := "trigger"
+		if alert.Resolved {
+			action = "resolve"
+		}
+		return map[string]interface{}{
+			"routing_key":  webhook.RoutingKey,
+			"event_action": action,
+			"dedup_key":    alert.Key,
+			"payload": map[string]interface{}{
+				"summary":   alert.Summary,
+				"source":    alert.Chain,
+				"severity":  "critical",
+				"timestamp": alert.Time.Format(time.RFC3339),
+				"custom_details": map[string]string{
+					"bridge":  alert.Bridge,
+					"address": alert.Address,
+				},
+			},
+		}
+	default:
+		return alert
+	}
+}
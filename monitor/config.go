@@ -0,0 +1,165 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tracing"
+)
+
+const (
+	defaultMetricsPort          = 9090
+	defaultPollIntervalSeconds  = 5
+	defaultStateIntervalSeconds = 30
+	defaultUnreachableSeconds   = 60
+)
+
+// Config is the configuration of the bridge monitoring service
+type Config struct {
+	LogLevel    string `json:"log-level"`
+	MetricsPort uint16 `json:"metrics-port"`
+	// PollIntervalSeconds is how often each chain is polled for new bridge events
+	PollIntervalSeconds uint64 `json:"poll-interval-seconds"`
+	// MaxBlockRange is the maximum number of blocks requested in a single eth_getLogs call
+	MaxBlockRange uint64 `json:"max-block-range"`
+	// StateIntervalSeconds is how often on-chain bridge state such as collateral is sampled
+	StateIntervalSeconds uint64 `json:"state-interval-seconds"`
+
+	// DriftTolerances maps bridge names to the maximum absolute balance drift, as a decimal integer
+	// in the token's smallest denomination, before reconciliation is considered failed. Bridges
+	// without a tolerance tolerate no drift. Transfers that are in flight while balances are
+	// sampled appear as transient drift, so tolerances should allow for them.
+	DriftTolerances map[string]string `json:"drift-tolerances"`
+
+	Alerts AlertsConfig `json:"alerts"`
+
+	// Tracing exports a trace for each delivered Teleporter message, timed by the block times of its send
+	// and delivery. Disabled unless an endpoint is set.
+	Tracing tracing.Config `json:"tracing"`
+
+	// LatencyBaselineFile optionally names a delivery latency report, such as the one written by the E2E suite of
+	// a release, that is exported as the baseline the live delivery latency is compared against
+	LatencyBaselineFile string `json:"latency-baseline-file"`
+
+	// VerifyStorageProofs reads the balances bridged to each destination, the collateral still needed for them and
+	// the supply of ERC20Destinations from eth_getProof storage proofs, verified against the state root of the
+	// latest block of each chain, rather than from contract views. The RPC nodes must serve eth_getProof.
+	VerifyStorageProofs bool `json:"verify-storage-proofs"`
+
+	Chains  []events.ChainConfig  `json:"chains"`
+	Bridges []events.BridgeConfig `json:"bridges"`
+
+	driftTolerances      map[string]*big.Int
+	burnedFeesThresholds map[string]*big.Int
+}
+
+// LoadConfig reads and validates the JSON configuration file at the given path,
+// filling in defaults for unset optional values.
+func LoadConfig(path string) (*Config, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var config Config
+	if err := json.Unmarshal(bytes, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	config.setDefaults()
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return &config, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.LogLevel == "" {
+		c.LogLevel = logging.Info.LowerString()
+	}
+	if c.MetricsPort == 0 {
+		c.MetricsPort = defaultMetricsPort
+	}
+	if c.PollIntervalSeconds == 0 {
+		c.PollIntervalSeconds = defaultPollIntervalSeconds
+	}
+	if c.StateIntervalSeconds == 0 {
+		c.StateIntervalSeconds = defaultStateIntervalSeconds
+	}
+	if c.Alerts.UnreachableSeconds == 0 {
+		c.Alerts.UnreachableSeconds = defaultUnreachableSeconds
+	}
+	for i := range c.Alerts.Webhooks {
+		if c.Alerts.Webhooks[i].Format == "" {
+			c.Alerts.Webhooks[i].Format = GenericWebhook
+		}
+	}
+}
+
+// Validate checks that the configuration is well formed
+func (c *Config) Validate() error {
+	if _, err := logging.ToLevel(c.LogLevel); err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+	if len(c.Bridges) == 0 {
+		return fmt.Errorf("no bridges configured")
+	}
+	if err := events.Validate(c.Chains, c.Bridges); err != nil {
+		return err
+	}
+
+	bridgeNames := make(map[string]struct{}, len(c.Bridges))
+	for _, bridge := range c.Bridges {
+		bridgeNames[bridge.Name] = struct{}{}
+	}
+	var err error
+	c.driftTolerances, err = events.ParseBridgeAmounts(c.DriftTolerances, bridgeNames, "drift tolerance")
+	if err != nil {
+		return err
+	}
+	c.burnedFeesThresholds, err = events.ParseBridgeAmounts(
+		c.Alerts.BurnedFeesThresholds,
+		bridgeNames,
+		"burned fees threshold",
+	)
+	if err != nil {
+		return err
+	}
+	for i := range c.Alerts.Webhooks {
+		if err := c.Alerts.Webhooks[i].validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDriftTolerances returns the parsed drift tolerances, keyed by bridge name
+func (c *Config) GetDriftTolerances() map[string]*big.Int {
+	return c.driftTolerances
+}
+
+// GetBurnedFeesThresholds returns the parsed unreported burned fees alert thresholds, keyed by bridge name
+func (c *Config) GetBurnedFeesThresholds() map[string]*big.Int {
+	return c.burnedFeesThresholds
+}
+
+func (c *Config) pollerConfig() events.PollerConfig {
+	return events.PollerConfig{
+		PollInterval:  time.Duration(c.PollIntervalSeconds) * time.Second,
+		MaxBlockRange: c.MaxBlockRange,
+	}
+}
+
+func (c *Config) stateInterval() time.Duration {
+	return time.Duration(c.StateIntervalSeconds) * time.Second
+}
+
+func (c *Config) unreachableAfter() time.Duration {
+	return time.Duration(c.Alerts.UnreachableSeconds) * time.Second
+}
@@ -0,0 +1,392 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	teleporterregistry "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/upgrades/TeleporterRegistry"
+	"github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+// The number of blocks whose ReceiptReceived events are requested at once, if the max block range is not set
+const defaultReceiptBlockRange = 2048
+
+// FeeReport is the result of auditing the fees paid by a bridge's contracts, and the tokens left held by them
+type FeeReport struct {
+	Bridge    string               `json:"bridge"`
+	Contracts []*ContractFeeReport `json:"contracts"`
+	// RelayerRewards are the fee rewards that the relayers of the bridge's messages can redeem from the Teleporter
+	// messengers of each chain
+	RelayerRewards []*RelayerReward `json:"relayerRewards"`
+	// Anomalous is whether any of the bridge's contracts holds residual fee tokens or allowances
+	Anomalous bool `json:"anomalous"`
+}
+
+// ContractFeeReport holds the fee tokens left with a bridge contract, which are expected to be zero, since each
+// fee is transferred to the contract and approved to the Teleporter messenger, which transfers it out, in the same
+// transaction. Residues are an early sign of an accounting bug.
+type ContractFeeReport struct {
+	Chain   string              `json:"chain"`
+	Address common.Address      `json:"address"`
+	Type    events.ContractType `json:"type"`
+	// FeeTokens are the tokens that the contract has paid fees in, including the token of a destination, which
+	// is always a potential fee token
+	FeeTokens []common.Address `json:"feeTokens"`
+	// Residues are the non-zero balances of the fee tokens held by the contract. The source token locked by a
+	// source is not a residue, and is reconciled with the supply of its destinations instead.
+	Residues []*TokenBalance `json:"residues"`
+	// Allowances are the non-zero allowances of the fee tokens that the contract has left with the Teleporter
+	// messengers of its registry
+	Allowances []*TokenAllowance `json:"allowances"`
+	Anomalies  []string          `json:"anomalies"`
+}
+
+// TokenBalance is a balance of an ERC20 token
+type TokenBalance struct {
+	Token  common.Address `json:"token"`
+	Amount *big.Int       `json:"amount"`
+}
+
+// TokenAllowance is an allowance of an ERC20 token to a spender
+type TokenAllowance struct {
+	Token   common.Address `json:"token"`
+	Spender common.Address `json:"spender"`
+	Amount  *big.Int       `json:"amount"`
+}
+
+// RelayerReward is the fee reward of a token that a relayer can redeem from a Teleporter messenger. Rewards of a
+// relayer are not tracked per application, so the amount includes rewards for relaying messages of other
+// applications in the same token.
+type RelayerReward struct {
+	Chain     string         `json:"chain"`
+	Messenger common.Address `json:"messenger"`
+	Relayer   common.Address `json:"relayer"`
+	FeeToken  common.Address `json:"feeToken"`
+	Amount    *big.Int       `json:"amount"`
+}
+
+// FeeAuditor enumerates the fees paid by bridge contracts, the relayer rewards accumulated for their messages,
+// and any fee tokens left held by or approved from the contracts
+type FeeAuditor struct {
+	logger        logging.Logger
+	chains        map[string]*events.Chain
+	bridges       []events.BridgeConfig
+	decoder       *events.Decoder
+	maxBlockRange uint64
+}
+
+// NewFeeAuditor creates an auditor for the given bridges. The events of each contract are scanned from its chain's
+// start block, which should be set to a block no later than the contract's deployment, since fees paid before it
+// are not found, or from genesis if it is not set.
+func NewFeeAuditor(
+	logger logging.Logger,
+	chains map[string]*events.Chain,
+	bridges []events.BridgeConfig,
+	maxBlockRange uint64,
+) (*FeeAuditor, error) {
+	decoder, err := events.NewDecoder()
+	if err != nil {
+		return nil, err
+	}
+	return &FeeAuditor{
+		logger:        logger,
+		chains:        chains,
+		bridges:       bridges,
+		decoder:       decoder,
+		maxBlockRange: maxBlockRange,
+	}, nil
+}
+
+// Audit audits each of the configured bridges
+func (a *FeeAuditor) Audit(ctx context.Context) ([]*FeeReport, error) {
+	reports := make([]*FeeReport, 0, len(a.bridges))
+	for _, bridge := range a.bridges {
+		report, err := a.AuditBridge(ctx, bridge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to audit bridge %s: %w", bridge.Name, err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// AuditBridge finds the fee tokens paid by each of the bridge's contracts and the messages they sent, and reports
+// the balances and allowances of the fee tokens left with each contract, and the rewards that the relayers of the
+// messages can redeem in each fee token
+func (a *FeeAuditor) AuditBridge(ctx context.Context, bridge events.BridgeConfig) (*FeeReport, error) {
+	report := &FeeReport{Bridge: bridge.Name}
+	contracts := append([]events.ContractConfig{bridge.Source}, bridge.Destinations...)
+	for _, contractConfig := range contracts {
+		contractReport, rewards, err := a.auditContract(ctx, bridge.Name, contractConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to audit %s on %s: %w", contractConfig.Address, contractConfig.Chain, err)
+		}
+		report.Contracts = append(report.Contracts, contractReport)
+		report.RelayerRewards = append(report.RelayerRewards, rewards...)
+		if len(contractReport.Anomalies) > 0 {
+			report.Anomalous = true
+		}
+	}
+	return report, nil
+}
+
+func (a *FeeAuditor) auditContract(
+	ctx context.Context,
+	bridgeName string,
+	contractConfig events.ContractConfig,
+) (*ContractFeeReport, []*RelayerReward, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	chain := a.chains[contractConfig.Chain]
+	address := common.HexToAddress(contractConfig.Address)
+	report := &ContractFeeReport{
+		Chain:   chain.Name,
+		Address: address,
+		Type:    contractConfig.Type,
+	}
+
+	// The token of a source is locked by it, so is only checked for allowances. A destination is its own token.
+	var (
+		lockedToken       common.Address
+		registryAddress   common.Address
+		feeTokens         = make(map[common.Address]struct{})
+		sentMessageIDs    = make(map[ids.ID]struct{})
+		latestBlockNumber uint64
+	)
+	if contractConfig.Type.IsSource() {
+		source, err := teleportertokensource.NewTeleporterTokenSource(address, chain.Client)
+		if err != nil {
+			return nil, nil, err
+		}
+		if lockedToken, err = source.TokenAddress(opts); err != nil {
+			return nil, nil, fmt.Errorf("failed to get source token address: %w", err)
+		}
+		if registryAddress, err = source.TeleporterRegistry(opts); err != nil {
+			return nil, nil, fmt.Errorf("failed to get TeleporterRegistry address: %w", err)
+		}
+		feeTokens[lockedToken] = struct{}{}
+	} else {
+		destination, err := teleportertokendestination.NewTeleporterTokenDestination(address, chain.Client)
+		if err != nil {
+			return nil, nil, err
+		}
+		if registryAddress, err = destination.TeleporterRegistry(opts); err != nil {
+			return nil, nil, fmt.Errorf("failed to get TeleporterRegistry address: %w", err)
+		}
+		feeTokens[address] = struct{}{}
+	}
+
+	poller := events.NewPoller(
+		a.logger,
+		chain,
+		a.decoder,
+		map[common.Address]events.ContractInfo{address: {Bridge: bridgeName, Type: contractConfig.Type}},
+		events.PollerConfig{MaxBlockRange: a.maxBlockRange},
+		func(_ context.Context, bridgeEvents []*events.Event, toBlock uint64) error {
+			for _, event := range bridgeEvents {
+				if event.TeleporterMessageID != ids.Empty {
+					sentMessageIDs[event.TeleporterMessageID] = struct{}{}
+				}
+				if event.Type.IsSend() && event.PrimaryFee != nil && event.PrimaryFee.Sign() > 0 {
+					feeTokens[event.PrimaryFeeTokenAddress] = struct{}{}
+				}
+			}
+			latestBlockNumber = toBlock
+			return nil
+		},
+	)
+	poller.SetNextBlock(chain.StartBlock)
+	for {
+		caughtUp, err := poller.Poll(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		if caughtUp {
+			break
+		}
+	}
+
+	messengers, err := a.messengers(ctx, chain, registryAddress)
+	if err != nil {
+		return nil, nil, err
+	}
+	for feeToken := range feeTokens {
+		report.FeeTokens = append(report.FeeTokens, feeToken)
+	}
+	sort.Slice(report.FeeTokens, func(i, j int) bool {
+		return bytes.Compare(report.FeeTokens[i].Bytes(), report.FeeTokens[j].Bytes()) < 0
+	})
+	for _, feeToken := range report.FeeTokens {
+		token, err := exampleerc20.NewExampleERC20(feeToken, chain.Client)
+		if err != nil {
+			return nil, nil, err
+		}
+		if feeToken != lockedToken {
+			balance, err := token.BalanceOf(opts, address)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get balance of fee token %s: %w", feeToken, err)
+			}
+			if balance.Sign() > 0 {
+				report.Residues = append(report.Residues, &TokenBalance{Token: feeToken, Amount: balance})
+				report.Anomalies = append(
+					report.Anomalies,
+					fmt.Sprintf("holds a residual balance of %s of fee token %s", balance, feeToken),
+				)
+			}
+		}
+		for _, messenger := range messengers {
+			allowance, err := token.Allowance(opts, address, messenger)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to get allowance of fee token %s: %w", feeToken, err)
+			}
+			if allowance.Sign() > 0 {
+				report.Allowances = append(report.Allowances, &TokenAllowance{
+					Token:   feeToken,
+					Spender: messenger,
+					Amount:  allowance,
+				})
+				report.Anomalies = append(report.Anomalies, fmt.Sprintf(
+					"left an allowance of %s of fee token %s to Teleporter %s",
+					allowance,
+					feeToken,
+					messenger,
+				))
+			}
+		}
+	}
+
+	rewards, err := a.relayerRewards(ctx, chain, messengers, sentMessageIDs, latestBlockNumber)
+	if err != nil {
+		return nil, nil, err
+	}
+	a.logger.Debug(
+		"Audited bridge contract",
+		zap.String("chain", chain.Name),
+		zap.Stringer("address", address),
+		zap.Int("feeTokens", len(report.FeeTokens)),
+		zap.Int("sentMessages", len(sentMessageIDs)),
+		zap.Int("anomalies", len(report.Anomalies)),
+	)
+	return report, rewards, nil
+}
+
+// Returns the address of every Teleporter version in the registry, since fees may have been approved to, and
+// rewards accumulated with, versions that are no longer the latest
+func (a *FeeAuditor) messengers(
+	ctx context.Context,
+	chain *events.Chain,
+	registryAddress common.Address,
+) ([]common.Address, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	registry, err := teleporterregistry.NewTeleporterRegistry(registryAddress, chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	latestVersion, err := registry.LatestVersion(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latest Teleporter version: %w", err)
+	}
+	var messengers []common.Address
+	for version := uint64(1); version <= latestVersion.Uint64(); version++ {
+		messenger, err := registry.GetAddressFromVersion(opts, new(big.Int).SetUint64(version))
+		if err != nil {
+			// Versions may be skipped when they are registered
+			continue
+		}
+		messengers = append(messengers, messenger)
+	}
+	return messengers, nil
+}
+
+// Returns the rewards that the relayers rewarded for delivering the sent messages can redeem, in the fee tokens
+// they were rewarded in. Relayers are rewarded once the receipt of their delivery is received back on the chain
+// the message was sent from.
+func (a *FeeAuditor) relayerRewards(
+	ctx context.Context,
+	chain *events.Chain,
+	messengers []common.Address,
+	sentMessageIDs map[ids.ID]struct{},
+	toBlock uint64,
+) ([]*RelayerReward, error) {
+	if len(sentMessageIDs) == 0 {
+		return nil, nil
+	}
+	blockRange := a.maxBlockRange
+	if blockRange == 0 {
+		blockRange = defaultReceiptBlockRange
+	}
+
+	type rewardKey struct {
+		messenger common.Address
+		relayer   common.Address
+		feeToken  common.Address
+	}
+	var (
+		rewards []*RelayerReward
+		seen    = make(map[rewardKey]struct{})
+	)
+	for _, messengerAddress := range messengers {
+		messenger, err := teleportermessenger.NewTeleporterMessenger(messengerAddress, chain.Client)
+		if err != nil {
+			return nil, err
+		}
+		for fromBlock := chain.StartBlock; fromBlock <= toBlock; fromBlock += blockRange {
+			endBlock := min(fromBlock+blockRange-1, toBlock)
+			it, err := messenger.FilterReceiptReceived(
+				&bind.FilterOpts{Context: ctx, Start: fromBlock, End: &endBlock},
+				nil,
+				nil,
+				nil,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to filter receipts of Teleporter %s: %w", messengerAddress, err)
+			}
+			for it.Next() {
+				if _, ok := sentMessageIDs[ids.ID(it.Event.MessageID)]; !ok {
+					continue
+				}
+				key := rewardKey{messengerAddress, it.Event.RelayerRewardAddress, it.Event.FeeInfo.FeeTokenAddress}
+				if _, ok := seen[key]; ok || it.Event.FeeInfo.Amount.Sign() == 0 {
+					continue
+				}
+				seen[key] = struct{}{}
+				amount, err := messenger.CheckRelayerRewardAmount(
+					&bind.CallOpts{Context: ctx},
+					key.relayer,
+					key.feeToken,
+				)
+				if err != nil {
+					it.Close()
+					return nil, fmt.Errorf("failed to get reward of relayer %s: %w", key.relayer, err)
+				}
+				rewards = append(rewards, &RelayerReward{
+					Chain:     chain.Name,
+					Messenger: messengerAddress,
+					Relayer:   key.relayer,
+					FeeToken:  key.feeToken,
+					Amount:    amount,
+				})
+			}
+			err = it.Error()
+			it.Close()
+			if err != nil {
+				return nil, fmt.Errorf("failed to iterate receipts of Teleporter %s: %w", messengerAddress, err)
+			}
+		}
+	}
+	return rewards, nil
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Sends and deliveries that have not been matched within this window are dropped
+const latencyTrackerWindow = 24 * time.Hour
+
+type messageObservation struct {
+	chain       string
+	time        time.Time
+	eventType   events.EventType
+	txHash      common.Hash
+	blockNumber uint64
+}
+
+func newMessageObservation(event *events.Event) messageObservation {
+	return messageObservation{
+		chain:       event.Chain,
+		time:        event.BlockTime,
+		eventType:   event.Type,
+		txHash:      event.TxHash,
+		blockNumber: event.BlockNumber,
+	}
+}
+
+// latencyTracker matches the send and delivery of each Teleporter message to compute delivery latency.
+// Chains are polled independently, so either side of a message may be observed first.
+type latencyTracker struct {
+	lock      sync.Mutex
+	sent      map[ids.ID]messageObservation
+	delivered map[ids.ID]messageObservation
+}
+
+func newLatencyTracker() *latencyTracker {
+	return &latencyTracker{
+		sent:      make(map[ids.ID]messageObservation),
+		delivered: make(map[ids.ID]messageObservation),
+	}
+}
+
+// Records the send of a message. If its delivery has already been observed,
+// returns the delivery along with true.
+func (t *latencyTracker) observeSend(messageID ids.ID, send messageObservation) (messageObservation, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if delivery, ok := t.delivered[messageID]; ok {
+		delete(t.delivered, messageID)
+		return delivery, true
+	}
+	t.sent[messageID] = send
+	return messageObservation{}, false
+}
+
+// Records the delivery of a message. If its send has already been observed,
+// returns the send along with true.
+func (t *latencyTracker) observeDelivery(messageID ids.ID, delivery messageObservation) (messageObservation, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if send, ok := t.sent[messageID]; ok {
+		delete(t.sent, messageID)
+		return send, true
+	}
+	t.delivered[messageID] = delivery
+	return messageObservation{}, false
+}
+
+// Drops observations older than the tracker window, relative to now
+func (t *latencyTracker) prune(now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, observations := range []map[ids.ID]messageObservation{t.sent, t.delivered} {
+		for messageID, observation := range observations {
+			if now.Sub(observation.time) > latencyTrackerWindow {
+				delete(observations, messageID)
+			}
+		}
+	}
+}
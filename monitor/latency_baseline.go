@@ -0,0 +1,151 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// DeliveryLatencyBuckets are the upper bounds, in seconds, of the delivery latency histograms. They are shared by
+// the live delivery latency metric and the baseline measured by the E2E suite, so that the two can be compared.
+var DeliveryLatencyBuckets = []float64{1, 2, 5, 10, 20, 30, 60, 120, 300, 600, 1800}
+
+// DeliveryLatencySample is the time between the block a Teleporter message was sent in and the block it was
+// delivered in
+type DeliveryLatencySample struct {
+	From    string
+	To      string
+	Latency time.Duration
+}
+
+// DeliveryLatencyBucket is the number of deliveries of a route with a latency of at most the upper bound
+type DeliveryLatencyBucket struct {
+	UpperBound float64 `json:"le"`
+	Count      uint64  `json:"count"`
+}
+
+// DeliveryLatencyRoute summarizes the latencies, in seconds, of the deliveries from one chain to another
+type DeliveryLatencyRoute struct {
+	From       string                  `json:"from"`
+	To         string                  `json:"to"`
+	Deliveries uint64                  `json:"deliveries"`
+	Sum        float64                 `json:"sum"`
+	Min        float64                 `json:"min"`
+	Median     float64                 `json:"median"`
+	P90        float64                 `json:"p90"`
+	Max        float64                 `json:"max"`
+	Buckets    []DeliveryLatencyBucket `json:"buckets"`
+}
+
+// DeliveryLatencyReport is the histogram of delivery latencies of each route, sorted by route so that reports of
+// different releases can be compared
+type DeliveryLatencyReport struct {
+	Routes []DeliveryLatencyRoute `json:"routes"`
+}
+
+// NewDeliveryLatencyReport aggregates the samples of each route into a histogram with DeliveryLatencyBuckets
+func NewDeliveryLatencyReport(samples []DeliveryLatencySample) *DeliveryLatencyReport {
+	type routeKey struct {
+		from string
+		to   string
+	}
+	latencies := make(map[routeKey][]float64)
+	for _, sample := range samples {
+		key := routeKey{from: sample.From, to: sample.To}
+		latencies[key] = append(latencies[key], sample.Latency.Seconds())
+	}
+
+	report := &DeliveryLatencyReport{Routes: []DeliveryLatencyRoute{}}
+	for key, seconds := range latencies {
+		sort.Float64s(seconds)
+		route := DeliveryLatencyRoute{
+			From:       key.from,
+			To:         key.to,
+			Deliveries: uint64(len(seconds)),
+			Min:        seconds[0],
+			Median:     latencyPercentile(seconds, 50),
+			P90:        latencyPercentile(seconds, 90),
+			Max:        seconds[len(seconds)-1],
+			Buckets:    make([]DeliveryLatencyBucket, len(DeliveryLatencyBuckets)),
+		}
+		for _, latency := range seconds {
+			route.Sum += latency
+		}
+		for i, upperBound := range DeliveryLatencyBuckets {
+			route.Buckets[i] = DeliveryLatencyBucket{
+				UpperBound: upperBound,
+				Count:      uint64(sort.Search(len(seconds), func(j int) bool { return seconds[j] > upperBound })),
+			}
+		}
+		report.Routes = append(report.Routes, route)
+	}
+	sort.Slice(report.Routes, func(i, j int) bool {
+		if report.Routes[i].From != report.Routes[j].From {
+			return report.Routes[i].From < report.Routes[j].From
+		}
+		return report.Routes[i].To < report.Routes[j].To
+	})
+	return report
+}
+
+// Returns the nearest-rank percentile of the sorted latencies
+func latencyPercentile(sorted []float64, percentile int) float64 {
+	rank := (len(sorted)*percentile + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// LoadDeliveryLatencyReport reads a delivery latency report written as JSON, such as by the E2E suite
+func LoadDeliveryLatencyReport(path string) (*DeliveryLatencyReport, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read delivery latency report: %w", err)
+	}
+	var report DeliveryLatencyReport
+	if err := json.Unmarshal(bytes, &report); err != nil {
+		return nil, fmt.Errorf("failed to parse delivery latency report: %w", err)
+	}
+	return &report, nil
+}
+
+// latencyBaselineCollector exports the histograms of a delivery latency report as constant metrics, as the
+// baseline that the live delivery latency is compared against
+type latencyBaselineCollector struct {
+	desc   *prometheus.Desc
+	report *DeliveryLatencyReport
+}
+
+func newLatencyBaselineCollector(report *DeliveryLatencyReport) *latencyBaselineCollector {
+	return &latencyBaselineCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(metricsNamespace, "", "delivery_latency_baseline_seconds"),
+			"Delivery latency of each route measured by the E2E suite, as a baseline for the live delivery latency",
+			[]string{"from", "to"},
+			nil,
+		),
+		report: report,
+	}
+}
+
+func (c *latencyBaselineCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *latencyBaselineCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, route := range c.report.Routes {
+		buckets := make(map[float64]uint64, len(route.Buckets))
+		for _, bucket := range route.Buckets {
+			buckets[bucket.UpperBound] = bucket.Count
+		}
+		ch <- prometheus.MustNewConstHistogram(c.desc, route.Deliveries, route.Sum, buckets, route.From, route.To)
+	}
+}
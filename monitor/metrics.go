@@ -0,0 +1,188 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"math/big"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "bridge"
+
+// Metrics are the Prometheus metrics exported by the monitoring service.
+// Token amounts are reported in the token's smallest denomination.
+type Metrics struct {
+	transfers            *prometheus.CounterVec
+	transferVolume       *prometheus.CounterVec
+	feesPaid             *prometheus.CounterVec
+	deliveries           *prometheus.CounterVec
+	deliveryLatency      *prometheus.HistogramVec
+	collateralNeeded     *prometheus.GaugeVec
+	collateralized       *prometheus.GaugeVec
+	lastProcessedBlock   *prometheus.GaugeVec
+	confirmationDepth    *prometheus.GaugeVec
+	chainReachable       *prometheus.GaugeVec
+	sourceDrift          *prometheus.GaugeVec
+	destinationDrift     *prometheus.GaugeVec
+	driftExceeded        *prometheus.GaugeVec
+	unreportedBurnedFees *prometheus.GaugeVec
+}
+
+// NewMetrics creates the monitoring metrics and registers them with the registerer
+func NewMetrics(registerer prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		transfers: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "transfers_total",
+				Help:      "Number of transfers sent, by direction and event type",
+			},
+			[]string{"bridge", "from", "to", "type"},
+		),
+		transferVolume: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "transfer_volume_total",
+				Help:      "Amount of tokens sent, by direction",
+			},
+			[]string{"bridge", "from", "to"},
+		),
+		feesPaid: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "fees_paid_total",
+				Help:      "Amount of primary relayer fees paid for transfers, by fee token",
+			},
+			[]string{"bridge", "chain", "fee_token"},
+		),
+		deliveries: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: metricsNamespace,
+				Name:      "deliveries_total",
+				Help:      "Number of transfers delivered, by event type",
+			},
+			[]string{"bridge", "chain", "type"},
+		),
+		deliveryLatency: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: metricsNamespace,
+				Name:      "delivery_latency_seconds",
+				Help:      "Time between the block a transfer was sent in and the block it was delivered in",
+				Buckets:   DeliveryLatencyBuckets,
+			},
+			[]string{"bridge", "from", "to"},
+		),
+		collateralNeeded: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "collateral_needed",
+				Help:      "Collateral still needed by a destination, as tracked by the source",
+			},
+			[]string{"bridge", "destination_chain", "destination_address"},
+		),
+		collateralized: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "destination_collateralized",
+				Help:      "Whether a destination considers itself collateralized (1) or not (0)",
+			},
+			[]string{"bridge", "destination_chain", "destination_address"},
+		),
+		lastProcessedBlock: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "last_processed_block",
+				Help:      "Last block processed for bridge events",
+			},
+			[]string{"chain"},
+		),
+		confirmationDepth: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "confirmation_depth",
+				Help:      "Blocks built on a block before its bridge events are processed, which the last processed block lags by",
+			},
+			[]string{"chain"},
+		),
+		chainReachable: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "chain_reachable",
+				Help:      "Whether the last poll of a chain for bridge events succeeded (1) or not (0)",
+			},
+			[]string{"chain"},
+		),
+		sourceDrift: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "source_balance_drift",
+				Help:      "Tokens locked in the source in excess of the balances bridged to its destinations",
+			},
+			[]string{"bridge"},
+		),
+		destinationDrift: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "destination_supply_drift",
+				Help:      "Balance bridged to a destination as tracked by the source, in excess of its supply",
+			},
+			[]string{"bridge", "destination_chain", "destination_address"},
+		),
+		driftExceeded: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "drift_tolerance_exceeded",
+				Help:      "Whether any balance drift of a bridge exceeds its configured tolerance (1) or not (0)",
+			},
+			[]string{"bridge"},
+		),
+		unreportedBurnedFees: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: metricsNamespace,
+				Name:      "unreported_burned_fees",
+				Help:      "Transaction fees burned on a native token destination's chain not yet reported to the source",
+			},
+			[]string{"bridge", "destination_chain", "destination_address"},
+		),
+	}
+
+	for _, collector := range []prometheus.Collector{
+		m.transfers,
+		m.transferVolume,
+		m.feesPaid,
+		m.deliveries,
+		m.deliveryLatency,
+		m.collateralNeeded,
+		m.collateralized,
+		m.lastProcessedBlock,
+		m.confirmationDepth,
+		m.chainReachable,
+		m.sourceDrift,
+		m.destinationDrift,
+		m.driftExceeded,
+		m.unreportedBurnedFees,
+	} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Converts a token amount to a float for export as a metric. Precision loss is acceptable for monitoring.
+func toFloat(amount *big.Int) float64 {
+	if amount == nil {
+		return 0
+	}
+	f, _ := new(big.Float).SetInt(amount).Float64()
+	return f
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,481 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tracing"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+// Monitor follows the events of the configured bridges and exports them as metrics,
+// along with periodic samples of on-chain bridge state.
+type Monitor struct {
+	logger  logging.Logger
+	config  *Config
+	metrics *Metrics
+	decoder *events.Decoder
+
+	chains         map[string]*events.Chain
+	chainsByID     map[ids.ID]*events.Chain
+	reconciler     *Reconciler
+	alerter        *Alerter
+	latencyTracker *latencyTracker
+	reachability   *reachabilityTracker
+	tracer         tracing.Tracer
+}
+
+// NewMonitor connects to each configured chain and registers the monitoring metrics with the registerer
+func NewMonitor(
+	ctx context.Context,
+	logger logging.Logger,
+	config *Config,
+	registerer prometheus.Registerer,
+) (*Monitor, error) {
+	metrics, err := NewMetrics(registerer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metrics: %w", err)
+	}
+	if config.LatencyBaselineFile != "" {
+		baseline, err := LoadDeliveryLatencyReport(config.LatencyBaselineFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := registerer.Register(newLatencyBaselineCollector(baseline)); err != nil {
+			return nil, fmt.Errorf("failed to register delivery latency baseline: %w", err)
+		}
+	}
+	decoder, err := events.NewDecoder()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event decoder: %w", err)
+	}
+
+	tracer, err := tracing.NewTracer(config.Tracing, "bridge-metrics")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracer: %w", err)
+	}
+
+	chains, err := events.ConnectChains(ctx, config.Chains)
+	if err != nil {
+		return nil, err
+	}
+	chainsByID := make(map[ids.ID]*events.Chain, len(chains))
+	for _, chain := range chains {
+		chainsByID[chain.BlockchainID] = chain
+	}
+
+	reconciler := NewReconciler(chains, config.Bridges, config.GetDriftTolerances())
+	reconciler.SetVerifyStorageProofs(config.VerifyStorageProofs)
+
+	return &Monitor{
+		logger:         logger,
+		config:         config,
+		metrics:        metrics,
+		decoder:        decoder,
+		chains:         chains,
+		chainsByID:     chainsByID,
+		reconciler:     reconciler,
+		alerter:        NewAlerter(logger, config.Alerts.Webhooks),
+		latencyTracker: newLatencyTracker(),
+		reachability:   newReachabilityTracker(),
+		tracer:         tracer,
+	}, nil
+}
+
+// Close flushes any spans that have not yet been exported
+func (m *Monitor) Close() error {
+	return m.tracer.Close()
+}
+
+// Run follows every chain hosting bridge contracts and samples bridge state
+// until the context is cancelled or an error occurs. Chains that can't be polled are retried until reachable.
+func (m *Monitor) Run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+	for _, chain := range m.chains {
+		chain := chain
+		contracts := events.ContractsOnChain(m.config.Bridges, chain.Name)
+		if len(contracts) == 0 {
+			continue
+		}
+		m.metrics.confirmationDepth.WithLabelValues(chain.Name).Set(float64(chain.ConfirmationDepth))
+		poller := events.NewPoller(
+			m.logger,
+			chain,
+			m.decoder,
+			contracts,
+			m.config.pollerConfig(),
+			func(ctx context.Context, bridgeEvents []*events.Event, toBlock uint64) error {
+				for _, event := range bridgeEvents {
+					m.handleEvent(event)
+				}
+				m.metrics.lastProcessedBlock.WithLabelValues(chain.Name).Set(float64(toBlock))
+				return nil
+			},
+		)
+		g.Go(func() error {
+			m.follow(ctx, chain, poller)
+			return nil
+		})
+	}
+	g.Go(func() error {
+		return m.runStateSampler(ctx)
+	})
+	return g.Wait()
+}
+
+// Follows the chain with the poller until the context is cancelled. Failed polls, such as while the chain's
+// validators are halted, are retried every poll interval rather than stopping the monitor, so the other chains are
+// still followed, and the chain resumes from the first block not yet processed once it is reachable again.
+func (m *Monitor) follow(ctx context.Context, chain *events.Chain, poller *events.Poller) {
+	ticker := time.NewTicker(m.config.pollerConfig().PollInterval)
+	defer ticker.Stop()
+	// Chains without a start block are followed from their final block when first reached
+	started := chain.StartBlock != 0
+	for {
+		var (
+			caughtUp bool
+			err      error
+		)
+		if started {
+			caughtUp, err = poller.Poll(ctx)
+		} else {
+			var final uint64
+			if final, err = chain.FinalBlock(ctx); err == nil {
+				poller.SetNextBlock(final)
+				started = true
+			}
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		m.observeReachability(ctx, chain.Name, err)
+		if err == nil && !caughtUp {
+			// Continue immediately while backfilling
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Records the result of polling the chain, alerting once polls of it have been failing for the configured period
+func (m *Monitor) observeReachability(ctx context.Context, chain string, err error) {
+	unreachableFor := m.reachability.observe(chain, err == nil, time.Now())
+	m.metrics.chainReachable.WithLabelValues(chain).Set(boolToFloat(err == nil))
+	if err != nil {
+		m.logger.Warn("Failed to poll chain", zap.String("chain", chain), zap.Error(err))
+	}
+	m.alerter.Update(ctx, Alert{
+		Key:   fmt.Sprintf("%s/unreachable", chain),
+		Chain: chain,
+		Summary: fmt.Sprintf(
+			"Chain %s has not been reachable for at least %s, so bridge events and state on it are not followed",
+			chain,
+			m.config.unreachableAfter(),
+		),
+	}, err != nil && unreachableFor >= m.config.unreachableAfter())
+}
+
+// Returns the name of a chain of the bridge whose last poll failed, if any
+func (m *Monitor) unreachableChain(bridge events.BridgeConfig) (string, bool) {
+	contracts := append([]events.ContractConfig{bridge.Source}, bridge.Destinations...)
+	for _, contract := range contracts {
+		if !m.reachability.reachable(contract.Chain) {
+			return contract.Chain, true
+		}
+	}
+	return "", false
+}
+
+func (m *Monitor) handleEvent(event *events.Event) {
+	m.logger.Debug(
+		"Received bridge event",
+		zap.String("type", string(event.Type)),
+		zap.String("bridge", event.Bridge),
+		zap.String("chain", event.Chain),
+		zap.Stringer("txHash", event.TxHash),
+	)
+
+	if event.Type.IsDelivery() && event.ReceivedMessageID != ids.Empty {
+		m.metrics.deliveries.WithLabelValues(event.Bridge, event.Chain, string(event.Type)).Inc()
+		delivery := newMessageObservation(event)
+		if send, ok := m.latencyTracker.observeDelivery(event.ReceivedMessageID, delivery); ok {
+			m.observeLatency(event.Bridge, event.ReceivedMessageID, send, delivery)
+		}
+	}
+
+	if event.Type.IsSend() {
+		to := m.chainName(event.DestinationBlockchainID)
+		m.metrics.transfers.WithLabelValues(event.Bridge, event.Chain, to, string(event.Type)).Inc()
+		m.metrics.transferVolume.WithLabelValues(event.Bridge, event.Chain, to).Add(toFloat(event.Amount))
+		if event.PrimaryFee != nil && event.PrimaryFee.Sign() > 0 {
+			m.metrics.feesPaid.WithLabelValues(
+				event.Bridge,
+				event.Chain,
+				event.PrimaryFeeTokenAddress.Hex(),
+			).Add(toFloat(event.PrimaryFee))
+		}
+		send := newMessageObservation(event)
+		if delivery, ok := m.latencyTracker.observeSend(event.TeleporterMessageID, send); ok {
+			m.observeLatency(event.Bridge, event.TeleporterMessageID, send, delivery)
+		}
+	}
+}
+
+func (m *Monitor) observeLatency(
+	bridge string,
+	messageID ids.ID,
+	send messageObservation,
+	delivery messageObservation,
+) {
+	latency := delivery.time.Sub(send.time)
+	if latency < 0 {
+		latency = 0
+	}
+	m.metrics.deliveryLatency.WithLabelValues(bridge, send.chain, delivery.chain).Observe(latency.Seconds())
+	m.traceMessage(bridge, messageID, send, delivery)
+}
+
+// Exports the spans of a delivered message. Spans are only created once both the send and delivery have been
+// observed, so are timed by the block times of each rather than when they were observed.
+func (m *Monitor) traceMessage(bridge string, messageID ids.ID, send messageObservation, delivery messageObservation) {
+	ctx, relay := m.tracer.Start(
+		context.Background(),
+		tracing.RelaySpan,
+		trace.WithTimestamp(send.time),
+		trace.WithAttributes(tracing.BridgeKey.String(bridge)),
+		trace.WithAttributes(tracing.MessageAttributes(
+			messageID,
+			m.chainID(send.chain),
+			m.chainID(delivery.chain),
+		)...),
+	)
+	for _, phase := range []struct {
+		name        string
+		observation messageObservation
+	}{
+		{tracing.SendSpan, send},
+		{tracing.ReceiveSpan, delivery},
+	} {
+		_, span := m.tracer.Start(
+			ctx,
+			phase.name,
+			trace.WithTimestamp(phase.observation.time),
+			trace.WithAttributes(tracing.EventTypeKey.String(string(phase.observation.eventType))),
+			trace.WithAttributes(tracing.TransactionAttributes(
+				phase.observation.txHash,
+				phase.observation.blockNumber,
+			)...),
+		)
+		if phase.observation.eventType == events.CallSucceeded || phase.observation.eventType == events.CallFailed {
+			_, call := m.tracer.Start(
+				trace.ContextWithSpan(ctx, span),
+				tracing.CallSpan,
+				trace.WithTimestamp(phase.observation.time),
+				trace.WithAttributes(tracing.CallSucceededKey.Bool(phase.observation.eventType == events.CallSucceeded)),
+			)
+			call.End(trace.WithTimestamp(phase.observation.time))
+		}
+		span.End(trace.WithTimestamp(phase.observation.time))
+	}
+	relay.End(trace.WithTimestamp(delivery.time))
+}
+
+// Returns the blockchain ID of the configured chain with the given name
+func (m *Monitor) chainID(name string) ids.ID {
+	if chain, ok := m.chains[name]; ok {
+		return chain.BlockchainID
+	}
+	return ids.Empty
+}
+
+// Returns the configured name of the chain with the given blockchain ID, or the ID itself if unknown
+func (m *Monitor) chainName(blockchainID ids.ID) string {
+	if chain, ok := m.chainsByID[blockchainID]; ok {
+		return chain.Name
+	}
+	return blockchainID.String()
+}
+
+func (m *Monitor) runStateSampler(ctx context.Context) error {
+	ticker := time.NewTicker(m.config.stateInterval())
+	defer ticker.Stop()
+	for {
+		for _, bridge := range m.config.Bridges {
+			// The state of a bridge is read from each of its chains, so is not sampled while any is unreachable,
+			// leaving its metrics at their last sampled values rather than reporting drift from a partial read
+			if chain, ok := m.unreachableChain(bridge); ok {
+				m.logger.Debug(
+					"Skipping sampling of bridge with unreachable chain",
+					zap.String("bridge", bridge.Name),
+					zap.String("chain", chain),
+				)
+				continue
+			}
+			if err := m.sampleCollateral(ctx, bridge); err != nil {
+				// Sampling errors are transient RPC failures, so log and retry on the next interval
+				m.logger.Warn("Failed to sample collateral", zap.String("bridge", bridge.Name), zap.Error(err))
+			}
+			if err := m.sampleDrift(ctx, bridge); err != nil {
+				m.logger.Warn("Failed to reconcile balances", zap.String("bridge", bridge.Name), zap.Error(err))
+			}
+			if err := m.sampleBurnedFees(ctx, bridge); err != nil {
+				m.logger.Warn("Failed to sample burned fees", zap.String("bridge", bridge.Name), zap.Error(err))
+			}
+		}
+		m.latencyTracker.prune(time.Now())
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Monitor) sampleCollateral(ctx context.Context, bridge events.BridgeConfig) error {
+	sourceChain := m.chains[bridge.Source.Chain]
+	source, err := teleportertokensource.NewTeleporterTokenSource(
+		common.HexToAddress(bridge.Source.Address),
+		sourceChain.Client,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, destinationConfig := range bridge.Destinations {
+		destinationChain := m.chains[destinationConfig.Chain]
+		destinationAddress := common.HexToAddress(destinationConfig.Address)
+
+		settings, err := source.RegisteredDestinations(
+			&bind.CallOpts{Context: ctx},
+			destinationChain.BlockchainID,
+			destinationAddress,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to get destination settings: %w", err)
+		}
+		m.metrics.collateralNeeded.WithLabelValues(
+			bridge.Name,
+			destinationChain.Name,
+			destinationAddress.Hex(),
+		).Set(toFloat(settings.CollateralNeeded))
+
+		destination, err := teleportertokendestination.NewTeleporterTokenDestination(
+			destinationAddress,
+			destinationChain.Client,
+		)
+		if err != nil {
+			return err
+		}
+		collateralized, err := destination.IsCollateralized(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return fmt.Errorf("failed to get destination collateralization: %w", err)
+		}
+		m.metrics.collateralized.WithLabelValues(
+			bridge.Name,
+			destinationChain.Name,
+			destinationAddress.Hex(),
+		).Set(boolToFloat(collateralized))
+
+		// Unregistered destinations cannot receive transfers yet, so are only alerted on once registered
+		if destinationConfig.Type == events.NativeTokenDestination && settings.Registered {
+			m.alerter.Update(ctx, Alert{
+				Key:     fmt.Sprintf("%s/%s/%s/collateral", bridge.Name, destinationChain.Name, destinationAddress.Hex()),
+				Bridge:  bridge.Name,
+				Chain:   destinationChain.Name,
+				Address: destinationAddress.Hex(),
+				Summary: fmt.Sprintf(
+					"Native token destination %s on %s of bridge %s is not collateralized, %s collateral still needed",
+					destinationAddress.Hex(),
+					destinationChain.Name,
+					bridge.Name,
+					settings.CollateralNeeded,
+				),
+			}, !collateralized || settings.CollateralNeeded.Sign() > 0)
+		}
+	}
+	return nil
+}
+
+// Samples the transaction fees burned on each NativeTokenDestination's chain that are not yet reported to
+// the source, alerting when they exceed the bridge's threshold. Until reported, the source's accounting of
+// the destination's bridged balance diverges from the destination's supply by this amount.
+func (m *Monitor) sampleBurnedFees(ctx context.Context, bridge events.BridgeConfig) error {
+	threshold, hasThreshold := m.config.GetBurnedFeesThresholds()[bridge.Name]
+	for _, destinationConfig := range bridge.Destinations {
+		if destinationConfig.Type != events.NativeTokenDestination {
+			continue
+		}
+		destinationChain := m.chains[destinationConfig.Chain]
+		destinationAddress := common.HexToAddress(destinationConfig.Address)
+
+		unreported, err := unreportedBurnedFees(ctx, destinationChain, destinationAddress)
+		if err != nil {
+			return err
+		}
+		m.metrics.unreportedBurnedFees.WithLabelValues(
+			bridge.Name,
+			destinationChain.Name,
+			destinationAddress.Hex(),
+		).Set(toFloat(unreported))
+		if !hasThreshold {
+			continue
+		}
+		m.alerter.Update(ctx, Alert{
+			Key:     fmt.Sprintf("%s/%s/%s/burned-fees", bridge.Name, destinationChain.Name, destinationAddress.Hex()),
+			Bridge:  bridge.Name,
+			Chain:   destinationChain.Name,
+			Address: destinationAddress.Hex(),
+			Summary: fmt.Sprintf(
+				"%s burned transaction fees on %s not reported to the source of bridge %s, exceeding threshold of %s",
+				unreported,
+				destinationChain.Name,
+				bridge.Name,
+				threshold,
+			),
+		}, unreported.Cmp(threshold) > 0)
+	}
+	return nil
+}
+
+func (m *Monitor) sampleDrift(ctx context.Context, bridge events.BridgeConfig) error {
+	report, err := m.reconciler.ReconcileBridge(ctx, bridge)
+	if err != nil {
+		return err
+	}
+	if report.DriftExceeded {
+		m.logger.Warn(
+			"Bridge balance drift exceeds tolerance",
+			zap.String("bridge", bridge.Name),
+			zap.Stringer("sourceDrift", report.Drift),
+		)
+	}
+	m.metrics.sourceDrift.WithLabelValues(bridge.Name).Set(toFloat(report.Drift))
+	m.metrics.driftExceeded.WithLabelValues(bridge.Name).Set(boolToFloat(report.DriftExceeded))
+	for _, destination := range report.Destinations {
+		m.metrics.destinationDrift.WithLabelValues(
+			bridge.Name,
+			destination.Chain,
+			destination.Address.Hex(),
+		).Set(toFloat(destination.Drift))
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"sync"
+	"time"
+)
+
+// reachabilityTracker records whether each chain could be polled the last time it was, and for how long chains
+// that can't be polled have been unreachable. Chains are reachable until a poll of them fails.
+type reachabilityTracker struct {
+	lock sync.Mutex
+	// The time of the first failed poll of each unreachable chain since it was last reachable
+	unreachableSince map[string]time.Time
+}
+
+func newReachabilityTracker() *reachabilityTracker {
+	return &reachabilityTracker{
+		unreachableSince: make(map[string]time.Time),
+	}
+}
+
+// Records whether the poll of the chain at the given time succeeded, returning how long the chain has been
+// unreachable, which is zero if the poll succeeded
+func (t *reachabilityTracker) observe(chain string, reachable bool, now time.Time) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	if reachable {
+		delete(t.unreachableSince, chain)
+		return 0
+	}
+	since, ok := t.unreachableSince[chain]
+	if !ok {
+		t.unreachableSince[chain] = now
+		return 0
+	}
+	return now.Sub(since)
+}
+
+// Returns whether the last poll of the chain succeeded
+func (t *reachabilityTracker) reachable(chain string) bool {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	_, unreachable := t.unreachableSince[chain]
+	return !unreachable
+}
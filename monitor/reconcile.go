@@ -0,0 +1,345 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/vmerrs"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/proofs"
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BridgeReport is the result of reconciling the balances of a bridge's source and destinations
+type BridgeReport struct {
+	Bridge string `json:"bridge"`
+	// LockedBalance is the balance of the source token held by the source contract, less the withdrawals it holds
+	LockedBalance *big.Int `json:"lockedBalance"`
+	// HeldWithdrawals are the tokens held by a NativeTokenSource for recipients that could not receive their
+	// withdrawals, to be claimed as the wrapped native token. They no longer back any bridged balance.
+	HeldWithdrawals *big.Int `json:"heldWithdrawals"`
+	// ExpectedLockedBalance is the sum of the balances bridged to each destination converted to the
+	// source token denomination, plus the collateral held for each destination
+	ExpectedLockedBalance *big.Int `json:"expectedLockedBalance"`
+	// Drift is LockedBalance - ExpectedLockedBalance. A negative drift means the source does not
+	// hold enough tokens to back the balances bridged to its destinations.
+	Drift         *big.Int             `json:"drift"`
+	Destinations  []*DestinationReport `json:"destinations"`
+	DriftExceeded bool                 `json:"driftExceeded"`
+	// Proven is whether the bridged balances, collateral and ERC20Destination supplies were read from verified
+	// storage proofs rather than from contract views
+	Proven bool `json:"proven"`
+}
+
+// DestinationReport is the result of reconciling the supply of a destination with the balance
+// the source has bridged to it. All amounts are denominated in the destination token.
+type DestinationReport struct {
+	Chain   string         `json:"chain"`
+	Address common.Address `json:"address"`
+	// BridgedBalance is the balance bridged to the destination, as tracked by the source
+	BridgedBalance          *big.Int `json:"bridgedBalance"`
+	InitialReserveImbalance *big.Int `json:"initialReserveImbalance"`
+	// Supply is the supply of the destination token, including any burned transaction fees
+	// not yet reported to the source.
+	Supply *big.Int `json:"supply"`
+	// Drift is BridgedBalance + InitialReserveImbalance - Supply. A negative drift means the
+	// destination has more tokens in circulation than are backed by the source.
+	Drift *big.Int `json:"drift"`
+}
+
+// Reconciler compares the balances locked in bridge sources with the supply of their destinations
+type Reconciler struct {
+	chains     map[string]*events.Chain
+	bridges    []events.BridgeConfig
+	tolerances map[string]*big.Int
+
+	verifyStorageProofs bool
+}
+
+// NewReconciler creates a reconciler for the given bridges. Bridges without a tolerance tolerate no drift.
+func NewReconciler(
+	chains map[string]*events.Chain,
+	bridges []events.BridgeConfig,
+	tolerances map[string]*big.Int,
+) *Reconciler {
+	return &Reconciler{
+		chains:     chains,
+		bridges:    bridges,
+		tolerances: tolerances,
+	}
+}
+
+// SetVerifyStorageProofs sets whether the balances bridged to each destination, the collateral still needed for it,
+// and the supply of ERC20Destinations are read from storage proofs verified against the state root of the latest
+// block of each chain, rather than trusting the views served by the RPC nodes. The locked balance is always read
+// from the source token's view, since the storage layout of the token is not known, as is the supply of a
+// NativeTokenDestination, which is the supply of the chain's native asset.
+func (r *Reconciler) SetVerifyStorageProofs(enabled bool) {
+	r.verifyStorageProofs = enabled
+}
+
+// Reconcile reconciles each of the configured bridges
+func (r *Reconciler) Reconcile(ctx context.Context) ([]*BridgeReport, error) {
+	reports := make([]*BridgeReport, 0, len(r.bridges))
+	for _, bridge := range r.bridges {
+		report, err := r.ReconcileBridge(ctx, bridge)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile bridge %s: %w", bridge.Name, err)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+// ReconcileBridge compares the balance locked in the bridge's source with the balances bridged to each
+// of its destinations, and the balance bridged to each destination with the destination's supply.
+func (r *Reconciler) ReconcileBridge(ctx context.Context, bridge events.BridgeConfig) (*BridgeReport, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	sourceChain := r.chains[bridge.Source.Chain]
+	sourceAddress := common.HexToAddress(bridge.Source.Address)
+	source, err := teleportertokensource.NewTeleporterTokenSource(sourceAddress, sourceChain.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	// When verifying storage proofs, the views of the source are read at the block its state is proven at, so that
+	// they are consistent with the proven values
+	var sourceHeader *types.Header
+	if r.verifyStorageProofs {
+		sourceHeader, err = sourceChain.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest header: %w", err)
+		}
+		opts.BlockNumber = sourceHeader.Number
+	}
+
+	// Both source types hold the source token as an ERC20, the wrapped native token in the case of a NativeTokenSource
+	tokenAddress, err := source.TokenAddress(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get source token address: %w", err)
+	}
+	token, err := exampleerc20.NewExampleERC20(tokenAddress, sourceChain.Client)
+	if err != nil {
+		return nil, err
+	}
+	lockedBalance, err := token.BalanceOf(opts, sourceAddress)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get locked balance: %w", err)
+	}
+	heldWithdrawals := big.NewInt(0)
+	if bridge.Source.Type == events.NativeTokenSource {
+		heldWithdrawals, err = totalClaimableWithdrawals(opts, sourceChain, sourceAddress)
+		if err != nil {
+			return nil, err
+		}
+		lockedBalance.Sub(lockedBalance, heldWithdrawals)
+	}
+
+	tolerance := r.tolerances[bridge.Name]
+	if tolerance == nil {
+		tolerance = big.NewInt(0)
+	}
+	report := &BridgeReport{
+		Bridge:                bridge.Name,
+		LockedBalance:         lockedBalance,
+		HeldWithdrawals:       heldWithdrawals,
+		ExpectedLockedBalance: big.NewInt(0),
+		Proven:                r.verifyStorageProofs,
+	}
+	for _, destinationConfig := range bridge.Destinations {
+		destinationChain := r.chains[destinationConfig.Chain]
+		destinationAddress := common.HexToAddress(destinationConfig.Address)
+
+		settings, err := source.RegisteredDestinations(opts, destinationChain.BlockchainID, destinationAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get destination settings: %w", err)
+		}
+		if !settings.Registered {
+			// Unregistered destinations have no balance bridged to them
+			continue
+		}
+		bridgedBalance, err := source.BridgedBalances(opts, destinationChain.BlockchainID, destinationAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bridged balance: %w", err)
+		}
+		if r.verifyStorageProofs {
+			prover := proofs.NewProver(sourceChain.Client)
+			bridgedBalance, err = prover.BridgedBalance(
+				ctx,
+				sourceHeader,
+				sourceAddress,
+				destinationChain.BlockchainID,
+				destinationAddress,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to prove bridged balance: %w", err)
+			}
+			settings.CollateralNeeded, err = prover.CollateralNeeded(
+				ctx,
+				sourceHeader,
+				sourceAddress,
+				destinationChain.BlockchainID,
+				destinationAddress,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("failed to prove collateral needed: %w", err)
+			}
+		}
+
+		destination, err := teleportertokendestination.NewTeleporterTokenDestination(
+			destinationAddress,
+			destinationChain.Client,
+		)
+		if err != nil {
+			return nil, err
+		}
+		// The source's block number is unrelated to the destination chain's, so views of the destination are not pinned
+		initialReserveImbalance, err := destination.InitialReserveImbalance(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get initial reserve imbalance: %w", err)
+		}
+		supply, err := r.destinationSupply(ctx, destinationChain, destinationConfig.Type, destinationAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		// The collateral held by the source is the collateral needed on registration, less that still needed
+		collateralHeld := tokenscaling.CollateralNeeded(
+			settings.TokenMultiplier,
+			settings.MultiplyOnDestination,
+			initialReserveImbalance,
+		)
+		collateralHeld.Sub(collateralHeld, settings.CollateralNeeded)
+		report.ExpectedLockedBalance.Add(report.ExpectedLockedBalance, collateralHeld)
+		report.ExpectedLockedBalance.Add(
+			report.ExpectedLockedBalance,
+			tokenscaling.RemoveTokenScale(settings.TokenMultiplier, settings.MultiplyOnDestination, bridgedBalance),
+		)
+
+		drift := new(big.Int).Add(bridgedBalance, initialReserveImbalance)
+		drift.Sub(drift, supply)
+		report.Destinations = append(report.Destinations, &DestinationReport{
+			Chain:                   destinationChain.Name,
+			Address:                 destinationAddress,
+			BridgedBalance:          bridgedBalance,
+			InitialReserveImbalance: initialReserveImbalance,
+			Supply:                  supply,
+			Drift:                   drift,
+		})
+		if new(big.Int).Abs(drift).Cmp(tolerance) > 0 {
+			report.DriftExceeded = true
+		}
+	}
+
+	report.Drift = new(big.Int).Sub(report.LockedBalance, report.ExpectedLockedBalance)
+	if new(big.Int).Abs(report.Drift).Cmp(tolerance) > 0 {
+		report.DriftExceeded = true
+	}
+	return report, nil
+}
+
+// Returns the withdrawals held by a NativeTokenSource to be claimed by their recipients. Sources deployed before
+// withdrawals were totalled revert the call, and are treated as holding none.
+func totalClaimableWithdrawals(
+	opts *bind.CallOpts,
+	chain *events.Chain,
+	address common.Address,
+) (*big.Int, error) {
+	source, err := nativetokensource.NewNativeTokenSource(address, chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	total, err := source.TotalClaimableWithdrawals(opts)
+	if err != nil && strings.Contains(err.Error(), vmerrs.ErrExecutionReverted.Error()) {
+		return big.NewInt(0), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total claimable withdrawals: %w", err)
+	}
+	return total, nil
+}
+
+// Returns the supply of the destination token. For a NativeTokenDestination this is the total native asset
+// supply, with burned transaction fees that have not yet been reported to the source added back, since the
+// source still accounts for them in the destination's bridged balance.
+func (r *Reconciler) destinationSupply(
+	ctx context.Context,
+	chain *events.Chain,
+	contractType events.ContractType,
+	address common.Address,
+) (*big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	if contractType == events.ERC20Destination && r.verifyStorageProofs {
+		header, err := chain.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get latest header: %w", err)
+		}
+		supply, err := proofs.NewProver(chain.Client).TotalSupply(ctx, header, contractType, address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to prove total supply: %w", err)
+		}
+		return supply, nil
+	}
+	if contractType == events.ERC20Destination {
+		destination, err := erc20destination.NewERC20Destination(address, chain.Client)
+		if err != nil {
+			return nil, err
+		}
+		supply, err := destination.TotalSupply(opts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get total supply: %w", err)
+		}
+		return supply, nil
+	}
+
+	destination, err := nativetokendestination.NewNativeTokenDestination(address, chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	supply, err := destination.TotalNativeAssetSupply(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get total native asset supply: %w", err)
+	}
+	unreported, err := unreportedBurnedFees(ctx, chain, address)
+	if err != nil {
+		return nil, err
+	}
+	return supply.Add(supply, unreported), nil
+}
+
+// Returns the transaction fees burned on a NativeTokenDestination's chain that have not yet been reported
+// to the source. These are still counted in the destination's bridged balance on the source.
+func unreportedBurnedFees(ctx context.Context, chain *events.Chain, address common.Address) (*big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	destination, err := nativetokendestination.NewNativeTokenDestination(address, chain.Client)
+	if err != nil {
+		return nil, err
+	}
+	burnedTxFeesAddress, err := destination.BURNEDTXFEESADDRESS(opts)
+	if err != nil {
+		return nil, err
+	}
+	burnedTxFees, err := chain.Client.BalanceAt(ctx, burnedTxFeesAddress, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get burned transaction fees: %w", err)
+	}
+	lastReported, err := destination.LastestBurnedFeesReported(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get last reported burned fees: %w", err)
+	}
+	return burnedTxFees.Sub(burnedTxFees, lastReported), nil
+}
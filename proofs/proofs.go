@@ -0,0 +1,211 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package proofs reads the state of the bridge contracts from their storage, verified with eth_getProof Merkle
+// proofs against the state root of a block header, rather than trusting the views served by an RPC node. Values
+// are only as trustworthy as the header they are proven against, which can be checked independently of the node
+// serving the proofs, such as against another node or an accepted block hash.
+package proofs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/ethclient"
+	"github.com/ava-labs/subnet-evm/ethclient/subnetevmclient"
+	"github.com/ava-labs/subnet-evm/trie"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	ErrAccountNotFound = errors.New("account not found")
+	ErrInvalidProof    = errors.New("invalid proof")
+)
+
+// Prover fetches storage proofs of contracts from a chain's RPC node, and verifies them against block headers
+type Prover struct {
+	client *subnetevmclient.Client
+}
+
+// NewProver creates a prover fetching proofs through the client's RPC connection
+func NewProver(client ethclient.Client) *Prover {
+	return &Prover{client: subnetevmclient.New(client.Client())}
+}
+
+// Storage returns the values of the storage slots of the contract in the state of the header's block, verified
+// against the header's state root. Slots that were never written are proven to be zero. Nodes that prune old
+// state can only prove the state of recent blocks.
+func (p *Prover) Storage(
+	ctx context.Context,
+	header *types.Header,
+	address common.Address,
+	slots ...common.Hash,
+) ([]common.Hash, error) {
+	keys := make([]string, len(slots))
+	for i, slot := range slots {
+		keys[i] = slot.Hex()
+	}
+	result, err := p.client.GetProof(ctx, address, keys, header.Number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get proof of %s at block %s: %w", address, header.Number, err)
+	}
+
+	accountRLP, err := verifyProof(header.Root, crypto.Keccak256(address.Bytes()), result.AccountProof)
+	if err != nil {
+		return nil, fmt.Errorf("%w: account %s: %w", ErrInvalidProof, address, err)
+	}
+	if accountRLP == nil {
+		return nil, fmt.Errorf("%w: %s at block %s", ErrAccountNotFound, address, header.Number)
+	}
+	var account types.StateAccount
+	if err := rlp.DecodeBytes(accountRLP, &account); err != nil {
+		return nil, fmt.Errorf("%w: account %s: %w", ErrInvalidProof, address, err)
+	}
+	if account.Root != result.StorageHash {
+		return nil, fmt.Errorf("%w: storage root of %s is %s, but %s was returned", ErrInvalidProof, address,
+			account.Root, result.StorageHash)
+	}
+	if len(result.StorageProof) != len(slots) {
+		return nil, fmt.Errorf("%w: %d storage proofs returned for %d slots", ErrInvalidProof,
+			len(result.StorageProof), len(slots))
+	}
+
+	values := make([]common.Hash, len(slots))
+	for i, slot := range slots {
+		valueRLP, err := verifyProof(account.Root, crypto.Keccak256(slot.Bytes()), result.StorageProof[i].Proof)
+		if err != nil {
+			return nil, fmt.Errorf("%w: slot %s of %s: %w", ErrInvalidProof, slot, address, err)
+		}
+		// Slots absent from the storage trie are zero. Present values are RLP encoded with leading zeros trimmed.
+		if valueRLP != nil {
+			var value []byte
+			if err := rlp.DecodeBytes(valueRLP, &value); err != nil {
+				return nil, fmt.Errorf("%w: slot %s of %s: %w", ErrInvalidProof, slot, address, err)
+			}
+			values[i] = common.BytesToHash(value)
+		}
+		if returned := result.StorageProof[i].Value; returned != nil && returned.Cmp(values[i].Big()) != 0 {
+			return nil, fmt.Errorf("%w: slot %s of %s is proven to be %s, but %s was returned", ErrInvalidProof,
+				slot, address, values[i].Big(), returned)
+		}
+	}
+	return values, nil
+}
+
+// BridgedBalance returns the balance the source has bridged to the destination, in the destination's denomination
+func (p *Prover) BridgedBalance(
+	ctx context.Context,
+	header *types.Header,
+	source common.Address,
+	destinationBlockchainID ids.ID,
+	destinationBridgeAddress common.Address,
+) (*big.Int, error) {
+	return p.uint256(ctx, header, source, BridgedBalanceSlot(destinationBlockchainID, destinationBridgeAddress))
+}
+
+// CollateralNeeded returns the collateral the source still needs to be added for the destination, in the source
+// token's denomination
+func (p *Prover) CollateralNeeded(
+	ctx context.Context,
+	header *types.Header,
+	source common.Address,
+	destinationBlockchainID ids.ID,
+	destinationBridgeAddress common.Address,
+) (*big.Int, error) {
+	return p.uint256(ctx, header, source, CollateralNeededSlot(destinationBlockchainID, destinationBridgeAddress))
+}
+
+// TotalSupply returns the ERC20 total supply of the destination. For a NativeTokenDestination this is the supply
+// of its wrapped native token.
+func (p *Prover) TotalSupply(
+	ctx context.Context,
+	header *types.Header,
+	contractType events.ContractType,
+	destination common.Address,
+) (*big.Int, error) {
+	slot, err := TotalSupplySlot(contractType)
+	if err != nil {
+		return nil, err
+	}
+	return p.uint256(ctx, header, destination, slot)
+}
+
+// TotalMinted returns the native tokens minted by the NativeTokenDestination
+func (p *Prover) TotalMinted(ctx context.Context, header *types.Header, destination common.Address) (*big.Int, error) {
+	return p.uint256(ctx, header, destination, slotHash(NativeTokenDestinationTotalMintedSlot))
+}
+
+// IsCollateralized returns whether the destination is known to be fully collateralized
+func (p *Prover) IsCollateralized(
+	ctx context.Context,
+	header *types.Header,
+	contractType events.ContractType,
+	destination common.Address,
+) (bool, error) {
+	slot, err := CollateralizedSlot(contractType)
+	if err != nil {
+		return false, err
+	}
+	values, err := p.Storage(ctx, header, destination, slot)
+	if err != nil {
+		return false, err
+	}
+	// The flag is packed into the lowest byte of the slot, along with the other flags declared after it
+	return values[0][common.HashLength-1] != 0, nil
+}
+
+// ERC20Balance returns the balance of the account in a token with the OpenZeppelin ERC20 layout, such as the
+// balance of the source token locked in a source
+func (p *Prover) ERC20Balance(
+	ctx context.Context,
+	header *types.Header,
+	token common.Address,
+	account common.Address,
+) (*big.Int, error) {
+	return p.uint256(ctx, header, token, ERC20BalanceSlot(account))
+}
+
+func (p *Prover) uint256(
+	ctx context.Context,
+	header *types.Header,
+	address common.Address,
+	slot common.Hash,
+) (*big.Int, error) {
+	values, err := p.Storage(ctx, header, address, slot)
+	if err != nil {
+		return nil, err
+	}
+	return values[0].Big(), nil
+}
+
+// Verifies the Merkle proof of the key in the trie with the root, returning the value proven, or nil if the proof
+// shows that the key is absent
+func verifyProof(root common.Hash, key []byte, proof []string) ([]byte, error) {
+	db := memorydb.New()
+	for _, encoded := range proof {
+		node, err := hexutil.Decode(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode proof node: %w", err)
+		}
+		if err := db.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	value, err := trie.VerifyProof(root, key, db)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return nil, nil
+	}
+	return value, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package proofs
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The storage slots of the state variables of the bridge contracts, from their storage layout as compiled by solc.
+// Solidity lays out the variables of each contract in the C3 linearization of its inheritance, from the most base
+// contract. The state of each bridge contract starts with the ReentrancyGuard, TeleporterUpgradeable and Ownable
+// variables of TeleporterOwnerUpgradeable, taking slots 0-3, followed by SendReentrancyGuard and SendPausable,
+// except that NativeTokenDestination inherits ERC20 first. The slots are checked against the contracts' views by
+// the Solidity unit tests, which must be updated along with them if the layout of a contract changes.
+const (
+	// SourceRegisteredDestinationsSlot is the slot of the registeredDestinations mapping of ERC20Source and
+	// NativeTokenSource
+	SourceRegisteredDestinationsSlot uint64 = 6
+	// SourceBridgedBalancesSlot is the slot of the bridgedBalances mapping of ERC20Source and NativeTokenSource
+	SourceBridgedBalancesSlot uint64 = 7
+
+	// ERC20DestinationCollateralizedSlot is the slot of the isCollateralized flag of ERC20Destination, in its
+	// lowest byte
+	ERC20DestinationCollateralizedSlot uint64 = 7
+	// ERC20DestinationTotalSupplySlot is the slot of the ERC20 total supply of ERC20Destination
+	ERC20DestinationTotalSupplySlot uint64 = 11
+
+	// NativeTokenDestinationTotalSupplySlot is the slot of the ERC20 total supply of the wrapped native token of
+	// NativeTokenDestination
+	NativeTokenDestinationTotalSupplySlot uint64 = 2
+	// NativeTokenDestinationCollateralizedSlot is the slot of the isCollateralized flag of NativeTokenDestination,
+	// in its lowest byte
+	NativeTokenDestinationCollateralizedSlot uint64 = 12
+	// NativeTokenDestinationTotalMintedSlot is the slot of the totalMinted counter of NativeTokenDestination
+	NativeTokenDestinationTotalMintedSlot uint64 = 16
+
+	// ERC20BalancesSlot is the slot of the balances mapping of OpenZeppelin ERC20 tokens that declare no state
+	// before it, such as ExampleERC20 and ExampleWAVAX. Other tokens may store their balances elsewhere.
+	ERC20BalancesSlot uint64 = 0
+)
+
+// The offset of the collateralNeeded field within the DestinationBridgeSettings struct stored for each destination
+const collateralNeededOffset = 1
+
+// MappingSlot returns the slot of the value stored for the key in the mapping at the slot, keccak256(key . slot)
+func MappingSlot(slot common.Hash, key common.Hash) common.Hash {
+	return crypto.Keccak256Hash(key.Bytes(), slot.Bytes())
+}
+
+// BridgedBalanceSlot returns the slot of the balance a source has bridged to the destination
+func BridgedBalanceSlot(destinationBlockchainID ids.ID, destinationBridgeAddress common.Address) common.Hash {
+	return destinationSlot(SourceBridgedBalancesSlot, destinationBlockchainID, destinationBridgeAddress)
+}
+
+// CollateralNeededSlot returns the slot of the collateral a source still needs to be added for the destination
+// before it can send tokens to it
+func CollateralNeededSlot(destinationBlockchainID ids.ID, destinationBridgeAddress common.Address) common.Hash {
+	settings := destinationSlot(SourceRegisteredDestinationsSlot, destinationBlockchainID, destinationBridgeAddress)
+	return offsetSlot(settings, collateralNeededOffset)
+}
+
+// ERC20BalanceSlot returns the slot of the balance of the account in a token with the OpenZeppelin ERC20 layout
+func ERC20BalanceSlot(account common.Address) common.Hash {
+	return MappingSlot(slotHash(ERC20BalancesSlot), common.BytesToHash(account.Bytes()))
+}
+
+// TotalSupplySlot returns the slot of the ERC20 total supply of a destination contract
+func TotalSupplySlot(contractType events.ContractType) (common.Hash, error) {
+	switch contractType {
+	case events.ERC20Destination:
+		return slotHash(ERC20DestinationTotalSupplySlot), nil
+	case events.NativeTokenDestination:
+		return slotHash(NativeTokenDestinationTotalSupplySlot), nil
+	default:
+		return common.Hash{}, fmt.Errorf("%s has no total supply", contractType)
+	}
+}
+
+// CollateralizedSlot returns the slot of the isCollateralized flag of a destination contract
+func CollateralizedSlot(contractType events.ContractType) (common.Hash, error) {
+	switch contractType {
+	case events.ERC20Destination:
+		return slotHash(ERC20DestinationCollateralizedSlot), nil
+	case events.NativeTokenDestination:
+		return slotHash(NativeTokenDestinationCollateralizedSlot), nil
+	default:
+		return common.Hash{}, fmt.Errorf("%s is not a destination", contractType)
+	}
+}
+
+// The bridge mappings of a source are keyed by the destination blockchain ID, then the destination bridge address
+func destinationSlot(slot uint64, destinationBlockchainID ids.ID, destinationBridgeAddress common.Address) common.Hash {
+	inner := MappingSlot(slotHash(slot), common.Hash(destinationBlockchainID))
+	return MappingSlot(inner, common.BytesToHash(destinationBridgeAddress.Bytes()))
+}
+
+func offsetSlot(slot common.Hash, offset int64) common.Hash {
+	return common.BigToHash(new(big.Int).Add(slot.Big(), big.NewInt(offset)))
+}
+
+func slotHash(slot uint64) common.Hash {
+	return common.BigToHash(new(big.Int).SetUint64(slot))
+}
@@ -0,0 +1,145 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package relayer
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/signer"
+)
+
+const (
+	defaultPollIntervalSeconds = 2
+	defaultMaxBlockRange       = 2048
+)
+
+// Config is the configuration of the relayer
+type Config struct {
+	LogLevel string `json:"log-level"`
+	// PrivateKeyFile is the path of a file containing the hex encoded private key of the account that delivers
+	// messages, which is also the reward address of each delivery. It must hold the native token of each
+	// destination chain to pay for gas.
+	PrivateKeyFile string `json:"private-key-file"`
+	// KeystoreFile is the path of an encrypted keystore file holding the same key, in place of PrivateKeyFile, so
+	// that the key is not kept on disk in plaintext
+	KeystoreFile string `json:"keystore-file"`
+	// KeystorePassphraseEnv is the environment variable holding the passphrase of KeystoreFile
+	KeystorePassphraseEnv string `json:"keystore-passphrase-env"`
+	// PollIntervalSeconds is how often each chain is checked for new blocks
+	PollIntervalSeconds uint64 `json:"poll-interval-seconds"`
+	// MaxBlockRange is the maximum number of blocks fetched per eth_getLogs request
+	MaxBlockRange uint64 `json:"max-block-range"`
+	// NodeURIs maps chain names to the base URI of a node serving the chain's Warp API and the P-Chain API,
+	// which messages sent from the chain are signed through. Defaults to the host of the chain's RPC endpoint.
+	NodeURIs map[string]string `json:"node-uris"`
+
+	Chains []events.ChainConfig `json:"chains"`
+	// Bridges are the bridge contracts whose messages are relayed. If none are configured, every Teleporter
+	// message sent between the configured chains is relayed.
+	Bridges []events.BridgeConfig `json:"bridges"`
+}
+
+// LoadConfig reads and validates the JSON configuration file at the given path,
+// filling in defaults for unset optional values.
+func LoadConfig(path string) (*Config, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	var config Config
+	if err := json.Unmarshal(bytes, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+	config.setDefaults()
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return &config, nil
+}
+
+func (c *Config) setDefaults() {
+	if c.LogLevel == "" {
+		c.LogLevel = logging.Info.LowerString()
+	}
+	if c.PollIntervalSeconds == 0 {
+		c.PollIntervalSeconds = defaultPollIntervalSeconds
+	}
+	if c.MaxBlockRange == 0 {
+		c.MaxBlockRange = defaultMaxBlockRange
+	}
+}
+
+// Validate checks that the configuration is well formed
+func (c *Config) Validate() error {
+	if _, err := logging.ToLevel(c.LogLevel); err != nil {
+		return fmt.Errorf("invalid log level: %w", err)
+	}
+	if c.PrivateKeyFile == "" && c.KeystoreFile == "" {
+		return fmt.Errorf("private key file or keystore file must be set")
+	}
+	if err := c.validateKeystore(); err != nil {
+		return err
+	}
+	if len(c.Chains) < 2 {
+		return fmt.Errorf("at least two chains must be configured")
+	}
+	if err := events.Validate(c.Chains, c.Bridges); err != nil {
+		return err
+	}
+	chainNames := make(map[string]struct{}, len(c.Chains))
+	for _, chain := range c.Chains {
+		chainNames[chain.Name] = struct{}{}
+	}
+	for chain, uri := range c.NodeURIs {
+		if _, ok := chainNames[chain]; !ok {
+			return fmt.Errorf("node URI set for unknown chain %s", chain)
+		}
+		if _, err := url.Parse(uri); err != nil {
+			return fmt.Errorf("invalid node URI for chain %s: %w", chain, err)
+		}
+	}
+	return nil
+}
+
+// LoadPrivateKey reads the private key of the account that delivers messages
+func (c *Config) LoadPrivateKey() (*ecdsa.PrivateKey, error) {
+	if c.KeystoreFile != "" {
+		return signer.LoadKeystore(c.KeystoreFile, c.KeystorePassphraseEnv)
+	}
+	return signer.LoadKeyFile(c.PrivateKeyFile)
+}
+
+func (c *Config) validateKeystore() error {
+	if c.PrivateKeyFile != "" && c.KeystoreFile != "" {
+		return fmt.Errorf("only one of private key file and keystore file may be set")
+	}
+	if c.KeystoreFile != "" && c.KeystorePassphraseEnv == "" {
+		return fmt.Errorf("keystore passphrase env must be set along with the keystore file")
+	}
+	return nil
+}
+
+// Returns the base URI of the node that messages sent from the chain are signed through
+func (c *Config) nodeURI(chain events.ChainConfig) (string, error) {
+	if uri, ok := c.NodeURIs[chain.Name]; ok {
+		return strings.TrimSuffix(uri, "/"), nil
+	}
+	rpcURL, err := url.Parse(chain.RPCEndpoint)
+	if err != nil || rpcURL.Host == "" {
+		return "", fmt.Errorf("invalid rpc endpoint %s for chain %s", chain.RPCEndpoint, chain.Name)
+	}
+	return rpcURL.Scheme + "://" + rpcURL.Host, nil
+}
+
+func (c *Config) pollInterval() time.Duration {
+	return time.Duration(c.PollIntervalSeconds) * time.Second
+}
@@ -0,0 +1,352 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package relayer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	avalancheWarp "github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	subnetEvmInterfaces "github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// Validators may not have accepted the block that sent a message by the time it is seen, so its signature
+	// is retried before the message is given up on
+	signatureAttempts      = 10
+	signatureRetryInterval = time.Second
+)
+
+// Relayer follows the blocks of each configured chain, and delivers the Teleporter messages sent by the configured
+// bridge contracts to the other configured chains. The aggregate signature of each message is fetched from the
+// Warp API of the source chain, and the message is delivered from the configured account. Messages whose
+// signature or delivery fails are logged and skipped, and can be delivered later with `bridge-cli recover`.
+type Relayer struct {
+	logger logging.Logger
+	config *Config
+
+	chains     map[string]*events.Chain
+	chainsByID map[ids.ID]*events.Chain
+	nodeURIs   map[string]string
+	key        *ecdsa.PrivateKey
+	account    common.Address
+
+	teleporter  *teleportermessenger.TeleporterMessengerFilterer
+	sendEventID common.Hash
+
+	signingSubnetsLock sync.Mutex
+	signingSubnets     map[[2]ids.ID]ids.ID
+	// Deliveries to each chain are sent one at a time, since they are sent from the same account
+	deliveryLocks map[ids.ID]*sync.Mutex
+}
+
+// NewRelayer connects to each configured chain and loads the account that delivers messages
+func NewRelayer(ctx context.Context, logger logging.Logger, config *Config) (*Relayer, error) {
+	key, err := config.LoadPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	teleporter, err := teleportermessenger.NewTeleporterMessengerFilterer(common.Address{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	teleporterABI, err := teleportermessenger.TeleporterMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	nodeURIs := make(map[string]string, len(config.Chains))
+	for _, chain := range config.Chains {
+		if nodeURIs[chain.Name], err = config.nodeURI(chain); err != nil {
+			return nil, err
+		}
+	}
+	chains, err := events.ConnectChains(ctx, config.Chains)
+	if err != nil {
+		return nil, err
+	}
+	chainsByID := make(map[ids.ID]*events.Chain, len(chains))
+	deliveryLocks := make(map[ids.ID]*sync.Mutex, len(chains))
+	for _, chain := range chains {
+		chainsByID[chain.BlockchainID] = chain
+		deliveryLocks[chain.BlockchainID] = &sync.Mutex{}
+	}
+	return &Relayer{
+		logger:         logger,
+		config:         config,
+		chains:         chains,
+		chainsByID:     chainsByID,
+		nodeURIs:       nodeURIs,
+		key:            key,
+		account:        crypto.PubkeyToAddress(key.PublicKey),
+		teleporter:     teleporter,
+		sendEventID:    teleporterABI.Events["SendCrossChainMessage"].ID,
+		signingSubnets: make(map[[2]ids.ID]ids.ID),
+		deliveryLocks:  deliveryLocks,
+	}, nil
+}
+
+// Account returns the address that messages are delivered from
+func (r *Relayer) Account() common.Address {
+	return r.account
+}
+
+// Run relays the messages sent from every chain, starting at each chain's start block, or the latest block if not
+// set, until the context is cancelled
+func (r *Relayer) Run(ctx context.Context) error {
+	r.logger.Info("Starting relayer", zap.Stringer("account", r.account), zap.Int("numChains", len(r.chains)))
+	g, ctx := errgroup.WithContext(ctx)
+	for _, chain := range r.chains {
+		chain := chain
+		g.Go(func() error {
+			return r.follow(ctx, chain)
+		})
+	}
+	return g.Wait()
+}
+
+// Relays the messages sent in each new block of the chain
+func (r *Relayer) follow(ctx context.Context, chain *events.Chain) error {
+	nextBlock := chain.StartBlock
+	if nextBlock == 0 {
+		final, err := chain.FinalBlock(ctx)
+		if err != nil {
+			return err
+		}
+		nextBlock = final
+	}
+
+	ticker := time.NewTicker(r.config.pollInterval())
+	defer ticker.Stop()
+	for {
+		caughtUp, err := r.poll(ctx, chain, &nextBlock)
+		if err != nil {
+			// Errors are transient RPC failures, so log and retry the same blocks on the next interval
+			r.logger.Warn("Failed to relay blocks", zap.String("chain", chain.Name), zap.Error(err))
+		} else if !caughtUp {
+			// Continue immediately while catching up
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// Relays the messages sent in the next range of blocks, up to the chain's final block, advancing nextBlock past
+// them. Returns true if the chain's final block has been relayed.
+func (r *Relayer) poll(ctx context.Context, chain *events.Chain, nextBlock *uint64) (bool, error) {
+	latest, err := chain.FinalBlock(ctx)
+	if err != nil {
+		return false, err
+	}
+	if *nextBlock > latest {
+		return true, nil
+	}
+	toBlock := min(latest, *nextBlock+r.config.MaxBlockRange-1)
+	if err := r.relayBlocks(ctx, chain, *nextBlock, toBlock); err != nil {
+		return false, err
+	}
+	*nextBlock = toBlock + 1
+	return toBlock == latest, nil
+}
+
+// Relays the messages sent by the chain in the given inclusive block range. Messages are relayed in the order
+// they were sent.
+func (r *Relayer) relayBlocks(ctx context.Context, chain *events.Chain, fromBlock uint64, toBlock uint64) error {
+	// Teleporter messengers of every version emit the same event, so logs are filtered by topic alone
+	logs, err := chain.Client.FilterLogs(ctx, subnetEvmInterfaces.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
+		Topics:    [][]common.Hash{{r.sendEventID}},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to filter logs on chain %s: %w", chain.Name, err)
+	}
+
+	// The messages of a transaction to each destination are found from its receipt together
+	type sentTo struct {
+		txHash        common.Hash
+		destinationID ids.ID
+	}
+	var sends []sentTo
+	messageIDs := make(map[sentTo]map[ids.ID]struct{}, len(logs))
+	contracts := events.ContractsOnChain(r.config.Bridges, chain.Name)
+	for _, log := range logs {
+		event, err := r.teleporter.ParseSendCrossChainMessage(log)
+		if err != nil {
+			return fmt.Errorf("failed to parse SendCrossChainMessage event: %w", err)
+		}
+		if _, ok := r.chainsByID[ids.ID(event.DestinationBlockchainID)]; !ok {
+			continue
+		}
+		if _, ok := contracts[event.Message.OriginSenderAddress]; len(r.config.Bridges) > 0 && !ok {
+			continue
+		}
+		key := sentTo{log.TxHash, ids.ID(event.DestinationBlockchainID)}
+		if _, ok := messageIDs[key]; !ok {
+			sends = append(sends, key)
+			messageIDs[key] = make(map[ids.ID]struct{})
+		}
+		messageIDs[key][ids.ID(event.MessageID)] = struct{}{}
+	}
+
+	for _, send := range sends {
+		destination := r.chainsByID[send.destinationID]
+		sent, err := bridge.SentMessages(ctx, chain, destination.BlockchainID, send.txHash)
+		if err != nil {
+			return err
+		}
+		for _, message := range sent {
+			if _, ok := messageIDs[send][message.MessageID]; !ok {
+				continue
+			}
+			fields := []zap.Field{
+				zap.String("sourceChain", chain.Name),
+				zap.String("destinationChain", destination.Name),
+				zap.Stringer("messageID", message.MessageID),
+				zap.Stringer("txHash", send.txHash),
+			}
+			if err := r.relay(ctx, chain, destination, message, fields); err != nil {
+				r.logger.Error("Failed to relay message", append(fields, zap.Error(err))...)
+			}
+		}
+	}
+	return nil
+}
+
+// Delivers the message to the destination, unless it has already been delivered or can't be delivered by the
+// relayer's account
+func (r *Relayer) relay(
+	ctx context.Context,
+	source *events.Chain,
+	destination *events.Chain,
+	message *bridge.SentMessage,
+	fields []zap.Field,
+) error {
+	if !r.allowedRelayer(message.Message.AllowedRelayerAddresses) {
+		r.logger.Info("Skipping message that the relayer is not allowed to deliver", fields...)
+		return nil
+	}
+	messenger, err := teleportermessenger.NewTeleporterMessenger(message.TeleporterAddress, destination.Client)
+	if err != nil {
+		return err
+	}
+	delivered, err := messenger.MessageReceived(&bind.CallOpts{Context: ctx}, message.MessageID)
+	if err != nil {
+		return fmt.Errorf("failed to check if message was received: %w", err)
+	}
+	if delivered {
+		r.logger.Debug("Message already delivered", fields...)
+		return nil
+	}
+
+	signedMessage, err := r.sign(ctx, source, destination, message)
+	if err != nil {
+		return err
+	}
+
+	lock := r.deliveryLocks[destination.BlockchainID]
+	lock.Lock()
+	defer lock.Unlock()
+	receipt, err := bridge.DeliverMessage(ctx, destination, message, signedMessage, r.key)
+	if err != nil {
+		return err
+	}
+	for _, receiptLog := range receipt.Logs {
+		if _, err := messenger.ParseMessageExecutionFailed(*receiptLog); err == nil {
+			r.logger.Warn(
+				"Delivered message, but its execution failed",
+				append(fields, zap.Stringer("deliveryTxHash", receipt.TxHash))...,
+			)
+			return nil
+		}
+	}
+	r.logger.Info("Delivered message", append(fields, zap.Stringer("deliveryTxHash", receipt.TxHash))...)
+	return nil
+}
+
+// Fetches the aggregate signature of the message, retrying while the validators may not yet have accepted the
+// block that sent it
+func (r *Relayer) sign(
+	ctx context.Context,
+	source *events.Chain,
+	destination *events.Chain,
+	message *bridge.SentMessage,
+) (*avalancheWarp.Message, error) {
+	nodeURI := r.nodeURIs[source.Name]
+	signingSubnetID, err := r.signingSubnetID(ctx, nodeURI, source, destination)
+	if err != nil {
+		return nil, err
+	}
+	for attempt := 1; ; attempt++ {
+		signedMessage, err := bridge.SignMessage(ctx, nodeURI, source.BlockchainID, signingSubnetID, message)
+		if err == nil || attempt == signatureAttempts {
+			return signedMessage, err
+		}
+		r.logger.Debug(
+			"Failed to sign message, retrying",
+			zap.Stringer("messageID", message.MessageID),
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(signatureRetryInterval):
+		}
+	}
+}
+
+// Returns the subnet whose validators sign the messages sent from the source to the destination, which only
+// changes if a chain is moved to another subnet, so is only read once
+func (r *Relayer) signingSubnetID(
+	ctx context.Context,
+	nodeURI string,
+	source *events.Chain,
+	destination *events.Chain,
+) (ids.ID, error) {
+	r.signingSubnetsLock.Lock()
+	defer r.signingSubnetsLock.Unlock()
+	key := [2]ids.ID{source.BlockchainID, destination.BlockchainID}
+	if subnetID, ok := r.signingSubnets[key]; ok {
+		return subnetID, nil
+	}
+	subnetID, err := bridge.SigningSubnetID(ctx, nodeURI, source.BlockchainID, destination.BlockchainID)
+	if err != nil {
+		return ids.Empty, err
+	}
+	r.signingSubnets[key] = subnetID
+	return subnetID, nil
+}
+
+// Returns whether the relayer's account may deliver a message with the allowed relayers. Any relayer may deliver
+// messages that don't restrict their relayers.
+func (r *Relayer) allowedRelayer(allowedRelayers []common.Address) bool {
+	if len(allowedRelayers) == 0 {
+		return true
+	}
+	for _, allowed := range allowedRelayers {
+		if allowed == r.account {
+			return true
+		}
+	}
+	return false
+}
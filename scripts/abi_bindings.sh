@@ -11,18 +11,15 @@ TELEPORTER_TOKEN_BRIDGE_PATH=$(
 
 source $TELEPORTER_TOKEN_BRIDGE_PATH/scripts/constants.sh
 source $TELEPORTER_TOKEN_BRIDGE_PATH/scripts/versions.sh
-source $TELEPORTER_PATH/scripts/utils.sh
-
-setARCH
-
-# Contract names to generate Go bindings for
-DEFAULT_CONTRACT_LIST="TeleporterTokenSource TeleporterTokenDestination ERC20Source ERC20Destination NativeTokenSource NativeTokenDestination ExampleWAVAX MockERC20SendAndCallReceiver MockNativeSendAndCallReceiver"
 
+# Contract names to generate Go bindings for. If empty, cmd/abi-bindings-gen generates bindings for all bridge contracts.
 CONTRACT_LIST=
+CHECK=
 HELP=
 while [ $# -gt 0 ]; do
     case "$1" in
         -c | --contract) CONTRACT_LIST=$2 ;;
+        --check) CHECK=true ;;
         -h | --help) HELP=true ;;
     esac
     shift
@@ -35,6 +32,7 @@ if [ "$HELP" = true ]; then
     echo "Options:"
     echo "  -c, --contract <contract_name>          Generate Go bindings for the contract. If empty, generate Go bindings for a default list of contracts"
     echo "  -c, --contract "contract1 contract2"    Generate Go bindings for multiple contracts"
+    echo "  --check                                 Fail if the checked-in Go bindings differ from the contracts, rather than writing them"
     echo "  -h, --help                              Print this help message"
     exit 0
 fi
@@ -43,42 +41,9 @@ if ! command -v forge &> /dev/null; then
     echo "forge not found. You can install by calling $TELEPORTER_TOKEN_BRIDGE_PATH/scripts/install_foundry.sh" && exit 1
 fi
 
-echo "Building subnet-evm abigen"
-go install github.com/ava-labs/subnet-evm/cmd/abigen@${SUBNET_EVM_VERSION}
-
-# Force recompile of all contracts to prevent against using previous
-# compilations that did not generate new ABI files.
-echo "Building Contracts"
-cd $TELEPORTER_TOKEN_BRIDGE_PATH/contracts
-forge build --force --extra-output-files abi bin
-
-contract_names=($CONTRACT_LIST)
-
-# If CONTRACT_LIST is empty, use DEFAULT_CONTRACT_LIST
-if [[ -z "${CONTRACT_LIST}" ]]; then
-    contract_names=($DEFAULT_CONTRACT_LIST)
-fi
-
-cd $TELEPORTER_TOKEN_BRIDGE_PATH/contracts/src
-for contract_name in "${contract_names[@]}"
-do
-    path=$(find . -name $contract_name.sol)
-    dir=$(dirname $path)
-    abi_file=$TELEPORTER_TOKEN_BRIDGE_PATH/contracts/out/$contract_name.sol/$contract_name.abi.json
-    if ! [ -f $abi_file ]; then
-        echo "Error: Contract $contract_name abi file not found"
-        exit 1
-    fi
-
-    echo "Generating Go bindings for $contract_name..."
-    gen_path=$TELEPORTER_TOKEN_BRIDGE_PATH/abi-bindings/go/$dir/$contract_name
-    mkdir -p $gen_path
-    $GOPATH/bin/abigen --abi $abi_file \
-                       --pkg $(convertToLower $contract_name) \
-                       --bin $TELEPORTER_TOKEN_BRIDGE_PATH/contracts/out/$contract_name.sol/$contract_name.bin \
-                       --type $contract_name \
-                       --out $gen_path/$contract_name.go
-    echo "Done generating Go bindings for $contract_name."
-done
-
-exit 0
+cd $TELEPORTER_TOKEN_BRIDGE_PATH
+go run ./cmd/abi-bindings-gen \
+    --root $TELEPORTER_TOKEN_BRIDGE_PATH \
+    --forge $(command -v forge) \
+    --contracts "$CONTRACT_LIST" \
+    ${CHECK:+--check}
@@ -0,0 +1,44 @@
+#!/usr/bin/env bash
+# Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+# See the file LICENSE for licensing terms.
+
+set -e
+
+TELEPORTER_TOKEN_BRIDGE_PATH=$(
+  cd "$(dirname "${BASH_SOURCE[0]}")"
+  cd .. && pwd
+)
+
+MANIFEST_FILE=$TELEPORTER_TOKEN_BRIDGE_PATH/tests/compatibility/versions.json
+export COMPATIBILITY_ARTIFACTS_DIR=${COMPATIBILITY_ARTIFACTS_DIR:-"$TELEPORTER_TOKEN_BRIDGE_PATH/tests/compatibility/artifacts"}
+
+if ! command -v jq &> /dev/null; then
+  echo "jq not found, required to read $MANIFEST_FILE" && exit 1
+fi
+
+if command -v forge &> /dev/null; then
+  FORGE_COMMAND="forge build"
+else
+  echo "Forge command not found, attempting to use from $HOME"
+  FORGE_COMMAND="$HOME/.foundry/bin/forge build"
+fi
+
+# Build the contracts of each pinned version from a worktree checked out at its ref
+for name in $(jq -r '.versions[].name' $MANIFEST_FILE); do
+  ref=$(jq -r --arg name "$name" '.versions[] | select(.name == $name) | .ref' $MANIFEST_FILE)
+  if [ -d "$COMPATIBILITY_ARTIFACTS_DIR/$name/out" ]; then
+    echo "Using existing artifacts of version $name"
+    continue
+  fi
+
+  echo "Building contracts of version $name at $ref"
+  worktree=$(mktemp -d)
+  git -C $TELEPORTER_TOKEN_BRIDGE_PATH worktree add --detach $worktree $ref
+  git -C $worktree submodule update --init --recursive
+  (cd $worktree/contracts && $FORGE_COMMAND)
+  mkdir -p $COMPATIBILITY_ARTIFACTS_DIR/$name
+  cp -r $worktree/contracts/out $COMPATIBILITY_ARTIFACTS_DIR/$name/out
+  git -C $TELEPORTER_TOKEN_BRIDGE_PATH worktree remove --force $worktree
+done
+
+E2E_SUITE=compatibility $TELEPORTER_TOKEN_BRIDGE_PATH/scripts/e2e_test.sh
@@ -14,6 +14,51 @@ source "$TELEPORTER_TOKEN_BRIDGE_PATH"/scripts/versions.sh
 
 BASEDIR=${BASEDIR:-"$HOME/.teleporter-token-bridge-deps"}
 
+LIST_FLOWS=
+HELP=
+while [ $# -gt 0 ]; do
+    case "$1" in
+        --list-flows) LIST_FLOWS=true ;;
+        -h | --help) HELP=true ;;
+    esac
+    shift
+done
+
+if [ "$HELP" = true ]; then
+    echo "Usage: ./scripts/e2e_test.sh [OPTIONS]"
+    echo "Run the E2E tests"
+    echo ""
+    echo "Options:"
+    echo "  --list-flows    List the flows that can be selected with RUN_FLOWS, and the specs of the suite, without running them"
+    echo "  -h, --help      Print this help message"
+    echo ""
+    echo "Environment:"
+    echo "  RUN_FLOWS             Comma separated flows to run, for example RUN_FLOWS=erc20,multihop"
+    echo "  GINKGO_LABEL_FILTER   Ginkgo label filter, applied in addition to RUN_FLOWS"
+    echo "  GINKGO_FOCUS          Run only the specs whose description matches"
+    echo "  E2E_SUITE             The suite to run, either local, compatibility, or devnet. Defaults to local"
+    echo "  E2E_DEVNET_DIR        Directory the devnet suite writes its endpoints and manifest to. Defaults to ./devnet"
+    echo "  E2E_SUBNET_CONFIG_FILE  JSON file with the fee config and precompiles of each Subnet"
+    echo "  E2E_GAS_REPORT_DIR    Write a gas report of the local suite to this directory"
+    echo "  E2E_GAS_REPORT_BASELINE  A gas-report.json of an earlier run to compare the gas report against"
+    echo "  E2E_RESOURCE_REPORT_DIR  Write the wall time, RPC calls and transactions of each flow to this directory"
+    echo "  E2E_RANDOM_SEED       Seed of the random amounts bridged by the flows, logged by each run"
+    exit 0
+fi
+
+# The suite to run, either local, compatibility, or devnet, which keeps the network running until interrupted
+E2E_SUITE=${E2E_SUITE:-"local"}
+
+# Listing the flows only needs the test binary, not the network or the contracts
+if [ "$LIST_FLOWS" = true ]; then
+  go install -v github.com/onsi/ginkgo/v2/ginkgo
+  ginkgo build ./tests/$E2E_SUITE/
+  LIST_FLOWS=true ./tests/$E2E_SUITE/$E2E_SUITE.test \
+    --ginkgo.label-filter=${GINKGO_LABEL_FILTER:-""} \
+    --ginkgo.focus=${GINKGO_FOCUS:-""}
+  exit 0
+fi
+
 cwd=$(pwd)
 # Install the avalanchego and subnet-evm binaries
 rm -rf $BASEDIR/avalanchego
@@ -32,22 +77,41 @@ else
   FORGE_COMMAND="$HOME/.foundry/bin/forge build"
 fi
 
-cd $TELEPORTER_TOKEN_BRIDGE_PATH/contracts
-$FORGE_COMMAND
+# Artifacts of unchanged contract sources are cached here across runs, slimmed down to their ABI and bytecode
+export E2E_ARTIFACT_CACHE_DIR=${E2E_ARTIFACT_CACHE_DIR:-"$BASEDIR/artifact-cache"}
+
+# Builds the contracts in the given directory, unless its artifacts were already built from the same sources.
+# The hash of the sources is written alongside the artifacts, and keys the cached artifacts used by the tests.
+build_contracts() {
+  local contracts_dir=$1
+  local source_hash=$(cd $contracts_dir && find . \( -path ./out -o -path ./cache \) -prune -o -type f \
+    \( -name '*.sol' -o -name foundry.toml -o -name remappings.txt \) -print0 \
+    | LC_ALL=C sort -z | xargs -0 shasum -a 256 | shasum -a 256 | cut -d ' ' -f 1)
+  if [ "$(cat $contracts_dir/out/.source-hash 2> /dev/null)" == "$source_hash" ]; then
+    echo "Contracts in $contracts_dir are unchanged since the last build, skipping forge build"
+    return
+  fi
+  (cd $contracts_dir && $FORGE_COMMAND)
+  echo $source_hash > $contracts_dir/out/.source-hash
+}
 
-cd $TELEPORTER_PATH/contracts
-$FORGE_COMMAND
+build_contracts $TELEPORTER_TOKEN_BRIDGE_PATH/contracts
+build_contracts $TELEPORTER_PATH/contracts
 
 cd $cwd
 # Build ginkgo
 # to install the ginkgo binary (required for test build and run)
 go install -v github.com/onsi/ginkgo/v2/ginkgo
 
-ginkgo build ./tests/local/
+ginkgo build ./tests/$E2E_SUITE/
+
+if [ -z "$AWM_RELAYER_PATH" ]; then
+  echo "AWM_RELAYER_PATH not set, flows delivering messages with the relayer will be skipped"
+fi
 
 # Run the tests
 echo "Running e2e tests"
-RUN_E2E=true ./tests/local/local.test \
+RUN_E2E=true ./tests/$E2E_SUITE/$E2E_SUITE.test \
   --ginkgo.vv \
   --ginkgo.label-filter=${GINKGO_LABEL_FILTER:-""} \
   --ginkgo.focus=${GINKGO_FOCUS:-""} \
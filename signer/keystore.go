@@ -0,0 +1,50 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package signer
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// LoadKeyFile reads the private key from a file containing the hex encoded key, with or without a 0x prefix
+func LoadKeyFile(path string) (*ecdsa.PrivateKey, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key file: %w", err)
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(string(bytes)), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	return key, nil
+}
+
+// LoadKeystore decrypts the private key of an encrypted keystore file, in the version 3 format written by geth,
+// Core and most wallets, with the passphrase held by the passphraseEnv environment variable. The passphrase is read
+// from the environment, rather than passed as a flag or written to a config file, so that it is not kept in shell
+// history or on disk alongside the keystore.
+func LoadKeystore(path string, passphraseEnv string) (*ecdsa.PrivateKey, error) {
+	if passphraseEnv == "" {
+		return nil, fmt.Errorf("no passphrase environment variable set for keystore file %s", path)
+	}
+	passphrase, ok := os.LookupEnv(passphraseEnv)
+	if !ok {
+		return nil, fmt.Errorf("%s must be set to the passphrase of keystore file %s", passphraseEnv, path)
+	}
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read keystore file: %w", err)
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt keystore file %s: %w", path, err)
+	}
+	return key.PrivateKey, nil
+}
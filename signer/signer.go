@@ -0,0 +1,103 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package signer provides the private keys that the E2E suites and tools send transactions from, either as
+// individual keys, read from plaintext or encrypted keystore files, or derived from a single mnemonic.
+package signer
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil/hdkeychain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultBasePath is the BIP-44 path of the EVM accounts of a mnemonic, m/44'/60'/0'/0, that account indices are
+// appended to. It is the path used by Core and MetaMask for C-Chain and Subnet-EVM accounts.
+var DefaultBasePath = accounts.DefaultRootDerivationPath
+
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// Signer provides the private key of each of the accounts that transactions are sent from, by account index
+type Signer interface {
+	Key(index uint32) (*ecdsa.PrivateKey, error)
+}
+
+// Keys is a Signer of a fixed list of private keys, indexed by their position in the list
+type Keys []*ecdsa.PrivateKey
+
+func (k Keys) Key(index uint32) (*ecdsa.PrivateKey, error) {
+	if int(index) >= len(k) {
+		return nil, fmt.Errorf("no key at index %d of %d keys", index, len(k))
+	}
+	return k[index], nil
+}
+
+// HDWallet is a Signer of the accounts of a BIP-39 mnemonic, deriving the key of each account index by appending
+// the index to its base path as in BIP-44
+type HDWallet struct {
+	master   *hdkeychain.ExtendedKey
+	basePath accounts.DerivationPath
+}
+
+// NewHDWallet creates the wallet of the mnemonic and optional BIP-39 passphrase, whose accounts are derived from
+// the base path
+func NewHDWallet(mnemonic string, passphrase string, basePath accounts.DerivationPath) (*HDWallet, error) {
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, passphrase)
+	if err != nil {
+		return nil, ErrInvalidMnemonic
+	}
+	// The network parameters only affect the serialization of extended keys, which are never serialized
+	master, err := hdkeychain.NewMaster(seed, &chaincfg.MainNetParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive master key: %w", err)
+	}
+	return &HDWallet{
+		master:   master,
+		basePath: basePath,
+	}, nil
+}
+
+// Key derives the private key of the account at the index
+func (w *HDWallet) Key(index uint32) (*ecdsa.PrivateKey, error) {
+	path := make(accounts.DerivationPath, len(w.basePath), len(w.basePath)+1)
+	copy(path, w.basePath)
+	return w.DeriveKey(append(path, index))
+}
+
+// DeriveKey derives the private key at the full derivation path
+func (w *HDWallet) DeriveKey(path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+	key := w.master
+	for _, index := range path {
+		child, err := key.Derive(index)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive %s: %w", path, err)
+		}
+		key = child
+	}
+	privateKey, err := key.ECPrivKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive %s: %w", path, err)
+	}
+	return crypto.ToECDSA(privateKey.Serialize())
+}
+
+// Derive returns the private keys of count consecutive accounts, starting from the first index
+func Derive(s Signer, first uint32, count int) ([]*ecdsa.PrivateKey, error) {
+	keys := make([]*ecdsa.PrivateKey, count)
+	for i := range keys {
+		key, err := s.Key(first + uint32(i))
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+	return keys, nil
+}
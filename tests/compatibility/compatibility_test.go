@@ -0,0 +1,124 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package compatibility
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ava-labs/teleporter-token-bridge/tests/flows"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ava-labs/teleporter/tests/local"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+)
+
+const (
+	versionManifestFile = "tests/compatibility/versions.json"
+
+	// Directory that the artifacts of each version in the manifest are built to
+	artifactsDirEnvVar  = "COMPATIBILITY_ARTIFACTS_DIR"
+	defaultArtifactsDir = "tests/compatibility/artifacts"
+)
+
+var (
+	LocalNetworkInstance *local.LocalNetwork
+	// SharedNetworkInstance wraps LocalNetworkInstance with the Teleporter deployment shared by every spec
+	SharedNetworkInstance interfaces.LocalNetwork
+	// SpecNetworkInstance wraps SharedNetworkInstance with an account funded for the current spec,
+	// and is passed to the flows
+	SpecNetworkInstance *utils.SpecNetwork
+	// Whether the suite setup or any spec failed, in which case the nodes' data directories are kept for their logs
+	suiteFailed bool
+)
+
+func TestCompatibility(t *testing.T) {
+	// Specs are selected by version with GINKGO_LABEL_FILTER, so no flows are selectable with RUN_FLOWS
+	suiteConfig, reporterConfig, err := utils.FlowSelectionConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Listing the specs does not run them, so does not need the E2E environment
+	if os.Getenv("RUN_E2E") == "" && !suiteConfig.DryRun {
+		t.Skip("Environment variable RUN_E2E not set; skipping compatibility tests")
+	}
+	format.MaxLength = 10000
+
+	RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Teleporter token bridge compatibility test", suiteConfig, reporterConfig)
+}
+
+var _ = ginkgo.BeforeSuite(func() {
+	// Registered first, so that the network is torn down and leaks are cleaned up after the AfterSuite even if
+	// the setup fails part way
+	utils.RecordSuiteResources()
+	ginkgo.DeferCleanup(func() {
+		utils.TearDownSuite(LocalNetworkInstance, suiteFailed)
+	})
+	suiteFailed = true
+
+	// Create the local network instance, allowing the NativeTokenDestinations deployed by the flows to mint
+	LocalNetworkInstance = local.NewLocalNetwork(utils.ApplyNativeMinterConfig(utils.WarpGenesisFile()))
+	// Configure each Subnet on top of the shared genesis, if E2E_SUBNET_CONFIG_FILE is set
+	utils.ConfigureSubnets(context.Background(), LocalNetworkInstance, utils.SubnetEVMConfigsFromEnv())
+
+	// Deploy Teleporter and the TeleporterRegistry to each chain
+	SharedNetworkInstance = utils.DeployTeleporterContracts(
+		context.Background(),
+		LocalNetworkInstance,
+		utils.TeleporterByteCodeFile(),
+	)
+	suiteFailed = false
+	log.Info("Set up ginkgo before suite")
+})
+
+// The network and Teleporter deployment are shared by every spec, while each spec deploys its own bridge
+// contracts from its own account
+var _ = ginkgo.BeforeEach(func() {
+	SpecNetworkInstance = utils.NewSpecNetwork(
+		context.Background(),
+		SharedNetworkInstance,
+		ginkgo.CurrentSpecReport().FullText(),
+	)
+})
+
+var _ = ginkgo.AfterEach(func() {
+	if ginkgo.CurrentSpecReport().Failed() {
+		suiteFailed = true
+		utils.TraceFailedSpec(context.Background(), ginkgo.CurrentSpecReport().FullText())
+	}
+	SpecNetworkInstance.Close()
+})
+
+// Each pinned version is deployed on one side of a bridge with the current version on the other,
+// in both directions, since bridge upgrades are rolled out one chain at a time
+var _ = ginkgo.Describe("[Teleporter Token Bridge compatibility tests]", func() {
+	manifest := utils.LoadVersionManifest(
+		utils.RepoPath(versionManifestFile),
+		utils.PathFromEnv(artifactsDirEnvVar, defaultArtifactsDir),
+	)
+
+	for _, version := range manifest.Versions {
+		version := version
+		ginkgo.Context(fmt.Sprintf("with version %s (%s)", version.Name, version.Ref), ginkgo.Label(version.Name), func() {
+			ginkgo.It("Bridge an ERC20 token from a pinned source to a current destination", func() {
+				flows.ERC20SourceERC20DestinationVersions(SpecNetworkInstance, version, utils.CurrentVersion)
+			})
+			ginkgo.It("Bridge an ERC20 token from a current source to a pinned destination", func() {
+				flows.ERC20SourceERC20DestinationVersions(SpecNetworkInstance, utils.CurrentVersion, version)
+			})
+			ginkgo.It("Bridge a native token from a pinned source to a current destination", func() {
+				flows.NativeSourceERC20DestinationVersions(SpecNetworkInstance, version, utils.CurrentVersion)
+			})
+			ginkgo.It("Bridge a native token from a current source to a pinned destination", func() {
+				flows.NativeSourceERC20DestinationVersions(SpecNetworkInstance, utils.CurrentVersion, version)
+			})
+		})
+	}
+})
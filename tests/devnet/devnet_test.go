@@ -0,0 +1,232 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package devnet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ava-labs/teleporter-token-bridge/conformance"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ava-labs/teleporter/tests/local"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// The number of accounts funded for the tools developed against the devnet
+	devAccountCount = 5
+
+	cChainName  = "c-chain"
+	subnetAName = "subnet-a"
+	subnetBName = "subnet-b"
+)
+
+var (
+	// Native tokens, and source tokens, sent to each dev account on every chain
+	devAccountFunding = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000))
+
+	nativeReserveImbalance = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1e6))
+	nativeBurnedFeesReward = big.NewInt(1)
+)
+
+var (
+	LocalNetworkInstance *local.LocalNetwork
+	// SharedNetworkInstance wraps LocalNetworkInstance with the Teleporter deployment of each chain
+	SharedNetworkInstance interfaces.LocalNetwork
+	// Whether the suite setup failed, in which case the nodes' data directories are kept for their logs
+	suiteFailed bool
+)
+
+func TestDevnet(t *testing.T) {
+	if os.Getenv("RUN_E2E") == "" {
+		t.Skip("Environment variable RUN_E2E not set; skipping devnet")
+	}
+	suiteConfig, reporterConfig := ginkgo.GinkgoConfiguration()
+	// The devnet runs until it is interrupted, rather than until the default suite timeout
+	suiteConfig.Timeout = 0
+
+	RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Teleporter token bridge devnet", suiteConfig, reporterConfig)
+}
+
+// The network is set up as for the e2e suite, so that the devnet matches the network the flows run against
+var _ = ginkgo.BeforeSuite(func() {
+	utils.RecordSuiteResources()
+	ginkgo.DeferCleanup(func() {
+		utils.TearDownSuite(LocalNetworkInstance, suiteFailed)
+	})
+	suiteFailed = true
+
+	LocalNetworkInstance = local.NewLocalNetwork(utils.ApplyNativeMinterConfig(utils.WarpGenesisFile()))
+	utils.ConfigureSubnets(context.Background(), LocalNetworkInstance, utils.SubnetEVMConfigsFromEnv())
+	SharedNetworkInstance = utils.DeployTeleporterContracts(
+		context.Background(),
+		LocalNetworkInstance,
+		utils.TeleporterByteCodeFile(),
+	)
+
+	suiteFailed = false
+	log.Info("Set up ginkgo before suite")
+})
+
+var _ = ginkgo.Describe("[Teleporter Token Bridge devnet]", func() {
+	ginkgo.It("Run the local network until interrupted", func(ctx ginkgo.SpecContext) {
+		devnet, manifest := deployDevnet(ctx, SharedNetworkInstance)
+		dir := utils.WriteDevnet(ctx, SharedNetworkInstance, devnet, manifest)
+		ginkgo.DeferCleanup(func() {
+			utils.RemoveDevnet(dir)
+		})
+
+		log.Info("Devnet is running, interrupt to tear it down", "dir", dir)
+		<-ctx.Done()
+	})
+})
+
+// Deploys an ERC20Source on the C-Chain with an ERC20Destination on each Subnet, and a NativeTokenSource on the
+// C-Chain with a collateralized NativeTokenDestination on Subnet B. Funds the dev accounts with native tokens on
+// every chain, and with the source token on the C-Chain. Returns the description of the devnet, and the manifest
+// of its two bridges.
+func deployDevnet(ctx context.Context, network interfaces.LocalNetwork) (*utils.Devnet, *conformance.Manifest) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		DeployERC20Destination(subnetBInfo).
+		Register(subnetAInfo).
+		Register(subnetBInfo)
+	erc20SourceAddress, _, tokenAddress, token := s.Source()
+	subnetADestinationAddress, _ := s.Destination(subnetAInfo)
+	subnetBDestinationAddress, _ := s.Destination(subnetBInfo)
+
+	wavaxAddress, _ := utils.DeployExampleWAVAX(ctx, fundedKey, cChainInfo)
+	nativeTokenSourceAddress, nativeTokenSource := utils.DeployNativeTokenSource(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		wavaxAddress,
+	)
+	nativeTokenDestinationAddress, _, collateralAmount := utils.DeployAndRegisterNativeTokenDestination(
+		ctx,
+		network,
+		subnetBInfo,
+		"SUBB",
+		cChainInfo,
+		nativeTokenSourceAddress,
+		nativeReserveImbalance,
+		0,
+		false,
+		nativeBurnedFeesReward,
+	)
+	utils.AddCollateralToNativeTokenSource(
+		ctx,
+		cChainInfo,
+		nativeTokenSource,
+		nativeTokenSourceAddress,
+		subnetBInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+		collateralAmount,
+		fundedKey,
+	)
+
+	devnet := &utils.Devnet{
+		Chains: []utils.DevnetChain{
+			utils.NewDevnetChain(ctx, cChainName, cChainInfo),
+			utils.NewDevnetChain(ctx, subnetAName, subnetAInfo),
+			utils.NewDevnetChain(ctx, subnetBName, subnetBInfo),
+		},
+		Contracts: []utils.DevnetContract{
+			{Name: "token", Chain: cChainName, Address: tokenAddress.Hex(), Type: "erc20"},
+			{Name: "wavax", Chain: cChainName, Address: wavaxAddress.Hex(), Type: "wavax"},
+			{
+				Name:    "erc20-source",
+				Chain:   cChainName,
+				Address: erc20SourceAddress.Hex(),
+				Type:    string(events.ERC20Source),
+			},
+			{
+				Name:    "erc20-destination-a",
+				Chain:   subnetAName,
+				Address: subnetADestinationAddress.Hex(),
+				Type:    string(events.ERC20Destination),
+			},
+			{
+				Name:    "erc20-destination-b",
+				Chain:   subnetBName,
+				Address: subnetBDestinationAddress.Hex(),
+				Type:    string(events.ERC20Destination),
+			},
+			{
+				Name:    "native-source",
+				Chain:   cChainName,
+				Address: nativeTokenSourceAddress.Hex(),
+				Type:    string(events.NativeTokenSource),
+			},
+			{
+				Name:    "native-destination-b",
+				Chain:   subnetBName,
+				Address: nativeTokenDestinationAddress.Hex(),
+				Type:    string(events.NativeTokenDestination),
+			},
+		},
+	}
+
+	devKeys := make([]*ecdsa.PrivateKey, devAccountCount)
+	devAddresses := make([]common.Address, devAccountCount)
+	for i := range devKeys {
+		devKeys[i] = utils.NewAccountKey()
+		devAddresses[i] = crypto.PubkeyToAddress(devKeys[i].PublicKey)
+		devnet.Accounts = append(devnet.Accounts, utils.NewDevnetAccount(devKeys[i]))
+	}
+	for _, subnet := range network.GetAllSubnetsInfo() {
+		utils.FundAccounts(ctx, subnet, fundedKey, devAddresses, devAccountFunding)
+	}
+	utils.FundAccountsERC20(ctx, cChainInfo, fundedKey, tokenAddress, token, devAddresses, devAccountFunding)
+
+	manifest := &conformance.Manifest{
+		Chains: devnet.ChainConfigs(),
+		Bridges: []events.BridgeConfig{
+			{
+				Name: "erc20",
+				Source: events.ContractConfig{
+					Chain:   cChainName,
+					Address: erc20SourceAddress.Hex(),
+					Type:    events.ERC20Source,
+				},
+				Destinations: []events.ContractConfig{
+					{Chain: subnetAName, Address: subnetADestinationAddress.Hex(), Type: events.ERC20Destination},
+					{Chain: subnetBName, Address: subnetBDestinationAddress.Hex(), Type: events.ERC20Destination},
+				},
+			},
+			{
+				Name: "native",
+				Source: events.ContractConfig{
+					Chain:   cChainName,
+					Address: nativeTokenSourceAddress.Hex(),
+					Type:    events.NativeTokenSource,
+				},
+				Destinations: []events.ContractConfig{{
+					Chain:   subnetBName,
+					Address: nativeTokenDestinationAddress.Hex(),
+					Type:    events.NativeTokenDestination,
+				}},
+			},
+		},
+	}
+	return devnet, manifest
+}
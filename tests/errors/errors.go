@@ -3,4 +3,28 @@ package errors
 var (
 	ErrDestinationNotRegistered = "destination not registered"
 	ErrNonZeroCollateralNeeded  = "collateral needed for destination"
+	ErrZeroCollateralNeeded     = "zero collateral needed"
+
+	ErrCallerNotOwner             = "caller is not the owner"
+	ErrInvalidTeleporterVersion   = "invalid Teleporter version"
+	ErrMinTeleporterVersionTooLow = "not greater than current minimum version"
+	ErrTeleporterAddressPaused    = "address already paused"
+	ErrTeleporterAddressNotPaused = "address not paused"
+	ErrRetryExecutionFailed       = "retry execution failed"
+	ErrProtocolAddressNotFound    = "protocol address not found"
+
+	ErrSendsPaused        = "sends paused"
+	ErrSendsAlreadyPaused = "sends already paused"
+	ErrSendsNotPaused     = "sends not paused"
+
+	ErrRateLimitExceeded   = "rate limit exceeded"
+	ErrZeroRateLimitWindow = "zero rate limit window"
+
+	ErrRecipientBlocked = "recipient blocked"
+	ErrNoEscrowedTokens = "no escrowed tokens"
+
+	ErrNoClaimableWithdrawal = "no claimable withdrawal"
+
+	ErrZeroScaledAmountToReportBurn = "zero scaled amount to report burn"
+	ErrBurnAmountExceedsBalance     = "burn amount exceeds balance"
 )
@@ -0,0 +1,29 @@
+package flows
+
+import (
+	"context"
+
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+)
+
+/**
+ * Starts the in-repo bridge relayer delivering messages between all chains of the local network
+ * Bridges ERC20 tokens from the C-Chain to Subnet A and back, delivered by the relayer
+ * Bridges C-Chain native tokens to Subnet A and back, delivered by the relayer
+ * Bridges ERC20 tokens from Subnet A to Subnet B through the C-Chain, delivered by the relayer
+ * Stops the relayer
+ */
+func BridgeRelayerDelivery(network interfaces.LocalNetwork) {
+	ctx := context.Background()
+
+	relayer := utils.StartBridgeRelayer(ctx, network)
+	defer relayer.Stop(ctx)
+
+	// Messages relayed by the flows, including registration messages, are awaited from the relayer
+	relayerNetwork := utils.NewRelayerNetwork(network, relayer)
+
+	ERC20SourceERC20Destination(relayerNetwork)
+	NativeSourceERC20Destination(relayerNetwork)
+	ERC20SourceERC20DestinationMultiHop(relayerNetwork)
+}
@@ -0,0 +1,356 @@
+package flows
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+// Chosen so that the reward is rarely a whole percentage of the burned fees, exercising the rounding
+var burnedFeesReportingRewardPercentageForReport = big.NewInt(33)
+
+/**
+ * Deploy a native token source on the primary network
+ * Deploys a native token destination to Subnet A, with a nonzero burned fees reporting reward percentage
+ * Bridges enough C-Chain native tokens to Subnet A to cover the fees burned on Subnet A
+ * Report the burned transaction fees on Subnet A twice, the second time reporting only the small amount
+ * burned by the first report, and check that the reward is exactly the configured percentage rounded down,
+ * with the remainder reported as burned
+ * Relay the reports to the C-Chain from a relayer account, and check that the source burns the reported
+ * amounts, rounded down by the token scaling
+ * Check that the relayer that delivered the reports is rewarded exactly the reward amounts, which it
+ * redeems on Subnet A as the wrapped native token.
+ * Deploys a native token destination to Subnet B with a large decimals shift, and check that a report
+ * of a burned amount that scales to zero on the source reverts
+ */
+func BurnedFeeReportingReward(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	teleporterAddress := network.GetTeleporterContractAddress()
+
+	ctx := context.Background()
+
+	// Deploy an example WAVAX on the primary network
+	cChainWAVAXAddress, wavax := utils.DeployExampleWAVAX(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	// Create a NativeTokenSource on the primary network
+	nativeTokenSourceAddress, nativeTokenSource := utils.DeployNativeTokenSource(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		cChainWAVAXAddress,
+	)
+
+	// Deploy a NativeTokenDestination to Subnet A. It must be able to mint native tokens for the reward.
+	nativeTokenDestinationAddress, nativeTokenDestination := utils.DeployNativeTokenDestination(
+		ctx,
+		subnetAInfo,
+		"SUBA",
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		nativeTokenSourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentageForReport,
+	)
+	rewardPercentage, err := nativeTokenDestination.BurnedFeesReportingRewardPercentage(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(rewardPercentage, burnedFeesReportingRewardPercentageForReport)
+
+	// Register the NativeTokenDestination on the NativeTokenSource
+	collateralAmount := utils.RegisterTokenDestinationOnSource(
+		ctx,
+		network,
+		cChainInfo,
+		nativeTokenSourceAddress,
+		subnetAInfo,
+		nativeTokenDestinationAddress,
+		initialReserveImbalance,
+		tokenMultiplier,
+		multiplyOnDestination,
+	)
+
+	utils.AddCollateralToNativeTokenSource(
+		ctx,
+		cChainInfo,
+		nativeTokenSource,
+		nativeTokenSourceAddress,
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+		collateralAmount,
+		fundedKey,
+	)
+
+	// The reported fees are deducted from the balance bridged to Subnet A, and the first report includes all of
+	// the fees burned on Subnet A so far. Bridge enough tokens to cover them, with a margin for further fees.
+	burnedTxFeesAddress, err := nativeTokenDestination.BURNEDTXFEESADDRESS(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	burnedTxFees, err := subnetAInfo.RPCClient.BalanceAt(ctx, burnedTxFeesAddress, nil)
+	Expect(err).Should(BeNil())
+	amount := new(big.Int).Add(
+		utils.RemoveTokenScaling(tokenMultiplier, multiplyOnDestination, burnedTxFees),
+		new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10)),
+	)
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	input := nativetokensource.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: nativeTokenDestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   cChainWAVAXAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+	}
+	receipt, bridgedAmount := utils.SendNativeTokenSource(
+		ctx,
+		cChainInfo,
+		nativeTokenSource,
+		nativeTokenSourceAddress,
+		wavax,
+		input,
+		amount,
+		fundedKey,
+	)
+	network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	teleporterUtils.CheckBalance(ctx, recipientAddress, bridgedAmount, subnetAInfo.RPCClient)
+
+	// Generate a relayer account, with gas tokens to deliver messages on both chains
+	relayerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	relayerAddress := crypto.PubkeyToAddress(relayerKey.PublicKey)
+	for _, subnet := range []interfaces.SubnetTestInfo{cChainInfo, subnetAInfo} {
+		teleporterUtils.SendNativeTransfer(ctx, subnet, fundedKey, relayerAddress, big.NewInt(1e18))
+	}
+
+	teleporterBalanceBefore, err := nativeTokenDestination.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+
+	// Report all of the fees burned so far, followed immediately by a report of the small amount
+	// of fees burned by the first report
+	firstReceipt, firstReward, firstFeesBurned := reportBurnedTxFeesAndCheckReward(
+		ctx,
+		subnetAInfo,
+		nativeTokenDestination,
+		fundedKey,
+	)
+	secondReceipt, secondReward, secondFeesBurned := reportBurnedTxFeesAndCheckReward(
+		ctx,
+		subnetAInfo,
+		nativeTokenDestination,
+		fundedKey,
+	)
+	Expect(secondFeesBurned.Cmp(firstFeesBurned)).Should(BeNumerically("<", 0))
+	totalReward := new(big.Int).Add(firstReward, secondReward)
+
+	// The rewards are minted and held by Teleporter as the fees of the report messages
+	teleporterBalance, err := nativeTokenDestination.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(teleporterBalance, new(big.Int).Add(teleporterBalanceBefore, totalReward))
+
+	// Relay the reports to the C-Chain, where the reported amounts are burned
+	sourceChainBurnAddress, err := nativeTokenDestination.SOURCECHAINBURNADDRESS(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	var messageIDs [][32]byte
+	for _, report := range []struct {
+		receipt    *types.Receipt
+		feesBurned *big.Int
+	}{
+		{receipt: firstReceipt, feesBurned: firstFeesBurned},
+		{receipt: secondReceipt, feesBurned: secondFeesBurned},
+	} {
+		sendEvent, err := teleporterUtils.GetEventFromLogs(
+			report.receipt.Logs,
+			subnetAInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+		)
+		Expect(err).Should(BeNil())
+		messageIDs = append(messageIDs, sendEvent.MessageID)
+
+		burnBalanceBefore, err := cChainInfo.RPCClient.BalanceAt(ctx, sourceChainBurnAddress, nil)
+		Expect(err).Should(BeNil())
+		bridgedBalanceBefore, err := nativeTokenSource.BridgedBalances(
+			&bind.CallOpts{Context: ctx},
+			subnetAInfo.BlockchainID,
+			nativeTokenDestinationAddress,
+		)
+		Expect(err).Should(BeNil())
+
+		receipt := utils.RelayMessageWithKey(ctx, network, report.receipt, subnetAInfo, cChainInfo, relayerKey, true)
+
+		// Any remainder of the reported amount below the token scaling is not burned on the source
+		sourceAmount := utils.RemoveTokenScaling(tokenMultiplier, multiplyOnDestination, report.feesBurned)
+		utils.CheckNativeTokenSourceWithdrawal(
+			ctx,
+			nativeTokenSourceAddress,
+			wavax,
+			receipt,
+			sourceAmount,
+		)
+		burnBalance, err := cChainInfo.RPCClient.BalanceAt(ctx, sourceChainBurnAddress, nil)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(burnBalance, new(big.Int).Add(burnBalanceBefore, sourceAmount))
+		bridgedBalance, err := nativeTokenSource.BridgedBalances(
+			&bind.CallOpts{Context: ctx},
+			subnetAInfo.BlockchainID,
+			nativeTokenDestinationAddress,
+		)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(bridgedBalance, teleporterUtils.BigIntSub(bridgedBalanceBefore, report.feesBurned))
+	}
+
+	// Rewards are not allocated until the receipts of the reports are delivered back to Subnet A
+	checkRelayerReward(ctx, subnetAInfo, relayerAddress, nativeTokenDestinationAddress, big.NewInt(0))
+
+	receipt, _ = teleporterUtils.SendSpecifiedReceiptsAndWaitForAcceptance(
+		ctx,
+		subnetAInfo.BlockchainID,
+		cChainInfo,
+		messageIDs,
+		teleportermessenger.TeleporterFeeInfo{
+			FeeTokenAddress: common.Address{},
+			Amount:          big.NewInt(0),
+		},
+		[]common.Address{},
+		fundedKey,
+	)
+	receipt = utils.RelayMessageWithKey(ctx, network, receipt, cChainInfo, subnetAInfo, relayerKey, true)
+	for _, messageID := range messageIDs {
+		Expect(teleporterUtils.CheckReceiptReceived(receipt, messageID, subnetAInfo.TeleporterMessenger)).Should(BeTrue())
+	}
+
+	// Only the relayer that delivered the reports is allocated the rewards
+	checkRelayerReward(ctx, subnetAInfo, relayerAddress, nativeTokenDestinationAddress, totalReward)
+	checkRelayerReward(ctx, subnetAInfo, fundedAddress, nativeTokenDestinationAddress, big.NewInt(0))
+
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		subnetAInfo,
+		relayerKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return subnetAInfo.TeleporterMessenger.RedeemRelayerRewards(opts, nativeTokenDestinationAddress)
+		},
+	)
+	relayerBalance, err := nativeTokenDestination.BalanceOf(&bind.CallOpts{Context: ctx}, relayerAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(relayerBalance, totalReward)
+	checkRelayerReward(ctx, subnetAInfo, relayerAddress, nativeTokenDestinationAddress, big.NewInt(0))
+
+	// Deploy a NativeTokenDestination to Subnet B that scales amounts down by 18 decimals on the source.
+	// It reports without a reward, so it does not mint, and does not need one of the Native Minter deployer keys.
+	const largeDecimalsShift = uint8(18)
+	nativeTokenDestinationAddressB, nativeTokenDestinationB := utils.DeployNativeTokenDestinationWithKey(
+		ctx,
+		fundedKey,
+		subnetBInfo,
+		"SUBB",
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		nativeTokenSourceAddress,
+		initialReserveImbalance,
+		largeDecimalsShift,
+		multiplyOnDestination,
+		big.NewInt(0),
+	)
+
+	// Burn a whole source token's worth of fees, so that the first report scales to a nonzero amount.
+	// The report is not delivered, since the destination is not registered on the source.
+	teleporterUtils.SendNativeTransfer(
+		ctx,
+		subnetBInfo,
+		fundedKey,
+		burnedTxFeesAddress,
+		utils.GetTokenMultiplier(largeDecimalsShift),
+	)
+	reportBurnedTxFeesAndCheckReward(ctx, subnetBInfo, nativeTokenDestinationB, fundedKey)
+
+	// The fees burned by the first report are less than a whole source token, so scale to zero
+	lastReported, err := nativeTokenDestinationB.LastestBurnedFeesReported(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	_, err = nativeTokenDestinationB.ReportBurnedTxFees(
+		utils.NewTransactor(ctx, subnetBInfo, fundedKey),
+		utils.DefaultNativeTokenRequiredGas,
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrZeroScaledAmountToReportBurn)))
+	newLastReported, err := nativeTokenDestinationB.LastestBurnedFeesReported(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(newLastReported, lastReported)
+
+	bridgedBalance, err := nativeTokenSource.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetBInfo.BlockchainID,
+		nativeTokenDestinationAddressB,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, big.NewInt(0))
+}
+
+// Reports the burned transaction fees of the NativeTokenDestination, and checks that the newly burned fees
+// are split between the reward and the reported amount, with the reward being exactly the configured
+// percentage rounded down, and paid as the fee of the report message. Returns the receipt of the report,
+// the reward, and the amount of fees reported as burned.
+func reportBurnedTxFeesAndCheckReward(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	nativeTokenDestination *nativetokendestination.NativeTokenDestination,
+	senderKey *ecdsa.PrivateKey,
+) (*types.Receipt, *big.Int, *big.Int) {
+	lastReported, err := nativeTokenDestination.LastestBurnedFeesReported(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	rewardPercentage, err := nativeTokenDestination.BurnedFeesReportingRewardPercentage(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	receipt := utils.TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return nativeTokenDestination.ReportBurnedTxFees(opts, utils.DefaultNativeTokenRequiredGas)
+		},
+	)
+	reportEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenDestination.ParseReportBurnedTxFees)
+	Expect(err).Should(BeNil())
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		subnet.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	Expect(reportEvent.TeleporterMessageID).Should(Equal(sendEvent.MessageID))
+	reward := sendEvent.FeeInfo.Amount
+
+	// The newly burned fees are fully accounted for between the reward and the reported amount
+	reported, err := nativeTokenDestination.LastestBurnedFeesReported(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	burnedDifference := teleporterUtils.BigIntSub(reported, lastReported)
+	teleporterUtils.ExpectBigEqual(burnedDifference, new(big.Int).Add(reward, reportEvent.FeesBurned))
+
+	// The reward is rounded down, so any remainder is reported as burned
+	expectedReward := new(big.Int).Mul(burnedDifference, rewardPercentage)
+	expectedReward.Div(expectedReward, big.NewInt(100))
+	teleporterUtils.ExpectBigEqual(reward, expectedReward)
+	Expect(sendEvent.FeeInfo.FeeTokenAddress).Should(Equal(sendEvent.Message.OriginSenderAddress))
+
+	return receipt, reward, reportEvent.FeesBurned
+}
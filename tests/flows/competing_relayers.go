@@ -0,0 +1,135 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Bridges C-Chain example ERC20 tokens to Subnet A
+ * Delivers the message to Subnet A from two relayers at once
+ * Check that exactly one delivery succeeds, and the other reverts without side effects
+ * Check that the tokens are only minted once, and the winning relayer is allocated the reward
+ */
+func CompetingRelayers(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	// Create an ERC20Source for bridging the source token
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC20Destination to Subnet A, and register it with the ERC20Source
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	// Generate two competing relayers, with gas tokens to deliver messages on Subnet A
+	relayerKeys := utils.GenerateFundedAccounts(ctx, subnetAInfo, fundedKey, 2, big.NewInt(1e18))
+
+	// Generate new recipient to receive bridged tokens
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// Send tokens from C-Chain to recipient on subnet A
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(1e18),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+
+	receipts := utils.DeliverMessageConcurrently(ctx, network, receipt, cChainInfo, subnetAInfo, relayerKeys)
+
+	// Exactly one of the deliveries succeeds
+	winner := -1
+	for i, receipt := range receipts {
+		if receipt.Status == types.ReceiptStatusSuccessful {
+			Expect(winner).Should(Equal(-1), "message delivered more than once")
+			winner = i
+			continue
+		}
+		// Reverted deliveries emit no events, so neither deliver the message nor mint tokens
+		Expect(receipt.Status).Should(Equal(types.ReceiptStatusFailed))
+		Expect(receipt.Logs).Should(BeEmpty())
+	}
+	Expect(winner).ShouldNot(Equal(-1), "message was not delivered")
+
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipts[winner],
+		recipientAddress,
+		bridgedAmount,
+	)
+
+	// Check that the tokens were only minted once
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, bridgedAmount)
+	totalSupply, err := erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(totalSupply, bridgedAmount)
+
+	// The winning relayer is allocated the reward of the message
+	delivered, err := subnetAInfo.TeleporterMessenger.MessageReceived(&bind.CallOpts{Context: ctx}, sendEvent.MessageID)
+	Expect(err).Should(BeNil())
+	Expect(delivered).Should(BeTrue())
+	rewardAddress, err := subnetAInfo.TeleporterMessenger.GetRelayerRewardAddress(
+		&bind.CallOpts{Context: ctx},
+		sendEvent.MessageID,
+	)
+	Expect(err).Should(BeNil())
+	Expect(rewardAddress).Should(Equal(crypto.PubkeyToAddress(relayerKeys[winner].PublicKey)))
+}
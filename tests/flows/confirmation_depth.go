@@ -0,0 +1,123 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Sends tokens from the C-Chain, and checks that the send is not final, nor processed by a poller, on a chain
+ * configured with a confirmation depth deeper than the chain
+ * Builds the confirmation depth of blocks on the send, and checks that the send is then final through the SDK and
+ * processed by a poller of the chain with the depth
+ * Other specs may build blocks concurrently, so only a depth that can't yet be reached is expected to leave the
+ * send unfinal. Reorgs can't happen on the local network, and are covered by the unit tests instead.
+ */
+func ConfirmationDepth(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, _ := s.Destination(subnetAInfo)
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	receipt, _ := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                crypto.PubkeyToAddress(recipientKey.PublicKey),
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		big.NewInt(1e18),
+		fundedKey,
+	)
+
+	newChain := func(confirmationDepth uint64) *events.Chain {
+		return &events.Chain{
+			Name:              "C-Chain",
+			BlockchainID:      cChainInfo.BlockchainID,
+			Client:            cChainInfo.RPCClient,
+			StartBlock:        receipt.BlockNumber.Uint64(),
+			ConfirmationDepth: confirmationDepth,
+		}
+	}
+	const depth = 3
+	deepChain, chain := newChain(receipt.BlockNumber.Uint64()+1_000_000), newChain(depth)
+
+	// The send is not final on a chain whose confirmation depth can't yet be reached, and is not processed
+	final, err := bridge.IsFinal(ctx, deepChain, receipt)
+	Expect(err).Should(BeNil())
+	Expect(final).Should(BeFalse())
+	Expect(pollSends(ctx, deepChain, erc20SourceAddress)).Should(BeEmpty())
+
+	// Once the depth of blocks is built on the send, it is final and processed
+	utils.AdvanceBlocks(ctx, cChainInfo, fundedKey, depth)
+	final, err = bridge.IsFinal(ctx, chain, receipt)
+	Expect(err).Should(BeNil())
+	Expect(final).Should(BeTrue())
+	finalReceipt, err := bridge.WaitForFinality(ctx, chain, receipt.TxHash)
+	Expect(err).Should(BeNil())
+	Expect(finalReceipt.BlockHash).Should(Equal(receipt.BlockHash))
+	sends := pollSends(ctx, chain, erc20SourceAddress)
+	Expect(sends).Should(HaveLen(1))
+	Expect(sends[0].TxHash).Should(Equal(receipt.TxHash))
+}
+
+// Polls the chain from its start block up to its final block, and returns the sends of the source processed
+func pollSends(ctx context.Context, chain *events.Chain, sourceAddress common.Address) []*events.Event {
+	decoder, err := events.NewDecoder()
+	Expect(err).Should(BeNil())
+	var sends []*events.Event
+	poller := events.NewPoller(
+		logging.NoLog{},
+		chain,
+		decoder,
+		map[common.Address]events.ContractInfo{sourceAddress: {Bridge: "bridge", Type: events.ERC20Source}},
+		events.PollerConfig{},
+		func(_ context.Context, bridgeEvents []*events.Event, _ uint64) error {
+			for _, event := range bridgeEvents {
+				if event.Type == events.TokensSent {
+					sends = append(sends, event)
+				}
+			}
+			return nil
+		},
+	)
+	for {
+		caughtUp, err := poller.Poll(ctx)
+		Expect(err).Should(BeNil())
+		if caughtUp {
+			return sends
+		}
+	}
+}
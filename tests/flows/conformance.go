@@ -0,0 +1,103 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/teleporter-token-bridge/conformance"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A and Subnet B, and registers only the destination on Subnet A
+ * Bridges tokens from the C-Chain to Subnet A
+ * Describes the deployment in a conformance manifest, as a bridge to Subnet A and a bridge to Subnet B
+ * Runs the conformance checks, and checks that the bridge to Subnet A passes every check, and that the bridge
+ * to Subnet B fails the registration check
+ */
+func Conformance(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	ctx := context.Background()
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		DeployERC20Destination(subnetBInfo).
+		Register(subnetAInfo).
+		Send(cChainInfo, subnetAInfo, recipientAddress, big.NewInt(1e18))
+	erc20SourceAddress, _, _, _ := s.Source()
+	subnetADestinationAddress, _ := s.Destination(subnetAInfo)
+	subnetBDestinationAddress, _ := s.Destination(subnetBInfo)
+
+	source := events.ContractConfig{
+		Chain:   "c-chain",
+		Address: erc20SourceAddress.Hex(),
+		Type:    events.ERC20Source,
+	}
+	manifest := &conformance.Manifest{
+		Chains: []events.ChainConfig{
+			conformanceChainConfig("c-chain", cChainInfo),
+			conformanceChainConfig("subnet-a", subnetAInfo),
+			conformanceChainConfig("subnet-b", subnetBInfo),
+		},
+		Bridges: []events.BridgeConfig{
+			{
+				Name:   "registered",
+				Source: source,
+				Destinations: []events.ContractConfig{{
+					Chain:   "subnet-a",
+					Address: subnetADestinationAddress.Hex(),
+					Type:    events.ERC20Destination,
+				}},
+			},
+			{
+				Name:   "unregistered",
+				Source: source,
+				Destinations: []events.ContractConfig{{
+					Chain:   "subnet-b",
+					Address: subnetBDestinationAddress.Hex(),
+					Type:    events.ERC20Destination,
+				}},
+			},
+		},
+	}
+	Expect(manifest.Validate()).Should(Succeed())
+
+	deployment, err := conformance.NewDeployment(ctx, manifest)
+	Expect(err).Should(BeNil())
+	results := deployment.Run(ctx)
+	Expect(results).Should(HaveLen(len(manifest.Bridges) * len(conformance.Checks)))
+
+	var unregistered *conformance.Result
+	for i, result := range results {
+		switch result.Bridge {
+		case "registered":
+			Expect(result.Err).Should(BeNil(), "check %s", result.Check)
+		case "unregistered":
+			if result.Check == "registration" {
+				unregistered = &results[i]
+			}
+		}
+	}
+	Expect(unregistered).ShouldNot(BeNil())
+	Expect(unregistered.Err).Should(MatchError(ContainSubstring("not registered with the source")))
+}
+
+func conformanceChainConfig(name string, subnet interfaces.SubnetTestInfo) events.ChainConfig {
+	return events.ChainConfig{
+		Name:         name,
+		BlockchainID: subnet.BlockchainID.String(),
+		RPCEndpoint:  teleporterUtils.HttpToRPCURI(subnet.NodeURIs[0], subnet.BlockchainID.String()),
+	}
+}
@@ -0,0 +1,119 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/teleporter-token-bridge/conformance"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A and Subnet B with CREATE2 from the same salt, and checks that both
+ * destinations are at the same address
+ * Registers both destinations, and bridges tokens from the C-Chain to each of them
+ * Deploys another ERC20Destination to Subnet B without CREATE2
+ * Describes the deployment in a conformance manifest, with address parity set for a bridge to the CREATE2
+ * destinations and for a bridge to the Subnet A destination and the other Subnet B destination
+ * Runs the address parity check, and checks that it passes for the CREATE2 destinations, and fails for the other
+ * Subnet B destination
+ */
+func Create2AddressParity(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+
+	// The TeleporterRegistry is a constructor argument of the destinations, so must have the same address on both
+	// Subnets for the destinations to have the same init code
+	Expect(subnetAInfo.TeleporterRegistryAddress).Should(Equal(subnetBInfo.TeleporterRegistryAddress))
+
+	recipientAddress := crypto.PubkeyToAddress(utils.NewAccountKey().PublicKey)
+	amount := big.NewInt(1e18)
+	salt := utils.Create2Salt("create2-address-parity")
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20DestinationCreate2(subnetAInfo, salt).
+		DeployERC20DestinationCreate2(subnetBInfo, salt).
+		Register(subnetAInfo).
+		Register(subnetBInfo).
+		Send(cChainInfo, subnetAInfo, recipientAddress, amount).
+		ExpectBalance(subnetAInfo, recipientAddress, amount).
+		Send(cChainInfo, subnetBInfo, recipientAddress, amount).
+		ExpectBalance(subnetBInfo, recipientAddress, amount)
+	erc20SourceAddress, _, _, _ := s.Source()
+	subnetADestinationAddress, _ := s.Destination(subnetAInfo)
+	subnetBDestinationAddress, _ := s.Destination(subnetBInfo)
+	Expect(subnetADestinationAddress).Should(Equal(subnetBDestinationAddress))
+
+	otherDestinationAddress, _, _ := utils.DeployERC20DestinationForSource(
+		ctx,
+		fundedKey,
+		subnetBInfo,
+		fundedAddress,
+		cChainInfo,
+		erc20SourceAddress,
+		utils.ERC20DestinationMetadata{},
+	)
+	Expect(otherDestinationAddress).ShouldNot(Equal(subnetADestinationAddress))
+
+	source := events.ContractConfig{
+		Chain:   "c-chain",
+		Address: erc20SourceAddress.Hex(),
+		Type:    events.ERC20Source,
+	}
+	subnetADestination := events.ContractConfig{
+		Chain:   "subnet-a",
+		Address: subnetADestinationAddress.Hex(),
+		Type:    events.ERC20Destination,
+	}
+	manifest := &conformance.Manifest{
+		Chains: []events.ChainConfig{
+			conformanceChainConfig("c-chain", cChainInfo),
+			conformanceChainConfig("subnet-a", subnetAInfo),
+			conformanceChainConfig("subnet-b", subnetBInfo),
+		},
+		Bridges: []events.BridgeConfig{
+			{
+				Name:   "create2",
+				Source: source,
+				Destinations: []events.ContractConfig{
+					subnetADestination,
+					{Chain: "subnet-b", Address: subnetBDestinationAddress.Hex(), Type: events.ERC20Destination},
+				},
+			},
+			{
+				Name:   "mismatched",
+				Source: source,
+				Destinations: []events.ContractConfig{
+					subnetADestination,
+					{Chain: "subnet-b", Address: otherDestinationAddress.Hex(), Type: events.ERC20Destination},
+				},
+			},
+		},
+		AddressParity: []string{"create2", "mismatched"},
+	}
+	Expect(manifest.Validate()).Should(Succeed())
+
+	deployment, err := conformance.NewDeployment(ctx, manifest)
+	Expect(err).Should(BeNil())
+	var parityCheck *conformance.Check
+	for i, check := range conformance.Checks {
+		if check.Name == "address-parity" {
+			parityCheck = &conformance.Checks[i]
+		}
+	}
+	Expect(parityCheck).ShouldNot(BeNil())
+	Expect(deployment.RunCheck(ctx, *parityCheck, manifest.Bridges[0])).Should(Succeed())
+	Expect(deployment.RunCheck(ctx, *parityCheck, manifest.Bridges[1])).Should(
+		MatchError(ContainSubstring("expected " + subnetADestinationAddress.String())),
+	)
+}
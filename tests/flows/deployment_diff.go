@@ -0,0 +1,175 @@
+package flows
+
+import (
+	"context"
+
+	"github.com/ava-labs/teleporter-token-bridge/deployment"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A and Subnet B, and registers both with the source
+ * Records a manifest of the deployment's code hashes, owners and multipliers, and check that it matches
+ * Transfers the ownership of the Subnet A destination, and check that the new owner is reported
+ * Check that a wrong token multiplier is reported against both the destination and its registration
+ * Check that the Subnet B destination is reported as registered once it is removed from the manifest
+ * Check that an address without code is reported, and that it is not reported as unregistered
+ */
+func DeploymentDiff(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		DeployERC20Destination(subnetBInfo).
+		Register(subnetAInfo).
+		Register(subnetBInfo)
+	erc20SourceAddress, _, _, _ := s.Source()
+	subnetADestinationAddress, subnetADestination := s.Destination(subnetAInfo)
+	subnetBDestinationAddress, _ := s.Destination(subnetBInfo)
+
+	manifest := &deployment.Manifest{
+		Chains: []events.ChainConfig{
+			diffChainConfig("c-chain", cChainInfo),
+			diffChainConfig("subnet-a", subnetAInfo),
+			diffChainConfig("subnet-b", subnetBInfo),
+		},
+		Bridges: []deployment.BridgeManifest{{
+			Name: "erc20",
+			Source: diffContractManifest(
+				ctx,
+				cChainInfo,
+				"c-chain",
+				erc20SourceAddress,
+				events.ERC20Source,
+				fundedAddress,
+			),
+			Destinations: []deployment.DestinationManifest{
+				diffDestinationManifest(ctx, subnetAInfo, "subnet-a", subnetADestinationAddress, fundedAddress),
+				diffDestinationManifest(ctx, subnetBInfo, "subnet-b", subnetBDestinationAddress, fundedAddress),
+			},
+		}},
+	}
+	chains, err := events.ConnectChains(ctx, manifest.Chains)
+	Expect(err).Should(BeNil())
+	diff := func() []deployment.Difference {
+		Expect(manifest.Validate()).Should(Succeed())
+		differences, err := deployment.Diff(ctx, chains, manifest)
+		Expect(err).Should(BeNil())
+		return differences
+	}
+	Expect(diff()).Should(BeEmpty())
+
+	// Transferring the ownership of a destination is drift from the manifest
+	newOwnerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	newOwnerAddress := crypto.PubkeyToAddress(newOwnerKey.PublicKey)
+	tx, err := subnetADestination.TransferOwnership(utils.NewTransactor(ctx, subnetAInfo, fundedKey), newOwnerAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.WaitForTransactionSuccess(ctx, subnetAInfo, tx.Hash())
+	Expect(diff()).Should(ConsistOf(deployment.Difference{
+		Bridge:   "erc20",
+		Chain:    "subnet-a",
+		Contract: subnetADestinationAddress,
+		Field:    deployment.Owner,
+		Expected: fundedAddress.Hex(),
+		Actual:   newOwnerAddress.Hex(),
+	}))
+	manifest.Bridges[0].Destinations[0].Owner = newOwnerAddress.Hex()
+
+	// A wrong multiplier differs from both the destination's constructor arguments and its registration
+	manifest.Bridges[0].Destinations[0].TokenMultiplier = "10"
+	Expect(diff()).Should(ConsistOf(
+		And(HaveField("Field", deployment.TokenMultiplier), HaveField("Expected", "10"), HaveField("Actual", "1")),
+		And(
+			HaveField("Field", deployment.RegisteredTokenMultiplier),
+			HaveField("Expected", "10"),
+			HaveField("Actual", "1"),
+		),
+	))
+	manifest.Bridges[0].Destinations[0].TokenMultiplier = "1"
+
+	// A registered destination missing from the manifest is reported
+	subnetBManifest := manifest.Bridges[0].Destinations[1]
+	manifest.Bridges[0].Destinations = manifest.Bridges[0].Destinations[:1]
+	Expect(diff()).Should(ConsistOf(deployment.Difference{
+		Bridge:   "erc20",
+		Chain:    "subnet-b",
+		Contract: subnetBDestinationAddress,
+		Field:    deployment.Registration,
+		Expected: "unregistered",
+		Actual:   "registered",
+	}))
+
+	// A destination without code is only reported as missing its code
+	missingAddress := common.HexToAddress("0x000000000000000000000000000000000000dEaD")
+	subnetBManifest.Address = missingAddress.Hex()
+	manifest.Bridges[0].Destinations = append(manifest.Bridges[0].Destinations, subnetBManifest)
+	Expect(diff()).Should(ConsistOf(
+		And(
+			HaveField("Contract", missingAddress),
+			HaveField("Field", deployment.CodeHash),
+			HaveField("Actual", "none"),
+		),
+		And(HaveField("Contract", subnetBDestinationAddress), HaveField("Field", deployment.Registration)),
+	))
+}
+
+func diffChainConfig(name string, subnet interfaces.SubnetTestInfo) events.ChainConfig {
+	return events.ChainConfig{
+		Name:         name,
+		BlockchainID: subnet.BlockchainID.String(),
+		RPCEndpoint:  teleporterUtils.HttpToRPCURI(subnet.NodeURIs[0], subnet.BlockchainID.String()),
+	}
+}
+
+// Records the code hash of the contract as deployed, along with its expected owner
+func diffContractManifest(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	chain string,
+	address common.Address,
+	contractType events.ContractType,
+	owner common.Address,
+) deployment.ContractManifest {
+	code, err := subnet.RPCClient.CodeAt(ctx, address, nil)
+	Expect(err).Should(BeNil())
+	Expect(code).ShouldNot(BeEmpty())
+	return deployment.ContractManifest{
+		ContractConfig: events.ContractConfig{
+			Chain:   chain,
+			Address: address.Hex(),
+			Type:    contractType,
+		},
+		CodeHash: crypto.Keccak256Hash(code).Hex(),
+		Owner:    owner.Hex(),
+	}
+}
+
+// ERC20Destinations don't scale amounts, so have a multiplier of one
+func diffDestinationManifest(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	chain string,
+	address common.Address,
+	owner common.Address,
+) deployment.DestinationManifest {
+	multiplyOnDestination := false
+	return deployment.DestinationManifest{
+		ContractManifest:      diffContractManifest(ctx, subnet, chain, address, events.ERC20Destination, owner),
+		TokenMultiplier:       "1",
+		MultiplyOnDestination: &multiplyOnDestination,
+	}
+}
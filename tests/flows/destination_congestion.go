@@ -0,0 +1,186 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// The number of accounts sending transactions that congest the destination
+	congestionSenders = 4
+	// The number of transfers in flight while the destination is congested
+	congestedTransfers = 3
+	// The number of times each delivery may be replaced with bumped fees while the destination is congested
+	congestedDeliveryReplacements = 8
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Congests Subnet A with transactions that burn most of the gas of each block at a high priority fee, and waits
+ * for its base fee to rise
+ * Sends several transfers to Subnet A while it is congested, and checks that each is delivered within a bounded
+ * number of fee replacements
+ * Sends a sendAndCall whose required gas limit does not cover the call, and checks that its delivery succeeds but
+ * the message execution fails, leaving the tokens unminted
+ * Retries the message execution with enough gas, and checks that the recipient contract is called
+ */
+func DestinationCongestion(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+	receiverAddress, receiver := utils.DeployMockERC20SendAndCallReceiver(ctx, fundedKey, subnetAInfo)
+
+	// Replace stalled transactions on Subnet A sooner and more often than by default, bounding how long each
+	// delivery may take while Subnet A is congested
+	feeConfig := utils.GetTransactionFeeConfig(subnetAInfo.EVMChainID)
+	defer utils.SetTransactionFeeConfig(subnetAInfo.EVMChainID, feeConfig)
+	congestedFeeConfig := feeConfig
+	congestedFeeConfig.MaxReplacements = congestedDeliveryReplacements
+	congestedFeeConfig.ReplacementTimeout = 5 * time.Second
+	utils.SetTransactionFeeConfig(subnetAInfo.EVMChainID, congestedFeeConfig)
+
+	initialBaseFee, err := subnetAInfo.RPCClient.EstimateBaseFee(ctx)
+	Expect(err).Should(BeNil())
+
+	senderKeys := utils.GenerateFundedAccounts(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		congestionSenders,
+		new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100)),
+	)
+	stopCongestion := utils.CongestChain(ctx, subnetAInfo, fundedKey, senderKeys)
+	defer stopCongestion()
+
+	utils.WaitForBaseFeeAbove(ctx, subnetAInfo, initialBaseFee, 2*time.Minute)
+
+	// Send the transfers before relaying any of them, so that they are all in flight at once
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := big.NewInt(1e18)
+
+	sendReceipts := make([]*types.Receipt, congestedTransfers)
+	sentAmounts := make([]*big.Int, congestedTransfers)
+	for i := range sendReceipts {
+		sendReceipts[i], sentAmounts[i] = utils.SendERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			erc20source.SendTokensInput{
+				DestinationBlockchainID:  subnetAInfo.BlockchainID,
+				DestinationBridgeAddress: erc20DestinationAddress,
+				Recipient:                recipientAddress,
+				PrimaryFeeTokenAddress:   sourceTokenAddress,
+				PrimaryFee:               big.NewInt(0),
+				SecondaryFee:             big.NewInt(0),
+				RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+			},
+			amount,
+			fundedKey,
+		)
+	}
+
+	// Each delivery is replaced with bumped fees until it is mined, failing the spec if it is not mined within
+	// the configured number of replacements
+	totalSent := big.NewInt(0)
+	for i, sendReceipt := range sendReceipts {
+		receipt := network.RelayMessage(ctx, sendReceipt, cChainInfo, subnetAInfo, true)
+		utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, sentAmounts[i])
+		totalSent.Add(totalSent, sentAmounts[i])
+	}
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, totalSent)
+
+	// Send a sendAndCall whose required gas limit only just exceeds the gas given to the recipient contract. Not
+	// enough gas is left for ERC20Destination to make the call, so the message execution fails. The delivery
+	// itself still succeeds, since Teleporter stores the failed message to be retried, and the tokens are neither
+	// minted to the recipient contract nor sent to the fallback recipient.
+	recipientGasLimit := teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas)
+	sendReceipt, calledAmount := utils.SendAndCallERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendAndCallInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			RecipientContract:        receiverAddress,
+			RecipientPayload:         []byte{1},
+			RequiredGasLimit:         new(big.Int).Add(recipientGasLimit, big.NewInt(10_000)),
+			RecipientGasLimit:        recipientGasLimit,
+			FallbackRecipient:        recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+		},
+		amount,
+		fundedKey,
+	)
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		sendReceipt.Logs,
+		cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+
+	receipt := network.RelayMessage(ctx, sendReceipt, cChainInfo, subnetAInfo, true)
+	failedEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		subnetAInfo.TeleporterMessenger.ParseMessageExecutionFailed,
+	)
+	Expect(err).Should(BeNil())
+	Expect(failedEvent.MessageID).Should(Equal(sendEvent.MessageID))
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallSucceeded)
+	Expect(err).ShouldNot(BeNil())
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallFailed)
+	Expect(err).ShouldNot(BeNil())
+	balance, err = erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, totalSent)
+
+	// Anyone can retry the execution with all of the gas of their transaction, which calls the recipient contract
+	receipt = utils.TransactAndWaitForSuccess(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return subnetAInfo.TeleporterMessenger.RetryMessageExecution(
+				opts,
+				cChainInfo.BlockchainID,
+				sendEvent.Message,
+			)
+		},
+	)
+	succeededEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallSucceeded)
+	Expect(err).Should(BeNil())
+	Expect(succeededEvent.RecipientContract).Should(Equal(receiverAddress))
+	teleporterUtils.ExpectBigEqual(succeededEvent.Amount, calledAmount)
+	receivedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, receiver.ParseTokensReceived)
+	Expect(err).Should(BeNil())
+	Expect(receivedEvent.Payload).Should(Equal([]byte{1}))
+}
@@ -0,0 +1,162 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A without specifying its metadata, and checks that its name, symbol,
+ * and decimals are populated from the source token
+ * Deploys ERC20Destination to Subnet B with a different name and symbol, and checks that only its decimals
+ * are populated from the source token
+ * Bridges C-Chain example ERC20 tokens to both destinations, and checks that the same amounts are received
+ */
+func DestinationTokenMetadata(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	sourceMetadata := utils.GetERC20SourceTokenMetadata(ctx, cChainInfo, erc20SourceAddress)
+	Expect(sourceMetadata.Name).Should(Equal(tokenName))
+	Expect(sourceMetadata.Symbol).Should(Equal(tokenSymbol))
+	Expect(*sourceMetadata.Decimals).Should(Equal(tokenDecimals))
+
+	// Deploy an ERC20Destination to Subnet A with all of its metadata populated from the source token
+	erc20DestinationAddressA, erc20DestinationA, metadataA := utils.DeployERC20DestinationForSource(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		fundedAddress,
+		cChainInfo,
+		erc20SourceAddress,
+		utils.ERC20DestinationMetadata{},
+	)
+	Expect(metadataA).Should(Equal(sourceMetadata))
+	checkERC20DestinationMetadata(ctx, erc20DestinationA, metadataA)
+
+	// Deploy an ERC20Destination to Subnet B with its name and symbol specified,
+	// so only its decimals are populated from the source token
+	erc20DestinationAddressB, erc20DestinationB, metadataB := utils.DeployERC20DestinationForSource(
+		ctx,
+		fundedKey,
+		subnetBInfo,
+		fundedAddress,
+		cChainInfo,
+		erc20SourceAddress,
+		utils.ERC20DestinationMetadata{
+			Name:   "Subnet B " + tokenName,
+			Symbol: tokenSymbol + ".b",
+		},
+	)
+	Expect(metadataB.Name).Should(Equal("Subnet B " + tokenName))
+	Expect(metadataB.Symbol).Should(Equal(tokenSymbol + ".b"))
+	Expect(*metadataB.Decimals).Should(Equal(tokenDecimals))
+	checkERC20DestinationMetadata(ctx, erc20DestinationB, metadataB)
+
+	// The metadata has no effect on bridging, so both destinations receive the same amounts
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	for _, destination := range []struct {
+		subnet           interfaces.SubnetTestInfo
+		address          common.Address
+		erc20Destination *erc20destination.ERC20Destination
+	}{
+		{subnet: subnetAInfo, address: erc20DestinationAddressA, erc20Destination: erc20DestinationA},
+		{subnet: subnetBInfo, address: erc20DestinationAddressB, erc20Destination: erc20DestinationB},
+	} {
+		utils.RegisterERC20DestinationOnSource(
+			ctx,
+			network,
+			cChainInfo,
+			erc20SourceAddress,
+			destination.subnet,
+			destination.address,
+		)
+
+		input := erc20source.SendTokensInput{
+			DestinationBlockchainID:  destination.subnet.BlockchainID,
+			DestinationBridgeAddress: destination.address,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(1e18),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		}
+		receipt, bridgedAmount := utils.SendERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			input,
+			amount,
+			fundedKey,
+		)
+		teleporterUtils.ExpectBigEqual(bridgedAmount, amount)
+
+		receipt = network.RelayMessage(ctx, receipt, cChainInfo, destination.subnet, true)
+		utils.CheckERC20DestinationWithdrawal(
+			ctx,
+			destination.erc20Destination,
+			receipt,
+			recipientAddress,
+			bridgedAmount,
+		)
+	}
+}
+
+// Checks that the token metadata of the ERC20Destination matches the metadata it was deployed with
+func checkERC20DestinationMetadata(
+	ctx context.Context,
+	erc20Destination *erc20destination.ERC20Destination,
+	metadata utils.ERC20DestinationMetadata,
+) {
+	name, err := erc20Destination.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(name).Should(Equal(metadata.Name))
+	symbol, err := erc20Destination.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(symbol).Should(Equal(metadata.Symbol))
+	decimals, err := erc20Destination.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(decimals).Should(Equal(*metadata.Decimals))
+}
@@ -0,0 +1,254 @@
+package flows
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	subnetevminterfaces "github.com/ava-labs/subnet-evm/interfaces"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+// receivingBridge is the receive path that every bridge contract inherits from TeleporterUpgradeable, which has
+// the same signature in each of the generated bindings.
+type receivingBridge interface {
+	ReceiveTeleporterMessage(
+		opts *bind.TransactOpts,
+		sourceBlockchainID [32]byte,
+		originSenderAddress common.Address,
+		message []byte,
+	) (*types.Transaction, error)
+	TeleporterRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+/**
+ * Deploy an ERC20Source and a NativeTokenSource on the primary network
+ * Deploys an ERC20Destination and a NativeTokenDestination to Subnet A
+ * For each bridge contract, and each type of bridge message, spoofing the bridge's counterpart as the sender:
+ *   Check that calling receiveTeleporterMessage directly from an EOA reverts
+ *   Check that calling receiveTeleporterMessage directly from a contract other than the TeleporterMessenger,
+ *   including the Teleporter registry and the other bridge on the same chain, reverts
+ * Check that unwrapping tokens from the NativeTokenDestination without a wrapped balance reverts
+ * Check that none of the calls minted or released tokens
+ */
+func DirectCallRejected(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy the source bridges on the primary network
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	cChainWAVAXAddress, _ := utils.DeployExampleWAVAX(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+	nativeTokenSourceAddress, nativeTokenSource := utils.DeployNativeTokenSource(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		cChainWAVAXAddress,
+	)
+
+	// Deploy the destination bridges to Subnet A
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		tokenName,
+		tokenSymbol,
+		tokenDecimals,
+	)
+
+	// The NativeTokenDestination never mints native tokens, so does not need one of the Native Minter deployer keys
+	nativeTokenDestinationAddress, nativeTokenDestination := utils.DeployNativeTokenDestinationWithKey(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		"SUBA",
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		nativeTokenSourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
+	)
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	bridgeMessages := directCallMessages(recipientAddress, erc20SourceAddress)
+
+	expectDirectCallsRejected(ctx, cChainInfo, erc20Source, erc20SourceAddress,
+		subnetAInfo.BlockchainID, erc20DestinationAddress, nativeTokenSourceAddress, bridgeMessages, fundedKey)
+	expectDirectCallsRejected(ctx, cChainInfo, nativeTokenSource, nativeTokenSourceAddress,
+		subnetAInfo.BlockchainID, nativeTokenDestinationAddress, erc20SourceAddress, bridgeMessages, fundedKey)
+	expectDirectCallsRejected(ctx, subnetAInfo, erc20Destination, erc20DestinationAddress,
+		cChainInfo.BlockchainID, erc20SourceAddress, nativeTokenDestinationAddress, bridgeMessages, fundedKey)
+	expectDirectCallsRejected(ctx, subnetAInfo, nativeTokenDestination, nativeTokenDestinationAddress,
+		cChainInfo.BlockchainID, nativeTokenSourceAddress, erc20DestinationAddress, bridgeMessages, fundedKey)
+
+	// Unwrapping tokens that were never minted to the caller reverts
+	_, err = nativeTokenDestination.Withdraw(utils.NewTransactor(ctx, subnetAInfo, fundedKey), big.NewInt(1e18))
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrBurnAmountExceedsBalance)))
+
+	// None of the calls minted or released tokens
+	totalSupply, err := erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(totalSupply.Uint64()).Should(BeZero())
+	totalMinted, err := nativeTokenDestination.TotalMinted(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(totalMinted.Uint64()).Should(BeZero())
+	bridgedBalance, err := erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	Expect(bridgedBalance.Uint64()).Should(BeZero())
+	bridgedBalance, err = nativeTokenSource.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	Expect(bridgedBalance.Uint64()).Should(BeZero())
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	Expect(balance.Uint64()).Should(BeZero())
+}
+
+// Returns a message of each bridge message type, sending tokens to the recipient
+func directCallMessages(recipient common.Address, originSender common.Address) [][]byte {
+	amount := big.NewInt(1e18)
+	var bridgeMessages [][]byte
+	for _, message := range []messages.Message{
+		messages.RegisterDestinationMessage{
+			InitialReserveImbalance: big.NewInt(0),
+			TokenMultiplier:         big.NewInt(1),
+			MultiplyOnDestination:   false,
+		},
+		messages.SingleHopSendMessage{
+			Recipient: recipient,
+			Amount:    amount,
+		},
+		messages.SingleHopCallMessage{
+			OriginSenderAddress: originSender,
+			RecipientContract:   recipient,
+			Amount:              amount,
+			RecipientPayload:    []byte{},
+			RecipientGasLimit:   big.NewInt(100_000),
+			FallbackRecipient:   recipient,
+		},
+		messages.MultiHopSendMessage{
+			Recipient:         recipient,
+			Amount:            amount,
+			SecondaryFee:      big.NewInt(0),
+			SecondaryGasLimit: big.NewInt(100_000),
+			MultiHopFallback:  recipient,
+		},
+		messages.MultiHopCallMessage{
+			OriginSenderAddress:       originSender,
+			RecipientContract:         recipient,
+			Amount:                    amount,
+			RecipientPayload:          []byte{},
+			RecipientGasLimit:         big.NewInt(100_000),
+			FallbackRecipient:         recipient,
+			SecondaryRequiredGasLimit: big.NewInt(100_000),
+			MultiHopFallback:          recipient,
+			SecondaryFee:              big.NewInt(0),
+		},
+	} {
+		bridgeMessage, err := messages.Encode(message)
+		Expect(err).Should(BeNil())
+		bridgeMessages = append(bridgeMessages, bridgeMessage)
+	}
+	return bridgeMessages
+}
+
+// Checks that each of the messages is rejected when delivered to the bridge directly, rather than through the
+// TeleporterMessenger, as if sent by the bridge's counterpart. Each message is delivered from senderKey, and
+// from the Teleporter registry and sibling contracts. Calls from an EOA are only estimated, since a reverting
+// call fails gas estimation before being sent, and calls from a contract are made with eth_call.
+func expectDirectCallsRejected(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	bridge receivingBridge,
+	bridgeAddress common.Address,
+	counterpartBlockchainID ids.ID,
+	counterpartAddress common.Address,
+	siblingAddress common.Address,
+	bridgeMessages [][]byte,
+	senderKey *ecdsa.PrivateKey,
+) {
+	teleporterRegistryAddress, err := bridge.TeleporterRegistry(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	bridgeABI, err := teleportertokensource.TeleporterTokenSourceMetaData.GetAbi()
+	Expect(err).Should(BeNil())
+
+	for _, bridgeMessage := range bridgeMessages {
+		_, err := bridge.ReceiveTeleporterMessage(
+			utils.NewTransactor(ctx, subnet, senderKey),
+			counterpartBlockchainID,
+			counterpartAddress,
+			bridgeMessage,
+		)
+		Expect(err).Should(MatchError(ContainSubstring(errors.ErrProtocolAddressNotFound)))
+
+		callData, err := bridgeABI.Pack(
+			"receiveTeleporterMessage",
+			counterpartBlockchainID,
+			counterpartAddress,
+			bridgeMessage,
+		)
+		Expect(err).Should(BeNil())
+		for _, caller := range []common.Address{teleporterRegistryAddress, siblingAddress} {
+			_, err := subnet.RPCClient.CallContract(ctx, subnetevminterfaces.CallMsg{
+				From: caller,
+				To:   &bridgeAddress,
+				Data: callData,
+			}, nil)
+			Expect(err).Should(
+				MatchError(ContainSubstring(errors.ErrProtocolAddressNotFound)),
+				"direct call to %s from contract %s was not rejected", bridgeAddress, caller,
+			)
+		}
+	}
+}
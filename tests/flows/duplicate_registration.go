@@ -0,0 +1,237 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploys an ERC20Source contract on the C-Chain
+ * Deploys a NativeTokenDestination contract on Subnet A
+ * Register the NativeTokenDestination to the source contract
+ * Register the NativeTokenDestination a second time, and check that the registration fails to execute on the source
+ * Partially collateralize the destination
+ * Register the NativeTokenDestination a third time, and check that the collateral needed is not reset
+ * Fully collateralize the destination, and check that no further collateral can be added
+ */
+func DuplicateRegistration(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	// Create an ERC20Source for bridging the source token
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy a NativeTokenDestination to Subnet A. No tokens are bridged to it,
+	// so it does not need one of the Native Minter deployer keys.
+	nativeTokenDestinationAddressA, nativeTokenDestinationA := utils.DeployNativeTokenDestinationWithKey(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		"SUBA",
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
+	)
+
+	// Register the NativeTokenDestination to the ERC20Source
+	collateralNeeded := utils.RegisterTokenDestinationOnSource(
+		ctx,
+		network,
+		cChainInfo,
+		erc20SourceAddress,
+		subnetAInfo,
+		nativeTokenDestinationAddressA,
+		initialReserveImbalance,
+		utils.GetTokenMultiplier(decimalsShift),
+		multiplyOnDestination,
+	)
+	settings, err := erc20Source.RegisteredDestinations(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddressA,
+	)
+	Expect(err).Should(BeNil())
+	Expect(settings.Registered).Should(BeTrue())
+	teleporterUtils.ExpectBigEqual(settings.CollateralNeeded, collateralNeeded)
+
+	// The destination is only marked as registered once it receives a message from the source,
+	// so it can send a second registration, which fails to execute on the source.
+	isRegistered, err := nativeTokenDestinationA.IsRegistered(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(isRegistered).Should(BeFalse())
+	failedMessage := registerDestinationAgain(
+		ctx,
+		network,
+		cChainInfo,
+		subnetAInfo,
+		nativeTokenDestinationA,
+	)
+
+	newSettings, err := erc20Source.RegisteredDestinations(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddressA,
+	)
+	Expect(err).Should(BeNil())
+	Expect(newSettings).Should(Equal(settings))
+
+	// Partially collateralize the destination
+	partialCollateral := new(big.Int).Div(collateralNeeded, big.NewInt(2))
+	teleporterUtils.ERC20Approve(
+		ctx,
+		sourceToken,
+		erc20SourceAddress,
+		partialCollateral,
+		cChainInfo,
+		fundedKey,
+	)
+	receipt := utils.TransactAndWaitForSuccess(
+		ctx,
+		cChainInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Source.AddCollateral(
+				opts,
+				subnetAInfo.BlockchainID,
+				nativeTokenDestinationAddressA,
+				partialCollateral,
+			)
+		},
+	)
+	collateralEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseCollateralAdded)
+	Expect(err).Should(BeNil())
+	remainingCollateral := new(big.Int).Sub(collateralNeeded, partialCollateral)
+	teleporterUtils.ExpectBigEqual(collateralEvent.Amount, partialCollateral)
+	teleporterUtils.ExpectBigEqual(collateralEvent.Remaining, remainingCollateral)
+
+	// Registering again neither resets the collateral needed to its initial value, nor adds to it
+	registerDestinationAgain(
+		ctx,
+		network,
+		cChainInfo,
+		subnetAInfo,
+		nativeTokenDestinationA,
+	)
+	newSettings, err = erc20Source.RegisteredDestinations(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddressA,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(newSettings.CollateralNeeded, remainingCollateral)
+
+	// Retrying the failed registration also fails
+	_, err = cChainInfo.TeleporterMessenger.RetryMessageExecution(
+		utils.NewTransactor(ctx, cChainInfo, fundedKey),
+		subnetAInfo.BlockchainID,
+		failedMessage,
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrRetryExecutionFailed)))
+
+	// Fully collateralize the destination with the remaining collateral only
+	utils.AddCollateralToERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddressA,
+		remainingCollateral,
+		fundedKey,
+	)
+	balance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, collateralNeeded)
+
+	// No further collateral is needed
+	teleporterUtils.ERC20Approve(
+		ctx,
+		sourceToken,
+		erc20SourceAddress,
+		big.NewInt(1),
+		cChainInfo,
+		fundedKey,
+	)
+	_, err = erc20Source.AddCollateral(
+		utils.NewTransactor(ctx, cChainInfo, fundedKey),
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddressA,
+		big.NewInt(1),
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrZeroCollateralNeeded)))
+}
+
+// Sends another registration from the destination to the source, and checks that it is received
+// by Teleporter on the source, but fails to execute. Returns the failed Teleporter message.
+func registerDestinationAgain(
+	ctx context.Context,
+	network interfaces.Network,
+	sourceSubnet interfaces.SubnetTestInfo,
+	destinationSubnet interfaces.SubnetTestInfo,
+	tokenDestination *nativetokendestination.NativeTokenDestination,
+) teleportermessenger.TeleporterMessage {
+	_, fundedKey := network.GetFundedAccountInfo()
+	receipt := utils.TransactAndWaitForSuccess(
+		ctx,
+		destinationSubnet,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return tokenDestination.RegisterWithSource(
+				opts,
+				nativetokendestination.TeleporterFeeInfo{FeeTokenAddress: common.Address{}, Amount: big.NewInt(0)},
+			)
+		},
+	)
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		destinationSubnet.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+
+	receipt = network.RelayMessage(ctx, receipt, destinationSubnet, sourceSubnet, true)
+	failedEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		sourceSubnet.TeleporterMessenger.ParseMessageExecutionFailed,
+	)
+	Expect(err).Should(BeNil())
+	Expect(failedEvent.MessageID).Should(Equal(sendEvent.MessageID))
+	_, err = teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		sourceSubnet.TeleporterMessenger.ParseMessageExecuted,
+	)
+	Expect(err).ShouldNot(BeNil())
+
+	return sendEvent.Message
+}
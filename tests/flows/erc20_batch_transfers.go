@@ -0,0 +1,112 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+// Teleporter returns at most this many receipts in each message, from ReceiptQueue.sol
+const maxReceiptsPerMessage = 5
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destinations to Subnet A and Subnet B, and registers both with the source
+ * Sends a batch of transfers to several recipients on Subnet A and Subnet B before relaying any of them, and checks
+ * every delivery along with the aggregate balances of the recipients and destinations
+ * Check that Subnet A queued a receipt for each of the batch's messages
+ * Bridges tokens back from Subnet A until its receipt queue is drained, checking that each message returns the
+ * maximum number of receipts to the source
+ */
+func ERC20BatchTransfers(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+
+	s := scenario.New(context.Background(), network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		DeployERC20Destination(subnetBInfo).
+		Register(subnetAInfo).
+		Register(subnetBInfo)
+	initialReceiptCount := teleporterUtils.GetOutstandingReceiptCount(subnetAInfo, cChainInfo.BlockchainID)
+
+	// Each recipient is sent two transfers of different amounts to each destination
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	var transfers []scenario.Transfer
+	transfersToA := 0
+	for i := 0; i < 3; i++ {
+		key, err := crypto.GenerateKey()
+		Expect(err).Should(BeNil())
+		recipient := crypto.PubkeyToAddress(key.PublicKey)
+		if i == 0 {
+			recipient = recipientAddress
+		}
+		for j := 1; j <= 2; j++ {
+			amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(int64(i+j)))
+			transfers = append(transfers,
+				scenario.Transfer{To: subnetAInfo, Recipient: recipient, Amount: amount},
+				scenario.Transfer{To: subnetBInfo, Recipient: recipient, Amount: amount},
+			)
+			transfersToA++
+		}
+	}
+	s.SendBatch(cChainInfo, transfers...)
+	Expect(s.LastBatchReceipts()).Should(HaveLen(len(transfers)))
+
+	// The messages delivered to Subnet A each queue a receipt to return to the C-Chain
+	batchMessageIDs := make(map[ids.ID]bool)
+	for i, receipt := range s.LastBatchReceipts() {
+		if transfers[i].To.BlockchainID != subnetAInfo.BlockchainID {
+			continue
+		}
+		event, err := teleporterUtils.GetEventFromLogs(
+			receipt.Logs,
+			subnetAInfo.TeleporterMessenger.ParseReceiveCrossChainMessage,
+		)
+		Expect(err).Should(BeNil())
+		batchMessageIDs[ids.ID(event.MessageID)] = false
+	}
+	Expect(batchMessageIDs).Should(HaveLen(transfersToA))
+	receiptCount := teleporterUtils.GetOutstandingReceiptCount(subnetAInfo, cChainInfo.BlockchainID)
+	teleporterUtils.ExpectBigEqual(
+		receiptCount,
+		new(big.Int).Add(initialReceiptCount, big.NewInt(int64(transfersToA))),
+	)
+
+	// Each message back to the C-Chain returns the oldest outstanding receipts, until the queue is drained
+	s.Fund(subnetAInfo, recipientAddress, big.NewInt(1e18)).As(recipientKey)
+	for receiptCount.Sign() > 0 {
+		expectedReceipts := receiptCount.Int64()
+		if expectedReceipts > maxReceiptsPerMessage {
+			expectedReceipts = maxReceiptsPerMessage
+		}
+		s.Send(subnetAInfo, cChainInfo, recipientAddress, big.NewInt(1e17))
+
+		remaining := teleporterUtils.GetOutstandingReceiptCount(subnetAInfo, cChainInfo.BlockchainID)
+		teleporterUtils.ExpectBigEqual(remaining, new(big.Int).Sub(receiptCount, big.NewInt(expectedReceipts)))
+		receiptCount = remaining
+
+		for _, log := range s.LastReceipt().Logs {
+			event, err := cChainInfo.TeleporterMessenger.ParseReceiptReceived(*log)
+			if err != nil {
+				continue
+			}
+			if _, ok := batchMessageIDs[ids.ID(event.MessageID)]; ok {
+				batchMessageIDs[ids.ID(event.MessageID)] = true
+			}
+		}
+	}
+
+	// Every message of the batch had its receipt returned to the C-Chain
+	for messageID, received := range batchMessageIDs {
+		Expect(received).Should(BeTrue(), "receipt of message %s was not received", messageID)
+	}
+}
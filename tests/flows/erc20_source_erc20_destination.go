@@ -21,6 +21,15 @@ import (
  * Bridge tokens from Subnet A to C-Chain
  */
 func ERC20SourceERC20Destination(network interfaces.Network) {
+	ERC20SourceERC20DestinationVersions(network, utils.CurrentVersion, utils.CurrentVersion)
+}
+
+// ERC20SourceERC20DestinationVersions runs ERC20SourceERC20Destination with the given versions of each contract
+func ERC20SourceERC20DestinationVersions(
+	network interfaces.Network,
+	sourceVersion utils.ContractVersion,
+	destinationVersion utils.ContractVersion,
+) {
 	cChainInfo := network.GetPrimaryNetworkInfo()
 	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
 	fundedAddress, fundedKey := network.GetFundedAccountInfo()
@@ -35,27 +44,29 @@ func ERC20SourceERC20Destination(network interfaces.Network) {
 	)
 
 	// Create an ERC20Source for bridging the source token
-	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+	erc20SourceAddress, erc20Source := utils.DeployERC20SourceVersion(
 		ctx,
 		fundedKey,
 		cChainInfo,
+		sourceVersion,
 		fundedAddress,
 		sourceTokenAddress,
 	)
 
 	// Token representation on subnet A will have same name, symbol, and decimals
-	tokenName, err := sourceToken.Name(&bind.CallOpts{})
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
-	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{})
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
-	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{})
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
 
 	// Deploy an ERC20Destination to Subnet A
-	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
+	erc20DestinationAddress, erc20Destination := utils.DeployERC20DestinationVersion(
 		ctx,
 		fundedKey,
 		subnetAInfo,
+		destinationVersion,
 		fundedAddress,
 		cChainInfo.BlockchainID,
 		erc20SourceAddress,
@@ -119,7 +130,7 @@ func ERC20SourceERC20Destination(network interfaces.Network) {
 	)
 
 	// Check that the recipient received the tokens
-	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{}, recipientAddress)
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 	Expect(err).Should(BeNil())
 	Expect(balance).Should(Equal(bridgedAmount))
 
@@ -170,7 +181,7 @@ func ERC20SourceERC20Destination(network interfaces.Network) {
 	)
 
 	// Check that the recipient received the tokens
-	balance, err = sourceToken.BalanceOf(&bind.CallOpts{}, recipientAddress)
+	balance, err = sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 	Expect(err).Should(BeNil())
 	Expect(balance).Should(Equal(bridgedAmount))
 }
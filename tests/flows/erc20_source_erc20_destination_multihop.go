@@ -44,11 +44,11 @@ func ERC20SourceERC20DestinationMultiHop(network interfaces.Network) {
 	)
 
 	// Token representation on subnets A and B will have same name, symbol, and decimals
-	tokenName, err := sourceToken.Name(&bind.CallOpts{})
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
-	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{})
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
-	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{})
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
 
 	// Deploy an ERC20Destination for Subnet A
@@ -141,7 +141,7 @@ func ERC20SourceERC20DestinationMultiHop(network interfaces.Network) {
 	)
 
 	// Check that the recipient received the tokens
-	balance, err := erc20Destination_A.BalanceOf(&bind.CallOpts{}, recipientAddress)
+	balance, err := erc20Destination_A.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 	Expect(err).Should(BeNil())
 	Expect(balance).Should(Equal(bridgedAmount))
 
@@ -56,34 +56,13 @@ func ERC20SourceERC20DestinationSendAndCall(network interfaces.Network) {
 		subnetAInfo,
 	)
 
-	// Token representation on subnet A will have same name, symbol, and decimals
-	tokenName, err := sourceToken.Name(&bind.CallOpts{})
-	Expect(err).Should(BeNil())
-	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{})
-	Expect(err).Should(BeNil())
-	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{})
-	Expect(err).Should(BeNil())
-
-	// Deploy an ERC20Destination to Subnet A
-	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
-		ctx,
-		fundedKey,
-		subnetAInfo,
-		fundedAddress,
-		cChainInfo.BlockchainID,
-		erc20SourceAddress,
-		tokenName,
-		tokenSymbol,
-		tokenDecimals,
-	)
-
-	utils.RegisterERC20DestinationOnSource(
+	// Deploy an ERC20Destination to Subnet A, and register it with the ERC20Source
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
 		ctx,
 		network,
+		subnetAInfo,
 		cChainInfo,
 		erc20SourceAddress,
-		subnetAInfo,
-		erc20DestinationAddress,
 	)
 
 	// Generate new recipient to receive bridged tokens
@@ -146,7 +125,7 @@ func ERC20SourceERC20DestinationSendAndCall(network interfaces.Network) {
 		Expect(receiverEvent.Payload).Should(Equal(input.RecipientPayload))
 
 		// Check that the contract received the tokens
-		balance, err := erc20Destination.BalanceOf(&bind.CallOpts{}, destMockERC20SACRAddress)
+		balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, destMockERC20SACRAddress)
 		Expect(err).Should(BeNil())
 		Expect(balance).Should(Equal(bridgedAmount))
 	}
@@ -193,7 +172,7 @@ func ERC20SourceERC20DestinationSendAndCall(network interfaces.Network) {
 		)
 
 		// Check that the recipient received the tokens
-		balance, err := erc20Destination.BalanceOf(&bind.CallOpts{}, recipientAddress)
+		balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 		Expect(err).Should(BeNil())
 		Expect(balance).Should(Equal(bridgedAmount))
 	}
@@ -251,7 +230,7 @@ func ERC20SourceERC20DestinationSendAndCall(network interfaces.Network) {
 		Expect(receiverEvent.Payload).Should(Equal(inputB.RecipientPayload))
 
 		// Check that the recipient received the tokens
-		balance, err := sourceToken.BalanceOf(&bind.CallOpts{}, sourceMockERC20SACRAddress)
+		balance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, sourceMockERC20SACRAddress)
 		Expect(err).Should(BeNil())
 		Expect(balance).Should(Equal(bridgedAmount))
 	}
@@ -0,0 +1,260 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Deploys a MockDEX to Subnet A, with liquidity to swap the bridged tokens for another example ERC20
+ * Deploys an ExampleERC20SwapReceiver to Subnet A, which swaps tokens bridged to it on the MockDEX
+ * Bridges C-Chain example ERC20 tokens to the swap receiver using sendAndCall, and checks the
+ * recipient in the payload receives the swapped tokens
+ * Bridges C-Chain example ERC20 tokens to the swap receiver with an unachievable minimum
+ * swap output, and checks the bridged tokens are sent to the fallback recipient
+ */
+func ERC20SourceERC20DestinationSendAndSwap(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	// Create an ERC20Source for bridging the source token
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC20Destination to Subnet A, and register it with the ERC20Source
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	// Deploy a MockDEX to Subnet A, which swaps the bridged tokens for another example ERC20 at a rate of 2:1
+	swapTokenAddress, swapToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+	)
+	dexAddress, dex := utils.DeployMockDEX(ctx, fundedKey, subnetAInfo)
+	rate := new(big.Int).Mul(big.NewInt(2), big.NewInt(1e18))
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return dex.SetRate(opts, erc20DestinationAddress, swapTokenAddress, rate)
+		},
+	)
+	liquidity := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000))
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return swapToken.Transfer(opts, dexAddress, liquidity)
+		},
+	)
+
+	swapReceiverAddress, swapReceiver := utils.DeployExampleERC20SwapReceiver(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		dexAddress,
+	)
+
+	// Generate new recipient to receive swapped tokens
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// Generate new fallback recipient to receive bridged tokens if the swap fails
+	fallbackKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	fallbackAddress := crypto.PubkeyToAddress(fallbackKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	// Bridge tokens to the swap receiver, which swaps them for the recipient
+	{
+		expectedAmountOut, err := dex.GetAmountOut(
+			&bind.CallOpts{Context: ctx},
+			erc20DestinationAddress,
+			swapTokenAddress,
+			amount,
+		)
+		Expect(err).Should(BeNil())
+		payload := utils.SwapPayload{
+			TokenOut:     swapTokenAddress,
+			MinAmountOut: expectedAmountOut,
+			Recipient:    recipientAddress,
+		}
+		input := erc20source.SendAndCallInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			RecipientContract:        swapReceiverAddress,
+			RecipientPayload:         utils.PackSwapPayload(payload),
+			RequiredGasLimit:         teleporterUtils.BigIntMul(big.NewInt(10), utils.DefaultERC20RequiredGas),
+			RecipientGasLimit:        teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas),
+			FallbackRecipient:        fallbackAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(1e18),
+			SecondaryFee:             big.NewInt(0),
+		}
+
+		receipt, bridgedAmount := utils.SendAndCallERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			input,
+			amount,
+			fundedKey,
+		)
+		teleporterUtils.ExpectBigEqual(bridgedAmount, amount)
+
+		receipt = network.RelayMessage(
+			ctx,
+			receipt,
+			cChainInfo,
+			subnetAInfo,
+			true,
+		)
+
+		event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallSucceeded)
+		Expect(err).Should(BeNil())
+		Expect(event.RecipientContract).Should(Equal(swapReceiverAddress))
+		teleporterUtils.ExpectBigEqual(event.Amount, bridgedAmount)
+
+		swappedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, swapReceiver.ParseTokensSwapped)
+		Expect(err).Should(BeNil())
+		Expect(swappedEvent.SourceBlockchainID[:]).Should(Equal(cChainInfo.BlockchainID[:]))
+		Expect(swappedEvent.OriginSenderAddress).Should(Equal(fundedAddress))
+		Expect(swappedEvent.TokenIn).Should(Equal(erc20DestinationAddress))
+		teleporterUtils.ExpectBigEqual(swappedEvent.AmountIn, bridgedAmount)
+		Expect(swappedEvent.TokenOut).Should(Equal(swapTokenAddress))
+		teleporterUtils.ExpectBigEqual(swappedEvent.AmountOut, expectedAmountOut)
+		Expect(swappedEvent.Recipient).Should(Equal(recipientAddress))
+
+		swapEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, dex.ParseSwap)
+		Expect(err).Should(BeNil())
+		Expect(swapEvent.Sender).Should(Equal(swapReceiverAddress))
+		teleporterUtils.ExpectBigEqual(swapEvent.AmountOut, expectedAmountOut)
+
+		// The recipient received the swapped tokens, and the DEX received the bridged tokens
+		balance, err := swapToken.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, expectedAmountOut)
+		balance, err = erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, dexAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, bridgedAmount)
+		balance, err = erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, swapReceiverAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, big.NewInt(0))
+	}
+
+	// Bridge tokens to the swap receiver with a minimum output the DEX cannot meet,
+	// so the swap fails, and the bridged tokens are sent to the fallback recipient
+	{
+		expectedAmountOut, err := dex.GetAmountOut(
+			&bind.CallOpts{Context: ctx},
+			erc20DestinationAddress,
+			swapTokenAddress,
+			amount,
+		)
+		Expect(err).Should(BeNil())
+		payload := utils.SwapPayload{
+			TokenOut:     swapTokenAddress,
+			MinAmountOut: new(big.Int).Add(expectedAmountOut, big.NewInt(1)),
+			Recipient:    recipientAddress,
+		}
+		input := erc20source.SendAndCallInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			RecipientContract:        swapReceiverAddress,
+			RecipientPayload:         utils.PackSwapPayload(payload),
+			RequiredGasLimit:         teleporterUtils.BigIntMul(big.NewInt(10), utils.DefaultERC20RequiredGas),
+			RecipientGasLimit:        teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas),
+			FallbackRecipient:        fallbackAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(1e18),
+			SecondaryFee:             big.NewInt(0),
+		}
+
+		recipientBalanceBefore, err := swapToken.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+		Expect(err).Should(BeNil())
+		dexBalanceBefore, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, dexAddress)
+		Expect(err).Should(BeNil())
+
+		receipt, bridgedAmount := utils.SendAndCallERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			input,
+			amount,
+			fundedKey,
+		)
+
+		receipt = network.RelayMessage(
+			ctx,
+			receipt,
+			cChainInfo,
+			subnetAInfo,
+			true,
+		)
+
+		event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallFailed)
+		Expect(err).Should(BeNil())
+		Expect(event.RecipientContract).Should(Equal(swapReceiverAddress))
+		teleporterUtils.ExpectBigEqual(event.Amount, bridgedAmount)
+
+		// The failed swap emits no events of the receiver or the DEX, since they are reverted
+		_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, swapReceiver.ParseTokensSwapped)
+		Expect(err).ShouldNot(BeNil())
+		_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, dex.ParseSwap)
+		Expect(err).ShouldNot(BeNil())
+
+		// The fallback recipient received the bridged tokens, and no tokens were swapped
+		balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, fallbackAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, bridgedAmount)
+		balance, err = swapToken.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, recipientBalanceBefore)
+		balance, err = erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, dexAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, dexBalanceBefore)
+		balance, err = erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, swapReceiverAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, big.NewInt(0))
+	}
+}
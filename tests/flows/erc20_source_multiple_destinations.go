@@ -0,0 +1,52 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destinations to Subnet A and Subnet B, and registers both with the source
+ * Bridges C-Chain example ERC20 tokens to the same recipient on Subnet A and Subnet B
+ * Bridges half of the tokens from each of Subnet A and Subnet B back to the recipient on the C-Chain
+ */
+func ERC20SourceMultipleDestinations(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+	halfAmount := new(big.Int).Div(amount, big.NewInt(2))
+
+	// Bridging half of the tokens back from a destination to the C-Chain, from the recipient's account
+	bridgeHalfBack := func(subnet interfaces.SubnetTestInfo) scenario.Step {
+		return func(s *scenario.Scenario) {
+			s.Fund(subnet, recipientAddress, big.NewInt(1e18)).
+				As(recipientKey).
+				Send(subnet, cChainInfo, recipientAddress, halfAmount).
+				ExpectBalance(subnet, recipientAddress, new(big.Int).Sub(amount, halfAmount))
+		}
+	}
+
+	scenario.New(context.Background(), network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		DeployERC20Destination(subnetBInfo).
+		Register(subnetAInfo).
+		Register(subnetBInfo).
+		Send(cChainInfo, subnetAInfo, recipientAddress, amount).
+		ExpectBalance(subnetAInfo, recipientAddress, amount).
+		Send(cChainInfo, subnetBInfo, recipientAddress, amount).
+		ExpectBalance(subnetBInfo, recipientAddress, amount).
+		Then(bridgeHalfBack(subnetAInfo), bridgeHalfBack(subnetBInfo)).
+		ExpectBalance(cChainInfo, recipientAddress, new(big.Int).Mul(halfAmount, big.NewInt(2)))
+}
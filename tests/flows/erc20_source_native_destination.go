@@ -43,31 +43,20 @@ func ERC20SourceNativeDestination(network interfaces.Network) {
 		sourceTokenAddress,
 	)
 
-	// Deploy a NativeTokenDestination to Subnet A
-	nativeTokenDestinationAddressA, nativeTokenDestinationA := utils.DeployNativeTokenDestination(
-		ctx,
-		subnetAInfo,
-		"SUBA",
-		fundedAddress,
-		cChainInfo.BlockchainID,
-		erc20SourceAddress,
-		initialReserveImbalance,
-		decimalsShift,
-		multiplyOnDestination,
-		burnedFeesReportingRewardPercentage,
-	)
-
-	collateralAmount := utils.RegisterTokenDestinationOnSource(
-		ctx,
-		network,
-		cChainInfo,
-		erc20SourceAddress,
-		subnetAInfo,
-		nativeTokenDestinationAddressA,
-		initialReserveImbalance,
-		utils.GetTokenMultiplier(decimalsShift),
-		multiplyOnDestination,
-	)
+	// Deploy a NativeTokenDestination to Subnet A, and register it with the ERC20Source
+	nativeTokenDestinationAddressA, nativeTokenDestinationA, collateralAmount :=
+		utils.DeployAndRegisterNativeTokenDestination(
+			ctx,
+			network,
+			subnetAInfo,
+			"SUBA",
+			cChainInfo,
+			erc20SourceAddress,
+			initialReserveImbalance,
+			decimalsShift,
+			multiplyOnDestination,
+			burnedFeesReportingRewardPercentage,
+		)
 
 	utils.AddCollateralToERC20Source(
 		ctx,
@@ -164,7 +153,7 @@ func ERC20SourceNativeDestination(network interfaces.Network) {
 	)
 
 	// Check that the recipient received the tokens
-	balance, err := sourceToken.BalanceOf(&bind.CallOpts{}, recipientAddress)
+	balance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 	Expect(err).Should(BeNil())
 	Expect(balance).Should(Equal(scaledAmount))
 }
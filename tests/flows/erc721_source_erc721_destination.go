@@ -0,0 +1,155 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc721destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Destination"
+	erc721source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC721 token source on the primary network
+ * Deploys ERC721Destination to Subnet A
+ * Bridges a C-Chain example ERC721 token to Subnet A
+ * Bridge the token from Subnet A back to C-Chain
+ */
+func ERC721SourceERC721Destination(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC721 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := utils.DeployExampleERC721(ctx, fundedKey, cChainInfo)
+
+	// Create an ERC721Source for bridging the source token
+	erc721SourceAddress, erc721Source := utils.DeployERC721Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC721Destination to Subnet A and register it with the source
+	erc721DestinationAddress, erc721Destination := utils.DeployAndRegisterERC721Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc721Source,
+		erc721SourceAddress,
+		sourceToken,
+	)
+
+	// Mint the token to be bridged
+	tokenID := big.NewInt(7)
+	tokenURI := "ipfs://example-erc721/7"
+	utils.MintExampleERC721(ctx, cChainInfo, sourceToken, fundedKey, fundedAddress, tokenID, tokenURI)
+
+	// Generate new recipient to receive the bridged token
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// Send the token from C-Chain to recipient on subnet A
+	input := erc721source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc721DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   common.Address{},
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC721RequiredGas,
+	}
+	receipt := utils.SendERC721Source(
+		ctx,
+		cChainInfo,
+		erc721Source,
+		erc721SourceAddress,
+		sourceToken,
+		input,
+		tokenID,
+		fundedKey,
+	)
+
+	// The source records where the token was bridged to
+	bridgedToken, err := erc721Source.BridgedTokens(&bind.CallOpts{Context: ctx}, tokenID)
+	Expect(err).Should(BeNil())
+	Expect(bridgedToken.DestinationBlockchainID[:]).Should(Equal(subnetAInfo.BlockchainID[:]))
+	Expect(bridgedToken.DestinationBridgeAddress).Should(Equal(erc721DestinationAddress))
+
+	// Relay the message to Subnet A and check that the token was minted with its token URI
+	receipt = network.RelayMessage(
+		ctx,
+		receipt,
+		cChainInfo,
+		subnetAInfo,
+		true,
+	)
+	utils.CheckERC721DestinationWithdrawal(
+		ctx,
+		erc721Destination,
+		receipt,
+		recipientAddress,
+		tokenID,
+		tokenURI,
+	)
+
+	// Bridge back to source
+	// Fund recipient with gas tokens on subnet A
+	teleporterUtils.SendNativeTransfer(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		recipientAddress,
+		big.NewInt(1e18),
+	)
+	inputB := erc721destination.SendTokensInput{
+		DestinationBlockchainID:  cChainInfo.BlockchainID,
+		DestinationBridgeAddress: erc721SourceAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   common.Address{},
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC721RequiredGas,
+	}
+	receipt = utils.SendERC721Destination(
+		ctx,
+		subnetAInfo,
+		erc721Destination,
+		inputB,
+		tokenID,
+		recipientKey,
+	)
+
+	receipt = network.RelayMessage(
+		ctx,
+		receipt,
+		subnetAInfo,
+		cChainInfo,
+		true,
+	)
+	utils.CheckERC721SourceWithdrawal(
+		ctx,
+		erc721SourceAddress,
+		sourceToken,
+		receipt,
+		recipientAddress,
+		tokenID,
+	)
+
+	// The token is no longer recorded as bridged
+	bridgedToken, err = erc721Source.BridgedTokens(&bind.CallOpts{Context: ctx}, tokenID)
+	Expect(err).Should(BeNil())
+	Expect(bridgedToken.DestinationBridgeAddress).Should(Equal(common.Address{}))
+}
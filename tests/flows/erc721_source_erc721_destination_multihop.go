@@ -0,0 +1,159 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	erc721destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Destination"
+	erc721source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC721 token source on the primary network
+ * Deploys ERC721Destination to Subnet A and Subnet B
+ * Bridges a C-Chain example ERC721 token to Subnet A
+ * Bridge the token from Subnet A to Subnet B through multi-hop
+ * Multi-hop the token from Subnet B to an unregistered bridge, which returns it to the fallback on C-Chain
+ */
+func ERC721SourceERC721DestinationMultiHop(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC721 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := utils.DeployExampleERC721(ctx, fundedKey, cChainInfo)
+
+	// Create an ERC721Source for bridging the source token
+	erc721SourceAddress, erc721Source := utils.DeployERC721Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC721Destination to Subnets A and B and register them with the source
+	erc721DestinationAddressA, erc721DestinationA := utils.DeployAndRegisterERC721Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc721Source,
+		erc721SourceAddress,
+		sourceToken,
+	)
+	erc721DestinationAddressB, erc721DestinationB := utils.DeployAndRegisterERC721Destination(
+		ctx,
+		network,
+		subnetBInfo,
+		cChainInfo,
+		erc721Source,
+		erc721SourceAddress,
+		sourceToken,
+	)
+
+	tokenID := big.NewInt(11)
+	tokenURI := "ipfs://example-erc721/11"
+	utils.MintExampleERC721(ctx, cChainInfo, sourceToken, fundedKey, fundedAddress, tokenID, tokenURI)
+
+	// Generate new recipient to receive the bridged token
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// Send the token from C-Chain to recipient on Subnet A
+	input := erc721source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc721DestinationAddressA,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   common.Address{},
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC721RequiredGas,
+	}
+	receipt := utils.SendERC721Source(
+		ctx,
+		cChainInfo,
+		erc721Source,
+		erc721SourceAddress,
+		sourceToken,
+		input,
+		tokenID,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC721DestinationWithdrawal(ctx, erc721DestinationA, receipt, recipientAddress, tokenID, tokenURI)
+
+	// Multi-hop the token from Subnet A to Subnet B through the source on C-Chain
+	teleporterUtils.SendNativeTransfer(ctx, subnetAInfo, fundedKey, recipientAddress, big.NewInt(1e18))
+	multiHopInput := erc721destination.SendTokensInput{
+		DestinationBlockchainID:  subnetBInfo.BlockchainID,
+		DestinationBridgeAddress: erc721DestinationAddressB,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   common.Address{},
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC721RequiredGas,
+		MultiHopFallback:         recipientAddress,
+	}
+	receipt = utils.SendERC721Destination(
+		ctx,
+		subnetAInfo,
+		erc721DestinationA,
+		multiHopInput,
+		tokenID,
+		recipientKey,
+	)
+
+	// The source routes the token on to Subnet B, carrying over the token URI of the source token
+	intermediateReceipt := network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	routedEvent, err := teleporterUtils.GetEventFromLogs(intermediateReceipt.Logs, erc721Source.ParseTokenRouted)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(routedEvent.TokenId, tokenID)
+	Expect(routedEvent.Input.DestinationBridgeAddress).Should(Equal(erc721DestinationAddressB))
+
+	receipt = network.RelayMessage(ctx, intermediateReceipt, cChainInfo, subnetBInfo, true)
+	utils.CheckERC721DestinationWithdrawal(ctx, erc721DestinationB, receipt, recipientAddress, tokenID, tokenURI)
+
+	// Multi-hop the token from Subnet B to a bridge that is not registered with the source.
+	// The source cannot route it, so returns it to the multi-hop fallback on C-Chain.
+	teleporterUtils.SendNativeTransfer(ctx, subnetBInfo, fundedKey, recipientAddress, big.NewInt(1e18))
+	fallbackKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	fallbackAddress := crypto.PubkeyToAddress(fallbackKey.PublicKey)
+	unregisteredInput := erc721destination.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: common.HexToAddress("0x0000000000000000000000000000000000000721"),
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   common.Address{},
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC721RequiredGas,
+		MultiHopFallback:         fallbackAddress,
+	}
+	receipt = utils.SendERC721Destination(
+		ctx,
+		subnetBInfo,
+		erc721DestinationB,
+		unregisteredInput,
+		tokenID,
+		recipientKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetBInfo, cChainInfo, true)
+	utils.CheckERC721SourceWithdrawal(
+		ctx,
+		erc721SourceAddress,
+		sourceToken,
+		receipt,
+		fallbackAddress,
+		tokenID,
+	)
+}
@@ -0,0 +1,155 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc721source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC721 token source on the primary network
+ * Deploys ERC721Destination to Subnet A
+ * Bridges a C-Chain example ERC721 token to a mock receiver contract on Subnet A through sendAndCall,
+ * with the token ID as the payload of the call
+ * Bridges a second token to a receiver that rejects the call, which sends it to the fallback recipient
+ */
+func ERC721SourceERC721DestinationSendAndCall(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC721 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := utils.DeployExampleERC721(ctx, fundedKey, cChainInfo)
+
+	// Create an ERC721Source for bridging the source token
+	erc721SourceAddress, erc721Source := utils.DeployERC721Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC721Destination to Subnet A and register it with the source
+	erc721DestinationAddress, erc721Destination := utils.DeployAndRegisterERC721Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc721Source,
+		erc721SourceAddress,
+		sourceToken,
+	)
+
+	// Deploy a mock receiver on Subnet A to be called with the bridged token
+	receiverAddress, receiver := utils.DeployMockERC721SendAndCallReceiver(ctx, fundedKey, subnetAInfo)
+
+	// Generate new recipient to receive the token if the call fails
+	fallbackKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	fallbackAddress := crypto.PubkeyToAddress(fallbackKey.PublicKey)
+
+	tokenID := big.NewInt(21)
+	tokenURI := "ipfs://example-erc721/21"
+	utils.MintExampleERC721(ctx, cChainInfo, sourceToken, fundedKey, fundedAddress, tokenID, tokenURI)
+
+	// Send the token to the receiver, with the token ID as the payload of the call
+	input := erc721source.SendAndCallInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc721DestinationAddress,
+		RecipientContract:        receiverAddress,
+		RecipientPayload:         common.LeftPadBytes(tokenID.Bytes(), common.HashLength),
+		RequiredGasLimit:         big.NewInt(0).Add(utils.DefaultERC721RequiredGas, big.NewInt(200_000)),
+		RecipientGasLimit:        big.NewInt(200_000),
+		FallbackRecipient:        fallbackAddress,
+		PrimaryFeeTokenAddress:   common.Address{},
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+	}
+	receipt := utils.SendAndCallERC721Source(
+		ctx,
+		cChainInfo,
+		erc721Source,
+		erc721SourceAddress,
+		sourceToken,
+		input,
+		tokenID,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+
+	// Check that the receiver was called with the token ID payload, and took the token
+	receivedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, receiver.ParseTokenReceived)
+	Expect(err).Should(BeNil())
+	Expect(receivedEvent.SourceBlockchainID[:]).Should(Equal(cChainInfo.BlockchainID[:]))
+	Expect(receivedEvent.OriginSenderAddress).Should(Equal(fundedAddress))
+	Expect(receivedEvent.Token).Should(Equal(erc721DestinationAddress))
+	teleporterUtils.ExpectBigEqual(receivedEvent.TokenId, tokenID)
+	teleporterUtils.ExpectBigEqual(new(big.Int).SetBytes(receivedEvent.Payload), tokenID)
+
+	callEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc721Destination.ParseCallSucceeded)
+	Expect(err).Should(BeNil())
+	Expect(callEvent.RecipientContract).Should(Equal(receiverAddress))
+	teleporterUtils.ExpectBigEqual(callEvent.TokenId, tokenID)
+
+	owner, err := erc721Destination.OwnerOf(&bind.CallOpts{Context: ctx}, tokenID)
+	Expect(err).Should(BeNil())
+	Expect(owner).Should(Equal(receiverAddress))
+	uri, err := erc721Destination.TokenURI(&bind.CallOpts{Context: ctx}, tokenID)
+	Expect(err).Should(BeNil())
+	Expect(uri).Should(Equal(tokenURI))
+
+	// Block the sender on the receiver, so that the call for a second token fails
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return receiver.BlockSender(opts, cChainInfo.BlockchainID, fundedAddress)
+		},
+	)
+
+	secondTokenID := big.NewInt(22)
+	utils.MintExampleERC721(
+		ctx,
+		cChainInfo,
+		sourceToken,
+		fundedKey,
+		fundedAddress,
+		secondTokenID,
+		"ipfs://example-erc721/22",
+	)
+	input.RecipientPayload = common.LeftPadBytes(secondTokenID.Bytes(), common.HashLength)
+	receipt = utils.SendAndCallERC721Source(
+		ctx,
+		cChainInfo,
+		erc721Source,
+		erc721SourceAddress,
+		sourceToken,
+		input,
+		secondTokenID,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+
+	// Check that the token was sent to the fallback recipient instead
+	failedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc721Destination.ParseCallFailed)
+	Expect(err).Should(BeNil())
+	Expect(failedEvent.RecipientContract).Should(Equal(receiverAddress))
+	teleporterUtils.ExpectBigEqual(failedEvent.TokenId, secondTokenID)
+
+	owner, err = erc721Destination.OwnerOf(&bind.CallOpts{Context: ctx}, secondTokenID)
+	Expect(err).Should(BeNil())
+	Expect(owner).Should(Equal(fallbackAddress))
+}
@@ -0,0 +1,431 @@
+package flows
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Bridges C-Chain example ERC20 tokens to Subnet A, and checks the TokensSent and TokensWithdrawn events
+ * Bridges C-Chain example ERC20 tokens to a contract on Subnet A using sendAndCall, and checks the
+ * TokensAndCallSent and CallSucceeded events
+ * Bridges C-Chain example ERC20 tokens to a contract on Subnet A using sendAndCall with a call that fails,
+ * and checks the CallFailed event
+ * Bridges the tokens back from Subnet A to the C-Chain, and checks the TokensSent and TokensWithdrawn events
+ * Deploys a NativeTokenDestination to Subnet A, collateralizes it in two steps, and checks the
+ * CollateralAdded events
+ *
+ * Every field of each event is checked, including the address of the emitting contract, since
+ * indexers depend on the event schemas.
+ */
+func EventEmission(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		tokenName,
+		tokenSymbol,
+		tokenDecimals,
+	)
+
+	utils.RegisterERC20DestinationOnSource(
+		ctx,
+		network,
+		cChainInfo,
+		erc20SourceAddress,
+		subnetAInfo,
+		erc20DestinationAddress,
+	)
+
+	destMockERC20SACRAddress, _ := utils.DeployMockERC20SendAndCallReceiver(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+	)
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	fallbackKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	fallbackAddress := crypto.PubkeyToAddress(fallbackKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	// Send tokens from the C-Chain to the recipient on Subnet A
+	sendInput := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(1e18),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		sendInput,
+		amount,
+		fundedKey,
+	)
+	sentEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseTokensSent)
+	Expect(err).Should(BeNil())
+	Expect(sentEvent.Raw.Address).Should(Equal(erc20SourceAddress))
+	expectTeleporterMessageID(
+		receipt,
+		cChainInfo,
+		sentEvent.TeleporterMessageID,
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddress,
+		sendInput.PrimaryFeeTokenAddress,
+		sendInput.PrimaryFee,
+	)
+	Expect(sentEvent.Sender).Should(Equal(fundedAddress))
+	expectSourceSendTokensInput(sentEvent.Input, sendInput)
+	teleporterUtils.ExpectBigEqual(sentEvent.Amount, amount)
+
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	destinationWithdrawnEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		erc20Destination.ParseTokensWithdrawn,
+	)
+	Expect(err).Should(BeNil())
+	Expect(destinationWithdrawnEvent.Raw.Address).Should(Equal(erc20DestinationAddress))
+	Expect(destinationWithdrawnEvent.Recipient).Should(Equal(recipientAddress))
+	teleporterUtils.ExpectBigEqual(destinationWithdrawnEvent.Amount, bridgedAmount)
+
+	// Send tokens from the C-Chain to a contract on Subnet A, whose call succeeds
+	sendAndCallInput := erc20source.SendAndCallInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		RecipientContract:        destMockERC20SACRAddress,
+		RecipientPayload:         []byte{1},
+		RequiredGasLimit:         teleporterUtils.BigIntMul(big.NewInt(10), utils.DefaultERC20RequiredGas),
+		RecipientGasLimit:        teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas),
+		FallbackRecipient:        fallbackAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(1e18),
+		SecondaryFee:             big.NewInt(0),
+	}
+	receipt, bridgedAmount = utils.SendAndCallERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		sendAndCallInput,
+		amount,
+		fundedKey,
+	)
+	expectTokensAndCallSentEvent(
+		receipt,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		fundedAddress,
+		sendAndCallInput,
+		bridgedAmount,
+	)
+
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	callSucceededEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallSucceeded)
+	Expect(err).Should(BeNil())
+	Expect(callSucceededEvent.Raw.Address).Should(Equal(erc20DestinationAddress))
+	Expect(callSucceededEvent.RecipientContract).Should(Equal(destMockERC20SACRAddress))
+	teleporterUtils.ExpectBigEqual(callSucceededEvent.Amount, bridgedAmount)
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallFailed)
+	Expect(err).ShouldNot(BeNil())
+
+	// Send tokens from the C-Chain to a contract on Subnet A, whose call fails on the empty payload,
+	// so the tokens are sent to the fallback recipient
+	sendAndCallInput.RecipientPayload = []byte{}
+	receipt, bridgedAmount = utils.SendAndCallERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		sendAndCallInput,
+		amount,
+		fundedKey,
+	)
+	expectTokensAndCallSentEvent(
+		receipt,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		fundedAddress,
+		sendAndCallInput,
+		bridgedAmount,
+	)
+
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	callFailedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallFailed)
+	Expect(err).Should(BeNil())
+	Expect(callFailedEvent.Raw.Address).Should(Equal(erc20DestinationAddress))
+	Expect(callFailedEvent.RecipientContract).Should(Equal(destMockERC20SACRAddress))
+	teleporterUtils.ExpectBigEqual(callFailedEvent.Amount, bridgedAmount)
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallSucceeded)
+	Expect(err).ShouldNot(BeNil())
+	fallbackBalance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, fallbackAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(fallbackBalance, bridgedAmount)
+
+	// Send the tokens of the recipient on Subnet A back to the C-Chain
+	teleporterUtils.SendNativeTransfer(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		recipientAddress,
+		big.NewInt(1e18),
+	)
+	recipientBalance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	sendBackInput := erc20destination.SendTokensInput{
+		DestinationBlockchainID:  cChainInfo.BlockchainID,
+		DestinationBridgeAddress: erc20SourceAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   erc20DestinationAddress,
+		PrimaryFee:               big.NewInt(1e10),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	sendBackAmount := teleporterUtils.BigIntSub(recipientBalance, sendBackInput.PrimaryFee)
+	receipt, bridgedAmount = utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		erc20Destination,
+		erc20DestinationAddress,
+		sendBackInput,
+		sendBackAmount,
+		recipientKey,
+	)
+	destinationSentEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseTokensSent)
+	Expect(err).Should(BeNil())
+	Expect(destinationSentEvent.Raw.Address).Should(Equal(erc20DestinationAddress))
+	expectTeleporterMessageID(
+		receipt,
+		subnetAInfo,
+		destinationSentEvent.TeleporterMessageID,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		sendBackInput.PrimaryFeeTokenAddress,
+		sendBackInput.PrimaryFee,
+	)
+	Expect(destinationSentEvent.Sender).Should(Equal(recipientAddress))
+	expectDestinationSendTokensInput(destinationSentEvent.Input, sendBackInput)
+	teleporterUtils.ExpectBigEqual(destinationSentEvent.Amount, sendBackAmount)
+
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	sourceWithdrawnEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseTokensWithdrawn)
+	Expect(err).Should(BeNil())
+	Expect(sourceWithdrawnEvent.Raw.Address).Should(Equal(erc20SourceAddress))
+	Expect(sourceWithdrawnEvent.Recipient).Should(Equal(recipientAddress))
+	teleporterUtils.ExpectBigEqual(sourceWithdrawnEvent.Amount, bridgedAmount)
+
+	// Register a NativeTokenDestination with the source, which needs collateral. No tokens are bridged to it,
+	// so it does not need one of the Native Minter deployer keys.
+	nativeTokenDestinationAddressA, _ := utils.DeployNativeTokenDestinationWithKey(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		"SUBA",
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
+	)
+	collateralNeeded := utils.RegisterTokenDestinationOnSource(
+		ctx,
+		network,
+		cChainInfo,
+		erc20SourceAddress,
+		subnetAInfo,
+		nativeTokenDestinationAddressA,
+		initialReserveImbalance,
+		utils.GetTokenMultiplier(decimalsShift),
+		multiplyOnDestination,
+	)
+
+	// Partially collateralize the destination, and then add more than the remaining collateral needed,
+	// for which only the remaining collateral is taken.
+	partialCollateral := new(big.Int).Div(collateralNeeded, big.NewInt(3))
+	remainingCollateral := teleporterUtils.BigIntSub(collateralNeeded, partialCollateral)
+	excessCollateral := new(big.Int).Add(remainingCollateral, big.NewInt(1e18))
+	for _, collateral := range []struct {
+		amount    *big.Int
+		added     *big.Int
+		remaining *big.Int
+	}{
+		{amount: partialCollateral, added: partialCollateral, remaining: remainingCollateral},
+		{amount: excessCollateral, added: remainingCollateral, remaining: big.NewInt(0)},
+	} {
+		teleporterUtils.ERC20Approve(
+			ctx,
+			sourceToken,
+			erc20SourceAddress,
+			collateral.amount,
+			cChainInfo,
+			fundedKey,
+		)
+		receipt = utils.TransactAndWaitForSuccess(
+			ctx,
+			cChainInfo,
+			fundedKey,
+			func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return erc20Source.AddCollateral(
+					opts,
+					subnetAInfo.BlockchainID,
+					nativeTokenDestinationAddressA,
+					collateral.amount,
+				)
+			},
+		)
+		collateralEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseCollateralAdded)
+		Expect(err).Should(BeNil())
+		Expect(collateralEvent.Raw.Address).Should(Equal(erc20SourceAddress))
+		Expect(collateralEvent.DestinationBlockchainID[:]).Should(Equal(subnetAInfo.BlockchainID[:]))
+		Expect(collateralEvent.DestinationBridgeAddress).Should(Equal(nativeTokenDestinationAddressA))
+		teleporterUtils.ExpectBigEqual(collateralEvent.Amount, collateral.added)
+		teleporterUtils.ExpectBigEqual(collateralEvent.Remaining, collateral.remaining)
+	}
+}
+
+// Checks that the TokensAndCallSent event in the receipt matches the sendAndCall sent by sender
+func expectTokensAndCallSentEvent(
+	receipt *types.Receipt,
+	subnet interfaces.SubnetTestInfo,
+	erc20Source *erc20source.ERC20Source,
+	erc20SourceAddress common.Address,
+	sender common.Address,
+	input erc20source.SendAndCallInput,
+	bridgedAmount *big.Int,
+) {
+	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseTokensAndCallSent)
+	Expect(err).Should(BeNil())
+	Expect(event.Raw.Address).Should(Equal(erc20SourceAddress))
+	expectTeleporterMessageID(
+		receipt,
+		subnet,
+		event.TeleporterMessageID,
+		input.DestinationBlockchainID,
+		input.DestinationBridgeAddress,
+		input.PrimaryFeeTokenAddress,
+		input.PrimaryFee,
+	)
+	Expect(event.Sender).Should(Equal(sender))
+
+	Expect(event.Input.DestinationBlockchainID[:]).Should(Equal(input.DestinationBlockchainID[:]))
+	Expect(event.Input.DestinationBridgeAddress).Should(Equal(input.DestinationBridgeAddress))
+	Expect(event.Input.RecipientContract).Should(Equal(input.RecipientContract))
+	Expect(bytes.Equal(event.Input.RecipientPayload, input.RecipientPayload)).Should(BeTrue())
+	teleporterUtils.ExpectBigEqual(event.Input.RequiredGasLimit, input.RequiredGasLimit)
+	teleporterUtils.ExpectBigEqual(event.Input.RecipientGasLimit, input.RecipientGasLimit)
+	Expect(event.Input.MultiHopFallback).Should(Equal(input.MultiHopFallback))
+	Expect(event.Input.FallbackRecipient).Should(Equal(input.FallbackRecipient))
+	Expect(event.Input.PrimaryFeeTokenAddress).Should(Equal(input.PrimaryFeeTokenAddress))
+	teleporterUtils.ExpectBigEqual(event.Input.PrimaryFee, input.PrimaryFee)
+	teleporterUtils.ExpectBigEqual(event.Input.SecondaryFee, input.SecondaryFee)
+	teleporterUtils.ExpectBigEqual(event.Amount, bridgedAmount)
+}
+
+// Checks that the Teleporter message sent in the receipt has the given message ID, destination, and fee
+func expectTeleporterMessageID(
+	receipt *types.Receipt,
+	subnet interfaces.SubnetTestInfo,
+	messageID [32]byte,
+	destinationBlockchainID ids.ID,
+	destinationAddress common.Address,
+	feeTokenAddress common.Address,
+	feeAmount *big.Int,
+) {
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		subnet.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	Expect(sendEvent.MessageID[:]).Should(Equal(messageID[:]))
+	Expect(sendEvent.DestinationBlockchainID[:]).Should(Equal(destinationBlockchainID[:]))
+	Expect(sendEvent.Message.DestinationAddress).Should(Equal(destinationAddress))
+	Expect(sendEvent.FeeInfo.FeeTokenAddress).Should(Equal(feeTokenAddress))
+	teleporterUtils.ExpectBigEqual(sendEvent.FeeInfo.Amount, feeAmount)
+}
+
+func expectSourceSendTokensInput(actual erc20source.SendTokensInput, expected erc20source.SendTokensInput) {
+	Expect(actual.DestinationBlockchainID[:]).Should(Equal(expected.DestinationBlockchainID[:]))
+	Expect(actual.DestinationBridgeAddress).Should(Equal(expected.DestinationBridgeAddress))
+	Expect(actual.Recipient).Should(Equal(expected.Recipient))
+	Expect(actual.PrimaryFeeTokenAddress).Should(Equal(expected.PrimaryFeeTokenAddress))
+	teleporterUtils.ExpectBigEqual(actual.PrimaryFee, expected.PrimaryFee)
+	teleporterUtils.ExpectBigEqual(actual.SecondaryFee, expected.SecondaryFee)
+	teleporterUtils.ExpectBigEqual(actual.RequiredGasLimit, expected.RequiredGasLimit)
+	Expect(actual.MultiHopFallback).Should(Equal(expected.MultiHopFallback))
+}
+
+func expectDestinationSendTokensInput(
+	actual erc20destination.SendTokensInput,
+	expected erc20destination.SendTokensInput,
+) {
+	Expect(actual.DestinationBlockchainID[:]).Should(Equal(expected.DestinationBlockchainID[:]))
+	Expect(actual.DestinationBridgeAddress).Should(Equal(expected.DestinationBridgeAddress))
+	Expect(actual.Recipient).Should(Equal(expected.Recipient))
+	Expect(actual.PrimaryFeeTokenAddress).Should(Equal(expected.PrimaryFeeTokenAddress))
+	teleporterUtils.ExpectBigEqual(actual.PrimaryFee, expected.PrimaryFee)
+	teleporterUtils.ExpectBigEqual(actual.SecondaryFee, expected.SecondaryFee)
+	teleporterUtils.ExpectBigEqual(actual.RequiredGasLimit, expected.RequiredGasLimit)
+	Expect(actual.MultiHopFallback).Should(Equal(expected.MultiHopFallback))
+}
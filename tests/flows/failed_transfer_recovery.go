@@ -0,0 +1,137 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * The owner of ERC20Destination pauses the Teleporter messenger that the source sends through
+ * Bridges C-Chain example ERC20 tokens to Subnet A, whose delivery fails to execute, and check that the SDK
+ * finds the failed message, and that the tokens stay locked in the source, accounted to Subnet A
+ * Check that retrying the execution through the SDK fails while the messenger is paused
+ * The owner unpauses the messenger, and another account retries the execution through the SDK, which mints
+ * the tokens to the recipient
+ * Check that the message can't be retried again
+ */
+func FailedTransferRecovery(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+	subnetAChain := &events.Chain{
+		Name:         "Subnet A",
+		BlockchainID: subnetAInfo.BlockchainID,
+		Client:       subnetAInfo.RPCClient,
+	}
+
+	// Messages are received on Subnet A by the messenger at the address of the one they are sent through
+	teleporter, _ := utils.BridgeTeleporter(ctx, cChainInfo, erc20SourceAddress)
+	tx, err := erc20Destination.PauseTeleporterAddress(
+		utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		teleporter.Address,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.WaitForTransactionSuccess(ctx, subnetAInfo, tx.Hash())
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		amount,
+		fundedKey,
+	)
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+
+	// The message is received, but its execution fails, so nothing is minted
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseTransfer)
+	Expect(err).ShouldNot(BeNil())
+	failed, err := bridge.FailedMessages(ctx, subnetAChain, receipt.TxHash)
+	Expect(err).Should(BeNil())
+	Expect(failed).Should(HaveLen(1))
+	Expect(failed[0].MessageID[:]).Should(Equal(sendEvent.MessageID[:]))
+	Expect(failed[0].SourceBlockchainID).Should(Equal(cChainInfo.BlockchainID))
+	Expect(failed[0].TeleporterAddress).Should(Equal(teleporter.Address))
+	retryable, err := failed[0].Retryable(ctx, subnetAChain)
+	Expect(err).Should(BeNil())
+	Expect(retryable).Should(BeTrue())
+
+	// The tokens stay locked in the source, accounted to Subnet A, until the execution is retried
+	bridgedBalance, err := erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, bridgedAmount)
+	s.ExpectBalance(subnetAInfo, recipientAddress, big.NewInt(0))
+
+	// The retry fails while the messenger is paused, without sending a transaction
+	retrierKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	s.Fund(subnetAInfo, crypto.PubkeyToAddress(retrierKey.PublicKey), big.NewInt(1e18))
+	retrierOpts := utils.NewTransactor(ctx, subnetAInfo, retrierKey)
+	_, err = bridge.RetryMessage(ctx, retrierOpts, subnetAChain, failed[0])
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrRetryExecutionFailed)))
+
+	// Once the owner unpauses the messenger, any account can retry the execution, which mints the tokens
+	tx, err = erc20Destination.UnpauseTeleporterAddress(
+		utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		teleporter.Address,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.WaitForTransactionSuccess(ctx, subnetAInfo, tx.Hash())
+	receipt, err = bridge.RetryMessage(ctx, retrierOpts, subnetAChain, failed[0])
+	Expect(err).Should(BeNil())
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, bridgedAmount)
+	s.ExpectBalance(subnetAInfo, recipientAddress, bridgedAmount)
+
+	// The message is no longer kept as failed, so it can't be executed twice
+	retryable, err = failed[0].Retryable(ctx, subnetAChain)
+	Expect(err).Should(BeNil())
+	Expect(retryable).Should(BeFalse())
+	_, err = bridge.RetryMessage(ctx, retrierOpts, subnetAChain, failed[0])
+	Expect(err).ShouldNot(BeNil())
+}
@@ -0,0 +1,134 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy a ERC20 token source on the primary network
+ * Deploys NativeTokenDestination to Subnet A
+ * Generates a new recipient, with no native balance on Subnet A, and checks that it cannot submit a transaction
+ * Bridges C-Chain example ERC20 tokens to the recipient on Subnet A as Subnet A's native token
+ * Submits the recipient's first transaction on Subnet A, a native transfer paid for with the bridged tokens, and
+ * checks that the recipient's balance covers both the transfer and its gas
+ */
+func GaslessOnboarding(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the C-Chain as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	// Create an ERC20Source for bridging the source token
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy a NativeTokenDestination to Subnet A, and register it with the ERC20Source
+	nativeTokenDestinationAddressA, _, collateralAmount := utils.DeployAndRegisterNativeTokenDestination(
+		ctx,
+		network,
+		subnetAInfo,
+		"SUBA",
+		cChainInfo,
+		erc20SourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
+	)
+
+	utils.AddCollateralToERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddressA,
+		collateralAmount,
+		fundedKey,
+	)
+
+	// Generate a new recipient, which has never held Subnet A's native token
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	teleporterUtils.CheckBalance(ctx, recipientAddress, big.NewInt(0), subnetAInfo.RPCClient)
+
+	// Without a native balance, the recipient cannot pay for the gas of a transaction
+	tx := teleporterUtils.CreateNativeTransferTransaction(ctx, subnetAInfo, recipientKey, fundedAddress, big.NewInt(0))
+	err = subnetAInfo.RPCClient.SendTransaction(ctx, tx)
+	Expect(err).Should(MatchError(ContainSubstring("insufficient funds")))
+
+	// Send tokens from C-Chain to the recipient on Subnet A
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: nativeTokenDestinationAddressA,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(1e18),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+	}
+
+	amount := big.NewInt(0).Mul(big.NewInt(1e18), big.NewInt(10))
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+
+	// Relay the message to Subnet A, minting the native tokens to the recipient
+	network.RelayMessage(
+		ctx,
+		receipt,
+		cChainInfo,
+		subnetAInfo,
+		true,
+	)
+	teleporterUtils.CheckBalance(ctx, recipientAddress, bridgedAmount, subnetAInfo.RPCClient)
+
+	nonce, err := subnetAInfo.RPCClient.NonceAt(ctx, recipientAddress, nil)
+	Expect(err).Should(BeNil())
+	Expect(nonce).Should(BeZero())
+
+	// Submit the recipient's first transaction, transferring half of the bridged tokens and paying for its gas
+	// with the rest
+	transferAmount := new(big.Int).Div(bridgedAmount, big.NewInt(2))
+	receipt = teleporterUtils.SendNativeTransfer(ctx, subnetAInfo, recipientKey, fundedAddress, transferAmount)
+
+	nonce, err = subnetAInfo.RPCClient.NonceAt(ctx, recipientAddress, nil)
+	Expect(err).Should(BeNil())
+	Expect(nonce).Should(Equal(uint64(1)))
+
+	gasCost := new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), receipt.EffectiveGasPrice)
+	expectedBalance := new(big.Int).Sub(bridgedAmount, transferAmount)
+	expectedBalance.Sub(expectedBalance, gasCost)
+	Expect(expectedBalance.Sign()).Should(BeNumerically(">", 0))
+	teleporterUtils.CheckBalance(ctx, recipientAddress, expectedBalance, subnetAInfo.RPCClient)
+}
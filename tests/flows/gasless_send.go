@@ -0,0 +1,203 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/metatx"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Deploys an ERC20SendForwarder on the primary network, which a new user approves to spend their tokens
+ * The user signs send requests, which a relayer submits to the forwarder and pays the gas of
+ * Check that the tokens are bridged to Subnet A without the user spending any gas
+ * Check that replayed, tampered with, and expired requests are rejected
+ */
+func GaslessSend(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC20Destination to Subnet A, and register it with the ERC20Source
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	forwarderAddress, forwarder := utils.DeployERC20SendForwarder(ctx, fundedKey, cChainInfo)
+
+	// Generate a new user holding source tokens. The user is only given enough gas to approve the forwarder once.
+	userKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	userAddress := crypto.PubkeyToAddress(userKey.PublicKey)
+	userTokens := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(100))
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		cChainInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return sourceToken.Transfer(opts, userAddress, userTokens)
+		},
+	)
+	teleporterUtils.SendNativeTransfer(ctx, cChainInfo, fundedKey, userAddress, big.NewInt(1e16))
+	teleporterUtils.ERC20Approve(ctx, sourceToken, forwarderAddress, userTokens, cChainInfo, userKey)
+
+	// Generate a relayer, which pays the gas of the user's sends
+	relayerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	teleporterUtils.SendNativeTransfer(
+		ctx,
+		cChainInfo,
+		fundedKey,
+		crypto.PubkeyToAddress(relayerKey.PublicKey),
+		big.NewInt(1e18),
+	)
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	userGasBalance, err := cChainInfo.RPCClient.BalanceAt(ctx, userAddress, nil)
+	Expect(err).Should(BeNil())
+
+	header, err := cChainInfo.RPCClient.HeaderByNumber(ctx, nil)
+	Expect(err).Should(BeNil())
+	deadline := new(big.Int).SetUint64(header.Time + uint64(time.Hour.Seconds()))
+
+	newRequest := func() metatx.SendRequest {
+		nonce, err := forwarder.Nonce(&bind.CallOpts{Context: ctx}, userAddress)
+		Expect(err).Should(BeNil())
+		return metatx.SendRequest{
+			From:   userAddress,
+			Bridge: erc20SourceAddress,
+			Token:  sourceTokenAddress,
+			Input: erc20source.SendTokensInput{
+				DestinationBlockchainID:  subnetAInfo.BlockchainID,
+				DestinationBridgeAddress: erc20DestinationAddress,
+				Recipient:                recipientAddress,
+				PrimaryFeeTokenAddress:   sourceTokenAddress,
+				PrimaryFee:               big.NewInt(1e18),
+				SecondaryFee:             big.NewInt(0),
+				RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+			},
+			Amount:   new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10)),
+			Nonce:    nonce,
+			Deadline: deadline,
+		}
+	}
+
+	// Send two transfers with signed requests relayed through the forwarder
+	const numTransfers = 2
+	totalSpent := big.NewInt(0)
+	totalBridged := big.NewInt(0)
+	var firstRequest metatx.SendRequest
+	var firstSignature []byte
+	for i := 0; i < numTransfers; i++ {
+		request := newRequest()
+		teleporterUtils.ExpectBigEqual(request.Nonce, big.NewInt(int64(i)))
+
+		// The digest signed off-chain matches the digest verified by the forwarder
+		hash, err := metatx.Hash(cChainInfo.EVMChainID, forwarderAddress, request)
+		Expect(err).Should(BeNil())
+		forwarderHash, err := forwarder.HashSendRequest(&bind.CallOpts{Context: ctx}, request)
+		Expect(err).Should(BeNil())
+		Expect(forwarderHash).Should(Equal(hash))
+
+		signature, err := forwarder.Sign(userKey, request)
+		Expect(err).Should(BeNil())
+		if i == 0 {
+			firstRequest, firstSignature = request, signature
+		}
+
+		receipt := utils.RelaySendRequest(ctx, cChainInfo, forwarder, request, signature, relayerKey)
+		event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseTokensSent)
+		Expect(err).Should(BeNil())
+		Expect(event.Sender).Should(Equal(forwarderAddress))
+		Expect(event.Input.Recipient).Should(Equal(recipientAddress))
+
+		receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+		utils.CheckERC20DestinationWithdrawal(
+			ctx,
+			erc20Destination,
+			receipt,
+			recipientAddress,
+			event.Amount,
+		)
+		totalSpent.Add(totalSpent, new(big.Int).Add(request.Amount, request.Input.PrimaryFee))
+		totalBridged.Add(totalBridged, event.Amount)
+	}
+
+	// The user paid for the transfers in tokens only, without spending any gas
+	balance, err := cChainInfo.RPCClient.BalanceAt(ctx, userAddress, nil)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, userGasBalance)
+	tokenBalance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, userAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(tokenBalance, teleporterUtils.BigIntSub(userTokens, totalSpent))
+	forwarderBalance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, forwarderAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(forwarderBalance, big.NewInt(0))
+	recipientBalance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(recipientBalance, totalBridged)
+
+	// A relayed request cannot be replayed, whether checked before submission or by the forwarder
+	_, err = forwarder.Relay(utils.NewTransactor(ctx, cChainInfo, relayerKey), firstRequest, firstSignature)
+	Expect(err).Should(MatchError(ContainSubstring("invalid nonce")))
+	_, err = forwarder.Execute(utils.NewTransactor(ctx, cChainInfo, relayerKey), firstRequest, firstSignature)
+	Expect(err).Should(MatchError(ContainSubstring("ERC20SendForwarder: invalid nonce")))
+
+	// A request altered after signing is rejected
+	request := newRequest()
+	signature, err := forwarder.Sign(userKey, request)
+	Expect(err).Should(BeNil())
+	request.Input.Recipient = crypto.PubkeyToAddress(relayerKey.PublicKey)
+	_, err = forwarder.Relay(utils.NewTransactor(ctx, cChainInfo, relayerKey), request, signature)
+	Expect(err).Should(MatchError(ContainSubstring("invalid signature")))
+	_, err = forwarder.Execute(utils.NewTransactor(ctx, cChainInfo, relayerKey), request, signature)
+	Expect(err).Should(MatchError(ContainSubstring("ERC20SendForwarder: invalid signature")))
+
+	// A request past its deadline is rejected
+	request = newRequest()
+	request.Deadline = new(big.Int).SetUint64(header.Time - 1)
+	signature, err = forwarder.Sign(userKey, request)
+	Expect(err).Should(BeNil())
+	_, err = forwarder.Execute(utils.NewTransactor(ctx, cChainInfo, relayerKey), request, signature)
+	Expect(err).Should(MatchError(ContainSubstring("ERC20SendForwarder: expired request")))
+
+	// None of the rejected requests moved the user's tokens
+	tokenBalance, err = sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, userAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(tokenBalance, teleporterUtils.BigIntSub(userTokens, totalSpent))
+}
@@ -0,0 +1,299 @@
+package flows
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/onsi/gomega"
+)
+
+// The hub-and-spoke flows deploy at least this many spokes, spread across the Subnets of the network.
+// If the network has fewer Subnets, some Subnets have more than one spoke.
+const minHubSpokes = 3
+
+// An ERC20Destination registered with the hub of a hub-and-spoke topology
+type hubSpoke struct {
+	subnet  interfaces.SubnetTestInfo
+	address common.Address
+	bridge  *erc20destination.ERC20Destination
+}
+
+// Returns the Subnet of each spoke of a hub-and-spoke topology on the network: one spoke on each Subnet,
+// and further spokes on the Subnets in turn until there are minHubSpokes
+func hubSpokeSubnets(network interfaces.Network) []interfaces.SubnetTestInfo {
+	subnets := network.GetSubnetsInfo()
+	Expect(subnets).ShouldNot(BeEmpty())
+	spokeSubnets := make([]interfaces.SubnetTestInfo, 0, max(minHubSpokes, len(subnets)))
+	for i := 0; i < cap(spokeSubnets); i++ {
+		spokeSubnets = append(spokeSubnets, subnets[i%len(subnets)])
+	}
+	return spokeSubnets
+}
+
+/**
+ * Deploy an ERC20 token source on the primary network as the hub
+ * Deploys an ERC20Destination spoke to each Subnet, and further spokes until there are at least three
+ * Bridges C-Chain example ERC20 tokens from the hub to the recipient on each spoke
+ * Bridges tokens between every ordered pair of spokes through multi-hop, including spokes on the same Subnet
+ * Checks that every spoke holds, and the hub tracks as bridged to it, the amount originally bridged to it
+ */
+func ERC20SourceHubAndSpoke(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the C-Chain as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	// Create an ERC20Source for bridging the source token, as the hub
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy and register each spoke
+	var spokes []hubSpoke
+	for _, subnet := range hubSpokeSubnets(network) {
+		address, bridge := utils.DeployAndRegisterERC20Destination(
+			ctx,
+			network,
+			subnet,
+			cChainInfo,
+			erc20SourceAddress,
+		)
+		spokes = append(spokes, hubSpoke{subnet: subnet, address: address, bridge: bridge})
+	}
+
+	// Generate new recipient to receive bridged tokens
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// Send tokens from the hub to the recipient on each spoke
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(12))
+	for _, spoke := range spokes {
+		input := erc20source.SendTokensInput{
+			DestinationBlockchainID:  spoke.subnet.BlockchainID,
+			DestinationBridgeAddress: spoke.address,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		}
+		receipt, bridgedAmount := utils.SendERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			input,
+			amount,
+			fundedKey,
+		)
+		receipt = network.RelayMessage(
+			ctx,
+			receipt,
+			cChainInfo,
+			spoke.subnet,
+			true,
+		)
+		utils.CheckERC20DestinationWithdrawal(
+			ctx,
+			spoke.bridge,
+			receipt,
+			recipientAddress,
+			bridgedAmount,
+		)
+	}
+
+	sendBetweenHubSpokes(ctx, network, fundedKey, recipientKey, cChainInfo, spokes, amount)
+	expectHubSpokesBalanced(ctx, erc20Source.BridgedBalances, recipientAddress, spokes, amount)
+}
+
+/**
+ * Deploy a native token source on the primary network as the hub
+ * Deploys an ERC20Destination spoke to each Subnet, and further spokes until there are at least three
+ * Bridges C-Chain native tokens from the hub to the recipient on each spoke
+ * Bridges tokens between every ordered pair of spokes through multi-hop, including spokes on the same Subnet
+ * Checks that every spoke holds, and the hub tracks as bridged to it, the amount originally bridged to it
+ */
+func NativeSourceHubAndSpoke(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy a native token source on the primary network, as the hub
+	wavaxAddress, wavax := utils.DeployExampleWAVAX(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	nativeTokenSourceAddress, nativeTokenSource := utils.DeployNativeTokenSource(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		wavaxAddress,
+	)
+
+	// Token representation on the spokes will have same name, symbol, and decimals
+	tokenName, err := wavax.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := wavax.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenDecimals, err := wavax.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	// Deploy and register each spoke
+	var spokes []hubSpoke
+	for _, subnet := range hubSpokeSubnets(network) {
+		address, bridge := utils.DeployERC20Destination(
+			ctx,
+			fundedKey,
+			subnet,
+			fundedAddress,
+			cChainInfo.BlockchainID,
+			nativeTokenSourceAddress,
+			tokenName,
+			tokenSymbol,
+			tokenDecimals,
+		)
+		utils.RegisterERC20DestinationOnSource(
+			ctx,
+			network,
+			cChainInfo,
+			nativeTokenSourceAddress,
+			subnet,
+			address,
+		)
+		spokes = append(spokes, hubSpoke{subnet: subnet, address: address, bridge: bridge})
+	}
+
+	// Generate new recipient to receive bridged tokens
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// Send tokens from the hub to the recipient on each spoke
+	amount := big.NewInt(3e18)
+	for _, spoke := range spokes {
+		input := nativetokensource.SendTokensInput{
+			DestinationBlockchainID:  spoke.subnet.BlockchainID,
+			DestinationBridgeAddress: spoke.address,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   wavaxAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		}
+		receipt, bridgedAmount := utils.SendNativeTokenSource(
+			ctx,
+			cChainInfo,
+			nativeTokenSource,
+			nativeTokenSourceAddress,
+			wavax,
+			input,
+			amount,
+			fundedKey,
+		)
+		receipt = network.RelayMessage(
+			ctx,
+			receipt,
+			cChainInfo,
+			spoke.subnet,
+			true,
+		)
+		utils.CheckERC20DestinationWithdrawal(
+			ctx,
+			spoke.bridge,
+			receipt,
+			recipientAddress,
+			bridgedAmount,
+		)
+	}
+
+	sendBetweenHubSpokes(ctx, network, fundedKey, recipientKey, cChainInfo, spokes, amount)
+	expectHubSpokesBalanced(ctx, nativeTokenSource.BridgedBalances, recipientAddress, spokes, amount)
+}
+
+// Bridges an equal share of the amount held on each spoke to every other spoke through multi-hop, so that each
+// spoke sends and receives the same total
+func sendBetweenHubSpokes(
+	ctx context.Context,
+	network interfaces.Network,
+	fundedKey *ecdsa.PrivateKey,
+	recipientKey *ecdsa.PrivateKey,
+	cChainInfo interfaces.SubnetTestInfo,
+	spokes []hubSpoke,
+	amount *big.Int,
+) {
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	hopAmount := new(big.Int).Div(amount, big.NewInt(int64(len(spokes))))
+	for i, from := range spokes {
+		for j, to := range spokes {
+			if i == j {
+				continue
+			}
+			utils.SendERC20MultiHopAndVerify(
+				ctx,
+				network,
+				fundedKey,
+				recipientKey,
+				recipientAddress,
+				from.subnet,
+				from.bridge,
+				from.address,
+				to.subnet,
+				to.bridge,
+				to.address,
+				cChainInfo,
+				hopAmount,
+			)
+		}
+	}
+}
+
+// Checks that the recipient holds the amount on every spoke, and that the hub tracks the amount as bridged to
+// every spoke
+func expectHubSpokesBalanced(
+	ctx context.Context,
+	bridgedBalance func(opts *bind.CallOpts, blockchainID [32]byte, bridgeAddress common.Address) (*big.Int, error),
+	recipientAddress common.Address,
+	spokes []hubSpoke,
+	amount *big.Int,
+) {
+	for i, spoke := range spokes {
+		description := fmt.Sprintf("spoke %d at %s on %s", i, spoke.address, spoke.subnet.BlockchainID)
+
+		balance, err := spoke.bridge.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+		Expect(err).Should(BeNil())
+		Expect(balance).Should(Equal(amount), description)
+
+		bridged, err := bridgedBalance(&bind.CallOpts{Context: ctx}, spoke.subnet.BlockchainID, spoke.address)
+		Expect(err).Should(BeNil())
+		Expect(bridged).Should(Equal(amount), description)
+	}
+}
@@ -0,0 +1,357 @@
+package flows
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// The decimals shift of the destination that amounts close to the uint256 limit overflow on when scaled
+	overflowDecimalsShift         = uint8(6)
+	overflowMultiplyOnDestination = true
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network, for a token whose supply is the maximum uint256 value
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Bridges the entire supply of the token to Subnet A, and checks that the recipient receives the exact amount
+ * Bridges the entire supply back from Subnet A to the C-Chain, and checks that the exact amount is released
+ */
+func LargeAmountsNearMaxUint256(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+
+	sourceTokenAddress, sourceToken := utils.DeployMaxSupplyERC20(ctx, fundedKey, cChainInfo)
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	maxAmount := new(big.Int).Set(math.MaxBig256)
+	recipientKey := utils.NewAccountKey()
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		maxAmount,
+		fundedKey,
+	)
+	teleporterUtils.ExpectBigEqual(bridgedAmount, maxAmount)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, maxAmount)
+
+	bridgedBalance, err := erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, maxAmount)
+
+	// Bridge the entire supply back to the C-Chain
+	utils.FundAccounts(ctx, subnetAInfo, fundedKey, []common.Address{recipientAddress}, big.NewInt(1e18))
+	returnAddress := crypto.PubkeyToAddress(utils.NewAccountKey().PublicKey)
+	receipt, _ = utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		erc20Destination,
+		erc20DestinationAddress,
+		erc20destination.SendTokensInput{
+			DestinationBlockchainID:  cChainInfo.BlockchainID,
+			DestinationBridgeAddress: erc20SourceAddress,
+			Recipient:                returnAddress,
+			PrimaryFeeTokenAddress:   erc20DestinationAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		maxAmount,
+		recipientKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	utils.CheckERC20SourceWithdrawal(ctx, erc20SourceAddress, sourceToken, receipt, returnAddress, maxAmount)
+
+	balance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, returnAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, maxAmount)
+	totalSupply, err := erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(totalSupply.Sign()).Should(BeZero())
+}
+
+/**
+ * Deploy an ERC20 token source on the primary network, for a token whose supply is the maximum uint256 value
+ * Deploys NativeTokenDestination to Subnet A, multiplying amounts by a large decimals shift, and collateralizes it
+ * Deploys ERC20Destination to Subnet B, and registers it with the source
+ * On the send path, checks that bridging the largest amount that does not overflow when multiplied succeeds with
+ * the exact scaled amount, and that bridging one more reverts on overflow rather than truncating the amount
+ * On the receive path, checks that a message whose amount overflows when executed fails on overflow, and is left
+ * to be retried, rather than being executed with a truncated amount:
+ *   Subnet A only ever adds to its total minted, so bridging one token back from Subnet A, and then to Subnet A
+ *   again, overflows the total minted on receipt, although the source's bridged balance does not overflow
+ *   A multi-hop transfer from Subnet B to Subnet A of an amount that overflows when multiplied overflows on the
+ *   source, since the multi-hop is scaled when it is routed
+ */
+func ScaledAmountOverflow(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+	tokenMultiplier := utils.GetTokenMultiplier(overflowDecimalsShift)
+
+	sourceTokenAddress, sourceToken := utils.DeployMaxSupplyERC20(ctx, fundedKey, cChainInfo)
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+	nativeTokenDestinationAddress, nativeTokenDestination, collateralAmount :=
+		utils.DeployAndRegisterNativeTokenDestination(
+			ctx,
+			network,
+			subnetAInfo,
+			"SUBA",
+			cChainInfo,
+			erc20SourceAddress,
+			initialReserveImbalance,
+			overflowDecimalsShift,
+			overflowMultiplyOnDestination,
+			burnedFeesReportingRewardPercentage,
+		)
+	utils.AddCollateralToERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+		collateralAmount,
+		fundedKey,
+	)
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetBInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	// The largest amount that can be multiplied without overflowing, and the smallest that overflows
+	maxScalableAmount := new(big.Int).Div(math.MaxBig256, tokenMultiplier)
+	overflowingAmount := new(big.Int).Add(maxScalableAmount, big.NewInt(1))
+
+	recipientKey := utils.NewAccountKey()
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	sendToSubnetAInput := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: nativeTokenDestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+	}
+
+	// Sending an amount that overflows when multiplied reverts, without taking the sender's tokens
+	senderBalance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, fundedAddress)
+	Expect(err).Should(BeNil())
+	utils.ApproveIfNeeded(
+		ctx,
+		cChainInfo,
+		fundedKey,
+		sourceTokenAddress,
+		sourceToken,
+		erc20SourceAddress,
+		overflowingAmount,
+	)
+	_, err = erc20Source.Send(
+		utils.NewTransactor(ctx, cChainInfo, fundedKey),
+		sendToSubnetAInput,
+		overflowingAmount,
+	)
+	utils.ExpectArithmeticOverflow(err)
+	balance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, fundedAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, senderBalance)
+
+	// The largest amount that does not overflow is received exactly
+	scaledMaxAmount := utils.ApplyTokenScaling(tokenMultiplier, overflowMultiplyOnDestination, maxScalableAmount)
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		sendToSubnetAInput,
+		maxScalableAmount,
+		fundedKey,
+	)
+	teleporterUtils.ExpectBigEqual(bridgedAmount, scaledMaxAmount)
+	network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	teleporterUtils.CheckBalance(ctx, recipientAddress, scaledMaxAmount, subnetAInfo.RPCClient)
+
+	// Bridge a single token back to the C-Chain, which the recipient pays the gas of from the bridged tokens
+	returnAddress := crypto.PubkeyToAddress(utils.NewAccountKey().PublicKey)
+	receipt, _ = utils.SendNativeTokenDestination(
+		ctx,
+		subnetAInfo,
+		nativeTokenDestination,
+		nativeTokenDestinationAddress,
+		nativetokendestination.SendTokensInput{
+			DestinationBlockchainID:  cChainInfo.BlockchainID,
+			DestinationBridgeAddress: erc20SourceAddress,
+			Recipient:                returnAddress,
+			PrimaryFeeTokenAddress:   nativeTokenDestinationAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+		},
+		tokenMultiplier,
+		recipientKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	utils.CheckERC20SourceWithdrawal(ctx, erc20SourceAddress, sourceToken, receipt, returnAddress, big.NewInt(1))
+
+	// Bridging the token to Subnet A again fits the source's bridged balance, but overflows the total minted
+	totalMinted, err := nativeTokenDestination.TotalMinted(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(totalMinted, scaledMaxAmount)
+	receipt, _ = utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		sendToSubnetAInput,
+		big.NewInt(1),
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	failedMessage := utils.ExpectMessageExecutionOverflow(ctx, cChainInfo, subnetAInfo, receipt)
+	expectOverflowingRetryFails(ctx, cChainInfo, subnetAInfo, failedMessage, fundedKey)
+	newTotalMinted, err := nativeTokenDestination.TotalMinted(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(newTotalMinted, totalMinted)
+
+	// Bridge an amount that overflows when multiplied to Subnet B, which does not scale amounts
+	subnetBSenderKey := utils.NewAccountKey()
+	subnetBSenderAddress := crypto.PubkeyToAddress(subnetBSenderKey.PublicKey)
+	receipt, _ = utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetBInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                subnetBSenderAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		overflowingAmount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetBInfo, true)
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, subnetBSenderAddress, overflowingAmount)
+
+	// Routing the amount on to Subnet A through a multi-hop transfer overflows on the source
+	utils.FundAccounts(ctx, subnetBInfo, fundedKey, []common.Address{subnetBSenderAddress}, big.NewInt(1e18))
+	receipt, _ = utils.SendERC20Destination(
+		ctx,
+		subnetBInfo,
+		erc20Destination,
+		erc20DestinationAddress,
+		erc20destination.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: nativeTokenDestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   erc20DestinationAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+			MultiHopFallback:         subnetBSenderAddress,
+		},
+		overflowingAmount,
+		subnetBSenderKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetBInfo, cChainInfo, true)
+	failedMessage = utils.ExpectMessageExecutionOverflow(ctx, subnetBInfo, cChainInfo, receipt)
+	expectOverflowingRetryFails(ctx, subnetBInfo, cChainInfo, failedMessage, fundedKey)
+
+	// Neither the bridged balance of Subnet B, nor that of Subnet A, changed when the multi-hop failed
+	bridgedBalance, err := erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetBInfo.BlockchainID,
+		erc20DestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, overflowingAmount)
+	bridgedBalance, err = erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, scaledMaxAmount)
+}
+
+// Checks that retrying the message that failed on overflow fails again
+func expectOverflowingRetryFails(
+	ctx context.Context,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	message teleportermessenger.TeleporterMessage,
+	senderKey *ecdsa.PrivateKey,
+) {
+	_, err := destination.TeleporterMessenger.RetryMessageExecution(
+		utils.NewTransactor(ctx, destination, senderKey),
+		source.BlockchainID,
+		message,
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrRetryExecutionFailed)))
+}
@@ -0,0 +1,126 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploys an ERC20Destination and a NativeTokenDestination to Subnet A, each with the funded account on the C-Chain
+ * as its token source, so that the funded account can send them arbitrary Teleporter messages
+ * Sends each destination truncated, over-long, and wrong-typed bridge messages, and relays them
+ * Check that the execution of each message fails without the destination emitting any events,
+ * and that retrying its execution fails
+ * Check that no tokens were minted, and the destinations are still unregistered
+ * Sends the ERC20Destination a well formed message from the same account, and checks that it mints the tokens
+ */
+func MalformedBridgeMessages(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		fundedAddress,
+		"Malformed",
+		"MAL",
+		18,
+	)
+
+	// The NativeTokenDestination never mints native tokens, so does not need one of the Native Minter deployer keys
+	nativeTokenDestinationAddress, nativeTokenDestination := utils.DeployNativeTokenDestinationWithKey(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		"SUBA",
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		fundedAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
+	)
+
+	// Generate new recipient to receive bridged tokens
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	malformedMessages := utils.MalformedBridgeMessages(recipientAddress, amount)
+	for _, destinationAddress := range []common.Address{erc20DestinationAddress, nativeTokenDestinationAddress} {
+		for _, malformed := range malformedMessages {
+			message := utils.DeliverMalformedBridgeMessage(
+				ctx,
+				network,
+				cChainInfo,
+				subnetAInfo,
+				destinationAddress,
+				malformed,
+				fundedKey,
+			)
+
+			// The message fails again when retried, rather than being partially executed
+			_, err := subnetAInfo.TeleporterMessenger.RetryMessageExecution(
+				utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+				cChainInfo.BlockchainID,
+				message,
+			)
+			Expect(err).Should(
+				MatchError(ContainSubstring(errors.ErrRetryExecutionFailed)),
+				"retrying %s", malformed.Name,
+			)
+		}
+	}
+
+	// None of the messages minted tokens, or registered the destinations
+	totalSupply, err := erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(totalSupply.Uint64()).Should(BeZero())
+	registered, err := erc20Destination.IsRegistered(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(registered).Should(BeFalse())
+
+	totalMinted, err := nativeTokenDestination.TotalMinted(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(totalMinted.Uint64()).Should(BeZero())
+	registered, err = nativeTokenDestination.IsRegistered(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(registered).Should(BeFalse())
+
+	// A well formed message from the same token source is accepted
+	sendReceipt, _ := utils.SendTeleporterMessage(
+		ctx,
+		cChainInfo,
+		subnetAInfo,
+		erc20DestinationAddress,
+		utils.PackBridgeMessage(messages.SingleHopSend, utils.PackSingleHopSendMessage(recipientAddress, amount)),
+		utils.DefaultERC20RequiredGas,
+		fundedKey,
+	)
+	receipt := network.RelayMessage(ctx, sendReceipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		amount,
+	)
+}
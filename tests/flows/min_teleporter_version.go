@@ -0,0 +1,294 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	runner_sdk "github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Deploys a new version of Teleporter to all chains, and registers it with the TeleporterRegistry on Subnet A
+ * Check that only the owner of ERC20Destination can update its minimum Teleporter version
+ * Bridges C-Chain example ERC20 tokens to Subnet A with the old Teleporter version, which are delivered
+ * Updates the minimum Teleporter version of ERC20Destination to the new version
+ * Bridges C-Chain example ERC20 tokens to Subnet A with the old Teleporter version, which fail to execute
+ * Registers the new Teleporter version on the remaining chains, and bridges tokens with it, which are delivered
+ *
+ * The new Teleporter version remains the network's Teleporter version for the rest of the suite,
+ * so this flow can only be run once per network.
+ */
+func MinTeleporterVersion(network interfaces.LocalNetwork, teleporterByteCodeFile string) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	oldTeleporterAddress := network.GetTeleporterContractAddress()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		tokenName,
+		tokenSymbol,
+		tokenDecimals,
+	)
+
+	utils.RegisterERC20DestinationOnSource(
+		ctx,
+		network,
+		cChainInfo,
+		erc20SourceAddress,
+		subnetAInfo,
+		erc20DestinationAddress,
+	)
+
+	// Deploy the new version of Teleporter, and restart the nodes with the off-chain messages to register it
+	newTeleporterAddress := teleporterUtils.DeployNewTeleporterVersion(ctx, network, fundedKey, teleporterByteCodeFile)
+	networkID := network.GetNetworkID()
+	offChainMessageC, chainConfigC := teleporterUtils.InitOffChainMessageChainConfig(
+		networkID,
+		cChainInfo,
+		newTeleporterAddress,
+		2,
+	)
+	offChainMessageA, chainConfigA := teleporterUtils.InitOffChainMessageChainConfig(
+		networkID,
+		subnetAInfo,
+		newTeleporterAddress,
+		2,
+	)
+	offChainMessageB, chainConfigB := teleporterUtils.InitOffChainMessageChainConfig(
+		networkID,
+		subnetBInfo,
+		newTeleporterAddress,
+		2,
+	)
+	chainConfigs := make(map[string]string)
+	teleporterUtils.SetChainConfig(chainConfigs, cChainInfo, chainConfigC)
+	teleporterUtils.SetChainConfig(chainConfigs, subnetAInfo, chainConfigA)
+	teleporterUtils.SetChainConfig(chainConfigs, subnetBInfo, chainConfigB)
+	network.RestartNodes(ctx, network.GetAllNodeNames(), runner_sdk.WithChainConfigs(chainConfigs))
+
+	teleporterUtils.AddProtocolVersionAndWaitForAcceptance(
+		ctx,
+		network,
+		subnetAInfo,
+		newTeleporterAddress,
+		fundedKey,
+		offChainMessageA,
+	)
+	latestVersionA, err := subnetAInfo.TeleporterRegistry.LatestVersion(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(1e18),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	// Bridge tokens with the old Teleporter version, which are delivered since the minimum version is unchanged
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		bridgedAmount,
+	)
+	totalBridged := new(big.Int).Set(bridgedAmount)
+
+	// Only the owner can update the minimum Teleporter version
+	nonOwnerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	teleporterUtils.SendNativeTransfer(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		crypto.PubkeyToAddress(nonOwnerKey.PublicKey),
+		big.NewInt(1e18),
+	)
+	_, err = erc20Destination.UpdateMinTeleporterVersion(
+		utils.NewTransactor(ctx, subnetAInfo, nonOwnerKey),
+		latestVersionA,
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+
+	// The minimum version cannot be set above the latest registered version
+	_, err = erc20Destination.UpdateMinTeleporterVersion(
+		utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		new(big.Int).Add(latestVersionA, big.NewInt(1)),
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrInvalidTeleporterVersion)))
+
+	oldMinVersion, err := erc20Destination.GetMinTeleporterVersion(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	receipt = utils.TransactAndWaitForSuccess(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.UpdateMinTeleporterVersion(opts, latestVersionA)
+		},
+	)
+	updatedEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		erc20Destination.ParseMinTeleporterVersionUpdated,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(updatedEvent.OldMinTeleporterVersion, oldMinVersion)
+	teleporterUtils.ExpectBigEqual(updatedEvent.NewMinTeleporterVersion, latestVersionA)
+
+	// Bridge tokens with the old Teleporter version, which is no longer allowed to deliver to ERC20Destination.
+	// The message is received by Teleporter, but its execution fails, so no tokens are minted.
+	receipt, _ = utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	failedEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		subnetAInfo.TeleporterMessenger.ParseMessageExecutionFailed,
+	)
+	Expect(err).Should(BeNil())
+	Expect(failedEvent.MessageID).Should(Equal(sendEvent.MessageID))
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseTransfer)
+	Expect(err).ShouldNot(BeNil())
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, totalBridged)
+
+	// Retrying the execution through the old Teleporter version also fails
+	_, err = subnetAInfo.TeleporterMessenger.RetryMessageExecution(
+		utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		cChainInfo.BlockchainID,
+		sendEvent.Message,
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrRetryExecutionFailed)))
+
+	// Register the new Teleporter version on the remaining chains, and use it for the rest of the flow
+	teleporterUtils.AddProtocolVersionAndWaitForAcceptance(
+		ctx,
+		network,
+		cChainInfo,
+		newTeleporterAddress,
+		fundedKey,
+		offChainMessageC,
+	)
+	teleporterUtils.AddProtocolVersionAndWaitForAcceptance(
+		ctx,
+		network,
+		subnetBInfo,
+		newTeleporterAddress,
+		fundedKey,
+		offChainMessageB,
+	)
+	network.SetTeleporterContractAddress(newTeleporterAddress)
+	cChainInfo = network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ = teleporterUtils.GetTwoSubnets(network)
+
+	// Bridge tokens with the new Teleporter version, which are delivered
+	receipt, bridgedAmount = utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	Expect(receipt.Logs).Should(ContainElement(
+		HaveField("Address", newTeleporterAddress),
+	))
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		bridgedAmount,
+	)
+	totalBridged.Add(totalBridged, bridgedAmount)
+
+	balance, err = erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, totalBridged)
+
+	// The old Teleporter version is still registered, but is below the minimum version
+	oldVersion, err := subnetAInfo.TeleporterRegistry.GetVersionFromAddress(
+		&bind.CallOpts{Context: ctx},
+		oldTeleporterAddress,
+	)
+	Expect(err).Should(BeNil())
+	minVersion, err := erc20Destination.GetMinTeleporterVersion(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(oldVersion.Cmp(minVersion)).Should(Equal(-1))
+}
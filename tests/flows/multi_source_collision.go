@@ -0,0 +1,259 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploys an ERC20 token source on the primary network and another on Subnet B, for tokens with the same
+ * name, symbol and decimals
+ * Deploys an ERC20Destination to Subnet A for each source
+ * Bridges the same amount from each source to the same recipient on Subnet A
+ * Check that each transfer is only minted by the destination of its source
+ * Bridges the same amount back from each destination, which is only released by its own source
+ * Sends tokens from the primary network's destination to the other source's destination through multi-hop,
+ * which is not a destination of its source, so the tokens are sent to the fallback recipient of the first hop
+ */
+func MultiSourceCollision(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy the same example ERC20 token as the source token on the primary network and Subnet B
+	sourceTokenAddressC, sourceTokenC := teleporterUtils.DeployExampleERC20(ctx, fundedKey, cChainInfo)
+	sourceTokenAddressB, sourceTokenB := teleporterUtils.DeployExampleERC20(ctx, fundedKey, subnetBInfo)
+	sourceAddressC, sourceC := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		crypto.PubkeyToAddress(fundedKey.PublicKey),
+		sourceTokenAddressC,
+	)
+	sourceAddressB, sourceB := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		subnetBInfo,
+		crypto.PubkeyToAddress(fundedKey.PublicKey),
+		sourceTokenAddressB,
+	)
+	Expect(utils.GetERC20SourceTokenMetadata(ctx, subnetBInfo, sourceAddressB)).Should(
+		Equal(utils.GetERC20SourceTokenMetadata(ctx, cChainInfo, sourceAddressC)),
+	)
+
+	destinationAddressC, destinationC := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		sourceAddressC,
+	)
+	destinationAddressB, destinationB := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		subnetBInfo,
+		sourceAddressB,
+	)
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+	fee := big.NewInt(1e18)
+
+	// Bridge the same amount from each source to the same recipient
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		sourceC,
+		sourceAddressC,
+		sourceTokenC,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: destinationAddressC,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddressC,
+			PrimaryFee:               fee,
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		amount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	expectOnlyTransfersOf(receipt, destinationAddressC)
+	utils.CheckERC20DestinationWithdrawal(ctx, destinationC, receipt, recipientAddress, bridgedAmount)
+
+	receipt, bridgedAmountB := utils.SendERC20Source(
+		ctx,
+		subnetBInfo,
+		sourceB,
+		sourceAddressB,
+		sourceTokenB,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: destinationAddressB,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddressB,
+			PrimaryFee:               fee,
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		amount,
+		fundedKey,
+	)
+	teleporterUtils.ExpectBigEqual(bridgedAmountB, bridgedAmount)
+	receipt = network.RelayMessage(ctx, receipt, subnetBInfo, subnetAInfo, true)
+	expectOnlyTransfersOf(receipt, destinationAddressB)
+	utils.CheckERC20DestinationWithdrawal(ctx, destinationB, receipt, recipientAddress, bridgedAmount)
+
+	// Each destination only minted its own source's transfer
+	expectSupplyAndBalance(ctx, destinationC, recipientAddress, bridgedAmount)
+	expectSupplyAndBalance(ctx, destinationB, recipientAddress, bridgedAmount)
+
+	// Bridge the same amount back from each destination, which is only released by its own source
+	teleporterUtils.SendNativeTransfer(ctx, subnetAInfo, fundedKey, recipientAddress, big.NewInt(1e18))
+	returnedAmount := new(big.Int).Div(bridgedAmount, big.NewInt(4))
+	receipt, returnedAmountC := utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		destinationC,
+		destinationAddressC,
+		erc20destination.SendTokensInput{
+			DestinationBlockchainID:  cChainInfo.BlockchainID,
+			DestinationBridgeAddress: sourceAddressC,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   destinationAddressC,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		returnedAmount,
+		recipientKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	expectOnlyTransfersOf(receipt, sourceTokenAddressC)
+	utils.CheckERC20SourceWithdrawal(ctx, sourceAddressC, sourceTokenC, receipt, recipientAddress, returnedAmountC)
+
+	receipt, returnedAmountB := utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		destinationB,
+		destinationAddressB,
+		erc20destination.SendTokensInput{
+			DestinationBlockchainID:  subnetBInfo.BlockchainID,
+			DestinationBridgeAddress: sourceAddressB,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   destinationAddressB,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		returnedAmount,
+		recipientKey,
+	)
+	teleporterUtils.ExpectBigEqual(returnedAmountB, returnedAmountC)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, subnetBInfo, true)
+	expectOnlyTransfersOf(receipt, sourceTokenAddressB)
+	utils.CheckERC20SourceWithdrawal(ctx, sourceAddressB, sourceTokenB, receipt, recipientAddress, returnedAmountB)
+
+	remainingAmount := teleporterUtils.BigIntSub(bridgedAmount, returnedAmount)
+	expectSupplyAndBalance(ctx, destinationC, recipientAddress, remainingAmount)
+	expectSupplyAndBalance(ctx, destinationB, recipientAddress, remainingAmount)
+	expectBridgedBalance(ctx, sourceC, subnetAInfo, destinationAddressC, remainingAmount)
+	expectBridgedBalance(ctx, sourceB, subnetAInfo, destinationAddressB, remainingAmount)
+
+	// The other source's destination is not registered with the primary network's source, so a multi-hop
+	// transfer to it is sent to the fallback recipient on the primary network, rather than minted by it
+	fallbackKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	fallbackAddress := crypto.PubkeyToAddress(fallbackKey.PublicKey)
+	receipt, multiHopAmount := utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		destinationC,
+		destinationAddressC,
+		erc20destination.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: destinationAddressB,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   destinationAddressC,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+			MultiHopFallback:         fallbackAddress,
+		},
+		returnedAmount,
+		recipientKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	expectOnlyTransfersOf(receipt, sourceTokenAddressC)
+	utils.CheckERC20SourceWithdrawal(ctx, sourceAddressC, sourceTokenC, receipt, fallbackAddress, multiHopAmount)
+	// No second hop was sent, so nothing can be relayed to the other source's destination
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, sourceC.ParseTokensSent)
+	Expect(err).ShouldNot(BeNil())
+
+	teleporterUtils.ExpectBigEqual(multiHopAmount, returnedAmount)
+	expectSupplyAndBalance(ctx, destinationC, recipientAddress, teleporterUtils.BigIntSub(remainingAmount, returnedAmount))
+	expectSupplyAndBalance(ctx, destinationB, recipientAddress, remainingAmount)
+	expectBridgedBalance(ctx, sourceB, subnetAInfo, destinationAddressB, remainingAmount)
+	fallbackBalance, err := sourceTokenB.BalanceOf(&bind.CallOpts{Context: ctx}, fallbackAddress)
+	Expect(err).Should(BeNil())
+	Expect(fallbackBalance.Sign()).Should(BeZero())
+}
+
+// Checks that every token transfer in the receipt is of the token at tokenAddress, so that the tokens of
+// another bridge sharing the chain were not moved
+func expectOnlyTransfersOf(receipt *types.Receipt, tokenAddress common.Address) {
+	tokenABI, err := exampleerc20.ExampleERC20MetaData.GetAbi()
+	Expect(err).Should(BeNil())
+	transferID := tokenABI.Events["Transfer"].ID
+	for _, log := range receipt.Logs {
+		if len(log.Topics) > 0 && log.Topics[0] == transferID {
+			Expect(log.Address).Should(Equal(tokenAddress))
+		}
+	}
+}
+
+// Checks that the destination's whole supply is held by the recipient
+func expectSupplyAndBalance(
+	ctx context.Context,
+	destination *erc20destination.ERC20Destination,
+	recipientAddress common.Address,
+	expectedAmount *big.Int,
+) {
+	balance, err := destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, expectedAmount)
+	totalSupply, err := destination.TotalSupply(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(totalSupply, expectedAmount)
+}
+
+// Checks the balance the source has bridged to the destination
+func expectBridgedBalance(
+	ctx context.Context,
+	source *erc20source.ERC20Source,
+	destination interfaces.SubnetTestInfo,
+	destinationAddress common.Address,
+	expectedAmount *big.Int,
+) {
+	balance, err := source.BridgedBalances(&bind.CallOpts{Context: ctx}, destination.BlockchainID, destinationAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, expectedAmount)
+}
@@ -21,6 +21,15 @@ import (
  * Bridge back tokens from Subnet A to C-Chain
  */
 func NativeSourceERC20Destination(network interfaces.Network) {
+	NativeSourceERC20DestinationVersions(network, utils.CurrentVersion, utils.CurrentVersion)
+}
+
+// NativeSourceERC20DestinationVersions runs NativeSourceERC20Destination with the given versions of each contract
+func NativeSourceERC20DestinationVersions(
+	network interfaces.Network,
+	sourceVersion utils.ContractVersion,
+	destinationVersion utils.ContractVersion,
+) {
 	cChainInfo := network.GetPrimaryNetworkInfo()
 	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
 	fundedAddress, fundedKey := network.GetFundedAccountInfo()
@@ -35,27 +44,29 @@ func NativeSourceERC20Destination(network interfaces.Network) {
 	)
 
 	// Create a NativeTokenSource for bridging the native token
-	nativeTokenSourceAddress, nativeTokenSource := utils.DeployNativeTokenSource(
+	nativeTokenSourceAddress, nativeTokenSource := utils.DeployNativeTokenSourceVersion(
 		ctx,
 		fundedKey,
 		cChainInfo,
+		sourceVersion,
 		fundedAddress,
 		wavaxAddress,
 	)
 
 	// Token representation on subnet A will have same name, symbol, and decimals
-	tokenName, err := wavax.Name(&bind.CallOpts{})
+	tokenName, err := wavax.Name(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
-	tokenSymbol, err := wavax.Symbol(&bind.CallOpts{})
+	tokenSymbol, err := wavax.Symbol(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
-	tokenDecimals, err := wavax.Decimals(&bind.CallOpts{})
+	tokenDecimals, err := wavax.Decimals(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
 
 	// Deploy an ERC20Destination to Subnet A
-	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
+	erc20DestinationAddress, erc20Destination := utils.DeployERC20DestinationVersion(
 		ctx,
 		fundedKey,
 		subnetAInfo,
+		destinationVersion,
 		fundedAddress,
 		cChainInfo.BlockchainID,
 		nativeTokenSourceAddress,
@@ -120,7 +131,7 @@ func NativeSourceERC20Destination(network interfaces.Network) {
 	)
 
 	// Check that the recipient received the tokens
-	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{}, recipientAddress)
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 	Expect(err).Should(BeNil())
 	Expect(balance).Should(Equal(bridgedAmount))
 
@@ -44,11 +44,11 @@ func NativeSourceERC20DestinationMultiHop(network interfaces.Network) {
 	)
 
 	// Token representation on subnet B will have same name, symbol, and decimals
-	tokenName, err := wavax.Name(&bind.CallOpts{})
+	tokenName, err := wavax.Name(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
-	tokenSymbol, err := wavax.Symbol(&bind.CallOpts{})
+	tokenSymbol, err := wavax.Symbol(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
-	tokenDecimals, err := wavax.Decimals(&bind.CallOpts{})
+	tokenDecimals, err := wavax.Decimals(&bind.CallOpts{Context: ctx})
 	Expect(err).Should(BeNil())
 
 	// Deploy an ERC20Destination on Subnet A
@@ -143,7 +143,7 @@ func NativeSourceERC20DestinationMultiHop(network interfaces.Network) {
 	)
 
 	// Check that the recipient received the tokens
-	balance, err := erc20Destination_A.BalanceOf(&bind.CallOpts{}, recipientAddress)
+	balance, err := erc20Destination_A.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 	Expect(err).Should(BeNil())
 	Expect(balance).Should(Equal(bridgedAmount))
 
@@ -51,32 +51,20 @@ func NativeSourceNativeDestination(network interfaces.Network) {
 		cChainWAVAXAddress,
 	)
 
-	// Deploy a NativeTokenDestination to Subnet A
-	nativeTokenDestinationAddress, nativeTokenDestination := utils.DeployNativeTokenDestination(
-		ctx,
-		subnetAInfo,
-		"SUBA",
-		fundedAddress,
-		cChainInfo.BlockchainID,
-		nativeTokenSourceAddress,
-		initialReserveImbalance,
-		decimalsShift,
-		multiplyOnDestination,
-		burnedFeesReportingRewardPercentage,
-	)
-
-	// Register the NativeTokenDestination on the NativeTokenSource
-	collateralAmount := utils.RegisterTokenDestinationOnSource(
-		ctx,
-		network,
-		cChainInfo,
-		nativeTokenSourceAddress,
-		subnetAInfo,
-		nativeTokenDestinationAddress,
-		initialReserveImbalance,
-		utils.GetTokenMultiplier(decimalsShift),
-		multiplyOnDestination,
-	)
+	// Deploy a NativeTokenDestination to Subnet A, and register it with the NativeTokenSource
+	nativeTokenDestinationAddress, nativeTokenDestination, collateralAmount :=
+		utils.DeployAndRegisterNativeTokenDestination(
+			ctx,
+			network,
+			subnetAInfo,
+			"SUBA",
+			cChainInfo,
+			nativeTokenSourceAddress,
+			initialReserveImbalance,
+			decimalsShift,
+			multiplyOnDestination,
+			burnedFeesReportingRewardPercentage,
+		)
 
 	utils.AddCollateralToNativeTokenSource(
 		ctx,
@@ -0,0 +1,202 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy a native token source on the primary network
+ * Deploys a native token destination to Subnet A
+ * Deploys a contract that cannot receive native tokens to both C-Chain and Subnet A
+ * Bridges C-Chain native tokens to Subnet A through sendAndCall, with the contract as both the recipient contract
+ * and the fallback recipient, and checks that the tokens are held for the fallback recipient to be claimed
+ * Claims the tokens through the SDK, and checks that they are credited to the contract as the wrapped native token
+ * Bridges tokens from Subnet A back to C-Chain with the contract as the recipient, and checks that the tokens are
+ * held for it to be claimed
+ * Claims the tokens through the SDK, and checks that the contract is sent C-Chain WAVAX
+ */
+func NativeWithdrawalClaim(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an example WAVAX on the primary network
+	cChainWAVAXAddress, wavax := utils.DeployExampleWAVAX(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	// Create a NativeTokenSource on the primary network
+	nativeTokenSourceAddress, nativeTokenSource := utils.DeployNativeTokenSource(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		cChainWAVAXAddress,
+	)
+
+	// Deploy a NativeTokenDestination to Subnet A, and register it with the NativeTokenSource
+	nativeTokenDestinationAddress, nativeTokenDestination, collateralAmount :=
+		utils.DeployAndRegisterNativeTokenDestination(
+			ctx,
+			network,
+			subnetAInfo,
+			"SUBA",
+			cChainInfo,
+			nativeTokenSourceAddress,
+			initialReserveImbalance,
+			decimalsShift,
+			multiplyOnDestination,
+			burnedFeesReportingRewardPercentage,
+		)
+
+	utils.AddCollateralToNativeTokenSource(
+		ctx,
+		cChainInfo,
+		nativeTokenSource,
+		nativeTokenSourceAddress,
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+		collateralAmount,
+		fundedKey,
+	)
+
+	// ExampleERC20 has neither a receive nor a fallback function, so cannot receive native tokens
+	cChainNonPayableAddress, _ := teleporterUtils.DeployExampleERC20(ctx, fundedKey, cChainInfo)
+	subnetANonPayableAddress, _ := teleporterUtils.DeployExampleERC20(ctx, fundedKey, subnetAInfo)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: nativeTokenSourceAddress,
+		Type:    events.NativeTokenSource,
+	}
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: nativeTokenDestinationAddress,
+		Type:    events.NativeTokenDestination,
+	}
+
+	// Send tokens from C-Chain through sendAndCall. The call fails, since the recipient contract does not implement
+	// receiveTokens, and the fallback recipient cannot receive the native tokens either.
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10))
+	receipt, bridgedAmount := utils.SendAndCallNativeTokenSource(
+		ctx,
+		cChainInfo,
+		nativeTokenSource,
+		nativeTokenSourceAddress,
+		nativetokensource.SendAndCallInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: nativeTokenDestinationAddress,
+			RecipientContract:        subnetANonPayableAddress,
+			RecipientPayload:         []byte{1},
+			RequiredGasLimit:         teleporterUtils.BigIntMul(big.NewInt(10), utils.DefaultNativeTokenRequiredGas),
+			RecipientGasLimit:        teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultNativeTokenRequiredGas),
+			FallbackRecipient:        subnetANonPayableAddress,
+			PrimaryFeeTokenAddress:   cChainWAVAXAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+		},
+		amount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+
+	_, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenDestination.ParseCallFailed)
+	Expect(err).Should(BeNil())
+	failedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenDestination.ParseWithdrawalFailed)
+	Expect(err).Should(BeNil())
+	Expect(failedEvent.Recipient).Should(Equal(subnetANonPayableAddress))
+	teleporterUtils.ExpectBigEqual(failedEvent.Amount, bridgedAmount)
+
+	claimable, err := bridge.ClaimableWithdrawal(ctx, destination, subnetANonPayableAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(claimable, bridgedAmount)
+
+	// Anyone can claim the tokens, which are credited to the contract as the wrapped native token
+	receipt, err = bridge.Claim(
+		ctx,
+		utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		destination,
+		subnetANonPayableAddress,
+	)
+	Expect(err).Should(BeNil())
+	claimedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenDestination.ParseWithdrawalClaimed)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(claimedEvent.Amount, bridgedAmount)
+
+	wrappedBalance, err := nativeTokenDestination.BalanceOf(&bind.CallOpts{Context: ctx}, subnetANonPayableAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(wrappedBalance, bridgedAmount)
+
+	_, err = nativeTokenDestination.ClaimWithdrawal(
+		utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		subnetANonPayableAddress,
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrNoClaimableWithdrawal)))
+
+	// Send half of the tokens from Subnet A back to the contract on C-Chain, which cannot receive them
+	receipt, bridgedAmount = utils.SendNativeTokenDestination(
+		ctx,
+		subnetAInfo,
+		nativeTokenDestination,
+		nativeTokenDestinationAddress,
+		nativetokendestination.SendTokensInput{
+			DestinationBlockchainID:  cChainInfo.BlockchainID,
+			DestinationBridgeAddress: nativeTokenSourceAddress,
+			Recipient:                cChainNonPayableAddress,
+			PrimaryFeeTokenAddress:   nativeTokenDestinationAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+		},
+		new(big.Int).Div(bridgedAmount, big.NewInt(2)),
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+
+	sourceAmount := utils.RemoveTokenScaling(tokenMultiplier, multiplyOnDestination, bridgedAmount)
+	utils.CheckNativeTokenSourceWithdrawal(ctx, nativeTokenSourceAddress, wavax, receipt, sourceAmount)
+	sourceFailedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenSource.ParseWithdrawalFailed)
+	Expect(err).Should(BeNil())
+	Expect(sourceFailedEvent.Recipient).Should(Equal(cChainNonPayableAddress))
+	teleporterUtils.ExpectBigEqual(sourceFailedEvent.Amount, sourceAmount)
+
+	claimable, err = bridge.ClaimableWithdrawal(ctx, source, cChainNonPayableAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(claimable, sourceAmount)
+
+	// Claim the tokens, which are sent to the contract as WAVAX
+	_, err = bridge.Claim(ctx, utils.NewTransactor(ctx, cChainInfo, fundedKey), source, cChainNonPayableAddress)
+	Expect(err).Should(BeNil())
+
+	wavaxBalance, err := wavax.BalanceOf(&bind.CallOpts{Context: ctx}, cChainNonPayableAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(wavaxBalance, sourceAmount)
+
+	claimable, err = bridge.ClaimableWithdrawal(ctx, source, cChainNonPayableAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(claimable, big.NewInt(0))
+}
@@ -0,0 +1,281 @@
+package flows
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+// ownableBridge is the set of owner-gated functions that every bridge contract inherits from
+// TeleporterOwnerUpgradeable, which have the same signature in each of the generated bindings.
+type ownableBridge interface {
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	GetMinTeleporterVersion(opts *bind.CallOpts) (*big.Int, error)
+	IsTeleporterAddressPaused(opts *bind.CallOpts, teleporterAddress common.Address) (bool, error)
+	UpdateMinTeleporterVersion(opts *bind.TransactOpts, version *big.Int) (*types.Transaction, error)
+	PauseTeleporterAddress(opts *bind.TransactOpts, teleporterAddress common.Address) (*types.Transaction, error)
+	UnpauseTeleporterAddress(opts *bind.TransactOpts, teleporterAddress common.Address) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+/**
+ * Deploy an ERC20Source and a NativeTokenSource on the primary network
+ * Deploys an ERC20Destination and a NativeTokenDestination to Subnet A
+ * For each bridge contract:
+ *   Check that a non-owner cannot call any of the owner-gated functions
+ *   Check that the owner can pause and unpause the Teleporter address, and passes the access check
+ *   when updating the minimum Teleporter version
+ *   Transfers ownership to the non-owner, and checks that only the new owner can call the owner-gated functions
+ *   Renounces ownership, and checks that no one can call the owner-gated functions
+ */
+func OwnershipAndAccessControl(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	teleporterAddress := network.GetTeleporterContractAddress()
+
+	ctx := context.Background()
+
+	// Deploy the source bridges on the primary network
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	cChainWAVAXAddress, _ := utils.DeployExampleWAVAX(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+	nativeTokenSourceAddress, nativeTokenSource := utils.DeployNativeTokenSource(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		cChainWAVAXAddress,
+	)
+
+	// Deploy the destination bridges to Subnet A
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	_, erc20Destination := utils.DeployERC20Destination(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		tokenName,
+		tokenSymbol,
+		tokenDecimals,
+	)
+
+	// The NativeTokenDestination never mints native tokens, so does not need one of the Native Minter deployer keys
+	_, nativeTokenDestination := utils.DeployNativeTokenDestinationWithKey(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		"SUBA",
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		nativeTokenSourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
+	)
+
+	// Fund a separate account on both chains, which starts as a non-owner and later becomes the owner
+	nonOwnerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	nonOwnerAddress := crypto.PubkeyToAddress(nonOwnerKey.PublicKey)
+	for _, subnet := range []interfaces.SubnetTestInfo{cChainInfo, subnetAInfo} {
+		teleporterUtils.SendNativeTransfer(
+			ctx,
+			subnet,
+			fundedKey,
+			nonOwnerAddress,
+			big.NewInt(1e18),
+		)
+	}
+
+	checkOwnershipAndAccessControl(ctx, cChainInfo, teleporterAddress, erc20Source, fundedKey, nonOwnerKey)
+	checkOwnershipAndAccessControl(ctx, cChainInfo, teleporterAddress, nativeTokenSource, fundedKey, nonOwnerKey)
+	checkOwnershipAndAccessControl(ctx, subnetAInfo, teleporterAddress, erc20Destination, fundedKey, nonOwnerKey)
+	checkOwnershipAndAccessControl(ctx, subnetAInfo, teleporterAddress, nativeTokenDestination, fundedKey, nonOwnerKey)
+}
+
+// Checks the owner-gated functions of a bridge owned by ownerKey, transferring its ownership
+// to newOwnerKey, and then renouncing it.
+func checkOwnershipAndAccessControl(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	teleporterAddress common.Address,
+	bridge ownableBridge,
+	ownerKey *ecdsa.PrivateKey,
+	newOwnerKey *ecdsa.PrivateKey,
+) {
+	ownerAddress := crypto.PubkeyToAddress(ownerKey.PublicKey)
+	newOwnerAddress := crypto.PubkeyToAddress(newOwnerKey.PublicKey)
+
+	owner, err := bridge.Owner(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(owner).Should(Equal(ownerAddress))
+
+	// The new owner is not yet the owner, so cannot call any of the owner-gated functions
+	expectOwnerGatedCallsRejected(ctx, subnet, teleporterAddress, bridge, newOwnerKey)
+
+	// The owner can call each of the owner-gated functions
+	checkOwnerCalls(ctx, subnet, teleporterAddress, bridge, ownerKey)
+
+	// Transfer ownership, after which only the new owner can call the owner-gated functions
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		ownerKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return bridge.TransferOwnership(opts, newOwnerAddress)
+		},
+	)
+	owner, err = bridge.Owner(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(owner).Should(Equal(newOwnerAddress))
+
+	expectOwnerGatedCallsRejected(ctx, subnet, teleporterAddress, bridge, ownerKey)
+	checkOwnerCalls(ctx, subnet, teleporterAddress, bridge, newOwnerKey)
+
+	// Renounce ownership, after which no one can call the owner-gated functions
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		newOwnerKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return bridge.RenounceOwnership(opts)
+		},
+	)
+	owner, err = bridge.Owner(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	Expect(owner).Should(Equal(common.Address{}))
+
+	expectOwnerGatedCallsRejected(ctx, subnet, teleporterAddress, bridge, ownerKey)
+	expectOwnerGatedCallsRejected(ctx, subnet, teleporterAddress, bridge, newOwnerKey)
+}
+
+// Checks that each of the owner-gated functions of the bridge reverts when called by senderKey.
+// Transactions are only estimated, since a reverting call fails gas estimation before being sent.
+func expectOwnerGatedCallsRejected(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	teleporterAddress common.Address,
+	bridge ownableBridge,
+	senderKey *ecdsa.PrivateKey,
+) {
+	minVersion, err := bridge.GetMinTeleporterVersion(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	_, err = bridge.UpdateMinTeleporterVersion(
+		utils.NewTransactor(ctx, subnet, senderKey),
+		new(big.Int).Add(minVersion, big.NewInt(1)),
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+
+	_, err = bridge.PauseTeleporterAddress(utils.NewTransactor(ctx, subnet, senderKey), teleporterAddress)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+
+	_, err = bridge.UnpauseTeleporterAddress(utils.NewTransactor(ctx, subnet, senderKey), teleporterAddress)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+
+	_, err = bridge.TransferOwnership(
+		utils.NewTransactor(ctx, subnet, senderKey),
+		crypto.PubkeyToAddress(senderKey.PublicKey),
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+
+	_, err = bridge.RenounceOwnership(utils.NewTransactor(ctx, subnet, senderKey))
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+
+	// None of the rejected calls changed the state of the bridge
+	paused, err := bridge.IsTeleporterAddressPaused(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	Expect(paused).Should(BeFalse())
+	newMinVersion, err := bridge.GetMinTeleporterVersion(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(newMinVersion, minVersion)
+}
+
+// Checks that the owner-gated functions of the bridge that leave its ownership unchanged
+// succeed when called by ownerKey.
+func checkOwnerCalls(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	teleporterAddress common.Address,
+	bridge ownableBridge,
+	ownerKey *ecdsa.PrivateKey,
+) {
+
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		ownerKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return bridge.PauseTeleporterAddress(opts, teleporterAddress)
+		},
+	)
+	paused, err := bridge.IsTeleporterAddressPaused(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	Expect(paused).Should(BeTrue())
+
+	_, err = bridge.PauseTeleporterAddress(utils.NewTransactor(ctx, subnet, ownerKey), teleporterAddress)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrTeleporterAddressPaused)))
+
+	utils.TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		ownerKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return bridge.UnpauseTeleporterAddress(opts, teleporterAddress)
+		},
+	)
+	paused, err = bridge.IsTeleporterAddressPaused(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	Expect(paused).Should(BeFalse())
+
+	_, err = bridge.UnpauseTeleporterAddress(utils.NewTransactor(ctx, subnet, ownerKey), teleporterAddress)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrTeleporterAddressNotPaused)))
+
+	// The bridge was deployed with the latest registered Teleporter version as its minimum version,
+	// so the owner passes the access check, but cannot raise the minimum version any further.
+	minVersion, err := bridge.GetMinTeleporterVersion(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	_, err = bridge.UpdateMinTeleporterVersion(utils.NewTransactor(ctx, subnet, ownerKey), minVersion)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrMinTeleporterVersionTooLow)))
+	_, err = bridge.UpdateMinTeleporterVersion(
+		utils.NewTransactor(ctx, subnet, ownerKey),
+		new(big.Int).Add(minVersion, big.NewInt(1)),
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrInvalidTeleporterVersion)))
+}
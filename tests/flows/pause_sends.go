@@ -0,0 +1,180 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, registers it, and bridges tokens to a recipient on Subnet A
+ * Sends tokens from the C-Chain to Subnet A, and from Subnet A back to the C-Chain, without relaying either
+ * Checks that a non-owner cannot pause sends, and pauses sends of both the source and the destination
+ * Checks that sends in both directions revert while paused
+ * Relays the transfers that were in flight when sends were paused, and checks that both settle
+ * Unpauses sends through the SDK, and checks that tokens are bridged in both directions again
+ */
+func PauseSends(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	amount := big.NewInt(1e18)
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo).
+		Send(cChainInfo, subnetAInfo, recipientAddress, new(big.Int).Mul(amount, big.NewInt(2))).
+		Fund(cChainInfo, recipientAddress, big.NewInt(1e18)).
+		Fund(subnetAInfo, recipientAddress, big.NewInt(1e18))
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	sourceInput := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	destinationInput := erc20destination.SendTokensInput{
+		DestinationBlockchainID:  cChainInfo.BlockchainID,
+		DestinationBridgeAddress: erc20SourceAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   erc20DestinationAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+
+	// Leave a transfer in flight in each direction
+	toDestinationReceipt, toDestinationAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		sourceInput,
+		amount,
+		fundedKey,
+	)
+	toSourceReceipt, toSourceAmount := utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		erc20Destination,
+		erc20DestinationAddress,
+		destinationInput,
+		amount,
+		recipientKey,
+	)
+
+	// Only the owner can pause sends
+	_, err = erc20Source.PauseSends(utils.NewTransactor(ctx, cChainInfo, recipientKey))
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+	_, err = erc20Destination.PauseSends(utils.NewTransactor(ctx, subnetAInfo, recipientKey))
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+
+	receipt := utils.TransactAndWaitForSuccess(
+		ctx,
+		cChainInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Source.PauseSends(opts)
+		},
+	)
+	sourcePaused, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseSendsPaused)
+	Expect(err).Should(BeNil())
+	Expect(sourcePaused.Account).Should(Equal(fundedAddress))
+
+	receipt = utils.TransactAndWaitForSuccess(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.PauseSends(opts)
+		},
+	)
+	destinationPaused, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseSendsPaused)
+	Expect(err).Should(BeNil())
+	Expect(destinationPaused.Account).Should(Equal(fundedAddress))
+
+	_, err = erc20Source.PauseSends(utils.NewTransactor(ctx, cChainInfo, fundedKey))
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrSendsAlreadyPaused)))
+
+	// Sends in both directions revert while paused. The transactions are only estimated, since a reverting
+	// call fails gas estimation before being sent.
+	_, err = erc20Source.Send(utils.NewTransactor(ctx, cChainInfo, fundedKey), sourceInput, amount)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrSendsPaused)))
+	_, err = erc20Destination.Send(utils.NewTransactor(ctx, subnetAInfo, recipientKey), destinationInput, amount)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrSendsPaused)))
+
+	// The transfers in flight when sends were paused still settle
+	receipt = network.RelayMessage(ctx, toDestinationReceipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, toDestinationAmount)
+	receipt = network.RelayMessage(ctx, toSourceReceipt, subnetAInfo, cChainInfo, true)
+	utils.CheckERC20SourceWithdrawal(ctx, erc20SourceAddress, sourceToken, receipt, recipientAddress, toSourceAmount)
+
+	// Unpause sends through the SDK, as the CLI does
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: erc20DestinationAddress,
+		Type:    events.ERC20Destination,
+	}
+	for _, endpoint := range []bridge.Endpoint{source, destination} {
+		paused, err := bridge.SendsPaused(ctx, endpoint)
+		Expect(err).Should(BeNil())
+		Expect(paused).Should(BeTrue())
+
+		subnet := cChainInfo
+		if endpoint.Chain.BlockchainID == subnetAInfo.BlockchainID {
+			subnet = subnetAInfo
+		}
+		_, err = bridge.SetSendsPaused(ctx, utils.NewTransactor(ctx, subnet, fundedKey), endpoint, false)
+		Expect(err).Should(BeNil())
+
+		paused, err = bridge.SendsPaused(ctx, endpoint)
+		Expect(err).Should(BeNil())
+		Expect(paused).Should(BeFalse())
+	}
+	_, err = erc20Source.UnpauseSends(utils.NewTransactor(ctx, cChainInfo, fundedKey))
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrSendsNotPaused)))
+
+	// Sends in both directions succeed again
+	s.Send(cChainInfo, subnetAInfo, recipientAddress, amount).
+		As(recipientKey).
+		Send(subnetAInfo, cChainInfo, recipientAddress, amount)
+}
@@ -0,0 +1,178 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A and Subnet B, and registers both
+ * Checks that only the owner can set a rate limit, and that the window of a limit cannot be zero
+ * Rate limits sends to Subnet A, sends up to the limit, and checks that a send over the limit reverts while
+ * sends to Subnet B are unaffected
+ * Rate limits sends to Subnet B, and checks that a multi-hop transfer from Subnet A over the limit is sent to
+ * its multi-hop fallback
+ * Waits for the window of Subnet A's limit to end, and checks that sends to Subnet A succeed again
+ * Resets and then removes Subnet A's limit through the SDK, and checks that sends succeed after each
+ */
+func RateLimit(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	amount := big.NewInt(1e18)
+	limit := new(big.Int).Mul(amount, big.NewInt(2))
+	window := 30 * time.Second
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		DeployERC20Destination(subnetBInfo).
+		Register(subnetAInfo).
+		Register(subnetBInfo).
+		Fund(subnetAInfo, recipientAddress, big.NewInt(1e18))
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddressA, erc20DestinationA := s.Destination(subnetAInfo)
+	erc20DestinationAddressB, _ := s.Destination(subnetBInfo)
+
+	// Only the owner can set a rate limit, and a limit must have a window
+	_, err = erc20Source.SetRateLimit(
+		utils.NewTransactor(ctx, cChainInfo, recipientKey),
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddressA,
+		limit,
+		big.NewInt(int64(window/time.Second)),
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+	_, err = erc20Source.SetRateLimit(
+		utils.NewTransactor(ctx, cChainInfo, fundedKey),
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddressA,
+		limit,
+		big.NewInt(0),
+	)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrZeroRateLimitWindow)))
+
+	// Send up to the limit to Subnet A
+	s.RateLimit(subnetAInfo, limit, window).
+		Send(cChainInfo, subnetAInfo, recipientAddress, amount).
+		Send(cChainInfo, subnetAInfo, recipientAddress, amount)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	rateLimit, err := bridge.GetRateLimit(ctx, source, subnetAInfo.BlockchainID, erc20DestinationAddressA)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(rateLimit.Limit, limit)
+	Expect(rateLimit.Window).Should(Equal(window))
+	teleporterUtils.ExpectBigEqual(rateLimit.AmountSent, limit)
+	teleporterUtils.ExpectBigEqual(rateLimit.Remaining, big.NewInt(0))
+
+	// A send over the limit reverts, while sends to Subnet B are not limited
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddressA,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	teleporterUtils.ERC20Approve(ctx, sourceToken, erc20SourceAddress, amount, cChainInfo, fundedKey)
+	_, err = erc20Source.Send(utils.NewTransactor(ctx, cChainInfo, fundedKey), input, amount)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrRateLimitExceeded)))
+	s.Send(cChainInfo, subnetBInfo, recipientAddress, amount)
+
+	// A multi-hop transfer over the limit of its final destination is sent to its multi-hop fallback on the
+	// C-Chain, rather than being routed
+	s.RateLimit(subnetBInfo, new(big.Int).Div(amount, big.NewInt(2)), window)
+	multiHopInput := erc20destination.SendTokensInput{
+		DestinationBlockchainID:  subnetBInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddressB,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   erc20DestinationAddressA,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		MultiHopFallback:         recipientAddress,
+	}
+	receipt, bridgedAmount := utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		erc20DestinationA,
+		erc20DestinationAddressA,
+		multiHopInput,
+		amount,
+		recipientKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	utils.CheckERC20SourceWithdrawal(ctx, erc20SourceAddress, sourceToken, receipt, recipientAddress, bridgedAmount)
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseTokensRouted)
+	Expect(err).ShouldNot(BeNil())
+
+	// Once the window of the limit ends, sends to Subnet A succeed again
+	utils.AdvanceTime(ctx, cChainInfo, fundedKey, window)
+	s.Send(cChainInfo, subnetAInfo, recipientAddress, amount)
+	rateLimit, err = bridge.GetRateLimit(ctx, source, subnetAInfo.BlockchainID, erc20DestinationAddressA)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(rateLimit.AmountSent, amount)
+	teleporterUtils.ExpectBigEqual(rateLimit.Remaining, new(big.Int).Sub(limit, amount))
+
+	// Resetting the limit through the SDK starts a new window
+	s.Send(cChainInfo, subnetAInfo, recipientAddress, amount)
+	_, err = bridge.SetRateLimit(
+		ctx,
+		utils.NewTransactor(ctx, cChainInfo, fundedKey),
+		source,
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddressA,
+		limit,
+		window,
+	)
+	Expect(err).Should(BeNil())
+	rateLimit, err = bridge.GetRateLimit(ctx, source, subnetAInfo.BlockchainID, erc20DestinationAddressA)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(rateLimit.AmountSent, big.NewInt(0))
+	teleporterUtils.ExpectBigEqual(rateLimit.Remaining, limit)
+	s.Send(cChainInfo, subnetAInfo, recipientAddress, amount)
+
+	// Removing the limit allows sends over it
+	_, err = bridge.SetRateLimit(
+		ctx,
+		utils.NewTransactor(ctx, cChainInfo, fundedKey),
+		source,
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddressA,
+		big.NewInt(0),
+		0,
+	)
+	Expect(err).Should(BeNil())
+	rateLimit, err = bridge.GetRateLimit(ctx, source, subnetAInfo.BlockchainID, erc20DestinationAddressA)
+	Expect(err).Should(BeNil())
+	Expect(rateLimit.Remaining).Should(BeNil())
+	s.Send(cChainInfo, subnetAInfo, recipientAddress, new(big.Int).Mul(limit, big.NewInt(2)))
+}
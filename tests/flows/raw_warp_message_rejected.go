@@ -0,0 +1,114 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploys an ERC20Source contract on the C-Chain
+ * Deploys an ERC20Destination contract on Subnet A
+ * Emits a raw Warp message on the C-Chain containing a Teleporter message that claims to be
+ * sent by the ERC20Source, with a single hop send to a recipient on Subnet A
+ * Checks that delivering the message to Subnet A is rejected, and no tokens are minted
+ */
+func RawWarpMessageRejected(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	// Create an ERC20Source for bridging the source token
+	erc20SourceAddress, _ := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Token representation on subnet A will have same name, symbol, and decimals
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	// Deploy an ERC20Destination to Subnet A
+	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		tokenName,
+		tokenSymbol,
+		tokenDecimals,
+	)
+
+	// Generate new recipient to receive bridged tokens
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// Construct a Teleporter message spoofing the ERC20Source as the origin sender
+	message := teleportermessenger.TeleporterMessage{
+		MessageNonce:            big.NewInt(1),
+		OriginSenderAddress:     erc20SourceAddress,
+		DestinationBlockchainID: subnetAInfo.BlockchainID,
+		DestinationAddress:      erc20DestinationAddress,
+		RequiredGasLimit:        utils.DefaultERC20RequiredGas,
+		AllowedRelayerAddresses: []common.Address{},
+		Receipts:                []teleportermessenger.TeleporterMessageReceipt{},
+		Message: utils.PackBridgeMessage(
+			messages.SingleHopSend,
+			utils.PackSingleHopSendMessage(recipientAddress, big.NewInt(1e18)),
+		),
+	}
+
+	signedMessage := utils.SendRawTeleporterMessage(
+		ctx,
+		network,
+		cChainInfo,
+		subnetAInfo,
+		message,
+		fundedKey,
+	)
+
+	// The Warp message was not sent by the Teleporter contract, so it must be rejected
+	receipt := utils.DeliverRawTeleporterMessage(
+		ctx,
+		subnetAInfo,
+		signedMessage,
+		message.RequiredGasLimit,
+		network.GetTeleporterContractAddress(),
+		fundedKey,
+	)
+	Expect(receipt.Status).Should(Equal(types.ReceiptStatusFailed))
+
+	// Check that no tokens were minted to the recipient
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	Expect(balance.Uint64()).Should(BeZero())
+}
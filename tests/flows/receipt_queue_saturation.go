@@ -0,0 +1,278 @@
+package flows
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// The number of messages sent to saturate the receipt queue of the destination
+	saturatingMessages = 200
+	// The number of token transfers sent back to the source, each returning the maximum number of receipts,
+	// before the remaining receipts are sent explicitly
+	saturatedReceiptReturns = 4
+	// The number of receipts sent explicitly in each message, bounded by the gas Teleporter allows to process them
+	specifiedReceiptsBatchSize = 10
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Sends hundreds of transfers to Subnet A, each paying a relayer fee, before relaying any of them
+ * Relays the messages from two relayer accounts in turn, saturating the receipt queue of Subnet A
+ * Check that every message queued a receipt, and that no rewards are redeemable before the receipts are returned
+ * Bridges tokens back from Subnet A, checking that each message returns the oldest outstanding receipts in order,
+ * and that each relayer is allocated the fees of the returned messages it delivered
+ * Sends the remaining receipts explicitly in batches, and checks that each relayer is allocated all of its fees
+ * Bridges tokens back from Subnet A again, checking that the receipts it returns a second time allocate no fees
+ * Redeem each relayer's rewards on the C-Chain, and check that the fees move from Teleporter to the relayers
+ */
+func ReceiptQueueSaturation(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	teleporterAddress := network.GetTeleporterContractAddress()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged, which fees are paid in
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC20Destination to Subnet A, and register it with the ERC20Source
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	// Generate two relayer accounts, with gas tokens to deliver messages on both chains
+	var (
+		relayerKeys      []*ecdsa.PrivateKey
+		relayerAddresses []common.Address
+	)
+	for i := 0; i < 2; i++ {
+		relayerKey, err := crypto.GenerateKey()
+		Expect(err).Should(BeNil())
+		relayerAddress := crypto.PubkeyToAddress(relayerKey.PublicKey)
+		for _, subnet := range []interfaces.SubnetTestInfo{cChainInfo, subnetAInfo} {
+			teleporterUtils.SendNativeTransfer(ctx, subnet, fundedKey, relayerAddress, big.NewInt(1e18))
+		}
+		relayerKeys = append(relayerKeys, relayerKey)
+		relayerAddresses = append(relayerAddresses, relayerAddress)
+	}
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	teleporterBalanceBefore, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	initialReceiptCount := teleporterUtils.GetOutstandingReceiptCount(subnetAInfo, cChainInfo.BlockchainID)
+
+	// Send every transfer to Subnet A before any of them are relayed, each paying a relayer fee
+	fee := big.NewInt(1e14)
+	amount := big.NewInt(1e15)
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               fee,
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	sendReceipts := make([]*types.Receipt, 0, saturatingMessages)
+	messageIDs := make([][32]byte, 0, saturatingMessages)
+	totalBridged := big.NewInt(0)
+	for i := 0; i < saturatingMessages; i++ {
+		receipt, bridgedAmount := utils.SendERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			input,
+			amount,
+			fundedKey,
+		)
+		sendEvent, err := teleporterUtils.GetEventFromLogs(
+			receipt.Logs,
+			cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+		)
+		Expect(err).Should(BeNil())
+		sendReceipts = append(sendReceipts, receipt)
+		messageIDs = append(messageIDs, sendEvent.MessageID)
+		totalBridged.Add(totalBridged, bridgedAmount)
+	}
+
+	// Relay the messages in the order they were sent, from each relayer in turn
+	relayerOf := make(map[ids.ID]int, saturatingMessages)
+	for i, receipt := range sendReceipts {
+		relayer := i % len(relayerKeys)
+		utils.RelayMessageWithKey(ctx, network, receipt, cChainInfo, subnetAInfo, relayerKeys[relayer], true)
+		relayerOf[ids.ID(messageIDs[i])] = relayer
+	}
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, totalBridged)
+
+	// Every message queued a receipt, and no fees are allocated until the receipts are returned
+	receiptCount := teleporterUtils.GetOutstandingReceiptCount(subnetAInfo, cChainInfo.BlockchainID)
+	teleporterUtils.ExpectBigEqual(
+		receiptCount,
+		new(big.Int).Add(initialReceiptCount, big.NewInt(saturatingMessages)),
+	)
+	expectedRewards := make([]*big.Int, len(relayerKeys))
+	for i, relayerAddress := range relayerAddresses {
+		expectedRewards[i] = big.NewInt(0)
+		checkRelayerReward(ctx, cChainInfo, relayerAddress, sourceTokenAddress, expectedRewards[i])
+	}
+
+	// Allocates the fee of each of the flow's messages whose receipt the delivery returned for the first time,
+	// checking that they are returned in the order the messages were sent
+	nextReceipt := 0
+	returned := make(map[ids.ID]bool, saturatingMessages)
+	checkReturnedReceipts := func(deliveryReceipt *types.Receipt) {
+		for _, log := range deliveryReceipt.Logs {
+			event, err := cChainInfo.TeleporterMessenger.ParseReceiptReceived(*log)
+			if err != nil {
+				continue
+			}
+			messageID := ids.ID(event.MessageID)
+			relayer, ok := relayerOf[messageID]
+			if !ok || returned[messageID] {
+				continue
+			}
+			Expect(messageID).Should(Equal(ids.ID(messageIDs[nextReceipt])), "receipt returned out of order")
+			Expect(event.RelayerRewardAddress).Should(Equal(relayerAddresses[relayer]))
+			teleporterUtils.ExpectBigEqual(event.FeeInfo.Amount, fee)
+			expectedRewards[relayer].Add(expectedRewards[relayer], fee)
+			returned[messageID] = true
+			nextReceipt++
+		}
+		for i, relayerAddress := range relayerAddresses {
+			checkRelayerReward(ctx, cChainInfo, relayerAddress, sourceTokenAddress, expectedRewards[i])
+		}
+	}
+
+	// Each transfer back to the C-Chain returns the oldest outstanding receipts, up to the maximum per message
+	teleporterUtils.SendNativeTransfer(ctx, subnetAInfo, fundedKey, recipientAddress, big.NewInt(1e18))
+	sendBack := func() {
+		expectedReceipts := receiptCount.Int64()
+		if expectedReceipts > maxReceiptsPerMessage {
+			expectedReceipts = maxReceiptsPerMessage
+		}
+		input := erc20destination.SendTokensInput{
+			DestinationBlockchainID:  cChainInfo.BlockchainID,
+			DestinationBridgeAddress: erc20SourceAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   erc20DestinationAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		}
+		receipt, bridgedAmount := utils.SendERC20Destination(
+			ctx,
+			subnetAInfo,
+			erc20Destination,
+			erc20DestinationAddress,
+			input,
+			amount,
+			recipientKey,
+		)
+		receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+		utils.CheckERC20SourceWithdrawal(
+			ctx,
+			erc20SourceAddress,
+			sourceToken,
+			receipt,
+			recipientAddress,
+			bridgedAmount,
+		)
+
+		remaining := teleporterUtils.GetOutstandingReceiptCount(subnetAInfo, cChainInfo.BlockchainID)
+		teleporterUtils.ExpectBigEqual(remaining, new(big.Int).Sub(receiptCount, big.NewInt(expectedReceipts)))
+		receiptCount = remaining
+		checkReturnedReceipts(receipt)
+	}
+	for i := 0; i < saturatedReceiptReturns; i++ {
+		sendBack()
+	}
+	Expect(nextReceipt).Should(BeNumerically(">", 0))
+
+	// Send the receipts of the remaining messages explicitly, in batches
+	for start := nextReceipt; start < saturatingMessages; start += specifiedReceiptsBatchSize {
+		end := min(start+specifiedReceiptsBatchSize, saturatingMessages)
+		receipt, _ := teleporterUtils.SendSpecifiedReceiptsAndWaitForAcceptance(
+			ctx,
+			cChainInfo.BlockchainID,
+			subnetAInfo,
+			messageIDs[start:end],
+			teleportermessenger.TeleporterFeeInfo{
+				FeeTokenAddress: common.Address{},
+				Amount:          big.NewInt(0),
+			},
+			[]common.Address{},
+			fundedKey,
+		)
+		receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+		for _, messageID := range messageIDs[start:end] {
+			Expect(teleporterUtils.CheckReceiptReceived(receipt, messageID, cChainInfo.TeleporterMessenger)).Should(
+				BeTrue(),
+			)
+		}
+		checkReturnedReceipts(receipt)
+	}
+	Expect(nextReceipt).Should(Equal(saturatingMessages))
+	totalFees := new(big.Int).Mul(fee, big.NewInt(saturatingMessages))
+	teleporterUtils.ExpectBigEqual(new(big.Int).Add(expectedRewards[0], expectedRewards[1]), totalFees)
+	checkRelayerReward(ctx, cChainInfo, fundedAddress, sourceTokenAddress, big.NewInt(0))
+
+	// Sending receipts explicitly does not dequeue them, so the queue still returns them, but their fees have
+	// already been allocated
+	Expect(receiptCount.Sign()).Should(BeNumerically(">", 0))
+	sendBack()
+
+	// Redeem each relayer's rewards, which returns Teleporter's balance of the token to before the flow
+	for i, relayerKey := range relayerKeys {
+		teleporterUtils.RedeemRelayerRewardsAndConfirm(
+			ctx,
+			cChainInfo,
+			sourceToken,
+			sourceTokenAddress,
+			relayerKey,
+			expectedRewards[i],
+		)
+	}
+	teleporterBalance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(teleporterBalance, teleporterBalanceBefore)
+}
@@ -0,0 +1,186 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A and registers it
+ * Checks that a non-owner cannot block recipients, and blocks a recipient on Subnet A through the SDK
+ * Sends tokens to the blocked recipient, and checks that they are held in escrow by the destination
+ * Sends tokens through sendAndCall with the blocked recipient as the fallback, and checks that the call is not made
+ * and the tokens are held in escrow for the fallback recipient
+ * Checks that the recipient cannot claim its escrowed tokens while blocked
+ * Unblocks the recipient through the SDK, and checks that it claims all of its escrowed tokens
+ * Checks that tokens are sent to the recipient directly again
+ */
+func RecipientBlocklist(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo).
+		Fund(subnetAInfo, recipientAddress, big.NewInt(1e18))
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: erc20DestinationAddress,
+		Type:    events.ERC20Destination,
+	}
+
+	// Only the owner can block recipients
+	_, err = erc20Destination.BlockRecipient(utils.NewTransactor(ctx, subnetAInfo, recipientKey), recipientAddress)
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrCallerNotOwner)))
+
+	// Block the recipient through the SDK, as the CLI does
+	receipt, err := bridge.SetRecipientBlocked(
+		ctx,
+		utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		destination,
+		recipientAddress,
+		true,
+	)
+	Expect(err).Should(BeNil())
+	blockedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseRecipientBlocked)
+	Expect(err).Should(BeNil())
+	Expect(blockedEvent.Recipient).Should(Equal(recipientAddress))
+
+	// Tokens sent to the blocked recipient are held in escrow by the destination
+	amount := big.NewInt(1e18)
+	receipt, sentAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		amount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationEscrow(
+		ctx,
+		erc20Destination,
+		erc20DestinationAddress,
+		receipt,
+		recipientAddress,
+		sentAmount,
+	)
+
+	// Tokens sent through sendAndCall with the blocked recipient as the fallback are held in escrow for it,
+	// without calling the recipient contract
+	receiverAddress, receiver := utils.DeployMockERC20SendAndCallReceiver(ctx, fundedKey, subnetAInfo)
+	receipt, calledAmount := utils.SendAndCallERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendAndCallInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			RecipientContract:        receiverAddress,
+			RecipientPayload:         []byte{1},
+			RequiredGasLimit:         teleporterUtils.BigIntMul(big.NewInt(10), utils.DefaultERC20RequiredGas),
+			RecipientGasLimit:        teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas),
+			FallbackRecipient:        recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+		},
+		amount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationEscrow(
+		ctx,
+		erc20Destination,
+		erc20DestinationAddress,
+		receipt,
+		recipientAddress,
+		calledAmount,
+	)
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, receiver.ParseTokensReceived)
+	Expect(err).ShouldNot(BeNil())
+
+	escrowed := new(big.Int).Add(sentAmount, calledAmount)
+	s.ExpectBalance(subnetAInfo, recipientAddress, big.NewInt(0)).
+		ExpectBalance(subnetAInfo, erc20DestinationAddress, escrowed)
+
+	// The recipient cannot claim its escrowed tokens while blocked
+	_, err = erc20Destination.ClaimEscrowedTokens(utils.NewTransactor(ctx, subnetAInfo, recipientKey))
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrRecipientBlocked)))
+
+	// Unblock the recipient through the SDK, which reports its escrowed tokens
+	_, err = bridge.SetRecipientBlocked(
+		ctx,
+		utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		destination,
+		recipientAddress,
+		false,
+	)
+	Expect(err).Should(BeNil())
+	blockedRecipient, err := bridge.GetBlockedRecipient(ctx, destination, recipientAddress)
+	Expect(err).Should(BeNil())
+	Expect(blockedRecipient.Blocked).Should(BeFalse())
+	teleporterUtils.ExpectBigEqual(blockedRecipient.Escrowed, escrowed)
+
+	// The recipient claims all of its escrowed tokens
+	receipt = utils.TransactAndWaitForSuccess(
+		ctx,
+		subnetAInfo,
+		recipientKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.ClaimEscrowedTokens(opts)
+		},
+	)
+	claimedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseEscrowClaimed)
+	Expect(err).Should(BeNil())
+	Expect(claimedEvent.Recipient).Should(Equal(recipientAddress))
+	teleporterUtils.ExpectBigEqual(claimedEvent.Amount, escrowed)
+	s.ExpectBalance(subnetAInfo, recipientAddress, escrowed).
+		ExpectBalance(subnetAInfo, erc20DestinationAddress, big.NewInt(0))
+
+	_, err = erc20Destination.ClaimEscrowedTokens(utils.NewTransactor(ctx, subnetAInfo, recipientKey))
+	Expect(err).Should(MatchError(ContainSubstring(errors.ErrNoEscrowedTokens)))
+
+	// Tokens are sent to the recipient directly again
+	s.Send(cChainInfo, subnetAInfo, recipientAddress, amount)
+}
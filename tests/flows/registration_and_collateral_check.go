@@ -78,7 +78,7 @@ func RegistrationAndCollateralCheck(network interfaces.Network) {
 
 	amount := big.NewInt(0).Mul(big.NewInt(1e18), big.NewInt(10))
 
-	initialBalance, err := sourceToken.BalanceOf(&bind.CallOpts{}, erc20SourceAddress)
+	initialBalance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
 	Expect(err).Should(BeNil())
 
 	// Send the tokens and expect for failure since destination bridge is not registered.
@@ -93,7 +93,7 @@ func RegistrationAndCollateralCheck(network interfaces.Network) {
 	Expect(err.Error()).Should(ContainSubstring(errors.ErrDestinationNotRegistered))
 
 	// Check the balance of the ERC20Source to ensure it was not changed
-	balance, err := sourceToken.BalanceOf(&bind.CallOpts{}, erc20SourceAddress)
+	balance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
 	Expect(err).Should(BeNil())
 	teleporterUtils.ExpectBigEqual(balance, initialBalance)
 
@@ -120,7 +120,7 @@ func RegistrationAndCollateralCheck(network interfaces.Network) {
 	Expect(err.Error()).Should(ContainSubstring(errors.ErrNonZeroCollateralNeeded))
 
 	// Check the balance of the ERC20Source to ensure it was not changed
-	balance, err = sourceToken.BalanceOf(&bind.CallOpts{}, erc20SourceAddress)
+	balance, err = sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
 	Expect(err).Should(BeNil())
 	teleporterUtils.ExpectBigEqual(balance, initialBalance)
 
@@ -139,7 +139,7 @@ func RegistrationAndCollateralCheck(network interfaces.Network) {
 
 	// Check the balance of the ERC20Source to ensure it was increased by the collateral amount.
 	// Also set the new initial balance before sending tokens
-	balance, err = sourceToken.BalanceOf(&bind.CallOpts{}, erc20SourceAddress)
+	balance, err = sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
 	Expect(err).Should(BeNil())
 	teleporterUtils.ExpectBigEqual(balance, initialBalance.Add(initialBalance, collateralNeeded))
 
@@ -166,6 +166,7 @@ func RegistrationAndCollateralCheck(network interfaces.Network) {
 
 	// Compute the scaled amount
 	scaledAmount := utils.GetScaledAmountFromERC20Source(
+		ctx,
 		erc20Source,
 		input.DestinationBlockchainID,
 		input.DestinationBridgeAddress,
@@ -174,7 +175,7 @@ func RegistrationAndCollateralCheck(network interfaces.Network) {
 	teleporterUtils.ExpectBigEqual(event.Amount, scaledAmount)
 
 	// Check the balance of the ERC20Source increased by the bridged amount
-	balance, err = sourceToken.BalanceOf(&bind.CallOpts{}, erc20SourceAddress)
+	balance, err = sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
 	Expect(err).Should(BeNil())
 	teleporterUtils.ExpectBigEqual(balance, big.NewInt(0).Add(initialBalance, amount))
 
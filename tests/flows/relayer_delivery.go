@@ -0,0 +1,33 @@
+package flows
+
+import (
+	"context"
+
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Starts an awm-relayer delivering messages between all chains of the local network
+ * Bridges ERC20 tokens from the C-Chain to Subnet A and back, delivered by the relayer
+ * Bridges C-Chain native tokens to Subnet A and back, delivered by the relayer
+ * Bridges ERC20 tokens from Subnet A to Subnet B through the C-Chain, delivered by the relayer
+ * Stops the relayer
+ */
+func RelayerDelivery(network interfaces.LocalNetwork) {
+	ctx := context.Background()
+
+	rewardKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	relayer := utils.StartRelayer(ctx, network, crypto.PubkeyToAddress(rewardKey.PublicKey))
+	defer relayer.Stop(ctx)
+
+	// Messages relayed by the flows, including registration messages, are awaited from the relayer
+	relayerNetwork := utils.NewRelayerNetwork(network, relayer)
+
+	ERC20SourceERC20Destination(relayerNetwork)
+	NativeSourceERC20Destination(relayerNetwork)
+	ERC20SourceERC20DestinationMultiHop(relayerNetwork)
+}
@@ -0,0 +1,188 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Bridges C-Chain example ERC20 tokens to Subnet A several times, paying a relayer fee in the source token
+ * Relays each message from a separate relayer account
+ * Check that no rewards are redeemable until the receipts of the messages are sent back to the C-Chain
+ * Redeem the relayer's rewards on the C-Chain, and check that the fees move from Teleporter to the relayer
+ */
+func RelayerRewardRedemption(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	teleporterAddress := network.GetTeleporterContractAddress()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged, which fees are paid in
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC20Destination to Subnet A, and register it with the ERC20Source
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	// Generate a relayer account, with gas tokens to deliver messages on both chains
+	relayerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	relayerAddress := crypto.PubkeyToAddress(relayerKey.PublicKey)
+	for _, subnet := range []interfaces.SubnetTestInfo{cChainInfo, subnetAInfo} {
+		teleporterUtils.SendNativeTransfer(ctx, subnet, fundedKey, relayerAddress, big.NewInt(1e18))
+	}
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	senderBalanceBefore, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, fundedAddress)
+	Expect(err).Should(BeNil())
+	teleporterBalanceBefore, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+
+	// Send several transfers to Subnet A, each paying a relayer fee, relayed by the relayer account
+	const numTransfers = 3
+	fee := big.NewInt(1e18)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5))
+	totalFees := new(big.Int).Mul(fee, big.NewInt(numTransfers))
+	totalBridged := big.NewInt(0)
+	var messageIDs [][32]byte
+	for i := 0; i < numTransfers; i++ {
+		input := erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               fee,
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		}
+		receipt, bridgedAmount := utils.SendERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			input,
+			amount,
+			fundedKey,
+		)
+		sendEvent, err := teleporterUtils.GetEventFromLogs(
+			receipt.Logs,
+			cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+		)
+		Expect(err).Should(BeNil())
+		Expect(sendEvent.FeeInfo.FeeTokenAddress).Should(Equal(sourceTokenAddress))
+		teleporterUtils.ExpectBigEqual(sendEvent.FeeInfo.Amount, fee)
+		messageIDs = append(messageIDs, sendEvent.MessageID)
+
+		receipt = utils.RelayMessageWithKey(ctx, network, receipt, cChainInfo, subnetAInfo, relayerKey, true)
+		utils.CheckERC20DestinationWithdrawal(
+			ctx,
+			erc20Destination,
+			receipt,
+			recipientAddress,
+			bridgedAmount,
+		)
+		totalBridged.Add(totalBridged, bridgedAmount)
+	}
+
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	Expect(balance).Should(Equal(totalBridged))
+
+	// The fees are held by Teleporter, having been paid by the sender along with the bridged amounts
+	senderBalance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, fundedAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(
+		senderBalance,
+		teleporterUtils.BigIntSub(senderBalanceBefore, new(big.Int).Add(totalFees, totalBridged)),
+	)
+	teleporterBalance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(teleporterBalance, new(big.Int).Add(teleporterBalanceBefore, totalFees))
+
+	// Rewards are not allocated until the receipts of the messages are delivered back to the C-Chain
+	checkRelayerReward(ctx, cChainInfo, relayerAddress, sourceTokenAddress, big.NewInt(0))
+
+	receipt, _ := teleporterUtils.SendSpecifiedReceiptsAndWaitForAcceptance(
+		ctx,
+		cChainInfo.BlockchainID,
+		subnetAInfo,
+		messageIDs,
+		teleportermessenger.TeleporterFeeInfo{
+			FeeTokenAddress: common.Address{},
+			Amount:          big.NewInt(0),
+		},
+		[]common.Address{},
+		fundedKey,
+	)
+	receipt = utils.RelayMessageWithKey(ctx, network, receipt, subnetAInfo, cChainInfo, relayerKey, true)
+	for _, messageID := range messageIDs {
+		Expect(teleporterUtils.CheckReceiptReceived(receipt, messageID, cChainInfo.TeleporterMessenger)).Should(BeTrue())
+	}
+
+	// Only the relayer that delivered the messages is allocated their fees
+	checkRelayerReward(ctx, cChainInfo, relayerAddress, sourceTokenAddress, totalFees)
+	checkRelayerReward(ctx, cChainInfo, fundedAddress, sourceTokenAddress, big.NewInt(0))
+
+	teleporterUtils.RedeemRelayerRewardsAndConfirm(
+		ctx,
+		cChainInfo,
+		sourceToken,
+		sourceTokenAddress,
+		relayerKey,
+		totalFees,
+	)
+	teleporterBalance, err = sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(teleporterBalance, teleporterBalanceBefore)
+}
+
+func checkRelayerReward(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	relayerAddress common.Address,
+	feeTokenAddress common.Address,
+	expectedReward *big.Int,
+) {
+	reward, err := subnet.TeleporterMessenger.CheckRelayerRewardAmount(
+		&bind.CallOpts{Context: ctx},
+		relayerAddress,
+		feeTokenAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(reward, expectedReward)
+}
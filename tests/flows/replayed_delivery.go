@@ -0,0 +1,122 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Bridges C-Chain example ERC20 tokens to Subnet A, and relays the message
+ * Captures the signed Warp message of the delivered Teleporter message, and delivers it to Subnet A a second time
+ * Check that the second delivery reverts without side effects, and the destination does not mint again
+ */
+func ReplayedDelivery(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	// Generate new recipient to receive bridged tokens
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// Send tokens from C-Chain to recipient on subnet A
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(1e18),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	sendReceipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		sendReceipt.Logs,
+		cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+
+	// Relay the message, and check that the tokens were minted to the recipient
+	receipt := network.RelayMessage(ctx, sendReceipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		bridgedAmount,
+	)
+	totalSupply, err := erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	// Deliver the same signed Warp message a second time
+	signedMessage := network.ConstructSignedWarpMessage(ctx, sendReceipt, cChainInfo, subnetAInfo)
+	receipt = utils.DeliverRawTeleporterMessage(
+		ctx,
+		subnetAInfo,
+		signedMessage,
+		sendEvent.Message.RequiredGasLimit,
+		network.GetTeleporterContractAddress(),
+		fundedKey,
+	)
+
+	// The messenger rejects the message as already delivered, so the destination is not called
+	Expect(receipt.Status).Should(Equal(types.ReceiptStatusFailed))
+	Expect(receipt.Logs).Should(BeEmpty())
+	delivered, err := subnetAInfo.TeleporterMessenger.MessageReceived(
+		&bind.CallOpts{Context: ctx},
+		sendEvent.MessageID,
+	)
+	Expect(err).Should(BeNil())
+	Expect(delivered).Should(BeTrue(), "message %s not marked as received", ids.ID(sendEvent.MessageID))
+
+	// Check that the tokens were only minted once
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, bridgedAmount)
+	replayedTotalSupply, err := erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(replayedTotalSupply, totalSupply)
+
+	// The source still tracks the tokens as bridged once
+	bridgedBalance, err := erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, bridgedAmount)
+}
@@ -0,0 +1,181 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// A large decimals shift, so that the amounts sent back to the source lose up to a million units when scaled
+	boundaryDecimalsShift         = uint8(6)
+	boundaryMultiplyOnDestination = true
+
+	// The number of random amounts sent in each direction
+	boundaryTransferCount = 6
+)
+
+var maxBoundarySourceAmount = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000))
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys NativeTokenDestination to Subnet A, scaling amounts by a large decimals shift, and collateralizes it
+ * Bridges random amounts biased towards the scaling boundaries from the C-Chain to Subnet A, and checks that the
+ * recipient receives each amount scaled to the destination
+ * Bridges random amounts biased towards the scaling boundaries back from Subnet A to the C-Chain, and checks that
+ * the recipient receives each amount with the scaling removed, rounded down. Amounts too small to be scaled are
+ * rejected by the contracts, which is covered by the unit tests, so are not sent.
+ * The amounts are generated from E2E_RANDOM_SEED if set, and the seed is logged otherwise
+ */
+func ScalingBoundaryAmounts(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+	boundaryTokenMultiplier := utils.GetTokenMultiplier(boundaryDecimalsShift)
+
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(ctx, fundedKey, cChainInfo)
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+	nativeTokenDestinationAddress, nativeTokenDestination, collateralAmount :=
+		utils.DeployAndRegisterNativeTokenDestination(
+			ctx,
+			network,
+			subnetAInfo,
+			"SUBA",
+			cChainInfo,
+			erc20SourceAddress,
+			initialReserveImbalance,
+			boundaryDecimalsShift,
+			boundaryMultiplyOnDestination,
+			burnedFeesReportingRewardPercentage,
+		)
+	utils.AddCollateralToERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+		collateralAmount,
+		fundedKey,
+	)
+
+	// The recipient on Subnet A sends the tokens back, so is funded to pay for gas separately from the bridged
+	// tokens
+	recipientKey := utils.NewAccountKey()
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	gasFunding := big.NewInt(1e18)
+	utils.FundAccounts(ctx, subnetAInfo, fundedKey, []common.Address{recipientAddress}, gasFunding)
+
+	// Bridge random amounts to Subnet A, where they are multiplied, so arrive without losing precision
+	sourceAmounts := utils.NewAmountGenerator(
+		boundaryTokenMultiplier,
+		boundaryMultiplyOnDestination,
+		true,
+		maxBoundarySourceAmount,
+	)
+	totalBridged := big.NewInt(0)
+	for i := 0; i < boundaryTransferCount; i++ {
+		amount := sourceAmounts.Next()
+		expectedAmount := utils.ApplyTokenScaling(boundaryTokenMultiplier, boundaryMultiplyOnDestination, amount)
+		log.Info("Bridging amount to Subnet A", "amount", amount, "expectedAmount", expectedAmount)
+
+		receipt, bridgedAmount := utils.SendERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			erc20source.SendTokensInput{
+				DestinationBlockchainID:  subnetAInfo.BlockchainID,
+				DestinationBridgeAddress: nativeTokenDestinationAddress,
+				Recipient:                recipientAddress,
+				PrimaryFeeTokenAddress:   sourceTokenAddress,
+				PrimaryFee:               big.NewInt(0),
+				SecondaryFee:             big.NewInt(0),
+				RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+			},
+			amount,
+			fundedKey,
+		)
+		Expect(bridgedAmount).Should(Equal(expectedAmount))
+		network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+
+		totalBridged.Add(totalBridged, expectedAmount)
+		teleporterUtils.CheckBalance(
+			ctx,
+			recipientAddress,
+			new(big.Int).Add(gasFunding, totalBridged),
+			subnetAInfo.RPCClient,
+		)
+	}
+
+	// Bridge random amounts back to the C-Chain, where they are divided, so lose any remainder. Each amount is at
+	// most an equal share of the bridged tokens, so that the source always has the tokens to release.
+	destinationAmounts := utils.NewAmountGenerator(
+		boundaryTokenMultiplier,
+		boundaryMultiplyOnDestination,
+		false,
+		new(big.Int).Div(totalBridged, big.NewInt(boundaryTransferCount)),
+	)
+	returnAddress := crypto.PubkeyToAddress(utils.NewAccountKey().PublicKey)
+	totalReturned := big.NewInt(0)
+	for i := 0; i < boundaryTransferCount; i++ {
+		amount := destinationAmounts.Next()
+		expectedAmount := utils.RemoveTokenScaling(boundaryTokenMultiplier, boundaryMultiplyOnDestination, amount)
+		if expectedAmount.Sign() == 0 {
+			log.Info("Skipping amount too small to be bridged back", "amount", amount)
+			continue
+		}
+		log.Info("Bridging amount back to the C-Chain", "amount", amount, "expectedAmount", expectedAmount)
+
+		receipt, _ := utils.SendNativeTokenDestination(
+			ctx,
+			subnetAInfo,
+			nativeTokenDestination,
+			nativeTokenDestinationAddress,
+			nativetokendestination.SendTokensInput{
+				DestinationBlockchainID:  cChainInfo.BlockchainID,
+				DestinationBridgeAddress: erc20SourceAddress,
+				Recipient:                returnAddress,
+				PrimaryFeeTokenAddress:   nativeTokenDestinationAddress,
+				PrimaryFee:               big.NewInt(0),
+				SecondaryFee:             big.NewInt(0),
+				RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+			},
+			amount,
+			recipientKey,
+		)
+		receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+		utils.CheckERC20SourceWithdrawal(
+			ctx,
+			erc20SourceAddress,
+			sourceToken,
+			receipt,
+			returnAddress,
+			expectedAmount,
+		)
+
+		totalReturned.Add(totalReturned, expectedAmount)
+		balance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, returnAddress)
+		Expect(err).Should(BeNil())
+		Expect(balance).Should(Equal(totalReturned))
+	}
+}
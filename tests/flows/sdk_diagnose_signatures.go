@@ -0,0 +1,94 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Sends tokens to Subnet A without relaying the message
+ * Diagnoses the signatures of the pending message through the SDK, fetching the signature of every Subnet A
+ * validator directly, and checks that every validator has signed it and the quorum is met
+ * Relays the message, and checks that the tokens are received
+ */
+func SDKDiagnoseSignatures(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	recipientAddress := crypto.PubkeyToAddress(utils.NewAccountKey().PublicKey)
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		},
+		big.NewInt(1e18),
+		fundedKey,
+	)
+
+	sourceChain := &events.Chain{
+		Name:         "C-Chain",
+		BlockchainID: cChainInfo.BlockchainID,
+		Client:       cChainInfo.RPCClient,
+	}
+	sent, err := bridge.SentMessages(ctx, sourceChain, subnetAInfo.BlockchainID, receipt.TxHash)
+	Expect(err).Should(BeNil())
+	Expect(sent).Should(HaveLen(1))
+
+	// Messages sent from the primary network are signed by the validators of the destination's subnet
+	nodeURI := cChainInfo.NodeURIs[0]
+	signingSubnetID, err := bridge.SigningSubnetID(ctx, nodeURI, cChainInfo.BlockchainID, subnetAInfo.BlockchainID)
+	Expect(err).Should(BeNil())
+	Expect(signingSubnetID).Should(Equal(subnetAInfo.SubnetID))
+
+	report, err := bridge.DiagnoseSignatures(
+		ctx,
+		nodeURI,
+		subnetAInfo.NodeURIs,
+		cChainInfo.BlockchainID,
+		signingSubnetID,
+		sent[0],
+	)
+	Expect(err).Should(BeNil())
+	Expect(report.MessageID).Should(Equal(sent[0].MessageID))
+	Expect(report.AggregateError).Should(BeEmpty())
+	Expect(report.Validators).ShouldNot(BeEmpty())
+	for _, validator := range report.Validators {
+		Expect(validator.Status).Should(Equal(bridge.SignatureSigned), "validator %s", validator.NodeIDs)
+	}
+	Expect(report.SignedWeight).Should(Equal(report.TotalWeight))
+	Expect(report.QuorumMet()).Should(BeTrue())
+
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, bridgedAmount)
+}
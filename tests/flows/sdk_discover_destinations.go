@@ -0,0 +1,95 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A and Subnet B, and registers only the destination on Subnet A
+ * Discovers the destinations of the source through the SDK, and checks that only Subnet A is returned
+ * Registers the destination on Subnet B, and checks that both destinations are discovered in registration order,
+ * with their scaling settings and collateralization, which is only read from the chains passed to the SDK
+ */
+func SDKDiscoverDestinations(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		DeployERC20Destination(subnetBInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, _, _, _ := s.Source()
+	subnetADestinationAddress, _ := s.Destination(subnetAInfo)
+	subnetBDestinationAddress, _ := s.Destination(subnetBInfo)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	// Only Subnet A's chain is passed, so only its destination's collateralization is read
+	options := bridge.DiscoverOptions{
+		DestinationChains: map[ids.ID]*events.Chain{
+			subnetAInfo.BlockchainID: {
+				Name:         "Subnet A",
+				BlockchainID: subnetAInfo.BlockchainID,
+				Client:       subnetAInfo.RPCClient,
+			},
+		},
+	}
+
+	// The unregistered destination on Subnet B is not a route
+	destinations, err := bridge.DiscoverDestinations(ctx, source, options)
+	Expect(err).Should(BeNil())
+	Expect(destinations).Should(HaveLen(1))
+	expectDiscoveredDestination(destinations[0], subnetAInfo.BlockchainID, subnetADestinationAddress)
+	Expect(destinations[0].Collateralized).ShouldNot(BeNil())
+	Expect(*destinations[0].Collateralized).Should(BeTrue())
+
+	s.Register(subnetBInfo)
+	destinations, err = bridge.DiscoverDestinations(ctx, source, options)
+	Expect(err).Should(BeNil())
+	Expect(destinations).Should(HaveLen(2))
+	expectDiscoveredDestination(destinations[0], subnetAInfo.BlockchainID, subnetADestinationAddress)
+	expectDiscoveredDestination(destinations[1], subnetBInfo.BlockchainID, subnetBDestinationAddress)
+	Expect(destinations[1].RegisteredBlock).Should(BeNumerically(">", destinations[0].RegisteredBlock))
+	Expect(destinations[1].Collateralized).Should(BeNil())
+
+	// Scanning from after the first registration misses it
+	options.FromBlock = destinations[0].RegisteredBlock + 1
+	destinations, err = bridge.DiscoverDestinations(ctx, source, options)
+	Expect(err).Should(BeNil())
+	Expect(destinations).Should(HaveLen(1))
+	expectDiscoveredDestination(destinations[0], subnetBInfo.BlockchainID, subnetBDestinationAddress)
+}
+
+// Checks that the discovered destination is an ERC20Destination with the source token's decimals, which
+// needs no collateral
+func expectDiscoveredDestination(
+	destination *bridge.RegisteredDestination,
+	blockchainID ids.ID,
+	address common.Address,
+) {
+	Expect(destination.BlockchainID).Should(Equal(blockchainID))
+	Expect(destination.Address).Should(Equal(address))
+	Expect(destination.RegisteredBlock).ShouldNot(BeZero())
+	teleporterUtils.ExpectBigEqual(destination.TokenMultiplier, big.NewInt(1))
+	teleporterUtils.ExpectBigEqual(destination.CollateralNeeded, big.NewInt(0))
+}
@@ -0,0 +1,157 @@
+package flows
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Estimates the delivery gas of a send to Subnet A through the SDK, sends with the estimated required gas limit,
+ * and checks that the delivery succeeds within the estimated receive gas limit
+ * Estimates the delivery gas of sendAndCalls with a small and a large payload, and checks that the larger payload
+ * is estimated to need more gas
+ * Sends the large payload with the estimated required gas limit, and checks that the recipient contract is called
+ * successfully within the estimated receive gas limit
+ */
+func SDKEstimateDeliveryGas(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: erc20DestinationAddress,
+		Type:    events.ERC20Destination,
+	}
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := big.NewInt(1e18)
+
+	// Send with the estimated required gas limit
+	estimate, err := bridge.EstimateDeliveryGas(ctx, source, destination, amount, bridge.DeliveryGasOptions{
+		Recipient: recipientAddress,
+		Sender:    fundedAddress,
+	})
+	Expect(err).Should(BeNil())
+	Expect(estimate.Route).Should(Equal(bridge.SourceToDestination))
+	Expect(estimate.RequiredGasLimit.Uint64()).Should(BeNumerically(">", estimate.ExecutionGas))
+	Expect(estimate.ReceiveGasLimit).Should(BeNumerically(">", estimate.RequiredGasLimit.Uint64()))
+	Expect(estimate.ReceiveFee.Sign()).Should(Equal(1))
+
+	receipt, sentAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         estimate.RequiredGasLimit,
+		},
+		amount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, sentAmount)
+	Expect(receipt.GasUsed).Should(BeNumerically("<=", estimate.ReceiveGasLimit))
+
+	// The estimate of a sendAndCall includes decoding its payload, and the gas made available to the recipient
+	receiverAddress, receiver := utils.DeployMockERC20SendAndCallReceiver(ctx, fundedKey, subnetAInfo)
+	recipientGasLimit := teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas)
+	call := bridge.SendAndCallInput{
+		RecipientContract: receiverAddress,
+		RecipientPayload:  []byte{1},
+		RecipientGasLimit: recipientGasLimit,
+		FallbackRecipient: recipientAddress,
+	}
+	smallEstimate, err := bridge.EstimateDeliveryGas(ctx, source, destination, amount, bridge.DeliveryGasOptions{
+		Call:   &call,
+		Sender: fundedAddress,
+	})
+	Expect(err).Should(BeNil())
+	Expect(smallEstimate.ExecutionGas).Should(BeNumerically(">", recipientGasLimit.Uint64()))
+
+	call.RecipientPayload = bytes.Repeat([]byte{1}, 4096)
+	largeEstimate, err := bridge.EstimateDeliveryGas(ctx, source, destination, amount, bridge.DeliveryGasOptions{
+		Call:   &call,
+		Sender: fundedAddress,
+	})
+	Expect(err).Should(BeNil())
+	Expect(largeEstimate.ExecutionGas).Should(BeNumerically(">", smallEstimate.ExecutionGas))
+	Expect(largeEstimate.ReceiveGasLimit).Should(BeNumerically(">", smallEstimate.ReceiveGasLimit))
+
+	// Send the large payload with the estimated required gas limit
+	receipt, calledAmount := utils.SendAndCallERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendAndCallInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			RecipientContract:        receiverAddress,
+			RecipientPayload:         call.RecipientPayload,
+			RequiredGasLimit:         largeEstimate.RequiredGasLimit,
+			RecipientGasLimit:        recipientGasLimit,
+			FallbackRecipient:        recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+		},
+		amount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	Expect(receipt.GasUsed).Should(BeNumerically("<=", largeEstimate.ReceiveGasLimit))
+
+	succeededEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseCallSucceeded)
+	Expect(err).Should(BeNil())
+	Expect(succeededEvent.RecipientContract).Should(Equal(receiverAddress))
+	teleporterUtils.ExpectBigEqual(succeededEvent.Amount, calledAmount)
+	receivedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, receiver.ParseTokensReceived)
+	Expect(err).Should(BeNil())
+	Expect(receivedEvent.Payload).Should(Equal(call.RecipientPayload))
+}
@@ -0,0 +1,139 @@
+package flows
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, without registering it with the source
+ * Check that the SDK's pre-flight check of a send to the destination reports the missing allowance and
+ * registration, and the missing balance of a sender without tokens
+ * Check that the SDK's send path refuses the send without sending it
+ * Registers the destination, and check that only the allowance is reported, which the send path approves
+ * Bridges C-Chain example ERC20 tokens to Subnet A through the SDK's send path, which are delivered
+ * Check that a send from the destination to another address than its source is reported
+ */
+func SDKPreflightSend(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo)
+	erc20SourceAddress, _, sourceTokenAddress, _ := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: erc20DestinationAddress,
+		Type:    events.ERC20Destination,
+	}
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+	input := bridge.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+
+	// The funded account holds the tokens, but has not approved them, and the destination is not registered
+	problems, err := bridge.Preflight(ctx, fundedAddress, source, input, amount)
+	Expect(err).Should(BeNil())
+	Expect(problems).Should(ConsistOf(
+		And(
+			HaveField("Kind", bridge.InsufficientAllowance),
+			HaveField("Token", sourceTokenAddress),
+			HaveField("Required", amount),
+		),
+		HaveField("Kind", bridge.DestinationNotRegistered),
+	))
+
+	// An account without tokens is also reported as holding too few
+	emptyKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	problems, err = bridge.Preflight(ctx, crypto.PubkeyToAddress(emptyKey.PublicKey), source, input, amount)
+	Expect(err).Should(BeNil())
+	Expect(problems).Should(ConsistOf(
+		And(
+			HaveField("Kind", bridge.InsufficientBalance),
+			HaveField("Token", sourceTokenAddress),
+		),
+		HaveField("Kind", bridge.InsufficientAllowance),
+		HaveField("Kind", bridge.DestinationNotRegistered),
+	))
+
+	// The send path approves allowances itself, so only refuses the send for the registration
+	opts, err := bind.NewKeyedTransactorWithChainID(fundedKey, cChainInfo.EVMChainID)
+	Expect(err).Should(BeNil())
+	nonce, err := cChainInfo.RPCClient.NonceAt(ctx, fundedAddress, nil)
+	Expect(err).Should(BeNil())
+	_, err = bridge.Send(ctx, opts, source, input, amount, nil)
+	var preflightErr *bridge.PreflightError
+	Expect(errors.As(err, &preflightErr)).Should(BeTrue(), "%v is not a pre-flight error", err)
+	Expect(preflightErr.Problems).Should(ConsistOf(HaveField("Kind", bridge.DestinationNotRegistered)))
+	nonceAfter, err := cChainInfo.RPCClient.NonceAt(ctx, fundedAddress, nil)
+	Expect(err).Should(BeNil())
+	Expect(nonceAfter).Should(Equal(nonce), "the refused send sent a transaction")
+
+	s.Register(subnetAInfo)
+	problems, err = bridge.Preflight(ctx, fundedAddress, source, input, amount)
+	Expect(err).Should(BeNil())
+	Expect(problems).Should(ConsistOf(HaveField("Kind", bridge.InsufficientAllowance)))
+
+	receipt, err := bridge.Send(ctx, opts, source, input, amount, nil)
+	Expect(err).Should(BeNil())
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, amount)
+
+	// A destination only sends single hops to its source
+	problems, err = bridge.Preflight(ctx, recipientAddress, destination, bridge.SendTokensInput{
+		DestinationBlockchainID:  cChainInfo.BlockchainID,
+		DestinationBridgeAddress: sourceTokenAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   erc20DestinationAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}, amount)
+	Expect(err).Should(BeNil())
+	Expect(problems).Should(ConsistOf(
+		HaveField("Kind", bridge.InsufficientAllowance),
+		HaveField("Kind", bridge.DestinationNotRegistered),
+	))
+}
@@ -0,0 +1,112 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Bridges C-Chain example ERC20 tokens to Subnet A twice through the SDK's send path, with an allowance manager
+ * approving the maximum amount
+ * Check that only the first send approved the source, and that both sends are delivered with the quoted amount
+ */
+func SDKSendWithMaxApproval(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, _, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: erc20DestinationAddress,
+		Type:    events.ERC20Destination,
+	}
+	allowances := &bridge.AllowanceManager{ApprovalAmount: abi.MaxUint256}
+	opts, err := bind.NewKeyedTransactorWithChainID(fundedKey, cChainInfo.EVMChainID)
+	Expect(err).Should(BeNil())
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+	input := bridge.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+
+	for i := 0; i < 2; i++ {
+		// The first send approves the maximum amount, which covers the second
+		approval, err := allowances.ApprovalNeeded(
+			ctx,
+			cChainInfo.RPCClient,
+			fundedAddress,
+			sourceTokenAddress,
+			erc20SourceAddress,
+			amount,
+		)
+		Expect(err).Should(BeNil())
+		if i == 0 {
+			teleporterUtils.ExpectBigEqual(approval, abi.MaxUint256)
+		} else {
+			Expect(approval).Should(BeNil())
+		}
+
+		quote, err := bridge.Quote(ctx, source, destination, amount, bridge.QuoteOptions{})
+		Expect(err).Should(BeNil())
+		receipt, err := bridge.Send(ctx, opts, source, input, amount, allowances)
+		Expect(err).Should(BeNil())
+
+		allowance, err := sourceToken.Allowance(&bind.CallOpts{Context: ctx}, fundedAddress, erc20SourceAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(allowance, abi.MaxUint256)
+
+		receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+		utils.CheckERC20DestinationWithdrawal(
+			ctx,
+			erc20Destination,
+			receipt,
+			recipientAddress,
+			quote.DestinationAmount,
+		)
+	}
+	s.ExpectBalance(subnetAInfo, recipientAddress, new(big.Int).Mul(amount, big.NewInt(2)))
+}
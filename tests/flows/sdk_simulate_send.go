@@ -0,0 +1,236 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Simulates a send from the C-Chain to Subnet A before approving the source, and checks that the approval is
+ * returned without simulating the send
+ * Simulates a send to the zero address, and checks that the simulation returns the revert reason
+ * Approves the source, simulates the send, and checks the predicted events and balance changes against the send
+ * Simulates bridging the tokens back from Subnet A, and checks the predicted events against the send
+ */
+func SDKSimulateSend(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: erc20DestinationAddress,
+		Type:    events.ERC20Destination,
+	}
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+	input := bridge.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(1e18),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	spent := new(big.Int).Add(amount, input.PrimaryFee)
+
+	// Without an allowance, the simulation returns the approval needed instead of simulating the send
+	options := bridge.SimulateOptions{}
+	simulation, err := bridge.SimulateSend(ctx, fundedAddress, source, destination, input, amount, options)
+	Expect(err).Should(BeNil())
+	Expect(simulation.Approvals).Should(HaveLen(1))
+	Expect(simulation.Approvals[0].Token).Should(Equal(sourceTokenAddress))
+	Expect(simulation.Approvals[0].Spender).Should(Equal(erc20SourceAddress))
+	teleporterUtils.ExpectBigEqual(simulation.Approvals[0].Amount, spent)
+	Expect(simulation.Events).Should(BeEmpty())
+	utils.ApproveIfNeeded(ctx, cChainInfo, fundedKey, sourceTokenAddress, sourceToken, erc20SourceAddress, spent)
+
+	// A send that the source would reject returns its revert reason
+	invalidInput := input
+	invalidInput.Recipient = common.Address{}
+	_, err = bridge.SimulateSend(ctx, fundedAddress, source, destination, invalidInput, amount, options)
+	Expect(err).ShouldNot(BeNil())
+	Expect(err.Error()).Should(ContainSubstring("zero recipient address"))
+
+	simulation, err = bridge.SimulateSend(ctx, fundedAddress, source, destination, input, amount, options)
+	Expect(err).Should(BeNil())
+	Expect(simulation.Approvals).Should(BeEmpty())
+	Expect(simulation.Events).Should(HaveLen(2))
+	Expect(simulation.BalanceChanges).Should(HaveLen(2))
+	expectBalanceChange(simulation.BalanceChanges[0], fundedAddress, sourceTokenAddress, new(big.Int).Neg(spent))
+	expectBalanceChange(simulation.BalanceChanges[1], recipientAddress, erc20DestinationAddress, amount)
+
+	// The send spends the amount and primary fee, as predicted
+	balanceBefore, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, fundedAddress)
+	Expect(err).Should(BeNil())
+	receipt, _ := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	balanceAfter, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, fundedAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(new(big.Int).Sub(balanceAfter, balanceBefore), simulation.BalanceChanges[0].Amount)
+
+	expectSimulatedSend(simulation.Events[0], receipt.Logs, source, fundedAddress, amount)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	expectSimulatedDelivery(simulation.Events[1], receipt.Logs, destination, recipientAddress, amount)
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, amount)
+
+	// Simulate bridging the tokens back to the C-Chain from the recipient
+	s.Fund(subnetAInfo, recipientAddress, big.NewInt(1e18))
+	returnInput := bridge.SendTokensInput{
+		DestinationBlockchainID:  cChainInfo.BlockchainID,
+		DestinationBridgeAddress: erc20SourceAddress,
+		Recipient:                fundedAddress,
+		PrimaryFeeTokenAddress:   erc20DestinationAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	utils.ApproveIfNeeded(
+		ctx,
+		subnetAInfo,
+		recipientKey,
+		erc20DestinationAddress,
+		erc20Destination,
+		erc20DestinationAddress,
+		amount,
+	)
+	simulation, err = bridge.SimulateSend(
+		ctx,
+		recipientAddress,
+		destination,
+		source,
+		returnInput,
+		amount,
+		options,
+	)
+	Expect(err).Should(BeNil())
+	Expect(simulation.Events).Should(HaveLen(2))
+
+	receipt, _ = utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		erc20Destination,
+		erc20DestinationAddress,
+		erc20destination.SendTokensInput(returnInput),
+		amount,
+		recipientKey,
+	)
+	expectSimulatedSend(simulation.Events[0], receipt.Logs, destination, recipientAddress, amount)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	expectSimulatedDelivery(simulation.Events[1], receipt.Logs, source, fundedAddress, amount)
+}
+
+// Checks the account, token, and amount of a simulated balance change
+func expectBalanceChange(change bridge.BalanceChange, account common.Address, token common.Address, amount *big.Int) {
+	Expect(change.Account).Should(Equal(account))
+	Expect(change.Token).Should(Equal(token))
+	teleporterUtils.ExpectBigEqual(change.Amount, amount)
+}
+
+// Checks that the simulated TokensSent event matches the one emitted by the send
+func expectSimulatedSend(
+	simulated events.Event,
+	logs []*types.Log,
+	source bridge.Endpoint,
+	sender common.Address,
+	amount *big.Int,
+) {
+	Expect(simulated.Type).Should(Equal(events.TokensSent))
+	event := decodeBridgeEvent(logs, source, events.TokensSent)
+	Expect(simulated.TeleporterMessageID).Should(Equal(event.TeleporterMessageID))
+	Expect(simulated.Contract).Should(Equal(source.Address))
+	Expect(simulated.Sender).Should(Equal(sender))
+	Expect(simulated.Recipient).Should(Equal(event.Recipient))
+	teleporterUtils.ExpectBigEqual(simulated.Amount, event.Amount)
+	teleporterUtils.ExpectBigEqual(simulated.Amount, amount)
+}
+
+// Checks that the simulated TokensWithdrawn event matches the one emitted by the delivery
+func expectSimulatedDelivery(
+	simulated events.Event,
+	logs []*types.Log,
+	destination bridge.Endpoint,
+	recipient common.Address,
+	amount *big.Int,
+) {
+	Expect(simulated.Type).Should(Equal(events.TokensWithdrawn))
+	event := decodeBridgeEvent(logs, destination, events.TokensWithdrawn)
+	Expect(simulated.Contract).Should(Equal(destination.Address))
+	Expect(simulated.Recipient).Should(Equal(recipient))
+	Expect(simulated.Recipient).Should(Equal(event.Recipient))
+	teleporterUtils.ExpectBigEqual(simulated.Amount, event.Amount)
+	teleporterUtils.ExpectBigEqual(simulated.Amount, amount)
+
+	decoder, err := events.NewDecoder()
+	Expect(err).Should(BeNil())
+	received, ok := decoder.DecodeReceivedMessage(logs)
+	Expect(ok).Should(BeTrue())
+	Expect(simulated.ReceivedMessageID).Should(Equal(received.MessageID))
+	Expect(simulated.SourceBridgeAddress).Should(Equal(received.OriginSenderAddress))
+}
+
+// Returns the first event of the given type emitted by the bridge contract in the logs
+func decodeBridgeEvent(logs []*types.Log, endpoint bridge.Endpoint, eventType events.EventType) *events.Event {
+	decoder, err := events.NewDecoder()
+	Expect(err).Should(BeNil())
+	for _, log := range logs {
+		if log.Address != endpoint.Address {
+			continue
+		}
+		event, ok, err := decoder.Decode(*log, events.ContractInfo{Type: endpoint.Type})
+		Expect(err).Should(BeNil())
+		if ok && event.Type == eventType {
+			return event
+		}
+	}
+	Expect(false).Should(BeTrue(), "no %s event emitted by %s", eventType, endpoint.Address)
+	return nil
+}
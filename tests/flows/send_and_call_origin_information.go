@@ -0,0 +1,215 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	mockERC20SACR "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/MockERC20SendAndCallReceiver"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A and Subnet B, and a mock send and call receiver to each chain
+ * Calls the receiver on Subnet A from an account on the C-Chain, and checks that the receiver is passed the
+ * C-Chain's blockchain ID and the sending account, rather than the source contract or the funded account
+ * Calls the receiver on the C-Chain from an account on Subnet A, and checks that the receiver is passed
+ * Subnet A's blockchain ID and the sending account
+ * Calls the receiver on Subnet B from the account on Subnet A through multi-hop, and checks that the receiver is
+ * passed Subnet A's blockchain ID and the sending account, rather than the C-Chain and the source contract that
+ * routed the transfer
+ */
+func SendAndCallOriginInformation(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		DeployERC20Destination(subnetBInfo).
+		Register(subnetAInfo).
+		Register(subnetBInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	subnetADestinationAddress, subnetADestination := s.Destination(subnetAInfo)
+	subnetBDestinationAddress, subnetBDestination := s.Destination(subnetBInfo)
+
+	cChainReceiverAddress, cChainReceiver := utils.DeployMockERC20SendAndCallReceiver(ctx, fundedKey, cChainInfo)
+	subnetAReceiverAddress, subnetAReceiver := utils.DeployMockERC20SendAndCallReceiver(ctx, fundedKey, subnetAInfo)
+	subnetBReceiverAddress, subnetBReceiver := utils.DeployMockERC20SendAndCallReceiver(ctx, fundedKey, subnetBInfo)
+
+	// The senders are neither the funded account that deployed the contracts nor the relayer, so that the origin
+	// sender cannot match either of them by accident
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10))
+	cChainSenderKey := utils.NewAccountKey()
+	cChainSenderAddress := crypto.PubkeyToAddress(cChainSenderKey.PublicKey)
+	subnetASenderKey := utils.NewAccountKey()
+	subnetASenderAddress := crypto.PubkeyToAddress(subnetASenderKey.PublicKey)
+	s.Fund(cChainInfo, cChainSenderAddress, big.NewInt(1e18)).
+		Fund(subnetAInfo, subnetASenderAddress, big.NewInt(1e18)).
+		Send(cChainInfo, subnetAInfo, subnetASenderAddress, amount)
+	utils.FundAccountsERC20(
+		ctx,
+		cChainInfo,
+		fundedKey,
+		sourceTokenAddress,
+		sourceToken,
+		[]common.Address{cChainSenderAddress},
+		amount,
+	)
+
+	fallbackAddress := crypto.PubkeyToAddress(utils.NewAccountKey().PublicKey)
+	sendAmount := big.NewInt(1e18)
+	payload := []byte{1}
+
+	// Call the receiver on Subnet A from the C-Chain
+	{
+		receipt, bridgedAmount := utils.SendAndCallERC20Source(
+			ctx,
+			cChainInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			erc20source.SendAndCallInput{
+				DestinationBlockchainID:  subnetAInfo.BlockchainID,
+				DestinationBridgeAddress: subnetADestinationAddress,
+				RecipientContract:        subnetAReceiverAddress,
+				RecipientPayload:         payload,
+				RequiredGasLimit:         teleporterUtils.BigIntMul(big.NewInt(10), utils.DefaultERC20RequiredGas),
+				RecipientGasLimit:        teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas),
+				FallbackRecipient:        fallbackAddress,
+				PrimaryFeeTokenAddress:   sourceTokenAddress,
+				PrimaryFee:               big.NewInt(0),
+				SecondaryFee:             big.NewInt(0),
+			},
+			sendAmount,
+			cChainSenderKey,
+		)
+		receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+
+		_, err := teleporterUtils.GetEventFromLogs(receipt.Logs, subnetADestination.ParseCallSucceeded)
+		Expect(err).Should(BeNil())
+		expectOriginInformation(
+			receipt,
+			subnetAReceiverAddress,
+			subnetAReceiver,
+			cChainInfo.BlockchainID,
+			cChainSenderAddress,
+			bridgedAmount,
+		)
+	}
+
+	// Call the receiver on the C-Chain from Subnet A
+	{
+		receipt, bridgedAmount := utils.SendAndCallERC20Destination(
+			ctx,
+			subnetAInfo,
+			subnetADestination,
+			subnetADestinationAddress,
+			erc20destination.SendAndCallInput{
+				DestinationBlockchainID:  cChainInfo.BlockchainID,
+				DestinationBridgeAddress: erc20SourceAddress,
+				RecipientContract:        cChainReceiverAddress,
+				RecipientPayload:         payload,
+				RequiredGasLimit:         teleporterUtils.BigIntMul(big.NewInt(10), utils.DefaultERC20RequiredGas),
+				RecipientGasLimit:        teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas),
+				FallbackRecipient:        fallbackAddress,
+				PrimaryFeeTokenAddress:   subnetADestinationAddress,
+				PrimaryFee:               big.NewInt(0),
+				SecondaryFee:             big.NewInt(0),
+			},
+			sendAmount,
+			subnetASenderKey,
+		)
+		receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+
+		_, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseCallSucceeded)
+		Expect(err).Should(BeNil())
+		expectOriginInformation(
+			receipt,
+			cChainReceiverAddress,
+			cChainReceiver,
+			subnetAInfo.BlockchainID,
+			subnetASenderAddress,
+			bridgedAmount,
+		)
+	}
+
+	// Call the receiver on Subnet B from Subnet A through multi-hop. The source routes the transfer, but the
+	// receiver is still passed the chain and account it was sent from.
+	{
+		originReceipt, bridgedAmount := utils.SendAndCallERC20Destination(
+			ctx,
+			subnetAInfo,
+			subnetADestination,
+			subnetADestinationAddress,
+			erc20destination.SendAndCallInput{
+				DestinationBlockchainID:  subnetBInfo.BlockchainID,
+				DestinationBridgeAddress: subnetBDestinationAddress,
+				RecipientContract:        subnetBReceiverAddress,
+				RecipientPayload:         payload,
+				RequiredGasLimit:         teleporterUtils.BigIntMul(big.NewInt(10), utils.DefaultERC20RequiredGas),
+				RecipientGasLimit:        teleporterUtils.BigIntMul(big.NewInt(5), utils.DefaultERC20RequiredGas),
+				MultiHopFallback:         fallbackAddress,
+				FallbackRecipient:        fallbackAddress,
+				PrimaryFeeTokenAddress:   subnetADestinationAddress,
+				PrimaryFee:               big.NewInt(0),
+				SecondaryFee:             big.NewInt(0),
+			},
+			sendAmount,
+			subnetASenderKey,
+		)
+		intermediateReceipt := network.RelayMessage(ctx, originReceipt, subnetAInfo, cChainInfo, true)
+		routed, err := teleporterUtils.GetEventFromLogs(intermediateReceipt.Logs, erc20Source.ParseTokensAndCallRouted)
+		Expect(err).Should(BeNil())
+		Expect(routed.Input.RecipientContract).Should(Equal(subnetBReceiverAddress))
+
+		receipt := network.RelayMessage(ctx, intermediateReceipt, cChainInfo, subnetBInfo, true)
+		_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, subnetBDestination.ParseCallSucceeded)
+		Expect(err).Should(BeNil())
+		expectOriginInformation(
+			receipt,
+			subnetBReceiverAddress,
+			subnetBReceiver,
+			subnetAInfo.BlockchainID,
+			subnetASenderAddress,
+			bridgedAmount,
+		)
+	}
+}
+
+// Checks that the receiver was called once in the receipt, with the expected origin of the transfer
+func expectOriginInformation(
+	receipt *types.Receipt,
+	receiverAddress common.Address,
+	receiver *mockERC20SACR.MockERC20SendAndCallReceiver,
+	originBlockchainID ids.ID,
+	originSenderAddress common.Address,
+	amount *big.Int,
+) {
+	var calls []*mockERC20SACR.MockERC20SendAndCallReceiverTokensReceived
+	for _, log := range receipt.Logs {
+		if log.Address != receiverAddress {
+			continue
+		}
+		event, err := receiver.ParseTokensReceived(*log)
+		if err == nil {
+			calls = append(calls, event)
+		}
+	}
+	Expect(calls).Should(HaveLen(1))
+	Expect(ids.ID(calls[0].SourceBlockchainID)).Should(Equal(originBlockchainID))
+	Expect(calls[0].OriginSenderAddress).Should(Equal(originSenderAddress))
+	Expect(calls[0].Amount).Should(Equal(amount))
+}
@@ -0,0 +1,166 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Bridges C-Chain example ERC20 tokens to Subnet A with a required gas limit below the gas of the delivery, and
+ * checks that the delivery succeeds but the message execution fails, leaving the tokens unminted
+ * Delivers the same signed message again with a much higher gas limit, and checks that it is rejected, since the
+ * required gas limit is part of the signed message and the message is already received
+ * Retries the execution through the SDK with all the gas of the transaction, which mints the tokens
+ * Configures the Subnet A destination's required gas limit in the SDK from its estimated delivery gas, and
+ * checks that a quoted send uses it and is delivered on its first attempt, and that a quote can override it
+ */
+func SendGasLimit(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: erc20DestinationAddress,
+		Type:    events.ERC20Destination,
+	}
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	// Send with half of the gas that the destination's receive uses
+	estimate, err := bridge.EstimateDeliveryGas(ctx, source, destination, amount, bridge.DeliveryGasOptions{
+		Recipient: recipientAddress,
+		Sender:    fundedAddress,
+	})
+	Expect(err).Should(BeNil())
+	lowGasLimit := new(big.Int).SetUint64(estimate.ExecutionGas / 2)
+	sendReceipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		erc20source.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         lowGasLimit,
+		},
+		amount,
+		fundedKey,
+	)
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		sendReceipt.Logs,
+		cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(sendEvent.Message.RequiredGasLimit, lowGasLimit)
+
+	// The delivery succeeds, since Teleporter stores the failed message to be retried, but nothing is minted
+	receipt := network.RelayMessage(ctx, sendReceipt, cChainInfo, subnetAInfo, true)
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseTransfer)
+	Expect(err).ShouldNot(BeNil())
+	failed, err := bridge.FailedMessages(ctx, destination.Chain, receipt.TxHash)
+	Expect(err).Should(BeNil())
+	Expect(failed).Should(HaveLen(1))
+	Expect(failed[0].MessageID[:]).Should(Equal(sendEvent.MessageID[:]))
+	s.ExpectBalance(subnetAInfo, recipientAddress, big.NewInt(0))
+
+	// The message can't be re-relayed with a higher limit. The limit is signed as part of the message, so a
+	// delivery with more gas executes with the same limit, and the messenger rejects it as already received.
+	signedMessage := network.ConstructSignedWarpMessage(ctx, sendReceipt, cChainInfo, subnetAInfo)
+	receipt = utils.DeliverRawTeleporterMessage(
+		ctx,
+		subnetAInfo,
+		signedMessage,
+		teleporterUtils.BigIntMul(big.NewInt(10), estimate.RequiredGasLimit),
+		network.GetTeleporterContractAddress(),
+		fundedKey,
+	)
+	Expect(receipt.Status).Should(Equal(types.ReceiptStatusFailed))
+	s.ExpectBalance(subnetAInfo, recipientAddress, big.NewInt(0))
+
+	// Retrying the execution gives it all the gas of the retry transaction, which mints the tokens
+	retryOpts := utils.NewTransactor(ctx, subnetAInfo, fundedKey)
+	receipt, err = bridge.RetryMessage(ctx, retryOpts, destination.Chain, failed[0])
+	Expect(err).Should(BeNil())
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, bridgedAmount)
+	s.ExpectBalance(subnetAInfo, recipientAddress, bridgedAmount)
+
+	// A destination configured with its estimated limit is quoted with it, in place of the default of its type
+	destination.RequiredGasLimit = estimate.RequiredGasLimit
+	quote, err := bridge.Quote(ctx, source, destination, amount, bridge.QuoteOptions{})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(quote.PrimaryRequiredGasLimit, estimate.RequiredGasLimit)
+	overridden, err := bridge.Quote(ctx, source, destination, amount, bridge.QuoteOptions{
+		RequiredGasLimit: lowGasLimit,
+	})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(overridden.PrimaryRequiredGasLimit, lowGasLimit)
+
+	// A send with the configured limit is executed on its first delivery
+	sendReceipt, err = bridge.Send(
+		ctx,
+		utils.NewTransactor(ctx, cChainInfo, fundedKey),
+		source,
+		bridge.SendTokensInput{
+			DestinationBlockchainID:  subnetAInfo.BlockchainID,
+			DestinationBridgeAddress: erc20DestinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   sourceTokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         quote.PrimaryRequiredGasLimit,
+		},
+		amount,
+		nil,
+	)
+	Expect(err).Should(BeNil())
+	receipt = network.RelayMessage(ctx, sendReceipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(ctx, erc20Destination, receipt, recipientAddress, quote.DestinationAmount)
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, new(big.Int).Add(bridgedAmount, quote.DestinationAmount))
+}
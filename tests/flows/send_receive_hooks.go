@@ -0,0 +1,88 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Registers hooks that count each send and receive, and replace the primary fee of each send
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A, and registers it with the source
+ * Bridges C-Chain example ERC20 tokens to Subnet A and back, without a primary fee set by the flow
+ * Check that each send was made with the primary fee set by the hook, and that the hooks were called
+ * around each send and receive
+ */
+func SendReceiveHooks(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+
+	hookPrimaryFee := big.NewInt(1e15)
+	var (
+		beforeSends, afterSends       int
+		beforeReceives, afterReceives int
+		sentFees                      []*big.Int
+		sendReceipts                  []*types.Receipt
+	)
+	unregister := utils.RegisterHooks(utils.Hooks{
+		BeforeSend: func(_ context.Context, send *utils.SendHookInfo) {
+			beforeSends++
+			send.PrimaryFee = hookPrimaryFee
+		},
+		AfterSend: func(_ context.Context, send utils.SendHookInfo, receipt *types.Receipt) {
+			afterSends++
+			sentFees = append(sentFees, send.PrimaryFee)
+			sendReceipts = append(sendReceipts, receipt)
+		},
+		BeforeReceive: func(_ context.Context, _ utils.ReceiveHookInfo) {
+			beforeReceives++
+		},
+		AfterReceive: func(_ context.Context, _ utils.ReceiveHookInfo, receipt *types.Receipt) {
+			Expect(receipt.Status).Should(Equal(types.ReceiptStatusSuccessful))
+			afterReceives++
+		},
+	})
+	defer unregister()
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+	returnAmount := new(big.Int).Sub(amount, hookPrimaryFee)
+
+	s := scenario.New(context.Background(), network).
+		DeployERC20Source(cChainInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo).
+		Send(cChainInfo, subnetAInfo, recipientAddress, amount)
+
+	// The send's TokensSent event records the fee it was sent with
+	_, erc20Source, _, _ := s.Source()
+	sendEvent, err := teleporterUtils.GetEventFromLogs(sendReceipts[0].Logs, erc20Source.ParseTokensSent)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(sendEvent.Input.PrimaryFee, hookPrimaryFee)
+
+	// The recipient pays the hook's fee out of the tokens it received when bridging back
+	s.Fund(subnetAInfo, recipientAddress, big.NewInt(1e18)).
+		As(recipientKey).
+		Send(subnetAInfo, cChainInfo, recipientAddress, returnAmount).
+		ExpectBalance(subnetAInfo, recipientAddress, big.NewInt(0)).
+		ExpectBalance(cChainInfo, recipientAddress, returnAmount)
+
+	Expect(beforeSends).Should(Equal(2))
+	Expect(afterSends).Should(Equal(2))
+	for _, fee := range sentFees {
+		teleporterUtils.ExpectBigEqual(fee, hookPrimaryFee)
+	}
+	// The registration is also received, in addition to the two sends
+	Expect(beforeReceives).Should(Equal(3))
+	Expect(afterReceives).Should(Equal(3))
+}
@@ -0,0 +1,190 @@
+package flows
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+// The nonce gap given to a dropped transaction, which leaves it queued without ever being mined
+const droppedNonceGap = 1000
+
+/**
+ * Deploys an ERC20Source contract on the C-Chain
+ * Deploys a NativeTokenDestination contract on Subnet A, which needs collateral once registered
+ * Sets up the destination with the SDK, relaying the registration, while the transaction adding the collateral is
+ * dropped, and checks that the setup fails at that step with the registration delivered and the collateral still
+ * needed, and that sends to the destination revert in the meantime
+ * Runs the setup again, and checks that it skips the registration, adds the collateral, and that a send to the
+ * destination is then minted
+ */
+func SetupPartialFailure(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(ctx, fundedKey, cChainInfo)
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+	nativeTokenDestinationAddress, _ := utils.DeployNativeTokenDestination(
+		ctx,
+		subnetAInfo,
+		"SUBA",
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
+	)
+
+	source := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "C-Chain",
+			BlockchainID: cChainInfo.BlockchainID,
+			Client:       cChainInfo.RPCClient,
+		},
+		Address: erc20SourceAddress,
+		Type:    events.ERC20Source,
+	}
+	destination := bridge.Endpoint{
+		Chain: &events.Chain{
+			Name:         "Subnet A",
+			BlockchainID: subnetAInfo.BlockchainID,
+			Client:       subnetAInfo.RPCClient,
+		},
+		Address: nativeTokenDestinationAddress,
+		Type:    events.NativeTokenDestination,
+	}
+	collateralNeeded := tokenscaling.CollateralNeeded(
+		utils.GetTokenMultiplier(decimalsShift),
+		multiplyOnDestination,
+		initialReserveImbalance,
+	)
+	relay := func(ctx context.Context, registration *types.Receipt) error {
+		network.RelayMessage(ctx, registration, subnetAInfo, cChainInfo, true)
+		return nil
+	}
+
+	// Drop the second transaction on the C-Chain, the addition of the collateral after its approval, by signing it
+	// with a nonce far ahead of the account's
+	sourceOpts := utils.NewTransactor(ctx, cChainInfo, fundedKey)
+	signer, signed := sourceOpts.Signer, 0
+	sourceOpts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		signed++
+		if signed == 2 {
+			tx = types.NewTx(&types.DynamicFeeTx{
+				ChainID:   tx.ChainId(),
+				Nonce:     tx.Nonce() + droppedNonceGap,
+				GasTipCap: tx.GasTipCap(),
+				GasFeeCap: tx.GasFeeCap(),
+				Gas:       tx.Gas(),
+				To:        tx.To(),
+				Value:     tx.Value(),
+				Data:      tx.Data(),
+			})
+		}
+		return signer(address, tx)
+	}
+	setupCtx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	defer cancel()
+	_, err := bridge.SetupDestination(setupCtx, source, destination, bridge.SetupOptions{
+		DestinationOpts: utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		SourceOpts:      sourceOpts,
+		Relay:           relay,
+	})
+	var setupErr *bridge.SetupError
+	Expect(errors.As(err, &setupErr)).Should(BeTrue())
+	Expect(setupErr.Step).Should(Equal(bridge.StepAddCollateral))
+	Expect(setupErr.Completed).Should(Equal([]bridge.SetupStep{
+		bridge.StepRegister,
+		bridge.StepDeliverRegistration,
+		bridge.StepApproveCollateral,
+	}))
+	Expect(errors.Is(err, context.DeadlineExceeded)).Should(BeTrue())
+	Expect(setupErr.Guidance()).Should(ContainSubstring("Run the setup again"))
+
+	// The registration was delivered, but the collateral is still needed, so sends to the destination revert
+	settings, err := erc20Source.RegisteredDestinations(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	Expect(settings.Registered).Should(BeTrue())
+	teleporterUtils.ExpectBigEqual(settings.CollateralNeeded, collateralNeeded)
+	_, err = bridge.Quote(ctx, source, destination, big.NewInt(1e18), bridge.QuoteOptions{})
+	Expect(err).Should(MatchError(ContainSubstring("more collateral")))
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10))
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: nativeTokenDestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultNativeTokenRequiredGas,
+	}
+	teleporterUtils.ERC20Approve(ctx, sourceToken, erc20SourceAddress, amount, cChainInfo, fundedKey)
+	_, err = erc20Source.Send(utils.NewTransactor(ctx, cChainInfo, fundedKey), input, amount)
+	Expect(err).ShouldNot(BeNil())
+
+	// Running the setup again skips the delivered registration and adds the collateral
+	result, err := bridge.SetupDestination(ctx, source, destination, bridge.SetupOptions{
+		DestinationOpts: utils.NewTransactor(ctx, subnetAInfo, fundedKey),
+		SourceOpts:      utils.NewTransactor(ctx, cChainInfo, fundedKey),
+		Relay:           relay,
+	})
+	Expect(err).Should(BeNil())
+	Expect(result.RegistrationTx).Should(Equal(common.Hash{}))
+	Expect(result.CollateralTx).ShouldNot(Equal(common.Hash{}))
+	teleporterUtils.ExpectBigEqual(result.Collateral, collateralNeeded)
+	Expect(result.Completed).Should(HaveLen(4))
+	settings, err = erc20Source.RegisteredDestinations(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		nativeTokenDestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(settings.CollateralNeeded, big.NewInt(0))
+
+	// Sends to the destination now succeed and are minted
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	teleporterUtils.CheckBalance(ctx, recipientAddress, bridgedAmount, subnetAInfo.RPCClient)
+}
@@ -0,0 +1,189 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/monitor"
+	"github.com/ava-labs/teleporter-token-bridge/tests/scenario"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// How long the destination subnet stays halted, checking the monitor's behavior
+	subnetOutageDuration = 10 * time.Second
+	// How long polls of a chain must be failing before the monitor alerts that it is unreachable
+	subnetOutageUnreachableSeconds = 2
+)
+
+/**
+ * Deploys an ERC20Source on Subnet B, and an ERC20Destination on Subnet A registered with it
+ * Starts the bridge monitor on both subnets, and waits for it to sample the bridge without drift
+ * Halts the validators of Subnet A, and sends transfers from Subnet B while it is halted, so that their messages are
+ * pending until it is restarted
+ * Checks that the monitor alerts that Subnet A is unreachable, keeps following Subnet B, and reports no drift,
+ * nor any other alert, while Subnet A is halted
+ * Restarts the validators of Subnet A, relays the pending messages, and checks that the transfers are delivered,
+ * the balance bridged to the destination backs its supply, and the monitor resolves its alert, records the
+ * deliveries and reports no drift
+ * The source is on Subnet B rather than the C-Chain, since the validators of Subnet A also validate the primary
+ * network, which may stall while they are halted
+ */
+func SubnetOutage(network interfaces.LocalNetwork) {
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	_, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	s := scenario.New(ctx, network).
+		DeployERC20Source(subnetBInfo).
+		DeployERC20Destination(subnetAInfo).
+		Register(subnetAInfo)
+	erc20SourceAddress, erc20Source, sourceTokenAddress, sourceToken := s.Source()
+	erc20DestinationAddress, erc20Destination := s.Destination(subnetAInfo)
+
+	chainConfig := func(name string, subnet interfaces.SubnetTestInfo) events.ChainConfig {
+		latest, err := subnet.RPCClient.BlockNumber(ctx)
+		Expect(err).Should(BeNil())
+		return events.ChainConfig{
+			Name:         name,
+			BlockchainID: subnet.BlockchainID.String(),
+			RPCEndpoint:  teleporterUtils.HttpToRPCURI(subnet.NodeURIs[0], subnet.BlockchainID.String()),
+			StartBlock:   latest,
+		}
+	}
+	const bridge = "bridge"
+	bridgeMonitor := utils.StartBridgeMonitor(ctx, &monitor.Config{
+		Alerts: monitor.AlertsConfig{UnreachableSeconds: subnetOutageUnreachableSeconds},
+		Chains: []events.ChainConfig{chainConfig("subnet-a", subnetAInfo), chainConfig("subnet-b", subnetBInfo)},
+		Bridges: []events.BridgeConfig{{
+			Name: bridge,
+			Source: events.ContractConfig{
+				Chain:   "subnet-b",
+				Address: erc20SourceAddress.Hex(),
+				Type:    events.ERC20Source,
+			},
+			Destinations: []events.ContractConfig{{
+				Chain:   "subnet-a",
+				Address: erc20DestinationAddress.Hex(),
+				Type:    events.ERC20Destination,
+			}},
+		}},
+	})
+	defer bridgeMonitor.Stop(ctx)
+	value := func(name string, labels map[string]string) func() float64 {
+		return func() float64 {
+			value, ok := bridgeMonitor.Value(name, labels)
+			Expect(ok).Should(BeTrue(), "metric %s%v is not set", name, labels)
+			return value
+		}
+	}
+	destinationLabels := map[string]string{
+		"bridge":              bridge,
+		"destination_chain":   "subnet-a",
+		"destination_address": erc20DestinationAddress.Hex(),
+	}
+	driftExceeded := value("bridge_drift_tolerance_exceeded", map[string]string{"bridge": bridge})
+	Eventually(func() bool {
+		_, ok := bridgeMonitor.Value("bridge_destination_supply_drift", destinationLabels)
+		return ok
+	}, 30*time.Second, time.Second).Should(BeTrue(), "waiting for the monitor to sample the bridge")
+	Expect(value("bridge_destination_supply_drift", destinationLabels)()).Should(BeZero())
+	Expect(driftExceeded()).Should(BeZero())
+
+	// Halt Subnet A, and send transfers to it from Subnet B, whose messages are pending while it is halted
+	haltedNodes := utils.HaltSubnet(ctx, subnetAInfo)
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+	amount := big.NewInt(1e18)
+	var receipts []*types.Receipt
+	for i := 0; i < 2; i++ {
+		receipt, _ := utils.SendERC20Source(
+			ctx,
+			subnetBInfo,
+			erc20Source,
+			erc20SourceAddress,
+			sourceToken,
+			erc20source.SendTokensInput{
+				DestinationBlockchainID:  subnetAInfo.BlockchainID,
+				DestinationBridgeAddress: erc20DestinationAddress,
+				Recipient:                recipientAddress,
+				PrimaryFeeTokenAddress:   sourceTokenAddress,
+				PrimaryFee:               big.NewInt(0),
+				SecondaryFee:             big.NewInt(0),
+				RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+			},
+			amount,
+			fundedKey,
+		)
+		receipts = append(receipts, receipt)
+	}
+
+	// The monitor alerts that Subnet A is unreachable, while still following Subnet B, and doesn't sample the bridge
+	// with one of its chains unreachable, so reports no drift for the pending transfers
+	unreachableKey := "subnet-a/unreachable"
+	Eventually(bridgeMonitor.Alerts, 30*time.Second, time.Second).Should(ContainElement(
+		And(HaveField("Key", unreachableKey), HaveField("Resolved", false)),
+	))
+	Expect(value("bridge_chain_reachable", map[string]string{"chain": "subnet-a"})()).Should(BeZero())
+	Eventually(
+		value("bridge_transfers_total", map[string]string{"bridge": bridge, "from": "subnet-b", "to": "subnet-a"}),
+		30*time.Second,
+		time.Second,
+	).Should(BeEquivalentTo(len(receipts)))
+	Consistently(func() float64 {
+		Expect(bridgeMonitor.Running()).Should(BeTrue())
+		Expect(value("bridge_chain_reachable", map[string]string{"chain": "subnet-b"})()).Should(BeEquivalentTo(1))
+		return driftExceeded()
+	}, subnetOutageDuration, time.Second).Should(BeZero())
+	Expect(bridgeMonitor.Alerts()).Should(HaveEach(HaveField("Key", unreachableKey)))
+
+	// Restart Subnet A, and relay the pending messages, which are delivered
+	network.RestartNodes(ctx, haltedNodes)
+	subnetAInfo, subnetBInfo = teleporterUtils.GetTwoSubnets(network)
+	for _, receipt := range receipts {
+		network.RelayMessage(ctx, receipt, subnetBInfo, subnetAInfo, true)
+	}
+	total := new(big.Int).Mul(amount, big.NewInt(int64(len(receipts))))
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, total)
+	bridgedBalance, err := erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	supply, err := erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, supply)
+
+	// The monitor resolves its alert, and records the deliveries without drift
+	Eventually(bridgeMonitor.Alerts, 30*time.Second, time.Second).Should(ContainElement(
+		And(HaveField("Key", unreachableKey), HaveField("Resolved", true)),
+	))
+	Expect(value("bridge_chain_reachable", map[string]string{"chain": "subnet-a"})()).Should(BeEquivalentTo(1))
+	Eventually(
+		value("bridge_deliveries_total", map[string]string{
+			"bridge": bridge,
+			"chain":  "subnet-a",
+			"type":   string(events.TokensWithdrawn),
+		}),
+		30*time.Second,
+		time.Second,
+	).Should(BeEquivalentTo(len(receipts)))
+	Eventually(func() float64 {
+		return value("bridge_destination_supply_drift", destinationLabels)() + driftExceeded()
+	}, 30*time.Second, time.Second).Should(BeZero())
+	Expect(bridgeMonitor.Alerts()).Should(HaveLen(2))
+}
@@ -0,0 +1,237 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	runner_sdk "github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploys a TeleporterRegistry to the primary network and Subnet A, with the network's Teleporter as version 1
+ * Deploys an ERC20 token source on the primary network and ERC20Destination to Subnet A, using the new registries
+ * Check that the Teleporter messenger of both bridge contracts resolves to version 1
+ * Bridges C-Chain example ERC20 tokens to Subnet A, which are sent and received through version 1
+ * Deploys a new Teleporter messenger to the same address on both chains, and registers it as version 2
+ * Check that the Teleporter messenger of both bridge contracts resolves to version 2
+ * Bridges C-Chain example ERC20 tokens to Subnet A, which are sent and received through version 2
+ *
+ * Only the registries deployed by the flow are updated, so the rest of the suite keeps the network's Teleporter.
+ */
+func TeleporterRegistryResolution(network interfaces.LocalNetwork) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	oldTeleporterAddress, _ := utils.LatestTeleporter(ctx, cChainInfo)
+	subnetATeleporterAddress, _ := utils.LatestTeleporter(ctx, subnetAInfo)
+	Expect(subnetATeleporterAddress).Should(Equal(oldTeleporterAddress))
+
+	cChainInfo = utils.DeployTeleporterRegistry(ctx, fundedKey, cChainInfo, oldTeleporterAddress)
+	subnetAInfo = utils.DeployTeleporterRegistry(ctx, fundedKey, subnetAInfo, oldTeleporterAddress)
+	cChainRegistryAddress := cChainInfo.TeleporterRegistryAddress
+	subnetARegistryAddress := subnetAInfo.TeleporterRegistryAddress
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	expectResolvedTeleporter(ctx, cChainInfo, erc20SourceAddress, oldTeleporterAddress, big.NewInt(1))
+	expectResolvedTeleporter(ctx, subnetAInfo, erc20DestinationAddress, oldTeleporterAddress, big.NewInt(1))
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               big.NewInt(1e18),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	// Bridge tokens, which are sent and received through the registered version
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	expectSentThrough(receipt, cChainInfo, oldTeleporterAddress)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	expectReceivedThrough(receipt, subnetAInfo, oldTeleporterAddress)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		bridgedAmount,
+	)
+
+	// Deploy a new Teleporter messenger to the same address on both chains, so that messages sent through it are
+	// delivered to it on the other chain
+	salt := utils.Create2Salt("TeleporterRegistryResolution")
+	newTeleporterAddress := utils.DeployCreate2(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		teleportermessenger.TeleporterMessengerMetaData,
+		salt,
+	)
+	Expect(utils.DeployCreate2(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		teleportermessenger.TeleporterMessengerMetaData,
+		salt,
+	)).Should(Equal(newTeleporterAddress))
+
+	// Restart the nodes with the off-chain messages to register the new messenger with the flow's registries.
+	// The other chains are not configured, since the flow has no registries on them.
+	networkID := network.GetNetworkID()
+	offChainMessageC, chainConfigC := teleporterUtils.InitOffChainMessageChainConfig(
+		networkID,
+		cChainInfo,
+		newTeleporterAddress,
+		2,
+	)
+	offChainMessageA, chainConfigA := teleporterUtils.InitOffChainMessageChainConfig(
+		networkID,
+		subnetAInfo,
+		newTeleporterAddress,
+		2,
+	)
+	chainConfigs := make(map[string]string)
+	teleporterUtils.SetChainConfig(chainConfigs, cChainInfo, chainConfigC)
+	teleporterUtils.SetChainConfig(chainConfigs, subnetAInfo, chainConfigA)
+	network.RestartNodes(ctx, network.GetAllNodeNames(), runner_sdk.WithChainConfigs(chainConfigs))
+
+	// Restarting the nodes replaces the clients of the network's subnet infos
+	cChainInfo = utils.WithTeleporterRegistry(network.GetPrimaryNetworkInfo(), cChainRegistryAddress)
+	subnetAInfo, _ = teleporterUtils.GetTwoSubnets(network)
+	subnetAInfo = utils.WithTeleporterRegistry(subnetAInfo, subnetARegistryAddress)
+
+	teleporterUtils.AddProtocolVersionAndWaitForAcceptance(
+		ctx,
+		network,
+		cChainInfo,
+		newTeleporterAddress,
+		fundedKey,
+		offChainMessageC,
+	)
+	teleporterUtils.AddProtocolVersionAndWaitForAcceptance(
+		ctx,
+		network,
+		subnetAInfo,
+		newTeleporterAddress,
+		fundedKey,
+		offChainMessageA,
+	)
+
+	// The bridge contracts were not updated, but resolve the new version through their registries
+	expectResolvedTeleporter(ctx, cChainInfo, erc20SourceAddress, newTeleporterAddress, big.NewInt(2))
+	expectResolvedTeleporter(ctx, subnetAInfo, erc20DestinationAddress, newTeleporterAddress, big.NewInt(2))
+
+	// The network's Teleporter is unchanged
+	latestTeleporterAddress, _ := utils.LatestTeleporter(ctx, network.GetPrimaryNetworkInfo())
+	Expect(latestTeleporterAddress).Should(Equal(oldTeleporterAddress))
+
+	// Bridge tokens, which are sent and received through the new version
+	receipt, secondBridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	expectSentThrough(receipt, cChainInfo, newTeleporterAddress)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	expectReceivedThrough(receipt, subnetAInfo, newTeleporterAddress)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		secondBridgedAmount,
+	)
+
+	balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, new(big.Int).Add(bridgedAmount, secondBridgedAmount))
+}
+
+// Checks that the bridge contract resolves its Teleporter messenger to the address at the version
+func expectResolvedTeleporter(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	bridgeAddress common.Address,
+	expectedAddress common.Address,
+	expectedVersion *big.Int,
+) {
+	teleporter, _ := utils.BridgeTeleporter(ctx, subnet, bridgeAddress)
+	Expect(teleporter.Address).Should(Equal(expectedAddress))
+	Expect(teleporter.RegistryAddress).Should(Equal(subnet.TeleporterRegistryAddress))
+	teleporterUtils.ExpectBigEqual(teleporter.Version, expectedVersion)
+}
+
+// Checks that the message in the receipt was sent through the Teleporter messenger
+func expectSentThrough(receipt *types.Receipt, subnet interfaces.SubnetTestInfo, teleporterAddress common.Address) {
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		subnet.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	Expect(sendEvent.Raw.Address).Should(Equal(teleporterAddress))
+}
+
+// Checks that the message in the receipt was received through the Teleporter messenger
+func expectReceivedThrough(receipt *types.Receipt, subnet interfaces.SubnetTestInfo, teleporterAddress common.Address) {
+	receiveEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		subnet.TeleporterMessenger.ParseReceiveCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	Expect(receiveEvent.Raw.Address).Should(Equal(teleporterAddress))
+}
@@ -0,0 +1,263 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/errors"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Check that sending from the C-Chain to Subnet B, where no destination is deployed, or to an unknown
+ * blockchain ID reverts, so no tokens are locked in the source
+ * Bridges C-Chain example ERC20 tokens to Subnet A
+ * Sends a multi-hop transfer from Subnet A to Subnet B, and check that the source sends the tokens
+ * to the multi-hop fallback on the C-Chain, rather than locking them
+ * Bridges C-Chain example ERC20 tokens to Subnet A without delivering the message, and check that the tokens
+ * remain locked in the source, accounted to Subnet A, until the message is delivered
+ */
+func UnreachableDestination(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, subnetBInfo := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenDecimals, err := sourceToken.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	erc20DestinationAddress, erc20Destination := utils.DeployERC20Destination(
+		ctx,
+		fundedKey,
+		subnetAInfo,
+		fundedAddress,
+		cChainInfo.BlockchainID,
+		erc20SourceAddress,
+		tokenName,
+		tokenSymbol,
+		tokenDecimals,
+	)
+
+	utils.RegisterERC20DestinationOnSource(
+		ctx,
+		network,
+		cChainInfo,
+		erc20SourceAddress,
+		subnetAInfo,
+		erc20DestinationAddress,
+	)
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	// A bridge address with no contract deployed to it on Subnet B
+	unreachableBridgeAddress := common.HexToAddress("0xdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef")
+	code, err := subnetBInfo.RPCClient.CodeAt(ctx, unreachableBridgeAddress, nil)
+	Expect(err).Should(BeNil())
+	Expect(code).Should(BeEmpty())
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+	fee := big.NewInt(1e18)
+
+	// Sending to a destination that never registered with the source reverts, and no tokens are taken
+	teleporterUtils.ERC20Approve(
+		ctx,
+		sourceToken,
+		erc20SourceAddress,
+		new(big.Int).Add(amount, fee),
+		cChainInfo,
+		fundedKey,
+	)
+	for _, destinationBlockchainID := range []ids.ID{subnetBInfo.BlockchainID, ids.GenerateTestID()} {
+		_, err = erc20Source.Send(
+			utils.NewTransactor(ctx, cChainInfo, fundedKey),
+			erc20source.SendTokensInput{
+				DestinationBlockchainID:  destinationBlockchainID,
+				DestinationBridgeAddress: unreachableBridgeAddress,
+				Recipient:                recipientAddress,
+				PrimaryFeeTokenAddress:   sourceTokenAddress,
+				PrimaryFee:               fee,
+				SecondaryFee:             big.NewInt(0),
+				RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+			},
+			amount,
+		)
+		Expect(err).Should(MatchError(ContainSubstring(errors.ErrDestinationNotRegistered)))
+	}
+	sourceBalance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(sourceBalance, big.NewInt(0))
+
+	// Bridge tokens to Subnet A
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   sourceTokenAddress,
+		PrimaryFee:               fee,
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		bridgedAmount,
+	)
+
+	// Send a multi-hop transfer to Subnet B, where no destination is registered with the source.
+	// The source cannot route the tokens, so sends them to the multi-hop fallback on the C-Chain.
+	teleporterUtils.SendNativeTransfer(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		recipientAddress,
+		big.NewInt(1e18),
+	)
+	multiHopInput := erc20destination.SendTokensInput{
+		DestinationBlockchainID:  subnetBInfo.BlockchainID,
+		DestinationBridgeAddress: unreachableBridgeAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   erc20DestinationAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		MultiHopFallback:         recipientAddress,
+	}
+	receipt, multiHopAmount := utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		erc20Destination,
+		erc20DestinationAddress,
+		multiHopInput,
+		bridgedAmount,
+		recipientKey,
+	)
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseTokensRouted)
+	Expect(err).ShouldNot(BeNil())
+	utils.CheckERC20SourceWithdrawal(
+		ctx,
+		erc20SourceAddress,
+		sourceToken,
+		receipt,
+		recipientAddress,
+		multiHopAmount,
+	)
+
+	// Nothing is accounted to the unreachable destination, and the tokens are no longer accounted to Subnet A
+	bridgedBalance, err := erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetBInfo.BlockchainID,
+		unreachableBridgeAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, big.NewInt(0))
+	bridgedBalance, err = erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, big.NewInt(0))
+
+	// Bridge tokens to Subnet A, but do not deliver the message, as if Subnet A were unreachable
+	sourceBalance, err = sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
+	Expect(err).Should(BeNil())
+	undeliveredReceipt, undeliveredAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		undeliveredReceipt.Logs,
+		cChainInfo.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+
+	// The tokens are locked in the source, and accounted to Subnet A, but not minted
+	balance, err := sourceToken.BalanceOf(&bind.CallOpts{Context: ctx}, erc20SourceAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, new(big.Int).Add(sourceBalance, undeliveredAmount))
+	bridgedBalance, err = erc20Source.BridgedBalances(
+		&bind.CallOpts{Context: ctx},
+		subnetAInfo.BlockchainID,
+		erc20DestinationAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(bridgedBalance, undeliveredAmount)
+	balance, err = erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, big.NewInt(0))
+
+	// The bridge has no way to cancel the transfer. The message remains pending in Teleporter on the C-Chain,
+	// and has not been received on Subnet A, so the tokens can only be recovered by delivering it.
+	messageHash, err := cChainInfo.TeleporterMessenger.GetMessageHash(&bind.CallOpts{Context: ctx}, sendEvent.MessageID)
+	Expect(err).Should(BeNil())
+	Expect(messageHash).ShouldNot(Equal([32]byte{}))
+	received, err := subnetAInfo.TeleporterMessenger.MessageReceived(&bind.CallOpts{Context: ctx}, sendEvent.MessageID)
+	Expect(err).Should(BeNil())
+	Expect(received).Should(BeFalse())
+
+	// Deliver the message, which recovers the tokens on Subnet A
+	receipt = network.RelayMessage(ctx, undeliveredReceipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		undeliveredAmount,
+	)
+	received, err = subnetAInfo.TeleporterMessenger.MessageReceived(&bind.CallOpts{Context: ctx}, sendEvent.MessageID)
+	Expect(err).Should(BeNil())
+	Expect(received).Should(BeTrue())
+}
@@ -0,0 +1,187 @@
+package flows
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	. "github.com/onsi/gomega"
+)
+
+/**
+ * Deploy an ERC20 token source on the primary network
+ * Deploys ERC20Destination to Subnet A
+ * Deploys a separate example ERC20 on the primary network as the fee token, and approves the source to spend it
+ * Bridges C-Chain example ERC20 tokens to Subnet A with a zero Teleporter fee
+ * Check that the message carries no fee, and that no fee tokens are pulled from the sender
+ * Bridges the tokens back from Subnet A to the C-Chain with a zero Teleporter fee
+ * Check that both transfers are delivered by the test relaying path
+ */
+func ZeroFeeTransfer(network interfaces.Network) {
+	cChainInfo := network.GetPrimaryNetworkInfo()
+	subnetAInfo, _ := teleporterUtils.GetTwoSubnets(network)
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	teleporterAddress := network.GetTeleporterContractAddress()
+
+	ctx := context.Background()
+
+	// Deploy an ExampleERC20 on the primary network as the source token to be bridged
+	sourceTokenAddress, sourceToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+
+	erc20SourceAddress, erc20Source := utils.DeployERC20Source(
+		ctx,
+		fundedKey,
+		cChainInfo,
+		fundedAddress,
+		sourceTokenAddress,
+	)
+
+	// Deploy an ERC20Destination to Subnet A, and register it with the ERC20Source
+	erc20DestinationAddress, erc20Destination := utils.DeployAndRegisterERC20Destination(
+		ctx,
+		network,
+		subnetAInfo,
+		cChainInfo,
+		erc20SourceAddress,
+	)
+
+	// Deploy a separate fee token, and approve the source to spend it, so that pulling a fee would succeed
+	feeTokenAddress, feeToken := teleporterUtils.DeployExampleERC20(
+		ctx,
+		fundedKey,
+		cChainInfo,
+	)
+	feeAllowance := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5))
+	teleporterUtils.ERC20Approve(ctx, feeToken, erc20SourceAddress, feeAllowance, cChainInfo, fundedKey)
+
+	senderFeeBalance, err := feeToken.BalanceOf(&bind.CallOpts{Context: ctx}, fundedAddress)
+	Expect(err).Should(BeNil())
+	teleporterFeeBalance, err := feeToken.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+
+	recipientKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	recipientAddress := crypto.PubkeyToAddress(recipientKey.PublicKey)
+
+	amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+
+	// Send tokens from C-Chain to recipient on Subnet A without a fee
+	input := erc20source.SendTokensInput{
+		DestinationBlockchainID:  subnetAInfo.BlockchainID,
+		DestinationBridgeAddress: erc20DestinationAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   feeTokenAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+
+	receipt, bridgedAmount := utils.SendERC20Source(
+		ctx,
+		cChainInfo,
+		erc20Source,
+		erc20SourceAddress,
+		sourceToken,
+		input,
+		amount,
+		fundedKey,
+	)
+	checkZeroFeeMessage(receipt, cChainInfo, feeTokenAddress)
+
+	// No fee tokens were transferred, and the allowance of the source is untouched
+	for _, log := range receipt.Logs {
+		Expect(log.Address).ShouldNot(Equal(feeTokenAddress))
+	}
+	allowance, err := feeToken.Allowance(&bind.CallOpts{Context: ctx}, fundedAddress, erc20SourceAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(allowance, feeAllowance)
+	balance, err := feeToken.BalanceOf(&bind.CallOpts{Context: ctx}, fundedAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, senderFeeBalance)
+	balance, err = feeToken.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, teleporterFeeBalance)
+
+	receipt = network.RelayMessage(ctx, receipt, cChainInfo, subnetAInfo, true)
+	utils.CheckERC20DestinationWithdrawal(
+		ctx,
+		erc20Destination,
+		receipt,
+		recipientAddress,
+		bridgedAmount,
+	)
+
+	// Fund recipient with gas tokens on Subnet A, and bridge the tokens back without a fee
+	teleporterUtils.SendNativeTransfer(
+		ctx,
+		subnetAInfo,
+		fundedKey,
+		recipientAddress,
+		big.NewInt(1e18),
+	)
+	inputB := erc20destination.SendTokensInput{
+		DestinationBlockchainID:  cChainInfo.BlockchainID,
+		DestinationBridgeAddress: erc20SourceAddress,
+		Recipient:                recipientAddress,
+		PrimaryFeeTokenAddress:   erc20DestinationAddress,
+		PrimaryFee:               big.NewInt(0),
+		SecondaryFee:             big.NewInt(0),
+		RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+	}
+
+	receipt, bridgedAmount = utils.SendERC20Destination(
+		ctx,
+		subnetAInfo,
+		erc20Destination,
+		erc20DestinationAddress,
+		inputB,
+		bridgedAmount,
+		recipientKey,
+	)
+	checkZeroFeeMessage(receipt, subnetAInfo, erc20DestinationAddress)
+
+	// Only the bridged amount was spent from the approval, with nothing left over for a fee
+	allowance, err = erc20Destination.Allowance(&bind.CallOpts{Context: ctx}, recipientAddress, erc20DestinationAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(allowance, big.NewInt(0))
+	balance, err = erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, teleporterAddress)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(balance, big.NewInt(0))
+
+	receipt = network.RelayMessage(ctx, receipt, subnetAInfo, cChainInfo, true)
+	utils.CheckERC20SourceWithdrawal(
+		ctx,
+		erc20SourceAddress,
+		sourceToken,
+		receipt,
+		recipientAddress,
+		bridgedAmount,
+	)
+}
+
+// Checks that the Teleporter message sent in the receipt carries a zero fee in the given fee token
+func checkZeroFeeMessage(
+	receipt *types.Receipt,
+	subnet interfaces.SubnetTestInfo,
+	feeTokenAddress common.Address,
+) {
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		subnet.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	Expect(sendEvent.FeeInfo.FeeTokenAddress).Should(Equal(feeTokenAddress))
+	teleporterUtils.ExpectBigEqual(sendEvent.FeeInfo.Amount, big.NewInt(0))
+}
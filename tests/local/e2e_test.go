@@ -4,12 +4,14 @@
 package local
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/ava-labs/teleporter-token-bridge/tests/flows"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
 	"github.com/ava-labs/teleporter/tests/local"
-	deploymentUtils "github.com/ava-labs/teleporter/utils/deployment-utils"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -17,9 +19,6 @@ import (
 )
 
 const (
-	teleporterByteCodeFile = "./contracts/lib/teleporter/contracts/out/TeleporterMessenger.sol/TeleporterMessenger.json"
-	warpGenesisFile        = "./tests/utils/warp-genesis.json"
-
 	erc20SourceLabel            = "ERC20Source"
 	erc20DestinationLabel       = "ERC20Destination"
 	nativeTokenSourceLabel      = "NativeTokenSource"
@@ -27,100 +26,460 @@ const (
 	multiHopLabel               = "MultiHop"
 	sendAndCallLabel            = "SendAndCall"
 	registrationLabel           = "Registration"
+	warpLabel                   = "Warp"
+	relayerLabel                = "Relayer"
+	feesLabel                   = "Fees"
+	upgradeLabel                = "Upgrade"
+	accessControlLabel          = "AccessControl"
+	erc721Label                 = "ERC721"
 )
 
-var LocalNetworkInstance *local.LocalNetwork
+// The flows that can be selected with RUN_FLOWS, without needing to know the ginkgo labels of each spec
+var flowSelectors = []utils.FlowSelector{
+	{
+		Name:        "erc20",
+		LabelFilter: erc20SourceLabel + " || " + erc20DestinationLabel,
+		Description: "Flows bridging an ERC20 token, or to an ERC20Destination",
+	},
+	{
+		Name:        "native",
+		LabelFilter: nativeTokenSourceLabel + " || " + nativeTokenDestinationLabel,
+		Description: "Flows bridging a native token, or to a NativeTokenDestination",
+	},
+	{Name: "erc20source", LabelFilter: erc20SourceLabel, Description: "Flows with an ERC20Source"},
+	{Name: "erc20destination", LabelFilter: erc20DestinationLabel, Description: "Flows with an ERC20Destination"},
+	{Name: "nativesource", LabelFilter: nativeTokenSourceLabel, Description: "Flows with a NativeTokenSource"},
+	{
+		Name:        "nativedestination",
+		LabelFilter: nativeTokenDestinationLabel,
+		Description: "Flows with a NativeTokenDestination",
+	},
+	{Name: "multihop", LabelFilter: multiHopLabel, Description: "Multi-hop transfers between destinations"},
+	{Name: "sendandcall", LabelFilter: sendAndCallLabel, Description: "Transfers calling a recipient contract"},
+	{Name: "registration", LabelFilter: registrationLabel, Description: "Destination registration and collateral"},
+	{Name: "warp", LabelFilter: warpLabel, Description: "Warp messages not sent by Teleporter"},
+	{Name: "relayer", LabelFilter: relayerLabel, Description: "Delivery with the AWM relayer binary"},
+	{Name: "fees", LabelFilter: feesLabel, Description: "Relayer fees and rewards"},
+	{Name: "upgrade", LabelFilter: upgradeLabel, Description: "Teleporter version upgrades"},
+	{Name: "accesscontrol", LabelFilter: accessControlLabel, Description: "Ownership and access control"},
+	{Name: "erc721", LabelFilter: erc721Label, Description: "Flows bridging an ERC721 token"},
+}
+
+var (
+	LocalNetworkInstance *local.LocalNetwork
+	// SharedNetworkInstance wraps LocalNetworkInstance with the Teleporter deployment shared by every spec
+	SharedNetworkInstance interfaces.LocalNetwork
+	// SpecNetworkInstance wraps SharedNetworkInstance with an account funded for the current spec
+	SpecNetworkInstance *utils.SpecNetwork
+	// TracedNetworkInstance wraps SpecNetworkInstance to trace message relaying, and is passed to the flows
+	TracedNetworkInstance interfaces.LocalNetwork
+	// Whether the suite setup or any spec failed, in which case the nodes' data directories are kept for their logs
+	suiteFailed bool
+)
 
 func TestE2E(t *testing.T) {
-	if os.Getenv("RUN_E2E") == "" {
+	suiteConfig, reporterConfig, err := utils.FlowSelectionConfig(flowSelectors)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Listing the flows does not run them, so does not need the E2E environment
+	if os.Getenv("RUN_E2E") == "" && !suiteConfig.DryRun {
 		t.Skip("Environment variable RUN_E2E not set; skipping E2E tests")
 	}
 	format.MaxLength = 10000
 
 	RegisterFailHandler(ginkgo.Fail)
-	ginkgo.RunSpecs(t, "Teleporter e2e test")
+	ginkgo.RunSpecs(t, "Teleporter e2e test", suiteConfig, reporterConfig)
 }
 
 // Define the Teleporter before and after suite functions.
 var _ = ginkgo.BeforeSuite(func() {
-	// Create the local network instance
-	LocalNetworkInstance = local.NewLocalNetwork(warpGenesisFile)
-
-	// Generate the Teleporter deployment values
-	teleporterDeployerTransaction, teleporterDeployerAddress,
-		teleporterContractAddress, err := deploymentUtils.ConstructKeylessTransaction(
-		teleporterByteCodeFile,
-		false,
-		deploymentUtils.GetDefaultContractCreationGasPrice(),
-	)
-	Expect(err).Should(BeNil())
-
-	_, fundedKey := LocalNetworkInstance.GetFundedAccountInfo()
-	LocalNetworkInstance.DeployTeleporterContracts(
-		teleporterDeployerTransaction,
-		teleporterDeployerAddress,
-		teleporterContractAddress,
-		fundedKey,
-		true,
+	// Registered first, so that the network is torn down and leaks are cleaned up after the AfterSuite even if
+	// the setup fails part way
+	utils.RecordSuiteResources()
+	ginkgo.DeferCleanup(func() {
+		utils.TearDownSuite(LocalNetworkInstance, suiteFailed)
+	})
+	suiteFailed = true
+
+	utils.InitTracing()
+	utils.CountRPCCalls()
+
+	// Create the local network instance, allowing the NativeTokenDestinations deployed by the flows to mint
+	LocalNetworkInstance = local.NewLocalNetwork(utils.ApplyNativeMinterConfig(utils.WarpGenesisFile()))
+	// Configure each Subnet on top of the shared genesis, if E2E_SUBNET_CONFIG_FILE is set
+	utils.ConfigureSubnets(context.Background(), LocalNetworkInstance, utils.SubnetEVMConfigsFromEnv())
+
+	// Deploy Teleporter and the TeleporterRegistry to each chain
+	SharedNetworkInstance = utils.DeployTeleporterContracts(
+		context.Background(),
+		LocalNetworkInstance,
+		utils.TeleporterByteCodeFile(),
 	)
 
-	LocalNetworkInstance.DeployTeleporterRegistryContracts(teleporterContractAddress, fundedKey)
+	suiteFailed = false
 	log.Info("Set up ginkgo before suite")
 })
 
+// The network is torn down by the cleanup registered in the BeforeSuite
 var _ = ginkgo.AfterSuite(func() {
-	LocalNetworkInstance.TearDownNetwork()
+	utils.CloseTracing()
+	utils.WriteGasReport()
+	utils.WriteDeliveryLatencyReport()
+	utils.WriteFlowResourceReport()
+})
+
+// The network and Teleporter deployment are shared by every spec, while each spec deploys its own bridge
+// contracts from its own account. Each spec is traced as the parent of the spans of its sends and relays.
+var _ = ginkgo.BeforeEach(func() {
+	specName := ginkgo.CurrentSpecReport().LeafNodeText
+	utils.StartSpecSpan(specName)
+	utils.StartFlowResources(specName)
+	SpecNetworkInstance = utils.NewSpecNetwork(context.Background(), SharedNetworkInstance, specName)
+	TracedNetworkInstance = utils.NewTracedNetwork(SpecNetworkInstance)
+})
+
+// Defined before the AfterEach closing the spec network, so that a diverging model is traced as a failed spec
+var _ = ginkgo.AfterEach(func() {
+	if !ginkgo.CurrentSpecReport().Failed() {
+		utils.CheckSpecAccounting(context.Background())
+	}
+})
+
+var _ = ginkgo.AfterEach(func() {
+	if ginkgo.CurrentSpecReport().Failed() {
+		suiteFailed = true
+		utils.TraceFailedSpec(context.Background(), ginkgo.CurrentSpecReport().LeafNodeText)
+	}
+	SpecNetworkInstance.Close()
+	utils.EndSpecSpan(ginkgo.CurrentSpecReport().Failed())
+	utils.EndFlowResources(ginkgo.CurrentSpecReport().Failed())
 })
 
 var _ = ginkgo.Describe("[Teleporter Token Bridge integration tests]", func() {
 	ginkgo.It("Bridge an ERC20 token between two Subnets",
 		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
 		func() {
-			flows.ERC20SourceERC20Destination(LocalNetworkInstance)
+			flows.ERC20SourceERC20Destination(TracedNetworkInstance)
 		})
 	ginkgo.It("Bridge a native token to an ERC20 token",
 		ginkgo.Label(nativeTokenSourceLabel, erc20DestinationLabel),
 		func() {
-			flows.NativeSourceERC20Destination(LocalNetworkInstance)
+			flows.NativeSourceERC20Destination(TracedNetworkInstance)
 		})
 	ginkgo.It("Bridge a native token to a native token",
 		ginkgo.Label(nativeTokenSourceLabel, nativeTokenDestinationLabel),
 		func() {
-			flows.NativeSourceNativeDestination(LocalNetworkInstance)
+			flows.NativeSourceNativeDestination(TracedNetworkInstance)
 		})
 	ginkgo.It("Bridge an ERC20 token with ERC20Source multi-hop",
 		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, multiHopLabel),
 		func() {
-			flows.ERC20SourceERC20DestinationMultiHop(LocalNetworkInstance)
+			flows.ERC20SourceERC20DestinationMultiHop(TracedNetworkInstance)
 		})
 	ginkgo.It("Bridge an ERC20 token with NativeTokenSource multi-hop",
 		ginkgo.Label(nativeTokenSourceLabel, erc20DestinationLabel, multiHopLabel),
 		func() {
-			flows.NativeSourceERC20DestinationMultiHop(LocalNetworkInstance)
+			flows.NativeSourceERC20DestinationMultiHop(TracedNetworkInstance)
 		})
 	ginkgo.It("Bridge an ERC20 token to a native token",
 		ginkgo.Label(erc20SourceLabel, nativeTokenDestinationLabel),
 		func() {
-			flows.ERC20SourceNativeDestination(LocalNetworkInstance)
+			flows.ERC20SourceNativeDestination(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge random amounts at the scaling boundaries to a native token",
+		ginkgo.Label(erc20SourceLabel, nativeTokenDestinationLabel),
+		func() {
+			flows.ScalingBoundaryAmounts(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge amounts close to the uint256 limit",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.LargeAmountsNearMaxUint256(TracedNetworkInstance)
+		})
+	ginkgo.It("Revert on amounts that overflow when scaled",
+		ginkgo.Label(erc20SourceLabel, nativeTokenDestinationLabel, erc20DestinationLabel, multiHopLabel),
+		func() {
+			flows.ScaledAmountOverflow(TracedNetworkInstance)
+		})
+	ginkgo.It("Onboard a new account with bridged native tokens",
+		ginkgo.Label(erc20SourceLabel, nativeTokenDestinationLabel),
+		func() {
+			flows.GaslessOnboarding(TracedNetworkInstance)
 		})
 	ginkgo.It("Bridge a Native token with ERC20Source multi-hop",
 		ginkgo.Label(erc20SourceLabel, nativeTokenDestinationLabel, multiHopLabel),
 		func() {
-			flows.ERC20SourceNativeDestinationMultiHop(LocalNetworkInstance)
+			flows.ERC20SourceNativeDestinationMultiHop(TracedNetworkInstance)
 		})
 	ginkgo.It("Bridge a native token to a native token multi-hop",
 		ginkgo.Label(nativeTokenSourceLabel, nativeTokenDestinationLabel, multiHopLabel),
 		func() {
-			flows.NativeSourceNativeDestinationMultiHop(LocalNetworkInstance)
+			flows.NativeSourceNativeDestinationMultiHop(TracedNetworkInstance)
+		})
+	ginkgo.It("Claim native tokens that could not be sent to their recipient",
+		ginkgo.Label(nativeTokenSourceLabel, nativeTokenDestinationLabel, sendAndCallLabel),
+		func() {
+			flows.NativeWithdrawalClaim(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC20 token between the spokes of an ERC20Source hub",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, multiHopLabel),
+		func() {
+			flows.ERC20SourceHubAndSpoke(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC20 token between the spokes of a NativeTokenSource hub",
+		ginkgo.Label(nativeTokenSourceLabel, erc20DestinationLabel, multiHopLabel),
+		func() {
+			flows.NativeSourceHubAndSpoke(TracedNetworkInstance)
 		})
 	ginkgo.It("Bridge an ERC20 token with ERC20TokenSource Send and Call",
 		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, sendAndCallLabel),
 		func() {
-			flows.ERC20SourceERC20DestinationSendAndCall(LocalNetworkInstance)
+			flows.ERC20SourceERC20DestinationSendAndCall(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC20 token and swap it with ERC20TokenSource Send and Call",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, sendAndCallLabel),
+		func() {
+			flows.ERC20SourceERC20DestinationSendAndSwap(TracedNetworkInstance)
+		})
+	ginkgo.It("Pass the origin sender and blockchain ID to Send and Call recipients",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, sendAndCallLabel, multiHopLabel),
+		func() {
+			flows.SendAndCallOriginInformation(TracedNetworkInstance)
+		})
+	ginkgo.It("Check the events emitted by each operation",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, sendAndCallLabel, registrationLabel),
+		func() {
+			flows.EventEmission(TracedNetworkInstance)
+		})
+	ginkgo.It("Populate ERC20Destination token metadata from the source token",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.DestinationTokenMetadata(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC20 token to multiple destinations",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.ERC20SourceMultipleDestinations(TracedNetworkInstance)
+		})
+	ginkgo.It("Keep the destinations of different sources on the same chain apart",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, multiHopLabel),
+		func() {
+			flows.MultiSourceCollision(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge a batch of ERC20 transfers with outstanding messages",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.ERC20BatchTransfers(TracedNetworkInstance)
+		})
+	ginkgo.It("Call registered hooks around each send and receive",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, feesLabel),
+		func() {
+			flows.SendReceiveHooks(TracedNetworkInstance)
+		})
+	ginkgo.It("Send through the SDK with a maximum approval",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.SDKSendWithMaxApproval(TracedNetworkInstance)
+		})
+	ginkgo.It("Check sends before sending through the SDK",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.SDKPreflightSend(TracedNetworkInstance)
+		})
+	ginkgo.It("Simulate sends through the SDK",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.SDKSimulateSend(TracedNetworkInstance)
+		})
+	ginkgo.It("Estimate delivery gas through the SDK",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, sendAndCallLabel),
+		func() {
+			flows.SDKEstimateDeliveryGas(TracedNetworkInstance)
+		})
+	ginkgo.It("Discover destinations through the SDK",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, registrationLabel),
+		func() {
+			flows.SDKDiscoverDestinations(TracedNetworkInstance)
+		})
+	ginkgo.It("Diagnose the signatures of a pending message through the SDK",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.SDKDiagnoseSignatures(TracedNetworkInstance)
+		})
+	ginkgo.It("Check a deployment for conformance",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, registrationLabel),
+		func() {
+			flows.Conformance(TracedNetworkInstance)
+		})
+	ginkgo.It("Deploy destinations to the same address on every chain with CREATE2",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, registrationLabel),
+		func() {
+			flows.Create2AddressParity(TracedNetworkInstance)
 		})
 	ginkgo.It("Registration and collateral checks",
 		ginkgo.Label(erc20SourceLabel, nativeTokenDestinationLabel, registrationLabel),
 		func() {
-			flows.RegistrationAndCollateralCheck(LocalNetworkInstance)
+			flows.RegistrationAndCollateralCheck(TracedNetworkInstance)
+		})
+	ginkgo.It("Duplicate registration checks",
+		ginkgo.Label(erc20SourceLabel, nativeTokenDestinationLabel, registrationLabel),
+		func() {
+			flows.DuplicateRegistration(TracedNetworkInstance)
+		})
+	ginkgo.It("Transfers to an unreachable destination",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, multiHopLabel, registrationLabel),
+		func() {
+			flows.UnreachableDestination(TracedNetworkInstance)
+		})
+	ginkgo.It("Report drift of a deployment from its manifest",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, registrationLabel),
+		func() {
+			flows.DeploymentDiff(TracedNetworkInstance)
+		})
+	ginkgo.It("Recover a transfer whose execution failed",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, upgradeLabel),
+		func() {
+			flows.FailedTransferRecovery(TracedNetworkInstance)
+		})
+	ginkgo.It("Recover a send whose required gas limit is too low",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.SendGasLimit(TracedNetworkInstance)
+		})
+	ginkgo.It("Resume a destination setup whose collateral was dropped",
+		ginkgo.Label(erc20SourceLabel, nativeTokenDestinationLabel, registrationLabel),
+		func() {
+			flows.SetupPartialFailure(TracedNetworkInstance)
+		})
+	ginkgo.It("Treat sends as final once buried under the confirmation depth",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.ConfirmationDepth(TracedNetworkInstance)
+		})
+	ginkgo.It("Deliver pending transfers once a halted destination subnet restarts",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.SubnetOutage(TracedNetworkInstance)
+		})
+	ginkgo.It("Reject raw Warp messages not sent by Teleporter",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, warpLabel),
+		func() {
+			flows.RawWarpMessageRejected(TracedNetworkInstance)
+		})
+	ginkgo.It("Reject a replayed delivery",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, warpLabel),
+		func() {
+			flows.ReplayedDelivery(TracedNetworkInstance)
+		})
+	ginkgo.It("Reject direct calls to the receive path",
+		ginkgo.Label(erc20SourceLabel, nativeTokenSourceLabel, erc20DestinationLabel, nativeTokenDestinationLabel),
+		func() {
+			flows.DirectCallRejected(TracedNetworkInstance)
+		})
+	ginkgo.It("Reject malformed bridge messages",
+		ginkgo.Label(erc20DestinationLabel, nativeTokenDestinationLabel),
+		func() {
+			flows.MalformedBridgeMessages(TracedNetworkInstance)
+		})
+	ginkgo.It("Deliver transfers with the relayer",
+		ginkgo.Label(erc20SourceLabel, nativeTokenSourceLabel, erc20DestinationLabel, multiHopLabel, relayerLabel),
+		func() {
+			if !utils.RelayerAvailable() {
+				ginkgo.Skip(utils.RelayerPathEnvVar + " not set; skipping relayer delivery")
+			}
+			flows.RelayerDelivery(TracedNetworkInstance)
+		})
+	ginkgo.It("Deliver transfers with the bridge relayer",
+		ginkgo.Label(erc20SourceLabel, nativeTokenSourceLabel, erc20DestinationLabel, multiHopLabel),
+		func() {
+			flows.BridgeRelayerDelivery(TracedNetworkInstance)
+		})
+	ginkgo.It("Redeem relayer rewards",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, feesLabel),
+		func() {
+			flows.RelayerRewardRedemption(TracedNetworkInstance)
+		})
+	ginkgo.It("Return receipts from a saturated receipt queue",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, feesLabel),
+		func() {
+			flows.ReceiptQueueSaturation(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC20 token with zero fees",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, feesLabel),
+		func() {
+			flows.ZeroFeeTransfer(TracedNetworkInstance)
+		})
+	ginkgo.It("Report burned fees with a reporting reward",
+		ginkgo.Label(nativeTokenSourceLabel, nativeTokenDestinationLabel, feesLabel),
+		func() {
+			flows.BurnedFeeReportingReward(TracedNetworkInstance)
+		})
+	ginkgo.It("Deliver a transfer from competing relayers",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.CompetingRelayers(TracedNetworkInstance)
+		})
+	ginkgo.It("Deliver transfers to a congested destination",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, sendAndCallLabel),
+		func() {
+			flows.DestinationCongestion(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC20 token with gasless sends",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel),
+		func() {
+			flows.GaslessSend(TracedNetworkInstance)
+		})
+	ginkgo.It("Ownership and access control checks",
+		ginkgo.Label(
+			erc20SourceLabel,
+			nativeTokenSourceLabel,
+			erc20DestinationLabel,
+			nativeTokenDestinationLabel,
+			accessControlLabel,
+		),
+		func() {
+			flows.OwnershipAndAccessControl(TracedNetworkInstance)
+		})
+	ginkgo.It("Pause and unpause sends",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, accessControlLabel),
+		func() {
+			flows.PauseSends(TracedNetworkInstance)
+		})
+	ginkgo.It("Rate limit sends to a destination",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, accessControlLabel, multiHopLabel),
+		func() {
+			flows.RateLimit(TracedNetworkInstance)
+		})
+	ginkgo.It("Escrow tokens sent to a blocked recipient",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, accessControlLabel, sendAndCallLabel),
+		func() {
+			flows.RecipientBlocklist(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC721 token between two Subnets",
+		ginkgo.Label(erc721Label),
+		func() {
+			flows.ERC721SourceERC721Destination(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC721 token with ERC721Source multi-hop",
+		ginkgo.Label(erc721Label, multiHopLabel),
+		func() {
+			flows.ERC721SourceERC721DestinationMultiHop(TracedNetworkInstance)
+		})
+	ginkgo.It("Bridge an ERC721 token with ERC721Source Send and Call",
+		ginkgo.Label(erc721Label, sendAndCallLabel),
+		func() {
+			flows.ERC721SourceERC721DestinationSendAndCall(TracedNetworkInstance)
+		})
+	ginkgo.It("Resolve the Teleporter messenger through the bridge's registry",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, upgradeLabel),
+		func() {
+			flows.TeleporterRegistryResolution(TracedNetworkInstance)
+		})
+	// Switches the network to a new Teleporter version, so must run after the flows that use the initial version
+	ginkgo.It("Enforce the minimum Teleporter version",
+		ginkgo.Label(erc20SourceLabel, erc20DestinationLabel, upgradeLabel),
+		func() {
+			flows.MinTeleporterVersion(TracedNetworkInstance, utils.CachedArtifactFile(utils.TeleporterByteCodeFile()))
 		})
 })
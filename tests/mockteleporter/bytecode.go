@@ -0,0 +1,129 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package mockteleporter
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// The offsets of the jump destinations of the messenger's code
+const (
+	messengerForward = 0x31
+	messengerReturn  = 0x5a
+)
+
+// messengerCode returns the runtime code of the mock Teleporter messenger. Calls from the relayer forward the
+// rest of their calldata to the contract at the address in its first 20 bytes, returning or reverting with the
+// result, so that deliveries come from the messenger the way Teleporter's do. Any other call is taken to be a
+// sendCrossChainMessage: its calldata is logged with the caller as the only topic, and the hash of the calldata is
+// returned as the message ID.
+func messengerCode(relayer common.Address) []byte {
+	code := []byte{byte(vm.CALLER), byte(vm.PUSH20)}
+	code = append(code, relayer.Bytes()...)
+	code = append(code,
+		byte(vm.EQ), byte(vm.PUSH1), messengerForward, byte(vm.JUMPI),
+
+		// Send: log the calldata, and return its hash
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.CALLER), byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.LOG1),
+		byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.KECCAK256),
+		byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+
+		// Forward: call the target with the rest of the calldata and all the remaining gas
+		byte(vm.JUMPDEST),
+		byte(vm.PUSH1), common.AddressLength, byte(vm.CALLDATASIZE), byte(vm.SUB),
+		byte(vm.DUP1), byte(vm.PUSH1), common.AddressLength, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.DUP3), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH1), 0, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 96, byte(vm.SHR),
+		byte(vm.GAS), byte(vm.CALL),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.RETURNDATACOPY),
+		byte(vm.PUSH1), messengerReturn, byte(vm.JUMPI),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.REVERT),
+		byte(vm.JUMPDEST),
+		byte(vm.RETURNDATASIZE), byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+	return code
+}
+
+// warpCode returns the runtime code of the mock Warp precompile, which returns the blockchain ID from every call.
+// The bridge contracts and the TeleporterRegistry only call getBlockchainID, since the messages they send and
+// receive go through the mock messenger.
+func warpCode(blockchainID ids.ID) []byte {
+	code := []byte{byte(vm.PUSH32)}
+	code = append(code, blockchainID[:]...)
+	return append(code,
+		byte(vm.PUSH1), 0, byte(vm.MSTORE),
+		byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+}
+
+// The sizes of the parts of a stub's code
+const (
+	stubDispatchSize = 11
+	stubReturnSize   = 16
+)
+
+// StubCode returns the runtime code of a contract that returns the fixed result of each function selector it is
+// given, and reverts calls of any other function. Stubs stand in for contract versions whose bytecode is not in
+// the bindings, such as ones with views added after the bindings were last generated.
+func StubCode(results map[[4]byte][]byte) []byte {
+	selectors := make([][4]byte, 0, len(results))
+	for selector := range results {
+		selectors = append(selectors, selector)
+	}
+	sort.Slice(selectors, func(i, j int) bool {
+		return bytes.Compare(selectors[i][:], selectors[j][:]) < 0
+	})
+
+	// Dispatch on the selector to the block returning its result, which copies it from the end of the code
+	code := []byte{byte(vm.PUSH1), 0, byte(vm.CALLDATALOAD), byte(vm.PUSH1), 224, byte(vm.SHR)}
+	returns := len(code) + len(selectors)*stubDispatchSize + 4
+	for i, selector := range selectors {
+		code = append(code, byte(vm.DUP1), byte(vm.PUSH4))
+		code = append(code, selector[:]...)
+		code = append(code, byte(vm.EQ), byte(vm.PUSH2))
+		code = append(code, uint16Bytes(returns+i*stubReturnSize)...)
+		code = append(code, byte(vm.JUMPI))
+	}
+	code = append(code, byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT))
+
+	offset := returns + len(selectors)*stubReturnSize
+	for _, selector := range selectors {
+		size := uint16Bytes(len(results[selector]))
+		code = append(code, byte(vm.JUMPDEST), byte(vm.PUSH2))
+		code = append(code, size...)
+		code = append(code, byte(vm.PUSH2))
+		code = append(code, uint16Bytes(offset)...)
+		code = append(code, byte(vm.PUSH1), 0, byte(vm.CODECOPY), byte(vm.PUSH2))
+		code = append(code, size...)
+		code = append(code, byte(vm.PUSH1), 0, byte(vm.RETURN))
+		offset += len(results[selector])
+	}
+	for _, selector := range selectors {
+		code = append(code, results[selector]...)
+	}
+	return code
+}
+
+// deployCode returns the init code of a contract with the given runtime code
+func deployCode(code []byte) []byte {
+	const size = 12
+	deploy := []byte{byte(vm.PUSH2)}
+	deploy = append(deploy, uint16Bytes(len(code))...)
+	deploy = append(deploy,
+		byte(vm.DUP1), byte(vm.PUSH1), size, byte(vm.PUSH1), 0, byte(vm.CODECOPY),
+		byte(vm.PUSH1), 0, byte(vm.RETURN),
+	)
+	return append(deploy, code...)
+}
+
+func uint16Bytes(n int) []byte {
+	return []byte{byte(n >> 8), byte(n)}
+}
@@ -0,0 +1,228 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package mockteleporter runs the bridge contracts in process, on simulated chains whose Teleporter messenger and
+// Warp precompile are replaced with mocks. Messages sent through the mock messenger are delivered to the chain
+// they are sent to without Warp signatures or a relayer, so that the encoding, scaling and events of transfers can
+// be tested in seconds, without the local network the e2e flows run on.
+//
+// The mock messenger does not charge fees, enforce allowed relayers or execute messages with exactly their
+// required gas limit, and a message whose execution reverts reverts its delivery rather than being stored to be
+// retried. Behavior that depends on Teleporter itself is covered by the e2e flows.
+package mockteleporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind/backends"
+	"github.com/ava-labs/subnet-evm/core"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	teleporterregistry "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/upgrades/TeleporterRegistry"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// The gas limit of the simulated chains' genesis blocks. Later blocks take theirs from the chains' fee config.
+	genesisGasLimit = 8_000_000
+	// The gas limit of each delivery, so that deliveries whose execution reverts are still mined
+	deliveryGasLimit = 5_000_000
+)
+
+var (
+	// MessengerAddress is the address of the mock Teleporter messenger on every simulated chain, the address of
+	// Teleporter v1.0.0 on every chain it is deployed to
+	MessengerAddress = common.HexToAddress("0x253b2784c75e510dD0fF1da844684a1aC0aa5fcf")
+	// EVMChainID is the EVM chain ID of every simulated chain
+	EVMChainID = big.NewInt(1337)
+
+	fundedBalance = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1_000_000))
+)
+
+// Chain is a simulated chain, with the mock messenger registered as the only version of its TeleporterRegistry
+type Chain struct {
+	BlockchainID ids.ID
+	Backend      *backends.SimulatedBackend
+	// TeleporterRegistryAddress is the registry to construct the chain's bridge contracts with
+	TeleporterRegistryAddress common.Address
+	// FundedKey is the key of an account funded in the chain's genesis, for deploying and using contracts
+	FundedKey     *ecdsa.PrivateKey
+	FundedAddress common.Address
+
+	relayerKey *ecdsa.PrivateKey
+}
+
+// Message is a Teleporter message sent through the mock messenger
+type Message struct {
+	teleportermessenger.TeleporterMessageInput
+	// ID is the message ID returned to the sender by the mock messenger, the hash of the send's calldata
+	ID                  ids.ID
+	SourceBlockchainID  ids.ID
+	OriginSenderAddress common.Address
+}
+
+// NewChain starts a simulated chain with the given blockchain ID
+func NewChain(ctx context.Context, blockchainID ids.ID) *Chain {
+	fundedKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	relayerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	fundedAddress := crypto.PubkeyToAddress(fundedKey.PublicKey)
+	relayerAddress := crypto.PubkeyToAddress(relayerKey.PublicKey)
+
+	c := &Chain{
+		BlockchainID: blockchainID,
+		Backend: backends.NewSimulatedBackend(core.GenesisAlloc{
+			fundedAddress:        {Balance: fundedBalance},
+			relayerAddress:       {Balance: fundedBalance},
+			MessengerAddress:     {Code: messengerCode(relayerAddress), Balance: big.NewInt(0)},
+			warp.ContractAddress: {Code: warpCode(blockchainID), Balance: big.NewInt(0)},
+		}, genesisGasLimit),
+		FundedKey:     fundedKey,
+		FundedAddress: fundedAddress,
+		relayerKey:    relayerKey,
+	}
+
+	c.Transact(ctx, fundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		var (
+			tx  *types.Transaction
+			err error
+		)
+		c.TeleporterRegistryAddress, tx, _, err = teleporterregistry.DeployTeleporterRegistry(
+			opts,
+			c.Backend,
+			[]teleporterregistry.ProtocolRegistryEntry{{
+				Version:         big.NewInt(1),
+				ProtocolAddress: MessengerAddress,
+			}},
+		)
+		return tx, err
+	})
+	return c
+}
+
+// Close stops the simulated chain
+func (c *Chain) Close() {
+	Expect(c.Backend.Close()).Should(Succeed())
+}
+
+// NewTransactor returns transaction options signed by the key on the chain
+func (c *Chain) NewTransactor(ctx context.Context, key *ecdsa.PrivateKey) *bind.TransactOpts {
+	opts, err := bind.NewKeyedTransactorWithChainID(key, EVMChainID)
+	Expect(err).Should(BeNil())
+	opts.Context = ctx
+	return opts
+}
+
+// Transact sends the transaction built by fn from the key, mines it in a new block, and expects it to succeed
+func (c *Chain) Transact(
+	ctx context.Context,
+	key *ecdsa.PrivateKey,
+	fn func(opts *bind.TransactOpts) (*types.Transaction, error),
+) *types.Receipt {
+	receipt := c.transact(ctx, c.NewTransactor(ctx, key), fn)
+	Expect(receipt.Status).Should(Equal(types.ReceiptStatusSuccessful), "transaction %s failed", receipt.TxHash)
+	return receipt
+}
+
+func (c *Chain) transact(
+	ctx context.Context,
+	opts *bind.TransactOpts,
+	fn func(opts *bind.TransactOpts) (*types.Transaction, error),
+) *types.Receipt {
+	tx, err := fn(opts)
+	Expect(err).Should(BeNil())
+	c.Backend.Commit(true)
+	receipt, err := c.Backend.TransactionReceipt(ctx, tx.Hash())
+	Expect(err).Should(BeNil())
+	return receipt
+}
+
+// DeployStub deploys a contract with the StubCode of the results, returning its address
+func (c *Chain) DeployStub(ctx context.Context, results map[[4]byte][]byte) common.Address {
+	var address common.Address
+	c.Transact(ctx, c.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		var (
+			tx  *types.Transaction
+			err error
+		)
+		address, tx, _, err = bind.DeployContract(opts, abi.ABI{}, deployCode(StubCode(results)), c.Backend)
+		return tx, err
+	})
+	return address
+}
+
+// SentMessages returns the messages sent through the mock messenger in the transaction
+func (c *Chain) SentMessages(receipt *types.Receipt) []*Message {
+	messengerABI, err := teleportermessenger.TeleporterMessengerMetaData.GetAbi()
+	Expect(err).Should(BeNil())
+	method := messengerABI.Methods["sendCrossChainMessage"]
+
+	var messages []*Message
+	for _, log := range receipt.Logs {
+		if log.Address != MessengerAddress || len(log.Topics) != 1 || len(log.Data) < 4 {
+			continue
+		}
+		if !bytes.Equal(log.Data[:4], method.ID) {
+			continue
+		}
+		values, err := method.Inputs.Unpack(log.Data[4:])
+		Expect(err).Should(BeNil())
+		input := abi.ConvertType(values[0], new(teleportermessenger.TeleporterMessageInput))
+		messages = append(messages, &Message{
+			TeleporterMessageInput: *input.(*teleportermessenger.TeleporterMessageInput),
+			ID:                     ids.ID(crypto.Keccak256Hash(log.Data)),
+			SourceBlockchainID:     c.BlockchainID,
+			OriginSenderAddress:    common.BytesToAddress(log.Topics[0].Bytes()),
+		})
+	}
+	return messages
+}
+
+// Deliver delivers the message to its destination address on the chain, from the mock messenger, and returns the
+// receipt of the delivery, which fails if the execution of the message reverts
+func (c *Chain) Deliver(ctx context.Context, message *Message) *types.Receipt {
+	Expect(ids.ID(message.DestinationBlockchainID)).Should(Equal(c.BlockchainID))
+	receiverABI, err := teleportertokensource.TeleporterTokenSourceMetaData.GetAbi()
+	Expect(err).Should(BeNil())
+	call, err := receiverABI.Pack(
+		"receiveTeleporterMessage",
+		[32]byte(message.SourceBlockchainID),
+		message.OriginSenderAddress,
+		message.Message,
+	)
+	Expect(err).Should(BeNil())
+
+	opts := c.NewTransactor(ctx, c.relayerKey)
+	opts.GasLimit = deliveryGasLimit
+	contract := bind.NewBoundContract(MessengerAddress, *receiverABI, c.Backend, c.Backend, c.Backend)
+	return c.transact(ctx, opts, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return contract.RawTransact(opts, append(message.DestinationAddress.Bytes(), call...))
+	})
+}
+
+// Relay delivers each message sent to the destination chain in the source chain's transaction, and expects each
+// delivery to succeed
+func Relay(ctx context.Context, source *Chain, receipt *types.Receipt, destination *Chain) []*types.Receipt {
+	var receipts []*types.Receipt
+	for _, message := range source.SentMessages(receipt) {
+		if ids.ID(message.DestinationBlockchainID) != destination.BlockchainID {
+			continue
+		}
+		delivery := destination.Deliver(ctx, message)
+		Expect(delivery.Status).Should(Equal(types.ReceiptStatusSuccessful), "delivery %s failed", delivery.TxHash)
+		receipts = append(receipts, delivery)
+	}
+	return receipts
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package mockteleporter
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/ethclient"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ReorgClient reads a simulated chain as a client of a chain with weaker finality would, so that shallow reorgs
+// can be simulated, which the simulated backend does not support. Reorg replaces the latest blocks, as seen
+// through the client, with blocks of the same height that include none of their transactions. The state of the
+// simulated chain is not reverted, so only the receipts, logs and headers read through the client are affected.
+//
+// Only the methods used to follow a chain's blocks and the finality of its transactions, and to call its contracts,
+// are implemented, and calling any other method panics.
+type ReorgClient struct {
+	unimplementedClient
+
+	chain *Chain
+
+	lock sync.Mutex
+	// The heights of the blocks replaced by reorgs
+	reorged map[uint64]struct{}
+}
+
+// The methods of the client that ReorgClient does not implement, embedded as nil
+type unimplementedClient interface {
+	ethclient.Client
+}
+
+// NewReorgClient returns a client of the simulated chain, with no blocks reorged
+func NewReorgClient(chain *Chain) *ReorgClient {
+	return &ReorgClient{
+		chain:   chain,
+		reorged: make(map[uint64]struct{}),
+	}
+}
+
+// EventsChain returns the chain read through the client, as followed by the tracker, monitor and SDK
+func (c *ReorgClient) EventsChain(name string, confirmationDepth uint64) *events.Chain {
+	return &events.Chain{
+		Name:              name,
+		BlockchainID:      c.chain.BlockchainID,
+		Client:            c,
+		StartBlock:        1,
+		ConfirmationDepth: confirmationDepth,
+	}
+}
+
+// Reorg replaces the latest depth blocks of the chain
+func (c *ReorgClient) Reorg(ctx context.Context, depth uint64) {
+	latest, _ := c.BlockNumber(ctx)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for i := uint64(0); i < depth; i++ {
+		c.reorged[latest-i] = struct{}{}
+	}
+}
+
+// Mine builds the given number of empty blocks on the chain
+func (c *ReorgClient) Mine(blocks int) {
+	for i := 0; i < blocks; i++ {
+		c.chain.Backend.Commit(true)
+	}
+}
+
+func (c *ReorgClient) isReorged(blockNumber uint64) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, ok := c.reorged[blockNumber]
+	return ok
+}
+
+func (c *ReorgClient) BlockNumber(context.Context) (uint64, error) {
+	return c.chain.Backend.Blockchain().CurrentBlock().Number.Uint64(), nil
+}
+
+// HeaderByNumber returns the header of the block at the height, which for a reorged block differs from the header
+// of the block the simulated chain built
+func (c *ReorgClient) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	header, err := c.chain.Backend.HeaderByNumber(ctx, number)
+	if err != nil || header == nil || !c.isReorged(header.Number.Uint64()) {
+		return header, err
+	}
+	header = types.CopyHeader(header)
+	header.Extra = append(header.Extra, []byte("reorged")...)
+	return header, nil
+}
+
+// TransactionReceipt returns the receipt of the transaction, or interfaces.NotFound if its block was reorged
+func (c *ReorgClient) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	receipt, err := c.chain.Backend.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if c.isReorged(receipt.BlockNumber.Uint64()) {
+		return nil, interfaces.NotFound
+	}
+	return receipt, nil
+}
+
+// FilterLogs returns the logs matching the query, without those of reorged blocks
+func (c *ReorgClient) FilterLogs(ctx context.Context, query interfaces.FilterQuery) ([]types.Log, error) {
+	logs, err := c.chain.Backend.FilterLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	var canonical []types.Log
+	for _, log := range logs {
+		if !c.isReorged(log.BlockNumber) {
+			canonical = append(canonical, log)
+		}
+	}
+	return canonical, nil
+}
+
+// CallContract executes the call against the state of the simulated chain, which reorgs do not revert
+func (c *ReorgClient) CallContract(
+	ctx context.Context,
+	call interfaces.CallMsg,
+	blockNumber *big.Int,
+) ([]byte, error) {
+	return c.chain.Backend.CallContract(ctx, call, blockNumber)
+}
+
+func (c *ReorgClient) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return c.chain.Backend.CodeAt(ctx, account, blockNumber)
+}
+
+func (c *ReorgClient) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.chain.Backend.SuggestGasPrice(ctx)
+}
@@ -0,0 +1,489 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package scenario composes e2e flows out of reusable steps, so that a new flow does not need to repeat
+// the deployment, registration, and relaying boilerplate of the existing flows.
+package scenario
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	"github.com/ava-labs/teleporter-token-bridge/tests/utils"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// Step is a reusable part of a scenario, such as a check shared by several flows
+type Step func(s *Scenario)
+
+// Scenario bridges an ERC20 token from an ERC20Source to ERC20Destinations on other chains.
+// Each step fails the spec if it does not succeed, and returns the scenario so that steps can be chained:
+//
+//	scenario.New(ctx, network).
+//		DeployERC20Source(cChainInfo).
+//		DeployERC20Destination(subnetAInfo).
+//		Register(subnetAInfo).
+//		Send(cChainInfo, subnetAInfo, recipientAddress, amount).
+//		ExpectBalance(subnetAInfo, recipientAddress, amount)
+//
+// Contracts are deployed from the network's funded account, which also sends tokens unless As is called.
+type Scenario struct {
+	ctx     context.Context
+	network interfaces.Network
+
+	senderKey  *ecdsa.PrivateKey
+	primaryFee *big.Int
+
+	source       *sourceBridge
+	destinations map[ids.ID]*destinationBridge
+
+	lastReceipt       *types.Receipt
+	lastBatchReceipts []*types.Receipt
+	lastBridgedAmount *big.Int
+}
+
+type sourceBridge struct {
+	subnet       interfaces.SubnetTestInfo
+	address      common.Address
+	erc20Source  *erc20source.ERC20Source
+	tokenAddress common.Address
+	token        *exampleerc20.ExampleERC20
+}
+
+type destinationBridge struct {
+	subnet           interfaces.SubnetTestInfo
+	address          common.Address
+	erc20Destination *erc20destination.ERC20Destination
+}
+
+type balanceOf interface {
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+}
+
+// New returns an empty scenario on the network, sending tokens from the network's funded account
+// with no primary fee. Every transaction, wait, and relay of the scenario is bound to ctx.
+func New(ctx context.Context, network interfaces.Network) *Scenario {
+	_, fundedKey := network.GetFundedAccountInfo()
+	return &Scenario{
+		ctx:          ctx,
+		network:      network,
+		senderKey:    fundedKey,
+		primaryFee:   big.NewInt(0),
+		destinations: make(map[ids.ID]*destinationBridge),
+	}
+}
+
+// As sends the tokens of subsequent steps from the account of senderKey
+func (s *Scenario) As(senderKey *ecdsa.PrivateKey) *Scenario {
+	s.senderKey = senderKey
+	return s
+}
+
+// WithPrimaryFee pays the relayer a primary fee of the bridged token for each subsequent send.
+// The fee is paid in addition to the amount sent.
+func (s *Scenario) WithPrimaryFee(primaryFee *big.Int) *Scenario {
+	s.primaryFee = primaryFee
+	return s
+}
+
+// DeployERC20Source deploys an ExampleERC20, minted to the funded account, and an ERC20Source for it to the subnet
+func (s *Scenario) DeployERC20Source(subnet interfaces.SubnetTestInfo) *Scenario {
+	Expect(s.source).Should(BeNil(), "scenario already has an ERC20Source")
+	fundedAddress, fundedKey := s.network.GetFundedAccountInfo()
+
+	tokenAddress, token := teleporterUtils.DeployExampleERC20(s.ctx, fundedKey, subnet)
+	address, erc20Source := utils.DeployERC20Source(s.ctx, fundedKey, subnet, fundedAddress, tokenAddress)
+	s.source = &sourceBridge{
+		subnet:       subnet,
+		address:      address,
+		erc20Source:  erc20Source,
+		tokenAddress: tokenAddress,
+		token:        token,
+	}
+	return s
+}
+
+// DeployERC20Destination deploys an ERC20Destination for the scenario's ERC20Source to the subnet,
+// with its token metadata populated from the source token
+func (s *Scenario) DeployERC20Destination(subnet interfaces.SubnetTestInfo) *Scenario {
+	source := s.mustSource()
+	Expect(s.destinations).ShouldNot(
+		HaveKey(subnet.BlockchainID),
+		"scenario already has an ERC20Destination on %s",
+		subnet.BlockchainID,
+	)
+	fundedAddress, fundedKey := s.network.GetFundedAccountInfo()
+
+	address, erc20Destination, _ := utils.DeployERC20DestinationForSource(
+		s.ctx,
+		fundedKey,
+		subnet,
+		fundedAddress,
+		source.subnet,
+		source.address,
+		utils.ERC20DestinationMetadata{},
+	)
+	s.destinations[subnet.BlockchainID] = &destinationBridge{
+		subnet:           subnet,
+		address:          address,
+		erc20Destination: erc20Destination,
+	}
+	return s
+}
+
+// DeployERC20DestinationCreate2 deploys an ERC20Destination for the scenario's ERC20Source to the subnet with
+// CREATE2 from the salt, with its token metadata populated from the source token. Destinations deployed from the
+// same salt have the same address on every subnet.
+func (s *Scenario) DeployERC20DestinationCreate2(subnet interfaces.SubnetTestInfo, salt [32]byte) *Scenario {
+	source := s.mustSource()
+	Expect(s.destinations).ShouldNot(
+		HaveKey(subnet.BlockchainID),
+		"scenario already has an ERC20Destination on %s",
+		subnet.BlockchainID,
+	)
+	fundedAddress, fundedKey := s.network.GetFundedAccountInfo()
+
+	address, erc20Destination := utils.DeployERC20DestinationCreate2(
+		s.ctx,
+		fundedKey,
+		subnet,
+		fundedAddress,
+		source.subnet,
+		source.address,
+		salt,
+	)
+	s.destinations[subnet.BlockchainID] = &destinationBridge{
+		subnet:           subnet,
+		address:          address,
+		erc20Destination: erc20Destination,
+	}
+	return s
+}
+
+// Register registers the ERC20Destination on the subnet with the ERC20Source
+func (s *Scenario) Register(subnet interfaces.SubnetTestInfo) *Scenario {
+	source := s.mustSource()
+	destination := s.mustDestination(subnet)
+	utils.RegisterERC20DestinationOnSource(
+		s.ctx,
+		s.network,
+		source.subnet,
+		source.address,
+		destination.subnet,
+		destination.address,
+	)
+	return s
+}
+
+// RateLimit limits the tokens the ERC20Source sends to the ERC20Destination on the subnet to limit per window,
+// from the funded account that owns the source. A zero limit removes the rate limit.
+func (s *Scenario) RateLimit(subnet interfaces.SubnetTestInfo, limit *big.Int, window time.Duration) *Scenario {
+	source := s.mustSource()
+	destination := s.mustDestination(subnet)
+	_, fundedKey := s.network.GetFundedAccountInfo()
+	utils.SetRateLimitOnSource(
+		s.ctx,
+		source.subnet,
+		source.address,
+		destination.subnet.BlockchainID,
+		destination.address,
+		limit,
+		window,
+		fundedKey,
+	)
+	return s
+}
+
+// Fund sends native tokens from the funded account to the account on the subnet, to pay for its gas
+func (s *Scenario) Fund(subnet interfaces.SubnetTestInfo, account common.Address, amount *big.Int) *Scenario {
+	_, fundedKey := s.network.GetFundedAccountInfo()
+	teleporterUtils.SendNativeTransfer(s.ctx, subnet, fundedKey, account, amount)
+	return s
+}
+
+// Send bridges the amount of tokens to the recipient, between the source chain and one of the destinations
+// in either direction. The message is relayed, and the recipient is checked to have been sent the tokens.
+func (s *Scenario) Send(
+	from interfaces.SubnetTestInfo,
+	to interfaces.SubnetTestInfo,
+	recipient common.Address,
+	amount *big.Int,
+) *Scenario {
+	s.deliver(s.send(from, to, recipient, amount))
+	return s
+}
+
+// Transfer is one of the transfers of a batch sent by SendBatch
+type Transfer struct {
+	To        interfaces.SubnetTestInfo
+	Recipient common.Address
+	Amount    *big.Int
+}
+
+// SendBatch sends each of the transfers from the chain before relaying any of them, so that every message of the
+// batch is outstanding at once. The messages are then relayed in the order they were sent, and each recipient is
+// checked to have been sent its tokens. Once all are delivered, the balance of each recipient, and the balance
+// the source tracks as bridged to each destination, are checked to have changed by the total of the batch.
+func (s *Scenario) SendBatch(from interfaces.SubnetTestInfo, transfers ...Transfer) *Scenario {
+	source := s.mustSource()
+
+	// Snapshot the balances that the batch changes
+	type recipientKey struct {
+		blockchainID ids.ID
+		recipient    common.Address
+	}
+	type recipientBalance struct {
+		subnet  interfaces.SubnetTestInfo
+		balance *big.Int
+	}
+	recipientBalances := make(map[recipientKey]*recipientBalance)
+	bridgedBalances := make(map[ids.ID]*big.Int)
+	for _, transfer := range transfers {
+		key := recipientKey{transfer.To.BlockchainID, transfer.Recipient}
+		if _, ok := recipientBalances[key]; !ok {
+			recipientBalances[key] = &recipientBalance{transfer.To, s.balance(transfer.To, transfer.Recipient)}
+		}
+		destinationID := transfer.To.BlockchainID
+		if destinationID == source.subnet.BlockchainID {
+			destinationID = from.BlockchainID
+		}
+		if _, ok := bridgedBalances[destinationID]; !ok {
+			bridgedBalances[destinationID] = s.bridgedBalance(destinationID)
+		}
+	}
+
+	pending := make([]*pendingTransfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		pending = append(pending, s.send(from, transfer.To, transfer.Recipient, transfer.Amount))
+	}
+	s.lastBatchReceipts = make([]*types.Receipt, 0, len(pending))
+	for _, transfer := range pending {
+		s.deliver(transfer)
+		s.lastBatchReceipts = append(s.lastBatchReceipts, s.lastReceipt)
+
+		key := recipientKey{transfer.to.BlockchainID, transfer.recipient}
+		recipientBalances[key].balance.Add(recipientBalances[key].balance, transfer.bridgedAmount)
+		if transfer.to.BlockchainID == source.subnet.BlockchainID {
+			bridgedBalances[from.BlockchainID].Sub(bridgedBalances[from.BlockchainID], transfer.bridgedAmount)
+		} else {
+			bridgedBalances[transfer.to.BlockchainID].Add(bridgedBalances[transfer.to.BlockchainID], transfer.bridgedAmount)
+		}
+	}
+
+	for key, expected := range recipientBalances {
+		s.ExpectBalance(expected.subnet, key.recipient, expected.balance)
+	}
+	for destinationID, expected := range bridgedBalances {
+		teleporterUtils.ExpectBigEqual(s.bridgedBalance(destinationID), expected)
+	}
+	log.Info("Scenario sent batch", "from", from.BlockchainID, "transfers", len(transfers))
+	return s
+}
+
+// A transfer that has been sent, but not yet relayed
+type pendingTransfer struct {
+	from          interfaces.SubnetTestInfo
+	to            interfaces.SubnetTestInfo
+	recipient     common.Address
+	bridgedAmount *big.Int
+	receipt       *types.Receipt
+}
+
+func (s *Scenario) send(
+	from interfaces.SubnetTestInfo,
+	to interfaces.SubnetTestInfo,
+	recipient common.Address,
+	amount *big.Int,
+) *pendingTransfer {
+	source := s.mustSource()
+	transfer := &pendingTransfer{from: from, to: to, recipient: recipient}
+	switch {
+	case from.BlockchainID == source.subnet.BlockchainID:
+		destination := s.mustDestination(to)
+		input := erc20source.SendTokensInput{
+			DestinationBlockchainID:  destination.subnet.BlockchainID,
+			DestinationBridgeAddress: destination.address,
+			Recipient:                recipient,
+			PrimaryFeeTokenAddress:   source.tokenAddress,
+			PrimaryFee:               s.primaryFee,
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		}
+		transfer.receipt, transfer.bridgedAmount = utils.SendERC20Source(
+			s.ctx,
+			source.subnet,
+			source.erc20Source,
+			source.address,
+			source.token,
+			input,
+			amount,
+			s.senderKey,
+		)
+	case to.BlockchainID == source.subnet.BlockchainID:
+		destination := s.mustDestination(from)
+		input := erc20destination.SendTokensInput{
+			DestinationBlockchainID:  source.subnet.BlockchainID,
+			DestinationBridgeAddress: source.address,
+			Recipient:                recipient,
+			PrimaryFeeTokenAddress:   destination.address,
+			PrimaryFee:               s.primaryFee,
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         utils.DefaultERC20RequiredGas,
+		}
+		transfer.receipt, transfer.bridgedAmount = utils.SendERC20Destination(
+			s.ctx,
+			destination.subnet,
+			destination.erc20Destination,
+			destination.address,
+			input,
+			amount,
+			s.senderKey,
+		)
+	default:
+		Expect(from.BlockchainID).Should(
+			Equal(source.subnet.BlockchainID),
+			"scenario sends must be to or from the source chain",
+		)
+	}
+	s.lastBridgedAmount = transfer.bridgedAmount
+	log.Info(
+		"Scenario sent tokens",
+		"from", from.BlockchainID,
+		"to", to.BlockchainID,
+		"recipient", recipient,
+		"amount", transfer.bridgedAmount,
+	)
+	return transfer
+}
+
+// Relays the transfer's message, and checks that the recipient was sent the tokens
+func (s *Scenario) deliver(transfer *pendingTransfer) {
+	source := s.mustSource()
+	if transfer.from.BlockchainID == source.subnet.BlockchainID {
+		destination := s.mustDestination(transfer.to)
+		s.lastReceipt = s.network.RelayMessage(s.ctx, transfer.receipt, source.subnet, destination.subnet, true)
+		utils.CheckERC20DestinationWithdrawal(
+			s.ctx,
+			destination.erc20Destination,
+			s.lastReceipt,
+			transfer.recipient,
+			transfer.bridgedAmount,
+		)
+		return
+	}
+	destination := s.mustDestination(transfer.from)
+	s.lastReceipt = s.network.RelayMessage(s.ctx, transfer.receipt, destination.subnet, source.subnet, true)
+	utils.CheckERC20SourceWithdrawal(
+		s.ctx,
+		source.address,
+		source.token,
+		s.lastReceipt,
+		transfer.recipient,
+		transfer.bridgedAmount,
+	)
+}
+
+// ExpectBalance checks the account's balance of the scenario's token on the subnet,
+// which is the source token on the source chain, or the ERC20Destination on other chains
+func (s *Scenario) ExpectBalance(
+	subnet interfaces.SubnetTestInfo,
+	account common.Address,
+	expected *big.Int,
+) *Scenario {
+	teleporterUtils.ExpectBigEqual(s.balance(subnet, account), expected)
+	return s
+}
+
+// ExpectBridgedAmount checks the amount bridged by the last send, after scaling to the receiving chain
+func (s *Scenario) ExpectBridgedAmount(expected *big.Int) *Scenario {
+	Expect(s.lastBridgedAmount).ShouldNot(BeNil(), "scenario has not sent any tokens")
+	teleporterUtils.ExpectBigEqual(s.lastBridgedAmount, expected)
+	return s
+}
+
+// Then runs the steps in order
+func (s *Scenario) Then(steps ...Step) *Scenario {
+	for _, step := range steps {
+		step(s)
+	}
+	return s
+}
+
+// Context returns the context the scenario's transactions are sent with
+func (s *Scenario) Context() context.Context {
+	return s.ctx
+}
+
+// Network returns the network the scenario runs on
+func (s *Scenario) Network() interfaces.Network {
+	return s.network
+}
+
+// Source returns the scenario's ERC20Source and the token it bridges
+func (s *Scenario) Source() (common.Address, *erc20source.ERC20Source, common.Address, *exampleerc20.ExampleERC20) {
+	source := s.mustSource()
+	return source.address, source.erc20Source, source.tokenAddress, source.token
+}
+
+// Destination returns the scenario's ERC20Destination on the subnet
+func (s *Scenario) Destination(subnet interfaces.SubnetTestInfo) (common.Address, *erc20destination.ERC20Destination) {
+	destination := s.mustDestination(subnet)
+	return destination.address, destination.erc20Destination
+}
+
+// LastReceipt returns the receipt of the delivery of the last send
+func (s *Scenario) LastReceipt() *types.Receipt {
+	return s.lastReceipt
+}
+
+// LastBatchReceipts returns the receipts of the deliveries of the last batch, in the order they were sent
+func (s *Scenario) LastBatchReceipts() []*types.Receipt {
+	return s.lastBatchReceipts
+}
+
+// Returns the account's balance of the scenario's token on the subnet
+func (s *Scenario) balance(subnet interfaces.SubnetTestInfo, account common.Address) *big.Int {
+	var token balanceOf
+	if source := s.mustSource(); subnet.BlockchainID == source.subnet.BlockchainID {
+		token = source.token
+	} else {
+		token = s.mustDestination(subnet).erc20Destination
+	}
+	balance, err := token.BalanceOf(&bind.CallOpts{Context: s.ctx}, account)
+	Expect(err).Should(BeNil())
+	return balance
+}
+
+// Returns the balance that the source tracks as bridged to its destination on the chain
+func (s *Scenario) bridgedBalance(blockchainID ids.ID) *big.Int {
+	source := s.mustSource()
+	destination, ok := s.destinations[blockchainID]
+	Expect(ok).Should(BeTrue(), "scenario has no ERC20Destination on %s", blockchainID)
+	balance, err := source.erc20Source.BridgedBalances(&bind.CallOpts{Context: s.ctx}, blockchainID, destination.address)
+	Expect(err).Should(BeNil())
+	return balance
+}
+
+func (s *Scenario) mustSource() *sourceBridge {
+	Expect(s.source).ShouldNot(BeNil(), "scenario has no ERC20Source")
+	return s.source
+}
+
+func (s *Scenario) mustDestination(subnet interfaces.SubnetTestInfo) *destinationBridge {
+	destination, ok := s.destinations[subnet.BlockchainID]
+	Expect(ok).Should(BeTrue(), "scenario has no ERC20Destination on %s", subnet.BlockchainID)
+	return destination
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package unit
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/mockteleporter"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const confirmationDepth = 2
+
+var _ = ginkgo.Describe("[Confirmation depth]", func() {
+	ginkgo.It("Treats sends as final once buried under the confirmation depth, across shallow reorgs", func() {
+		ctx := context.Background()
+		b := newERC20Bridge(ctx)
+		destinationAddress, erc20Destination := b.deployERC20Destination(ctx)
+		b.register(ctx, destinationAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.RegisterWithSource(opts, erc20destination.TeleporterFeeInfo{
+				FeeTokenAddress: destinationAddress,
+				Amount:          big.NewInt(0),
+			})
+		})
+
+		// The source chain is followed with the confirmation depth, and without one for comparison
+		client := mockteleporter.NewReorgClient(b.source)
+		chain := client.EventsChain("source", confirmationDepth)
+		finalSends := followSends(ctx, chain, b.sourceAddress)
+		latestSends := followSends(ctx, client.EventsChain("source", 0), b.sourceAddress)
+		amount := big.NewInt(1e18)
+
+		// A send is not final until the depth of blocks is built on it, so it is only processed without a depth
+		reorgedReceipt := b.send(ctx, destinationAddress, b.destination.FundedAddress, amount)
+		final, err := bridge.IsFinal(ctx, chain, reorgedReceipt)
+		Expect(err).Should(BeNil())
+		Expect(final).Should(BeFalse())
+		Expect(finalSends()).Should(BeEmpty())
+		Expect(latestSends()).Should(HaveLen(1))
+
+		// A reorg of the send's block and the block built on it removes the send from the chain. It was processed
+		// without a depth, but never with one.
+		client.Mine(1)
+		client.Reorg(ctx, confirmationDepth)
+		_, err = bridge.IsFinal(ctx, chain, reorgedReceipt)
+		Expect(errors.Is(err, bridge.ErrReorged)).Should(BeTrue())
+		client.Mine(confirmationDepth)
+		Expect(finalSends()).Should(BeEmpty())
+		waitCtx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+		_, err = bridge.WaitForFinality(waitCtx, chain, reorgedReceipt.TxHash)
+		Expect(errors.Is(err, context.DeadlineExceeded)).Should(BeTrue())
+
+		// A send that is not reorged is final, and processed, once the depth of blocks is built on it
+		receipt := b.send(ctx, destinationAddress, b.destination.FundedAddress, amount)
+		client.Mine(confirmationDepth - 1)
+		final, err = bridge.IsFinal(ctx, chain, receipt)
+		Expect(err).Should(BeNil())
+		Expect(final).Should(BeFalse())
+		Expect(finalSends()).Should(BeEmpty())
+		client.Mine(1)
+		finalReceipt, err := bridge.WaitForFinality(ctx, chain, receipt.TxHash)
+		Expect(err).Should(BeNil())
+		Expect(finalReceipt.BlockHash).Should(Equal(receipt.BlockHash))
+		sends := finalSends()
+		Expect(sends).Should(HaveLen(1))
+		Expect(sends[0].TxHash).Should(Equal(receipt.TxHash))
+	})
+})
+
+// Follows the sends of the source on the chain with a poller, returning a function that polls up to the chain's
+// final block and returns the sends processed since it was last called
+func followSends(ctx context.Context, chain *events.Chain, sourceAddress common.Address) func() []*events.Event {
+	decoder, err := events.NewDecoder()
+	Expect(err).Should(BeNil())
+	var sends []*events.Event
+	poller := events.NewPoller(
+		logging.NoLog{},
+		chain,
+		decoder,
+		map[common.Address]events.ContractInfo{sourceAddress: {Bridge: "bridge", Type: events.ERC20Source}},
+		events.PollerConfig{},
+		func(_ context.Context, bridgeEvents []*events.Event, _ uint64) error {
+			for _, event := range bridgeEvents {
+				if event.Type == events.TokensSent {
+					sends = append(sends, event)
+				}
+			}
+			return nil
+		},
+	)
+	poll := func() []*events.Event {
+		sends = nil
+		for {
+			caughtUp, err := poller.Poll(ctx)
+			Expect(err).Should(BeNil())
+			if caughtUp {
+				return sends
+			}
+		}
+	}
+	poll()
+	return poll
+}
@@ -0,0 +1,56 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package unit
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/tests/mockteleporter"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("[Quote]", func() {
+	ginkgo.It("Quotes sends from a source without a rate limit", func() {
+		ctx := context.Background()
+		b := newERC20Bridge(ctx)
+		destinationAddress, erc20Destination := b.deployERC20Destination(ctx)
+		b.register(ctx, destinationAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.RegisterWithSource(opts, erc20destination.TeleporterFeeInfo{
+				FeeTokenAddress: destinationAddress,
+				Amount:          big.NewInt(0),
+			})
+		})
+		source := bridge.Endpoint{
+			Chain:   mockteleporter.NewReorgClient(b.source).EventsChain("source", 0),
+			Address: b.sourceAddress,
+			Type:    events.ERC20Source,
+		}
+		destination := bridge.Endpoint{
+			Chain:   mockteleporter.NewReorgClient(b.destination).EventsChain("destination", 0),
+			Address: destinationAddress,
+			Type:    events.ERC20Destination,
+		}
+
+		// Sources that set no rate limit on the destination, or that were deployed before rate limits were added,
+		// don't limit the amount sent
+		rateLimit, err := bridge.GetRateLimit(ctx, source, destination.Chain.BlockchainID, destinationAddress)
+		Expect(err).Should(BeNil())
+		Expect(rateLimit.Remaining).Should(BeNil())
+
+		amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1_000_000))
+		quote, err := bridge.Quote(ctx, source, destination, amount, bridge.QuoteOptions{})
+		Expect(err).Should(BeNil())
+		Expect(quote.Route).Should(Equal(bridge.SourceToDestination))
+		teleporterUtils.ExpectBigEqual(quote.DestinationAmount, amount)
+		teleporterUtils.ExpectBigEqual(quote.PrimaryRequiredGasLimit, bridge.DefaultERC20RequiredGasLimit)
+	})
+})
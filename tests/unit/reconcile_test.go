@@ -0,0 +1,94 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package unit
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/monitor"
+	"github.com/ava-labs/teleporter-token-bridge/tests/mockteleporter"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("[Reconcile]", func() {
+	var (
+		ctx          context.Context
+		chain        *mockteleporter.Chain
+		sourceABI    *abi.ABI
+		tokenAddress common.Address
+		held         *big.Int
+	)
+
+	// Deploys a stub of a NativeTokenSource holding the tokenAddress stub's balance, with the given views, and
+	// reconciles a bridge of it without destinations, so that none of its balance is expected to be locked
+	reconcile := func(views map[string]interface{}) *monitor.BridgeReport {
+		results := make(map[[4]byte][]byte, len(views))
+		for name, value := range views {
+			method := sourceABI.Methods[name]
+			result, err := method.Outputs.Pack(value)
+			Expect(err).Should(BeNil())
+			results[[4]byte(method.ID)] = result
+		}
+		sourceAddress := chain.DeployStub(ctx, results)
+		bridge := events.BridgeConfig{
+			Name: "bridge",
+			Source: events.ContractConfig{
+				Chain:   "source",
+				Address: sourceAddress.Hex(),
+				Type:    events.NativeTokenSource,
+			},
+		}
+		chains := map[string]*events.Chain{
+			"source": mockteleporter.NewReorgClient(chain).EventsChain("source", 0),
+		}
+		report, err := monitor.NewReconciler(chains, []events.BridgeConfig{bridge}, nil).ReconcileBridge(ctx, bridge)
+		Expect(err).Should(BeNil())
+		return report
+	}
+
+	ginkgo.BeforeEach(func() {
+		ctx = context.Background()
+		chain = mockteleporter.NewChain(ctx, sourceBlockchainID)
+		ginkgo.DeferCleanup(chain.Close)
+		var err error
+		sourceABI, err = nativetokensource.NativeTokenSourceMetaData.GetAbi()
+		Expect(err).Should(BeNil())
+
+		// The wrapped native token balance of the source is all held for recipients that couldn't receive it
+		held = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(7))
+		tokenABI, err := exampleerc20.ExampleERC20MetaData.GetAbi()
+		Expect(err).Should(BeNil())
+		balanceOf := tokenABI.Methods["balanceOf"]
+		balance, err := balanceOf.Outputs.Pack(held)
+		Expect(err).Should(BeNil())
+		tokenAddress = chain.DeployStub(ctx, map[[4]byte][]byte{[4]byte(balanceOf.ID): balance})
+	})
+
+	ginkgo.It("Doesn't report withdrawals held by a native token source as drift", func() {
+		report := reconcile(map[string]interface{}{
+			"tokenAddress":              tokenAddress,
+			"totalClaimableWithdrawals": held,
+		})
+		teleporterUtils.ExpectBigEqual(report.HeldWithdrawals, held)
+		teleporterUtils.ExpectBigEqual(report.LockedBalance, big.NewInt(0))
+		teleporterUtils.ExpectBigEqual(report.Drift, big.NewInt(0))
+		Expect(report.DriftExceeded).Should(BeFalse())
+	})
+
+	ginkgo.It("Treats native token sources without held withdrawal totals as holding none", func() {
+		report := reconcile(map[string]interface{}{"tokenAddress": tokenAddress})
+		teleporterUtils.ExpectBigEqual(report.HeldWithdrawals, big.NewInt(0))
+		teleporterUtils.ExpectBigEqual(report.LockedBalance, held)
+		teleporterUtils.ExpectBigEqual(report.Drift, held)
+		Expect(report.DriftExceeded).Should(BeTrue())
+	})
+})
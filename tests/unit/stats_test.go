@@ -0,0 +1,180 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package unit
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/indexer"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = ginkgo.Describe("[Indexer stats]", func() {
+	ginkgo.It("Aggregates the usage of each route over a time window", func() {
+		ctx := context.Background()
+		store, err := indexer.OpenStore(ctx, indexer.DatabaseConfig{
+			Driver: indexer.SQLite,
+			DSN:    filepath.Join(ginkgo.GinkgoT().TempDir(), "indexer.db"),
+		})
+		Expect(err).Should(BeNil())
+		defer store.Close()
+
+		var (
+			start              = time.Unix(1_700_000_000, 0)
+			otherBlockchainID  = ids.ID{3}
+			sourceAddress      = common.Address{1}
+			destinationAddress = common.Address{2}
+			otherAddress       = common.Address{3}
+			feeToken           = common.Address{4}
+			alice, bob         = common.Address{5}, common.Address{6}
+			nextMessageID      byte
+			// The sends of transfers, and the deliveries of their hops, which are indexed separately
+			batch, deliveries indexer.Batch
+		)
+		// Adds a transfer sent at the offset from the start of the window, along with the send of its first hop
+		send := func(
+			bridge string,
+			from ids.ID,
+			fromAddress common.Address,
+			to ids.ID,
+			sender common.Address,
+			amount int64,
+			sentAt time.Duration,
+		) ids.ID {
+			nextMessageID++
+			messageID := ids.ID{nextMessageID}
+			batch.Transfers = append(batch.Transfers, &indexer.Transfer{
+				MessageID:               messageID,
+				Bridge:                  bridge,
+				Type:                    events.TokensSent,
+				SourceBlockchainID:      from,
+				SourceAddress:           fromAddress,
+				BlockTime:               start.Add(sentAt),
+				Sender:                  sender,
+				Recipient:               sender,
+				Amount:                  big.NewInt(amount),
+				DestinationBlockchainID: to,
+				DestinationAddress:      destinationAddress,
+			})
+			nextHop := to
+			if from != sourceBlockchainID {
+				nextHop = sourceBlockchainID
+			}
+			batch.HopSends = append(batch.HopSends, &indexer.HopSend{
+				MessageID:               messageID,
+				Bridge:                  bridge,
+				SourceBlockchainID:      from,
+				DestinationBlockchainID: nextHop,
+				Type:                    events.TokensSent,
+				BlockTime:               start.Add(sentAt),
+			})
+			return messageID
+		}
+		deliver := func(messageID ids.ID, to ids.ID, eventType events.EventType, at time.Duration) {
+			deliveries.HopDeliveries = append(deliveries.HopDeliveries, &indexer.HopDelivery{
+				MessageID:               messageID,
+				Bridge:                  "bridge",
+				DestinationBlockchainID: to,
+				Type:                    eventType,
+				BlockTime:               start.Add(at),
+			})
+		}
+		pay := func(messageID ids.ID, primaryFee, secondaryFee int64) {
+			batch.Fees = append(batch.Fees, &indexer.Fee{
+				MessageID:    messageID,
+				Bridge:       "bridge",
+				FeeToken:     feeToken,
+				PrimaryFee:   big.NewInt(primaryFee),
+				SecondaryFee: big.NewInt(secondaryFee),
+			})
+		}
+
+		// Two transfers from the source to the destination are delivered, after 10 and 30 seconds, and a third is
+		// still pending
+		delivered := send("bridge", sourceBlockchainID, sourceAddress, destinationBlockchainID, alice, 100, 0)
+		deliver(delivered, destinationBlockchainID, events.TokensWithdrawn, 10*time.Second)
+		pay(delivered, 5, 0)
+		delivered = send("bridge", sourceBlockchainID, sourceAddress, destinationBlockchainID, bob, 200, time.Hour)
+		deliver(delivered, destinationBlockchainID, events.TokensWithdrawn, time.Hour+30*time.Second)
+		pay(delivered, 7, 0)
+		send("bridge", sourceBlockchainID, sourceAddress, destinationBlockchainID, alice, 300, 2*time.Hour)
+
+		// A transfer between destinations is only delivered once its second hop is, which is routed by the source
+		routed := send("bridge", destinationBlockchainID, destinationAddress, otherBlockchainID, alice, 50, time.Hour)
+		pay(routed, 3, 2)
+		deliver(routed, sourceBlockchainID, events.TokensRouted, time.Hour+5*time.Second)
+		Expect(store.Apply(ctx, "sends", &batch, 1)).Should(Succeed())
+		Expect(store.Apply(ctx, "deliveries", &deliveries, 1)).Should(Succeed())
+		query := indexer.StatsQuery{From: start, To: start.Add(24 * time.Hour), Bridge: "bridge"}
+		stats, err := store.Stats(ctx, query)
+		Expect(err).Should(BeNil())
+		Expect(stats).Should(HaveLen(2))
+		Expect(stats[0].Delivered + stats[1].Delivered).Should(Equal(2))
+
+		nextMessageID++
+		secondHop := ids.ID{nextMessageID}
+		batch, deliveries = indexer.Batch{}, indexer.Batch{}
+		batch.HopSends = append(batch.HopSends, &indexer.HopSend{
+			MessageID:               secondHop,
+			PreviousMessageID:       routed,
+			Bridge:                  "bridge",
+			SourceBlockchainID:      sourceBlockchainID,
+			DestinationBlockchainID: otherBlockchainID,
+			Type:                    events.TokensRouted,
+			BlockTime:               start.Add(time.Hour + 5*time.Second),
+		})
+		deliver(secondHop, otherBlockchainID, events.TokensWithdrawn, time.Hour+20*time.Second)
+
+		// Transfers outside of the window, or of other bridges, are not counted
+		send("bridge", sourceBlockchainID, sourceAddress, destinationBlockchainID, bob, 1000, -time.Second)
+		send("bridge", sourceBlockchainID, sourceAddress, destinationBlockchainID, bob, 1000, 24*time.Hour)
+		send("other", sourceBlockchainID, otherAddress, destinationBlockchainID, bob, 1000, time.Hour)
+		Expect(store.Apply(ctx, "sends", &batch, 2)).Should(Succeed())
+		Expect(store.Apply(ctx, "deliveries", &deliveries, 2)).Should(Succeed())
+
+		stats, err = store.Stats(ctx, query)
+		Expect(err).Should(BeNil())
+		Expect(stats).Should(HaveLen(2))
+		direct, multiHop := stats[0], stats[1]
+		if direct.SourceBlockchainID != sourceBlockchainID {
+			direct, multiHop = multiHop, direct
+		}
+
+		Expect(direct.Bridge).Should(Equal("bridge"))
+		Expect(direct.SourceAddress).Should(Equal(sourceAddress))
+		Expect(direct.DestinationBlockchainID).Should(Equal(destinationBlockchainID))
+		Expect(direct.DestinationAddress).Should(Equal(destinationAddress))
+		Expect(direct.Transfers).Should(Equal(3))
+		Expect(direct.Delivered).Should(Equal(2))
+		Expect(direct.UniqueSenders).Should(Equal(2))
+		teleporterUtils.ExpectBigEqual(direct.Volume, big.NewInt(600))
+		Expect(direct.AverageLatency).Should(Equal(20 * time.Second))
+		Expect(direct.PrimaryFees).Should(HaveLen(1))
+		teleporterUtils.ExpectBigEqual(direct.PrimaryFees[feeToken], big.NewInt(12))
+		teleporterUtils.ExpectBigEqual(direct.SecondaryFees, big.NewInt(0))
+
+		Expect(multiHop.SourceBlockchainID).Should(Equal(destinationBlockchainID))
+		Expect(multiHop.DestinationBlockchainID).Should(Equal(otherBlockchainID))
+		Expect(multiHop.Transfers).Should(Equal(1))
+		Expect(multiHop.Delivered).Should(Equal(1))
+		Expect(multiHop.UniqueSenders).Should(Equal(1))
+		teleporterUtils.ExpectBigEqual(multiHop.Volume, big.NewInt(50))
+		Expect(multiHop.AverageLatency).Should(Equal(20 * time.Second))
+		teleporterUtils.ExpectBigEqual(multiHop.PrimaryFees[feeToken], big.NewInt(3))
+		teleporterUtils.ExpectBigEqual(multiHop.SecondaryFees, big.NewInt(2))
+
+		// Without a bridge, the transfers of every bridge are counted
+		stats, err = store.Stats(ctx, indexer.StatsQuery{From: query.From, To: query.To})
+		Expect(err).Should(BeNil())
+		Expect(stats).Should(HaveLen(3))
+	})
+})
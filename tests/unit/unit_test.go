@@ -0,0 +1,367 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package unit
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	"github.com/ava-labs/teleporter-token-bridge/tests/mockteleporter"
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	sourceBlockchainID      = ids.ID{1}
+	destinationBlockchainID = ids.ID{2}
+)
+
+// The specs run the bridge contracts on simulated chains with the mock Teleporter messenger, so unlike the e2e
+// suites they run with every go test, without a local network
+func TestUnit(t *testing.T) {
+	// The simulated chains log each block they build and each time they are stopped
+	log.Root().SetHandler(log.DiscardHandler())
+	RegisterFailHandler(ginkgo.Fail)
+	ginkgo.RunSpecs(t, "Teleporter token bridge unit test")
+}
+
+// An ERC20Source of an example token on the source chain, and the destination chain it bridges to
+type erc20Bridge struct {
+	source        *mockteleporter.Chain
+	destination   *mockteleporter.Chain
+	token         *exampleerc20.ExampleERC20
+	tokenAddress  common.Address
+	erc20Source   *erc20source.ERC20Source
+	sourceAddress common.Address
+}
+
+var _ = ginkgo.Describe("[Mock Teleporter]", func() {
+	var (
+		ctx context.Context
+		b   *erc20Bridge
+	)
+
+	ginkgo.BeforeEach(func() {
+		ctx = context.Background()
+		b = newERC20Bridge(ctx)
+	})
+
+	ginkgo.It("Encodes and delivers an ERC20 send, and its return to the source", func() {
+		destinationAddress, erc20Destination := b.deployERC20Destination(ctx)
+		registration := b.register(ctx, destinationAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.RegisterWithSource(opts, erc20destination.TeleporterFeeInfo{
+				FeeTokenAddress: destinationAddress,
+				Amount:          big.NewInt(0),
+			})
+		})
+		teleporterUtils.ExpectBigEqual(registration.TokenMultiplier, big.NewInt(1))
+		Expect(registration.MultiplyOnDestination).Should(BeFalse())
+
+		// The message carries the recipient and amount to the destination, and its ID is emitted with the send
+		amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(13))
+		receipt := b.send(ctx, destinationAddress, b.destination.FundedAddress, amount)
+		sent := b.source.SentMessages(receipt)
+		Expect(sent).Should(HaveLen(1))
+		Expect(ids.ID(sent[0].DestinationBlockchainID)).Should(Equal(destinationBlockchainID))
+		Expect(sent[0].DestinationAddress).Should(Equal(destinationAddress))
+		Expect(sent[0].OriginSenderAddress).Should(Equal(b.sourceAddress))
+		message, err := messages.Decode(sent[0].Message)
+		Expect(err).Should(BeNil())
+		Expect(message).Should(Equal(messages.SingleHopSendMessage{
+			Recipient: b.destination.FundedAddress,
+			Amount:    amount,
+		}))
+		sentEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, b.erc20Source.ParseTokensSent)
+		Expect(err).Should(BeNil())
+		Expect(ids.ID(sentEvent.TeleporterMessageID)).Should(Equal(sent[0].ID))
+		Expect(sentEvent.Sender).Should(Equal(b.source.FundedAddress))
+		teleporterUtils.ExpectBigEqual(sentEvent.Amount, amount)
+
+		// The destination mints the amount to the recipient
+		deliveries := mockteleporter.Relay(ctx, b.source, receipt, b.destination)
+		Expect(deliveries).Should(HaveLen(1))
+		withdrawnEvent, err := teleporterUtils.GetEventFromLogs(
+			deliveries[0].Logs,
+			erc20Destination.ParseTokensWithdrawn,
+		)
+		Expect(err).Should(BeNil())
+		Expect(withdrawnEvent.Recipient).Should(Equal(b.destination.FundedAddress))
+		teleporterUtils.ExpectBigEqual(withdrawnEvent.Amount, amount)
+		balance, err := erc20Destination.BalanceOf(&bind.CallOpts{Context: ctx}, b.destination.FundedAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, amount)
+
+		// Sending back burns the tokens on the destination, and the source releases them to the recipient
+		recipientAddress := newAddress()
+		returned := big.NewInt(1e18)
+		b.destination.Transact(ctx, b.destination.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.Approve(opts, destinationAddress, returned)
+		})
+		receipt = b.destination.Transact(
+			ctx,
+			b.destination.FundedKey,
+			func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return erc20Destination.Send(opts, erc20destination.SendTokensInput{
+					DestinationBlockchainID:  sourceBlockchainID,
+					DestinationBridgeAddress: b.sourceAddress,
+					Recipient:                recipientAddress,
+					PrimaryFeeTokenAddress:   destinationAddress,
+					PrimaryFee:               big.NewInt(0),
+					SecondaryFee:             big.NewInt(0),
+					RequiredGasLimit:         big.NewInt(100_000),
+				}, returned)
+			},
+		)
+		deliveries = mockteleporter.Relay(ctx, b.destination, receipt, b.source)
+		Expect(deliveries).Should(HaveLen(1))
+		releasedEvent, err := teleporterUtils.GetEventFromLogs(deliveries[0].Logs, b.erc20Source.ParseTokensWithdrawn)
+		Expect(err).Should(BeNil())
+		Expect(releasedEvent.Recipient).Should(Equal(recipientAddress))
+		teleporterUtils.ExpectBigEqual(releasedEvent.Amount, returned)
+		balance, err = b.token.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(balance, returned)
+		bridgedBalance, err := b.erc20Source.BridgedBalances(
+			&bind.CallOpts{Context: ctx},
+			destinationBlockchainID,
+			destinationAddress,
+		)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(bridgedBalance, new(big.Int).Sub(amount, returned))
+	})
+
+	ginkgo.It("Scales the collateral and sends of a native token destination", func() {
+		// The initial reserve imbalance is not a multiple of the multiplier, so its collateral is rounded up
+		multiplier := tokenscaling.TokenMultiplier(2)
+		initialReserveImbalance := new(big.Int).Add(new(big.Int).Mul(big.NewInt(1e18), big.NewInt(1000)), big.NewInt(1))
+		var (
+			destinationAddress     common.Address
+			nativeTokenDestination *nativetokendestination.NativeTokenDestination
+		)
+		b.destination.Transact(ctx, b.destination.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			destinationAddress, tx, nativeTokenDestination, err = nativetokendestination.DeployNativeTokenDestination(
+				opts,
+				b.destination.Backend,
+				nativetokendestination.NativeTokenDestinationSettings{
+					NativeAssetSymbol:                   "NATV",
+					TeleporterRegistryAddress:           b.destination.TeleporterRegistryAddress,
+					TeleporterManager:                   b.destination.FundedAddress,
+					SourceBlockchainID:                  sourceBlockchainID,
+					TokenSourceAddress:                  b.sourceAddress,
+					InitialReserveImbalance:             initialReserveImbalance,
+					DecimalsShift:                       2,
+					MultiplyOnDestination:               true,
+					BurnedFeesReportingRewardPercentage: big.NewInt(1),
+				},
+			)
+			return tx, err
+		})
+		registration := b.register(ctx, destinationAddress, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return nativeTokenDestination.RegisterWithSource(opts, nativetokendestination.TeleporterFeeInfo{
+				FeeTokenAddress: destinationAddress,
+				Amount:          big.NewInt(0),
+			})
+		})
+		teleporterUtils.ExpectBigEqual(registration.TokenMultiplier, multiplier)
+		Expect(registration.MultiplyOnDestination).Should(BeTrue())
+		collateralNeeded := tokenscaling.CollateralNeeded(multiplier, true, initialReserveImbalance)
+		teleporterUtils.ExpectBigEqual(registration.InitialCollateralNeeded, collateralNeeded)
+		teleporterUtils.ExpectBigEqual(
+			collateralNeeded,
+			new(big.Int).Add(new(big.Int).Mul(big.NewInt(1e16), big.NewInt(1000)), big.NewInt(1)),
+		)
+
+		b.source.Transact(ctx, b.source.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return b.token.Approve(opts, b.sourceAddress, collateralNeeded)
+		})
+		receipt := b.source.Transact(ctx, b.source.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return b.erc20Source.AddCollateral(opts, destinationBlockchainID, destinationAddress, collateralNeeded)
+		})
+		collateralEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, b.erc20Source.ParseCollateralAdded)
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(collateralEvent.Amount, collateralNeeded)
+		teleporterUtils.ExpectBigEqual(collateralEvent.Remaining, big.NewInt(0))
+
+		// The amount is scaled up to the destination's denomination in the message. Its delivery mints native
+		// tokens through the native minter precompile, which the simulated chains don't have, so isn't relayed.
+		recipientAddress := newAddress()
+		amount := new(big.Int).Mul(big.NewInt(1e18), big.NewInt(7))
+		receipt = b.send(ctx, destinationAddress, recipientAddress, amount)
+		sent := b.source.SentMessages(receipt)
+		Expect(sent).Should(HaveLen(1))
+		message, err := messages.Decode(sent[0].Message)
+		Expect(err).Should(BeNil())
+		Expect(message).Should(Equal(messages.SingleHopSendMessage{
+			Recipient: recipientAddress,
+			Amount:    tokenscaling.ApplyTokenScale(multiplier, true, amount),
+		}))
+	})
+
+	ginkgo.It("Rejects messages not sent by the token source, or of an unknown type", func() {
+		destinationAddress, erc20Destination := b.deployERC20Destination(ctx)
+		payload, err := messages.EncodePayload(messages.SingleHopSendMessage{
+			Recipient: newAddress(),
+			Amount:    big.NewInt(1e18),
+		})
+		Expect(err).Should(BeNil())
+		send, err := messages.EncodeBridgeMessage(messages.SingleHopSend, payload)
+		Expect(err).Should(BeNil())
+		unknown, err := messages.EncodeBridgeMessage(messages.MultiHopSend, payload)
+		Expect(err).Should(BeNil())
+
+		deliver := func(originSenderAddress common.Address, message []byte) *types.Receipt {
+			return b.destination.Deliver(ctx, &mockteleporter.Message{
+				TeleporterMessageInput: teleportermessenger.TeleporterMessageInput{
+					DestinationBlockchainID: destinationBlockchainID,
+					DestinationAddress:      destinationAddress,
+					Message:                 message,
+				},
+				SourceBlockchainID:  sourceBlockchainID,
+				OriginSenderAddress: originSenderAddress,
+			})
+		}
+		Expect(deliver(newAddress(), send).Status).Should(Equal(types.ReceiptStatusFailed))
+		// Destinations only receive single-hop messages, since multi-hop messages are routed through the source
+		Expect(deliver(b.sourceAddress, unknown).Status).Should(Equal(types.ReceiptStatusFailed))
+		totalSupply, err := erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(totalSupply, big.NewInt(0))
+
+		// The same message from the token source is delivered
+		Expect(deliver(b.sourceAddress, send).Status).Should(Equal(types.ReceiptStatusSuccessful))
+		totalSupply, err = erc20Destination.TotalSupply(&bind.CallOpts{Context: ctx})
+		Expect(err).Should(BeNil())
+		teleporterUtils.ExpectBigEqual(totalSupply, big.NewInt(1e18))
+	})
+})
+
+// Starts the source and destination chains, and deploys an example token and its ERC20Source to the source chain
+func newERC20Bridge(ctx context.Context) *erc20Bridge {
+	b := &erc20Bridge{
+		source:      mockteleporter.NewChain(ctx, sourceBlockchainID),
+		destination: mockteleporter.NewChain(ctx, destinationBlockchainID),
+	}
+	ginkgo.DeferCleanup(b.source.Close)
+	ginkgo.DeferCleanup(b.destination.Close)
+
+	b.source.Transact(ctx, b.source.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		var (
+			tx  *types.Transaction
+			err error
+		)
+		b.tokenAddress, tx, b.token, err = exampleerc20.DeployExampleERC20(opts, b.source.Backend)
+		return tx, err
+	})
+	b.source.Transact(ctx, b.source.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		var (
+			tx  *types.Transaction
+			err error
+		)
+		b.sourceAddress, tx, b.erc20Source, err = erc20source.DeployERC20Source(
+			opts,
+			b.source.Backend,
+			b.source.TeleporterRegistryAddress,
+			b.source.FundedAddress,
+			b.tokenAddress,
+		)
+		return tx, err
+	})
+	return b
+}
+
+// Deploys an ERC20Destination of the source to the destination chain, with the example token's decimals
+func (b *erc20Bridge) deployERC20Destination(
+	ctx context.Context,
+) (common.Address, *erc20destination.ERC20Destination) {
+	var (
+		address     common.Address
+		destination *erc20destination.ERC20Destination
+	)
+	b.destination.Transact(ctx, b.destination.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		var (
+			tx  *types.Transaction
+			err error
+		)
+		address, tx, destination, err = erc20destination.DeployERC20Destination(
+			opts,
+			b.destination.Backend,
+			b.destination.TeleporterRegistryAddress,
+			b.destination.FundedAddress,
+			sourceBlockchainID,
+			b.sourceAddress,
+			"Wrapped Token",
+			"WTOK",
+			18,
+		)
+		return tx, err
+	})
+	return address, destination
+}
+
+// Registers the destination with the source, and returns the registration emitted by the source
+func (b *erc20Bridge) register(
+	ctx context.Context,
+	destinationAddress common.Address,
+	registerWithSource func(opts *bind.TransactOpts) (*types.Transaction, error),
+) *erc20source.ERC20SourceDestinationRegistered {
+	receipt := b.destination.Transact(ctx, b.destination.FundedKey, registerWithSource)
+	deliveries := mockteleporter.Relay(ctx, b.destination, receipt, b.source)
+	Expect(deliveries).Should(HaveLen(1))
+	registration, err := teleporterUtils.GetEventFromLogs(
+		deliveries[0].Logs,
+		b.erc20Source.ParseDestinationRegistered,
+	)
+	Expect(err).Should(BeNil())
+	Expect(ids.ID(registration.DestinationBlockchainID)).Should(Equal(destinationBlockchainID))
+	Expect(registration.DestinationBridgeAddress).Should(Equal(destinationAddress))
+	return registration
+}
+
+// Sends amount of the example token from the source to the recipient on the destination, without fees
+func (b *erc20Bridge) send(
+	ctx context.Context,
+	destinationAddress common.Address,
+	recipientAddress common.Address,
+	amount *big.Int,
+) *types.Receipt {
+	b.source.Transact(ctx, b.source.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return b.token.Approve(opts, b.sourceAddress, amount)
+	})
+	return b.source.Transact(ctx, b.source.FundedKey, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return b.erc20Source.Send(opts, erc20source.SendTokensInput{
+			DestinationBlockchainID:  destinationBlockchainID,
+			DestinationBridgeAddress: destinationAddress,
+			Recipient:                recipientAddress,
+			PrimaryFeeTokenAddress:   b.tokenAddress,
+			PrimaryFee:               big.NewInt(0),
+			SecondaryFee:             big.NewInt(0),
+			RequiredGasLimit:         big.NewInt(100_000),
+		}, amount)
+	})
+}
+
+func newAddress() common.Address {
+	key, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	return crypto.PubkeyToAddress(key.PublicKey)
+}
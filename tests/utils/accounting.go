@@ -0,0 +1,421 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	subnetevminterfaces "github.com/ava-labs/subnet-evm/interfaces"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	"github.com/ava-labs/teleporter-token-bridge/proofs"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// The Teleporter messenger events that the accounting model is replayed from
+var (
+	sendCrossChainMessageTopic    common.Hash
+	receiveCrossChainMessageTopic common.Hash
+	messageExecutedTopic          common.Hash
+)
+
+func init() {
+	teleporterABI, err := teleportermessenger.TeleporterMessengerMetaData.GetAbi()
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse TeleporterMessenger ABI: %v", err))
+	}
+	sendCrossChainMessageTopic = teleporterABI.Events["SendCrossChainMessage"].ID
+	receiveCrossChainMessageTopic = teleporterABI.Events["ReceiveCrossChainMessage"].ID
+	messageExecutedTopic = teleporterABI.Events["MessageExecuted"].ID
+}
+
+// Identifies a Teleporter message by the chain that sent or received it
+type messageKey struct {
+	blockchainID ids.ID
+	messageID    ids.ID
+}
+
+// A bridge contract deployed by the spec, along with its modelled accounting
+type modelBridge struct {
+	subnet       interfaces.SubnetTestInfo
+	address      common.Address
+	contractType events.ContractType
+	// The token source of a destination
+	tokenSource contractKey
+
+	// The balance of a source bridged to each destination, in the destination's denomination
+	bridged map[contractKey]*big.Int
+	// The tokens minted by a destination, and the tokens it burned to send them back to the source
+	minted *big.Int
+	burned *big.Int
+}
+
+func (b *modelBridge) key() contractKey {
+	return contractKey{blockchainID: b.subnet.BlockchainID, address: b.address}
+}
+
+// accountingModel models the accounting of the bridge contracts deployed by a spec, independently of the
+// contracts' own state. It is replayed from the Teleporter messages that the bridges sent and executed, decoded
+// with the messages package, so that the model only depends on the messages exchanged by the contracts.
+type accountingModel struct {
+	bridges map[contractKey]*modelBridge
+	// The fee paid with each message sent by the bridges
+	fees map[messageKey]teleportermessenger.TeleporterFeeInfo
+	// The messages received by the bridges, applied to the model once they are executed successfully
+	received map[messageKey]*teleportermessenger.TeleporterMessengerReceiveCrossChainMessage
+	executed []messageKey
+	// The events emitted by the bridges, in the order of each chain's logs
+	events []bridgeEvent
+}
+
+type bridgeEvent struct {
+	bridge *modelBridge
+	event  *events.Event
+}
+
+// CheckSpecAccounting replays the Teleporter messages sent and received by the bridge contracts deployed by the
+// current spec through an independent model of their accounting, and fails if the model diverges from the state of
+// any of the contracts. The model tracks the balance each source has bridged to each destination, the tokens minted
+// and burned by each destination, and the fee paid with each message. It should be called once the spec's
+// messages have all been delivered.
+func CheckSpecAccounting(ctx context.Context) {
+	model := newAccountingModel(ctx, getSpecDeployments())
+	if len(model.bridges) == 0 {
+		return
+	}
+	model.replay(ctx)
+	model.check(ctx)
+}
+
+func newAccountingModel(ctx context.Context, deployments []specDeployment) *accountingModel {
+	model := &accountingModel{
+		bridges:  make(map[contractKey]*modelBridge),
+		fees:     make(map[messageKey]teleportermessenger.TeleporterFeeInfo),
+		received: make(map[messageKey]*teleportermessenger.TeleporterMessengerReceiveCrossChainMessage),
+	}
+	for _, deployment := range deployments {
+		bridge, ok := identifyBridge(ctx, deployment)
+		if !ok {
+			continue
+		}
+		model.bridges[bridge.key()] = bridge
+	}
+	return model
+}
+
+// Returns the bridge contract deployed, or false if the contract is not a bridge contract. Contracts are identified
+// by calling the views of each bridge contract type, since they may be deployed from bindings or artifacts.
+func identifyBridge(ctx context.Context, deployment specDeployment) (*modelBridge, bool) {
+	opts := &bind.CallOpts{Context: ctx}
+	client := deployment.subnet.RPCClient
+	bridge := &modelBridge{
+		subnet:  deployment.subnet,
+		address: deployment.address,
+		bridged: make(map[contractKey]*big.Int),
+		minted:  big.NewInt(0),
+		burned:  big.NewInt(0),
+	}
+
+	destination, err := teleportertokendestination.NewTeleporterTokenDestination(deployment.address, client)
+	Expect(err).Should(BeNil())
+	if tokenSourceAddress, err := destination.TokenSourceAddress(opts); err == nil {
+		sourceBlockchainID, err := destination.SourceBlockchainID(opts)
+		Expect(err).Should(BeNil())
+		bridge.tokenSource = contractKey{blockchainID: ids.ID(sourceBlockchainID), address: tokenSourceAddress}
+		bridge.contractType = events.ERC20Destination
+		native, err := nativetokendestination.NewNativeTokenDestination(deployment.address, client)
+		Expect(err).Should(BeNil())
+		if _, err := native.TotalMinted(opts); err == nil {
+			bridge.contractType = events.NativeTokenDestination
+		}
+		return bridge, true
+	}
+
+	source, err := teleportertokensource.NewTeleporterTokenSource(deployment.address, client)
+	Expect(err).Should(BeNil())
+	if _, err := source.TokenAddress(opts); err != nil {
+		return nil, false
+	}
+	bridge.contractType = events.ERC20Source
+	native, err := nativetokensource.NewNativeTokenSource(deployment.address, client)
+	Expect(err).Should(BeNil())
+	if _, err := native.WrappedToken(opts); err == nil {
+		bridge.contractType = events.NativeTokenSource
+	}
+	return bridge, true
+}
+
+// Replays the messages sent and received by the bridges on each chain, from the block of the chain's first
+// deployment. Received messages are applied once every chain's logs are read, since they may be executed in a
+// later transaction than the one that received them.
+func (m *accountingModel) replay(ctx context.Context) {
+	decoder, err := events.NewDecoder()
+	Expect(err).Should(BeNil())
+	messenger, err := teleportermessenger.NewTeleporterMessengerFilterer(common.Address{}, nil)
+	Expect(err).Should(BeNil())
+
+	chains := make(map[ids.ID][]*modelBridge)
+	for _, bridge := range m.bridges {
+		chains[bridge.subnet.BlockchainID] = append(chains[bridge.subnet.BlockchainID], bridge)
+	}
+	for blockchainID, bridges := range chains {
+		subnet := bridges[0].subnet
+		fromBlock := new(big.Int).SetUint64(m.firstDeploymentBlock(bridges))
+		addresses := make([]common.Address, len(bridges))
+		for i, bridge := range bridges {
+			addresses[i] = bridge.address
+		}
+
+		// Every version of the Teleporter messenger on the chain emits the same events
+		teleporterLogs, err := subnet.RPCClient.FilterLogs(ctx, subnetevminterfaces.FilterQuery{
+			FromBlock: fromBlock,
+			Topics:    [][]common.Hash{{sendCrossChainMessageTopic, receiveCrossChainMessageTopic, messageExecutedTopic}},
+		})
+		Expect(err).Should(BeNil())
+		for _, teleporterLog := range teleporterLogs {
+			m.replayTeleporterLog(blockchainID, messenger, teleporterLog)
+		}
+
+		bridgeLogs, err := subnet.RPCClient.FilterLogs(ctx, subnetevminterfaces.FilterQuery{
+			FromBlock: fromBlock,
+			Addresses: addresses,
+		})
+		Expect(err).Should(BeNil())
+		for _, bridgeLog := range bridgeLogs {
+			bridge := m.bridges[contractKey{blockchainID: blockchainID, address: bridgeLog.Address}]
+			event, ok, err := decoder.Decode(bridgeLog, events.ContractInfo{Type: bridge.contractType})
+			Expect(err).Should(BeNil())
+			if ok {
+				m.events = append(m.events, bridgeEvent{bridge: bridge, event: event})
+			}
+		}
+	}
+
+	for _, key := range m.executed {
+		if received, ok := m.received[key]; ok {
+			m.applyReceived(key.blockchainID, received)
+		}
+	}
+	for _, e := range m.events {
+		// Reporting burned fees re-mints the reward paid with the report's message
+		if e.event.Type == events.ReportBurnedTxFees {
+			reward := m.fees[messageKey{blockchainID: e.bridge.subnet.BlockchainID, messageID: e.event.TeleporterMessageID}]
+			if reward.Amount != nil {
+				e.bridge.minted.Add(e.bridge.minted, reward.Amount)
+			}
+		}
+	}
+}
+
+func (m *accountingModel) firstDeploymentBlock(bridges []*modelBridge) uint64 {
+	var first uint64
+	for _, deployment := range getSpecDeployments() {
+		for _, bridge := range bridges {
+			if deployment.subnet.BlockchainID == bridge.subnet.BlockchainID && deployment.address == bridge.address &&
+				(first == 0 || deployment.blockNumber < first) {
+				first = deployment.blockNumber
+			}
+		}
+	}
+	return first
+}
+
+func (m *accountingModel) replayTeleporterLog(
+	blockchainID ids.ID,
+	messenger *teleportermessenger.TeleporterMessengerFilterer,
+	teleporterLog types.Log,
+) {
+	switch teleporterLog.Topics[0] {
+	case sendCrossChainMessageTopic:
+		sent, err := messenger.ParseSendCrossChainMessage(teleporterLog)
+		Expect(err).Should(BeNil())
+		bridge, ok := m.bridges[contractKey{blockchainID: blockchainID, address: sent.Message.OriginSenderAddress}]
+		if !ok {
+			return
+		}
+		m.applySent(bridge, sent)
+	case receiveCrossChainMessageTopic:
+		received, err := messenger.ParseReceiveCrossChainMessage(teleporterLog)
+		Expect(err).Should(BeNil())
+		if _, ok := m.bridges[contractKey{blockchainID: blockchainID, address: received.Message.DestinationAddress}]; ok {
+			m.received[messageKey{blockchainID: blockchainID, messageID: ids.ID(received.MessageID)}] = received
+		}
+	case messageExecutedTopic:
+		executed, err := messenger.ParseMessageExecuted(teleporterLog)
+		Expect(err).Should(BeNil())
+		m.executed = append(m.executed, messageKey{blockchainID: blockchainID, messageID: ids.ID(executed.MessageID)})
+	}
+}
+
+// Sources lock the tokens they send to each destination, and destinations burn the tokens they send back
+func (m *accountingModel) applySent(
+	bridge *modelBridge,
+	sent *teleportermessenger.TeleporterMessengerSendCrossChainMessage,
+) {
+	m.fees[messageKey{blockchainID: bridge.subnet.BlockchainID, messageID: ids.ID(sent.MessageID)}] = sent.FeeInfo
+
+	message, err := messages.Decode(sent.Message.Message)
+	Expect(err).Should(BeNil(), "bridge %s sent a message that does not decode", bridge.address)
+	amount := messageAmount(message)
+	if amount == nil {
+		return
+	}
+	if bridge.contractType.IsSource() {
+		destination := contractKey{
+			blockchainID: ids.ID(sent.Message.DestinationBlockchainID),
+			address:      sent.Message.DestinationAddress,
+		}
+		addBridgedBalance(bridge, destination, amount)
+	} else {
+		bridge.burned.Add(bridge.burned, amount)
+	}
+}
+
+// Sources release the tokens sent back by each destination, and destinations mint the tokens sent by the source
+func (m *accountingModel) applyReceived(
+	blockchainID ids.ID,
+	received *teleportermessenger.TeleporterMessengerReceiveCrossChainMessage,
+) {
+	bridge := m.bridges[contractKey{blockchainID: blockchainID, address: received.Message.DestinationAddress}]
+	message, err := messages.Decode(received.Message.Message)
+	Expect(err).Should(BeNil(), "bridge %s executed a message that does not decode", bridge.address)
+	amount := messageAmount(message)
+	if amount == nil {
+		return
+	}
+	if bridge.contractType.IsSource() {
+		origin := contractKey{
+			blockchainID: ids.ID(received.SourceBlockchainID),
+			address:      received.Message.OriginSenderAddress,
+		}
+		addBridgedBalance(bridge, origin, new(big.Int).Neg(amount))
+	} else {
+		bridge.minted.Add(bridge.minted, amount)
+	}
+}
+
+func addBridgedBalance(source *modelBridge, destination contractKey, amount *big.Int) {
+	balance, ok := source.bridged[destination]
+	if !ok {
+		balance = big.NewInt(0)
+		source.bridged[destination] = balance
+	}
+	balance.Add(balance, amount)
+}
+
+// Returns the amount of tokens carried by the message, or nil if it carries none
+func messageAmount(message messages.Message) *big.Int {
+	switch m := message.(type) {
+	case messages.SingleHopSendMessage:
+		return m.Amount
+	case messages.SingleHopCallMessage:
+		return m.Amount
+	case messages.MultiHopSendMessage:
+		return m.Amount
+	case messages.MultiHopCallMessage:
+		return m.Amount
+	default:
+		return nil
+	}
+}
+
+// Checks the model against the state of each bridge, and the fee of each message sent by a bridge against the
+// primary fee of the send that emitted it. Each balance is read from the bridge's storage, verified with a storage
+// proof against the state root of the latest block of its chain, and also checked against the bridge's view.
+func (m *accountingModel) check(ctx context.Context) {
+	headers := make(map[ids.ID]*types.Header)
+	state := func(subnet interfaces.SubnetTestInfo) (*proofs.Prover, *types.Header, *bind.CallOpts) {
+		header, ok := headers[subnet.BlockchainID]
+		if !ok {
+			var err error
+			header, err = subnet.RPCClient.HeaderByNumber(ctx, nil)
+			Expect(err).Should(BeNil())
+			headers[subnet.BlockchainID] = header
+		}
+		return proofs.NewProver(subnet.RPCClient), header, &bind.CallOpts{Context: ctx, BlockNumber: header.Number}
+	}
+
+	for _, bridge := range m.bridges {
+		prover, header, opts := state(bridge.subnet)
+		switch bridge.contractType {
+		case events.ERC20Source, events.NativeTokenSource:
+			source, err := teleportertokensource.NewTeleporterTokenSource(bridge.address, bridge.subnet.RPCClient)
+			Expect(err).Should(BeNil())
+			// Destinations deployed for the source are checked even if the model has no balance bridged to them
+			for _, destination := range m.bridges {
+				if destination.contractType.IsDestination() && destination.tokenSource == bridge.key() {
+					addBridgedBalance(bridge, destination.key(), big.NewInt(0))
+				}
+			}
+			for destination, expected := range bridge.bridged {
+				balance, err := prover.BridgedBalance(ctx, header, bridge.address, destination.blockchainID,
+					destination.address)
+				Expect(err).Should(BeNil())
+				expectModelled(balance, expected, "proven balance of source %s bridged to %s on %s",
+					bridge.address, destination.address, destination.blockchainID)
+				balance, err = source.BridgedBalances(opts, destination.blockchainID, destination.address)
+				Expect(err).Should(BeNil())
+				expectModelled(balance, expected, "balance of source %s bridged to %s on %s",
+					bridge.address, destination.address, destination.blockchainID)
+			}
+		case events.ERC20Destination:
+			destination, err := erc20destination.NewERC20Destination(bridge.address, bridge.subnet.RPCClient)
+			Expect(err).Should(BeNil())
+			expected := new(big.Int).Sub(bridge.minted, bridge.burned)
+			totalSupply, err := prover.TotalSupply(ctx, header, bridge.contractType, bridge.address)
+			Expect(err).Should(BeNil())
+			expectModelled(totalSupply, expected, "proven total supply of ERC20Destination %s", bridge.address)
+			totalSupply, err = destination.TotalSupply(opts)
+			Expect(err).Should(BeNil())
+			expectModelled(totalSupply, expected, "total supply of ERC20Destination %s", bridge.address)
+		case events.NativeTokenDestination:
+			destination, err := nativetokendestination.NewNativeTokenDestination(bridge.address, bridge.subnet.RPCClient)
+			Expect(err).Should(BeNil())
+			totalMinted, err := prover.TotalMinted(ctx, header, bridge.address)
+			Expect(err).Should(BeNil())
+			expectModelled(totalMinted, bridge.minted, "proven total minted by NativeTokenDestination %s",
+				bridge.address)
+			totalMinted, err = destination.TotalMinted(opts)
+			Expect(err).Should(BeNil())
+			expectModelled(totalMinted, bridge.minted, "total minted by NativeTokenDestination %s", bridge.address)
+		}
+	}
+
+	for _, e := range m.events {
+		if !e.event.Type.IsSend() {
+			continue
+		}
+		key := messageKey{blockchainID: e.bridge.subnet.BlockchainID, messageID: e.event.TeleporterMessageID}
+		fee, ok := m.fees[key]
+		Expect(ok).Should(BeTrue(), "%s event of %s has no Teleporter message", e.event.Type, e.bridge.address)
+		expectModelled(fee.Amount, e.event.PrimaryFee, "fee of message %s sent by %s",
+			e.event.TeleporterMessageID, e.bridge.address)
+		if fee.Amount.Sign() > 0 {
+			Expect(fee.FeeTokenAddress).Should(Equal(e.event.PrimaryFeeTokenAddress))
+		}
+	}
+	log.Info("Checked accounting model", "bridges", len(m.bridges), "events", len(m.events))
+}
+
+func expectModelled(actual *big.Int, modelled *big.Int, description string, args ...interface{}) {
+	Expect(actual.Cmp(modelled)).Should(
+		Equal(0),
+		fmt.Sprintf("%s is %s, but the model expects %s", fmt.Sprintf(description, args...), actual, modelled),
+	)
+}
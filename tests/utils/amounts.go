@@ -0,0 +1,45 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"math/big"
+	"math/rand"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// RandomSeedEnvVar optionally sets the seed of the amounts generated by NewAmountGenerator, to reproduce a run
+const RandomSeedEnvVar = "E2E_RANDOM_SEED"
+
+// NewAmountGenerator returns a generator of amounts biased towards the scaling boundaries of the token scale, for
+// flows bridging random amounts. The generator is seeded from E2E_RANDOM_SEED if set, and otherwise randomly, and
+// the seed is logged so that the amounts of a failed run can be generated again.
+func NewAmountGenerator(
+	tokenMultiplier *big.Int,
+	multiplyOnDestination bool,
+	isSendToDestination bool,
+	maxAmount *big.Int,
+) *tokenscaling.AmountGenerator {
+	seed := time.Now().UnixNano()
+	if seedString := os.Getenv(RandomSeedEnvVar); seedString != "" {
+		var err error
+		seed, err = strconv.ParseInt(seedString, 10, 64)
+		Expect(err).Should(BeNil(), "invalid %s", RandomSeedEnvVar)
+	}
+	log.Info("Generating amounts", "seed", seed, "tokenMultiplier", tokenMultiplier)
+	return tokenscaling.NewAmountGenerator(
+		rand.New(rand.NewSource(seed)),
+		tokenMultiplier,
+		multiplyOnDestination,
+		isSendToDestination,
+		maxAmount,
+	)
+}
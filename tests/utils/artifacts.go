@@ -0,0 +1,113 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// ArtifactCacheDirEnvVar optionally sets the directory in which artifacts are cached across e2e runs
+	ArtifactCacheDirEnvVar = "E2E_ARTIFACT_CACHE_DIR"
+
+	// Written by e2e_test.sh alongside the artifacts in a Foundry out directory,
+	// identifying the contract sources they were built from
+	sourceHashFile = ".source-hash"
+)
+
+// The subset of a Foundry artifact needed to deploy a contract
+type contractArtifact struct {
+	ABI      abi.ABI
+	Bytecode []byte
+}
+
+// The JSON layout of a Foundry artifact, keeping only the fields needed to deploy a contract.
+// Cached artifacts are written in the same layout, so they can be read in place of the Foundry artifacts.
+type rawContractArtifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode struct {
+		Object string `json:"object"`
+	} `json:"bytecode"`
+}
+
+// Artifacts are loaded the first time a flow deploys them, rather than when the suite starts
+var loadedArtifacts = struct {
+	sync.Mutex
+	artifacts map[string]*contractArtifact
+}{artifacts: make(map[string]*contractArtifact)}
+
+// Returns the contract artifact in the Foundry artifact file, reading it on first use
+func loadContractArtifact(artifactFile string) *contractArtifact {
+	artifactFile = CachedArtifactFile(artifactFile)
+
+	loadedArtifacts.Lock()
+	defer loadedArtifacts.Unlock()
+	if artifact, ok := loadedArtifacts.artifacts[artifactFile]; ok {
+		return artifact
+	}
+
+	raw := readRawContractArtifact(artifactFile)
+	contractABI, err := abi.JSON(bytes.NewReader(raw.ABI))
+	Expect(err).Should(BeNil())
+	artifact := &contractArtifact{
+		ABI:      contractABI,
+		Bytecode: common.FromHex(raw.Bytecode.Object),
+	}
+	loadedArtifacts.artifacts[artifactFile] = artifact
+	return artifact
+}
+
+// CachedArtifactFile returns the cached copy of the Foundry artifact file, writing it on a cache miss.
+// Cached copies omit everything but the ABI and bytecode, so are much smaller than the artifacts built by forge,
+// and are keyed by the hash of the contract sources written to the Foundry out directory by e2e_test.sh.
+// Returns the artifact file itself if no cache directory is set, or the artifacts have no source hash.
+func CachedArtifactFile(artifactFile string) string {
+	cacheDir := os.Getenv(ArtifactCacheDirEnvVar)
+	if cacheDir == "" {
+		return artifactFile
+	}
+	// Foundry artifacts are written to <out>/<contract>.sol/<contract>.json
+	outDir := filepath.Dir(filepath.Dir(artifactFile))
+	sourceHash, err := os.ReadFile(filepath.Join(outDir, sourceHashFile))
+	if err != nil {
+		return artifactFile
+	}
+
+	cachedFile := filepath.Join(cacheDir, strings.TrimSpace(string(sourceHash)), filepath.Base(artifactFile))
+	if _, err := os.Stat(cachedFile); err == nil {
+		return cachedFile
+	}
+
+	rawBytes, err := json.Marshal(readRawContractArtifact(artifactFile))
+	Expect(err).Should(BeNil())
+	Expect(os.MkdirAll(filepath.Dir(cachedFile), 0o755)).Should(Succeed())
+	// Write to a temporary file first, so that a concurrent run never reads a partially written artifact
+	tmpFile, err := os.CreateTemp(filepath.Dir(cachedFile), filepath.Base(cachedFile))
+	Expect(err).Should(BeNil())
+	_, err = tmpFile.Write(rawBytes)
+	Expect(err).Should(BeNil())
+	Expect(tmpFile.Close()).Should(Succeed())
+	Expect(os.Rename(tmpFile.Name(), cachedFile)).Should(Succeed())
+	log.Info("Cached contract artifact", "artifact", artifactFile, "cachedArtifact", cachedFile)
+	return cachedFile
+}
+
+func readRawContractArtifact(artifactFile string) rawContractArtifact {
+	artifactBytes, err := os.ReadFile(artifactFile)
+	Expect(err).Should(BeNil())
+	var raw rawContractArtifact
+	Expect(json.Unmarshal(artifactBytes, &raw)).Should(Succeed())
+	return raw
+}
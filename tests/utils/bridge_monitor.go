@@ -0,0 +1,149 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/monitor"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/prometheus/client_golang/prometheus"
+
+	. "github.com/onsi/gomega"
+)
+
+// How often the bridge monitor polls each chain and samples bridge state, short enough for specs to await
+// changes in its metrics and alerts
+const (
+	bridgeMonitorPollIntervalSeconds  = 1
+	bridgeMonitorStateIntervalSeconds = 1
+)
+
+// BridgeMonitor is the monitoring service of cmd/bridge-metrics, running in the test process with its metrics
+// registered with a registry of its own, and its alerts sent to a webhook served by the test process
+type BridgeMonitor struct {
+	cancel   context.CancelFunc
+	done     chan error
+	webhook  *httptest.Server
+	registry *prometheus.Registry
+
+	lock   sync.Mutex
+	alerts []monitor.Alert
+}
+
+// StartBridgeMonitor starts the monitor with the chains and bridges of the config, polling and sampling them every
+// second unless the config sets its own intervals. Its alerts are recorded in the order they are received.
+func StartBridgeMonitor(ctx context.Context, config *monitor.Config) *BridgeMonitor {
+	m := &BridgeMonitor{
+		done:     make(chan error, 1),
+		registry: prometheus.NewRegistry(),
+	}
+	m.webhook = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var alert monitor.Alert
+		if err := json.NewDecoder(r.Body).Decode(&alert); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		m.lock.Lock()
+		m.alerts = append(m.alerts, alert)
+		m.lock.Unlock()
+	}))
+
+	if config.LogLevel == "" {
+		config.LogLevel = logging.Info.LowerString()
+	}
+	if config.PollIntervalSeconds == 0 {
+		config.PollIntervalSeconds = bridgeMonitorPollIntervalSeconds
+	}
+	if config.StateIntervalSeconds == 0 {
+		config.StateIntervalSeconds = bridgeMonitorStateIntervalSeconds
+	}
+	config.Alerts.Webhooks = append(config.Alerts.Webhooks, monitor.WebhookConfig{
+		URL:    m.webhook.URL,
+		Format: monitor.GenericWebhook,
+	})
+	Expect(config.Validate()).Should(Succeed())
+
+	logger := logging.NewLogger(
+		"bridge-metrics",
+		logging.NewWrappedCore(logging.Info, os.Stdout, logging.JSON.ConsoleEncoder()),
+	)
+	runCtx, cancel := context.WithCancel(context.Background())
+	bridgeMonitor, err := monitor.NewMonitor(ctx, logger, config, m.registry)
+	if err != nil {
+		cancel()
+		m.webhook.Close()
+	}
+	Expect(err).Should(BeNil())
+	m.cancel = cancel
+	go func() {
+		m.done <- bridgeMonitor.Run(runCtx)
+	}()
+	log.Info("Started bridge monitor")
+	return m
+}
+
+// Alerts returns the alerts received so far, in the order they were received
+func (m *BridgeMonitor) Alerts() []monitor.Alert {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return append([]monitor.Alert(nil), m.alerts...)
+}
+
+// Value returns the value of the gauge or counter with the given name and labels, and false if it has not been set
+func (m *BridgeMonitor) Value(name string, labels map[string]string) (float64, bool) {
+	families, err := m.registry.Gather()
+	Expect(err).Should(BeNil())
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			matched := 0
+			for _, label := range metric.GetLabel() {
+				if value, ok := labels[label.GetName()]; ok && value == label.GetValue() {
+					matched++
+				}
+			}
+			if matched != len(labels) {
+				continue
+			}
+			if metric.GetCounter() != nil {
+				return metric.GetCounter().GetValue(), true
+			}
+			return metric.GetGauge().GetValue(), true
+		}
+	}
+	return 0, false
+}
+
+// Running returns whether the monitor is still running, rather than having exited with an error
+func (m *BridgeMonitor) Running() bool {
+	select {
+	case err := <-m.done:
+		m.done <- err
+		return false
+	default:
+		return true
+	}
+}
+
+// Stop cancels the monitor, and waits for it to exit, failing if it exited with an error
+func (m *BridgeMonitor) Stop(ctx context.Context) {
+	defer m.webhook.Close()
+	m.cancel()
+	select {
+	case err := <-m.done:
+		Expect(err).Should(BeNil())
+	case <-ctx.Done():
+		Expect(ctx.Err()).Should(BeNil(), "waiting for the bridge monitor to stop")
+	}
+	log.Info("Stopped bridge monitor")
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter-token-bridge/relayer"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// How often the bridge relayer checks each chain for new blocks, short enough for deliveries to be awaited
+// as quickly as the network relays them itself
+const bridgeRelayerPollIntervalSeconds = 1
+
+// BridgeRelayer is the in-repo relayer, running in the test process and delivering the Teleporter messages sent
+// between all of the chains of a local network
+type BridgeRelayer struct {
+	cancel context.CancelFunc
+	done   chan error
+	dir    string
+
+	// Address delivering messages on each chain, which is also the reward address of each delivery
+	Address common.Address
+}
+
+// StartBridgeRelayer funds a new relayer account on each chain of the network, and starts the in-repo relayer
+// delivering the Teleporter messages sent between them from that account. Unlike StartRelayer, no relayer binary
+// is needed. Only messages sent after the relayer has started are delivered. The relayer should be stopped once it
+// is no longer needed, since it would otherwise deliver messages expected to be relayed by other flows.
+func StartBridgeRelayer(ctx context.Context, network interfaces.LocalNetwork) *BridgeRelayer {
+	relayerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	relayerAddress := crypto.PubkeyToAddress(relayerKey.PublicKey)
+	_, fundedKey := network.GetFundedAccountInfo()
+
+	dir, err := os.MkdirTemp("", "bridge-relayer")
+	Expect(err).Should(BeNil())
+	keyFile := filepath.Join(dir, "relayer.key")
+	Expect(os.WriteFile(keyFile, []byte(hex.EncodeToString(crypto.FromECDSA(relayerKey))), 0o600)).Should(Succeed())
+
+	config := &relayer.Config{
+		LogLevel:            logging.Info.LowerString(),
+		PrivateKeyFile:      keyFile,
+		PollIntervalSeconds: bridgeRelayerPollIntervalSeconds,
+		MaxBlockRange:       2048,
+	}
+	for _, subnet := range network.GetAllSubnetsInfo() {
+		teleporterUtils.SendNativeTransfer(ctx, subnet, fundedKey, relayerAddress, relayerFunding)
+		config.Chains = append(config.Chains, events.ChainConfig{
+			Name:         subnet.BlockchainID.String(),
+			BlockchainID: subnet.BlockchainID.String(),
+			RPCEndpoint:  teleporterUtils.HttpToRPCURI(subnet.NodeURIs[0], subnet.BlockchainID.String()),
+		})
+	}
+	Expect(config.Validate()).Should(Succeed())
+
+	logger := logging.NewLogger(
+		"bridge-relayer",
+		logging.NewWrappedCore(logging.Info, os.Stdout, logging.JSON.ConsoleEncoder()),
+	)
+	runCtx, cancel := context.WithCancel(context.Background())
+	r, err := relayer.NewRelayer(runCtx, logger, config)
+	Expect(err).Should(BeNil())
+	Expect(r.Account()).Should(Equal(relayerAddress))
+
+	bridgeRelayer := &BridgeRelayer{
+		cancel:  cancel,
+		done:    make(chan error, 1),
+		dir:     dir,
+		Address: relayerAddress,
+	}
+	go func() {
+		bridgeRelayer.done <- r.Run(runCtx)
+	}()
+	log.Info("Started bridge relayer", "address", relayerAddress)
+	return bridgeRelayer
+}
+
+// Stop cancels the relayer, and waits for it to exit, failing if it exited with an error
+func (r *BridgeRelayer) Stop(ctx context.Context) {
+	defer os.RemoveAll(r.dir)
+	r.cancel()
+	select {
+	case err := <-r.done:
+		Expect(err).Should(BeNil())
+	case <-ctx.Done():
+		Expect(ctx.Err()).Should(BeNil(), "waiting for the bridge relayer to stop")
+	}
+	log.Info("Stopped bridge relayer", "address", r.Address)
+}
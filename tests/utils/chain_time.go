@@ -0,0 +1,89 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// Chains only build blocks for pending transactions, and calls read the timestamp of the latest block, so the time
+// seen by the bridge contracts only moves forward once a new block is built. Blocks are built with the time of the
+// node's clock, and blocks from the future are rejected, so the chain's time can't be moved ahead of real time. The
+// helpers below instead wait for the least real time needed, and then build a block, so that time-dependent checks
+// are made against a known block timestamp rather than after sleeping an arbitrary margin.
+
+// LatestHeader returns the header of the subnet's latest block
+func LatestHeader(ctx context.Context, subnet interfaces.SubnetTestInfo) *types.Header {
+	header, err := subnet.RPCClient.HeaderByNumber(ctx, nil)
+	Expect(err).Should(BeNil())
+	return header
+}
+
+// AdvanceBlocks builds count blocks on the subnet, each with a transfer of nothing from senderKey to itself.
+// Returns the header of the last block built.
+func AdvanceBlocks(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	count int,
+) *types.Header {
+	senderAddress := crypto.PubkeyToAddress(senderKey.PublicKey)
+	var receipt *types.Receipt
+	for i := 0; i < count; i++ {
+		receipt = teleporterUtils.SendNativeTransfer(ctx, subnet, senderKey, senderAddress, big.NewInt(0))
+	}
+	Expect(receipt).ShouldNot(BeNil(), "no blocks built")
+	header, err := subnet.RPCClient.HeaderByHash(ctx, receipt.BlockHash)
+	Expect(err).Should(BeNil())
+	return header
+}
+
+// AdvanceTime builds a block on the subnet whose timestamp is at least duration after the subnet's latest block,
+// such as to end a rate limit window that started at or before the latest block. Returns the header of the block.
+func AdvanceTime(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	duration time.Duration,
+) *types.Header {
+	// Block timestamps are in whole seconds, so partial seconds are rounded up
+	seconds := uint64((duration + time.Second - 1) / time.Second)
+	return AdvanceTimeTo(ctx, subnet, senderKey, LatestHeader(ctx, subnet).Time+seconds)
+}
+
+// AdvanceTimeTo builds a block on the subnet with a timestamp of at least timestamp, waiting for the node's clock
+// to reach it first. Returns the header of the block.
+func AdvanceTimeTo(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	timestamp uint64,
+) *types.Header {
+	select {
+	case <-time.After(time.Until(time.Unix(int64(timestamp), 0))):
+	case <-ctx.Done():
+		Expect(ctx.Err()).Should(BeNil())
+	}
+
+	header := AdvanceBlocks(ctx, subnet, senderKey, 1)
+	Expect(header.Time).Should(BeNumerically(">=", timestamp))
+	log.Info(
+		"Advanced chain time",
+		"blockchainID", subnet.BlockchainID,
+		"blockNumber", header.Number,
+		"timestamp", header.Time,
+	)
+	return header
+}
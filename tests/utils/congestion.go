@@ -0,0 +1,187 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// The gas limit of each congesting transaction, all of which is burnt. Blocks of the local network have a
+	// 20M gas limit, so each block fits a few of them, and the remaining capacity is competed for.
+	congestionGasLimit = 6_000_000
+	// The number of congesting transactions each account keeps pending at once
+	congestionPendingTransactions = 4
+	// How long an account waits for its pending transactions to be accepted before re-sending them at the current
+	// base fee
+	congestionStallTimeout = 5 * time.Second
+)
+
+// The priority fee of congesting transactions, above the suggested tip that the test helpers pay by default, so
+// that other transactions are outbid for block space
+var congestionGasTipCap = big.NewInt(5e9)
+
+// The creation code of a contract that burns all of the gas it is called with. Its runtime code loops until less
+// than 10,000 gas remains, then stops:
+//
+//	JUMPDEST GAS PUSH2 0x2710 LT PUSH1 0x00 JUMPI STOP
+//
+// The creation code stores the 10 bytes of runtime code in memory, and returns them:
+//
+//	PUSH10 <runtime> PUSH1 0x00 MSTORE PUSH1 0x0a PUSH1 0x16 RETURN
+var gasBurnerBytecode = hexutil.MustDecode("0x695b5a6127101060005700600052600a6016f3")
+
+// CongestChain fills the blocks of the subnet with transactions from each of the keys that burn their gas limit,
+// raising the subnet's base fee while they are sent. The keys must be funded with the native token of the subnet.
+// The gas burning contract is deployed from fundedKey. Returns a function that stops sending the transactions, and
+// waits for the senders to exit.
+func CongestChain(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	fundedKey *ecdsa.PrivateKey,
+	keys []*ecdsa.PrivateKey,
+) func() {
+	var burnerAddress common.Address
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			burnerAddress, tx, _, err = bind.DeployContract(opts, abi.ABI{}, gasBurnerBytecode, subnet.RPCClient)
+			return tx, err
+		},
+	)
+	log.Info("Congesting chain", "blockchainID", subnet.BlockchainID, "senders", len(keys), "burner", burnerAddress)
+
+	congestionCtx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	for _, key := range keys {
+		wg.Add(1)
+		go func(key *ecdsa.PrivateKey) {
+			defer wg.Done()
+			sendCongestion(congestionCtx, subnet, burnerAddress, key)
+		}(key)
+	}
+	return func() {
+		cancel()
+		wg.Wait()
+		log.Info("Stopped congesting chain", "blockchainID", subnet.BlockchainID)
+	}
+}
+
+// Sends gas burning transactions from the key until the context is cancelled, keeping up to
+// congestionPendingTransactions of them pending at a time. Errors are logged rather than failing the spec, since
+// this runs outside of the spec's goroutine, and transactions are re-sent from the accepted nonce of the account
+// once they stall with a higher tip, such as after the base fee rises above their fee cap.
+func sendCongestion(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	burnerAddress common.Address,
+	key *ecdsa.PrivateKey,
+) {
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	signer := types.LatestSignerForChainID(subnet.EVMChainID)
+
+	gasTipCap := congestionGasTipCap
+	var nonce, acceptedNonce uint64
+	lastProgress := time.Now()
+	resync := true
+	for ctx.Err() == nil {
+		latestNonce, err := subnet.RPCClient.NonceAt(ctx, address, nil)
+		if err != nil {
+			sleepCongestion(ctx)
+			continue
+		}
+		if latestNonce > acceptedNonce {
+			acceptedNonce = latestNonce
+			lastProgress = time.Now()
+		}
+		if resync || nonce < acceptedNonce {
+			nonce = acceptedNonce
+			resync = false
+		}
+		if time.Since(lastProgress) > congestionStallTimeout {
+			// Replacements must pay a higher tip than the transactions they replace
+			nonce = acceptedNonce
+			lastProgress = time.Now()
+			gasTipCap = new(big.Int).Div(new(big.Int).Mul(gasTipCap, big.NewInt(125)), big.NewInt(100))
+		}
+		if nonce-acceptedNonce >= congestionPendingTransactions {
+			sleepCongestion(ctx)
+			continue
+		}
+
+		baseFee, err := subnet.RPCClient.EstimateBaseFee(ctx)
+		if err != nil {
+			sleepCongestion(ctx)
+			continue
+		}
+		gasFeeCap := new(big.Int).Mul(baseFee, big.NewInt(4))
+		gasFeeCap.Add(gasFeeCap, gasTipCap)
+		tx, err := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+			ChainID:   subnet.EVMChainID,
+			Nonce:     nonce,
+			GasTipCap: gasTipCap,
+			GasFeeCap: gasFeeCap,
+			Gas:       congestionGasLimit,
+			To:        &burnerAddress,
+		})
+		if err != nil {
+			log.Error("Failed to sign congesting transaction", "err", err)
+			return
+		}
+		if err := subnet.RPCClient.SendTransaction(ctx, tx); err != nil {
+			if ctx.Err() == nil {
+				log.Debug("Failed to send congesting transaction", "sender", address, "nonce", nonce, "err", err)
+			}
+			resync = true
+			sleepCongestion(ctx)
+			continue
+		}
+		nonce++
+	}
+}
+
+func sleepCongestion(ctx context.Context) {
+	select {
+	case <-ctx.Done():
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// WaitForBaseFeeAbove waits until the estimated base fee of the subnet's next block is above the threshold
+func WaitForBaseFeeAbove(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	threshold *big.Int,
+	timeout time.Duration,
+) {
+	var baseFee *big.Int
+	Eventually(func() *big.Int {
+		var err error
+		baseFee, err = subnet.RPCClient.EstimateBaseFee(ctx)
+		Expect(err).Should(BeNil())
+		return baseFee
+	}, timeout, time.Second).Should(BeNumerically(">", threshold))
+	log.Info("Base fee rose", "blockchainID", subnet.BlockchainID, "threshold", threshold, "baseFee", baseFee)
+}
@@ -5,4 +5,6 @@ import "math/big"
 var (
 	DefaultERC20RequiredGas       = big.NewInt(85_000)
 	DefaultNativeTokenRequiredGas = big.NewInt(120_000)
+	// Covers minting the token on an ERC721Destination and storing its token URI
+	DefaultERC721RequiredGas = big.NewInt(250_000)
 )
@@ -0,0 +1,204 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// Create2Deployer has no generated bindings, so it is deployed from the artifact built by forge in e2e_test.sh
+const create2DeployerContractName = "Create2Deployer"
+
+// Native tokens sent to the deployer of Create2Deployer on each chain, to pay for its deployment
+var create2DeployerFunding = big.NewInt(1e18)
+
+// Create2Deployer holds no state, so a single deployment shared by every spec is at the same address on every chain.
+// It is deployed by a key used for nothing else, as the first transaction of the key on each chain, so that its
+// address only depends on the key.
+var create2Deployers = struct {
+	sync.Mutex
+	key      *ecdsa.PrivateKey
+	address  common.Address
+	deployed map[ids.ID]bool
+}{deployed: make(map[ids.ID]bool)}
+
+// Create2Salt derives a CREATE2 salt from the name, so that deployments can be made from human readable salts
+func Create2Salt(name string) [32]byte {
+	return crypto.Keccak256Hash([]byte(name))
+}
+
+// Create2DeployerAddress returns the address of the Create2Deployer, which is the same on every chain
+func Create2DeployerAddress() common.Address {
+	create2Deployers.Lock()
+	defer create2Deployers.Unlock()
+	return create2DeployerAddress()
+}
+
+// Create2Address returns the address that DeployCreate2 deploys the contract with the constructor arguments to from
+// the salt, which is the same on every chain
+func Create2Address(metaData *bind.MetaData, salt [32]byte, constructorArgs ...interface{}) common.Address {
+	return crypto.CreateAddress2(
+		Create2DeployerAddress(),
+		salt,
+		crypto.Keccak256(create2InitCode(metaData, constructorArgs...)),
+	)
+}
+
+// DeployCreate2 deploys the contract with the constructor arguments through the Create2Deployer, deploying the
+// Create2Deployer from senderKey on first use on the chain. A contract deployed with the same salt and constructor
+// arguments has the same address on every chain, so contracts that differ between chains, such as a destination's
+// source blockchain ID, must be passed as the same constructor arguments to land at the same address. Contracts
+// are owned by the Create2Deployer if they set their owner from msg.sender, so only contracts taking their owner
+// as a constructor argument, such as the bridge contracts, should be deployed this way.
+func DeployCreate2(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	metaData *bind.MetaData,
+	salt [32]byte,
+	constructorArgs ...interface{},
+) common.Address {
+	deployer := getCreate2Deployer(ctx, subnet, senderKey)
+	initCode := create2InitCode(metaData, constructorArgs...)
+	address := crypto.CreateAddress2(deployer.address, salt, crypto.Keccak256(initCode))
+
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return deployer.Transact(opts, "deploy", salt, initCode)
+		},
+	)
+	code, err := subnet.RPCClient.CodeAt(ctx, address, nil)
+	Expect(err).Should(BeNil())
+	Expect(code).ShouldNot(BeEmpty(), "no contract deployed to %s", address)
+
+	// The deployment is not in the receipt's contract address, so is recorded here
+	recordSpecContract(subnet, address, receipt.BlockNumber.Uint64())
+	for _, binding := range gasReportBindings {
+		if binding.metaData == metaData {
+			contractABI, err := metaData.GetAbi()
+			Expect(err).Should(BeNil())
+			recordGasReportContract(subnet, address, binding.name, contractABI)
+			break
+		}
+	}
+	log.Info(
+		"Deployed contract with CREATE2",
+		"address", address,
+		"salt", common.Hash(salt),
+		"blockchainID", subnet.BlockchainID,
+	)
+	return address
+}
+
+// DeployERC20DestinationCreate2 deploys an ERC20Destination for the ERC20Source on sourceSubnet with DeployCreate2,
+// with its token metadata populated from the token bridged by the ERC20Source. The destination has the same
+// address on every chain it is deployed to from the salt, provided the chains have the same TeleporterRegistry
+// address.
+func DeployERC20DestinationCreate2(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	teleporterManager common.Address,
+	sourceSubnet interfaces.SubnetTestInfo,
+	erc20SourceAddress common.Address,
+	salt [32]byte,
+) (common.Address, *erc20destination.ERC20Destination) {
+	metadata := GetERC20SourceTokenMetadata(ctx, sourceSubnet, erc20SourceAddress)
+	address := DeployCreate2(
+		ctx,
+		senderKey,
+		subnet,
+		erc20destination.ERC20DestinationMetaData,
+		salt,
+		subnet.TeleporterRegistryAddress,
+		teleporterManager,
+		sourceSubnet.BlockchainID,
+		erc20SourceAddress,
+		metadata.Name,
+		metadata.Symbol,
+		*metadata.Decimals,
+	)
+	erc20Destination, err := erc20destination.NewERC20Destination(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, erc20Destination
+}
+
+func create2InitCode(metaData *bind.MetaData, constructorArgs ...interface{}) []byte {
+	contractABI, err := metaData.GetAbi()
+	Expect(err).Should(BeNil())
+	packedArgs, err := contractABI.Pack("", constructorArgs...)
+	Expect(err).Should(BeNil())
+	return append(common.FromHex(metaData.Bin), packedArgs...)
+}
+
+// Must be called with create2Deployers locked
+func create2DeployerAddress() common.Address {
+	if create2Deployers.key == nil {
+		create2Deployers.key = NewAccountKey()
+		create2Deployers.address = crypto.CreateAddress(crypto.PubkeyToAddress(create2Deployers.key.PublicKey), 0)
+	}
+	return create2Deployers.address
+}
+
+type boundCreate2Deployer struct {
+	*bind.BoundContract
+
+	address common.Address
+}
+
+// Returns the Create2Deployer deployed to the chain, funding its deployer from senderKey and deploying it on first
+// use
+func getCreate2Deployer(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+) boundCreate2Deployer {
+	create2Deployers.Lock()
+	defer create2Deployers.Unlock()
+	address := create2DeployerAddress()
+	artifactFile := contractArtifactFile(create2DeployerContractName)
+	if !create2Deployers.deployed[subnet.BlockchainID] {
+		deployerAddress := crypto.PubkeyToAddress(create2Deployers.key.PublicKey)
+		nonce, err := subnet.RPCClient.NonceAt(ctx, deployerAddress, nil)
+		Expect(err).Should(BeNil())
+		Expect(nonce).Should(BeZero(), "Create2Deployer deployer has already sent transactions")
+
+		FundAccounts(ctx, subnet, senderKey, []common.Address{deployerAddress}, create2DeployerFunding)
+		deployedAddress := deployContractArtifact(ctx, create2Deployers.key, subnet, artifactFile)
+		Expect(deployedAddress).Should(Equal(address))
+		// Any spec may use the shared deployment, so it is not recorded as deployed by the current spec
+		shareSpecContract(subnet, address)
+		create2Deployers.deployed[subnet.BlockchainID] = true
+		log.Info("Deployed Create2Deployer", "address", address, "blockchainID", subnet.BlockchainID)
+	}
+
+	artifact := loadContractArtifact(artifactFile)
+	return boundCreate2Deployer{
+		BoundContract: bind.NewBoundContract(
+			address,
+			artifact.ABI,
+			subnet.RPCClient,
+			subnet.RPCClient,
+			subnet.RPCClient,
+		),
+		address: address,
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/monitor"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// LatencyReportDirEnvVar optionally sets the directory that the delivery latency report of the suite is
+	// written to
+	LatencyReportDirEnvVar = "E2E_LATENCY_REPORT_DIR"
+
+	latencyReportJSONFile     = "delivery-latency.json"
+	latencyReportMarkdownFile = "delivery-latency.md"
+
+	primaryNetworkRoute = "C-Chain"
+	subnetRoute         = "Subnet"
+)
+
+// The latency of every message delivered during the suite. The blockchain IDs of the local network differ
+// between runs, so latencies are grouped by whether each end of the route is the C-Chain or a Subnet.
+var deliveryLatencies = struct {
+	sync.Mutex
+	samples []monitor.DeliveryLatencySample
+}{}
+
+// Records the time between the blocks that the message was sent and delivered in, the same way as the delivery
+// latency exported by the bridge-metrics service
+func recordDeliveryLatency(
+	ctx context.Context,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	sourceReceipt *types.Receipt,
+	receipt *types.Receipt,
+) {
+	sendHeader, err := source.RPCClient.HeaderByNumber(ctx, sourceReceipt.BlockNumber)
+	Expect(err).Should(BeNil())
+	deliveryHeader, err := destination.RPCClient.HeaderByNumber(ctx, receipt.BlockNumber)
+	Expect(err).Should(BeNil())
+	latency := time.Duration(int64(deliveryHeader.Time)-int64(sendHeader.Time)) * time.Second
+	if latency < 0 {
+		latency = 0
+	}
+
+	deliveryLatencies.Lock()
+	defer deliveryLatencies.Unlock()
+	deliveryLatencies.samples = append(deliveryLatencies.samples, monitor.DeliveryLatencySample{
+		From:    latencyRouteName(source),
+		To:      latencyRouteName(destination),
+		Latency: latency,
+	})
+}
+
+func latencyRouteName(subnet interfaces.SubnetTestInfo) string {
+	if subnet.SubnetID == constants.PrimaryNetworkID {
+		return primaryNetworkRoute
+	}
+	return subnetRoute
+}
+
+// WriteDeliveryLatencyReport logs the histogram of the delivery latencies of each route across every spec of the
+// suite, and writes it to E2E_LATENCY_REPORT_DIR as delivery-latency.json and delivery-latency.md, if the
+// directory is set. The JSON report can be exported by the bridge-metrics service as its latency baseline.
+func WriteDeliveryLatencyReport() {
+	deliveryLatencies.Lock()
+	report := monitor.NewDeliveryLatencyReport(deliveryLatencies.samples)
+	deliveryLatencies.Unlock()
+
+	for _, route := range report.Routes {
+		log.Info(
+			"Delivery latency",
+			"from", route.From,
+			"to", route.To,
+			"deliveries", route.Deliveries,
+			"median", route.Median,
+			"p90", route.P90,
+			"max", route.Max,
+		)
+	}
+
+	reportDir := os.Getenv(LatencyReportDirEnvVar)
+	if reportDir == "" {
+		return
+	}
+	Expect(os.MkdirAll(reportDir, 0o755)).Should(Succeed())
+
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	Expect(err).Should(BeNil())
+	jsonFile := filepath.Join(reportDir, latencyReportJSONFile)
+	Expect(os.WriteFile(jsonFile, reportJSON, 0o644)).Should(Succeed())
+
+	markdownFile := filepath.Join(reportDir, latencyReportMarkdownFile)
+	Expect(os.WriteFile(markdownFile, []byte(deliveryLatencyMarkdown(report)), 0o644)).Should(Succeed())
+	log.Info("Wrote delivery latency report", "json", jsonFile, "markdown", markdownFile, "routes", len(report.Routes))
+}
+
+// Formats the report as a markdown table of the latency percentiles of each route, and the number of deliveries
+// in each bucket of its histogram
+func deliveryLatencyMarkdown(report *monitor.DeliveryLatencyReport) string {
+	var b strings.Builder
+	b.WriteString("# Delivery latency\n\n")
+	b.WriteString("| From | To | Deliveries | Min (s) | Median (s) | P90 (s) | Max (s) |")
+	for _, upperBound := range monitor.DeliveryLatencyBuckets {
+		fmt.Fprintf(&b, " ≤ %gs |", upperBound)
+	}
+	b.WriteString("\n| --- | --- | ---: | ---: | ---: | ---: | ---: |")
+	b.WriteString(strings.Repeat(" ---: |", len(monitor.DeliveryLatencyBuckets)))
+	b.WriteString("\n")
+	for _, route := range report.Routes {
+		fmt.Fprintf(&b, "| %s | %s | %d | %g | %g | %g | %g |",
+			route.From, route.To, route.Deliveries, route.Min, route.Median, route.P90, route.Max)
+		for _, bucket := range route.Buckets {
+			fmt.Fprintf(&b, " %d |", bucket.Count)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
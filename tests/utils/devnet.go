@@ -0,0 +1,173 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/ava-labs/teleporter-token-bridge/conformance"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// DevnetDirEnvVar optionally sets the directory that the devnet suite writes the endpoints and deployment of the
+// network to. Defaults to devnet at the root of the repository.
+const DevnetDirEnvVar = "E2E_DEVNET_DIR"
+
+const (
+	defaultDevnetDir = "devnet"
+
+	devnetNetworkFile       = "network.json"
+	devnetManifestFile      = "manifest.json"
+	devnetRelayerConfigFile = "relayer-config.json"
+	devnetRelayerStorageDir = "relayer-storage"
+)
+
+// Devnet describes a running local network and the bridge contracts deployed to it, for the tools developed
+// against it
+type Devnet struct {
+	Chains    []DevnetChain    `json:"chains"`
+	Contracts []DevnetContract `json:"contracts"`
+	// Accounts are funded on every chain. Their private keys are written to disk, so they must only ever be used
+	// on the local network.
+	Accounts []DevnetAccount `json:"accounts"`
+}
+
+// DevnetChain holds the endpoints and Teleporter deployment of a chain of the network
+type DevnetChain struct {
+	Name                       string   `json:"name"`
+	SubnetID                   string   `json:"subnet-id"`
+	BlockchainID               string   `json:"blockchain-id"`
+	EVMChainID                 uint64   `json:"evm-chain-id"`
+	NodeURIs                   []string `json:"node-uris"`
+	RPCEndpoint                string   `json:"rpc-endpoint"`
+	WSEndpoint                 string   `json:"ws-endpoint"`
+	TeleporterMessengerAddress string   `json:"teleporter-messenger-address"`
+	TeleporterRegistryAddress  string   `json:"teleporter-registry-address"`
+}
+
+// DevnetContract is a contract deployed to a chain of the network, named by the chain's name
+type DevnetContract struct {
+	Name    string `json:"name"`
+	Chain   string `json:"chain"`
+	Address string `json:"address"`
+	// Type is the events.ContractType of bridge contracts, or the kind of token of other contracts
+	Type string `json:"type"`
+}
+
+// DevnetAccount is an account funded on every chain of the network
+type DevnetAccount struct {
+	Address    string `json:"address"`
+	PrivateKey string `json:"private-key"`
+}
+
+// NewDevnetChain describes the chain of the network by the name other tools refer to it with. The chain's
+// Teleporter messenger is the latest version registered with its TeleporterRegistry.
+func NewDevnetChain(ctx context.Context, name string, subnet interfaces.SubnetTestInfo) DevnetChain {
+	blockchainID := subnet.BlockchainID.String()
+	teleporterAddress, _ := LatestTeleporter(ctx, subnet)
+	return DevnetChain{
+		Name:                       name,
+		SubnetID:                   subnet.SubnetID.String(),
+		BlockchainID:               blockchainID,
+		EVMChainID:                 subnet.EVMChainID.Uint64(),
+		NodeURIs:                   subnet.NodeURIs,
+		RPCEndpoint:                teleporterUtils.HttpToRPCURI(subnet.NodeURIs[0], blockchainID),
+		WSEndpoint:                 teleporterUtils.HttpToWebsocketURI(subnet.NodeURIs[0], blockchainID),
+		TeleporterMessengerAddress: teleporterAddress.Hex(),
+		TeleporterRegistryAddress:  subnet.TeleporterRegistryAddress.Hex(),
+	}
+}
+
+// NewDevnetAccount describes the account of the key
+func NewDevnetAccount(key *ecdsa.PrivateKey) DevnetAccount {
+	return DevnetAccount{
+		Address:    crypto.PubkeyToAddress(key.PublicKey).Hex(),
+		PrivateKey: hex.EncodeToString(crypto.FromECDSA(key)),
+	}
+}
+
+// ChainConfigs returns the chains of the devnet in the format of the chains of a manifest, or of the monitoring
+// service's configuration
+func (d *Devnet) ChainConfigs() []events.ChainConfig {
+	configs := make([]events.ChainConfig, len(d.Chains))
+	for i, chain := range d.Chains {
+		configs[i] = events.ChainConfig{
+			Name:         chain.Name,
+			BlockchainID: chain.BlockchainID,
+			RPCEndpoint:  chain.RPCEndpoint,
+		}
+	}
+	return configs
+}
+
+// DevnetDir returns the directory that the devnet is written to
+func DevnetDir() string {
+	return PathFromEnv(DevnetDirEnvVar, defaultDevnetDir)
+}
+
+// WriteDevnet writes the devnet to DevnetDir, as network.json, along with the manifest of its bridges as
+// manifest.json, and an awm-relayer configuration relaying between every chain of the network as
+// relayer-config.json. The relayer configuration uses a newly funded account, but no relayer is started, so that
+// one can be run from the configuration while it is being developed. Returns the directory.
+func WriteDevnet(
+	ctx context.Context,
+	network interfaces.LocalNetwork,
+	devnet *Devnet,
+	manifest *conformance.Manifest,
+) string {
+	Expect(manifest.Validate()).Should(Succeed())
+	dir := DevnetDir()
+	Expect(os.MkdirAll(dir, 0o755)).Should(Succeed())
+
+	relayerKey := NewAccountKey()
+	relayerAddress := crypto.PubkeyToAddress(relayerKey.PublicKey)
+	_, fundedKey := network.GetFundedAccountInfo()
+	for _, subnet := range network.GetAllSubnetsInfo() {
+		teleporterUtils.SendNativeTransfer(ctx, subnet, fundedKey, relayerAddress, relayerFunding)
+	}
+	relayerConfig := newRelayerConfig(
+		ctx,
+		network,
+		relayerKey,
+		relayerAddress,
+		filepath.Join(dir, devnetRelayerStorageDir),
+	)
+
+	// The network description and relayer configuration hold private keys, so are only readable by their owner
+	writeDevnetFile(dir, devnetNetworkFile, devnet, 0o600)
+	writeDevnetFile(dir, devnetManifestFile, manifest, 0o644)
+	writeDevnetFile(dir, devnetRelayerConfigFile, relayerConfig, 0o600)
+	log.Info("Wrote devnet", "dir", dir, "relayerAddress", relayerAddress)
+	return dir
+}
+
+// RemoveDevnet removes the files written to the directory by WriteDevnet, so that tools are not left pointing at
+// the endpoints of a network that is no longer running. Other files in the directory are kept.
+func RemoveDevnet(dir string) {
+	for _, name := range []string{devnetNetworkFile, devnetManifestFile, devnetRelayerConfigFile} {
+		if err := os.Remove(filepath.Join(dir, name)); err != nil && !os.IsNotExist(err) {
+			log.Warn("Failed to remove devnet file", "file", name, "err", err)
+		}
+	}
+	if err := os.RemoveAll(filepath.Join(dir, devnetRelayerStorageDir)); err != nil {
+		log.Warn("Failed to remove relayer storage", "dir", dir, "err", err)
+	}
+}
+
+func writeDevnetFile(dir string, name string, value interface{}, perm os.FileMode) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	Expect(err).Should(BeNil())
+	Expect(os.WriteFile(filepath.Join(dir, name), data, perm)).Should(Succeed())
+}
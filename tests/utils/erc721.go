@@ -0,0 +1,367 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc721destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Destination"
+	erc721source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC721Source"
+	exampleerc721 "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/ExampleERC721"
+	mockERC721SACR "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/MockERC721SendAndCallReceiver"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// The ERC721 contracts are deployed from the artifacts built by forge in e2e_test.sh,
+// since their bindings are generated without bytecode until the contracts are built
+const (
+	exampleERC721ContractName                 = "ExampleERC721"
+	erc721SourceContractName                  = "ERC721Source"
+	erc721DestinationContractName             = "ERC721Destination"
+	mockERC721SendAndCallReceiverContractName = "MockERC721SendAndCallReceiver"
+)
+
+func DeployExampleERC721(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+) (common.Address, *exampleerc721.ExampleERC721) {
+	address := deployContractArtifact(ctx, senderKey, subnet, contractArtifactFile(exampleERC721ContractName))
+	log.Info("Deployed ExampleERC721", "address", address, "blockchainID", subnet.BlockchainID)
+
+	token, err := exampleerc721.NewExampleERC721(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, token
+}
+
+func DeployERC721Source(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	teleporterManager common.Address,
+	tokenAddress common.Address,
+) (common.Address, *erc721source.ERC721Source) {
+	address := deployContractArtifact(
+		ctx,
+		senderKey,
+		subnet,
+		contractArtifactFile(erc721SourceContractName),
+		subnet.TeleporterRegistryAddress,
+		teleporterManager,
+		tokenAddress,
+	)
+	log.Info("Deployed ERC721Source", "address", address, "blockchainID", subnet.BlockchainID)
+
+	erc721Source, err := erc721source.NewERC721Source(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, erc721Source
+}
+
+func DeployERC721Destination(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	teleporterManager common.Address,
+	sourceBlockchainID ids.ID,
+	tokenSourceAddress common.Address,
+	tokenName string,
+	tokenSymbol string,
+) (common.Address, *erc721destination.ERC721Destination) {
+	address := deployContractArtifact(
+		ctx,
+		senderKey,
+		subnet,
+		contractArtifactFile(erc721DestinationContractName),
+		subnet.TeleporterRegistryAddress,
+		teleporterManager,
+		[32]byte(sourceBlockchainID),
+		tokenSourceAddress,
+		tokenName,
+		tokenSymbol,
+	)
+	log.Info("Deployed ERC721Destination", "address", address, "blockchainID", subnet.BlockchainID)
+
+	erc721Destination, err := erc721destination.NewERC721Destination(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, erc721Destination
+}
+
+func DeployMockERC721SendAndCallReceiver(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+) (common.Address, *mockERC721SACR.MockERC721SendAndCallReceiver) {
+	address := deployContractArtifact(
+		ctx,
+		senderKey,
+		subnet,
+		contractArtifactFile(mockERC721SendAndCallReceiverContractName),
+	)
+	log.Info("Deployed MockERC721SendAndCallReceiver", "address", address, "blockchainID", subnet.BlockchainID)
+
+	contract, err := mockERC721SACR.NewMockERC721SendAndCallReceiver(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, contract
+}
+
+// RegisterERC721DestinationOnSource sends the register message of the ERC721Destination to the ERC721Source,
+// and checks that the source registered it once relayed
+func RegisterERC721DestinationOnSource(
+	ctx context.Context,
+	network interfaces.Network,
+	sourceSubnet interfaces.SubnetTestInfo,
+	erc721Source *erc721source.ERC721Source,
+	destinationSubnet interfaces.SubnetTestInfo,
+	erc721Destination *erc721destination.ERC721Destination,
+	erc721DestinationAddress common.Address,
+) {
+	_, fundedKey := network.GetFundedAccountInfo()
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		destinationSubnet,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc721Destination.RegisterWithSource(
+				opts,
+				erc721destination.TeleporterFeeInfo{FeeTokenAddress: common.Address{}, Amount: big.NewInt(0)},
+			)
+		},
+	)
+
+	receipt = network.RelayMessage(ctx, receipt, destinationSubnet, sourceSubnet, true)
+
+	registerEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc721Source.ParseDestinationRegistered)
+	Expect(err).Should(BeNil())
+	Expect(registerEvent.DestinationBlockchainID[:]).Should(Equal(destinationSubnet.BlockchainID[:]))
+	Expect(registerEvent.DestinationBridgeAddress).Should(Equal(erc721DestinationAddress))
+}
+
+// DeployAndRegisterERC721Destination deploys an ERC721Destination for the ERC721Source on sourceSubnet from the
+// network's funded account, with the name and symbol of the source token, and registers it with the ERC721Source.
+// Returns once the registration has been delivered, so the destination is ready to receive tokens.
+func DeployAndRegisterERC721Destination(
+	ctx context.Context,
+	network interfaces.Network,
+	subnet interfaces.SubnetTestInfo,
+	sourceSubnet interfaces.SubnetTestInfo,
+	erc721Source *erc721source.ERC721Source,
+	erc721SourceAddress common.Address,
+	sourceToken *exampleerc721.ExampleERC721,
+) (common.Address, *erc721destination.ERC721Destination) {
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	tokenName, err := sourceToken.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	tokenSymbol, err := sourceToken.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+
+	address, erc721Destination := DeployERC721Destination(
+		ctx,
+		fundedKey,
+		subnet,
+		fundedAddress,
+		sourceSubnet.BlockchainID,
+		erc721SourceAddress,
+		tokenName,
+		tokenSymbol,
+	)
+	RegisterERC721DestinationOnSource(ctx, network, sourceSubnet, erc721Source, subnet, erc721Destination, address)
+	return address, erc721Destination
+}
+
+// MintExampleERC721 mints the token with the given ID and URI to the recipient
+func MintExampleERC721(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	token *exampleerc721.ExampleERC721,
+	senderKey *ecdsa.PrivateKey,
+	recipient common.Address,
+	tokenID *big.Int,
+	tokenURI string,
+) {
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.Mint(opts, recipient, tokenID, tokenURI)
+		},
+	)
+}
+
+// approveERC721 approves the bridge to transfer the token with the given ID from its owner
+func approveERC721(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	token *exampleerc721.ExampleERC721,
+	senderKey *ecdsa.PrivateKey,
+	spender common.Address,
+	tokenID *big.Int,
+) {
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.Approve(opts, spender, tokenID)
+		},
+	)
+}
+
+func SendERC721Source(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	erc721Source *erc721source.ERC721Source,
+	erc721SourceAddress common.Address,
+	sourceToken *exampleerc721.ExampleERC721,
+	input erc721source.SendTokensInput,
+	tokenID *big.Int,
+	senderKey *ecdsa.PrivateKey,
+) *types.Receipt {
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	approveERC721(ctx, subnet, sourceToken, senderKey, erc721SourceAddress, tokenID)
+
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc721Source.Send(opts, input, tokenID)
+		},
+	)
+	setReceiptAttributes(span, receipt)
+	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc721Source.ParseTokenSent)
+	Expect(err).Should(BeNil())
+	Expect(event.Sender).Should(Equal(crypto.PubkeyToAddress(senderKey.PublicKey)))
+	teleporterUtils.ExpectBigEqual(event.TokenId, tokenID)
+
+	// The source holds the token until it is bridged back
+	owner, err := sourceToken.OwnerOf(&bind.CallOpts{Context: ctx}, tokenID)
+	Expect(err).Should(BeNil())
+	Expect(owner).Should(Equal(erc721SourceAddress))
+
+	return receipt
+}
+
+func SendAndCallERC721Source(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	erc721Source *erc721source.ERC721Source,
+	erc721SourceAddress common.Address,
+	sourceToken *exampleerc721.ExampleERC721,
+	input erc721source.SendAndCallInput,
+	tokenID *big.Int,
+	senderKey *ecdsa.PrivateKey,
+) *types.Receipt {
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	approveERC721(ctx, subnet, sourceToken, senderKey, erc721SourceAddress, tokenID)
+
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc721Source.SendAndCall(opts, input, tokenID)
+		},
+	)
+	setReceiptAttributes(span, receipt)
+	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc721Source.ParseTokenAndCallSent)
+	Expect(err).Should(BeNil())
+	Expect(event.Input.RecipientContract).Should(Equal(input.RecipientContract))
+	teleporterUtils.ExpectBigEqual(event.TokenId, tokenID)
+
+	return receipt
+}
+
+func SendERC721Destination(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	erc721Destination *erc721destination.ERC721Destination,
+	input erc721destination.SendTokensInput,
+	tokenID *big.Int,
+	senderKey *ecdsa.PrivateKey,
+) *types.Receipt {
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc721Destination.Send(opts, input, tokenID)
+		},
+	)
+	setReceiptAttributes(span, receipt)
+	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc721Destination.ParseTokenSent)
+	Expect(err).Should(BeNil())
+	Expect(event.Sender).Should(Equal(crypto.PubkeyToAddress(senderKey.PublicKey)))
+	teleporterUtils.ExpectBigEqual(event.TokenId, tokenID)
+
+	// The destination burns the token when it is sent
+	burnEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc721Destination.ParseTransfer)
+	Expect(err).Should(BeNil())
+	Expect(burnEvent.To).Should(Equal(common.Address{}))
+	teleporterUtils.ExpectBigEqual(burnEvent.TokenId, tokenID)
+
+	return receipt
+}
+
+// CheckERC721SourceWithdrawal checks that the ERC721Source released the token to the recipient
+func CheckERC721SourceWithdrawal(
+	ctx context.Context,
+	erc721SourceAddress common.Address,
+	sourceToken *exampleerc721.ExampleERC721,
+	receipt *types.Receipt,
+	expectedRecipientAddress common.Address,
+	expectedTokenID *big.Int,
+) {
+	transferEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, sourceToken.ParseTransfer)
+	Expect(err).Should(BeNil())
+	Expect(transferEvent.From).Should(Equal(erc721SourceAddress))
+	Expect(transferEvent.To).Should(Equal(expectedRecipientAddress))
+	teleporterUtils.ExpectBigEqual(transferEvent.TokenId, expectedTokenID)
+
+	owner, err := sourceToken.OwnerOf(&bind.CallOpts{Context: ctx}, expectedTokenID)
+	Expect(err).Should(BeNil())
+	Expect(owner).Should(Equal(expectedRecipientAddress))
+}
+
+// CheckERC721DestinationWithdrawal checks that the ERC721Destination minted the token to the recipient,
+// carrying over the token URI from the source
+func CheckERC721DestinationWithdrawal(
+	ctx context.Context,
+	erc721Destination *erc721destination.ERC721Destination,
+	receipt *types.Receipt,
+	expectedRecipientAddress common.Address,
+	expectedTokenID *big.Int,
+	expectedTokenURI string,
+) {
+	transferEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc721Destination.ParseTransfer)
+	Expect(err).Should(BeNil())
+	Expect(transferEvent.From).Should(Equal(common.Address{}))
+	Expect(transferEvent.To).Should(Equal(expectedRecipientAddress))
+	teleporterUtils.ExpectBigEqual(transferEvent.TokenId, expectedTokenID)
+
+	owner, err := erc721Destination.OwnerOf(&bind.CallOpts{Context: ctx}, expectedTokenID)
+	Expect(err).Should(BeNil())
+	Expect(owner).Should(Equal(expectedRecipientAddress))
+	tokenURI, err := erc721Destination.TokenURI(&bind.CallOpts{Context: ctx}, expectedTokenID)
+	Expect(err).Should(BeNil())
+	Expect(tokenURI).Should(Equal(expectedTokenURI))
+}
@@ -0,0 +1,192 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/subnet-evm/eth/tracers"
+	"github.com/ava-labs/subnet-evm/rpc"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/onsi/ginkgo/v2"
+)
+
+const (
+	// FailureTraceDirEnvVar optionally sets the directory that the traces of failed specs are written to,
+	// in addition to the test output
+	FailureTraceDirEnvVar = "E2E_FAILURE_TRACE_DIR"
+
+	failureTraceTimeout = 30 * time.Second
+)
+
+var (
+	specFileNameReplacer = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+	callTracer = "callTracer"
+)
+
+// A transaction sent by the current spec, traced if the spec fails
+type specTransaction struct {
+	subnet interfaces.SubnetTestInfo
+	txHash common.Hash
+	failed bool
+
+	// Set for transactions delivering a Teleporter message, to the transaction that sent it
+	sourceSubnet *interfaces.SubnetTestInfo
+	sourceTxHash common.Hash
+}
+
+// The transactions sent by the current spec, in the order they were sent
+var specTransactions = struct {
+	sync.Mutex
+	transactions []specTransaction
+}{}
+
+func resetSpecTransactions() {
+	specTransactions.Lock()
+	defer specTransactions.Unlock()
+	specTransactions.transactions = nil
+}
+
+// Records a mined transaction sent by the current spec, whether or not it succeeded
+func recordSpecTransaction(subnet interfaces.SubnetTestInfo, txHash common.Hash, failed bool) {
+	specTransactions.Lock()
+	defer specTransactions.Unlock()
+	specTransactions.transactions = append(specTransactions.transactions, specTransaction{
+		subnet: subnet,
+		txHash: txHash,
+		failed: failed,
+	})
+}
+
+// Records a transaction delivering the Teleporter message sent in the source transaction.
+// Recorded before it is sent, so that a delivery that fails to be mined is still traced.
+func recordSpecReceiveTransaction(
+	subnet interfaces.SubnetTestInfo,
+	txHash common.Hash,
+	sourceSubnet interfaces.SubnetTestInfo,
+	sourceTxHash common.Hash,
+) {
+	specTransactions.Lock()
+	defer specTransactions.Unlock()
+	specTransactions.transactions = append(specTransactions.transactions, specTransaction{
+		subnet:       subnet,
+		txHash:       txHash,
+		sourceSubnet: &sourceSubnet,
+		sourceTxHash: sourceTxHash,
+	})
+}
+
+// TraceFailedSpec prints the debug_traceTransaction call traces of the transaction that most likely caused the
+// current spec to fail, to the test output and to E2E_FAILURE_TRACE_DIR if it is set. The offending transaction
+// is the last that failed, or else the last that was sent. If it delivered a Teleporter message, the transaction
+// that sent the message is also traced, and if it sent a message that was delivered, the delivery is also traced.
+func TraceFailedSpec(ctx context.Context, specName string) {
+	specTransactions.Lock()
+	transactions := append([]specTransaction(nil), specTransactions.transactions...)
+	specTransactions.Unlock()
+	if len(transactions) == 0 {
+		return
+	}
+
+	offending := transactions[len(transactions)-1]
+	for _, tx := range transactions {
+		if tx.failed {
+			offending = tx
+		}
+	}
+	traced := []specTransaction{offending}
+	if offending.sourceSubnet != nil {
+		traced = append(traced, specTransaction{subnet: *offending.sourceSubnet, txHash: offending.sourceTxHash})
+	}
+	for _, tx := range transactions {
+		if tx.sourceSubnet != nil && tx.sourceTxHash == offending.txHash {
+			traced = append(traced, tx)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, failureTraceTimeout)
+	defer cancel()
+	for _, tx := range traced {
+		callTrace, err := traceTransaction(ctx, tx.subnet, tx.txHash)
+		if err != nil {
+			log.Warn(
+				"Failed to trace transaction",
+				"txHash", tx.txHash,
+				"blockchainID", tx.subnet.BlockchainID,
+				"err", err,
+			)
+			continue
+		}
+		fmt.Fprintf(
+			ginkgo.GinkgoWriter,
+			"Call trace of transaction %s on %s:\n%s\n",
+			tx.txHash,
+			tx.subnet.BlockchainID,
+			callTrace,
+		)
+		writeFailureTrace(specName, tx, callTrace)
+	}
+}
+
+// Returns the indented call trace of the transaction. Errors are returned rather than failing the spec,
+// since the spec has already failed.
+func traceTransaction(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	txHash common.Hash,
+) ([]byte, error) {
+	url := teleporterUtils.HttpToRPCURI(subnet.NodeURIs[0], subnet.BlockchainID.String())
+	rpcClient, err := rpc.DialContext(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer rpcClient.Close()
+
+	var result json.RawMessage
+	err = rpcClient.CallContext(
+		ctx,
+		&result,
+		"debug_traceTransaction",
+		txHash.String(),
+		tracers.TraceConfig{Tracer: &callTracer},
+	)
+	if err != nil {
+		return nil, err
+	}
+	var callTrace interface{}
+	if err := json.Unmarshal(result, &callTrace); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(callTrace, "", "  ")
+}
+
+// Writes the call trace to <E2E_FAILURE_TRACE_DIR>/<spec>/<blockchainID>-<txHash>.json, if the directory is set
+func writeFailureTrace(specName string, tx specTransaction, callTrace []byte) {
+	traceDir := os.Getenv(FailureTraceDirEnvVar)
+	if traceDir == "" {
+		return
+	}
+	specDir := filepath.Join(traceDir, specFileNameReplacer.ReplaceAllString(specName, "_"))
+	traceFile := filepath.Join(specDir, fmt.Sprintf("%s-%s.json", tx.subnet.BlockchainID, tx.txHash))
+	if err := os.MkdirAll(specDir, 0o755); err != nil {
+		log.Warn("Failed to create failure trace directory", "dir", specDir, "err", err)
+		return
+	}
+	if err := os.WriteFile(traceFile, callTrace, 0o644); err != nil {
+		log.Warn("Failed to write failure trace", "file", traceFile, "err", err)
+		return
+	}
+	log.Info("Wrote failure trace", "file", traceFile)
+}
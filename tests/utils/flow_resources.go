@@ -0,0 +1,254 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// ResourceReportDirEnvVar optionally sets the directory that the resource usage report of each flow of the
+	// suite is written to
+	ResourceReportDirEnvVar = "E2E_RESOURCE_REPORT_DIR"
+
+	resourceReportJSONFile     = "flow-resources.json"
+	resourceReportMarkdownFile = "flow-resources.md"
+
+	// The number of flows highlighted as the slowest of the suite
+	slowestFlowCount = 5
+
+	sendRawTransactionMethod = "eth_sendRawTransaction"
+)
+
+// FlowResources is the resource usage of a single flow of the suite
+type FlowResources struct {
+	Flow            string        `json:"flow"`
+	Failed          bool          `json:"failed"`
+	WallTime        time.Duration `json:"-"`
+	WallTimeSeconds float64       `json:"wallTimeSeconds"`
+	// RPCCalls is the number of JSON-RPC calls made over HTTP by the test process, including those to the P-Chain
+	// and info APIs. Batched calls are each counted.
+	RPCCalls int `json:"rpcCalls"`
+	// Transactions is the number of transactions sent by the test process
+	Transactions int `json:"transactions"`
+	// RPCCallsByMethod is the number of calls of each JSON-RPC method
+	RPCCallsByMethod map[string]int `json:"rpcCallsByMethod"`
+
+	start time.Time
+}
+
+// The resource usage of the current flow, and of every flow that has ended, in the order they ran
+var flowResources = struct {
+	sync.Mutex
+	current *FlowResources
+	flows   []*FlowResources
+}{}
+
+// CountRPCCalls counts the JSON-RPC calls of every HTTP client using the default transport towards the resource
+// usage of the current flow, which includes the RPC clients of the local network. Calls over websockets, and calls
+// made by relayer processes, are not counted. It should be called once, in BeforeSuite.
+func CountRPCCalls() {
+	if _, ok := http.DefaultTransport.(*rpcCountingTransport); ok {
+		return
+	}
+	http.DefaultTransport = &rpcCountingTransport{RoundTripper: http.DefaultTransport}
+}
+
+type rpcCountingTransport struct {
+	http.RoundTripper
+}
+
+func (t *rpcCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost && req.Body != nil {
+		flowResources.Lock()
+		counting := flowResources.current != nil
+		flowResources.Unlock()
+		if counting {
+			body, err := io.ReadAll(req.Body)
+			req.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+			recordRPCCalls(rpcMethods(body))
+		}
+	}
+	return t.RoundTripper.RoundTrip(req)
+}
+
+// Returns the methods of the JSON-RPC request or batch of requests, or none if the body is not JSON-RPC
+func rpcMethods(body []byte) []string {
+	type rpcRequest struct {
+		Method string `json:"method"`
+	}
+	var requests []rpcRequest
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &requests); err != nil {
+			return nil
+		}
+	} else {
+		var request rpcRequest
+		if err := json.Unmarshal(trimmed, &request); err != nil {
+			return nil
+		}
+		requests = append(requests, request)
+	}
+	methods := make([]string, 0, len(requests))
+	for _, request := range requests {
+		if request.Method != "" {
+			methods = append(methods, request.Method)
+		}
+	}
+	return methods
+}
+
+func recordRPCCalls(methods []string) {
+	flowResources.Lock()
+	defer flowResources.Unlock()
+	flow := flowResources.current
+	if flow == nil {
+		return
+	}
+	for _, method := range methods {
+		flow.RPCCalls++
+		flow.RPCCallsByMethod[method]++
+		if method == sendRawTransactionMethod {
+			flow.Transactions++
+		}
+	}
+}
+
+// StartFlowResources starts recording the resource usage of the named flow, until EndFlowResources is called
+func StartFlowResources(name string) {
+	flowResources.Lock()
+	defer flowResources.Unlock()
+	flowResources.current = &FlowResources{
+		Flow:             name,
+		RPCCallsByMethod: make(map[string]int),
+		start:            time.Now(),
+	}
+}
+
+// EndFlowResources stops recording the resource usage of the current flow, and adds it to the suite's report
+func EndFlowResources(failed bool) {
+	flowResources.Lock()
+	defer flowResources.Unlock()
+	flow := flowResources.current
+	if flow == nil {
+		return
+	}
+	flow.Failed = failed
+	flow.WallTime = time.Since(flow.start)
+	flow.WallTimeSeconds = flow.WallTime.Seconds()
+	flowResources.flows = append(flowResources.flows, flow)
+	flowResources.current = nil
+}
+
+// WriteFlowResourceReport logs the wall time, RPC calls and transactions of every flow of the suite, slowest
+// first, highlighting the slowest flows, and writes the report to E2E_RESOURCE_REPORT_DIR as flow-resources.json
+// and flow-resources.md, if the directory is set
+func WriteFlowResourceReport() {
+	flowResources.Lock()
+	flows := append([]*FlowResources(nil), flowResources.flows...)
+	flowResources.Unlock()
+	sort.SliceStable(flows, func(i, j int) bool {
+		return flows[i].WallTime > flows[j].WallTime
+	})
+
+	for i, flow := range flows {
+		message := "Flow resources"
+		if i < slowestFlowCount {
+			message = fmt.Sprintf("Flow resources, slowest #%d", i+1)
+		}
+		log.Info(
+			message,
+			"flow", flow.Flow,
+			"wallTime", flow.WallTime.Round(time.Millisecond),
+			"rpcCalls", flow.RPCCalls,
+			"transactions", flow.Transactions,
+			"failed", flow.Failed,
+		)
+	}
+
+	reportDir := os.Getenv(ResourceReportDirEnvVar)
+	if reportDir == "" {
+		return
+	}
+	Expect(os.MkdirAll(reportDir, 0o755)).Should(Succeed())
+
+	reportJSON, err := json.MarshalIndent(flows, "", "  ")
+	Expect(err).Should(BeNil())
+	jsonFile := filepath.Join(reportDir, resourceReportJSONFile)
+	Expect(os.WriteFile(jsonFile, reportJSON, 0o644)).Should(Succeed())
+
+	markdownFile := filepath.Join(reportDir, resourceReportMarkdownFile)
+	Expect(os.WriteFile(markdownFile, []byte(flowResourcesMarkdown(flows)), 0o644)).Should(Succeed())
+	log.Info("Wrote flow resource report", "json", jsonFile, "markdown", markdownFile, "flows", len(flows))
+}
+
+// Formats the flows, sorted slowest first, as a markdown table of their resource usage and the methods they call
+// most, followed by the totals of the suite. The slowest flows are in bold.
+func flowResourcesMarkdown(flows []*FlowResources) string {
+	var b strings.Builder
+	b.WriteString("# Flow resource usage\n\n")
+	b.WriteString("| Flow | Wall time (s) | RPC calls | Transactions | Most called methods |\n")
+	b.WriteString("| --- | ---: | ---: | ---: | --- |\n")
+	var (
+		totalWallTime     time.Duration
+		totalRPCCalls     int
+		totalTransactions int
+	)
+	for i, flow := range flows {
+		name := flow.Flow
+		if flow.Failed {
+			name += " (failed)"
+		}
+		if i < slowestFlowCount {
+			name = "**" + name + "**"
+		}
+		fmt.Fprintf(&b, "| %s | %.1f | %d | %d | %s |\n",
+			name, flow.WallTime.Seconds(), flow.RPCCalls, flow.Transactions, mostCalledMethods(flow, 3))
+		totalWallTime += flow.WallTime
+		totalRPCCalls += flow.RPCCalls
+		totalTransactions += flow.Transactions
+	}
+	fmt.Fprintf(&b, "| Total | %.1f | %d | %d | |\n", totalWallTime.Seconds(), totalRPCCalls, totalTransactions)
+	return b.String()
+}
+
+// Returns the flow's n most called methods with their call counts, most called first
+func mostCalledMethods(flow *FlowResources, n int) string {
+	methods := make([]string, 0, len(flow.RPCCallsByMethod))
+	for method := range flow.RPCCallsByMethod {
+		methods = append(methods, method)
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		ci, cj := flow.RPCCallsByMethod[methods[i]], flow.RPCCallsByMethod[methods[j]]
+		if ci != cj {
+			return ci > cj
+		}
+		return methods[i] < methods[j]
+	})
+	if len(methods) > n {
+		methods = methods[:n]
+	}
+	for i, method := range methods {
+		methods[i] = fmt.Sprintf("%s (%d)", method, flow.RPCCallsByMethod[method])
+	}
+	return strings.Join(methods, ", ")
+}
@@ -0,0 +1,109 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/onsi/ginkgo/v2"
+	ginkgoTypes "github.com/onsi/ginkgo/v2/types"
+)
+
+const (
+	// RunFlowsEnvVar optionally selects the flows to run, as a comma separated list of flow selector names
+	RunFlowsEnvVar = "RUN_FLOWS"
+	// ListFlowsEnvVar lists the flow selectors and the specs of the suite, without running them
+	ListFlowsEnvVar = "LIST_FLOWS"
+)
+
+// FlowSelector is a name accepted by RUN_FLOWS, which selects the specs matching its ginkgo label filter
+type FlowSelector struct {
+	Name        string
+	LabelFilter string
+	Description string
+}
+
+// FlowSelectionConfig returns the ginkgo configuration of the suite, with the flows selected by RUN_FLOWS
+// added to its label filter. Specs must match both the selected flows and any label filter passed to ginkgo.
+// If LIST_FLOWS is set, the selectors are printed and the suite is configured to list its specs without
+// running them.
+func FlowSelectionConfig(
+	selectors []FlowSelector,
+) (ginkgoTypes.SuiteConfig, ginkgoTypes.ReporterConfig, error) {
+	suiteConfig, reporterConfig := ginkgo.GinkgoConfiguration()
+
+	if runFlows := os.Getenv(RunFlowsEnvVar); runFlows != "" {
+		labelFilter, err := flowsLabelFilter(runFlows, selectors)
+		if err != nil {
+			return suiteConfig, reporterConfig, err
+		}
+		if suiteConfig.LabelFilter != "" {
+			labelFilter = fmt.Sprintf("(%s) && (%s)", suiteConfig.LabelFilter, labelFilter)
+		}
+		suiteConfig.LabelFilter = labelFilter
+	}
+
+	if os.Getenv(ListFlowsEnvVar) != "" {
+		printFlowSelectors(os.Stdout, selectors)
+		suiteConfig.DryRun = true
+		reporterConfig.Verbose = true
+	}
+	return suiteConfig, reporterConfig, nil
+}
+
+// Returns the label filter matching any of the comma separated flow selector names
+func flowsLabelFilter(runFlows string, selectors []FlowSelector) (string, error) {
+	var filters []string
+	for _, name := range strings.Split(runFlows, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		selector, ok := findFlowSelector(name, selectors)
+		if !ok {
+			names := make([]string, len(selectors))
+			for i, selector := range selectors {
+				names[i] = selector.Name
+			}
+			return "", fmt.Errorf(
+				"unknown flow %q in %s, expected one of %s",
+				name,
+				RunFlowsEnvVar,
+				strings.Join(names, ", "),
+			)
+		}
+		filters = append(filters, "("+selector.LabelFilter+")")
+	}
+	if len(filters) == 0 {
+		return "", fmt.Errorf("no flows selected in %s", RunFlowsEnvVar)
+	}
+	return strings.Join(filters, " || "), nil
+}
+
+func findFlowSelector(name string, selectors []FlowSelector) (FlowSelector, bool) {
+	for _, selector := range selectors {
+		if strings.EqualFold(selector.Name, name) {
+			return selector, true
+		}
+	}
+	return FlowSelector{}, false
+}
+
+func printFlowSelectors(w io.Writer, selectors []FlowSelector) {
+	if len(selectors) == 0 {
+		fmt.Fprintf(w, "No flows can be selected with %s in this suite\n\n", RunFlowsEnvVar)
+		return
+	}
+	fmt.Fprintf(w, "Flows that can be selected with %s, as a comma separated list:\n", RunFlowsEnvVar)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, selector := range selectors {
+		fmt.Fprintf(tw, "  %s\t%s\t(labels: %s)\n", selector.Name, selector.Description, selector.LabelFilter)
+	}
+	tw.Flush()
+	fmt.Fprintln(w)
+}
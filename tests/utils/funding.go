@@ -0,0 +1,157 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// BatchTransfer has no generated bindings, so it is deployed from the artifact built by forge in e2e_test.sh
+const batchTransferContractName = "BatchTransfer"
+
+// BatchTransfer holds no state, so a single deployment per chain is shared by every spec
+var batchTransfers = struct {
+	sync.Mutex
+	addresses map[ids.ID]common.Address
+}{addresses: make(map[ids.ID]common.Address)}
+
+// ApprovableToken is implemented by the bindings of any ERC20 token
+type ApprovableToken interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// GenerateFundedAccounts creates count accounts with NewAccountKey, and funds each of them with amount of the
+// native token in a single transaction. Returns the keys of the accounts.
+func GenerateFundedAccounts(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	count int,
+	amount *big.Int,
+) []*ecdsa.PrivateKey {
+	keys := make([]*ecdsa.PrivateKey, count)
+	recipients := make([]common.Address, count)
+	for i := range keys {
+		key := NewAccountKey()
+		keys[i] = key
+		recipients[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	FundAccounts(ctx, subnet, senderKey, recipients, amount)
+	return keys
+}
+
+// FundAccounts sends amount of the native token to each of the recipients in a single transaction,
+// rather than one transfer per recipient
+func FundAccounts(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	recipients []common.Address,
+	amount *big.Int,
+) *types.Receipt {
+	batchTransfer := getBatchTransfer(ctx, subnet, senderKey)
+	total := new(big.Int).Mul(amount, big.NewInt(int64(len(recipients))))
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = total
+			return batchTransfer.Transact(opts, "transferNative", recipients, amount)
+		},
+	)
+	log.Info(
+		"Funded accounts",
+		"recipients", len(recipients),
+		"amount", amount,
+		"blockchainID", subnet.BlockchainID,
+	)
+	return receipt
+}
+
+// FundAccountsERC20 sends amount of the ERC20 token to each of the recipients in two transactions,
+// approving the total amount and then transferring it to every recipient
+func FundAccountsERC20(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	tokenAddress common.Address,
+	token ApprovableToken,
+	recipients []common.Address,
+	amount *big.Int,
+) *types.Receipt {
+	batchTransfer := getBatchTransfer(ctx, subnet, senderKey)
+	total := new(big.Int).Mul(amount, big.NewInt(int64(len(recipients))))
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.Approve(opts, batchTransfer.address, total)
+		},
+	)
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return batchTransfer.Transact(opts, "transferERC20", tokenAddress, recipients, amount)
+		},
+	)
+	log.Info(
+		"Funded accounts with ERC20",
+		"token", tokenAddress,
+		"recipients", len(recipients),
+		"amount", amount,
+		"blockchainID", subnet.BlockchainID,
+	)
+	return receipt
+}
+
+type boundBatchTransfer struct {
+	*bind.BoundContract
+
+	address common.Address
+}
+
+// Returns the BatchTransfer deployed to the chain, deploying it from senderKey on first use
+func getBatchTransfer(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+) boundBatchTransfer {
+	batchTransfers.Lock()
+	defer batchTransfers.Unlock()
+	address, ok := batchTransfers.addresses[subnet.BlockchainID]
+	if !ok {
+		address = deployContractArtifact(ctx, senderKey, subnet, contractArtifactFile(batchTransferContractName))
+		// Any spec may use the shared deployment, so it is not recorded as deployed by the current spec
+		shareSpecContract(subnet, address)
+		batchTransfers.addresses[subnet.BlockchainID] = address
+		log.Info("Deployed BatchTransfer", "address", address, "blockchainID", subnet.BlockchainID)
+	}
+
+	artifact := loadContractArtifact(contractArtifactFile(batchTransferContractName))
+	return boundBatchTransfer{
+		BoundContract: bind.NewBoundContract(
+			address,
+			artifact.ABI,
+			subnet.RPCClient,
+			subnet.RPCClient,
+			subnet.RPCClient,
+		),
+		address: address,
+	}
+}
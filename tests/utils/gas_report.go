@@ -0,0 +1,324 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenDestination"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	examplewavax "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/ExampleWAVAX"
+	mockERC20SACR "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/MockERC20SendAndCallReceiver"
+	mockNSACR "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/MockNativeSendAndCallReceiver"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// GasReportDirEnvVar optionally sets the directory that the gas report of the suite is written to
+	GasReportDirEnvVar = "E2E_GAS_REPORT_DIR"
+	// GasReportBaselineEnvVar optionally sets the JSON gas report of an earlier run, such as of the base branch,
+	// that the markdown gas report is compared against
+	GasReportBaselineEnvVar = "E2E_GAS_REPORT_BASELINE"
+
+	gasReportJSONFile     = "gas-report.json"
+	gasReportMarkdownFile = "gas-report.md"
+
+	constructorFunction = "constructor"
+	// Reported for the deliveries of Teleporter messages to a contract, which call its receiveTeleporterMessage
+	deliveryFunction = "receiveTeleporterMessage"
+)
+
+// The contracts deployed from Go bindings, identified by the bytecode their deployment transactions start with
+var gasReportBindings = []struct {
+	name     string
+	metaData *bind.MetaData
+}{
+	{name: "ERC20Source", metaData: erc20source.ERC20SourceMetaData},
+	{name: "ERC20Destination", metaData: erc20destination.ERC20DestinationMetaData},
+	{name: "NativeTokenSource", metaData: nativetokensource.NativeTokenSourceMetaData},
+	{name: "NativeTokenDestination", metaData: nativetokendestination.NativeTokenDestinationMetaData},
+	{name: "ExampleWAVAX", metaData: examplewavax.ExampleWAVAXMetaData},
+	{name: "MockERC20SendAndCallReceiver", metaData: mockERC20SACR.MockERC20SendAndCallReceiverMetaData},
+	{name: "MockNativeSendAndCallReceiver", metaData: mockNSACR.MockNativeSendAndCallReceiverMetaData},
+}
+
+// A contract whose transactions are included in the gas report
+type gasReportContract struct {
+	name string
+	abi  *abi.ABI
+}
+
+// The gas used by a transaction sent to, or deploying, a contract
+type gasSample struct {
+	contract contractKey
+	// The first four bytes of the transaction's calldata, if it called the contract
+	selector []byte
+	function string
+	gasUsed  uint64
+}
+
+// The gas used by every transaction sent by the suite, and the contracts they were sent to. Samples are resolved
+// to the contracts' functions when the report is written, since contracts deployed from Foundry artifacts are
+// only named once their deployment is mined.
+var gasReport = struct {
+	sync.Mutex
+	contracts map[contractKey]gasReportContract
+	samples   []gasSample
+}{contracts: make(map[contractKey]gasReportContract)}
+
+// GasReportEntry aggregates the gas used by the calls to one function of a contract
+type GasReportEntry struct {
+	Contract string `json:"contract"`
+	Function string `json:"function"`
+	Calls    int    `json:"calls"`
+	Min      uint64 `json:"min"`
+	Mean     uint64 `json:"mean"`
+	Median   uint64 `json:"median"`
+	Max      uint64 `json:"max"`
+}
+
+// GasReport is the gas used by each contract function across every flow of a suite, sorted by contract and
+// function so that reports of different commits can be compared
+type GasReport struct {
+	Entries []GasReportEntry `json:"entries"`
+}
+
+func gasReportEnabled() bool {
+	return os.Getenv(GasReportDirEnvVar) != ""
+}
+
+// Records the gas used by a successful transaction. Deployments of contracts with Go bindings are named from
+// their bytecode.
+func recordGasSample(subnet interfaces.SubnetTestInfo, tx *types.Transaction, receipt *types.Receipt) {
+	if !gasReportEnabled() {
+		return
+	}
+	sample := gasSample{gasUsed: receipt.GasUsed}
+	if tx.To() == nil {
+		sample.contract = contractKey{blockchainID: subnet.BlockchainID, address: receipt.ContractAddress}
+		sample.function = constructorFunction
+		for _, binding := range gasReportBindings {
+			if bytes.HasPrefix(tx.Data(), common.FromHex(binding.metaData.Bin)) {
+				contractABI, err := binding.metaData.GetAbi()
+				Expect(err).Should(BeNil())
+				recordGasReportContract(subnet, receipt.ContractAddress, binding.name, contractABI)
+				break
+			}
+		}
+	} else {
+		sample.contract = contractKey{blockchainID: subnet.BlockchainID, address: *tx.To()}
+		if len(tx.Data()) >= 4 {
+			sample.selector = tx.Data()[:4]
+		}
+	}
+
+	gasReport.Lock()
+	defer gasReport.Unlock()
+	gasReport.samples = append(gasReport.samples, sample)
+}
+
+// Records the gas used by a successful delivery of a Teleporter message to the destination address
+func recordDeliveryGasSample(
+	destination interfaces.SubnetTestInfo,
+	destinationAddress common.Address,
+	receipt *types.Receipt,
+) {
+	if !gasReportEnabled() {
+		return
+	}
+	gasReport.Lock()
+	defer gasReport.Unlock()
+	gasReport.samples = append(gasReport.samples, gasSample{
+		contract: contractKey{blockchainID: destination.BlockchainID, address: destinationAddress},
+		function: deliveryFunction,
+		gasUsed:  receipt.GasUsed,
+	})
+}
+
+// Names the contract deployed at the address in the gas report, replacing any earlier name
+func recordGasReportContract(
+	subnet interfaces.SubnetTestInfo,
+	address common.Address,
+	name string,
+	contractABI *abi.ABI,
+) {
+	if !gasReportEnabled() {
+		return
+	}
+	gasReport.Lock()
+	defer gasReport.Unlock()
+	gasReport.contracts[contractKey{blockchainID: subnet.BlockchainID, address: address}] = gasReportContract{
+		name: name,
+		abi:  contractABI,
+	}
+}
+
+// WriteGasReport aggregates the gas used by each contract function across every spec of the suite, and writes it
+// to E2E_GAS_REPORT_DIR as gas-report.json and gas-report.md, if the directory is set. Only transactions sent to
+// contracts deployed by the suite are included, along with the deliveries of Teleporter messages to them. If
+// E2E_GAS_REPORT_BASELINE is set, the markdown report includes the change in the mean gas of each function.
+func WriteGasReport() {
+	reportDir := os.Getenv(GasReportDirEnvVar)
+	if reportDir == "" {
+		return
+	}
+	report := aggregateGasReport()
+	Expect(os.MkdirAll(reportDir, 0o755)).Should(Succeed())
+
+	reportJSON, err := json.MarshalIndent(report, "", "  ")
+	Expect(err).Should(BeNil())
+	jsonFile := filepath.Join(reportDir, gasReportJSONFile)
+	Expect(os.WriteFile(jsonFile, reportJSON, 0o644)).Should(Succeed())
+
+	var baseline *GasReport
+	if baselineFile := os.Getenv(GasReportBaselineEnvVar); baselineFile != "" {
+		baselineJSON, err := os.ReadFile(baselineFile)
+		Expect(err).Should(BeNil())
+		baseline = &GasReport{}
+		Expect(json.Unmarshal(baselineJSON, baseline)).Should(Succeed())
+	}
+	markdownFile := filepath.Join(reportDir, gasReportMarkdownFile)
+	Expect(os.WriteFile(markdownFile, []byte(report.Markdown(baseline)), 0o644)).Should(Succeed())
+	log.Info("Wrote gas report", "json", jsonFile, "markdown", markdownFile, "entries", len(report.Entries))
+}
+
+func aggregateGasReport() *GasReport {
+	gasReport.Lock()
+	defer gasReport.Unlock()
+
+	type functionKey struct {
+		contract string
+		function string
+	}
+	gasUsed := make(map[functionKey][]uint64)
+	for _, sample := range gasReport.samples {
+		contract, ok := gasReport.contracts[sample.contract]
+		if !ok {
+			continue
+		}
+		key := functionKey{contract: contract.name, function: sample.function}
+		if key.function == "" {
+			key.function = sampleFunction(contract, sample.selector)
+		}
+		gasUsed[key] = append(gasUsed[key], sample.gasUsed)
+	}
+
+	report := &GasReport{Entries: []GasReportEntry{}}
+	for key, samples := range gasUsed {
+		sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+		var total uint64
+		for _, sample := range samples {
+			total += sample
+		}
+		median := samples[len(samples)/2]
+		if len(samples)%2 == 0 {
+			median = (samples[len(samples)/2-1] + median) / 2
+		}
+		report.Entries = append(report.Entries, GasReportEntry{
+			Contract: key.contract,
+			Function: key.function,
+			Calls:    len(samples),
+			Min:      samples[0],
+			Mean:     total / uint64(len(samples)),
+			Median:   median,
+			Max:      samples[len(samples)-1],
+		})
+	}
+	sort.Slice(report.Entries, func(i, j int) bool {
+		if report.Entries[i].Contract != report.Entries[j].Contract {
+			return report.Entries[i].Contract < report.Entries[j].Contract
+		}
+		return report.Entries[i].Function < report.Entries[j].Function
+	})
+	return report
+}
+
+// Returns the name of the function of the contract with the selector. Transactions without calldata call the
+// contract's receive function, and unknown selectors are reported in hex.
+func sampleFunction(contract gasReportContract, selector []byte) string {
+	if len(selector) == 0 {
+		return "receive"
+	}
+	if method, err := contract.abi.MethodById(selector); err == nil {
+		return method.Name
+	}
+	return common.Bytes2Hex(selector)
+}
+
+// Markdown formats the report as a markdown table. If a baseline report is given, the change in each function's
+// mean gas from the baseline is included, and functions that are no longer called are listed.
+func (r *GasReport) Markdown(baseline *GasReport) string {
+	baselineMeans := make(map[string]uint64)
+	if baseline != nil {
+		for _, entry := range baseline.Entries {
+			baselineMeans[entry.Contract+"."+entry.Function] = entry.Mean
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("# Gas report\n\n")
+	b.WriteString("| Contract | Function | Calls | Min | Mean | Median | Max |")
+	if baseline != nil {
+		b.WriteString(" Mean change |")
+	}
+	b.WriteString("\n| --- | --- | ---: | ---: | ---: | ---: | ---: |")
+	if baseline != nil {
+		b.WriteString(" ---: |")
+	}
+	b.WriteString("\n")
+	for _, entry := range r.Entries {
+		fmt.Fprintf(&b, "| %s | %s | %d | %d | %d | %d | %d |",
+			entry.Contract, entry.Function, entry.Calls, entry.Min, entry.Mean, entry.Median, entry.Max)
+		if baseline != nil {
+			key := entry.Contract + "." + entry.Function
+			change := "new"
+			if baselineMean, ok := baselineMeans[key]; ok {
+				change = formatGasChange(baselineMean, entry.Mean)
+				delete(baselineMeans, key)
+			}
+			fmt.Fprintf(&b, " %s |", change)
+		}
+		b.WriteString("\n")
+	}
+
+	if len(baselineMeans) > 0 {
+		removed := make([]string, 0, len(baselineMeans))
+		for key := range baselineMeans {
+			removed = append(removed, key)
+		}
+		sort.Strings(removed)
+		b.WriteString("\nNo longer called:\n\n")
+		for _, key := range removed {
+			fmt.Fprintf(&b, "- %s\n", key)
+		}
+	}
+	return b.String()
+}
+
+func formatGasChange(baselineMean uint64, mean uint64) string {
+	if baselineMean == mean {
+		return "0"
+	}
+	if baselineMean == 0 {
+		return fmt.Sprintf("%+d", mean)
+	}
+	change := int64(mean) - int64(baselineMean)
+	return fmt.Sprintf("%+d (%+.2f%%)", change, float64(change)/float64(baselineMean)*100)
+}
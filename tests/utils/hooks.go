@@ -0,0 +1,122 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"math/big"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SendHookInfo describes a send of tokens by one of the Send or SendAndCall helpers
+type SendHookInfo struct {
+	Subnet                   interfaces.SubnetTestInfo
+	BridgeAddress            common.Address
+	Sender                   common.Address
+	DestinationBlockchainID  ids.ID
+	DestinationBridgeAddress common.Address
+	SendAndCall              bool
+	Amount                   *big.Int
+	// The fees of the send. BeforeSend hooks may replace them, but should not modify them in place,
+	// since they are shared with the caller's input.
+	PrimaryFee   *big.Int
+	SecondaryFee *big.Int
+}
+
+// ReceiveHookInfo describes the delivery of a Teleporter message relayed by RelayMessageWithKey,
+// including the messages relayed by the network passed to the flows
+type ReceiveHookInfo struct {
+	Source        interfaces.SubnetTestInfo
+	Destination   interfaces.SubnetTestInfo
+	SourceReceipt *types.Receipt
+	MessageID     ids.ID
+	Relayer       common.Address
+}
+
+// Hooks are called around each send and receive, for example to inject delays, record metrics, or change the fees
+// of sends, without modifying the flows. Any of the hooks may be nil. Hooks may fail the spec with gomega.
+type Hooks struct {
+	// Called before the send's transactions are built, with the send's fees as set by the flow
+	BeforeSend func(ctx context.Context, send *SendHookInfo)
+	// Called once the send's transaction is mined, with the fees it was sent with
+	AfterSend func(ctx context.Context, send SendHookInfo, receipt *types.Receipt)
+	// Called before the message's signatures are aggregated
+	BeforeReceive func(ctx context.Context, receive ReceiveHookInfo)
+	// Called once the transaction delivering the message is mined, whether or not it succeeded
+	AfterReceive func(ctx context.Context, receive ReceiveHookInfo, receipt *types.Receipt)
+}
+
+var registeredHooks = struct {
+	sync.RWMutex
+	nextID int
+	hooks  map[int]Hooks
+}{hooks: make(map[int]Hooks)}
+
+// RegisterHooks registers hooks to be called around every subsequent send and receive, in the order they were
+// registered. Returns a function unregistering them, which can be passed to ginkgo.DeferCleanup to limit the hooks
+// to a single spec.
+func RegisterHooks(hooks Hooks) func() {
+	registeredHooks.Lock()
+	defer registeredHooks.Unlock()
+	id := registeredHooks.nextID
+	registeredHooks.nextID++
+	registeredHooks.hooks[id] = hooks
+	return func() {
+		registeredHooks.Lock()
+		defer registeredHooks.Unlock()
+		delete(registeredHooks.hooks, id)
+	}
+}
+
+// Returns the registered hooks in the order they were registered
+func getHooks() []Hooks {
+	registeredHooks.RLock()
+	defer registeredHooks.RUnlock()
+	hooks := make([]Hooks, 0, len(registeredHooks.hooks))
+	for id := 0; id < registeredHooks.nextID; id++ {
+		if h, ok := registeredHooks.hooks[id]; ok {
+			hooks = append(hooks, h)
+		}
+	}
+	return hooks
+}
+
+// Runs the BeforeSend hooks, returning the send with any fees they replaced
+func beforeSend(ctx context.Context, send SendHookInfo) SendHookInfo {
+	for _, hooks := range getHooks() {
+		if hooks.BeforeSend != nil {
+			hooks.BeforeSend(ctx, &send)
+		}
+	}
+	return send
+}
+
+func afterSend(ctx context.Context, send SendHookInfo, receipt *types.Receipt) {
+	for _, hooks := range getHooks() {
+		if hooks.AfterSend != nil {
+			hooks.AfterSend(ctx, send, receipt)
+		}
+	}
+}
+
+func beforeReceive(ctx context.Context, receive ReceiveHookInfo) {
+	for _, hooks := range getHooks() {
+		if hooks.BeforeReceive != nil {
+			hooks.BeforeReceive(ctx, receive)
+		}
+	}
+}
+
+func afterReceive(ctx context.Context, receive ReceiveHookInfo, receipt *types.Receipt) {
+	for _, hooks := range getHooks() {
+		if hooks.AfterReceive != nil {
+			hooks.AfterReceive(ctx, receive, receipt)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// MalformedBridgeMessage is the message of a Teleporter message that a destination bridge cannot decode,
+// or does not accept
+type MalformedBridgeMessage struct {
+	Name    string
+	Message []byte
+}
+
+// MalformedBridgeMessages returns malformed variants of a single hop send of the amount to the recipient, which
+// are each expected to be rejected by a destination bridge: empty, truncated, and over-long encodings, messages
+// with a payload of the wrong type, and well formed messages of a type that destinations do not accept.
+func MalformedBridgeMessages(recipient common.Address, amount *big.Int) []MalformedBridgeMessage {
+	payload := PackSingleHopSendMessage(recipient, amount)
+	message := PackBridgeMessage(messages.SingleHopSend, payload)
+
+	// The BridgeMessage is encoded as the offset of the tuple, followed by the tuple's message type, the offset
+	// of its payload within the tuple, and the payload's length and contents
+	const wordSize = 32
+	tupleOffset := new(big.Int).SetBytes(message[:wordSize]).Uint64()
+	payloadOffset := new(big.Int).SetBytes(message[tupleOffset+wordSize : tupleOffset+2*wordSize]).Uint64()
+	payloadLengthOffset := tupleOffset + payloadOffset
+	overLongMessage := common.CopyBytes(message)
+	copy(
+		overLongMessage[payloadLengthOffset:payloadLengthOffset+wordSize],
+		common.LeftPadBytes(big.NewInt(int64(len(payload)+wordSize)).Bytes(), wordSize),
+	)
+
+	// Addresses are encoded left padded to a full word, so setting the padding makes the address invalid
+	dirtyPayload := common.CopyBytes(payload)
+	dirtyPayload[0] = 0xff
+
+	multiHopPayload, err := messages.EncodePayload(messages.MultiHopSendMessage{
+		Recipient:         recipient,
+		Amount:            amount,
+		SecondaryFee:      big.NewInt(0),
+		SecondaryGasLimit: big.NewInt(0),
+		MultiHopFallback:  recipient,
+	})
+	Expect(err).Should(BeNil())
+
+	return []MalformedBridgeMessage{
+		{Name: "empty message", Message: []byte{}},
+		{Name: "truncated message", Message: message[:len(message)-wordSize]},
+		{Name: "truncated payload", Message: PackBridgeMessage(messages.SingleHopSend, payload[:wordSize])},
+		{Name: "over-long payload length", Message: overLongMessage},
+		{Name: "unknown message type", Message: PackBridgeMessage(messages.MultiHopCall+1, payload)},
+		{Name: "payload of the wrong type", Message: PackBridgeMessage(messages.SingleHopCall, payload)},
+		{Name: "invalid recipient address", Message: PackBridgeMessage(messages.SingleHopSend, dirtyPayload)},
+		{Name: "multi-hop message type", Message: PackBridgeMessage(messages.MultiHopSend, multiHopPayload)},
+	}
+}
+
+// SendTeleporterMessage sends a Teleporter message with an arbitrary message to the destination address from
+// senderKey, with no fee. The message's origin sender is the address of senderKey, so it is only accepted by a
+// destination bridge whose token source is that address.
+// The message is sent through the latest Teleporter messenger registered on the source.
+func SendTeleporterMessage(
+	ctx context.Context,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	destinationAddress common.Address,
+	message []byte,
+	requiredGasLimit *big.Int,
+	senderKey *ecdsa.PrivateKey,
+) (*types.Receipt, ids.ID) {
+	_, messenger := LatestTeleporter(ctx, source)
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		source,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return messenger.SendCrossChainMessage(
+				opts,
+				teleportermessenger.TeleporterMessageInput{
+					DestinationBlockchainID: destination.BlockchainID,
+					DestinationAddress:      destinationAddress,
+					FeeInfo: teleportermessenger.TeleporterFeeInfo{
+						FeeTokenAddress: common.Address{},
+						Amount:          big.NewInt(0),
+					},
+					RequiredGasLimit:        requiredGasLimit,
+					AllowedRelayerAddresses: []common.Address{},
+					Message:                 message,
+				},
+			)
+		},
+	)
+	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, messenger.ParseSendCrossChainMessage)
+	Expect(err).Should(BeNil())
+	return receipt, ids.ID(event.MessageID)
+}
+
+// DeliverMalformedBridgeMessage sends the malformed message to the destination bridge from senderKey, and relays it.
+// The delivery is expected to succeed, but the message's execution to fail without the bridge emitting any events,
+// so that the message is left to be retried. Returns the delivered message.
+func DeliverMalformedBridgeMessage(
+	ctx context.Context,
+	network interfaces.Network,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	destinationBridgeAddress common.Address,
+	malformed MalformedBridgeMessage,
+	senderKey *ecdsa.PrivateKey,
+) teleportermessenger.TeleporterMessage {
+	sendReceipt, messageID := SendTeleporterMessage(
+		ctx,
+		source,
+		destination,
+		destinationBridgeAddress,
+		malformed.Message,
+		DefaultERC20RequiredGas,
+		senderKey,
+	)
+	receipt := network.RelayMessage(ctx, sendReceipt, source, destination, true)
+
+	failedEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		destination.TeleporterMessenger.ParseMessageExecutionFailed,
+	)
+	Expect(err).Should(BeNil(), "%s was executed", malformed.Name)
+	Expect(ids.ID(failedEvent.MessageID)).Should(Equal(messageID))
+	_, err = teleporterUtils.GetEventFromLogs(receipt.Logs, destination.TeleporterMessenger.ParseMessageExecuted)
+	Expect(err).ShouldNot(BeNil())
+	for _, receiptLog := range receipt.Logs {
+		Expect(receiptLog.Address).ShouldNot(
+			Equal(destinationBridgeAddress),
+			"bridge emitted an event executing %s", malformed.Name,
+		)
+	}
+	log.Info("Malformed bridge message rejected", "name", malformed.Name, "messageID", messageID)
+	return failedEvent.Message
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/metatx"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// The forwarder has no generated bindings, so it is deployed from the artifact built by forge in e2e_test.sh
+const erc20SendForwarderContractName = "ERC20SendForwarder"
+
+// DeployERC20SendForwarder deploys an ERC20SendForwarder, returning a client for relaying send requests to it
+func DeployERC20SendForwarder(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+) (common.Address, *metatx.Forwarder) {
+	address := deployContractArtifact(ctx, senderKey, subnet, contractArtifactFile(erc20SendForwarderContractName))
+	log.Info("Deployed ERC20SendForwarder", "address", address, "blockchainID", subnet.BlockchainID)
+	return address, metatx.NewForwarder(address, subnet.EVMChainID, subnet.RPCClient)
+}
+
+// RelaySendRequest submits the signed request to the forwarder with the relayer paying for gas,
+// and checks that the forwarder executed it
+func RelaySendRequest(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	forwarder *metatx.Forwarder,
+	request metatx.SendRequest,
+	signature []byte,
+	relayerKey *ecdsa.PrivateKey,
+) *types.Receipt {
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		relayerKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return forwarder.Relay(opts, request, signature)
+		},
+	)
+	setReceiptAttributes(span, receipt)
+	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, forwarder.ParseSendRequestExecuted)
+	Expect(err).Should(BeNil())
+	Expect(event.From).Should(Equal(request.From))
+	Expect(event.Bridge).Should(Equal(request.Bridge))
+	teleporterUtils.ExpectBigEqual(event.Nonce, request.Nonce)
+	Expect(event.Relayer).Should(Equal(crypto.PubkeyToAddress(relayerKey.PublicKey)))
+	return receipt
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	subnetevminterfaces "github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/subnet-evm/precompile/allowlist"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/nativeminter"
+	subnetevmutils "github.com/ava-labs/subnet-evm/utils"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+// NativeMinterAdmins returns the addresses of the NativeTokenDestinations deployed by DeployNativeTokenDestination,
+// which must be allowed to mint by the Native Minter precompile.
+func NativeMinterAdmins() []common.Address {
+	admins := make([]common.Address, 0, len(nativeTokenDestinationDeployerKeys))
+	for _, deployerKeyStr := range nativeTokenDestinationDeployerKeys {
+		deployerPK, err := crypto.HexToECDSA(deployerKeyStr)
+		Expect(err).Should(BeNil())
+		admins = append(admins, nativeTokenDestinationAddress(crypto.PubkeyToAddress(deployerPK.PublicKey)))
+	}
+	return admins
+}
+
+// NativeMinterConfig returns the Native Minter precompile config that allows every NativeTokenDestination deployed
+// by DeployNativeTokenDestination to mint, merged into the genesis config if there is one.
+// It returns whether the genesis config already allowed all of them.
+func NativeMinterConfig(genesisConfig *nativeminter.Config) (*nativeminter.Config, bool) {
+	if genesisConfig == nil {
+		return nativeminter.NewConfig(subnetevmutils.NewUint64(0), NativeMinterAdmins(), nil, nil, nil), false
+	}
+
+	allowed := make(map[common.Address]struct{})
+	for _, list := range [][]common.Address{
+		genesisConfig.AdminAddresses,
+		genesisConfig.ManagerAddresses,
+		genesisConfig.EnabledAddresses,
+	} {
+		for _, address := range list {
+			allowed[address] = struct{}{}
+		}
+	}
+	config := *genesisConfig
+	config.AdminAddresses = append([]common.Address{}, genesisConfig.AdminAddresses...)
+	for _, admin := range NativeMinterAdmins() {
+		if _, ok := allowed[admin]; !ok {
+			config.AdminAddresses = append(config.AdminAddresses, admin)
+		}
+	}
+	return &config, len(config.AdminAddresses) == len(genesisConfig.AdminAddresses)
+}
+
+// ApplyNativeMinterConfig returns a genesis file whose Native Minter precompile config allows every
+// NativeTokenDestination deployed by DeployNativeTokenDestination to mint. If the genesis file does not already allow
+// them, a copy of it with the config of NativeMinterConfig is written, and removed once the suite has finished.
+// It must be called from a suite node, such as BeforeSuite.
+func ApplyNativeMinterConfig(genesisFile string) string {
+	genesisJSON, err := os.ReadFile(genesisFile)
+	Expect(err).Should(BeNil())
+	var genesis map[string]json.RawMessage
+	Expect(json.Unmarshal(genesisJSON, &genesis)).Should(Succeed())
+	var chainConfig map[string]json.RawMessage
+	Expect(json.Unmarshal(genesis["config"], &chainConfig)).Should(Succeed())
+
+	var genesisConfig *nativeminter.Config
+	if configJSON, ok := chainConfig[nativeminter.ConfigKey]; ok {
+		genesisConfig = &nativeminter.Config{}
+		Expect(json.Unmarshal(configJSON, genesisConfig)).Should(Succeed())
+	}
+	config, applied := NativeMinterConfig(genesisConfig)
+	if applied {
+		return genesisFile
+	}
+
+	chainConfig[nativeminter.ConfigKey], err = json.Marshal(config)
+	Expect(err).Should(BeNil())
+	genesis["config"], err = json.Marshal(chainConfig)
+	Expect(err).Should(BeNil())
+	genesisJSON, err = json.MarshalIndent(genesis, "", "  ")
+	Expect(err).Should(BeNil())
+
+	appliedFile, err := os.CreateTemp(os.TempDir(), filepath.Base(genesisFile))
+	Expect(err).Should(BeNil())
+	ginkgo.DeferCleanup(os.Remove, appliedFile.Name())
+	_, err = appliedFile.Write(genesisJSON)
+	Expect(err).Should(BeNil())
+	Expect(appliedFile.Close()).Should(Succeed())
+	log.Info(
+		"Allowing NativeTokenDestinations to mint in the genesis",
+		"genesis", genesisFile,
+		"appliedGenesis", appliedFile.Name(),
+	)
+	return appliedFile.Name()
+}
+
+// ExpectNativeMinterEnabled fails unless the address is allowed to mint by the Native Minter precompile of the
+// subnet, so that a NativeTokenDestination that cannot mint fails when it is deployed, rather than when it first
+// receives tokens.
+func ExpectNativeMinterEnabled(ctx context.Context, subnet interfaces.SubnetTestInfo, address common.Address) {
+	Expect(nativeMinterRole(ctx, subnet, address).IsEnabled()).Should(
+		BeTrue(),
+		"%s is not allowed to mint by the Native Minter precompile on %s; add it to the %s of the genesis, "+
+			"for example with ApplyNativeMinterConfig",
+		address, subnet.BlockchainID, nativeminter.ConfigKey,
+	)
+}
+
+// Returns the role of the address in the allow list of the Native Minter precompile of the subnet
+func nativeMinterRole(ctx context.Context, subnet interfaces.SubnetTestInfo, address common.Address) allowlist.Role {
+	data, err := allowlist.PackReadAllowList(address)
+	Expect(err).Should(BeNil())
+	output, err := subnet.RPCClient.CallContract(ctx, subnetevminterfaces.CallMsg{
+		To:   &nativeminter.ContractAddress,
+		Data: data,
+	}, nil)
+	Expect(err).Should(BeNil())
+	// Calls to an inactive precompile succeed without output
+	Expect(output).Should(
+		HaveLen(common.HashLength),
+		"the Native Minter precompile is not enabled on %s", subnet.BlockchainID,
+	)
+	return allowlist.Role(common.BytesToHash(output))
+}
+
+// Returns the address of the NativeTokenDestination deployed by the deployer with its first transaction
+func nativeTokenDestinationAddress(deployer common.Address) common.Address {
+	return crypto.CreateAddress(deployer, 0)
+}
+
+// Fails unless the deployer has not sent any transactions on the subnet, so that the NativeTokenDestination it
+// deploys is at the address allowed to mint
+func expectUnusedDeployer(ctx context.Context, subnet interfaces.SubnetTestInfo, deployer common.Address) {
+	nonce, err := subnet.RPCClient.NonceAt(ctx, deployer, nil)
+	Expect(err).Should(BeNil())
+	Expect(nonce).Should(
+		BeZero(),
+		"deployer %s has already sent transactions on %s, so would not deploy to %s",
+		deployer, subnet.BlockchainID, nativeTokenDestinationAddress(deployer),
+	)
+}
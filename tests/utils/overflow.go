@@ -0,0 +1,97 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core/types"
+	subnetevminterfaces "github.com/ava-labs/subnet-evm/interfaces"
+	"github.com/ava-labs/subnet-evm/rpc"
+	teleportertokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenSource"
+	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+const maxSupplyERC20ContractName = "MaxSupplyERC20"
+
+// The revert data of Panic(0x11), which Solidity raises when checked arithmetic overflows or underflows
+var arithmeticOverflowRevert = append(
+	crypto.Keccak256([]byte("Panic(uint256)"))[:4],
+	common.LeftPadBytes([]byte{0x11}, common.HashLength)...,
+)
+
+// DeployMaxSupplyERC20 deploys a token whose entire supply of the maximum uint256 value is minted to the address
+// of senderKey, for flows bridging amounts close to the uint256 limit. The token is bound as an ExampleERC20,
+// since only its ERC20 functions are called.
+func DeployMaxSupplyERC20(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+) (common.Address, *exampleerc20.ExampleERC20) {
+	address := deployContractArtifact(ctx, senderKey, subnet, contractArtifactFile(maxSupplyERC20ContractName))
+	token, err := exampleerc20.NewExampleERC20(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	log.Info("Deployed MaxSupplyERC20", "address", address, "blockchainID", subnet.BlockchainID)
+	return address, token
+}
+
+// ExpectArithmeticOverflow checks that the call failed by reverting on checked arithmetic overflow, rather than
+// succeeding or reverting for another reason. The panic has no revert reason, so is identified by the revert
+// data returned by the node.
+func ExpectArithmeticOverflow(err error) {
+	Expect(err).ShouldNot(BeNil())
+	var dataErr rpc.DataError
+	Expect(errors.As(err, &dataErr)).Should(BeTrue(), "%v has no revert data", err)
+	Expect(dataErr.ErrorData()).Should(
+		Equal(hexutil.Encode(arithmeticOverflowRevert)),
+		"%v is not an arithmetic overflow", err,
+	)
+}
+
+// ExpectMessageExecutionOverflow checks that the delivery in the receipt failed to execute the Teleporter message
+// sent from source, and that the bridge reverted on checked arithmetic overflow, by calling the bridge with the
+// message from the TeleporterMessenger that executed it with eth_call. Returns the failed message, so that it can
+// be retried.
+func ExpectMessageExecutionOverflow(
+	ctx context.Context,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	receipt *types.Receipt,
+) teleportermessenger.TeleporterMessage {
+	failedEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		destination.TeleporterMessenger.ParseMessageExecutionFailed,
+	)
+	Expect(err).Should(BeNil(), "message was executed")
+	Expect(ids.ID(failedEvent.SourceBlockchainID)).Should(Equal(source.BlockchainID))
+
+	bridgeABI, err := teleportertokensource.TeleporterTokenSourceMetaData.GetAbi()
+	Expect(err).Should(BeNil())
+	callData, err := bridgeABI.Pack(
+		"receiveTeleporterMessage",
+		failedEvent.SourceBlockchainID,
+		failedEvent.Message.OriginSenderAddress,
+		failedEvent.Message.Message,
+	)
+	Expect(err).Should(BeNil())
+	_, err = destination.RPCClient.CallContract(ctx, subnetevminterfaces.CallMsg{
+		From: failedEvent.Raw.Address,
+		To:   &failedEvent.Message.DestinationAddress,
+		Data: callData,
+	}, nil)
+	ExpectArithmeticOverflow(err)
+	return failedEvent.Message
+}
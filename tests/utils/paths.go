@@ -0,0 +1,80 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// RepoRootEnvVar optionally sets the root of the repository that default paths are resolved against.
+	// Defaults to the root of the repository the suite was built from, so the suite can run from any directory.
+	RepoRootEnvVar = "E2E_REPO_ROOT"
+	// TeleporterByteCodeFileEnvVar optionally sets the Foundry artifact that Teleporter is deployed from
+	TeleporterByteCodeFileEnvVar = "E2E_TELEPORTER_BYTECODE_FILE"
+	// WarpGenesisFileEnvVar optionally sets the genesis file of the Subnets of the local network
+	WarpGenesisFileEnvVar = "E2E_WARP_GENESIS_FILE"
+	// ContractsOutDirEnvVar optionally sets the Foundry out directory that contracts without generated
+	// bindings are deployed from, for example to deploy an optimized build of them
+	ContractsOutDirEnvVar = "E2E_CONTRACTS_OUT_DIR"
+
+	defaultTeleporterByteCodeFile = "contracts/lib/teleporter/contracts/out/" +
+		"TeleporterMessenger.sol/TeleporterMessenger.json"
+	defaultWarpGenesisFile = "tests/utils/warp-genesis.json"
+	defaultContractsOutDir = "contracts/out"
+)
+
+// RepoPath returns the path relative to the root of the repository, or the path itself if it is absolute
+func RepoPath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(repoRoot(), path)
+}
+
+// PathFromEnv returns the path set by the environment variable, relative to the working directory,
+// or the default path relative to the root of the repository if it is not set
+func PathFromEnv(envVar string, defaultPath string) string {
+	if path := os.Getenv(envVar); path != "" {
+		absPath, err := filepath.Abs(path)
+		Expect(err).Should(BeNil())
+		return absPath
+	}
+	return RepoPath(defaultPath)
+}
+
+// TeleporterByteCodeFile returns the Foundry artifact that Teleporter is deployed from
+func TeleporterByteCodeFile() string {
+	return PathFromEnv(TeleporterByteCodeFileEnvVar, defaultTeleporterByteCodeFile)
+}
+
+// WarpGenesisFile returns the genesis file of the Subnets of the local network
+func WarpGenesisFile() string {
+	return PathFromEnv(WarpGenesisFileEnvVar, defaultWarpGenesisFile)
+}
+
+// Returns the Foundry artifact of the bridge contract, in the configured out directory
+func contractArtifactFile(contractName string) string {
+	outDir := PathFromEnv(ContractsOutDirEnvVar, defaultContractsOutDir)
+	return filepath.Join(outDir, contractName+".sol", contractName+".json")
+}
+
+func repoRoot() string {
+	if root := os.Getenv(RepoRootEnvVar); root != "" {
+		absRoot, err := filepath.Abs(root)
+		Expect(err).Should(BeNil())
+		return absRoot
+	}
+	// This file is at <root>/tests/utils/paths.go. Builds with -trimpath have no absolute source paths,
+	// in which case the suite must be run from the root of the repository.
+	_, file, _, ok := runtime.Caller(0)
+	if !ok || !filepath.IsAbs(file) {
+		return "."
+	}
+	return filepath.Dir(filepath.Dir(filepath.Dir(file)))
+}
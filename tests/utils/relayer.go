@@ -0,0 +1,371 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/tracing"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/gomega"
+)
+
+// RelayerPathEnvVar is the environment variable set to the path of the awm-relayer binary.
+// Flows delivering messages with the relayer are skipped if it is not set.
+const RelayerPathEnvVar = "AWM_RELAYER_PATH"
+
+const (
+	relayerAPIPort     = 8080
+	relayerMetricsPort = 9090
+
+	relayerStartupTimeout  = 30 * time.Second
+	relayerDeliveryTimeout = 60 * time.Second
+	relayerStopTimeout     = 10 * time.Second
+)
+
+// Native tokens sent to the relayer on each chain to pay for delivering messages
+var relayerFunding = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(10))
+
+// The subset of the awm-relayer configuration used by the tests
+type relayerConfig struct {
+	LogLevel               string                         `json:"log-level"`
+	PChainAPI              relayerAPIConfig               `json:"p-chain-api"`
+	InfoAPI                relayerAPIConfig               `json:"info-api"`
+	StorageLocation        string                         `json:"storage-location"`
+	ProcessMissedBlocks    bool                           `json:"process-missed-blocks"`
+	APIPort                uint16                         `json:"api-port"`
+	MetricsPort            uint16                         `json:"metrics-port"`
+	SourceBlockchains      []relayerSourceBlockchain      `json:"source-blockchains"`
+	DestinationBlockchains []relayerDestinationBlockchain `json:"destination-blockchains"`
+}
+
+type relayerAPIConfig struct {
+	BaseURL string `json:"base-url"`
+}
+
+type relayerSourceBlockchain struct {
+	SubnetID         string                                  `json:"subnet-id"`
+	BlockchainID     string                                  `json:"blockchain-id"`
+	VM               string                                  `json:"vm"`
+	RPCEndpoint      relayerAPIConfig                        `json:"rpc-endpoint"`
+	WSEndpoint       relayerAPIConfig                        `json:"ws-endpoint"`
+	MessageContracts map[string]relayerMessageContractConfig `json:"message-contracts"`
+}
+
+type relayerMessageContractConfig struct {
+	MessageFormat string            `json:"message-format"`
+	Settings      map[string]string `json:"settings"`
+}
+
+type relayerDestinationBlockchain struct {
+	SubnetID          string           `json:"subnet-id"`
+	BlockchainID      string           `json:"blockchain-id"`
+	VM                string           `json:"vm"`
+	RPCEndpoint       relayerAPIConfig `json:"rpc-endpoint"`
+	AccountPrivateKey string           `json:"account-private-key"`
+}
+
+// Relayer is an awm-relayer process delivering Teleporter messages between all of the chains of a local network
+type Relayer struct {
+	cmd  *exec.Cmd
+	done chan error
+	dir  string
+
+	// Address delivering messages on each chain
+	Address common.Address
+	// Address that relayer rewards are allocated to on each chain
+	RewardAddress common.Address
+}
+
+// RelayerAvailable returns whether an awm-relayer binary has been provided to start relayers with
+func RelayerAvailable() bool {
+	return os.Getenv(RelayerPathEnvVar) != ""
+}
+
+// StartRelayer funds a new relayer account on each chain of the network, and starts an awm-relayer delivering
+// the Teleporter messages sent between them using that account, with rewards allocated to rewardAddress.
+// Only messages sent after the relayer has started are delivered. The relayer should be stopped once it is no
+// longer needed, since it would otherwise deliver messages expected to be relayed by other flows.
+func StartRelayer(
+	ctx context.Context,
+	network interfaces.LocalNetwork,
+	rewardAddress common.Address,
+) *Relayer {
+	relayerPath := os.Getenv(RelayerPathEnvVar)
+	Expect(relayerPath).ShouldNot(BeEmpty(), "%s must be set to start a relayer", RelayerPathEnvVar)
+
+	relayerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+	relayerAddress := crypto.PubkeyToAddress(relayerKey.PublicKey)
+	_, fundedKey := network.GetFundedAccountInfo()
+	for _, subnet := range network.GetAllSubnetsInfo() {
+		teleporterUtils.SendNativeTransfer(ctx, subnet, fundedKey, relayerAddress, relayerFunding)
+	}
+
+	dir, err := os.MkdirTemp("", "awm-relayer")
+	Expect(err).Should(BeNil())
+	config := newRelayerConfig(ctx, network, relayerKey, rewardAddress, filepath.Join(dir, "storage"))
+	configBytes, err := json.MarshalIndent(config, "", "  ")
+	Expect(err).Should(BeNil())
+	configFile := filepath.Join(dir, "config.json")
+	Expect(os.WriteFile(configFile, configBytes, 0o600)).Should(Succeed())
+
+	cmd := exec.Command(relayerPath, "--config-file", configFile)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	Expect(cmd.Start()).Should(Succeed())
+	relayer := &Relayer{
+		cmd:           cmd,
+		done:          make(chan error, 1),
+		dir:           dir,
+		Address:       relayerAddress,
+		RewardAddress: rewardAddress,
+	}
+	go func() {
+		relayer.done <- cmd.Wait()
+	}()
+	log.Info("Started relayer", "pid", cmd.Process.Pid, "address", relayerAddress, "configFile", configFile)
+
+	relayer.waitForHealthy(ctx)
+	return relayer
+}
+
+// Stop interrupts the relayer, and waits for it to exit. The relayer is killed if it has not exited
+// within relayerStopTimeout, or once ctx is done.
+func (r *Relayer) Stop(ctx context.Context) {
+	defer os.RemoveAll(r.dir)
+	if err := r.cmd.Process.Signal(os.Interrupt); err != nil {
+		// The relayer has already exited
+		return
+	}
+	select {
+	case <-r.done:
+	case <-ctx.Done():
+		log.Warn("Context done before the relayer stopped, killing it", "pid", r.cmd.Process.Pid)
+		Expect(r.cmd.Process.Kill()).Should(Succeed())
+		<-r.done
+	case <-time.After(relayerStopTimeout):
+		log.Warn("Relayer did not stop in time, killing it", "pid", r.cmd.Process.Pid)
+		Expect(r.cmd.Process.Kill()).Should(Succeed())
+		<-r.done
+	}
+	log.Info("Stopped relayer", "pid", r.cmd.Process.Pid)
+}
+
+// Waits until the relayer has subscribed to each source chain, failing if it exits before then
+func (r *Relayer) waitForHealthy(ctx context.Context) {
+	healthURL := fmt.Sprintf("http://localhost:%d/health", relayerAPIPort)
+	Eventually(ctx, func() error {
+		select {
+		case err := <-r.done:
+			r.done <- err
+			return StopTrying("relayer exited").Wrap(err)
+		default:
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthURL, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("relayer health check returned status %d", resp.StatusCode)
+		}
+		return nil
+	}, relayerStartupTimeout, 500*time.Millisecond).Should(Succeed())
+}
+
+func newRelayerConfig(
+	ctx context.Context,
+	network interfaces.LocalNetwork,
+	relayerKey *ecdsa.PrivateKey,
+	rewardAddress common.Address,
+	storageLocation string,
+) relayerConfig {
+	primaryNodeURI := network.GetPrimaryNetworkInfo().NodeURIs[0]
+	config := relayerConfig{
+		LogLevel:            "info",
+		PChainAPI:           relayerAPIConfig{BaseURL: primaryNodeURI},
+		InfoAPI:             relayerAPIConfig{BaseURL: primaryNodeURI},
+		StorageLocation:     storageLocation,
+		ProcessMissedBlocks: false,
+		APIPort:             relayerAPIPort,
+		MetricsPort:         relayerMetricsPort,
+	}
+	for _, subnet := range network.GetAllSubnetsInfo() {
+		nodeURI := subnet.NodeURIs[0]
+		blockchainID := subnet.BlockchainID.String()
+		// Messages sent through every registered version of Teleporter are relayed, since contracts may not have
+		// moved to the latest version
+		messageContracts := make(map[string]relayerMessageContractConfig)
+		for _, teleporterAddress := range registeredTeleporters(ctx, subnet) {
+			messageContracts[teleporterAddress.Hex()] = relayerMessageContractConfig{
+				MessageFormat: "teleporter",
+				Settings:      map[string]string{"reward-address": rewardAddress.Hex()},
+			}
+		}
+		config.SourceBlockchains = append(config.SourceBlockchains, relayerSourceBlockchain{
+			SubnetID:         subnet.SubnetID.String(),
+			BlockchainID:     blockchainID,
+			VM:               "evm",
+			RPCEndpoint:      relayerAPIConfig{BaseURL: teleporterUtils.HttpToRPCURI(nodeURI, blockchainID)},
+			WSEndpoint:       relayerAPIConfig{BaseURL: teleporterUtils.HttpToWebsocketURI(nodeURI, blockchainID)},
+			MessageContracts: messageContracts,
+		})
+		config.DestinationBlockchains = append(config.DestinationBlockchains, relayerDestinationBlockchain{
+			SubnetID:          subnet.SubnetID.String(),
+			BlockchainID:      blockchainID,
+			VM:                "evm",
+			RPCEndpoint:       relayerAPIConfig{BaseURL: teleporterUtils.HttpToRPCURI(nodeURI, blockchainID)},
+			AccountPrivateKey: hex.EncodeToString(crypto.FromECDSA(relayerKey)),
+		})
+	}
+	return config
+}
+
+// WaitForDelivery waits for the Teleporter message sent in the source receipt to be delivered to the destination,
+// and returns the receipt of the transaction that delivered it. The delivery is awaited by subscribing to the
+// destination's ReceiveCrossChainMessage events, rather than by polling whether the message has been received.
+// The latency of the delivery is recorded for the suite's delivery latency report.
+func WaitForDelivery(
+	ctx context.Context,
+	sourceReceipt *types.Receipt,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+) *types.Receipt {
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		sourceReceipt.Logs,
+		source.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	messageID := sendEvent.MessageID
+
+	// Teleporter is deployed to the same address on every chain.
+	// Subscribe before checking for an earlier delivery, so that a delivery in between is not missed.
+	wsMessenger, err := teleportermessenger.NewTeleporterMessenger(sendEvent.Raw.Address, destination.WSClient)
+	Expect(err).Should(BeNil())
+	sink := make(chan *teleportermessenger.TeleporterMessengerReceiveCrossChainMessage, 1)
+	sub, err := wsMessenger.WatchReceiveCrossChainMessage(
+		&bind.WatchOpts{Context: ctx},
+		sink,
+		[][32]byte{messageID},
+		[][32]byte{source.BlockchainID},
+		nil,
+	)
+	Expect(err).Should(BeNil())
+	defer sub.Unsubscribe()
+
+	var txHash common.Hash
+	it, err := eventTeleporter(destination, sendEvent.Raw.Address).FilterReceiveCrossChainMessage(
+		&bind.FilterOpts{Context: ctx},
+		[][32]byte{messageID},
+		[][32]byte{source.BlockchainID},
+		nil,
+	)
+	Expect(err).Should(BeNil())
+	if it.Next() {
+		txHash = it.Event.Raw.TxHash
+	} else {
+		Expect(it.Error()).Should(BeNil())
+		select {
+		case event := <-sink:
+			txHash = event.Raw.TxHash
+		case err := <-sub.Err():
+			Expect(err).Should(BeNil())
+		case <-ctx.Done():
+			Expect(ctx.Err()).Should(BeNil(), "waiting for delivery of message %s", ids.ID(messageID))
+		case <-time.After(relayerDeliveryTimeout):
+		}
+	}
+	it.Close()
+	Expect(txHash).ShouldNot(
+		Equal(common.Hash{}),
+		"message %s was not delivered to %s",
+		ids.ID(messageID),
+		destination.BlockchainID,
+	)
+
+	receipt, err := destination.RPCClient.TransactionReceipt(ctx, txHash)
+	Expect(err).Should(BeNil())
+	Expect(receipt.Status).Should(Equal(types.ReceiptStatusSuccessful))
+	recordDeliveryLatency(ctx, source, destination, sourceReceipt, receipt)
+	return receipt
+}
+
+// MessageRelayer is a relayer delivering the Teleporter messages sent between the chains of a local network,
+// either an awm-relayer started by StartRelayer, or a bridge relayer started by StartBridgeRelayer
+type MessageRelayer interface {
+	Stop(ctx context.Context)
+}
+
+type relayerNetwork struct {
+	interfaces.LocalNetwork
+	relayer MessageRelayer
+}
+
+// NewRelayerNetwork wraps the network so that messages are delivered by the relayer rather than the test
+// application. RelayMessage waits for the relayer to deliver the message instead of delivering it itself.
+func NewRelayerNetwork(network interfaces.LocalNetwork, relayer MessageRelayer) interfaces.LocalNetwork {
+	return &relayerNetwork{
+		LocalNetwork: network,
+		relayer:      relayer,
+	}
+}
+
+// The funded account is not the relayer, so cannot relay messages itself without racing it
+func (n *relayerNetwork) SupportsIndependentRelaying() bool {
+	return false
+}
+
+// RelayMessage waits for the relayer to deliver the message. The relayer does not submit deliveries that would
+// revert, so only successful deliveries can be awaited.
+func (n *relayerNetwork) RelayMessage(
+	ctx context.Context,
+	sourceReceipt *types.Receipt,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	expectSuccess bool,
+) *types.Receipt {
+	Expect(expectSuccess).Should(BeTrue(), "failed deliveries cannot be awaited from the relayer")
+
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		sourceReceipt.Logs,
+		source.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	ctx, span := StartSpan(ctx, tracing.ReceiveSpan, trace.WithAttributes(tracing.MessageAttributes(
+		ids.ID(sendEvent.MessageID),
+		source.BlockchainID,
+		destination.BlockchainID,
+	)...))
+	defer span.End()
+
+	receipt := WaitForDelivery(ctx, sourceReceipt, source, destination)
+	setReceiptAttributes(span, receipt)
+	traceCall(ctx, receipt)
+	return receipt
+}
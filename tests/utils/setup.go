@@ -0,0 +1,223 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	teleporterregistry "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/upgrades/TeleporterRegistry"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	deploymentUtils "github.com/ava-labs/teleporter/utils/deployment-utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"golang.org/x/sync/errgroup"
+
+	. "github.com/onsi/gomega"
+)
+
+// Bounds the time taken to deploy the Teleporter contracts, since each chain waits for its transactions to be mined
+const teleporterDeploymentTimeout = 2 * time.Minute
+
+// Sent to the keyless Teleporter deployer on each chain, to pay for the Teleporter deployment
+var teleporterDeployerFundAmount = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(11))
+
+type teleporterRegistryInfo struct {
+	address  common.Address
+	registry *teleporterregistry.TeleporterRegistry
+}
+
+// DeployTeleporterContracts deploys Teleporter from the bytecode file, and a TeleporterRegistry with it
+// as version 1, to every chain of the network. Chains are independent of each other, so are set up concurrently.
+// Returns the network, with the TeleporterRegistry of each chain set in its subnet info.
+func DeployTeleporterContracts(
+	ctx context.Context,
+	network interfaces.LocalNetwork,
+	teleporterByteCodeFile string,
+) interfaces.LocalNetwork {
+	ctx, cancel := context.WithTimeout(ctx, teleporterDeploymentTimeout)
+	defer cancel()
+
+	// Generate the Teleporter deployment values, which are the same on every chain
+	teleporterDeployerTransaction, teleporterDeployerAddress,
+		teleporterContractAddress, err := deploymentUtils.ConstructKeylessTransaction(
+		CachedArtifactFile(teleporterByteCodeFile),
+		false,
+		deploymentUtils.GetDefaultContractCreationGasPrice(),
+	)
+	Expect(err).Should(BeNil())
+	deployerTx := new(types.Transaction)
+	Expect(deployerTx.UnmarshalBinary(teleporterDeployerTransaction)).Should(Succeed())
+
+	_, fundedKey := network.GetFundedAccountInfo()
+	subnets := network.GetAllSubnetsInfo()
+	registries := make([]teleporterRegistryInfo, len(subnets))
+	g, gctx := errgroup.WithContext(ctx)
+	for i, subnet := range subnets {
+		i, subnet := i, subnet
+		g.Go(func() error {
+			registry, err := deployTeleporterContractsToChain(
+				gctx,
+				subnet,
+				fundedKey,
+				deployerTx,
+				teleporterDeployerAddress,
+				teleporterContractAddress,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to deploy Teleporter contracts to %s: %w", subnet.BlockchainID, err)
+			}
+			registries[i] = registry
+			return nil
+		})
+	}
+	Expect(g.Wait()).Should(Succeed())
+
+	network.SetTeleporterContractAddress(teleporterContractAddress)
+	registryNetwork := &teleporterRegistryNetwork{
+		LocalNetwork: network,
+		registries:   make(map[ids.ID]teleporterRegistryInfo, len(subnets)),
+	}
+	for i, subnet := range subnets {
+		registryNetwork.registries[subnet.BlockchainID] = registries[i]
+	}
+	log.Info("Deployed Teleporter contracts to all subnets", "teleporterAddress", teleporterContractAddress)
+	return registryNetwork
+}
+
+// Funds the Teleporter deployer and deploys the TeleporterRegistry from the funded key, then deploys Teleporter
+// once the deployer is funded. Returns the TeleporterRegistry deployed to the chain.
+func deployTeleporterContractsToChain(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	fundedKey *ecdsa.PrivateKey,
+	deployerTx *types.Transaction,
+	deployerAddress common.Address,
+	teleporterAddress common.Address,
+) (teleporterRegistryInfo, error) {
+	// The funding and TeleporterRegistry transactions are sent without waiting for each other to be accepted,
+	// so their nonces are assigned explicitly, since bind would otherwise read the same accepted nonce for both
+	fundedAddress := crypto.PubkeyToAddress(fundedKey.PublicKey)
+	nonce, err := subnet.RPCClient.AcceptedNonceAt(ctx, fundedAddress)
+	if err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(fundedKey, subnet.EVMChainID)
+	if err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+	opts.Context = ctx
+
+	opts.Nonce = new(big.Int).SetUint64(nonce)
+	opts.Value = teleporterDeployerFundAmount
+	fundTx, err := bind.NewBoundContract(
+		deployerAddress,
+		abi.ABI{},
+		subnet.RPCClient,
+		subnet.RPCClient,
+		subnet.RPCClient,
+	).Transfer(opts)
+	if err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+
+	opts.Nonce = new(big.Int).SetUint64(nonce + 1)
+	opts.Value = nil
+	registryAddress, registryTx, registry, err := teleporterregistry.DeployTeleporterRegistry(
+		opts,
+		subnet.RPCClient,
+		[]teleporterregistry.ProtocolRegistryEntry{
+			{
+				Version:         big.NewInt(1),
+				ProtocolAddress: teleporterAddress,
+			},
+		},
+	)
+	if err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+
+	if err := waitForTransactionSuccess(ctx, subnet, fundTx); err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+	if err := subnet.RPCClient.SendTransaction(ctx, deployerTx); err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+	if err := waitForTransactionSuccess(ctx, subnet, deployerTx); err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+	teleporterCode, err := subnet.RPCClient.CodeAt(ctx, teleporterAddress, nil)
+	if err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+	if len(teleporterCode) == 0 {
+		return teleporterRegistryInfo{}, fmt.Errorf("no Teleporter code at %s", teleporterAddress)
+	}
+	if err := waitForTransactionSuccess(ctx, subnet, registryTx); err != nil {
+		return teleporterRegistryInfo{}, err
+	}
+
+	log.Info(
+		"Deployed Teleporter contracts",
+		"blockchainID", subnet.BlockchainID,
+		"teleporterRegistryAddress", registryAddress,
+	)
+	return teleporterRegistryInfo{address: registryAddress, registry: registry}, nil
+}
+
+// Waits for the transaction to be mined, returning an error rather than failing the spec,
+// so that it can be called from other goroutines than the spec's. Polls more frequently than bind.WaitMined.
+func waitForTransactionSuccess(ctx context.Context, subnet interfaces.SubnetTestInfo, tx *types.Transaction) error {
+	receipt, err := teleporterUtils.WaitMined(ctx, subnet.RPCClient, tx.Hash())
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s failed", tx.Hash())
+	}
+	return nil
+}
+
+// Wraps the network to set the TeleporterRegistry deployed to each chain in its subnet info
+type teleporterRegistryNetwork struct {
+	interfaces.LocalNetwork
+
+	registries map[ids.ID]teleporterRegistryInfo
+}
+
+func (n *teleporterRegistryNetwork) GetPrimaryNetworkInfo() interfaces.SubnetTestInfo {
+	return n.withRegistry(n.LocalNetwork.GetPrimaryNetworkInfo())
+}
+
+func (n *teleporterRegistryNetwork) GetSubnetsInfo() []interfaces.SubnetTestInfo {
+	return n.withRegistries(n.LocalNetwork.GetSubnetsInfo())
+}
+
+func (n *teleporterRegistryNetwork) GetAllSubnetsInfo() []interfaces.SubnetTestInfo {
+	return n.withRegistries(n.LocalNetwork.GetAllSubnetsInfo())
+}
+
+func (n *teleporterRegistryNetwork) withRegistries(subnets []interfaces.SubnetTestInfo) []interfaces.SubnetTestInfo {
+	for i := range subnets {
+		subnets[i] = n.withRegistry(subnets[i])
+	}
+	return subnets
+}
+
+func (n *teleporterRegistryNetwork) withRegistry(subnet interfaces.SubnetTestInfo) interfaces.SubnetTestInfo {
+	if registry, ok := n.registries[subnet.BlockchainID]; ok {
+		subnet.TeleporterRegistryAddress = registry.address
+		subnet.TeleporterRegistry = registry.registry
+	}
+	return subnet
+}
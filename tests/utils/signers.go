@@ -0,0 +1,73 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"crypto/ecdsa"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/ava-labs/teleporter-token-bridge/signer"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// MnemonicEnvVar optionally sets the mnemonic that the accounts of the suite are derived from
+	MnemonicEnvVar = "E2E_MNEMONIC"
+	// MnemonicFirstAccountEnvVar optionally sets the index of the first account derived from the mnemonic, so that
+	// runs sharing a mnemonic can use disjoint accounts
+	MnemonicFirstAccountEnvVar = "E2E_MNEMONIC_FIRST_ACCOUNT"
+	// MnemonicPassphraseEnvVar optionally sets the BIP-39 passphrase of the mnemonic, so that the mnemonic alone
+	// does not give access to the funds of the suite's accounts
+	MnemonicPassphraseEnvVar = "E2E_MNEMONIC_PASSPHRASE"
+)
+
+// The wallet of E2E_MNEMONIC, loaded the first time an account is needed, and the index of its next account
+var accountWallet = struct {
+	sync.Mutex
+	loaded bool
+	wallet *signer.HDWallet
+	next   uint32
+}{}
+
+// NewAccountKey returns the key of a new account. If E2E_MNEMONIC is set, the next account of its wallet is
+// derived, so that the accounts of a run are deterministic, and can be recovered from the mnemonic, for example to
+// sweep their funds after a run on Fuji. Otherwise, a random key is generated.
+func NewAccountKey() *ecdsa.PrivateKey {
+	accountWallet.Lock()
+	defer accountWallet.Unlock()
+	if !accountWallet.loaded {
+		loadAccountWallet()
+	}
+	if accountWallet.wallet == nil {
+		key, err := crypto.GenerateKey()
+		Expect(err).Should(BeNil())
+		return key
+	}
+	key, err := accountWallet.wallet.Key(accountWallet.next)
+	Expect(err).Should(BeNil())
+	accountWallet.next++
+	return key
+}
+
+func loadAccountWallet() {
+	accountWallet.loaded = true
+	mnemonic := os.Getenv(MnemonicEnvVar)
+	if mnemonic == "" {
+		return
+	}
+	wallet, err := signer.NewHDWallet(mnemonic, os.Getenv(MnemonicPassphraseEnvVar), signer.DefaultBasePath)
+	Expect(err).Should(BeNil(), "invalid %s", MnemonicEnvVar)
+	accountWallet.wallet = wallet
+	if first := os.Getenv(MnemonicFirstAccountEnvVar); first != "" {
+		index, err := strconv.ParseUint(first, 10, 32)
+		Expect(err).Should(BeNil(), "invalid %s", MnemonicFirstAccountEnvVar)
+		accountWallet.next = uint32(index)
+	}
+	log.Info("Deriving accounts from mnemonic", "path", signer.DefaultBasePath, "firstAccount", accountWallet.next)
+}
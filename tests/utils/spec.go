@@ -0,0 +1,161 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// Funded to the account of each spec on every chain. Covers the collateral of a few
+// NativeTokenDestinations with the default initial reserve imbalance, with plenty left for fees.
+var specAccountFundAmount = new(big.Int).Mul(big.NewInt(1e18), big.NewInt(5e5))
+
+type contractKey struct {
+	blockchainID ids.ID
+	address      common.Address
+}
+
+// Address book of the contracts deployed by each spec, and the spec currently running. A spec sending a
+// transaction to a contract deployed by another spec, for example through an address cached in a package
+// variable, fails rather than interfering with the other spec's contracts.
+var specContracts = struct {
+	sync.Mutex
+	currentSpec string
+	deployedBy  map[contractKey]string
+	// The contracts deployed by the current spec, in the order they were deployed
+	deployments []specDeployment
+}{deployedBy: make(map[contractKey]string)}
+
+// A contract deployed by the current spec
+type specDeployment struct {
+	subnet      interfaces.SubnetTestInfo
+	address     common.Address
+	blockNumber uint64
+}
+
+// SpecNetwork wraps the network shared by every spec, replacing its funded account with an account
+// funded for a single spec, so that the balances and nonces of one spec's account are not affected by others.
+type SpecNetwork struct {
+	interfaces.LocalNetwork
+
+	name       string
+	fundedKey  *ecdsa.PrivateKey
+	fundedAddr common.Address
+}
+
+// NewSpecNetwork creates and funds the account of the named spec on every chain of the shared network,
+// and starts recording the contracts the spec deploys. Close should be called when the spec ends.
+func NewSpecNetwork(ctx context.Context, network interfaces.LocalNetwork, name string) *SpecNetwork {
+	fundedKey := NewAccountKey()
+	fundedAddress := crypto.PubkeyToAddress(fundedKey.PublicKey)
+
+	_, sharedKey := network.GetFundedAccountInfo()
+	for _, subnet := range network.GetAllSubnetsInfo() {
+		teleporterUtils.SendNativeTransfer(ctx, subnet, sharedKey, fundedAddress, specAccountFundAmount)
+	}
+
+	specContracts.Lock()
+	specContracts.currentSpec = name
+	specContracts.deployments = nil
+	specContracts.Unlock()
+	resetSpecTransactions()
+	log.Info("Funded spec account", "spec", name, "address", fundedAddress)
+
+	return &SpecNetwork{
+		LocalNetwork: network,
+		name:         name,
+		fundedKey:    fundedKey,
+		fundedAddr:   fundedAddress,
+	}
+}
+
+// GetFundedAccountInfo returns the account funded for the spec
+func (n *SpecNetwork) GetFundedAccountInfo() (common.Address, *ecdsa.PrivateKey) {
+	return n.fundedAddr, n.fundedKey
+}
+
+// Close stops recording the contracts deployed by the spec. The contracts it deployed remain in the address book,
+// so that later specs cannot send transactions to them.
+func (n *SpecNetwork) Close() {
+	specContracts.Lock()
+	defer specContracts.Unlock()
+	if specContracts.currentSpec == n.name {
+		specContracts.currentSpec = ""
+	}
+}
+
+// Fails if the transaction is sent to a contract deployed by another spec than the current spec
+func expectSpecOwnsRecipient(subnet interfaces.SubnetTestInfo, tx *types.Transaction) {
+	if tx.To() == nil {
+		return
+	}
+	specContracts.Lock()
+	defer specContracts.Unlock()
+	if specContracts.currentSpec == "" {
+		return
+	}
+	deployedBy, ok := specContracts.deployedBy[contractKey{blockchainID: subnet.BlockchainID, address: *tx.To()}]
+	if !ok {
+		return
+	}
+	Expect(deployedBy).Should(
+		Equal(specContracts.currentSpec),
+		"transaction sent to contract %s on %s, which was deployed by another spec",
+		tx.To(),
+		subnet.BlockchainID,
+	)
+}
+
+// Records the contract deployed in the receipt, if any, as deployed by the current spec
+func recordSpecDeployment(subnet interfaces.SubnetTestInfo, receipt *types.Receipt) {
+	if receipt.ContractAddress == (common.Address{}) {
+		return
+	}
+	recordSpecContract(subnet, receipt.ContractAddress, receipt.BlockNumber.Uint64())
+}
+
+// Records the contract as deployed by the current spec at the block number. Contracts deployed by another contract
+// aren't in the receipt's contract address, so must be recorded with this directly.
+func recordSpecContract(subnet interfaces.SubnetTestInfo, address common.Address, blockNumber uint64) {
+	specContracts.Lock()
+	defer specContracts.Unlock()
+	if specContracts.currentSpec == "" {
+		return
+	}
+	key := contractKey{blockchainID: subnet.BlockchainID, address: address}
+	specContracts.deployedBy[key] = specContracts.currentSpec
+	specContracts.deployments = append(specContracts.deployments, specDeployment{
+		subnet:      subnet,
+		address:     address,
+		blockNumber: blockNumber,
+	})
+}
+
+// Returns the contracts deployed by the current spec
+func getSpecDeployments() []specDeployment {
+	specContracts.Lock()
+	defer specContracts.Unlock()
+	return append([]specDeployment(nil), specContracts.deployments...)
+}
+
+// Removes the contract from the address book, so that it may be used by any spec.
+// Only stateless helper contracts should be shared between specs.
+func shareSpecContract(subnet interfaces.SubnetTestInfo, address common.Address) {
+	specContracts.Lock()
+	defer specContracts.Unlock()
+	delete(specContracts.deployedBy, contractKey{blockchainID: subnet.BlockchainID, address: address})
+}
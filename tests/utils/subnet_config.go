@@ -0,0 +1,216 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	runner_sdk "github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/subnet-evm/commontype"
+	"github.com/ava-labs/subnet-evm/params"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/feemanager"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	"github.com/ava-labs/subnet-evm/precompile/modules"
+	"github.com/ava-labs/subnet-evm/rpc"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ava-labs/teleporter/tests/local"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/log"
+
+	// Registers the precompile modules, so that precompile configs can be parsed by their keys
+	_ "github.com/ava-labs/subnet-evm/precompile/registry"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// SubnetConfigFileEnvVar optionally sets a JSON file with the SubnetEVMConfig of each Subnet of the local
+	// network, as a list in the order of GetSubnetsInfo
+	SubnetConfigFileEnvVar = "E2E_SUBNET_CONFIG_FILE"
+
+	// The configuration is activated this long after it is applied. Nodes reject upgrades scheduled before their
+	// last accepted block, so it must be after the last block built before the nodes are restarted.
+	subnetConfigActivationDelay = 5 * time.Second
+)
+
+// SubnetEVMConfig configures a Subnet-EVM chain of the local network independently of the other Subnets, which
+// share a genesis. It is applied as a network upgrade of the chain.
+type SubnetEVMConfig struct {
+	// FeeConfig replaces the fee config of the chain, including its block gas limit. It is set as the initial
+	// fee config of the FeeManager precompile, which is enabled without any admins.
+	FeeConfig *commontype.FeeConfig `json:"feeConfig,omitempty"`
+	// EnabledPrecompiles are enabled with their configs, keyed as in a genesis file, such as txAllowListConfig.
+	// The block timestamp of each config is set when the configuration is applied.
+	EnabledPrecompiles params.Precompiles `json:"enabledPrecompiles,omitempty"`
+	// DisabledPrecompiles are the keys of precompiles enabled by the genesis to disable,
+	// such as contractNativeMinterConfig
+	DisabledPrecompiles []string `json:"disabledPrecompiles,omitempty"`
+}
+
+// IsEmpty returns whether the configuration leaves the chain as configured by the genesis
+func (c SubnetEVMConfig) IsEmpty() bool {
+	return c.FeeConfig == nil && len(c.EnabledPrecompiles) == 0 && len(c.DisabledPrecompiles) == 0
+}
+
+// Returns the network upgrade applying the configuration at the block timestamp
+func (c SubnetEVMConfig) upgradeBytes(blockTimestamp uint64) ([]byte, error) {
+	var upgrades []params.PrecompileUpgrade
+	for _, key := range c.DisabledPrecompiles {
+		if key == warp.ConfigKey {
+			return nil, fmt.Errorf("disabling %s would prevent Teleporter messages from being sent", key)
+		}
+		if _, ok := c.EnabledPrecompiles[key]; ok {
+			return nil, fmt.Errorf("precompile %s is both enabled and disabled", key)
+		}
+		upgrade, err := precompileUpgrade(key, map[string]interface{}{"blockTimestamp": blockTimestamp, "disable": true})
+		if err != nil {
+			return nil, err
+		}
+		upgrades = append(upgrades, upgrade)
+	}
+	// Sorted so that the upgrade is the same every time the configuration is applied
+	enabledKeys := make([]string, 0, len(c.EnabledPrecompiles))
+	for key := range c.EnabledPrecompiles {
+		enabledKeys = append(enabledKeys, key)
+	}
+	sort.Strings(enabledKeys)
+	for _, key := range enabledKeys {
+		configJSON, err := json.Marshal(c.EnabledPrecompiles[key])
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s: %w", key, err)
+		}
+		fields := make(map[string]interface{})
+		if err := json.Unmarshal(configJSON, &fields); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal %s: %w", key, err)
+		}
+		fields["blockTimestamp"] = blockTimestamp
+		upgrade, err := precompileUpgrade(key, fields)
+		if err != nil {
+			return nil, err
+		}
+		upgrades = append(upgrades, upgrade)
+	}
+	if c.FeeConfig != nil {
+		if _, ok := c.EnabledPrecompiles[feemanager.ConfigKey]; ok {
+			return nil, fmt.Errorf("the fee config and %s cannot both be set", feemanager.ConfigKey)
+		}
+		if err := c.FeeConfig.Verify(); err != nil {
+			return nil, fmt.Errorf("invalid fee config: %w", err)
+		}
+		upgrades = append(upgrades, params.PrecompileUpgrade{
+			Config: feemanager.NewConfig(&blockTimestamp, nil, nil, nil, c.FeeConfig),
+		})
+	}
+	return json.Marshal(params.UpgradeConfig{PrecompileUpgrades: upgrades})
+}
+
+// Parses the fields of the precompile's config, checking that the precompile exists
+func precompileUpgrade(key string, fields map[string]interface{}) (params.PrecompileUpgrade, error) {
+	if _, ok := modules.GetPrecompileModule(key); !ok {
+		return params.PrecompileUpgrade{}, fmt.Errorf("unknown precompile %s", key)
+	}
+	upgradeJSON, err := json.Marshal(map[string]interface{}{key: fields})
+	if err != nil {
+		return params.PrecompileUpgrade{}, fmt.Errorf("failed to marshal %s: %w", key, err)
+	}
+	var upgrade params.PrecompileUpgrade
+	if err := json.Unmarshal(upgradeJSON, &upgrade); err != nil {
+		return params.PrecompileUpgrade{}, fmt.Errorf("invalid %s: %w", key, err)
+	}
+	return upgrade, nil
+}
+
+// SubnetEVMConfigsFromEnv returns the configuration of each Subnet in the file set by E2E_SUBNET_CONFIG_FILE,
+// or nil if it is not set
+func SubnetEVMConfigsFromEnv() []SubnetEVMConfig {
+	configFile := os.Getenv(SubnetConfigFileEnvVar)
+	if configFile == "" {
+		return nil
+	}
+	configFile, err := filepath.Abs(configFile)
+	Expect(err).Should(BeNil())
+	configJSON, err := os.ReadFile(configFile)
+	Expect(err).Should(BeNil())
+	var configs []SubnetEVMConfig
+	Expect(json.Unmarshal(configJSON, &configs)).Should(Succeed())
+	return configs
+}
+
+// ConfigureSubnets applies the configuration of each Subnet of the network, in the order of GetSubnetsInfo, so that
+// Subnets can have different fee markets, block gas limits, and precompiles. The configuration is applied as a
+// network upgrade of each chain, by restarting every node of the network, and activated once the nodes are healthy.
+// Since network upgrades cannot be changed once activated, it must be called at most once, before any contracts
+// are deployed. The C-Chain cannot be configured.
+func ConfigureSubnets(ctx context.Context, network *local.LocalNetwork, configs []SubnetEVMConfig) {
+	subnets := network.GetSubnetsInfo()
+	Expect(len(configs)).Should(
+		BeNumerically("<=", len(subnets)),
+		"%d Subnet configs for %d Subnets", len(configs), len(subnets),
+	)
+
+	blockTimestamp := uint64(time.Now().Add(subnetConfigActivationDelay).Unix())
+	upgradeConfigs := make(map[string]string)
+	for i, config := range configs {
+		if config.IsEmpty() {
+			continue
+		}
+		upgradeBytes, err := config.upgradeBytes(blockTimestamp)
+		Expect(err).Should(BeNil(), "Subnet %d", i)
+		upgradeConfigs[subnets[i].BlockchainID.String()] = string(upgradeBytes)
+		log.Info(
+			"Configuring Subnet",
+			"blockchainID", subnets[i].BlockchainID,
+			"upgrade", string(upgradeBytes),
+		)
+	}
+	if len(upgradeConfigs) == 0 {
+		return
+	}
+
+	network.RestartNodes(ctx, network.GetAllNodeNames(), runner_sdk.WithUpgradeConfigs(upgradeConfigs))
+
+	// The upgrade is activated by the first block built after its timestamp
+	_, fundedKey := network.GetFundedAccountInfo()
+	for i, subnet := range network.GetSubnetsInfo() {
+		if i >= len(configs) || configs[i].IsEmpty() {
+			continue
+		}
+		AdvanceTimeTo(ctx, subnet, fundedKey, blockTimestamp+1)
+		expectSubnetConfigured(ctx, subnet, configs[i])
+	}
+}
+
+// Fails unless the configuration is active at the chain's latest block
+func expectSubnetConfigured(ctx context.Context, subnet interfaces.SubnetTestInfo, config SubnetEVMConfig) {
+	url := teleporterUtils.HttpToRPCURI(subnet.NodeURIs[0], subnet.BlockchainID.String())
+	rpcClient, err := rpc.DialContext(ctx, url)
+	Expect(err).Should(BeNil())
+	defer rpcClient.Close()
+
+	var activePrecompiles map[string]json.RawMessage
+	Expect(rpcClient.CallContext(ctx, &activePrecompiles, "eth_getActivePrecompilesAt", nil)).Should(Succeed())
+	for key := range config.EnabledPrecompiles {
+		Expect(activePrecompiles).Should(HaveKey(key), "precompile %s on %s", key, subnet.BlockchainID)
+	}
+	for _, key := range config.DisabledPrecompiles {
+		Expect(activePrecompiles).ShouldNot(HaveKey(key), "precompile %s on %s", key, subnet.BlockchainID)
+	}
+
+	if config.FeeConfig != nil {
+		var feeConfig struct {
+			FeeConfig commontype.FeeConfig `json:"feeConfig"`
+		}
+		Expect(rpcClient.CallContext(ctx, &feeConfig, "eth_feeConfig", nil)).Should(Succeed())
+		Expect(feeConfig.FeeConfig.Equal(config.FeeConfig)).Should(
+			BeTrue(),
+			"fee config of %s is %+v", subnet.BlockchainID, feeConfig.FeeConfig,
+		)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	runner_sdk "github.com/ava-labs/avalanche-network-runner/client"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// The avalanche-network-runner server that the nodes of the local network are managed through
+const networkRunnerEndpoint = "0.0.0.0:12352"
+
+// HaltSubnet stops each node of the local network validating the subnet, keeping its data and ports, and waits for
+// the subnet's RPC endpoint to stop responding. It returns the names of the halted nodes, which are brought back
+// with network.RestartNodes, resuming from the blocks they had accepted. The local network's subnets are validated
+// by disjoint nodes, so other subnets keep building blocks, but the nodes also validate the primary network, so the
+// C-Chain may stall until they are restarted.
+func HaltSubnet(ctx context.Context, subnet interfaces.SubnetTestInfo) []string {
+	client, err := runner_sdk.New(runner_sdk.Config{
+		Endpoint:    networkRunnerEndpoint,
+		DialTimeout: 10 * time.Second,
+	}, logging.NoLog{})
+	Expect(err).Should(BeNil())
+	defer client.Close()
+
+	status, err := client.Status(ctx)
+	Expect(err).Should(BeNil())
+	subnetURIs := make(map[string]struct{}, len(subnet.NodeURIs))
+	for _, uri := range subnet.NodeURIs {
+		subnetURIs[uri] = struct{}{}
+	}
+	var nodeNames []string
+	for name, info := range status.GetClusterInfo().GetNodeInfos() {
+		if _, ok := subnetURIs[info.GetUri()]; ok {
+			nodeNames = append(nodeNames, name)
+		}
+	}
+	Expect(nodeNames).Should(HaveLen(len(subnet.NodeURIs)), "finding the nodes of subnet %s", subnet.SubnetID)
+	sort.Strings(nodeNames)
+
+	for _, name := range nodeNames {
+		_, err := client.PauseNode(ctx, name)
+		Expect(err).Should(BeNil())
+	}
+	Eventually(func() error {
+		_, err := subnet.RPCClient.BlockNumber(ctx)
+		return err
+	}, 30*time.Second, time.Second).ShouldNot(BeNil(), "waiting for subnet %s to halt", subnet.SubnetID)
+	log.Info("Halted subnet", "subnetID", subnet.SubnetID, "nodeNames", nodeNames)
+	return nodeNames
+}
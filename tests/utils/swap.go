@@ -0,0 +1,96 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi"
+	exampleerc20swapreceiver "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/ExampleERC20SwapReceiver"
+	mockdex "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/MockDEX"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// The swap example is deployed from the artifacts built by forge in e2e_test.sh
+const (
+	mockDEXContractName = "MockDEX"
+
+	exampleERC20SwapReceiverContractName = "ExampleERC20SwapReceiver"
+)
+
+// SwapPayload mirrors the SwapPayload struct defined in ExampleERC20SwapReceiver.sol.
+// abigen does not generate bindings for structs that are not used in a contract's ABI,
+// so this must be kept up-to-date with the contract definition manually.
+type SwapPayload struct {
+	TokenOut     common.Address
+	MinAmountOut *big.Int
+	Recipient    common.Address
+}
+
+var swapPayloadType abi.Type
+
+func init() {
+	var err error
+	swapPayloadType, err = abi.NewType("tuple", "struct SwapPayload", []abi.ArgumentMarshaling{
+		{Name: "tokenOut", Type: "address"},
+		{Name: "minAmountOut", Type: "uint256"},
+		{Name: "recipient", Type: "address"},
+	})
+	if err != nil {
+		panic(fmt.Sprintf("failed to create SwapPayload ABI type: %v", err))
+	}
+}
+
+// PackSwapPayload ABI encodes a SwapPayload, for use as the recipient payload of a sendAndCall
+// to ExampleERC20SwapReceiver
+func PackSwapPayload(payload SwapPayload) []byte {
+	args := abi.Arguments{{Name: "swapPayload", Type: swapPayloadType}}
+	packed, err := args.Pack(payload)
+	Expect(err).Should(BeNil())
+	return packed
+}
+
+func DeployMockDEX(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+) (common.Address, *mockdex.MockDEX) {
+	address := deployContractArtifact(ctx, senderKey, subnet, contractArtifactFile(mockDEXContractName))
+	contract, err := mockdex.NewMockDEX(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	log.Info("Deployed MockDEX contract", "address", address.Hex(), "blockchainID", subnet.BlockchainID)
+
+	return address, contract
+}
+
+func DeployExampleERC20SwapReceiver(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	dexAddress common.Address,
+) (common.Address, *exampleerc20swapreceiver.ExampleERC20SwapReceiver) {
+	address := deployContractArtifact(
+		ctx,
+		senderKey,
+		subnet,
+		contractArtifactFile(exampleERC20SwapReceiverContractName),
+		dexAddress,
+	)
+	contract, err := exampleerc20swapreceiver.NewExampleERC20SwapReceiver(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	log.Info(
+		"Deployed ExampleERC20SwapReceiver contract",
+		"address", address.Hex(),
+		"blockchainID", subnet.BlockchainID,
+	)
+
+	return address, contract
+}
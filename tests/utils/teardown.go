@@ -0,0 +1,221 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ava-labs/avalanche-network-runner/utils/constants"
+	"github.com/ava-labs/teleporter/tests/local"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/onsi/ginkgo/v2"
+
+	. "github.com/onsi/gomega"
+)
+
+const (
+	// Bounds the time that processes started by the suite may take to exit once the network is torn down,
+	// before they are killed
+	suiteProcessExitTimeout = 30 * time.Second
+	// Bounds the time that goroutines started by the suite may take to exit once the network is torn down
+	suiteGoroutineExitTimeout = 10 * time.Second
+)
+
+// The prefixes of the temporary files and directories that the suite creates and is expected to remove: the chain
+// config written by the local network, and the working directories of relayers
+var suiteTempPrefixes = []string{"config.json", "awm-relayer", "bridge-relayer"}
+
+// The resources that existed before the suite started, recorded by RecordSuiteResources
+var suiteBaseline struct {
+	recorded    bool
+	goroutines  int
+	tempEntries map[string]struct{}
+	nodeDirs    map[string]struct{}
+}
+
+// RecordSuiteResources records the goroutines and temporary files that exist before the suite starts, so that
+// TearDownSuite can tell those the suite leaked from those it did not create. It should be called first thing in
+// BeforeSuite.
+func RecordSuiteResources() {
+	suiteBaseline.recorded = true
+	suiteBaseline.goroutines = runtime.NumGoroutine()
+	suiteBaseline.tempEntries = listTempEntries(os.TempDir(), suiteTempPrefixes)
+	suiteBaseline.nodeDirs = listTempEntries(nodeRootDir(), nil)
+}
+
+// TearDownSuite tears down the network, if it was created, and checks that the suite left nothing running or on
+// disk. Node and relayer processes started by the suite that are still running once the network is torn down are
+// killed, and temporary files the suite should have removed are removed, and each is reported as a leak, failing
+// the suite. The nodes' data directories are removed if the suite passed, and are otherwise kept for their logs.
+// Goroutines started by the suite that outlive it are reported, but do not fail the suite.
+// Every check runs even if tearing down the network fails, so that failed runs do not leave orphaned nodes.
+func TearDownSuite(network *local.LocalNetwork, suiteFailed bool) {
+	var leaks []string
+	if network != nil {
+		for _, subnet := range network.GetAllSubnetsInfo() {
+			subnet.RPCClient.Close()
+			subnet.WSClient.Close()
+		}
+		for _, failure := range InterceptGomegaFailures(network.TearDownNetwork) {
+			leaks = append(leaks, "failed to tear down network: "+failure)
+		}
+	}
+
+	leaks = append(leaks, killSuiteProcesses()...)
+	if suiteBaseline.recorded {
+		leaks = append(leaks, removeSuiteTempEntries(suiteFailed)...)
+		reportLeakedGoroutines()
+	}
+	Expect(leaks).Should(BeEmpty(), "the suite leaked resources, which have been cleaned up")
+}
+
+// Waits for the processes started by the suite to exit, killing those that do not and returning them as leaks
+func killSuiteProcesses() []string {
+	deadline := time.Now().Add(suiteProcessExitTimeout)
+	processes := descendantProcesses()
+	for len(processes) > 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Second)
+		processes = descendantProcesses()
+	}
+
+	var leaks []string
+	for pid, command := range processes {
+		log.Warn("Killing process left running by the suite", "pid", pid, "command", command)
+		leaks = append(leaks, fmt.Sprintf("process %d (%s) was still running", pid, command))
+		process, err := os.FindProcess(pid)
+		if err != nil {
+			continue
+		}
+		if err := process.Kill(); err != nil && !strings.Contains(err.Error(), "process already finished") {
+			log.Warn("Failed to kill process", "pid", pid, "err", err)
+		}
+	}
+	return leaks
+}
+
+// Returns the command of every running descendant of the suite's process, by pid
+func descendantProcesses() map[int]string {
+	cmd := exec.Command("ps", "-A", "-o", "pid=", "-o", "ppid=", "-o", "comm=")
+	output, err := cmd.Output()
+	if err != nil {
+		log.Warn("Failed to list processes", "err", err)
+		return nil
+	}
+
+	children := make(map[int][]int)
+	commands := make(map[int]string)
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 {
+			continue
+		}
+		pid, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		ppid, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		children[ppid] = append(children[ppid], pid)
+		commands[pid] = strings.Join(fields[2:], " ")
+	}
+
+	descendants := make(map[int]string)
+	pending := []int{os.Getpid()}
+	for len(pending) > 0 {
+		pid := pending[0]
+		pending = pending[1:]
+		for _, child := range children[pid] {
+			// The ps process listing the processes has exited by now
+			if child == cmd.Process.Pid {
+				continue
+			}
+			descendants[child] = commands[child]
+			pending = append(pending, child)
+		}
+	}
+	return descendants
+}
+
+// Removes the temporary files and directories created by the suite, returning those it should have removed as
+// leaks. The nodes' data directories are kept if the suite failed.
+func removeSuiteTempEntries(suiteFailed bool) []string {
+	var leaks []string
+	for entry := range listTempEntries(os.TempDir(), suiteTempPrefixes) {
+		if _, ok := suiteBaseline.tempEntries[entry]; ok {
+			continue
+		}
+		leaks = append(leaks, fmt.Sprintf("temporary file %s was not removed", entry))
+		if err := os.RemoveAll(entry); err != nil {
+			log.Warn("Failed to remove temporary file", "path", entry, "err", err)
+		}
+	}
+
+	for dir := range listTempEntries(nodeRootDir(), nil) {
+		if _, ok := suiteBaseline.nodeDirs[dir]; ok {
+			continue
+		}
+		if suiteFailed {
+			log.Info("Keeping the data directory of the failed suite's nodes", "path", dir)
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warn("Failed to remove the nodes' data directory", "path", dir, "err", err)
+		}
+	}
+	return leaks
+}
+
+// The directory that the avalanche-network-runner creates the data directories of the nodes of each network in
+func nodeRootDir() string {
+	return filepath.Join(os.TempDir(), constants.RootDirPrefix)
+}
+
+// Returns the paths of the entries of the directory with any of the prefixes, or every entry if there are none
+func listTempEntries(dir string, prefixes []string) map[string]struct{} {
+	entries, err := os.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		log.Warn("Failed to list temporary files", "dir", dir, "err", err)
+	}
+	paths := make(map[string]struct{})
+	for _, entry := range entries {
+		matched := len(prefixes) == 0
+		for _, prefix := range prefixes {
+			matched = matched || strings.HasPrefix(entry.Name(), prefix)
+		}
+		if matched {
+			paths[filepath.Join(dir, entry.Name())] = struct{}{}
+		}
+	}
+	return paths
+}
+
+// Waits for the goroutines started by the suite to exit, and reports the stacks of any that remain
+func reportLeakedGoroutines() {
+	deadline := time.Now().Add(suiteGoroutineExitTimeout)
+	for runtime.NumGoroutine() > suiteBaseline.goroutines && time.Now().Before(deadline) {
+		time.Sleep(100 * time.Millisecond)
+	}
+	leaked := runtime.NumGoroutine() - suiteBaseline.goroutines
+	if leaked <= 0 {
+		return
+	}
+	log.Warn("Goroutines started by the suite are still running", "count", leaked)
+	fmt.Fprintf(ginkgo.GinkgoWriter, "%d goroutines started by the suite are still running:\n", leaked)
+	if err := pprof.Lookup("goroutine").WriteTo(ginkgo.GinkgoWriter, 1); err != nil {
+		log.Warn("Failed to write goroutine stacks", "err", err)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/events"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	teleporterregistry "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/upgrades/TeleporterRegistry"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// BridgeTeleporter returns the Teleporter messenger that the bridge contract on the subnet sends messages
+// through, resolved through the TeleporterRegistry configured on the contract, rather than the network's
+// Teleporter address
+func BridgeTeleporter(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	bridgeAddress common.Address,
+) (*bridge.Teleporter, *teleportermessenger.TeleporterMessenger) {
+	teleporter, err := bridge.ResolveTeleporter(ctx, bridge.Endpoint{
+		Chain: &events.Chain{
+			BlockchainID: subnet.BlockchainID,
+			Client:       subnet.RPCClient,
+		},
+		Address: bridgeAddress,
+	})
+	Expect(err).Should(BeNil())
+	messenger, err := teleportermessenger.NewTeleporterMessenger(teleporter.Address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return teleporter, messenger
+}
+
+// LatestTeleporter returns the latest Teleporter messenger registered with the subnet's TeleporterRegistry, for
+// sending messages from accounts that are not bridge contracts
+func LatestTeleporter(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+) (common.Address, *teleportermessenger.TeleporterMessenger) {
+	address, err := subnet.TeleporterRegistry.GetLatestTeleporter(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	messenger, err := teleportermessenger.NewTeleporterMessenger(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, messenger
+}
+
+// DeployTeleporterRegistry deploys a TeleporterRegistry to the subnet with the Teleporter messenger as its only
+// version, and returns the subnet info with it as the subnet's registry. Bridge contracts deployed with the returned
+// info resolve Teleporter through the new registry, so flows can register new versions with it without changing
+// the Teleporter version of the rest of the suite.
+func DeployTeleporterRegistry(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	teleporterAddress common.Address,
+) interfaces.SubnetTestInfo {
+	var address common.Address
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, _, err = teleporterregistry.DeployTeleporterRegistry(
+				opts,
+				subnet.RPCClient,
+				[]teleporterregistry.ProtocolRegistryEntry{
+					{
+						Version:         big.NewInt(1),
+						ProtocolAddress: teleporterAddress,
+					},
+				},
+			)
+			return tx, err
+		},
+	)
+	log.Info("Deployed TeleporterRegistry", "address", address, "blockchainID", subnet.BlockchainID)
+	return WithTeleporterRegistry(subnet, address)
+}
+
+// WithTeleporterRegistry returns the subnet info with the TeleporterRegistry at registryAddress as the subnet's
+// registry, such as to re-apply a registry from DeployTeleporterRegistry to subnet infos fetched from the network
+func WithTeleporterRegistry(
+	subnet interfaces.SubnetTestInfo,
+	registryAddress common.Address,
+) interfaces.SubnetTestInfo {
+	registry, err := teleporterregistry.NewTeleporterRegistry(registryAddress, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	subnet.TeleporterRegistryAddress = registryAddress
+	subnet.TeleporterRegistry = registry
+	return subnet
+}
+
+// Returns every Teleporter messenger registered with the subnet's TeleporterRegistry, oldest version first.
+// Messages may be sent through any of them, by contracts whose registry has not moved to the latest version.
+// Versions may be skipped when registered, so versions without a messenger are ignored.
+func registeredTeleporters(ctx context.Context, subnet interfaces.SubnetTestInfo) []common.Address {
+	opts := &bind.CallOpts{Context: ctx}
+	latestVersion, err := subnet.TeleporterRegistry.LatestVersion(opts)
+	Expect(err).Should(BeNil())
+	var addresses []common.Address
+	for version := big.NewInt(1); version.Cmp(latestVersion) <= 0; version = new(big.Int).Add(version, big.NewInt(1)) {
+		address, err := subnet.TeleporterRegistry.GetAddressFromVersion(opts, version)
+		if err != nil {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+	return addresses
+}
+
+// Returns a binding of the Teleporter messenger that emitted the event, on the given chain. Teleporter is
+// deployed to the same address on every chain, so a message sent through a messenger is delivered to, and
+// executed by, the messenger at the same address on the destination.
+func eventTeleporter(
+	subnet interfaces.SubnetTestInfo,
+	eventAddress common.Address,
+) *teleportermessenger.TeleporterMessenger {
+	messenger, err := teleportermessenger.NewTeleporterMessenger(eventAddress, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return messenger
+}
@@ -1,12 +1,14 @@
 package utils
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
 	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
 	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
 	"github.com/ethereum/go-ethereum/common"
 
 	. "github.com/onsi/gomega"
@@ -19,7 +21,7 @@ func ApplyTokenScaling(
 	multiplyOnDestination bool,
 	sourceTokenAmount *big.Int,
 ) *big.Int {
-	return scaleTokens(tokenMultiplier, multiplyOnDestination, sourceTokenAmount, true)
+	return tokenscaling.ApplyTokenScale(tokenMultiplier, multiplyOnDestination, sourceTokenAmount)
 }
 
 // RemoveTokenScaling removes token scaling from the given amount of destination tokens.
@@ -29,34 +31,20 @@ func RemoveTokenScaling(
 	multiplyOnDestination bool,
 	destinationTokenAmount *big.Int,
 ) *big.Int {
-	return scaleTokens(tokenMultiplier, multiplyOnDestination, destinationTokenAmount, false)
-}
-
-func scaleTokens(
-	tokenMultiplier *big.Int,
-	multiplyOnDestination bool,
-	amount *big.Int,
-	isSendToDestination bool,
-) *big.Int {
-	// Multiply when multiplyOnDestination and isSendToDestination are
-	// both true or both false.
-	if multiplyOnDestination == isSendToDestination {
-		return big.NewInt(0).Mul(amount, tokenMultiplier)
-	}
-
-	return big.NewInt(0).Div(amount, tokenMultiplier)
+	return tokenscaling.RemoveTokenScale(tokenMultiplier, multiplyOnDestination, destinationTokenAmount)
 }
 
 // GetScaledAmountFromERC20Source returns the scaled amount of destination tokens that
 // will be sent to the destination bridge for an amount of source tokens.
 func GetScaledAmountFromERC20Source(
+	ctx context.Context,
 	erc20Source *erc20source.ERC20Source,
 	destinationBlockchainID ids.ID,
 	destinationBridgeAddress common.Address,
 	sourceTokenAmount *big.Int,
 ) *big.Int {
 	destinationSettings, err := erc20Source.RegisteredDestinations(
-		&bind.CallOpts{},
+		&bind.CallOpts{Context: ctx},
 		destinationBlockchainID,
 		destinationBridgeAddress,
 	)
@@ -72,13 +60,14 @@ func GetScaledAmountFromERC20Source(
 // GetScaledAmountFromNativeTokenSource returns the scaled amount of tokens that will be sent to the destination bridge
 // for corresponding amount of source tokens.
 func GetScaledAmountFromNativeTokenSource(
+	ctx context.Context,
 	nativeTokenSource *nativetokensource.NativeTokenSource,
 	destinationBlockchainID ids.ID,
 	destinationBridgeAddress common.Address,
 	amount *big.Int,
 ) *big.Int {
 	destinationSettings, err := nativeTokenSource.RegisteredDestinations(
-		&bind.CallOpts{},
+		&bind.CallOpts{Context: ctx},
 		destinationBlockchainID,
 		destinationBridgeAddress,
 	)
@@ -0,0 +1,229 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"os"
+	"strconv"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/core/types"
+	teleportertokendestination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/TeleporterTokenDestination"
+	"github.com/ava-labs/teleporter-token-bridge/tracing"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/gomega"
+)
+
+// Environment variables configuring the export of e2e test spans. Tracing is disabled unless an endpoint is set.
+const (
+	tracingEndpointEnvVar = "E2E_OTLP_ENDPOINT"
+	tracingExporterEnvVar = "E2E_OTLP_EXPORTER"
+	tracingInsecureEnvVar = "E2E_OTLP_INSECURE"
+)
+
+var (
+	tracer = tracing.Noop
+
+	// Spans started without a parent span in their context are children of the span of the current spec.
+	// Specs run serially within a ginkgo process, so a single spec span suffices.
+	specCtx  = context.Background()
+	specSpan trace.Span
+
+	callSucceededEventID common.Hash
+	callFailedEventID    common.Hash
+)
+
+func init() {
+	destinationABI, err := teleportertokendestination.TeleporterTokenDestinationMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	callSucceededEventID = destinationABI.Events["CallSucceeded"].ID
+	callFailedEventID = destinationABI.Events["CallFailed"].ID
+}
+
+// InitTracing configures the export of spans from the environment. Should be called before any specs are run.
+func InitTracing() {
+	insecure, _ := strconv.ParseBool(os.Getenv(tracingInsecureEnvVar))
+	var err error
+	tracer, err = tracing.NewTracer(tracing.Config{
+		Endpoint: os.Getenv(tracingEndpointEnvVar),
+		Exporter: os.Getenv(tracingExporterEnvVar),
+		Insecure: insecure,
+	}, "teleporter-token-bridge-e2e")
+	Expect(err).Should(BeNil())
+}
+
+// CloseTracing flushes any spans that have not yet been exported
+func CloseTracing() {
+	if err := tracer.Close(); err != nil {
+		log.Warn("Failed to flush traces", "err", err)
+	}
+}
+
+// StartSpecSpan starts the span that the spans of the spec with the given name are children of
+func StartSpecSpan(name string) {
+	specCtx, specSpan = tracer.Start(context.Background(), name)
+}
+
+// EndSpecSpan ends the span of the current spec
+func EndSpecSpan(failed bool) {
+	if specSpan == nil {
+		return
+	}
+	if failed {
+		specSpan.SetStatus(codes.Error, "spec failed")
+	}
+	specSpan.End()
+	specCtx, specSpan = context.Background(), nil
+}
+
+// StartSpan starts a span that is a child of the span in the context, if any, or of the current spec's span
+func StartSpan(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithSpan(ctx, trace.SpanFromContext(specCtx))
+	}
+	return tracer.Start(ctx, name, opts...)
+}
+
+// Starts the span of a send by a bridge contract on the given chain
+func startSendSpan(ctx context.Context, subnet interfaces.SubnetTestInfo) (context.Context, trace.Span) {
+	return StartSpan(
+		ctx,
+		tracing.SendSpan,
+		trace.WithAttributes(tracing.SourceBlockchainIDKey.String(subnet.BlockchainID.String())),
+	)
+}
+
+func setReceiptAttributes(span trace.Span, receipt *types.Receipt) {
+	span.SetAttributes(tracing.TransactionAttributes(receipt.TxHash, receipt.BlockNumber.Uint64())...)
+}
+
+type tracedNetwork struct {
+	interfaces.LocalNetwork
+}
+
+// NewTracedNetwork wraps the network to trace the relay of each message, and its receipt on the destination
+func NewTracedNetwork(network interfaces.LocalNetwork) interfaces.LocalNetwork {
+	return &tracedNetwork{LocalNetwork: network}
+}
+
+// RelayMessage relays the message the same way as the wrapped network, using its funded account
+func (n *tracedNetwork) RelayMessage(
+	ctx context.Context,
+	sourceReceipt *types.Receipt,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	expectSuccess bool,
+) *types.Receipt {
+	_, fundedKey := n.GetFundedAccountInfo()
+	return RelayMessageWithKey(ctx, n.LocalNetwork, sourceReceipt, source, destination, fundedKey, expectSuccess)
+}
+
+// RelayMessageWithKey relays the message sent in the source receipt, delivering it from the account of the
+// relayer key, which is allocated the relayer reward of the message. The aggregation of the message's signatures
+// is traced as its relay, and the transaction delivering it as its receipt.
+func RelayMessageWithKey(
+	ctx context.Context,
+	network interfaces.LocalNetwork,
+	sourceReceipt *types.Receipt,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	relayerKey *ecdsa.PrivateKey,
+	expectSuccess bool,
+) *types.Receipt {
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		sourceReceipt.Logs,
+		source.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	attributes := trace.WithAttributes(tracing.MessageAttributes(
+		ids.ID(sendEvent.MessageID),
+		source.BlockchainID,
+		destination.BlockchainID,
+	)...)
+
+	receive := ReceiveHookInfo{
+		Source:        source,
+		Destination:   destination,
+		SourceReceipt: sourceReceipt,
+		MessageID:     ids.ID(sendEvent.MessageID),
+		Relayer:       crypto.PubkeyToAddress(relayerKey.PublicKey),
+	}
+	beforeReceive(ctx, receive)
+
+	relayCtx, relaySpan := StartSpan(ctx, tracing.RelaySpan, attributes)
+	signedWarpMessage := network.ConstructSignedWarpMessage(relayCtx, sourceReceipt, source, destination)
+	relaySpan.End()
+
+	receiveCtx, receiveSpan := StartSpan(ctx, tracing.ReceiveSpan, attributes)
+	defer receiveSpan.End()
+	// The message is delivered to the messenger that sent it, rather than the network's Teleporter address, since
+	// the sender may not have moved to the network's latest Teleporter version. Teleporter is deployed to the same
+	// address on every chain.
+	if !expectSuccess {
+		signedTx := teleporterUtils.CreateReceiveCrossChainMessageTransaction(
+			receiveCtx,
+			signedWarpMessage,
+			sendEvent.Message.RequiredGasLimit,
+			sendEvent.Raw.Address,
+			relayerKey,
+			destination,
+		)
+		recordSpecReceiveTransaction(destination, signedTx.Hash(), source, sourceReceipt.TxHash)
+		receipt := teleporterUtils.SendTransactionAndWaitForFailure(receiveCtx, destination, signedTx)
+		setReceiptAttributes(receiveSpan, receipt)
+		afterReceive(ctx, receive, receipt)
+		return receipt
+	}
+	receipt := sendReceiveTransaction(
+		receiveCtx,
+		source,
+		destination,
+		sourceReceipt.TxHash,
+		signedWarpMessage,
+		sendEvent.Message.RequiredGasLimit,
+		sendEvent.Raw.Address,
+		relayerKey,
+	)
+	setReceiptAttributes(receiveSpan, receipt)
+
+	receiveEvent, err := teleporterUtils.GetEventFromLogs(
+		receipt.Logs,
+		destination.TeleporterMessenger.ParseReceiveCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+	Expect(receiveEvent.SourceBlockchainID[:]).Should(Equal(source.BlockchainID[:]))
+
+	traceCall(receiveCtx, receipt)
+	recordDeliveryGasSample(destination, sendEvent.Message.DestinationAddress, receipt)
+	recordDeliveryLatency(ctx, source, destination, sourceReceipt, receipt)
+	afterReceive(ctx, receive, receipt)
+	return receipt
+}
+
+// Records the result of a recipient contract call made while receiving tokens, if any
+func traceCall(ctx context.Context, receipt *types.Receipt) {
+	for _, log := range receipt.Logs {
+		if len(log.Topics) == 0 || (log.Topics[0] != callSucceededEventID && log.Topics[0] != callFailedEventID) {
+			continue
+		}
+		_, span := StartSpan(ctx, tracing.CallSpan, trace.WithAttributes(
+			tracing.CallSucceededKey.Bool(log.Topics[0] == callSucceededEventID),
+		))
+		if log.Topics[0] == callFailedEventID {
+			span.SetStatus(codes.Error, "recipient contract call failed")
+		}
+		span.End()
+	}
+}
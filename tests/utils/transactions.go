@@ -0,0 +1,343 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	avalancheWarp "github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	predicateutils "github.com/ava-labs/subnet-evm/predicate"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	gasUtils "github.com/ava-labs/teleporter/utils/gas-utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// TransactionFeeConfig configures how the EIP-1559 fee parameters of transactions
+// sent by the test helpers are computed, and how stalled or underpriced transactions are replaced.
+type TransactionFeeConfig struct {
+	// BaseFeeMultiplier is applied to the estimated base fee when computing the gas fee cap,
+	// leaving headroom for the base fee to rise before the transaction is included.
+	BaseFeeMultiplier int64
+	// MaxPriorityFeePerGas is used as the gas tip cap. If nil, the node's suggested tip is used.
+	MaxPriorityFeePerGas *big.Int
+	// ReplacementFeeBumpPercent is the percentage by which the gas fee cap and gas tip cap are
+	// increased when a transaction is replaced. Nodes require at least a 10% bump to accept a replacement.
+	ReplacementFeeBumpPercent int64
+	// MaxReplacements is the number of times a transaction may be replaced before failing.
+	MaxReplacements int
+	// ReplacementTimeout is how long to wait for a transaction to be mined before replacing it.
+	ReplacementTimeout time.Duration
+}
+
+var DefaultTransactionFeeConfig = TransactionFeeConfig{
+	BaseFeeMultiplier:         2,
+	MaxPriorityFeePerGas:      nil,
+	ReplacementFeeBumpPercent: 25,
+	MaxReplacements:           5,
+	ReplacementTimeout:        10 * time.Second,
+}
+
+var (
+	transactionFeeConfigs     = make(map[string]TransactionFeeConfig)
+	transactionFeeConfigsLock sync.RWMutex
+)
+
+// Error substrings returned by the node when a transaction's fees are too low to be accepted.
+var underpricedErrors = []string{
+	"transaction underpriced",
+	"replacement transaction underpriced",
+	"max fee per gas less than block base fee",
+}
+
+// SetTransactionFeeConfig overrides the fee configuration used for transactions sent to the chain
+// with the given EVM chain ID.
+func SetTransactionFeeConfig(evmChainID *big.Int, config TransactionFeeConfig) {
+	transactionFeeConfigsLock.Lock()
+	defer transactionFeeConfigsLock.Unlock()
+	transactionFeeConfigs[evmChainID.String()] = config
+}
+
+// GetTransactionFeeConfig returns the fee configuration for the chain with the given EVM chain ID,
+// falling back to DefaultTransactionFeeConfig if none has been set.
+func GetTransactionFeeConfig(evmChainID *big.Int) TransactionFeeConfig {
+	transactionFeeConfigsLock.RLock()
+	defer transactionFeeConfigsLock.RUnlock()
+	if config, ok := transactionFeeConfigs[evmChainID.String()]; ok {
+		return config
+	}
+	return DefaultTransactionFeeConfig
+}
+
+// NewTransactor returns transaction options for senderKey on the given subnet,
+// with the EIP-1559 gas fee cap and gas tip cap estimated from the current fee market.
+func NewTransactor(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+) *bind.TransactOpts {
+	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
+	Expect(err).Should(BeNil())
+	opts.Context = ctx
+
+	opts.GasFeeCap, opts.GasTipCap = estimateDynamicFees(ctx, subnet)
+	return opts
+}
+
+// TransactAndWaitForSuccess builds a transaction with the given transact function, sends it,
+// and waits for it to be mined successfully. If the transaction is rejected as underpriced,
+// or is not mined within the configured replacement timeout, it is replaced by a transaction
+// with the same nonce and bumped fees. Returns the receipt of the transaction that was mined.
+func TransactAndWaitForSuccess(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	transact func(opts *bind.TransactOpts) (*types.Transaction, error),
+) *types.Receipt {
+	config := GetTransactionFeeConfig(subnet.EVMChainID)
+
+	opts := NewTransactor(ctx, subnet, senderKey)
+	opts.NoSend = true
+	tx, err := transact(opts)
+	Expect(err).Should(BeNil())
+	expectSpecOwnsRecipient(subnet, tx)
+
+	// Subsequent replacements must re-use the nonce and gas limit of the original transaction.
+	opts.Nonce = new(big.Int).SetUint64(tx.Nonce())
+	opts.GasLimit = tx.Gas()
+
+	// Track every hash that was accepted by the node, since any of them may end up mined.
+	var sentHashes []common.Hash
+	for replacements := 0; ; replacements++ {
+		var receipt *types.Receipt
+		err = subnet.RPCClient.SendTransaction(ctx, tx)
+		switch {
+		case err == nil || strings.Contains(err.Error(), "already known"):
+			sentHashes = append(sentHashes, tx.Hash())
+			receipt = waitForAnyReceipt(ctx, subnet, sentHashes, config.ReplacementTimeout)
+			if receipt == nil {
+				log.Info("Transaction not mined before timeout, replacing", "txHash", tx.Hash())
+			}
+		case isUnderpricedError(err):
+			log.Info("Transaction underpriced, replacing", "txHash", tx.Hash(), "err", err)
+		default:
+			receipt = expectSentTransactionMined(ctx, subnet, sentHashes, err, config.ReplacementTimeout)
+		}
+		if receipt != nil {
+			// The transaction is traced by TraceFailedSpec once the spec fails
+			recordSpecTransaction(subnet, receipt.TxHash, receipt.Status == types.ReceiptStatusFailed)
+			Expect(receipt.Status).Should(
+				Equal(types.ReceiptStatusSuccessful),
+				"transaction %s failed",
+				receipt.TxHash,
+			)
+			recordSpecDeployment(subnet, receipt)
+			recordGasSample(subnet, tx, receipt)
+			return receipt
+		}
+
+		Expect(replacements).Should(BeNumerically("<", config.MaxReplacements),
+			"transaction was not mined after the maximum number of fee replacements")
+
+		bumpDynamicFees(ctx, subnet, opts, config)
+		tx, err = transact(opts)
+		Expect(err).Should(BeNil())
+	}
+}
+
+// Delivers the signed Warp message to the destination's Teleporter messenger from the relayer key, and waits for
+// the delivery to succeed. Deliveries are replaced with bumped fees the same way as TransactAndWaitForSuccess, so
+// that they are still mined if the destination's base fee rises while they are pending, such as when the
+// destination is congested.
+func sendReceiveTransaction(
+	ctx context.Context,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	sourceTxHash common.Hash,
+	signedMessage *avalancheWarp.Message,
+	requiredGasLimit *big.Int,
+	teleporterAddress common.Address,
+	relayerKey *ecdsa.PrivateKey,
+) *types.Receipt {
+	config := GetTransactionFeeConfig(destination.EVMChainID)
+	relayerAddress := crypto.PubkeyToAddress(relayerKey.PublicKey)
+
+	numSigners, err := signedMessage.Signature.NumSigners()
+	Expect(err).Should(BeNil())
+	gasLimit, err := gasUtils.CalculateReceiveMessageGasLimit(numSigners, requiredGasLimit)
+	Expect(err).Should(BeNil())
+	callData, err := teleportermessenger.PackReceiveCrossChainMessage(0, relayerAddress)
+	Expect(err).Should(BeNil())
+	// The first delivery is priced the same way as the transactions of TransactAndWaitForSuccess, with the
+	// destination's fee configuration
+	opts := &bind.TransactOpts{}
+	opts.GasFeeCap, opts.GasTipCap = estimateDynamicFees(ctx, destination)
+	nonce, err := destination.RPCClient.NonceAt(ctx, relayerAddress, nil)
+	Expect(err).Should(BeNil())
+
+	var sentHashes []common.Hash
+	for replacements := 0; ; replacements++ {
+		tx := predicateutils.NewPredicateTx(
+			destination.EVMChainID,
+			nonce,
+			&teleporterAddress,
+			gasLimit,
+			opts.GasFeeCap,
+			opts.GasTipCap,
+			big.NewInt(0),
+			callData,
+			types.AccessList{},
+			warp.ContractAddress,
+			signedMessage.Bytes(),
+		)
+		tx = teleporterUtils.SignTransaction(tx, relayerKey, destination.EVMChainID)
+		recordSpecReceiveTransaction(destination, tx.Hash(), source, sourceTxHash)
+
+		var receipt *types.Receipt
+		err = destination.RPCClient.SendTransaction(ctx, tx)
+		switch {
+		case err == nil || strings.Contains(err.Error(), "already known"):
+			sentHashes = append(sentHashes, tx.Hash())
+			receipt = waitForAnyReceipt(ctx, destination, sentHashes, config.ReplacementTimeout)
+			if receipt == nil {
+				log.Info("Delivery not mined before timeout, replacing", "txHash", tx.Hash())
+			}
+		case isUnderpricedError(err):
+			log.Info("Delivery underpriced, replacing", "txHash", tx.Hash(), "err", err)
+		default:
+			receipt = expectSentTransactionMined(ctx, destination, sentHashes, err, config.ReplacementTimeout)
+		}
+		if receipt != nil {
+			Expect(receipt.Status).Should(
+				Equal(types.ReceiptStatusSuccessful),
+				"delivery %s failed",
+				receipt.TxHash,
+			)
+			if replacements > 0 {
+				log.Info("Delivery mined after replacements", "txHash", receipt.TxHash, "replacements", replacements)
+			}
+			return receipt
+		}
+
+		Expect(replacements).Should(BeNumerically("<", config.MaxReplacements),
+			"delivery was not mined after the maximum number of fee replacements")
+		bumpDynamicFees(ctx, destination, opts, config)
+	}
+}
+
+// Returns the gas fee cap and gas tip cap to use for a new transaction on the given subnet
+func estimateDynamicFees(ctx context.Context, subnet interfaces.SubnetTestInfo) (*big.Int, *big.Int) {
+	config := GetTransactionFeeConfig(subnet.EVMChainID)
+
+	baseFee, err := subnet.RPCClient.EstimateBaseFee(ctx)
+	Expect(err).Should(BeNil())
+
+	gasTipCap := config.MaxPriorityFeePerGas
+	if gasTipCap == nil {
+		gasTipCap, err = subnet.RPCClient.SuggestGasTipCap(ctx)
+		Expect(err).Should(BeNil())
+	}
+
+	gasFeeCap := new(big.Int).Mul(baseFee, big.NewInt(config.BaseFeeMultiplier))
+	gasFeeCap.Add(gasFeeCap, gasTipCap)
+	return gasFeeCap, new(big.Int).Set(gasTipCap)
+}
+
+// Increases the fees in opts by the configured bump percentage, or to the current
+// fee market estimate if that is higher.
+func bumpDynamicFees(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	opts *bind.TransactOpts,
+	config TransactionFeeConfig,
+) {
+	bump := func(v *big.Int) *big.Int {
+		bumped := new(big.Int).Mul(v, big.NewInt(100+config.ReplacementFeeBumpPercent))
+		return bumped.Div(bumped, big.NewInt(100))
+	}
+	estimatedFeeCap, estimatedTipCap := estimateDynamicFees(ctx, subnet)
+
+	opts.GasFeeCap = bigMax(bump(opts.GasFeeCap), estimatedFeeCap)
+	opts.GasTipCap = bigMax(bump(opts.GasTipCap), estimatedTipCap)
+	log.Info("Bumped transaction fees", "gasFeeCap", opts.GasFeeCap, "gasTipCap", opts.GasTipCap)
+}
+
+// Returns the receipt of whichever of the sent transactions was mined, when sending a replacement of them failed
+// with an error other than being underpriced. A transaction mined just before its replacement was sent has used
+// the nonce, so the replacement is rejected as "nonce too low" even though the transaction was mined. Fails with
+// the send error if none of them was mined.
+func expectSentTransactionMined(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	sentHashes []common.Hash,
+	sendErr error,
+	timeout time.Duration,
+) *types.Receipt {
+	var receipt *types.Receipt
+	if len(sentHashes) > 0 {
+		receipt = waitForAnyReceipt(ctx, subnet, sentHashes, timeout)
+	}
+	Expect(receipt).ShouldNot(BeNil(), sendErr.Error())
+	log.Info("Replacement rejected after an earlier transaction was mined", "txHash", receipt.TxHash, "err", sendErr)
+	return receipt
+}
+
+// Polls for the receipt of any of the given transaction hashes until the timeout elapses.
+// Returns nil if none of the transactions were mined.
+func waitForAnyReceipt(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	txHashes []common.Hash,
+	timeout time.Duration,
+) *types.Receipt {
+	cctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	queryTicker := time.NewTicker(200 * time.Millisecond)
+	defer queryTicker.Stop()
+	for {
+		for _, txHash := range txHashes {
+			receipt, err := subnet.RPCClient.TransactionReceipt(cctx, txHash)
+			if err == nil {
+				return receipt
+			}
+		}
+
+		select {
+		case <-cctx.Done():
+			Expect(ctx.Err()).Should(BeNil())
+			return nil
+		case <-queryTicker.C:
+		}
+	}
+}
+
+func isUnderpricedError(err error) bool {
+	for _, s := range underpricedErrors {
+		if strings.Contains(err.Error(), s) {
+			return true
+		}
+	}
+	return false
+}
+
+func bigMax(a *big.Int, b *big.Int) *big.Int {
+	if a.Cmp(b) >= 0 {
+		return a
+	}
+	return b
+}
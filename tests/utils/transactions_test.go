@@ -0,0 +1,109 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/set"
+	avalancheWarp "github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/ethclient"
+	"github.com/ava-labs/subnet-evm/interfaces"
+	teleporterInterfaces "github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	. "github.com/onsi/gomega"
+)
+
+// A client of a chain with a fixed base fee and suggested tip, that mines every transaction sent to it
+type feeMarketClient struct {
+	unimplementedClient
+
+	baseFee   *big.Int
+	gasTipCap *big.Int
+	sent      []*types.Transaction
+}
+
+// The methods of the client that feeMarketClient does not implement, embedded as nil
+type unimplementedClient interface {
+	ethclient.Client
+}
+
+func (c *feeMarketClient) EstimateBaseFee(context.Context) (*big.Int, error) {
+	return new(big.Int).Set(c.baseFee), nil
+}
+
+func (c *feeMarketClient) SuggestGasTipCap(context.Context) (*big.Int, error) {
+	return new(big.Int).Set(c.gasTipCap), nil
+}
+
+func (c *feeMarketClient) NonceAt(context.Context, common.Address, *big.Int) (uint64, error) {
+	return 0, nil
+}
+
+func (c *feeMarketClient) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	c.sent = append(c.sent, tx)
+	return nil
+}
+
+func (c *feeMarketClient) TransactionReceipt(_ context.Context, txHash common.Hash) (*types.Receipt, error) {
+	for _, tx := range c.sent {
+		if tx.Hash() == txHash {
+			return &types.Receipt{TxHash: txHash, Status: types.ReceiptStatusSuccessful}, nil
+		}
+	}
+	return nil, interfaces.NotFound
+}
+
+// The first delivery of a message is priced with the destination's fee configuration, as replacements are
+func TestSendReceiveTransactionFees(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+
+	client := &feeMarketClient{baseFee: big.NewInt(25_000_000_000), gasTipCap: big.NewInt(1_000_000_000)}
+	destination := teleporterInterfaces.SubnetTestInfo{
+		BlockchainID: ids.ID{2},
+		RPCClient:    client,
+		EVMChainID:   big.NewInt(99_001),
+	}
+	tipCap := big.NewInt(3_000_000_000)
+	config := DefaultTransactionFeeConfig
+	config.BaseFeeMultiplier = 4
+	config.MaxPriorityFeePerGas = tipCap
+	SetTransactionFeeConfig(destination.EVMChainID, config)
+
+	unsignedMessage, err := avalancheWarp.NewUnsignedMessage(constants.UnitTestID, ids.ID{1}, []byte{})
+	Expect(err).Should(BeNil())
+	signedMessage, err := avalancheWarp.NewMessage(unsignedMessage, &avalancheWarp.BitSetSignature{
+		Signers:   set.NewBits(0).Bytes(),
+		Signature: [bls.SignatureLen]byte{},
+	})
+	Expect(err).Should(BeNil())
+	relayerKey, err := crypto.GenerateKey()
+	Expect(err).Should(BeNil())
+
+	sendReceiveTransaction(
+		ctx,
+		teleporterInterfaces.SubnetTestInfo{BlockchainID: ids.ID{1}},
+		destination,
+		common.Hash{},
+		signedMessage,
+		big.NewInt(100_000),
+		common.Address{1},
+		relayerKey,
+	)
+	Expect(client.sent).Should(HaveLen(1))
+	gasFeeCap := new(big.Int).Mul(client.baseFee, big.NewInt(config.BaseFeeMultiplier))
+	gasFeeCap.Add(gasFeeCap, tipCap)
+	teleporterUtils.ExpectBigEqual(client.sent[0].GasFeeCap(), gasFeeCap)
+	teleporterUtils.ExpectBigEqual(client.sent[0].GasTipCap(), tipCap)
+}
@@ -6,8 +6,8 @@ package utils
 import (
 	"context"
 	"crypto/ecdsa"
-	"math"
 	"math/big"
+	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
@@ -21,6 +21,8 @@ import (
 	examplewavax "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/ExampleWAVAX"
 	mockERC20SACR "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/MockERC20SendAndCallReceiver"
 	mockNSACR "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/mocks/MockNativeSendAndCallReceiver"
+	"github.com/ava-labs/teleporter-token-bridge/bridge"
+	"github.com/ava-labs/teleporter-token-bridge/tokenscaling"
 	exampleerc20 "github.com/ava-labs/teleporter/abi-bindings/go/Mocks/ExampleERC20"
 	"github.com/ava-labs/teleporter/tests/interfaces"
 	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
@@ -31,8 +33,11 @@ import (
 	. "github.com/onsi/gomega"
 )
 
+// Approves the exact amounts spent by sends, as the SDK does by default
+var allowances bridge.AllowanceManager
+
 // Deployer keys set in the genesis file in order to determine the deployed address in advance.
-// The deployed address is set as an admin for the Native Minter precompile.
+// The deployed address is set as an admin for the Native Minter precompile, as generated by NativeMinterConfig.
 
 var nativeTokenDestinationDeployerKeys = []string{
 	// Deployer address: 			   0x1337cfd2dCff6270615B90938aCB1efE79801704
@@ -61,6 +66,10 @@ var nativeTokenDestinationDeployerKeys = []string{
 	// Deployer address:			   0xd466f12795BA59d0fef389c21fA63c287956fb18
 	// NativeTokenDestination address: 0x463a6bE7a5098A5f06435c6c468adD338F15B93A
 	"ebb7f0cf71e0b6fd880326e5f5061b8456b0aef81901566cbe578b5024852ec9",
+
+	// Deployer address:			   0xa55Cc925A82e60a993A950261bc3426f0C7388a4
+	// NativeTokenDestination address: 0x4Ce26EF2428dd32C63e9CD19F464eb3A0A7C489D
+	"0c0267601fb25175770ab138a73fa079245af6aee7a0ee47b2b9a324a2aa3ebf",
 }
 var nativeTokenDestinationDeployerKeyIndex = 0
 
@@ -71,21 +80,29 @@ func DeployERC20Source(
 	teleporterManager common.Address,
 	tokenSourceAddress common.Address,
 ) (common.Address, *erc20source.ERC20Source) {
-	opts, err := bind.NewKeyedTransactorWithChainID(
-		senderKey,
-		subnet.EVMChainID,
+	var (
+		address     common.Address
+		erc20Source *erc20source.ERC20Source
 	)
-	Expect(err).Should(BeNil())
-	address, tx, erc20Source, err := erc20source.DeployERC20Source(
-		opts,
-		subnet.RPCClient,
-		subnet.TeleporterRegistryAddress,
-		teleporterManager,
-		tokenSourceAddress,
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, erc20Source, err = erc20source.DeployERC20Source(
+				opts,
+				subnet.RPCClient,
+				subnet.TeleporterRegistryAddress,
+				teleporterManager,
+				tokenSourceAddress,
+			)
+			return tx, err
+		},
 	)
-	Expect(err).Should(BeNil())
-
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
 
 	return address, erc20Source
 }
@@ -101,27 +118,115 @@ func DeployERC20Destination(
 	tokenSymbol string,
 	tokenDecimals uint8,
 ) (common.Address, *erc20destination.ERC20Destination) {
-	opts, err := bind.NewKeyedTransactorWithChainID(
+	var (
+		address          common.Address
+		erc20Destination *erc20destination.ERC20Destination
+	)
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
 		senderKey,
-		subnet.EVMChainID,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, erc20Destination, err = erc20destination.DeployERC20Destination(
+				opts,
+				subnet.RPCClient,
+				subnet.TeleporterRegistryAddress,
+				teleporterManager,
+				sourceBlockchainID,
+				tokenSourceAddress,
+				tokenName,
+				tokenSymbol,
+				tokenDecimals,
+			)
+			return tx, err
+		},
 	)
+
+	return address, erc20Destination
+}
+
+// ERC20DestinationMetadata is the token metadata that an ERC20Destination is deployed with.
+// Zero values are populated from the token of the ERC20Source by DeployERC20DestinationForSource.
+type ERC20DestinationMetadata struct {
+	Name     string
+	Symbol   string
+	Decimals *uint8
+}
+
+// GetERC20SourceTokenMetadata returns the metadata of the token bridged by the ERC20Source
+func GetERC20SourceTokenMetadata(
+	ctx context.Context,
+	sourceSubnet interfaces.SubnetTestInfo,
+	erc20SourceAddress common.Address,
+) ERC20DestinationMetadata {
+	erc20Source, err := erc20source.NewERC20Source(erc20SourceAddress, sourceSubnet.RPCClient)
 	Expect(err).Should(BeNil())
-	address, tx, erc20Destination, err := erc20destination.DeployERC20Destination(
-		opts,
-		subnet.RPCClient,
-		subnet.TeleporterRegistryAddress,
-		teleporterManager,
-		sourceBlockchainID,
-		tokenSourceAddress,
-		tokenName,
-		tokenSymbol,
-		tokenDecimals,
-	)
+	tokenAddress, err := erc20Source.Token(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	token, err := exampleerc20.NewExampleERC20(tokenAddress, sourceSubnet.RPCClient)
 	Expect(err).Should(BeNil())
 
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	name, err := token.Name(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	symbol, err := token.Symbol(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	decimals, err := token.Decimals(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
 
-	return address, erc20Destination
+	return ERC20DestinationMetadata{
+		Name:     name,
+		Symbol:   symbol,
+		Decimals: &decimals,
+	}
+}
+
+// DeployERC20DestinationForSource deploys an ERC20Destination for the ERC20Source on sourceSubnet.
+// Any metadata that is not specified is populated from the token bridged by the ERC20Source.
+// Returns the metadata that the ERC20Destination was deployed with.
+func DeployERC20DestinationForSource(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	teleporterManager common.Address,
+	sourceSubnet interfaces.SubnetTestInfo,
+	erc20SourceAddress common.Address,
+	metadata ERC20DestinationMetadata,
+) (common.Address, *erc20destination.ERC20Destination, ERC20DestinationMetadata) {
+	sourceMetadata := GetERC20SourceTokenMetadata(ctx, sourceSubnet, erc20SourceAddress)
+	if metadata.Name == "" {
+		metadata.Name = sourceMetadata.Name
+	}
+	if metadata.Symbol == "" {
+		metadata.Symbol = sourceMetadata.Symbol
+	}
+	if metadata.Decimals == nil {
+		metadata.Decimals = sourceMetadata.Decimals
+	}
+
+	address, erc20Destination := DeployERC20Destination(
+		ctx,
+		senderKey,
+		subnet,
+		teleporterManager,
+		sourceSubnet.BlockchainID,
+		erc20SourceAddress,
+		metadata.Name,
+		metadata.Symbol,
+		*metadata.Decimals,
+	)
+	log.Info(
+		"Deployed ERC20Destination contract",
+		"address", address.Hex(),
+		"name", metadata.Name,
+		"symbol", metadata.Symbol,
+		"decimals", *metadata.Decimals,
+	)
+
+	return address, erc20Destination, metadata
 }
 
 func DeployNativeTokenDestination(
@@ -142,31 +247,24 @@ func DeployNativeTokenDestination(
 	deployerKeyStr := nativeTokenDestinationDeployerKeys[nativeTokenDestinationDeployerKeyIndex]
 	deployerPK, err := crypto.HexToECDSA(deployerKeyStr)
 	Expect(err).Should(BeNil())
+	deployerAddress := crypto.PubkeyToAddress(deployerPK.PublicKey)
+	expectUnusedDeployer(ctx, subnet, deployerAddress)
 
-	opts, err := bind.NewKeyedTransactorWithChainID(
+	address, nativeTokenDestination := DeployNativeTokenDestinationWithKey(
+		ctx,
 		deployerPK,
-		subnet.EVMChainID,
-	)
-	Expect(err).Should(BeNil())
-
-	address, tx, nativeTokenDestination, err := nativetokendestination.DeployNativeTokenDestination(
-		opts,
-		subnet.RPCClient,
-		nativetokendestination.NativeTokenDestinationSettings{
-			NativeAssetSymbol:                   symbol,
-			TeleporterRegistryAddress:           subnet.TeleporterRegistryAddress,
-			TeleporterManager:                   teleporterManager,
-			SourceBlockchainID:                  sourceBlockchainID,
-			TokenSourceAddress:                  tokenSourceAddress,
-			InitialReserveImbalance:             initialReserveImbalance,
-			DecimalsShift:                       decimalsShift,
-			MultiplyOnDestination:               multiplyOnDestination,
-			BurnedFeesReportingRewardPercentage: burnedFeesReportingRewardPercentage,
-		},
+		subnet,
+		symbol,
+		teleporterManager,
+		sourceBlockchainID,
+		tokenSourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
 	)
-	Expect(err).Should(BeNil())
-
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	Expect(address).Should(Equal(nativeTokenDestinationAddress(deployerAddress)))
+	ExpectNativeMinterEnabled(ctx, subnet, address)
 
 	// Increment to the next deployer key so that the next contract deployment succeeds
 	nativeTokenDestinationDeployerKeyIndex++
@@ -174,6 +272,57 @@ func DeployNativeTokenDestination(
 	return address, nativeTokenDestination
 }
 
+// DeployNativeTokenDestinationWithKey deploys a NativeTokenDestination with senderKey, rather than
+// one of the deployer keys set in the genesis file. The resulting contract is not an admin for the
+// Native Minter precompile, so it can be registered and collateralized, but cannot mint native tokens.
+func DeployNativeTokenDestinationWithKey(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	symbol string,
+	teleporterManager common.Address,
+	sourceBlockchainID ids.ID,
+	tokenSourceAddress common.Address,
+	initialReserveImbalance *big.Int,
+	decimalsShift uint8,
+	multiplyOnDestination bool,
+	burnedFeesReportingRewardPercentage *big.Int,
+) (common.Address, *nativetokendestination.NativeTokenDestination) {
+	var (
+		address                common.Address
+		nativeTokenDestination *nativetokendestination.NativeTokenDestination
+	)
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, nativeTokenDestination, err = nativetokendestination.DeployNativeTokenDestination(
+				opts,
+				subnet.RPCClient,
+				nativetokendestination.NativeTokenDestinationSettings{
+					NativeAssetSymbol:                   symbol,
+					TeleporterRegistryAddress:           subnet.TeleporterRegistryAddress,
+					TeleporterManager:                   teleporterManager,
+					SourceBlockchainID:                  sourceBlockchainID,
+					TokenSourceAddress:                  tokenSourceAddress,
+					InitialReserveImbalance:             initialReserveImbalance,
+					DecimalsShift:                       decimalsShift,
+					MultiplyOnDestination:               multiplyOnDestination,
+					BurnedFeesReportingRewardPercentage: burnedFeesReportingRewardPercentage,
+				},
+			)
+			return tx, err
+		},
+	)
+
+	return address, nativeTokenDestination
+}
+
 func DeployNativeTokenSource(
 	ctx context.Context,
 	senderKey *ecdsa.PrivateKey,
@@ -181,21 +330,29 @@ func DeployNativeTokenSource(
 	teleporterManager common.Address,
 	tokenAddress common.Address,
 ) (common.Address, *nativetokensource.NativeTokenSource) {
-	opts, err := bind.NewKeyedTransactorWithChainID(
-		senderKey,
-		subnet.EVMChainID,
+	var (
+		address           common.Address
+		nativeTokenSource *nativetokensource.NativeTokenSource
 	)
-	Expect(err).Should(BeNil())
-	address, tx, nativeTokenSource, err := nativetokensource.DeployNativeTokenSource(
-		opts,
-		subnet.RPCClient,
-		subnet.TeleporterRegistryAddress,
-		teleporterManager,
-		tokenAddress,
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, nativeTokenSource, err = nativetokensource.DeployNativeTokenSource(
+				opts,
+				subnet.RPCClient,
+				subnet.TeleporterRegistryAddress,
+				teleporterManager,
+				tokenAddress,
+			)
+			return tx, err
+		},
 	)
-	Expect(err).Should(BeNil())
-
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
 
 	return address, nativeTokenSource
 }
@@ -205,16 +362,26 @@ func DeployExampleWAVAX(
 	senderKey *ecdsa.PrivateKey,
 	subnet interfaces.SubnetTestInfo,
 ) (common.Address, *examplewavax.ExampleWAVAX) {
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-
-	// Deploy mock WAVAX contract
-	address, tx, token, err := examplewavax.DeployExampleWAVAX(opts, subnet.RPCClient)
-	Expect(err).Should(BeNil())
-	log.Info("Deployed ExampleWAVAX contract", "address", address.Hex(), "txHash", tx.Hash().Hex())
+	var (
+		address common.Address
+		token   *examplewavax.ExampleWAVAX
+	)
 
-	// Wait for the transaction to be mined
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	// Deploy mock WAVAX contract and wait for the transaction to be mined
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, token, err = examplewavax.DeployExampleWAVAX(opts, subnet.RPCClient)
+			return tx, err
+		},
+	)
+	log.Info("Deployed ExampleWAVAX contract", "address", address.Hex(), "txHash", receipt.TxHash.Hex())
 
 	return address, token
 }
@@ -224,16 +391,26 @@ func DeployMockNativeSendAndCallReceiver(
 	senderKey *ecdsa.PrivateKey,
 	subnet interfaces.SubnetTestInfo,
 ) (common.Address, *mockNSACR.MockNativeSendAndCallReceiver) {
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-
-	// Deploy MockNativeSendAndCallReceiver contract
-	address, tx, contract, err := mockNSACR.DeployMockNativeSendAndCallReceiver(opts, subnet.RPCClient)
-	Expect(err).Should(BeNil())
-	log.Info("Deployed MockNativeSendAndCallReceiver contract", "address", address.Hex(), "txHash", tx.Hash().Hex())
+	var (
+		address  common.Address
+		contract *mockNSACR.MockNativeSendAndCallReceiver
+	)
 
-	// Wait for the transaction to be mined
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	// Deploy MockNativeSendAndCallReceiver contract and wait for the transaction to be mined
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, contract, err = mockNSACR.DeployMockNativeSendAndCallReceiver(opts, subnet.RPCClient)
+			return tx, err
+		},
+	)
+	log.Info("Deployed MockNativeSendAndCallReceiver contract", "address", address.Hex(), "txHash", receipt.TxHash.Hex())
 
 	return address, contract
 }
@@ -243,16 +420,26 @@ func DeployMockERC20SendAndCallReceiver(
 	senderKey *ecdsa.PrivateKey,
 	subnet interfaces.SubnetTestInfo,
 ) (common.Address, *mockERC20SACR.MockERC20SendAndCallReceiver) {
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-
-	// Deploy MockERC20SendAndCallReceiver contract
-	address, tx, contract, err := mockERC20SACR.DeployMockERC20SendAndCallReceiver(opts, subnet.RPCClient)
-	Expect(err).Should(BeNil())
-	log.Info("Deployed MockERC20SendAndCallReceiver contract", "address", address.Hex(), "txHash", tx.Hash().Hex())
+	var (
+		address  common.Address
+		contract *mockERC20SACR.MockERC20SendAndCallReceiver
+	)
 
-	// Wait for the transaction to be mined
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	// Deploy MockERC20SendAndCallReceiver contract and wait for the transaction to be mined
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, contract, err = mockERC20SACR.DeployMockERC20SendAndCallReceiver(opts, subnet.RPCClient)
+			return tx, err
+		},
+	)
+	log.Info("Deployed MockERC20SendAndCallReceiver contract", "address", address.Hex(), "txHash", receipt.TxHash.Hex())
 
 	return address, contract
 }
@@ -296,14 +483,17 @@ func RegisterTokenDestinationOnSource(
 	)
 	Expect(err).Should(BeNil())
 	_, fundedKey := network.GetFundedAccountInfo()
-	opts, err := bind.NewKeyedTransactorWithChainID(fundedKey, destinationSubnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	sendRegisterTx, err := tokenDestination.RegisterWithSource(
-		opts,
-		teleportertokendestination.TeleporterFeeInfo{FeeTokenAddress: common.Address{}, Amount: big.NewInt(0)},
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		destinationSubnet,
+		fundedKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return tokenDestination.RegisterWithSource(
+				opts,
+				teleportertokendestination.TeleporterFeeInfo{FeeTokenAddress: common.Address{}, Amount: big.NewInt(0)},
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, destinationSubnet, sendRegisterTx.Hash())
 
 	// Relay the register message to the source
 	receipt = network.RelayMessage(ctx, receipt, destinationSubnet, sourceSubnet, true)
@@ -330,6 +520,120 @@ func RegisterTokenDestinationOnSource(
 	return collateralNeeded
 }
 
+// DeployAndRegisterERC20Destination deploys an ERC20Destination for the ERC20Source on sourceSubnet from the
+// network's funded account, with its token metadata populated from the source token, and registers it with the
+// ERC20Source. Returns once the registration has been delivered, so the destination is ready to receive tokens.
+func DeployAndRegisterERC20Destination(
+	ctx context.Context,
+	network interfaces.Network,
+	subnet interfaces.SubnetTestInfo,
+	sourceSubnet interfaces.SubnetTestInfo,
+	erc20SourceAddress common.Address,
+) (common.Address, *erc20destination.ERC20Destination) {
+	fundedAddress, fundedKey := network.GetFundedAccountInfo()
+	address, erc20Destination, _ := DeployERC20DestinationForSource(
+		ctx,
+		fundedKey,
+		subnet,
+		fundedAddress,
+		sourceSubnet,
+		erc20SourceAddress,
+		ERC20DestinationMetadata{},
+	)
+	RegisterERC20DestinationOnSource(ctx, network, sourceSubnet, erc20SourceAddress, subnet, address)
+	return address, erc20Destination
+}
+
+// DeployAndRegisterNativeTokenDestination deploys a NativeTokenDestination for the token source on sourceSubnet,
+// from the next deployer key set in the genesis file, and registers it with the source.
+// Returns once the registration has been delivered, along with the collateral the source needs before tokens
+// are minted on the destination, which depends on whether the source is an ERC20Source or a NativeTokenSource.
+func DeployAndRegisterNativeTokenDestination(
+	ctx context.Context,
+	network interfaces.Network,
+	subnet interfaces.SubnetTestInfo,
+	symbol string,
+	sourceSubnet interfaces.SubnetTestInfo,
+	tokenSourceAddress common.Address,
+	initialReserveImbalance *big.Int,
+	decimalsShift uint8,
+	multiplyOnDestination bool,
+	burnedFeesReportingRewardPercentage *big.Int,
+) (common.Address, *nativetokendestination.NativeTokenDestination, *big.Int) {
+	fundedAddress, _ := network.GetFundedAccountInfo()
+	address, nativeTokenDestination := DeployNativeTokenDestination(
+		ctx,
+		subnet,
+		symbol,
+		fundedAddress,
+		sourceSubnet.BlockchainID,
+		tokenSourceAddress,
+		initialReserveImbalance,
+		decimalsShift,
+		multiplyOnDestination,
+		burnedFeesReportingRewardPercentage,
+	)
+	collateralNeeded := RegisterTokenDestinationOnSource(
+		ctx,
+		network,
+		sourceSubnet,
+		tokenSourceAddress,
+		subnet,
+		address,
+		initialReserveImbalance,
+		GetTokenMultiplier(decimalsShift),
+		multiplyOnDestination,
+	)
+	return address, nativeTokenDestination, collateralNeeded
+}
+
+// SetRateLimitOnSource limits the tokens the token source sends to the destination bridge to limit per window,
+// sent from the source's owner, and verifies that the rate limit was updated. The window is rounded down to whole
+// seconds.
+func SetRateLimitOnSource(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	tokenSourceAddress common.Address,
+	destinationBlockchainID ids.ID,
+	destinationBridgeAddress common.Address,
+	limit *big.Int,
+	window time.Duration,
+	ownerKey *ecdsa.PrivateKey,
+) *types.Receipt {
+	tokenSource, err := teleportertokensource.NewTeleporterTokenSource(tokenSourceAddress, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	windowSeconds := big.NewInt(int64(window / time.Second))
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		ownerKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return tokenSource.SetRateLimit(
+				opts,
+				destinationBlockchainID,
+				destinationBridgeAddress,
+				limit,
+				windowSeconds,
+			)
+		},
+	)
+	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, tokenSource.ParseRateLimitUpdated)
+	Expect(err).Should(BeNil())
+	Expect(event.DestinationBlockchainID[:]).Should(Equal(destinationBlockchainID[:]))
+	Expect(event.DestinationBridgeAddress).Should(Equal(destinationBridgeAddress))
+	teleporterUtils.ExpectBigEqual(event.Limit, limit)
+	teleporterUtils.ExpectBigEqual(event.Window, windowSeconds)
+
+	rateLimit, err := tokenSource.RateLimits(
+		&bind.CallOpts{Context: ctx},
+		destinationBlockchainID,
+		destinationBridgeAddress,
+	)
+	Expect(err).Should(BeNil())
+	teleporterUtils.ExpectBigEqual(rateLimit.AmountSent, big.NewInt(0))
+	return receipt
+}
+
 // AddCollateralToERC20Source adds collateral to the ERC20Source contract
 // and verifies the collateral was added successfully. Any excess amount
 // is returned to the caller.
@@ -355,23 +659,26 @@ func AddCollateralToERC20Source(
 	)
 
 	// Add collateral to the ERC20Source
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	tx, err := erc20Source.AddCollateral(
-		opts,
-		destinationBlockchainID,
-		destinationBridgeAddress,
-		collateralAmount,
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Source.AddCollateral(
+				opts,
+				destinationBlockchainID,
+				destinationBridgeAddress,
+				collateralAmount,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseCollateralAdded)
 	Expect(err).Should(BeNil())
 	Expect(event.DestinationBlockchainID[:]).Should(Equal(destinationBlockchainID[:]))
 	Expect(event.DestinationBridgeAddress).Should(Equal(destinationBridgeAddress))
 
 	destinationSettings, err := erc20Source.RegisteredDestinations(
-		&bind.CallOpts{},
+		&bind.CallOpts{Context: ctx},
 		destinationBlockchainID,
 		destinationBridgeAddress)
 	Expect(err).Should(BeNil())
@@ -395,24 +702,26 @@ func AddCollateralToNativeTokenSource(
 	collateralAmount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 ) {
-	// Add collateral to the ERC20Source
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	opts.Value = collateralAmount
-
-	tx, err := nativeTokenSource.AddCollateral(
-		opts,
-		destinationBlockchainID,
-		destinationBridgeAddress,
+	// Add collateral to the NativeTokenSource
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = collateralAmount
+			return nativeTokenSource.AddCollateral(
+				opts,
+				destinationBlockchainID,
+				destinationBridgeAddress,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenSource.ParseCollateralAdded)
 	Expect(err).Should(BeNil())
 	Expect(event.DestinationBlockchainID[:]).Should(Equal(destinationBlockchainID[:]))
 	Expect(event.DestinationBridgeAddress).Should(Equal(destinationBridgeAddress))
 	destinationSettings, err := nativeTokenSource.RegisteredDestinations(
-		&bind.CallOpts{},
+		&bind.CallOpts{Context: ctx},
 		destinationBlockchainID,
 		destinationBridgeAddress)
 	Expect(err).Should(BeNil())
@@ -433,33 +742,57 @@ func SendERC20Source(
 	amount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 ) (*types.Receipt, *big.Int) {
-	// Approve the ERC20Source to spend the tokens
-	teleporterUtils.ERC20Approve(
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	send := beforeSend(ctx, SendHookInfo{
+		Subnet:                   subnet,
+		BridgeAddress:            erc20SourceAddress,
+		Sender:                   crypto.PubkeyToAddress(senderKey.PublicKey),
+		DestinationBlockchainID:  input.DestinationBlockchainID,
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		SendAndCall:              false,
+		Amount:                   amount,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+	})
+	input.PrimaryFee, input.SecondaryFee = send.PrimaryFee, send.SecondaryFee
+
+	// Approve the ERC20Source to spend the tokens, unless it already may
+	sourceTokenAddress, err := erc20Source.TokenAddress(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	ApproveIfNeeded(
 		ctx,
+		subnet,
+		senderKey,
+		sourceTokenAddress,
 		sourceToken,
 		erc20SourceAddress,
 		big.NewInt(0).Add(amount, input.PrimaryFee),
-		subnet,
-		senderKey,
 	)
 
 	// Send the tokens and verify expected events
-	optsA, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	tx, err := erc20Source.Send(
-		optsA,
-		input,
-		amount,
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Source.Send(
+				opts,
+				input,
+				amount,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	setReceiptAttributes(span, receipt)
+	afterSend(ctx, send, receipt)
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseTokensSent)
 	Expect(err).Should(BeNil())
 	Expect(event.Sender).Should(Equal(crypto.PubkeyToAddress(senderKey.PublicKey)))
 
 	// Compute the scaled amount
 	scaledAmount := GetScaledAmountFromERC20Source(
+		ctx,
 		erc20Source,
 		input.DestinationBlockchainID,
 		input.DestinationBridgeAddress,
@@ -480,6 +813,22 @@ func SendNativeTokenSource(
 	amount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 ) (*types.Receipt, *big.Int) {
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	send := beforeSend(ctx, SendHookInfo{
+		Subnet:                   subnet,
+		BridgeAddress:            nativeTokenSourceAddress,
+		Sender:                   crypto.PubkeyToAddress(senderKey.PublicKey),
+		DestinationBlockchainID:  input.DestinationBlockchainID,
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		SendAndCall:              false,
+		Amount:                   amount,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+	})
+	input.PrimaryFee, input.SecondaryFee = send.PrimaryFee, send.SecondaryFee
+
 	DepositAndApproveWrappedTokenForFees(
 		ctx,
 		subnet,
@@ -489,23 +838,27 @@ func SendNativeTokenSource(
 		senderKey,
 	)
 
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	opts.Value = amount
-
-	tx, err := nativeTokenSource.Send(
-		opts,
-		input,
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = amount
+			return nativeTokenSource.Send(
+				opts,
+				input,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	setReceiptAttributes(span, receipt)
+	afterSend(ctx, send, receipt)
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenSource.ParseTokensSent)
 	Expect(err).Should(BeNil())
 	Expect(event.Sender).Should(Equal(crypto.PubkeyToAddress(senderKey.PublicKey)))
 
 	// Compute the scaled amount
 	scaledAmount := GetScaledAmountFromNativeTokenSource(
+		ctx,
 		nativeTokenSource,
 		input.DestinationBlockchainID,
 		input.DestinationBridgeAddress,
@@ -525,6 +878,22 @@ func SendNativeTokenDestination(
 	amount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 ) (*types.Receipt, *big.Int) {
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	send := beforeSend(ctx, SendHookInfo{
+		Subnet:                   subnet,
+		BridgeAddress:            nativeTokenDestinationAddress,
+		Sender:                   crypto.PubkeyToAddress(senderKey.PublicKey),
+		DestinationBlockchainID:  input.DestinationBlockchainID,
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		SendAndCall:              false,
+		Amount:                   amount,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+	})
+	input.PrimaryFee, input.SecondaryFee = send.PrimaryFee, send.SecondaryFee
+
 	DepositAndApproveWrappedTokenForFees(
 		ctx,
 		subnet,
@@ -534,17 +903,20 @@ func SendNativeTokenDestination(
 		senderKey,
 	)
 
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	opts.Value = amount
-
-	tx, err := nativeTokenDestination.Send(
-		opts,
-		input,
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = amount
+			return nativeTokenDestination.Send(
+				opts,
+				input,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	setReceiptAttributes(span, receipt)
+	afterSend(ctx, send, receipt)
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenDestination.ParseTokensSent)
 	Expect(err).Should(BeNil())
 	Expect(event.Sender).Should(Equal(crypto.PubkeyToAddress(senderKey.PublicKey)))
@@ -562,26 +934,48 @@ func SendERC20Destination(
 	amount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 ) (*types.Receipt, *big.Int) {
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	tx, err := erc20Destination.Approve(
-		opts,
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	send := beforeSend(ctx, SendHookInfo{
+		Subnet:                   subnet,
+		BridgeAddress:            erc20DestinationAddress,
+		Sender:                   crypto.PubkeyToAddress(senderKey.PublicKey),
+		DestinationBlockchainID:  input.DestinationBlockchainID,
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		SendAndCall:              false,
+		Amount:                   amount,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+	})
+	input.PrimaryFee, input.SecondaryFee = send.PrimaryFee, send.SecondaryFee
+
+	// The ERC20Destination spends its own tokens, so is approved as its own spender
+	ApproveIfNeeded(
+		ctx,
+		subnet,
+		senderKey,
+		erc20DestinationAddress,
+		erc20Destination,
 		erc20DestinationAddress,
 		big.NewInt(0).Add(amount, input.PrimaryFee),
 	)
-	Expect(err).Should(BeNil())
-
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
 
 	// Bridge the tokens back to subnet A
-	tx, err = erc20Destination.Send(
-		opts,
-		input,
-		amount,
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.Send(
+				opts,
+				input,
+				amount,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	setReceiptAttributes(span, receipt)
+	afterSend(ctx, send, receipt)
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseTokensSent)
 	Expect(err).Should(BeNil())
 	Expect(event.Sender).Should(Equal(crypto.PubkeyToAddress(senderKey.PublicKey)))
@@ -600,33 +994,57 @@ func SendAndCallERC20Source(
 	amount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 ) (*types.Receipt, *big.Int) {
-	// Approve the ERC20Source to spend the tokens
-	teleporterUtils.ERC20Approve(
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	send := beforeSend(ctx, SendHookInfo{
+		Subnet:                   subnet,
+		BridgeAddress:            erc20SourceAddress,
+		Sender:                   crypto.PubkeyToAddress(senderKey.PublicKey),
+		DestinationBlockchainID:  input.DestinationBlockchainID,
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		SendAndCall:              true,
+		Amount:                   amount,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+	})
+	input.PrimaryFee, input.SecondaryFee = send.PrimaryFee, send.SecondaryFee
+
+	// Approve the ERC20Source to spend the tokens, unless it already may
+	sourceTokenAddress, err := erc20Source.TokenAddress(&bind.CallOpts{Context: ctx})
+	Expect(err).Should(BeNil())
+	ApproveIfNeeded(
 		ctx,
+		subnet,
+		senderKey,
+		sourceTokenAddress,
 		sourceToken,
 		erc20SourceAddress,
 		big.NewInt(0).Add(amount, input.PrimaryFee),
-		subnet,
-		senderKey,
 	)
 
 	// Send the tokens and verify expected events
-	optsA, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	tx, err := erc20Source.SendAndCall(
-		optsA,
-		input,
-		amount,
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Source.SendAndCall(
+				opts,
+				input,
+				amount,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	setReceiptAttributes(span, receipt)
+	afterSend(ctx, send, receipt)
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Source.ParseTokensAndCallSent)
 	Expect(err).Should(BeNil())
 	Expect(event.Input.RecipientContract).Should(Equal(input.RecipientContract))
 
 	// Computer the scaled amount
 	scaledAmount := GetScaledAmountFromERC20Source(
+		ctx,
 		erc20Source,
 		input.DestinationBlockchainID,
 		input.DestinationBridgeAddress,
@@ -641,28 +1059,49 @@ func SendAndCallNativeTokenSource(
 	ctx context.Context,
 	subnet interfaces.SubnetTestInfo,
 	nativeTokenSource *nativetokensource.NativeTokenSource,
+	nativeTokenSourceAddress common.Address,
 	input nativetokensource.SendAndCallInput,
 	amount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 ) (*types.Receipt, *big.Int) {
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	opts.Value = amount
-
-	tx, err := nativeTokenSource.SendAndCall(
-		opts,
-		input,
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	send := beforeSend(ctx, SendHookInfo{
+		Subnet:                   subnet,
+		BridgeAddress:            nativeTokenSourceAddress,
+		Sender:                   crypto.PubkeyToAddress(senderKey.PublicKey),
+		DestinationBlockchainID:  input.DestinationBlockchainID,
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		SendAndCall:              true,
+		Amount:                   amount,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+	})
+	input.PrimaryFee, input.SecondaryFee = send.PrimaryFee, send.SecondaryFee
+
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = amount
+			return nativeTokenSource.SendAndCall(
+				opts,
+				input,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
+	setReceiptAttributes(span, receipt)
+	afterSend(ctx, send, receipt)
 
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenSource.ParseTokensAndCallSent)
 	Expect(err).Should(BeNil())
 	Expect(event.Input.RecipientContract).Should(Equal(input.RecipientContract))
 
 	// Computer the scaled amount
 	destinationSettings, err := nativeTokenSource.RegisteredDestinations(
-		&bind.CallOpts{},
+		&bind.CallOpts{Context: ctx},
 		input.DestinationBlockchainID,
 		input.DestinationBridgeAddress)
 	Expect(err).Should(BeNil())
@@ -681,25 +1120,46 @@ func SendAndCallNativeTokenDestination(
 	ctx context.Context,
 	subnet interfaces.SubnetTestInfo,
 	nativeTokenDestination *nativetokendestination.NativeTokenDestination,
+	nativeTokenDestinationAddress common.Address,
 	input nativetokendestination.SendAndCallInput,
 	amount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 	tokenMultiplier *big.Int,
 	multiplyOnDestination bool,
 ) (*types.Receipt, *big.Int) {
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	opts.Value = amount
-
-	tx, err := nativeTokenDestination.SendAndCall(
-		opts,
-		input,
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	send := beforeSend(ctx, SendHookInfo{
+		Subnet:                   subnet,
+		BridgeAddress:            nativeTokenDestinationAddress,
+		Sender:                   crypto.PubkeyToAddress(senderKey.PublicKey),
+		DestinationBlockchainID:  input.DestinationBlockchainID,
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		SendAndCall:              true,
+		Amount:                   amount,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+	})
+	input.PrimaryFee, input.SecondaryFee = send.PrimaryFee, send.SecondaryFee
+
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = amount
+			return nativeTokenDestination.SendAndCall(
+				opts,
+				input,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
+	setReceiptAttributes(span, receipt)
+	afterSend(ctx, send, receipt)
 
 	bridgedAmount := big.NewInt(0).Sub(amount, input.PrimaryFee)
 
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, nativeTokenDestination.ParseTokensAndCallSent)
 	Expect(err).Should(BeNil())
 	Expect(event.Input.RecipientContract).Should(Equal(input.RecipientContract))
@@ -717,26 +1177,48 @@ func SendAndCallERC20Destination(
 	amount *big.Int,
 	senderKey *ecdsa.PrivateKey,
 ) (*types.Receipt, *big.Int) {
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	tx, err := erc20Destination.Approve(
-		opts,
+	ctx, span := startSendSpan(ctx, subnet)
+	defer span.End()
+
+	send := beforeSend(ctx, SendHookInfo{
+		Subnet:                   subnet,
+		BridgeAddress:            erc20DestinationAddress,
+		Sender:                   crypto.PubkeyToAddress(senderKey.PublicKey),
+		DestinationBlockchainID:  input.DestinationBlockchainID,
+		DestinationBridgeAddress: input.DestinationBridgeAddress,
+		SendAndCall:              true,
+		Amount:                   amount,
+		PrimaryFee:               input.PrimaryFee,
+		SecondaryFee:             input.SecondaryFee,
+	})
+	input.PrimaryFee, input.SecondaryFee = send.PrimaryFee, send.SecondaryFee
+
+	// The ERC20Destination spends its own tokens, so is approved as its own spender
+	ApproveIfNeeded(
+		ctx,
+		subnet,
+		senderKey,
+		erc20DestinationAddress,
+		erc20Destination,
 		erc20DestinationAddress,
 		big.NewInt(0).Add(amount, input.PrimaryFee),
 	)
-	Expect(err).Should(BeNil())
-
-	teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
 
 	// Bridge the tokens back to subnet A
-	tx, err = erc20Destination.SendAndCall(
-		opts,
-		input,
-		amount,
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return erc20Destination.SendAndCall(
+				opts,
+				input,
+				amount,
+			)
+		},
 	)
-	Expect(err).Should(BeNil())
-
-	receipt := teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	setReceiptAttributes(span, receipt)
+	afterSend(ctx, send, receipt)
 	event, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseTokensAndCallSent)
 	Expect(err).Should(BeNil())
 	Expect(event.Input.RecipientContract).Should(Equal(input.RecipientContract))
@@ -882,7 +1364,7 @@ func SendERC20MultiHopAndVerify(
 		teleporterUtils.TraceTransactionAndExit(ctx, cChainInfo, intermediateReceipt.TxHash)
 	}
 
-	initialBalance, err := toBridge.BalanceOf(&bind.CallOpts{}, recipientAddress)
+	initialBalance, err := toBridge.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 	Expect(err).Should(BeNil())
 
 	// When we relay the above message to the home-chain, a multi-hop transfer
@@ -908,7 +1390,7 @@ func SendERC20MultiHopAndVerify(
 		bridgedAmount,
 	)
 
-	balance, err := toBridge.BalanceOf(&bind.CallOpts{}, recipientAddress)
+	balance, err := toBridge.BalanceOf(&bind.CallOpts{Context: ctx}, recipientAddress)
 	Expect(err).Should(BeNil())
 	teleporterUtils.ExpectBigEqual(balance, big.NewInt(0).Add(initialBalance, bridgedAmount))
 }
@@ -942,6 +1424,28 @@ func CheckERC20DestinationWithdrawal(
 	teleporterUtils.ExpectBigEqual(transferEvent.Value, expectedAmount)
 }
 
+// CheckERC20DestinationEscrow checks that the tokens received for a blocked recipient were minted to the
+// destination itself, and held in escrow for the recipient
+func CheckERC20DestinationEscrow(
+	ctx context.Context,
+	erc20Destination *erc20destination.ERC20Destination,
+	erc20DestinationAddress common.Address,
+	receipt *types.Receipt,
+	expectedRecipientAddress common.Address,
+	expectedAmount *big.Int,
+) {
+	escrowedEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseTokensEscrowed)
+	Expect(err).Should(BeNil())
+	Expect(escrowedEvent.Recipient).Should(Equal(expectedRecipientAddress))
+	teleporterUtils.ExpectBigEqual(escrowedEvent.Amount, expectedAmount)
+
+	transferEvent, err := teleporterUtils.GetEventFromLogs(receipt.Logs, erc20Destination.ParseTransfer)
+	Expect(err).Should(BeNil())
+	Expect(transferEvent.From).Should(Equal(common.Address{}))
+	Expect(transferEvent.To).Should(Equal(erc20DestinationAddress))
+	teleporterUtils.ExpectBigEqual(transferEvent.Value, expectedAmount)
+}
+
 func CheckNativeTokenSourceWithdrawal(
 	ctx context.Context,
 	nativeTokenSourceAddress common.Address,
@@ -958,7 +1462,7 @@ func CheckNativeTokenSourceWithdrawal(
 func GetTokenMultiplier(
 	decimalsShift uint8,
 ) *big.Int {
-	return big.NewInt(int64(math.Pow10(int(decimalsShift))))
+	return tokenscaling.TokenMultiplier(decimalsShift)
 }
 
 type WrappedToken interface {
@@ -979,18 +1483,58 @@ func DepositAndApproveWrappedTokenForFees(
 	}
 
 	// Deposit the native tokens for paying the fee
-	opts, err := bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	opts.Value = amount
-	tx, err := wrappedToken.Deposit(opts)
-	Expect(err).Should(BeNil())
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			opts.Value = amount
+			return wrappedToken.Deposit(opts)
+		},
+	)
 
-	_ = teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return wrappedToken.Approve(opts, spender, amount)
+		},
+	)
+}
 
-	opts, err = bind.NewKeyedTransactorWithChainID(senderKey, subnet.EVMChainID)
-	Expect(err).Should(BeNil())
-	tx, err = wrappedToken.Approve(opts, spender, amount)
+// ApproveIfNeeded approves the spender to spend amount of the token on behalf of the sender, unless the sender's
+// current allowance already covers it, in the same way as the SDK's send path. Returns the receipt of the approval,
+// or nil if none was needed.
+func ApproveIfNeeded(
+	ctx context.Context,
+	subnet interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	tokenAddress common.Address,
+	token ApprovableToken,
+	spender common.Address,
+	amount *big.Int,
+) *types.Receipt {
+	approval, err := allowances.ApprovalNeeded(
+		ctx,
+		subnet.RPCClient,
+		crypto.PubkeyToAddress(senderKey.PublicKey),
+		tokenAddress,
+		spender,
+		amount,
+	)
 	Expect(err).Should(BeNil())
-
-	_ = teleporterUtils.WaitForTransactionSuccess(ctx, subnet, tx.Hash())
+	if approval == nil {
+		return nil
+	}
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return token.Approve(opts, spender, approval)
+		},
+	)
+	log.Info("Approved ERC20", "token", tokenAddress, "spender", spender, "amount", approval)
+	return receipt
 }
@@ -0,0 +1,217 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	erc20destination "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Destination"
+	erc20source "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/ERC20Source"
+	nativetokensource "github.com/ava-labs/teleporter-token-bridge/abi-bindings/go/NativeTokenSource"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// ContractVersion is a version of the bridge contracts, deployed from the Foundry artifacts built at a git ref
+type ContractVersion struct {
+	Name string `json:"name"`
+	Ref  string `json:"ref"`
+
+	artifactsDir string
+}
+
+// CurrentVersion is the version of the contracts in this tree, deployed using the generated bindings
+var CurrentVersion = ContractVersion{Name: "current"}
+
+// IsCurrent returns whether the version is the version of the contracts in this tree
+func (v ContractVersion) IsCurrent() bool {
+	return v.artifactsDir == ""
+}
+
+// VersionManifest lists the pinned contract versions that the current version must interoperate with
+type VersionManifest struct {
+	Versions []ContractVersion `json:"versions"`
+}
+
+// LoadVersionManifest reads the manifest, with the artifacts of each version expected to have been built to
+// <artifactsDir>/<name>/out
+func LoadVersionManifest(manifestFile string, artifactsDir string) VersionManifest {
+	manifestBytes, err := os.ReadFile(manifestFile)
+	Expect(err).Should(BeNil())
+	var manifest VersionManifest
+	Expect(json.Unmarshal(manifestBytes, &manifest)).Should(Succeed())
+	for i := range manifest.Versions {
+		Expect(manifest.Versions[i].Name).ShouldNot(BeEmpty())
+		manifest.Versions[i].artifactsDir = filepath.Join(artifactsDir, manifest.Versions[i].Name, "out")
+	}
+	return manifest
+}
+
+// Deploys the named contract from the artifacts of the version, returning its address.
+// Constructor arguments are packed according to the version's ABI.
+func deployContractVersion(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	version ContractVersion,
+	contractName string,
+	constructorArgs ...interface{},
+) common.Address {
+	artifactFile := filepath.Join(version.artifactsDir, contractName+".sol", contractName+".json")
+	address := deployContractArtifact(ctx, senderKey, subnet, artifactFile, constructorArgs...)
+	recordGasReportContract(subnet, address, contractName+"@"+version.Name, &loadContractArtifact(artifactFile).ABI)
+	log.Info(
+		"Deployed contract version",
+		"contract", contractName,
+		"version", version.Name,
+		"address", address,
+		"blockchainID", subnet.BlockchainID,
+	)
+	return address
+}
+
+// Deploys the contract in the Foundry artifact file, returning its address
+func deployContractArtifact(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	artifactFile string,
+	constructorArgs ...interface{},
+) common.Address {
+	artifact := loadContractArtifact(artifactFile)
+
+	var address common.Address
+	TransactAndWaitForSuccess(
+		ctx,
+		subnet,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			var (
+				tx  *types.Transaction
+				err error
+			)
+			address, tx, _, err = bind.DeployContract(
+				opts,
+				artifact.ABI,
+				artifact.Bytecode,
+				subnet.RPCClient,
+				constructorArgs...,
+			)
+			return tx, err
+		},
+	)
+	contractName := strings.TrimSuffix(filepath.Base(artifactFile), ".json")
+	recordGasReportContract(subnet, address, contractName, &artifact.ABI)
+	return address
+}
+
+// DeployERC20SourceVersion deploys the given version of ERC20Source, returning the current bindings for it
+func DeployERC20SourceVersion(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	version ContractVersion,
+	teleporterManager common.Address,
+	tokenSourceAddress common.Address,
+) (common.Address, *erc20source.ERC20Source) {
+	if version.IsCurrent() {
+		return DeployERC20Source(ctx, senderKey, subnet, teleporterManager, tokenSourceAddress)
+	}
+	address := deployContractVersion(
+		ctx,
+		senderKey,
+		subnet,
+		version,
+		"ERC20Source",
+		subnet.TeleporterRegistryAddress,
+		teleporterManager,
+		tokenSourceAddress,
+	)
+	erc20Source, err := erc20source.NewERC20Source(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, erc20Source
+}
+
+// DeployNativeTokenSourceVersion deploys the given version of NativeTokenSource, returning the current bindings for it
+func DeployNativeTokenSourceVersion(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	version ContractVersion,
+	teleporterManager common.Address,
+	tokenAddress common.Address,
+) (common.Address, *nativetokensource.NativeTokenSource) {
+	if version.IsCurrent() {
+		return DeployNativeTokenSource(ctx, senderKey, subnet, teleporterManager, tokenAddress)
+	}
+	address := deployContractVersion(
+		ctx,
+		senderKey,
+		subnet,
+		version,
+		"NativeTokenSource",
+		subnet.TeleporterRegistryAddress,
+		teleporterManager,
+		tokenAddress,
+	)
+	nativeTokenSource, err := nativetokensource.NewNativeTokenSource(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, nativeTokenSource
+}
+
+// DeployERC20DestinationVersion deploys the given version of ERC20Destination, returning the current bindings for it
+func DeployERC20DestinationVersion(
+	ctx context.Context,
+	senderKey *ecdsa.PrivateKey,
+	subnet interfaces.SubnetTestInfo,
+	version ContractVersion,
+	teleporterManager common.Address,
+	sourceBlockchainID ids.ID,
+	tokenSourceAddress common.Address,
+	tokenName string,
+	tokenSymbol string,
+	tokenDecimals uint8,
+) (common.Address, *erc20destination.ERC20Destination) {
+	if version.IsCurrent() {
+		return DeployERC20Destination(
+			ctx,
+			senderKey,
+			subnet,
+			teleporterManager,
+			sourceBlockchainID,
+			tokenSourceAddress,
+			tokenName,
+			tokenSymbol,
+			tokenDecimals,
+		)
+	}
+	address := deployContractVersion(
+		ctx,
+		senderKey,
+		subnet,
+		version,
+		"ERC20Destination",
+		subnet.TeleporterRegistryAddress,
+		teleporterManager,
+		[32]byte(sourceBlockchainID),
+		tokenSourceAddress,
+		tokenName,
+		tokenSymbol,
+		tokenDecimals,
+	)
+	erc20Destination, err := erc20destination.NewERC20Destination(address, subnet.RPCClient)
+	Expect(err).Should(BeNil())
+	return address, erc20Destination
+}
@@ -0,0 +1,238 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package utils
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	avalancheWarp "github.com/ava-labs/avalanchego/vms/platformvm/warp"
+	"github.com/ava-labs/subnet-evm/accounts/abi/bind"
+	"github.com/ava-labs/subnet-evm/core/types"
+	"github.com/ava-labs/subnet-evm/precompile/contracts/warp"
+	predicateutils "github.com/ava-labs/subnet-evm/predicate"
+	"github.com/ava-labs/teleporter-token-bridge/messages"
+	teleportermessenger "github.com/ava-labs/teleporter/abi-bindings/go/Teleporter/TeleporterMessenger"
+	"github.com/ava-labs/teleporter/tests/interfaces"
+	teleporterUtils "github.com/ava-labs/teleporter/tests/utils"
+	gasUtils "github.com/ava-labs/teleporter/utils/gas-utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+
+	. "github.com/onsi/gomega"
+)
+
+// PackBridgeMessage ABI encodes a BridgeMessage the same way the bridge contracts do,
+// so that it can be used as the message of a raw Teleporter message.
+func PackBridgeMessage(messageType messages.MessageType, payload []byte) []byte {
+	packed, err := messages.EncodeBridgeMessage(messageType, payload)
+	Expect(err).Should(BeNil())
+	return packed
+}
+
+// PackSingleHopSendMessage ABI encodes a SingleHopSendMessage payload
+func PackSingleHopSendMessage(recipient common.Address, amount *big.Int) []byte {
+	packed, err := messages.EncodePayload(messages.SingleHopSendMessage{
+		Recipient: recipient,
+		Amount:    amount,
+	})
+	Expect(err).Should(BeNil())
+	return packed
+}
+
+// PackRegisterDestinationMessage ABI encodes a RegisterDestinationMessage payload
+func PackRegisterDestinationMessage(
+	initialReserveImbalance *big.Int,
+	tokenMultiplier *big.Int,
+	multiplyOnDestination bool,
+) []byte {
+	packed, err := messages.EncodePayload(messages.RegisterDestinationMessage{
+		InitialReserveImbalance: initialReserveImbalance,
+		TokenMultiplier:         tokenMultiplier,
+		MultiplyOnDestination:   multiplyOnDestination,
+	})
+	Expect(err).Should(BeNil())
+	return packed
+}
+
+// SendRawWarpMessage emits a Warp message with an arbitrary payload on the source chain
+// by calling the Warp precompile directly from senderKey. The resulting Warp message's
+// origin sender address is the address of senderKey, rather than the Teleporter contract.
+func SendRawWarpMessage(
+	ctx context.Context,
+	source interfaces.SubnetTestInfo,
+	senderKey *ecdsa.PrivateKey,
+	payload []byte,
+) *types.Receipt {
+	callData, err := warp.PackSendWarpMessage(payload)
+	Expect(err).Should(BeNil())
+
+	warpPrecompile := bind.NewBoundContract(
+		warp.ContractAddress,
+		warp.WarpABI,
+		source.RPCClient,
+		source.RPCClient,
+		source.RPCClient,
+	)
+	receipt := TransactAndWaitForSuccess(
+		ctx,
+		source,
+		senderKey,
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return warpPrecompile.RawTransact(opts, callData)
+		},
+	)
+	log.Info("Sent raw Warp message", "sourceBlockchainID", source.BlockchainID, "txHash", receipt.TxHash)
+	return receipt
+}
+
+// SendRawTeleporterMessage emits a Warp message containing an arbitrary Teleporter message on the
+// source chain, and returns it aggregate signed by the source chain's validators for delivery
+// to the destination chain. Since the Warp message is not sent by the Teleporter contract,
+// delivering it to the destination's TeleporterMessenger is expected to be rejected.
+func SendRawTeleporterMessage(
+	ctx context.Context,
+	network interfaces.LocalNetwork,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	message teleportermessenger.TeleporterMessage,
+	senderKey *ecdsa.PrivateKey,
+) *avalancheWarp.Message {
+	payload, err := teleportermessenger.PackTeleporterMessage(message)
+	Expect(err).Should(BeNil())
+
+	receipt := SendRawWarpMessage(ctx, source, senderKey, payload)
+	return network.ConstructSignedWarpMessage(ctx, receipt, source, destination)
+}
+
+// DeliverRawWarpMessage submits a signed Warp message to the destination chain in the predicate
+// of a transaction calling the given contract with the given calldata. The receipt is returned
+// without asserting its status, so that callers can check for the expected failure.
+func DeliverRawWarpMessage(
+	ctx context.Context,
+	destination interfaces.SubnetTestInfo,
+	signedMessage *avalancheWarp.Message,
+	contractAddress common.Address,
+	callData []byte,
+	gasLimit uint64,
+	senderKey *ecdsa.PrivateKey,
+) *types.Receipt {
+	gasFeeCap, gasTipCap, nonce := teleporterUtils.CalculateTxParams(
+		ctx,
+		destination,
+		teleporterUtils.PrivateKeyToAddress(senderKey),
+	)
+
+	tx := predicateutils.NewPredicateTx(
+		destination.EVMChainID,
+		nonce,
+		&contractAddress,
+		gasLimit,
+		gasFeeCap,
+		gasTipCap,
+		big.NewInt(0),
+		callData,
+		types.AccessList{},
+		warp.ContractAddress,
+		signedMessage.Bytes(),
+	)
+	tx = teleporterUtils.SignTransaction(tx, senderKey, destination.EVMChainID)
+
+	err := destination.RPCClient.SendTransaction(ctx, tx)
+	Expect(err).Should(BeNil())
+
+	cctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	receipt, err := teleporterUtils.WaitMined(cctx, destination.RPCClient, tx.Hash())
+	Expect(err).Should(BeNil())
+	return receipt
+}
+
+// DeliverRawTeleporterMessage submits a signed Warp message to the destination's TeleporterMessenger
+// by calling receiveCrossChainMessage, with enough gas to execute a message with the given required gas limit.
+// The receipt is returned without asserting its status.
+func DeliverRawTeleporterMessage(
+	ctx context.Context,
+	destination interfaces.SubnetTestInfo,
+	signedMessage *avalancheWarp.Message,
+	requiredGasLimit *big.Int,
+	teleporterContractAddress common.Address,
+	senderKey *ecdsa.PrivateKey,
+) *types.Receipt {
+	numSigners, err := signedMessage.Signature.NumSigners()
+	Expect(err).Should(BeNil())
+
+	gasLimit, err := gasUtils.CalculateReceiveMessageGasLimit(numSigners, requiredGasLimit)
+	Expect(err).Should(BeNil())
+
+	callData, err := teleportermessenger.PackReceiveCrossChainMessage(
+		0,
+		teleporterUtils.PrivateKeyToAddress(senderKey),
+	)
+	Expect(err).Should(BeNil())
+
+	return DeliverRawWarpMessage(
+		ctx,
+		destination,
+		signedMessage,
+		teleporterContractAddress,
+		callData,
+		gasLimit,
+		senderKey,
+	)
+}
+
+// DeliverMessageConcurrently relays the Teleporter message sent in the source receipt from each of the relayer
+// keys at once, as competing relayers would. Each relayer aggregates the message's signatures independently,
+// and their transactions are all submitted before waiting for any of them to be accepted. The receipts are
+// returned in the order of the keys without asserting their status, since at most one delivery can succeed.
+func DeliverMessageConcurrently(
+	ctx context.Context,
+	network interfaces.LocalNetwork,
+	sourceReceipt *types.Receipt,
+	source interfaces.SubnetTestInfo,
+	destination interfaces.SubnetTestInfo,
+	relayerKeys []*ecdsa.PrivateKey,
+) []*types.Receipt {
+	sendEvent, err := teleporterUtils.GetEventFromLogs(
+		sourceReceipt.Logs,
+		source.TeleporterMessenger.ParseSendCrossChainMessage,
+	)
+	Expect(err).Should(BeNil())
+
+	var signedTxs []*types.Transaction
+	for _, relayerKey := range relayerKeys {
+		signedWarpMessage := network.ConstructSignedWarpMessage(ctx, sourceReceipt, source, destination)
+		signedTxs = append(signedTxs, teleporterUtils.CreateReceiveCrossChainMessageTransaction(
+			ctx,
+			signedWarpMessage,
+			sendEvent.Message.RequiredGasLimit,
+			sendEvent.Raw.Address,
+			relayerKey,
+			destination,
+		))
+	}
+	for _, tx := range signedTxs {
+		Expect(destination.RPCClient.SendTransaction(ctx, tx)).Should(Succeed())
+	}
+
+	cctx, cancel := context.WithTimeout(ctx, 20*time.Second)
+	defer cancel()
+	var receipts []*types.Receipt
+	for _, tx := range signedTxs {
+		receipt, err := teleporterUtils.WaitMined(cctx, destination.RPCClient, tx.Hash())
+		Expect(err).Should(BeNil())
+		receipts = append(receipts, receipt)
+	}
+	log.Info(
+		"Delivered message concurrently",
+		"messageID", ids.ID(sendEvent.MessageID),
+		"destinationBlockchainID", destination.BlockchainID,
+		"numRelayers", len(relayerKeys),
+	)
+	return receipts
+}
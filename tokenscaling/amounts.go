@@ -0,0 +1,167 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package tokenscaling
+
+import (
+	"math/big"
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// The kinds of amount generated by an AmountGenerator
+type amountKind int
+
+const (
+	// A single unit of the smallest denomination
+	singleWeiAmount amountKind = iota
+	// One less than a multiple of the token multiplier, which loses the most precision when divided
+	multipleMinusOneAmount
+	// A multiple of the token multiplier, which is scaled without losing precision
+	multipleAmount
+	// One more than a multiple of the token multiplier, which loses a single unit when divided
+	multiplePlusOneAmount
+	// The largest amount that can be scaled without overflowing, or the maximum amount if smaller
+	maxSafeAmount
+	// One less than the largest safe amount
+	maxSafeMinusOneAmount
+	// The largest multiple of the token multiplier no greater than the largest safe amount
+	maxSafeMultipleAmount
+	// Uniformly distributed between a single unit and the largest safe amount
+	uniformAmount
+)
+
+// The relative frequency of each kind of amount. Amounts at the scaling boundaries are generated more often
+// than uniformly distributed amounts, since precision bugs are only hit at the boundaries.
+var amountWeights = []struct {
+	kind   amountKind
+	weight int
+}{
+	{kind: singleWeiAmount, weight: 1},
+	{kind: multipleMinusOneAmount, weight: 3},
+	{kind: multipleAmount, weight: 2},
+	{kind: multiplePlusOneAmount, weight: 3},
+	{kind: maxSafeAmount, weight: 1},
+	{kind: maxSafeMinusOneAmount, weight: 1},
+	{kind: maxSafeMultipleAmount, weight: 1},
+	{kind: uniformAmount, weight: 2},
+}
+
+// The largest factor of the token multiplier in the multiples generated near zero, so that small amounts are
+// generated as often as large ones
+const maxSmallMultiple = 1000
+
+// AmountGenerator generates random amounts to be scaled by a destination bridge's token scale, biased towards the
+// amounts at which scaling loses precision or overflows. Every amount is between one and the maximum amount.
+type AmountGenerator struct {
+	rand            *rand.Rand
+	tokenMultiplier *big.Int
+	// Whether the generated amounts are multiplied by the token multiplier when scaled, rather than divided
+	multiplies bool
+	maxSafe    *big.Int
+}
+
+// NewAmountGenerator returns a generator of amounts sent in the given direction over a destination bridge with the
+// token scale, of at most maxAmount. Amounts are generated from rng, so the same seed generates the same amounts.
+func NewAmountGenerator(
+	rng *rand.Rand,
+	tokenMultiplier *big.Int,
+	multiplyOnDestination bool,
+	isSendToDestination bool,
+	maxAmount *big.Int,
+) *AmountGenerator {
+	multiplies := multiplyOnDestination == isSendToDestination
+	maxSafe := new(big.Int).Set(maxAmount)
+	if multiplies {
+		maxScalable := new(big.Int).Div(math.MaxBig256, tokenMultiplier)
+		if maxScalable.Cmp(maxSafe) < 0 {
+			maxSafe = maxScalable
+		}
+	}
+	return &AmountGenerator{
+		rand:            rng,
+		tokenMultiplier: tokenMultiplier,
+		multiplies:      multiplies,
+		maxSafe:         maxSafe,
+	}
+}
+
+// MaxSafeAmount returns the largest amount the generator generates
+func (g *AmountGenerator) MaxSafeAmount() *big.Int {
+	return new(big.Int).Set(g.maxSafe)
+}
+
+// Next returns a new random amount
+func (g *AmountGenerator) Next() *big.Int {
+	return g.clamp(g.generate(g.nextKind()))
+}
+
+func (g *AmountGenerator) nextKind() amountKind {
+	total := 0
+	for _, w := range amountWeights {
+		total += w.weight
+	}
+	n := g.rand.Intn(total)
+	for _, w := range amountWeights {
+		if n < w.weight {
+			return w.kind
+		}
+		n -= w.weight
+	}
+	return uniformAmount
+}
+
+func (g *AmountGenerator) generate(kind amountKind) *big.Int {
+	switch kind {
+	case singleWeiAmount:
+		return big.NewInt(1)
+	case multipleMinusOneAmount:
+		return new(big.Int).Sub(g.multiple(), big.NewInt(1))
+	case multipleAmount:
+		return g.multiple()
+	case multiplePlusOneAmount:
+		return new(big.Int).Add(g.multiple(), big.NewInt(1))
+	case maxSafeAmount:
+		return new(big.Int).Set(g.maxSafe)
+	case maxSafeMinusOneAmount:
+		return new(big.Int).Sub(g.maxSafe, big.NewInt(1))
+	case maxSafeMultipleAmount:
+		return new(big.Int).Sub(g.maxSafe, new(big.Int).Mod(g.maxSafe, g.tokenMultiplier))
+	default:
+		return g.uniform(g.maxSafe)
+	}
+}
+
+// Returns a random multiple of the token multiplier, half of the time with a small factor, and otherwise with any
+// factor up to the largest safe amount
+func (g *AmountGenerator) multiple() *big.Int {
+	maxFactor := new(big.Int).Div(g.maxSafe, g.tokenMultiplier)
+	if g.rand.Intn(2) == 0 && maxFactor.Cmp(big.NewInt(maxSmallMultiple)) > 0 {
+		maxFactor = big.NewInt(maxSmallMultiple)
+	}
+	return new(big.Int).Mul(g.uniform(maxFactor), g.tokenMultiplier)
+}
+
+// Returns a uniformly distributed amount between one and max, or one if max is less than one
+func (g *AmountGenerator) uniform(max *big.Int) *big.Int {
+	if max.Cmp(big.NewInt(1)) <= 0 {
+		return big.NewInt(1)
+	}
+	n := make([]byte, (max.BitLen()+7)/8+8)
+	g.rand.Read(n)
+	amount := new(big.Int).SetBytes(n)
+	amount.Mod(amount, max)
+	return amount.Add(amount, big.NewInt(1))
+}
+
+// Returns the amount within one and the largest safe amount
+func (g *AmountGenerator) clamp(amount *big.Int) *big.Int {
+	if amount.Sign() <= 0 {
+		return big.NewInt(1)
+	}
+	if amount.Cmp(g.maxSafe) > 0 {
+		return new(big.Int).Set(g.maxSafe)
+	}
+	return amount
+}
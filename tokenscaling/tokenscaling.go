@@ -0,0 +1,89 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package tokenscaling mirrors the token scaling applied by the bridge contracts
+// in TokenScalingUtils.sol, for use off-chain.
+package tokenscaling
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// MaxDecimalsShift is the largest decimals shift accepted by the TeleporterTokenDestination constructor
+const MaxDecimalsShift = 18
+
+var ErrDecimalsShiftTooLarge = errors.New("decimals shift too large")
+
+// TokenMultiplier returns the token multiplier of a destination bridge constructed with the decimals shift,
+// as computed in TeleporterTokenDestination.sol
+func TokenMultiplier(decimalsShift uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimalsShift)), nil)
+}
+
+// DecimalsShift returns the decimalsShift and multiplyOnDestination constructor arguments of a destination bridge
+// whose token has destinationDecimals, bridging a source token with sourceDecimals, such that one whole source
+// token is bridged as one whole destination token.
+func DecimalsShift(sourceDecimals uint8, destinationDecimals uint8) (uint8, bool, error) {
+	multiplyOnDestination := destinationDecimals > sourceDecimals
+	decimalsShift := sourceDecimals - destinationDecimals
+	if multiplyOnDestination {
+		decimalsShift = destinationDecimals - sourceDecimals
+	}
+	if decimalsShift > MaxDecimalsShift {
+		return 0, false, fmt.Errorf(
+			"%w: %d, between %d source decimals and %d destination decimals, exceeds %d",
+			ErrDecimalsShiftTooLarge,
+			decimalsShift,
+			sourceDecimals,
+			destinationDecimals,
+			MaxDecimalsShift,
+		)
+	}
+	return decimalsShift, multiplyOnDestination, nil
+}
+
+// ApplyTokenScale scales the amount of source tokens to the destination bridge's token scale
+func ApplyTokenScale(tokenMultiplier *big.Int, multiplyOnDestination bool, sourceTokenAmount *big.Int) *big.Int {
+	return scaleTokens(tokenMultiplier, multiplyOnDestination, sourceTokenAmount, true)
+}
+
+// RemoveTokenScale removes the destination bridge's token scale from the amount of destination tokens,
+// returning the corresponding amount of source tokens.
+func RemoveTokenScale(
+	tokenMultiplier *big.Int,
+	multiplyOnDestination bool,
+	destinationTokenAmount *big.Int,
+) *big.Int {
+	return scaleTokens(tokenMultiplier, multiplyOnDestination, destinationTokenAmount, false)
+}
+
+// CollateralNeeded returns the amount of source tokens the source requires as collateral for
+// a destination with the given initial reserve imbalance, rounded up as in TeleporterTokenSource.sol.
+func CollateralNeeded(
+	tokenMultiplier *big.Int,
+	multiplyOnDestination bool,
+	initialReserveImbalance *big.Int,
+) *big.Int {
+	collateralNeeded := RemoveTokenScale(tokenMultiplier, multiplyOnDestination, initialReserveImbalance)
+	if multiplyOnDestination && new(big.Int).Mod(initialReserveImbalance, tokenMultiplier).Sign() != 0 {
+		collateralNeeded.Add(collateralNeeded, big.NewInt(1))
+	}
+	return collateralNeeded
+}
+
+func scaleTokens(
+	tokenMultiplier *big.Int,
+	multiplyOnDestination bool,
+	amount *big.Int,
+	isSendToDestination bool,
+) *big.Int {
+	// Multiply when multiplyOnDestination and isSendToDestination are
+	// both true or both false.
+	if multiplyOnDestination == isSendToDestination {
+		return new(big.Int).Mul(amount, tokenMultiplier)
+	}
+	// Otherwise divide.
+	return new(big.Int).Div(amount, tokenMultiplier)
+}
@@ -0,0 +1,108 @@
+// Copyright (C) 2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package tracing exports OpenTelemetry spans for the phases of bridge transfers to an OTLP endpoint.
+// A transfer is traced as a send on the origin chain, then for each hop, the relay of its Teleporter
+// message and its receipt on the next chain, including any call of a recipient contract.
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/trace"
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Span names of the phases of a transfer
+const (
+	SendSpan    = "send"
+	RelaySpan   = "relay"
+	ReceiveSpan = "receive"
+	CallSpan    = "call"
+)
+
+// Attribute keys set on transfer spans
+const (
+	BridgeKey                  = attribute.Key("bridge.name")
+	EventTypeKey               = attribute.Key("bridge.event_type")
+	MessageIDKey               = attribute.Key("bridge.message_id")
+	SourceBlockchainIDKey      = attribute.Key("bridge.source_blockchain_id")
+	DestinationBlockchainIDKey = attribute.Key("bridge.destination_blockchain_id")
+	TxHashKey                  = attribute.Key("bridge.tx_hash")
+	BlockNumberKey             = attribute.Key("bridge.block_number")
+	CallSucceededKey           = attribute.Key("bridge.call_succeeded")
+)
+
+const defaultSampleRate = 1
+
+// Tracer creates spans and exports them until closed
+type Tracer = trace.Tracer
+
+// Noop is a tracer that does not export any spans
+var Noop = trace.Noop
+
+// Config configures the export of spans. Tracing is disabled if no endpoint is set.
+type Config struct {
+	// Endpoint is the host:port of the OTLP collector
+	Endpoint string `json:"endpoint"`
+	// Exporter is the OTLP protocol, either grpc (default) or http
+	Exporter string `json:"exporter"`
+	// Insecure disables TLS, e.g. for a collector running locally
+	Insecure bool              `json:"insecure"`
+	Headers  map[string]string `json:"headers"`
+	// SampleRate is the fraction of traces to export, which defaults to all of them
+	SampleRate float64 `json:"sample-rate"`
+}
+
+// NewTracer creates a tracer for the named application, or a no-op tracer if tracing is disabled
+func NewTracer(config Config, appName string) (Tracer, error) {
+	if config.Endpoint == "" {
+		return Noop, nil
+	}
+	exporter := config.Exporter
+	if exporter == "" {
+		exporter = trace.GRPC.String()
+	}
+	exporterType, err := trace.ExporterTypeFromString(exporter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tracing exporter: %w", err)
+	}
+	sampleRate := config.SampleRate
+	if sampleRate == 0 {
+		sampleRate = defaultSampleRate
+	}
+	return trace.New(trace.Config{
+		ExporterConfig: trace.ExporterConfig{
+			Type:     exporterType,
+			Endpoint: config.Endpoint,
+			Headers:  config.Headers,
+			Insecure: config.Insecure,
+		},
+		Enabled:         true,
+		TraceSampleRate: sampleRate,
+		AppName:         appName,
+	})
+}
+
+// MessageAttributes returns the attributes identifying a Teleporter message between two chains
+func MessageAttributes(
+	messageID ids.ID,
+	sourceBlockchainID ids.ID,
+	destinationBlockchainID ids.ID,
+) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		MessageIDKey.String(messageID.String()),
+		SourceBlockchainIDKey.String(sourceBlockchainID.String()),
+		DestinationBlockchainIDKey.String(destinationBlockchainID.String()),
+	}
+}
+
+// TransactionAttributes returns the attributes identifying a transaction
+func TransactionAttributes(txHash common.Hash, blockNumber uint64) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		TxHashKey.String(txHash.Hex()),
+		BlockNumberKey.Int64(int64(blockNumber)),
+	}
+}